@@ -15,6 +15,7 @@ val services = mapOf(
     "appflow" to ServiceSpec("AppFlow"),
     "appintegrations" to ServiceSpec("AppIntegrations"),
     "applicationinsights" to ServiceSpec("CloudWatch Application Insights"),
+    "applicationsignals" to ServiceSpec("CloudWatch Application Signals"),
     "appmesh" to ServiceSpec("App Mesh"),
     "apprunner" to ServiceSpec("App Runner"),
     "appstream" to ServiceSpec("AppStream 2.0", vpcLock = true, parallelismOverride = 10),
@@ -28,6 +29,8 @@ val services = mapOf(
     "bcmdataexports" to ServiceSpec("BCM Data Exports"),
     "bedrock" to ServiceSpec("Amazon Bedrock"),
     "bedrockagent" to ServiceSpec("Agents for Amazon Bedrock"),
+    "billingconductor" to ServiceSpec("Billing Conductor"),
+    "braket" to ServiceSpec("Braket"),
     "budgets" to ServiceSpec("Web Services Budgets"),
     "ce" to ServiceSpec("CE (Cost Explorer)"),
     "chatbot" to ServiceSpec("Chatbot"),
@@ -64,6 +67,7 @@ val services = mapOf(
     "costoptimizationhub" to ServiceSpec("Cost Optimization Hub"),
     "cur" to ServiceSpec("Cost and Usage Report", regionOverride = "us-east-1"),
     "customerprofiles" to ServiceSpec("Connect Customer Profiles"),
+    "databrew" to ServiceSpec("Glue DataBrew"),
     "dataexchange" to ServiceSpec("Data Exchange"),
     "datapipeline" to ServiceSpec("Data Pipeline"),
     "datasync" to ServiceSpec("DataSync", vpcLock = true),
@@ -97,6 +101,7 @@ val services = mapOf(
     "emr" to ServiceSpec("EMR", vpcLock = true),
     "emrcontainers" to ServiceSpec("EMR Containers"),
     "emrserverless" to ServiceSpec("EMR Serverless"),
+    "entityresolution" to ServiceSpec("Entity Resolution"),
     "events" to ServiceSpec("EventBridge"),
     "evidently" to ServiceSpec("CloudWatch Evidently"),
     "finspace" to ServiceSpec("FinSpace"),
@@ -110,6 +115,7 @@ val services = mapOf(
     "glue" to ServiceSpec("Glue"),
     "grafana" to ServiceSpec("Managed Grafana"),
     "greengrass" to ServiceSpec("IoT Greengrass"),
+    "greengrassv2" to ServiceSpec("IoT Greengrass V2"),
     "groundstation" to ServiceSpec("Ground Station"),
     "guardduty" to ServiceSpec("GuardDuty"),
     "healthlake" to ServiceSpec("HealthLake"),
@@ -122,6 +128,7 @@ val services = mapOf(
     "iot" to ServiceSpec("IoT Core"),
     "iotanalytics" to ServiceSpec("IoT Analytics"),
     "iotevents" to ServiceSpec("IoT Events"),
+    "iottwinmaker" to ServiceSpec("IoT TwinMaker"),
     "ipam" to ServiceSpec("VPC IPAM (IP Address Manager)", vpcLock = true, patternOverride = "TestAccIPAM", splitPackageRealPackage = "ec2"),
     "ivs" to ServiceSpec("IVS (Interactive Video)"),
     "ivschat" to ServiceSpec("IVS (Interactive Video) Chat"),
@@ -143,6 +150,8 @@ val services = mapOf(
     "lookoutmetrics" to ServiceSpec("Lookout for Metrics"),
     "m2" to ServiceSpec("Mainframe Modernization"),
     "macie2" to ServiceSpec("Macie"),
+    "managedblockchain" to ServiceSpec("Managed Blockchain"),
+    "marketplacecatalog" to ServiceSpec("Marketplace Catalog"),
     "mediaconnect" to ServiceSpec("Elemental MediaConnect"),
     "mediaconvert" to ServiceSpec("Elemental MediaConvert"),
     "medialive" to ServiceSpec("Elemental MediaLive"),
@@ -156,6 +165,7 @@ val services = mapOf(
     "neptunegraph" to ServiceSpec("Neptune Analytics"),
     "networkfirewall" to ServiceSpec("Network Firewall", vpcLock = true),
     "networkmanager" to ServiceSpec("Network Manager", vpcLock = true),
+    "networkmonitor" to ServiceSpec("CloudWatch Network Monitor"),
     "oam" to ServiceSpec("CloudWatch Observability Access Manager"),
     "opensearch" to ServiceSpec("OpenSearch", vpcLock = true),
     "opensearchserverless" to ServiceSpec("OpenSearch Serverless"),
@@ -179,6 +189,7 @@ val services = mapOf(
     "redshiftdata" to ServiceSpec("Redshift Data"),
     "redshiftserverless" to ServiceSpec("Redshift Serverless"),
     "rekognition" to ServiceSpec("Rekognition"),
+    "resiliencehub" to ServiceSpec("Resilience Hub"),
     "resourceexplorer2" to ServiceSpec("Resource Explorer"),
     "resourcegroups" to ServiceSpec("Resource Groups"),
     "resourcegroupstaggingapi" to ServiceSpec("Resource Groups Tagging"),
@@ -222,10 +233,12 @@ val services = mapOf(
     "sts" to ServiceSpec("STS (Security Token)"),
     "swf" to ServiceSpec("SWF (Simple Workflow)"),
     "synthetics" to ServiceSpec("CloudWatch Synthetics", parallelismOverride = 10),
+    "timestreaminfluxdb" to ServiceSpec("Timestream for InfluxDB"),
     "timestreamwrite" to ServiceSpec("Timestream Write"),
     "transcribe" to ServiceSpec("Transcribe"),
     "transfer" to ServiceSpec("Transfer Family", vpcLock = true),
     "transitgateway" to ServiceSpec("Transit Gateway", vpcLock = true, patternOverride = "TestAccTransitGateway", splitPackageRealPackage = "ec2"),
+    "translate" to ServiceSpec("Translate"),
     "verifiedaccess" to ServiceSpec("Verified Access", vpcLock = true, patternOverride = "TestAccVerifiedAccess", splitPackageRealPackage = "ec2"),
     "verifiedpermissions" to ServiceSpec("Verified Permissions"),
     "vpc" to ServiceSpec("VPC (Virtual Private Cloud)", vpcLock = true, patternOverride = "TestAccVPC", splitPackageRealPackage = "ec2"),
@@ -7,7 +7,7 @@ require (
 	github.com/YakDriver/go-version v0.1.0
 	github.com/YakDriver/regexache v0.23.0
 	github.com/aws/aws-sdk-go v1.53.0
-	github.com/aws/aws-sdk-go-v2 v1.26.1
+	github.com/aws/aws-sdk-go-v2 v1.36.5
 	github.com/aws/aws-sdk-go-v2/config v1.27.13
 	github.com/aws/aws-sdk-go-v2/credentials v1.17.13
 	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.16.1
@@ -15,9 +15,9 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/accessanalyzer v1.29.3
 	github.com/aws/aws-sdk-go-v2/service/account v1.16.5
 	github.com/aws/aws-sdk-go-v2/service/acm v1.25.5
-	github.com/aws/aws-sdk-go-v2/service/acmpca v1.29.5
+	github.com/aws/aws-sdk-go-v2/service/acmpca v1.40.2
 	github.com/aws/aws-sdk-go-v2/service/amp v1.25.5
-	github.com/aws/aws-sdk-go-v2/service/amplify v1.21.6
+	github.com/aws/aws-sdk-go-v2/service/amplify v1.32.1
 	github.com/aws/aws-sdk-go-v2/service/apigateway v1.23.7
 	github.com/aws/aws-sdk-go-v2/service/apigatewayv2 v1.20.5
 	github.com/aws/aws-sdk-go-v2/service/appconfig v1.29.3
@@ -25,6 +25,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/appflow v1.41.5
 	github.com/aws/aws-sdk-go-v2/service/appintegrations v1.25.5
 	github.com/aws/aws-sdk-go-v2/service/applicationautoscaling v1.27.5
+	github.com/aws/aws-sdk-go-v2/service/applicationsignals v1.11.1
 	github.com/aws/aws-sdk-go-v2/service/apprunner v1.28.5
 	github.com/aws/aws-sdk-go-v2/service/athena v1.40.5
 	github.com/aws/aws-sdk-go-v2/service/auditmanager v1.32.5
@@ -35,10 +36,10 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/bedrock v1.8.2
 	github.com/aws/aws-sdk-go-v2/service/bedrockagent v1.10.1
 	github.com/aws/aws-sdk-go-v2/service/budgets v1.23.1
-	github.com/aws/aws-sdk-go-v2/service/chatbot v1.1.6
+	github.com/aws/aws-sdk-go-v2/service/chatbot v1.10.2
 	github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines v1.15.6
 	github.com/aws/aws-sdk-go-v2/service/chimesdkvoice v1.15.1
-	github.com/aws/aws-sdk-go-v2/service/cleanrooms v1.12.1
+	github.com/aws/aws-sdk-go-v2/service/cleanrooms v1.25.0
 	github.com/aws/aws-sdk-go-v2/service/cloud9 v1.24.5
 	github.com/aws/aws-sdk-go-v2/service/cloudcontrol v1.18.5
 	github.com/aws/aws-sdk-go-v2/service/cloudformation v1.50.1
@@ -48,7 +49,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/cloudsearch v1.22.5
 	github.com/aws/aws-sdk-go-v2/service/cloudtrail v1.39.3
 	github.com/aws/aws-sdk-go-v2/service/cloudwatch v1.38.1
-	github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs v1.35.2
+	github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs v1.51.0
 	github.com/aws/aws-sdk-go-v2/service/codeartifact v1.27.1
 	github.com/aws/aws-sdk-go-v2/service/codebuild v1.34.2
 	github.com/aws/aws-sdk-go-v2/service/codecatalyst v1.13.2
@@ -69,37 +70,41 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/costexplorer v1.38.1
 	github.com/aws/aws-sdk-go-v2/service/costoptimizationhub v1.4.5
 	github.com/aws/aws-sdk-go-v2/service/customerprofiles v1.36.5
+	github.com/aws/aws-sdk-go-v2/service/databrew v1.34.2
 	github.com/aws/aws-sdk-go-v2/service/datasync v1.37.2
-	github.com/aws/aws-sdk-go-v2/service/datazone v1.8.1
+	github.com/aws/aws-sdk-go-v2/service/datazone v1.30.0
 	github.com/aws/aws-sdk-go-v2/service/dax v1.19.5
 	github.com/aws/aws-sdk-go-v2/service/devopsguru v1.30.5
 	github.com/aws/aws-sdk-go-v2/service/directoryservice v1.24.5
 	github.com/aws/aws-sdk-go-v2/service/docdbelastic v1.9.4
 	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.32.1
 	github.com/aws/aws-sdk-go-v2/service/ec2 v1.161.1
-	github.com/aws/aws-sdk-go-v2/service/ecr v1.28.0
+	github.com/aws/aws-sdk-go-v2/service/ecr v1.43.3
 	github.com/aws/aws-sdk-go-v2/service/ecrpublic v1.23.5
-	github.com/aws/aws-sdk-go-v2/service/ecs v1.41.8
+	github.com/aws/aws-sdk-go-v2/service/ecs v1.53.8
 	github.com/aws/aws-sdk-go-v2/service/eks v1.42.2
 	github.com/aws/aws-sdk-go-v2/service/elasticache v1.38.2
 	github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk v1.23.5
 	github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2 v1.30.6
 	github.com/aws/aws-sdk-go-v2/service/emr v1.39.6
 	github.com/aws/aws-sdk-go-v2/service/emrserverless v1.19.2
+	github.com/aws/aws-sdk-go-v2/service/entityresolution v1.16.0
 	github.com/aws/aws-sdk-go-v2/service/eventbridge v1.30.5
 	github.com/aws/aws-sdk-go-v2/service/evidently v1.19.5
 	github.com/aws/aws-sdk-go-v2/service/finspace v1.24.2
 	github.com/aws/aws-sdk-go-v2/service/firehose v1.28.7
-	github.com/aws/aws-sdk-go-v2/service/fis v1.24.3
+	github.com/aws/aws-sdk-go-v2/service/fis v1.33.2
 	github.com/aws/aws-sdk-go-v2/service/fms v1.33.2
 	github.com/aws/aws-sdk-go-v2/service/glacier v1.22.5
 	github.com/aws/aws-sdk-go-v2/service/globalaccelerator v1.23.2
+	github.com/aws/aws-sdk-go-v2/service/greengrassv2 v1.30.0
 	github.com/aws/aws-sdk-go-v2/service/groundstation v1.27.1
 	github.com/aws/aws-sdk-go-v2/service/healthlake v1.24.1
 	github.com/aws/aws-sdk-go-v2/service/iam v1.32.1
 	github.com/aws/aws-sdk-go-v2/service/identitystore v1.23.6
 	github.com/aws/aws-sdk-go-v2/service/inspector2 v1.26.1
 	github.com/aws/aws-sdk-go-v2/service/internetmonitor v1.14.1
+	github.com/aws/aws-sdk-go-v2/service/iottwinmaker v1.21.0
 	github.com/aws/aws-sdk-go-v2/service/ivschat v1.12.6
 	github.com/aws/aws-sdk-go-v2/service/kafka v1.31.4
 	github.com/aws/aws-sdk-go-v2/service/kendra v1.50.2
@@ -113,6 +118,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/lightsail v1.37.1
 	github.com/aws/aws-sdk-go-v2/service/lookoutmetrics v1.27.5
 	github.com/aws/aws-sdk-go-v2/service/m2 v1.13.1
+	github.com/aws/aws-sdk-go-v2/service/managedblockchain v1.19.0
 	github.com/aws/aws-sdk-go-v2/service/mediaconnect v1.28.5
 	github.com/aws/aws-sdk-go-v2/service/mediaconvert v1.53.2
 	github.com/aws/aws-sdk-go-v2/service/medialive v1.52.1
@@ -122,7 +128,9 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/mq v1.22.5
 	github.com/aws/aws-sdk-go-v2/service/mwaa v1.26.6
 	github.com/aws/aws-sdk-go-v2/service/neptunegraph v1.8.2
+	github.com/aws/aws-sdk-go-v2/service/networkmonitor v1.8.2
 	github.com/aws/aws-sdk-go-v2/service/oam v1.11.1
+	github.com/aws/aws-sdk-go-v2/service/opensearch v1.46.3
 	github.com/aws/aws-sdk-go-v2/service/opensearchserverless v1.11.6
 	github.com/aws/aws-sdk-go-v2/service/osis v1.8.5
 	github.com/aws/aws-sdk-go-v2/service/paymentcryptography v1.10.1
@@ -132,12 +140,14 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/pricing v1.28.2
 	github.com/aws/aws-sdk-go-v2/service/qbusiness v1.6.1
 	github.com/aws/aws-sdk-go-v2/service/qldb v1.21.5
+	github.com/aws/aws-sdk-go-v2/service/quicksight v1.86.0
 	github.com/aws/aws-sdk-go-v2/service/rbin v1.16.5
 	github.com/aws/aws-sdk-go-v2/service/rds v1.78.1
 	github.com/aws/aws-sdk-go-v2/service/redshift v1.44.1
 	github.com/aws/aws-sdk-go-v2/service/redshiftdata v1.25.5
 	github.com/aws/aws-sdk-go-v2/service/redshiftserverless v1.18.1
 	github.com/aws/aws-sdk-go-v2/service/rekognition v1.40.1
+	github.com/aws/aws-sdk-go-v2/service/resiliencehub v1.30.2
 	github.com/aws/aws-sdk-go-v2/service/resourceexplorer2 v1.10.6
 	github.com/aws/aws-sdk-go-v2/service/resourcegroups v1.22.1
 	github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi v1.21.5
@@ -148,16 +158,16 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/s3control v1.44.7
 	github.com/aws/aws-sdk-go-v2/service/scheduler v1.8.5
 	github.com/aws/aws-sdk-go-v2/service/secretsmanager v1.28.7
-	github.com/aws/aws-sdk-go-v2/service/securityhub v1.48.1
+	github.com/aws/aws-sdk-go-v2/service/securityhub v1.58.0
 	github.com/aws/aws-sdk-go-v2/service/securitylake v1.13.4
 	github.com/aws/aws-sdk-go-v2/service/servicecatalogappregistry v1.26.5
-	github.com/aws/aws-sdk-go-v2/service/servicequotas v1.21.5
+	github.com/aws/aws-sdk-go-v2/service/servicequotas v1.28.0
 	github.com/aws/aws-sdk-go-v2/service/sesv2 v1.29.1
 	github.com/aws/aws-sdk-go-v2/service/shield v1.25.5
 	github.com/aws/aws-sdk-go-v2/service/signer v1.22.8
 	github.com/aws/aws-sdk-go-v2/service/sns v1.29.5
 	github.com/aws/aws-sdk-go-v2/service/sqs v1.32.0
-	github.com/aws/aws-sdk-go-v2/service/ssm v1.50.1
+	github.com/aws/aws-sdk-go-v2/service/ssm v1.59.3
 	github.com/aws/aws-sdk-go-v2/service/ssmcontacts v1.22.5
 	github.com/aws/aws-sdk-go-v2/service/ssmincidents v1.30.5
 	github.com/aws/aws-sdk-go-v2/service/ssmsap v1.13.0
@@ -165,10 +175,12 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/ssoadmin v1.25.6
 	github.com/aws/aws-sdk-go-v2/service/sts v1.28.7
 	github.com/aws/aws-sdk-go-v2/service/swf v1.22.5
-	github.com/aws/aws-sdk-go-v2/service/synthetics v1.24.5
+	github.com/aws/aws-sdk-go-v2/service/synthetics v1.34.0
+	github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb v1.10.3
 	github.com/aws/aws-sdk-go-v2/service/timestreamwrite v1.25.6
 	github.com/aws/aws-sdk-go-v2/service/transcribe v1.37.1
 	github.com/aws/aws-sdk-go-v2/service/transfer v1.47.3
+	github.com/aws/aws-sdk-go-v2/service/translate v1.24.0
 	github.com/aws/aws-sdk-go-v2/service/verifiedpermissions v1.14.0
 	github.com/aws/aws-sdk-go-v2/service/vpclattice v1.7.6
 	github.com/aws/aws-sdk-go-v2/service/waf v1.20.5
@@ -178,7 +190,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.39.1
 	github.com/aws/aws-sdk-go-v2/service/workspacesweb v1.18.1
 	github.com/aws/aws-sdk-go-v2/service/xray v1.25.5
-	github.com/aws/smithy-go v1.20.2
+	github.com/aws/smithy-go v1.22.4
 	github.com/beevik/etree v1.3.0
 	github.com/cedar-policy/cedar-go v0.0.0-20240318205125-470d1fe984bb
 	github.com/davecgh/go-spew v1.1.1
@@ -229,9 +241,9 @@ require (
 	github.com/agext/levenshtein v1.2.3 // indirect
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
-	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.2 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.5 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.5 // indirect
+	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.11 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.36 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.36 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.0 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.11.2 // indirect
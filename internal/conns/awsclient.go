@@ -36,19 +36,20 @@ type AWSClient struct {
 	Region            string
 	ServicePackages   map[string]ServicePackage
 
-	awsConfig                 *aws_sdkv2.Config
-	clients                   map[string]any
-	conns                     map[string]any
-	dnsSuffix                 string
-	endpoints                 map[string]string // From provider configuration.
-	httpClient                *http.Client
-	lock                      sync.Mutex
-	logger                    baselogging.Logger
-	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3_sdkv2.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	awsConfig                        *aws_sdkv2.Config
+	clients                          map[string]any
+	conns                            map[string]any
+	dnsSuffix                        string
+	endpoints                        map[string]string // From provider configuration.
+	httpClient                       *http.Client
+	lock                             sync.Mutex
+	logger                           baselogging.Logger
+	organizationsAllowedEmailDomains []string // From provider configuration.
+	session                          *session_sdkv1.Session
+	s3ExpressClient                  *s3_sdkv2.Client
+	s3UsePathStyle                   bool   // From provider configuration.
+	s3USEast1RegionalEndpoint        string // From provider configuration.
+	stsRegion                        string // From provider configuration.
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -144,6 +145,12 @@ func (c *AWSClient) S3UsePathStyle(context.Context) bool {
 	return c.s3UsePathStyle
 }
 
+// OrganizationsAllowedEmailDomains returns the email domains allowed for Organizations member account root email addresses.
+// An empty result means that any domain is allowed.
+func (c *AWSClient) OrganizationsAllowedEmailDomains(context.Context) []string {
+	return c.organizationsAllowedEmailDomains
+}
+
 // SetHTTPClient sets the http.Client used for AWS API calls.
 // To have effect it must be called before the AWS SDK v1 Session is created.
 func (c *AWSClient) SetHTTPClient(_ context.Context, httpClient *http.Client) {
@@ -27,41 +27,42 @@ import (
 )
 
 type Config struct {
-	AccessKey                      string
-	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
-	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
-	CustomCABundle                 string
-	DefaultTagsConfig              *tftags.DefaultConfig
-	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
-	EC2MetadataServiceEndpoint     string
-	EC2MetadataServiceEndpointMode string
-	Endpoints                      map[string]string
-	ForbiddenAccountIds            []string
-	HTTPProxy                      *string
-	HTTPSProxy                     *string
-	IgnoreTagsConfig               *tftags.IgnoreConfig
-	Insecure                       bool
-	MaxRetries                     int
-	NoProxy                        string
-	Profile                        string
-	Region                         string
-	RetryMode                      aws_sdkv2.RetryMode
-	S3UsePathStyle                 bool
-	S3USEast1RegionalEndpoint      string
-	SecretKey                      string
-	SharedConfigFiles              []string
-	SharedCredentialsFiles         []string
-	SkipCredsValidation            bool
-	SkipRegionValidation           bool
-	SkipRequestingAccountId        bool
-	STSRegion                      string
-	SuppressDebugLog               bool
-	TerraformVersion               string
-	Token                          string
-	TokenBucketRateLimiterCapacity int
-	UseDualStackEndpoint           bool
-	UseFIPSEndpoint                bool
+	AccessKey                        string
+	AllowedAccountIds                []string
+	AssumeRole                       *awsbase.AssumeRole
+	AssumeRoleWithWebIdentity        *awsbase.AssumeRoleWithWebIdentity
+	CustomCABundle                   string
+	DefaultTagsConfig                *tftags.DefaultConfig
+	EC2MetadataServiceEnableState    imds_sdkv2.ClientEnableState
+	EC2MetadataServiceEndpoint       string
+	EC2MetadataServiceEndpointMode   string
+	Endpoints                        map[string]string
+	ForbiddenAccountIds              []string
+	HTTPProxy                        *string
+	HTTPSProxy                       *string
+	IgnoreTagsConfig                 *tftags.IgnoreConfig
+	Insecure                         bool
+	MaxRetries                       int
+	NoProxy                          string
+	OrganizationsAllowedEmailDomains []string
+	Profile                          string
+	Region                           string
+	RetryMode                        aws_sdkv2.RetryMode
+	S3UsePathStyle                   bool
+	S3USEast1RegionalEndpoint        string
+	SecretKey                        string
+	SharedConfigFiles                []string
+	SharedCredentialsFiles           []string
+	SkipCredsValidation              bool
+	SkipRegionValidation             bool
+	SkipRequestingAccountId          bool
+	STSRegion                        string
+	SuppressDebugLog                 bool
+	TerraformVersion                 string
+	Token                            string
+	TokenBucketRateLimiterCapacity   int
+	UseDualStackEndpoint             bool
+	UseFIPSEndpoint                  bool
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
@@ -224,6 +225,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.organizationsAllowedEmailDomains = c.OrganizationsAllowedEmailDomains
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
@@ -73,7 +73,8 @@ func main() {
 				td.ImportAWS_V1 = true
 			}
 			switch packageName {
-			case "imagebuilder",
+			case "entityresolution",
+				"imagebuilder",
 				"globalaccelerator",
 				"route53recoveryreadiness",
 				"worklink":
@@ -92,6 +92,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"organizations_allowed_email_domains": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of email domains allowed for the root email address of member accounts managed by the aws_organizations_account resource.",
+			},
 			"profile": schema.StringAttribute{
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
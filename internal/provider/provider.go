@@ -146,6 +146,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. " +
 					"Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"organizations_allowed_email_domains": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Description: "List of email domains allowed for the root email address of member accounts " +
+					"managed by the aws_organizations_account resource.",
+			},
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -563,6 +570,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.NoProxy = v
 	}
 
+	if v, ok := d.GetOk("organizations_allowed_email_domains"); ok && v.(*schema.Set).Len() > 0 {
+		config.OrganizationsAllowedEmailDomains = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -23,6 +23,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	primaryContactDefaultResourceID = "default"
+)
+
 // @SDKResource("aws_account_primary_contact")
 func resourcePrimaryContact() *schema.Resource {
 	return &schema.Resource{
@@ -32,7 +36,14 @@ func resourcePrimaryContact() *schema.Resource {
 		DeleteWithoutTimeout: schema.NoopContext,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// The caller's own account is managed without an account ID.
+				if d.Id() == meta.(*conns.AWSClient).AccountID {
+					d.SetId(primaryContactDefaultResourceID)
+				}
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -101,7 +112,7 @@ func resourcePrimaryContactPut(ctx context.Context, d *schema.ResourceData, meta
 
 	conn := meta.(*conns.AWSClient).AccountClient(ctx)
 
-	id := "default"
+	id := primaryContactDefaultResourceID
 	input := &account.PutContactInformationInput{
 		ContactInformation: &types.ContactInformation{
 			AddressLine1: aws.String(d.Get("address_line_1").(string)),
@@ -160,7 +171,14 @@ func resourcePrimaryContactRead(ctx context.Context, d *schema.ResourceData, met
 
 	conn := meta.(*conns.AWSClient).AccountClient(ctx)
 
-	contactInformation, err := findContactInformation(ctx, conn, d.Get(names.AttrAccountID).(string))
+	// The resource ID is the account ID when managing a member account, allowing import.
+	// The caller's own account is managed without an account ID, as the API rejects it.
+	accountID := d.Get(names.AttrAccountID).(string)
+	if accountID == "" && d.Id() != primaryContactDefaultResourceID && d.Id() != meta.(*conns.AWSClient).AccountID {
+		accountID = d.Id()
+	}
+
+	contactInformation, err := findContactInformation(ctx, conn, accountID)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Account Primary Contact (%s) not found, removing from state", d.Id())
@@ -172,7 +190,7 @@ func resourcePrimaryContactRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "reading Account Primary Contact (%s): %s", d.Id(), err)
 	}
 
-	d.Set(names.AttrAccountID, d.Get(names.AttrAccountID))
+	d.Set(names.AttrAccountID, accountID)
 	d.Set("address_line_1", contactInformation.AddressLine1)
 	d.Set("address_line_2", contactInformation.AddressLine2)
 	d.Set("address_line_3", contactInformation.AddressLine3)
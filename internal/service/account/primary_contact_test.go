@@ -51,6 +51,12 @@ func testAccPrimaryContact_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     acctest.AccountID(),
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccPrimaryConfig_basic(rName2),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -232,6 +232,19 @@ func resourceCertificateAuthority() *schema.Resource {
 							DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"crl_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ValidateDiagFunc: enum.Validate[types.CrlType](),
+										DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+											// Ignore attributes if CRL configuration is not enabled
+											if d.Get("revocation_configuration.0.crl_configuration.0.enabled").(bool) {
+												return old == new
+											}
+											return true
+										},
+									},
 									"custom_cname": {
 										Type:         schema.TypeString,
 										Optional:     true,
@@ -244,6 +257,18 @@ func resourceCertificateAuthority() *schema.Resource {
 											return true
 										},
 									},
+									"custom_path": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 253),
+										DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+											// Ignore attributes if CRL configuration is not enabled
+											if d.Get("revocation_configuration.0.crl_configuration.0.enabled").(bool) {
+												return old == new
+											}
+											return true
+										},
+									},
 									names.AttrEnabled: {
 										Type:     schema.TypeBool,
 										Optional: true,
@@ -470,6 +495,21 @@ func resourceCertificateAuthorityUpdate(ctx context.Context, d *schema.ResourceD
 
 		if d.HasChange("revocation_configuration") {
 			input.RevocationConfiguration = expandRevocationConfiguration(d.Get("revocation_configuration").([]interface{}))
+
+			// Omitted configurations are disabled instead of being left unchanged.
+			if input.RevocationConfiguration == nil {
+				input.RevocationConfiguration = &types.RevocationConfiguration{}
+			}
+			if input.RevocationConfiguration.CrlConfiguration == nil {
+				input.RevocationConfiguration.CrlConfiguration = &types.CrlConfiguration{
+					Enabled: aws.Bool(false),
+				}
+			}
+			if input.RevocationConfiguration.OcspConfiguration == nil {
+				input.RevocationConfiguration.OcspConfiguration = &types.OcspConfiguration{
+					Enabled: aws.Bool(false),
+				}
+			}
 		}
 
 		_, err := conn.UpdateCertificateAuthority(ctx, input)
@@ -695,9 +735,15 @@ func expandCrlConfiguration(l []interface{}) *types.CrlConfiguration {
 	}
 
 	if crlEnabled {
+		if v, ok := m["crl_type"]; ok && v.(string) != "" {
+			config.CrlType = types.CrlType(v.(string))
+		}
 		if v, ok := m["custom_cname"]; ok && v.(string) != "" {
 			config.CustomCname = aws.String(v.(string))
 		}
+		if v, ok := m["custom_path"]; ok && v.(string) != "" {
+			config.CustomPath = aws.String(v.(string))
+		}
 		if v, ok := m["expiration_in_days"]; ok && v.(int) > 0 {
 			config.ExpirationInDays = aws.Int32(int32(v.(int)))
 		}
@@ -789,7 +835,9 @@ func flattenCrlConfiguration(config *types.CrlConfiguration) []interface{} {
 	}
 
 	m := map[string]interface{}{
+		"crl_type":             string(config.CrlType),
 		"custom_cname":         aws.ToString(config.CustomCname),
+		"custom_path":          aws.ToString(config.CustomPath),
 		names.AttrEnabled:      aws.ToBool(config.Enabled),
 		"expiration_in_days":   int(aws.ToInt32(config.ExpirationInDays)),
 		names.AttrS3BucketName: aws.ToString(config.S3BucketName),
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acmpca
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_acmpca_certificate_authority_chain", name="Certificate Authority Chain")
+func dataSourceCertificateAuthorityChain() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCertificateAuthorityChainRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"certificate_bundle": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceCertificateAuthorityChainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ACMPCAClient(ctx)
+
+	arn := d.Get(names.AttrARN).(string)
+	output, err := findCertificateAuthorityCertificateByARN(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ACM PCA Certificate Authority (%s) Certificate: %s", arn, err)
+	}
+
+	certificates, err := orderCertificateChain(aws.ToString(output.Certificate), aws.ToString(output.CertificateChain))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ACM PCA Certificate Authority (%s) Certificate: %s", arn, err)
+	}
+
+	d.SetId(arn)
+	d.Set("certificate_bundle", strings.Join(certificates, ""))
+	d.Set("certificates", certificates)
+
+	return diags
+}
+
+// orderCertificateChain returns the PEM encoded certificate followed by the certificates of
+// the chain in issuing order, i.e. each certificate is followed by the certificate of its issuer
+// and the root certificate is last. Certificates of the chain that are not part of the path
+// are appended in the order in which they appear in the chain.
+func orderCertificateChain(certificate, chain string) ([]string, error) {
+	leaf, err := parseCertificatesPEM(certificate)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(leaf) != 1 {
+		return nil, errors.New("expected exactly one certificate authority certificate")
+	}
+
+	remaining, err := parseCertificatesPEM(chain)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ordered := []*x509.Certificate{leaf[0]}
+
+	for current := leaf[0]; !bytes.Equal(current.RawIssuer, current.RawSubject); {
+		i := -1
+		for j, v := range remaining {
+			if bytes.Equal(v.RawSubject, current.RawIssuer) {
+				i = j
+				break
+			}
+		}
+
+		if i < 0 {
+			break
+		}
+
+		current = remaining[i]
+		ordered = append(ordered, current)
+		remaining = append(remaining[:i], remaining[i+1:]...)
+	}
+
+	ordered = append(ordered, remaining...)
+
+	var output []string
+
+	for _, v := range ordered {
+		output = append(output, string(pem.EncodeToMemory(&pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: v.Raw,
+		})))
+	}
+
+	return output, nil
+}
+
+func parseCertificatesPEM(s string) ([]*x509.Certificate, error) {
+	var output []*x509.Certificate
+
+	rest := []byte(s)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		certificate, err := x509.ParseCertificate(block.Bytes)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, certificate)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acmpca_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfacmpca "github.com/hashicorp/terraform-provider-aws/internal/service/acmpca"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestOrderCertificateChain(t *testing.T) {
+	t.Parallel()
+
+	rootKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	root := acctest.TLSRSAX509SelfSignedCACertificatePEM(t, rootKey)
+	intermediateKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	intermediate := acctest.TLSRSAX509LocallySignedCertificatePEM(t, rootKey, root, intermediateKey, "intermediate.example.com")
+	issuingKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	issuing := acctest.TLSRSAX509LocallySignedCertificatePEM(t, intermediateKey, intermediate, issuingKey, "issuing.example.com")
+
+	testCases := map[string]struct {
+		certificate string
+		chain       string
+		expected    []string
+	}{
+		"root": {
+			certificate: root,
+			expected:    []string{root},
+		},
+		"issuing order": {
+			certificate: issuing,
+			chain:       intermediate + root,
+			expected:    []string{issuing, intermediate, root},
+		},
+		"reverse order": {
+			certificate: issuing,
+			chain:       root + intermediate,
+			expected:    []string{issuing, intermediate, root},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfacmpca.OrderCertificateChain(testCase.certificate, testCase.chain)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != len(testCase.expected) {
+				t.Fatalf("expected %d certificates, got %d", len(testCase.expected), len(got))
+			}
+
+			for i, v := range testCase.expected {
+				if strings.TrimSpace(got[i]) != strings.TrimSpace(v) {
+					t.Errorf("certificate %d: expected %s, got %s", i, v, got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestAccACMPCACertificateAuthorityChainDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_acmpca_certificate_authority_chain.test"
+	resourceName := "aws_acmpca_certificate_authority.test"
+
+	commonName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ACMPCAServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateAuthorityChainDataSourceConfig_basic(commonName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(dataSourceName, "certificate_bundle"),
+					resource.TestCheckResourceAttr(dataSourceName, "certificates.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "certificates.0", resourceName, names.AttrCertificate),
+				),
+			},
+		},
+	})
+}
+
+func testAccCertificateAuthorityChainDataSourceConfig_basic(commonName string) string {
+	return acctest.ConfigCompose(testAccCertificateAuthorityCertificateConfig_subordinateCA(commonName), `
+data "aws_acmpca_certificate_authority_chain" "test" {
+  arn = aws_acmpca_certificate_authority_certificate.test.certificate_authority_arn
+}
+`)
+}
@@ -67,10 +67,18 @@ func dataSourceCertificateAuthority() *schema.Resource {
 							Computed: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"crl_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
 									"custom_cname": {
 										Type:     schema.TypeString,
 										Computed: true,
 									},
+									"custom_path": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
 									names.AttrEnabled: {
 										Type:     schema.TypeBool,
 										Computed: true,
@@ -445,6 +445,41 @@ func TestAccACMPCACertificateAuthority_RevocationCrl_enabled(t *testing.T) {
 	})
 }
 
+func TestAccACMPCACertificateAuthority_RevocationCrl_toOcsp(t *testing.T) {
+	ctx := acctest.Context(t)
+	var certificateAuthority types.CertificateAuthority
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_acmpca_certificate_authority.test"
+	commonName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ACMPCAServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateAuthorityDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateAuthorityConfig_revocationConfigurationCrlConfigurationEnabled(rName, commonName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateAuthorityExists(ctx, resourceName, &certificateAuthority),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.0.crl_configuration.0.enabled", "true"),
+				),
+			},
+			{
+				Config: testAccCertificateAuthorityConfig_revocationConfigurationOcspConfigurationEnabled(commonName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateAuthorityExists(ctx, resourceName, &certificateAuthority),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.0.crl_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.0.crl_configuration.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.0.ocsp_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.0.ocsp_configuration.0.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccACMPCACertificateAuthority_RevocationCrl_expirationInDays(t *testing.T) {
 	ctx := acctest.Context(t)
 	var certificateAuthority types.CertificateAuthority
@@ -559,6 +594,54 @@ func TestAccACMPCACertificateAuthority_RevocationCrl_s3ObjectACL(t *testing.T) {
 	})
 }
 
+func TestAccACMPCACertificateAuthority_RevocationCrl_crlType(t *testing.T) {
+	ctx := acctest.Context(t)
+	var certificateAuthority types.CertificateAuthority
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_acmpca_certificate_authority.test"
+	commonName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ACMPCAServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateAuthorityDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateAuthorityConfig_revocationConfigurationCrlConfigurationCrlType(rName, commonName, "COMPLETE", "crl"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateAuthorityExists(ctx, resourceName, &certificateAuthority),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.0.crl_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.0.crl_configuration.0.crl_type", "COMPLETE"),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.0.crl_configuration.0.custom_path", "crl"),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.0.crl_configuration.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"permanent_deletion_time_in_days",
+				},
+			},
+			// Test switching to partitioned CRLs in place
+			{
+				Config: testAccCertificateAuthorityConfig_revocationConfigurationCrlConfigurationCrlType(rName, commonName, "PARTITIONED", "partitioned-crls"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateAuthorityExists(ctx, resourceName, &certificateAuthority),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.0.crl_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.0.crl_configuration.0.crl_type", "PARTITIONED"),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.0.crl_configuration.0.custom_path", "partitioned-crls"),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.0.crl_configuration.0.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccACMPCACertificateAuthority_RevocationOcsp_enabled(t *testing.T) {
 	ctx := acctest.Context(t)
 	var certificateAuthority types.CertificateAuthority
@@ -852,6 +935,41 @@ resource "aws_acmpca_certificate_authority" "test" {
 `, commonName)
 }
 
+func testAccCertificateAuthorityConfig_revocationConfigurationCrlConfigurationCrlType(rName, commonName, crlType, customPath string) string {
+	return acctest.ConfigCompose(
+		testAccCertificateAuthorityConfig_S3Bucket(rName),
+		fmt.Sprintf(`
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = %[1]q
+    }
+  }
+
+  revocation_configuration {
+    crl_configuration {
+      crl_type           = %[2]q
+      custom_path        = %[3]q
+      enabled            = true
+      expiration_in_days = 1
+      s3_bucket_name     = aws_s3_bucket.test.id
+    }
+  }
+
+  depends_on = [
+    aws_s3_bucket_policy.test,
+    aws_s3_bucket_public_access_block.test,
+    aws_s3_bucket_ownership_controls.test,
+  ]
+}
+`, commonName, crlType, customPath))
+}
+
 func testAccCertificateAuthorityConfig_revocationConfigurationCrlConfigurationCustomCNAME(rName, commonName, customCname string) string {
 	return acctest.ConfigCompose(
 		testAccCertificateAuthorityConfig_S3Bucket(rName),
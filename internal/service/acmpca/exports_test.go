@@ -15,5 +15,6 @@ var (
 	FindCertificateByTwoPartKey              = findCertificateByTwoPartKey
 	FindPermissionByThreePartKey             = findPermissionByThreePartKey
 	FindPolicyByARN                          = findPolicyByARN
+	OrderCertificateChain                    = orderCertificateChain
 	ValidTemplateARN                         = validTemplateARN
 )
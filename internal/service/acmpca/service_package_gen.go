@@ -37,6 +37,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  dataSourceCertificateAuthorityChain,
+			TypeName: "aws_acmpca_certificate_authority_chain",
+			Name:     "Certificate Authority Chain",
+		},
 	}
 }
 
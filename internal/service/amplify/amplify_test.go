@@ -23,6 +23,8 @@ func TestAccAmplify_serial(t *testing.T) {
 			"AutoBranchCreationConfig": testAccApp_AutoBranchCreationConfig,
 			"BasicAuthCredentials":     testAccApp_BasicAuthCredentials,
 			"BuildSpec":                testAccApp_BuildSpec,
+			"CacheConfig":              testAccApp_CacheConfig,
+			"ComputeRole":              testAccApp_ComputeRole,
 			"CustomRules":              testAccApp_CustomRules,
 			"Description":              testAccApp_Description,
 			"EnvironmentVariables":     testAccApp_EnvironmentVariables,
@@ -40,8 +42,10 @@ func TestAccAmplify_serial(t *testing.T) {
 			"disappears":           testAccBranch_disappears,
 			names.AttrTags:         testAccBranch_tags,
 			"BasicAuthCredentials": testAccBranch_BasicAuthCredentials,
+			"ComputeRole":          testAccBranch_ComputeRole,
 			"EnvironmentVariables": testAccBranch_EnvironmentVariables,
 			"OptionalArguments":    testAccBranch_OptionalArguments,
+			"SkewProtection":       testAccBranch_SkewProtection,
 		},
 		"DomainAssociation": {
 			"basic":      testAccDomainAssociation_basic,
@@ -170,6 +170,26 @@ func resourceApp() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(1, 25000),
 			},
+			"cache_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.CacheConfigType](),
+						},
+					},
+				},
+			},
+			"compute_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"custom_headers": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -327,6 +347,14 @@ func resourceAppCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		input.BuildSpec = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("cache_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CacheConfig = expandCacheConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("compute_role_arn"); ok {
+		input.ComputeRoleArn = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("custom_headers"); ok {
 		input.CustomHeaders = aws.String(v.(string))
 	}
@@ -413,6 +441,14 @@ func resourceAppRead(ctx context.Context, d *schema.ResourceData, meta interface
 	d.Set("auto_branch_creation_patterns", aws.StringSlice(app.AutoBranchCreationPatterns))
 	d.Set("basic_auth_credentials", app.BasicAuthCredentials)
 	d.Set("build_spec", app.BuildSpec)
+	if app.CacheConfig != nil {
+		if err := d.Set("cache_config", []interface{}{flattenCacheConfig(app.CacheConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting cache_config: %s", err)
+		}
+	} else {
+		d.Set("cache_config", nil)
+	}
+	d.Set("compute_role_arn", app.ComputeRoleArn)
 	d.Set("custom_headers", app.CustomHeaders)
 	if err := d.Set("custom_rule", flattenCustomRules(app.CustomRules)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting custom_rule: %s", err)
@@ -476,6 +512,16 @@ func resourceAppUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 			input.BuildSpec = aws.String(d.Get("build_spec").(string))
 		}
 
+		if d.HasChange("cache_config") {
+			if v, ok := d.GetOk("cache_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.CacheConfig = expandCacheConfig(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("compute_role_arn") {
+			input.ComputeRoleArn = aws.String(d.Get("compute_role_arn").(string))
+		}
+
 		if d.HasChange("custom_headers") {
 			input.CustomHeaders = aws.String(d.Get("custom_headers").(string))
 		}
@@ -689,6 +735,32 @@ func flattenAutoBranchCreationConfig(apiObject *types.AutoBranchCreationConfig)
 	return tfMap
 }
 
+func expandCacheConfig(tfMap map[string]interface{}) *types.CacheConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.CacheConfig{}
+
+	if v, ok := tfMap[names.AttrType].(string); ok && v != "" {
+		apiObject.Type = types.CacheConfigType(v)
+	}
+
+	return apiObject
+}
+
+func flattenCacheConfig(apiObject *types.CacheConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrType: apiObject.Type,
+	}
+
+	return tfMap
+}
+
 func expandCustomRule(tfMap map[string]interface{}) *types.CustomRule {
 	if tfMap == nil {
 		return nil
@@ -330,6 +330,89 @@ func testAccApp_BuildSpec(t *testing.T) {
 	})
 }
 
+func testAccApp_CacheConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var app types.App
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_amplify_app.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AmplifyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_cacheConfig(rName, "AMPLIFY_MANAGED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(ctx, resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "cache_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "cache_config.0.type", "AMPLIFY_MANAGED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppConfig_cacheConfig(rName, "AMPLIFY_MANAGED_NO_COOKIES"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(ctx, resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "cache_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "cache_config.0.type", "AMPLIFY_MANAGED_NO_COOKIES"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApp_ComputeRole(t *testing.T) {
+	ctx := acctest.Context(t)
+	var app1, app2, app3 types.App
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_amplify_app.test"
+	iamRole1ResourceName := "aws_iam_role.test1"
+	iamRole2ResourceName := "aws_iam_role.test2"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AmplifyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_computeRoleARN(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(ctx, resourceName, &app1),
+					resource.TestCheckResourceAttrPair(resourceName, "compute_role_arn", iamRole1ResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppConfig_computeRoleARN(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(ctx, resourceName, &app2),
+					testAccCheckAppNotRecreated(&app1, &app2),
+					resource.TestCheckResourceAttrPair(resourceName, "compute_role_arn", iamRole2ResourceName, names.AttrARN),
+				),
+			},
+			{
+				Config: testAccAppConfig_computeRoleARNRemoved(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(ctx, resourceName, &app3),
+					testAccCheckAppNotRecreated(&app2, &app3),
+					resource.TestCheckResourceAttr(resourceName, "compute_role_arn", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccApp_CustomRules(t *testing.T) {
 	ctx := acctest.Context(t)
 	var app types.App
@@ -798,6 +881,38 @@ resource "aws_amplify_app" "test" {
 `, rName, buildSpec)
 }
 
+func testAccAppConfig_cacheConfig(rName, cacheConfigType string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+
+  cache_config {
+    type = %[2]q
+  }
+}
+`, rName, cacheConfigType)
+}
+
+func testAccAppConfig_computeRoleARN(rName, roleName string) string {
+	return acctest.ConfigCompose(testAccAppIAMServiceRoleBaseConfig(rName), fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name     = %[1]q
+  platform = "WEB_COMPUTE"
+
+  compute_role_arn = aws_iam_role.%[2]s.arn
+}
+`, rName, roleName))
+}
+
+func testAccAppConfig_computeRoleARNRemoved(rName string) string {
+	return acctest.ConfigCompose(testAccAppIAMServiceRoleBaseConfig(rName), fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name     = %[1]q
+  platform = "WEB_COMPUTE"
+}
+`, rName))
+}
+
 func testAccAppConfig_customRules(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_amplify_app" "test" {
@@ -83,6 +83,11 @@ func resourceBranch() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z/_.-]{1,255}$`), "should be not be more than 255 letters, numbers, and the symbols /_.-"),
 			},
+			"compute_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"custom_domains": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -124,6 +129,10 @@ func resourceBranch() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"enable_skew_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"environment_variables": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -196,6 +205,10 @@ func resourceBranchCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		input.BasicAuthCredentials = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("compute_role_arn"); ok {
+		input.ComputeRoleArn = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk(names.AttrDescription); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -220,6 +233,10 @@ func resourceBranchCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		input.EnablePullRequestPreview = aws.Bool(v.(bool))
 	}
 
+	if v, ok := d.GetOk("enable_skew_protection"); ok {
+		input.EnableSkewProtection = aws.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk("environment_variables"); ok && len(v.(map[string]interface{})) > 0 {
 		input.EnvironmentVariables = flex.ExpandStringValueMap(v.(map[string]interface{}))
 	}
@@ -278,6 +295,7 @@ func resourceBranchRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("backend_environment_arn", branch.BackendEnvironmentArn)
 	d.Set("basic_auth_credentials", branch.BasicAuthCredentials)
 	d.Set("branch_name", branch.BranchName)
+	d.Set("compute_role_arn", branch.ComputeRoleArn)
 	d.Set("custom_domains", branch.CustomDomains)
 	d.Set(names.AttrDescription, branch.Description)
 	d.Set("destination_branch", branch.DestinationBranch)
@@ -287,6 +305,7 @@ func resourceBranchRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("enable_notification", branch.EnableNotification)
 	d.Set("enable_performance_mode", branch.EnablePerformanceMode)
 	d.Set("enable_pull_request_preview", branch.EnablePullRequestPreview)
+	d.Set("enable_skew_protection", branch.EnableSkewProtection)
 	d.Set("environment_variables", branch.EnvironmentVariables)
 	d.Set("framework", branch.Framework)
 	d.Set("pull_request_environment_name", branch.PullRequestEnvironmentName)
@@ -322,6 +341,10 @@ func resourceBranchUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.BasicAuthCredentials = aws.String(d.Get("basic_auth_credentials").(string))
 		}
 
+		if d.HasChange("compute_role_arn") {
+			input.ComputeRoleArn = aws.String(d.Get("compute_role_arn").(string))
+		}
+
 		if d.HasChange(names.AttrDescription) {
 			input.Description = aws.String(d.Get(names.AttrDescription).(string))
 		}
@@ -350,6 +373,10 @@ func resourceBranchUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.EnablePullRequestPreview = aws.Bool(d.Get("enable_pull_request_preview").(bool))
 		}
 
+		if d.HasChange("enable_skew_protection") {
+			input.EnableSkewProtection = aws.Bool(d.Get("enable_skew_protection").(bool))
+		}
+
 		if d.HasChange("environment_variables") {
 			if v := d.Get("environment_variables").(map[string]interface{}); len(v) > 0 {
 				input.EnvironmentVariables = flex.ExpandStringValueMap(v)
@@ -188,6 +188,41 @@ func testAccBranch_BasicAuthCredentials(t *testing.T) {
 	})
 }
 
+func testAccBranch_ComputeRole(t *testing.T) {
+	ctx := acctest.Context(t)
+	var branch types.Branch
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_amplify_branch.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AmplifyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBranchDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBranchConfig_computeRoleARN(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBranchExists(ctx, resourceName, &branch),
+					resource.TestCheckResourceAttrPair(resourceName, "compute_role_arn", "aws_iam_role.test1", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBranchConfig_computeRoleARN(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBranchExists(ctx, resourceName, &branch),
+					resource.TestCheckResourceAttrPair(resourceName, "compute_role_arn", "aws_iam_role.test2", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func testAccBranch_EnvironmentVariables(t *testing.T) {
 	ctx := acctest.Context(t)
 	var branch types.Branch
@@ -291,6 +326,41 @@ func testAccBranch_OptionalArguments(t *testing.T) {
 	})
 }
 
+func testAccBranch_SkewProtection(t *testing.T) {
+	ctx := acctest.Context(t)
+	var branch types.Branch
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_amplify_branch.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AmplifyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBranchDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBranchConfig_skewProtection(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBranchExists(ctx, resourceName, &branch),
+					resource.TestCheckResourceAttr(resourceName, "enable_skew_protection", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBranchConfig_skewProtection(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBranchExists(ctx, resourceName, &branch),
+					resource.TestCheckResourceAttr(resourceName, "enable_skew_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBranchExists(ctx context.Context, resourceName string, v *types.Branch) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -402,6 +472,43 @@ resource "aws_amplify_branch" "test" {
 `, rName, basicAuthCredentials)
 }
 
+func testAccBranchConfig_computeRoleARN(rName, roleName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test1" {
+  name = "%[1]s-1"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "amplify.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role" "test2" {
+  name = "%[1]s-2"
+
+  assume_role_policy = aws_iam_role.test1.assume_role_policy
+}
+
+resource "aws_amplify_app" "test" {
+  name     = %[1]q
+  platform = "WEB_COMPUTE"
+}
+
+resource "aws_amplify_branch" "test" {
+  app_id      = aws_amplify_app.test.id
+  branch_name = %[1]q
+
+  compute_role_arn = aws_iam_role.%[2]s.arn
+}
+`, rName, roleName)
+}
+
 func testAccBranchConfig_environmentVariables(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_amplify_app" "test" {
@@ -506,3 +613,18 @@ resource "aws_amplify_branch" "test" {
 }
 `, rName, environmentName)
 }
+
+func testAccBranchConfig_skewProtection(rName string, enableSkewProtection bool) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_amplify_branch" "test" {
+  app_id      = aws_amplify_app.test.id
+  branch_name = %[1]q
+
+  enable_skew_protection = %[2]t
+}
+`, rName, enableSkewProtection)
+}
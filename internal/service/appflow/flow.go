@@ -5,6 +5,7 @@ package appflow
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"slices"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/appflow"
 	"github.com/aws/aws-sdk-go-v2/service/appflow/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -43,6 +45,11 @@ func resourceFlow() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"activate": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -706,6 +713,65 @@ func resourceFlow() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringMatch(regexache.MustCompile(`arn:.*:kms:.*:[0-9]+:.*`), "must be a valid ARN of a Key Management Services (KMS) key"),
 			},
+			"last_run_metadata_catalog_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"partition_registration_output": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     registrationOutputSchema(),
+						},
+						names.AttrTableName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"table_registration_output": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     registrationOutputSchema(),
+						},
+					},
+				},
+			},
+			"metadata_catalog_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"glue_data_catalog": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrDatabaseName: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									names.AttrRoleARN: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"table_prefix": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			names.AttrName: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -932,6 +998,34 @@ func resourceFlow() *schema.Resource {
 													Required:     true,
 													ValidateFunc: validation.All(validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"), validation.StringLenBetween(1, 512)),
 												},
+												"pagination_config": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"max_page_size": {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ValidateFunc: validation.IntBetween(1, 10000),
+															},
+														},
+													},
+												},
+												"parallelism_config": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"max_parallelism": {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ValidateFunc: validation.IntBetween(1, 10),
+															},
+														},
+													},
+												},
 											},
 										},
 									},
@@ -1249,7 +1343,29 @@ func resourceFlow() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			validateActivateCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func registrationOutputSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrMessage: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"result": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
 	}
 }
 
@@ -1276,6 +1392,10 @@ func resourceFlowCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		input.KmsArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("metadata_catalog_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.MetadataCatalogConfig = expandMetadataCatalogConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	output, err := conn.CreateFlow(ctx, input)
 
 	if err != nil {
@@ -1284,6 +1404,14 @@ func resourceFlowCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	d.SetId(aws.ToString(output.FlowArn))
 
+	if d.Get("activate").(bool) {
+		if _, err := conn.StartFlow(ctx, &appflow.StartFlowInput{
+			FlowName: aws.String(name),
+		}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "activating AppFlow Flow (%s): %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceFlowRead(ctx, d, meta)...)
 }
 
@@ -1315,8 +1443,19 @@ func resourceFlowRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	if err := d.Set("destination_flow_config", flattenDestinationFlowConfigs(output.DestinationFlowConfigList)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting destination_flow_config: %s", err)
 	}
+	d.Set("activate", output.FlowStatus == types.FlowStatusActive)
 	d.Set("flow_status", output.FlowStatus)
 	d.Set("kms_arn", output.KmsArn)
+	if err := d.Set("last_run_metadata_catalog_details", flattenMetadataCatalogDetails(output.LastRunMetadataCatalogDetails)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting last_run_metadata_catalog_details: %s", err)
+	}
+	if output.MetadataCatalogConfig != nil {
+		if err := d.Set("metadata_catalog_config", []interface{}{flattenMetadataCatalogConfig(output.MetadataCatalogConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting metadata_catalog_config: %s", err)
+		}
+	} else {
+		d.Set("metadata_catalog_config", nil)
+	}
 	d.Set(names.AttrName, output.FlowName)
 	if output.SourceFlowConfig != nil {
 		if err := d.Set("source_flow_config", []interface{}{flattenSourceFlowConfig(output.SourceFlowConfig)}); err != nil {
@@ -1346,7 +1485,7 @@ func resourceFlowUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	conn := meta.(*conns.AWSClient).AppFlowClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept("activate", names.AttrTags, names.AttrTagsAll) {
 		input := &appflow.UpdateFlowInput{
 			DestinationFlowConfigList: expandDestinationFlowConfigs(d.Get("destination_flow_config").([]interface{})),
 			FlowName:                  aws.String(d.Get(names.AttrName).(string)),
@@ -1360,6 +1499,10 @@ func resourceFlowUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			input.Description = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("metadata_catalog_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.MetadataCatalogConfig = expandMetadataCatalogConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		_, err := conn.UpdateFlow(ctx, input)
 
 		if err != nil {
@@ -1367,6 +1510,24 @@ func resourceFlowUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
+	if d.HasChange("activate") {
+		name := d.Get(names.AttrName).(string)
+
+		if d.Get("activate").(bool) {
+			if _, err := conn.StartFlow(ctx, &appflow.StartFlowInput{
+				FlowName: aws.String(name),
+			}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "activating AppFlow Flow (%s): %s", d.Id(), err)
+			}
+		} else {
+			if _, err := conn.StopFlow(ctx, &appflow.StopFlowInput{
+				FlowName: aws.String(name),
+			}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "deactivating AppFlow Flow (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
 	return append(diags, resourceFlowRead(ctx, d, meta)...)
 }
 
@@ -1395,6 +1556,17 @@ func resourceFlowDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	return diags
 }
 
+func validateActivateCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	// On-demand flows are run rather than activated, so StartFlow would run the flow on every change.
+	if v := d.GetRawConfig().GetAttr("activate"); v.IsKnown() && !v.IsNull() {
+		if v, ok := d.GetOk("trigger_config.0.trigger_type"); ok && types.TriggerType(v.(string)) == types.TriggerTypeOndemand {
+			return fmt.Errorf("activate cannot be set when trigger_type is %q", types.TriggerTypeOndemand)
+		}
+	}
+
+	return nil
+}
+
 func findFlowByARN(ctx context.Context, conn *appflow.Client, arn string) (*types.FlowDefinition, error) {
 	input := &appflow.ListFlowsInput{}
 
@@ -2309,6 +2481,54 @@ func expandSAPODataSourceProperties(tfMap map[string]interface{}) *types.SAPODat
 		a.ObjectPath = aws.String(v)
 	}
 
+	if v, ok := tfMap["pagination_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.PaginationConfig = &types.SAPODataPaginationConfig{
+			MaxPageSize: aws.Int32(int32(v[0].(map[string]interface{})["max_page_size"].(int))),
+		}
+	}
+
+	if v, ok := tfMap["parallelism_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.ParallelismConfig = &types.SAPODataParallelismConfig{
+			MaxParallelism: aws.Int32(int32(v[0].(map[string]interface{})["max_parallelism"].(int))),
+		}
+	}
+
+	return a
+}
+
+func expandMetadataCatalogConfig(tfMap map[string]interface{}) *types.MetadataCatalogConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	a := &types.MetadataCatalogConfig{}
+
+	if v, ok := tfMap["glue_data_catalog"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.GlueDataCatalog = expandGlueDataCatalogConfig(v[0].(map[string]interface{}))
+	}
+
+	return a
+}
+
+func expandGlueDataCatalogConfig(tfMap map[string]interface{}) *types.GlueDataCatalogConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	a := &types.GlueDataCatalogConfig{}
+
+	if v, ok := tfMap[names.AttrDatabaseName].(string); ok && v != "" {
+		a.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrRoleARN].(string); ok && v != "" {
+		a.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_prefix"].(string); ok && v != "" {
+		a.TablePrefix = aws.String(v)
+	}
+
 	return a
 }
 
@@ -3381,9 +3601,74 @@ func flattenSAPODataSourceProperties(sapoDataSourceProperties *types.SAPODataSou
 		m["object_path"] = aws.ToString(v)
 	}
 
+	if v := sapoDataSourceProperties.PaginationConfig; v != nil {
+		m["pagination_config"] = []interface{}{map[string]interface{}{
+			"max_page_size": aws.ToInt32(v.MaxPageSize),
+		}}
+	}
+
+	if v := sapoDataSourceProperties.ParallelismConfig; v != nil {
+		m["parallelism_config"] = []interface{}{map[string]interface{}{
+			"max_parallelism": aws.ToInt32(v.MaxParallelism),
+		}}
+	}
+
 	return m
 }
 
+func flattenMetadataCatalogConfig(metadataCatalogConfig *types.MetadataCatalogConfig) map[string]interface{} {
+	if metadataCatalogConfig == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if v := metadataCatalogConfig.GlueDataCatalog; v != nil {
+		m["glue_data_catalog"] = []interface{}{map[string]interface{}{
+			names.AttrDatabaseName: aws.ToString(v.DatabaseName),
+			names.AttrRoleARN:      aws.ToString(v.RoleArn),
+			"table_prefix":         aws.ToString(v.TablePrefix),
+		}}
+	}
+
+	return m
+}
+
+func flattenMetadataCatalogDetails(metadataCatalogDetails []types.MetadataCatalogDetail) []interface{} {
+	l := make([]interface{}, 0, len(metadataCatalogDetails))
+
+	for _, v := range metadataCatalogDetails {
+		m := map[string]interface{}{
+			"catalog_type":      string(v.CatalogType),
+			names.AttrTableName: aws.ToString(v.TableName),
+		}
+
+		if v := v.PartitionRegistrationOutput; v != nil {
+			m["partition_registration_output"] = []interface{}{flattenRegistrationOutput(v)}
+		}
+
+		if v := v.TableRegistrationOutput; v != nil {
+			m["table_registration_output"] = []interface{}{flattenRegistrationOutput(v)}
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func flattenRegistrationOutput(registrationOutput *types.RegistrationOutput) map[string]interface{} {
+	if registrationOutput == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		names.AttrMessage: aws.ToString(registrationOutput.Message),
+		"result":          aws.ToString(registrationOutput.Result),
+		names.AttrStatus:  string(registrationOutput.Status),
+	}
+}
+
 func flattenServiceNowSourceProperties(serviceNowSourceProperties *types.ServiceNowSourceProperties) map[string]interface{} {
 	if serviceNowSourceProperties == nil {
 		return nil
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// Application Signals discovers services in an account once its service-linked role exists.
+	// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/using-service-linked-roles.html#service-linked-role-signals.
+	discoveryRoleName = "AWSServiceRoleForCloudWatchApplicationSignals"
+)
+
+// @SDKResource("aws_applicationsignals_discovery", name="Discovery")
+func resourceDiscovery() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDiscoveryCreate,
+		ReadWithoutTimeout:   resourceDiscoveryRead,
+		DeleteWithoutTimeout: resourceDiscoveryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{},
+	}
+}
+
+func resourceDiscoveryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+	_, err := conn.StartDiscovery(ctx, &applicationsignals.StartDiscoveryInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Application Signals Discovery: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	return append(diags, resourceDiscoveryRead(ctx, d, meta)...)
+}
+
+func resourceDiscoveryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	_, err := tfiam.FindRoleByName(ctx, conn, discoveryRoleName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Application Signals Discovery (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Application Signals Discovery (%s): reading IAM Role (%s): %s", d.Id(), discoveryRoleName, err)
+	}
+
+	return diags
+}
+
+func resourceDiscoveryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// There is no API to stop discovery. Deleting the service-linked role stops Application Signals
+	// collecting telemetry in the account. The deletion fails while any SLO still exists.
+	if err := tfiam.DeleteServiceLinkedRole(ctx, meta.(*conns.AWSClient).IAMClient(ctx), discoveryRoleName); err != nil {
+		return sdkdiag.AppendWarningf(diags, "deleting IAM service-linked Role (%s): %s", discoveryRoleName, err)
+	}
+
+	return diags
+}
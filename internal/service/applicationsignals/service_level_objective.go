@@ -0,0 +1,793 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_applicationsignals_service_level_objective", name="Service Level Objective")
+// @Tags(identifierAttribute="arn")
+func resourceServiceLevelObjective() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServiceLevelObjectiveCreate,
+		ReadWithoutTimeout:   resourceServiceLevelObjectiveRead,
+		UpdateWithoutTimeout: resourceServiceLevelObjectiveUpdate,
+		DeleteWithoutTimeout: resourceServiceLevelObjectiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"burn_rate_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"look_back_window_minutes": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 10080),
+						},
+					},
+				},
+			},
+			names.AttrCreatedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"evaluation_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"goal": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attainment_goal": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.FloatBetween(0, 100),
+						},
+						names.AttrInterval: {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"calendar_interval": {
+										Type:         schema.TypeList,
+										Optional:     true,
+										MaxItems:     1,
+										ExactlyOneOf: []string{"goal.0.interval.0.calendar_interval", "goal.0.interval.0.rolling_interval"},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrDuration: {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"duration_unit": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ValidateDiagFunc: enum.Validate[awstypes.DurationUnit](),
+												},
+												names.AttrStartTime: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.IsRFC3339Time,
+												},
+											},
+										},
+									},
+									"rolling_interval": {
+										Type:         schema.TypeList,
+										Optional:     true,
+										MaxItems:     1,
+										ExactlyOneOf: []string{"goal.0.interval.0.calendar_interval", "goal.0.interval.0.rolling_interval"},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrDuration: {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"duration_unit": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ValidateDiagFunc: enum.Validate[awstypes.DurationUnit](),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"warning_threshold": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.FloatBetween(0, 100),
+						},
+					},
+				},
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z][0-9A-Za-z_-]*[0-9A-Za-z]$`), "must start and end with an alphanumeric character and contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"sli": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comparison_operator": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ServiceLevelIndicatorComparisonOperator](),
+						},
+						"metric_threshold": {
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"sli_metric": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key_attributes": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"metric_data_query": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrAccountID: {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidAccountID,
+												},
+												names.AttrExpression: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												names.AttrID: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"label": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"metric_stat": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"metric": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"dimension": {
+																			Type:     schema.TypeSet,
+																			Optional: true,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					names.AttrName: {
+																						Type:     schema.TypeString,
+																						Required: true,
+																					},
+																					names.AttrValue: {
+																						Type:     schema.TypeString,
+																						Required: true,
+																					},
+																				},
+																			},
+																		},
+																		names.AttrMetricName: {
+																			Type:     schema.TypeString,
+																			Optional: true,
+																		},
+																		names.AttrNamespace: {
+																			Type:     schema.TypeString,
+																			Optional: true,
+																		},
+																	},
+																},
+															},
+															"period": {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ValidateFunc: validation.IntAtLeast(1),
+															},
+															"stat": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															names.AttrUnit: {
+																Type:             schema.TypeString,
+																Optional:         true,
+																ValidateDiagFunc: enum.Validate[awstypes.StandardUnit](),
+															},
+														},
+													},
+												},
+												"period": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"return_data": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"metric_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.ServiceLevelIndicatorMetricType](),
+									},
+									"operation_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"period_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(60, 900),
+									},
+									"statistic": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceServiceLevelObjectiveCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &applicationsignals.CreateServiceLevelObjectiveInput{
+		BurnRateConfigurations: expandBurnRateConfigurations(d.Get("burn_rate_configuration").([]interface{})),
+		Name:                   aws.String(name),
+		SliConfig:              expandServiceLevelIndicatorConfig(d.Get("sli").([]interface{})),
+		Tags:                   getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("goal"); ok {
+		input.Goal = expandGoal(v.([]interface{}))
+	}
+
+	output, err := conn.CreateServiceLevelObjective(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Application Signals Service Level Objective (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Slo.Arn))
+
+	return append(diags, resourceServiceLevelObjectiveRead(ctx, d, meta)...)
+}
+
+func resourceServiceLevelObjectiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+	slo, err := findServiceLevelObjectiveByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Application Signals Service Level Objective (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Application Signals Service Level Objective (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, slo.Arn)
+	if err := d.Set("burn_rate_configuration", flattenBurnRateConfigurations(slo.BurnRateConfigurations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting burn_rate_configuration: %s", err)
+	}
+	d.Set(names.AttrCreatedTime, aws.ToTime(slo.CreatedTime).Format(time.RFC3339))
+	d.Set(names.AttrDescription, slo.Description)
+	d.Set("evaluation_type", slo.EvaluationType)
+	if err := d.Set("goal", flattenGoal(slo.Goal)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting goal: %s", err)
+	}
+	d.Set("last_updated_time", aws.ToTime(slo.LastUpdatedTime).Format(time.RFC3339))
+	d.Set(names.AttrName, slo.Name)
+	if err := d.Set("sli", flattenServiceLevelIndicator(slo.Sli)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting sli: %s", err)
+	}
+
+	return diags
+}
+
+func resourceServiceLevelObjectiveUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &applicationsignals.UpdateServiceLevelObjectiveInput{
+			Id: aws.String(d.Id()),
+		}
+
+		if d.HasChange("burn_rate_configuration") {
+			input.BurnRateConfigurations = expandBurnRateConfigurations(d.Get("burn_rate_configuration").([]interface{}))
+			if input.BurnRateConfigurations == nil {
+				input.BurnRateConfigurations = []awstypes.BurnRateConfiguration{}
+			}
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange("goal") {
+			input.Goal = expandGoal(d.Get("goal").([]interface{}))
+		}
+
+		if d.HasChange("sli") {
+			input.SliConfig = expandServiceLevelIndicatorConfig(d.Get("sli").([]interface{}))
+		}
+
+		_, err := conn.UpdateServiceLevelObjective(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Application Signals Service Level Objective (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceServiceLevelObjectiveRead(ctx, d, meta)...)
+}
+
+func resourceServiceLevelObjectiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Application Signals Service Level Objective: %s", d.Id())
+	_, err := conn.DeleteServiceLevelObjective(ctx, &applicationsignals.DeleteServiceLevelObjectiveInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Application Signals Service Level Objective (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findServiceLevelObjectiveByID(ctx context.Context, conn *applicationsignals.Client, id string) (*awstypes.ServiceLevelObjective, error) {
+	input := &applicationsignals.GetServiceLevelObjectiveInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetServiceLevelObjective(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Slo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Slo, nil
+}
+
+func expandBurnRateConfigurations(tfList []interface{}) []awstypes.BurnRateConfiguration {
+	var apiObjects []awstypes.BurnRateConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.BurnRateConfiguration{
+			LookBackWindowMinutes: aws.Int32(int32(tfMap["look_back_window_minutes"].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandGoal(tfList []interface{}) *awstypes.Goal {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.Goal{}
+
+	if v, ok := tfMap["attainment_goal"].(float64); ok && v != 0 {
+		apiObject.AttainmentGoal = aws.Float64(v)
+	}
+
+	if v, ok := tfMap[names.AttrInterval].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Interval = expandInterval(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["warning_threshold"].(float64); ok && v != 0 {
+		apiObject.WarningThreshold = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandInterval(tfMap map[string]interface{}) awstypes.Interval {
+	if v, ok := tfMap["calendar_interval"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		startTime, _ := time.Parse(time.RFC3339, tfMap[names.AttrStartTime].(string))
+
+		return &awstypes.IntervalMemberCalendarInterval{
+			Value: awstypes.CalendarInterval{
+				Duration:     aws.Int32(int32(tfMap[names.AttrDuration].(int))),
+				DurationUnit: awstypes.DurationUnit(tfMap["duration_unit"].(string)),
+				StartTime:    aws.Time(startTime),
+			},
+		}
+	}
+
+	if v, ok := tfMap["rolling_interval"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		return &awstypes.IntervalMemberRollingInterval{
+			Value: awstypes.RollingInterval{
+				Duration:     aws.Int32(int32(tfMap[names.AttrDuration].(int))),
+				DurationUnit: awstypes.DurationUnit(tfMap["duration_unit"].(string)),
+			},
+		}
+	}
+
+	return nil
+}
+
+func expandServiceLevelIndicatorConfig(tfList []interface{}) *awstypes.ServiceLevelIndicatorConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.ServiceLevelIndicatorConfig{
+		ComparisonOperator: awstypes.ServiceLevelIndicatorComparisonOperator(tfMap["comparison_operator"].(string)),
+		MetricThreshold:    aws.Float64(tfMap["metric_threshold"].(float64)),
+	}
+
+	if v, ok := tfMap["sli_metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SliMetricConfig = expandServiceLevelIndicatorMetricConfig(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandServiceLevelIndicatorMetricConfig(tfMap map[string]interface{}) *awstypes.ServiceLevelIndicatorMetricConfig {
+	apiObject := &awstypes.ServiceLevelIndicatorMetricConfig{}
+
+	keyAttributes := flex.ExpandStringValueMap(tfMap["key_attributes"].(map[string]interface{}))
+
+	if len(keyAttributes) > 0 {
+		// The metric data queries of an SLO on a discovered service are built by the service
+		// from the key attributes, operation, statistic and period.
+		apiObject.KeyAttributes = keyAttributes
+
+		if v, ok := tfMap["metric_type"].(string); ok && v != "" {
+			apiObject.MetricType = awstypes.ServiceLevelIndicatorMetricType(v)
+		}
+
+		if v, ok := tfMap["operation_name"].(string); ok && v != "" {
+			apiObject.OperationName = aws.String(v)
+		}
+
+		if v, ok := tfMap["period_seconds"].(int); ok && v != 0 {
+			apiObject.PeriodSeconds = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["statistic"].(string); ok && v != "" {
+			apiObject.Statistic = aws.String(v)
+		}
+
+		return apiObject
+	}
+
+	if v, ok := tfMap["metric_data_query"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MetricDataQueries = expandMetricDataQueries(v)
+	}
+
+	return apiObject
+}
+
+func expandMetricDataQueries(tfList []interface{}) []awstypes.MetricDataQuery {
+	var apiObjects []awstypes.MetricDataQuery
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.MetricDataQuery{
+			Id: aws.String(tfMap[names.AttrID].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrAccountID].(string); ok && v != "" {
+			apiObject.AccountId = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrExpression].(string); ok && v != "" {
+			apiObject.Expression = aws.String(v)
+		}
+
+		if v, ok := tfMap["label"].(string); ok && v != "" {
+			apiObject.Label = aws.String(v)
+		}
+
+		if v, ok := tfMap["metric_stat"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.MetricStat = expandMetricStat(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["period"].(int); ok && v != 0 {
+			apiObject.Period = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["return_data"].(bool); ok {
+			apiObject.ReturnData = aws.Bool(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMetricStat(tfMap map[string]interface{}) *awstypes.MetricStat {
+	apiObject := &awstypes.MetricStat{
+		Period: aws.Int32(int32(tfMap["period"].(int))),
+		Stat:   aws.String(tfMap["stat"].(string)),
+	}
+
+	if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		metric := &awstypes.Metric{}
+
+		for _, tfMapRaw := range tfMap["dimension"].(*schema.Set).List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+
+			metric.Dimensions = append(metric.Dimensions, awstypes.Dimension{
+				Name:  aws.String(tfMap[names.AttrName].(string)),
+				Value: aws.String(tfMap[names.AttrValue].(string)),
+			})
+		}
+
+		if v, ok := tfMap[names.AttrMetricName].(string); ok && v != "" {
+			metric.MetricName = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrNamespace].(string); ok && v != "" {
+			metric.Namespace = aws.String(v)
+		}
+
+		apiObject.Metric = metric
+	}
+
+	if v, ok := tfMap[names.AttrUnit].(string); ok && v != "" {
+		apiObject.Unit = awstypes.StandardUnit(v)
+	}
+
+	return apiObject
+}
+
+func flattenBurnRateConfigurations(apiObjects []awstypes.BurnRateConfiguration) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"look_back_window_minutes": aws.ToInt32(apiObject.LookBackWindowMinutes),
+		})
+	}
+
+	return tfList
+}
+
+func flattenGoal(apiObject *awstypes.Goal) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"attainment_goal":   aws.ToFloat64(apiObject.AttainmentGoal),
+		"warning_threshold": aws.ToFloat64(apiObject.WarningThreshold),
+	}
+
+	switch v := apiObject.Interval.(type) {
+	case *awstypes.IntervalMemberCalendarInterval:
+		tfMap[names.AttrInterval] = []interface{}{map[string]interface{}{
+			"calendar_interval": []interface{}{map[string]interface{}{
+				names.AttrDuration:  aws.ToInt32(v.Value.Duration),
+				"duration_unit":     v.Value.DurationUnit,
+				names.AttrStartTime: aws.ToTime(v.Value.StartTime).Format(time.RFC3339),
+			}},
+		}}
+	case *awstypes.IntervalMemberRollingInterval:
+		tfMap[names.AttrInterval] = []interface{}{map[string]interface{}{
+			"rolling_interval": []interface{}{map[string]interface{}{
+				names.AttrDuration: aws.ToInt32(v.Value.Duration),
+				"duration_unit":    v.Value.DurationUnit,
+			}},
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenServiceLevelIndicator(apiObject *awstypes.ServiceLevelIndicator) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"comparison_operator": apiObject.ComparisonOperator,
+		"metric_threshold":    aws.ToFloat64(apiObject.MetricThreshold),
+	}
+
+	if v := apiObject.SliMetric; v != nil {
+		tfMap["sli_metric"] = []interface{}{flattenServiceLevelIndicatorMetric(v)}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenServiceLevelIndicatorMetric(apiObject *awstypes.ServiceLevelIndicatorMetric) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"key_attributes":    apiObject.KeyAttributes,
+		"metric_data_query": flattenMetricDataQueries(apiObject.MetricDataQueries),
+		"metric_type":       apiObject.MetricType,
+		"operation_name":    aws.ToString(apiObject.OperationName),
+	}
+
+	// The statistic and period of an SLO on a discovered service are only returned
+	// as part of the metric data query generated by the service.
+	if len(apiObject.KeyAttributes) > 0 && len(apiObject.MetricDataQueries) > 0 {
+		if v := apiObject.MetricDataQueries[0].MetricStat; v != nil {
+			tfMap["period_seconds"] = aws.ToInt32(v.Period)
+			tfMap["statistic"] = aws.ToString(v.Stat)
+		}
+	}
+
+	return tfMap
+}
+
+func flattenMetricDataQueries(apiObjects []awstypes.MetricDataQuery) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrAccountID:  aws.ToString(apiObject.AccountId),
+			names.AttrExpression: aws.ToString(apiObject.Expression),
+			names.AttrID:         aws.ToString(apiObject.Id),
+			"label":              aws.ToString(apiObject.Label),
+			"period":             aws.ToInt32(apiObject.Period),
+			"return_data":        aws.ToBool(apiObject.ReturnData),
+		}
+
+		if v := apiObject.MetricStat; v != nil {
+			tfMetricStat := map[string]interface{}{
+				"period":       aws.ToInt32(v.Period),
+				"stat":         aws.ToString(v.Stat),
+				names.AttrUnit: v.Unit,
+			}
+
+			if v := v.Metric; v != nil {
+				var tfDimensions []interface{}
+
+				for _, v := range v.Dimensions {
+					tfDimensions = append(tfDimensions, map[string]interface{}{
+						names.AttrName:  aws.ToString(v.Name),
+						names.AttrValue: aws.ToString(v.Value),
+					})
+				}
+
+				tfMetricStat["metric"] = []interface{}{map[string]interface{}{
+					"dimension":          tfDimensions,
+					names.AttrMetricName: aws.ToString(v.MetricName),
+					names.AttrNamespace:  aws.ToString(v.Namespace),
+				}}
+			}
+
+			tfMap["metric_stat"] = []interface{}{tfMetricStat}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
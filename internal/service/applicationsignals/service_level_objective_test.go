@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapplicationsignals "github.com/hashicorp/terraform-provider-aws/internal/service/applicationsignals"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccApplicationSignalsServiceLevelObjective_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName, 99.9),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "application-signals", regexache.MustCompile(`slo/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedTime),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_type", "PeriodBased"),
+					resource.TestCheckResourceAttr(resourceName, "goal.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.attainment_goal", "99.9"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.0.duration", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.0.duration_unit", "DAY"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "sli.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.comparison_operator", "LessThan"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.metric_threshold", "80"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.sli_metric.0.metric_data_query.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.sli_metric.0.metric_data_query.0.metric_stat.0.metric.0.metric_name", "CPUUtilization"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName, 99.5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.attainment_goal", "99.5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName, 99.9),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfapplicationsignals.ResourceServiceLevelObjective(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_burnRateConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_burnRateConfiguration(rName, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.0.look_back_window_minutes", "60"),
+					resource.TestCheckResourceAttr("aws_cloudwatch_metric_alarm.test", "dimensions.BurnRateWindowMinutes", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_burnRateConfiguration(rName, 300),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.0.look_back_window_minutes", "300"),
+				),
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName, 99.9),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckServiceLevelObjectiveDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_applicationsignals_service_level_objective" {
+				continue
+			}
+
+			_, err := tfapplicationsignals.FindServiceLevelObjectiveByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Application Signals Service Level Objective %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckServiceLevelObjectiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+		_, err := tfapplicationsignals.FindServiceLevelObjectiveByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccServiceLevelObjectiveConfig_sli() string {
+	return `
+  sli {
+    comparison_operator = "LessThan"
+    metric_threshold    = 80
+
+    sli_metric {
+      metric_data_query {
+        id          = "cpu"
+        return_data = true
+
+        metric_stat {
+          period = 60
+          stat   = "Average"
+
+          metric {
+            metric_name = "CPUUtilization"
+            namespace   = "AWS/EC2"
+          }
+        }
+      }
+    }
+  }
+`
+}
+
+func testAccServiceLevelObjectiveConfig_basic(rName string, attainmentGoal float64) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+%[3]s
+
+  goal {
+    attainment_goal = %[2]g
+
+    interval {
+      rolling_interval {
+        duration      = 1
+        duration_unit = "DAY"
+      }
+    }
+  }
+}
+`, rName, attainmentGoal, testAccServiceLevelObjectiveConfig_sli())
+}
+
+func testAccServiceLevelObjectiveConfig_burnRateConfiguration(rName string, lookBackWindowMinutes int) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+%[3]s
+
+  goal {
+    attainment_goal = 99.9
+
+    interval {
+      rolling_interval {
+        duration      = 1
+        duration_unit = "DAY"
+      }
+    }
+  }
+
+  burn_rate_configuration {
+    look_back_window_minutes = %[2]d
+  }
+}
+
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanThreshold"
+  evaluation_periods  = 1
+  metric_name         = "BurnRate"
+  namespace           = "AWS/ApplicationSignals"
+  period              = 60
+  statistic           = "Average"
+  threshold           = 14.4
+
+  dimensions = {
+    SloName               = aws_applicationsignals_service_level_objective.test.name
+    BurnRateWindowMinutes = tostring(aws_applicationsignals_service_level_objective.test.burn_rate_configuration[0].look_back_window_minutes)
+  }
+}
+`, rName, lookBackWindowMinutes, testAccServiceLevelObjectiveConfig_sli())
+}
+
+func testAccServiceLevelObjectiveConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+%[4]s
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1, testAccServiceLevelObjectiveConfig_sli())
+}
+
+func testAccServiceLevelObjectiveConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+%[6]s
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2, testAccServiceLevelObjectiveConfig_sli())
+}
@@ -536,7 +536,10 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 	if err := d.Set(names.AttrNetworkConfiguration, flattenNetworkConfiguration(service.NetworkConfiguration)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting network_configuration: %s", err)
 	}
-	if err := d.Set("observability_configuration", flattenServiceObservabilityConfiguration(service.ObservabilityConfiguration)); err != nil {
+	if v := service.ObservabilityConfiguration; v != nil && !v.ObservabilityEnabled && v.ObservabilityConfigurationArn == nil && len(d.Get("observability_configuration").([]interface{})) == 0 {
+		// Disabled observability isn't configured.
+		d.Set("observability_configuration", nil)
+	} else if err := d.Set("observability_configuration", flattenServiceObservabilityConfiguration(service.ObservabilityConfiguration)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting observability_configuration: %s", err)
 	}
 	d.Set("service_id", service.ServiceId)
@@ -577,7 +580,14 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if d.HasChange("observability_configuration") {
-			input.ObservabilityConfiguration = expandServiceObservabilityConfiguration(d.Get("observability_configuration").([]interface{}))
+			if v := expandServiceObservabilityConfiguration(d.Get("observability_configuration").([]interface{})); v != nil {
+				input.ObservabilityConfiguration = v
+			} else {
+				// Removing the block disassociates the observability configuration.
+				input.ObservabilityConfiguration = &types.ServiceObservabilityConfiguration{
+					ObservabilityEnabled: false,
+				}
+			}
 		}
 
 		if d.HasChange("source_configuration") {
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -421,6 +422,113 @@ func TestAccAppRunnerService_ImageRepository_observabilityConfiguration(t *testi
 	})
 }
 
+func TestAccAppRunnerService_ImageRepository_autoScalingUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_apprunner_service.test"
+	autoScalingResourceName := "aws_apprunner_auto_scaling_configuration_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppRunnerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_ImageRepository_autoScalingConfigurationSelect(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "auto_scaling_configuration_arn", autoScalingResourceName+".0", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccServiceConfig_ImageRepository_autoScalingConfigurationSelect(rName, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "auto_scaling_configuration_arn", autoScalingResourceName+".1", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppRunnerService_ImageRepository_observabilityConfigurationUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_apprunner_service.test"
+	observabilityConfigurationResourceName := "aws_apprunner_observability_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppRunnerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_ImageRepository_observabilityConfigurationSelect(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "observability_configuration.0.observability_configuration_arn", observabilityConfigurationResourceName+".0", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccServiceConfig_ImageRepository_observabilityConfigurationSelect(rName, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "observability_configuration.0.observability_configuration_arn", observabilityConfigurationResourceName+".1", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "observability_configuration.0.observability_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccServiceConfig_imageRepository(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "observability_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppRunnerService_webACLAssociation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_apprunner_service.test"
+	associationResourceName := "aws_wafv2_web_acl_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppRunnerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_webACLAssociation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(associationResourceName, "resource_arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(associationResourceName, "web_acl_arn", "aws_wafv2_web_acl.test", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19469
 func TestAccAppRunnerService_ImageRepository_runtimeEnvironmentVars(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -915,6 +1023,92 @@ resource "aws_apprunner_service" "test" {
 `, rName)
 }
 
+func testAccServiceConfig_ImageRepository_autoScalingConfigurationSelect(rName string, index int) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_auto_scaling_configuration_version" "test" {
+  count = 2
+
+  auto_scaling_configuration_name = "%[1]s-${count.index}"
+  max_size                        = count.index == 0 ? 2 : 10
+}
+
+resource "aws_apprunner_service" "test" {
+  auto_scaling_configuration_arn = aws_apprunner_auto_scaling_configuration_version.test[%[2]d].arn
+
+  service_name = %[1]q
+
+  source_configuration {
+    auto_deployments_enabled = false
+    image_repository {
+      image_configuration {
+        port = "80"
+      }
+      image_identifier      = "public.ecr.aws/nginx/nginx:latest"
+      image_repository_type = "ECR_PUBLIC"
+    }
+  }
+}
+`, rName, index)
+}
+
+func testAccServiceConfig_ImageRepository_observabilityConfigurationSelect(rName string, index int) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_observability_configuration" "test" {
+  count = 2
+
+  observability_configuration_name = "%[1]s-${count.index}"
+
+  trace_configuration {
+    vendor = "AWSXRAY"
+  }
+}
+
+resource "aws_apprunner_service" "test" {
+  service_name = %[1]q
+
+  observability_configuration {
+    observability_configuration_arn = aws_apprunner_observability_configuration.test[%[2]d].arn
+    observability_enabled           = true
+  }
+
+  source_configuration {
+    auto_deployments_enabled = false
+    image_repository {
+      image_configuration {
+        port = "80"
+      }
+      image_identifier      = "public.ecr.aws/nginx/nginx:latest"
+      image_repository_type = "ECR_PUBLIC"
+    }
+  }
+}
+`, rName, index)
+}
+
+func testAccServiceConfig_webACLAssociation(rName string) string {
+	return acctest.ConfigCompose(testAccServiceConfig_imageRepository(rName), fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name  = %[1]q
+  scope = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+
+resource "aws_wafv2_web_acl_association" "test" {
+  resource_arn = aws_apprunner_service.test.arn
+  web_acl_arn  = aws_wafv2_web_acl.test.arn
+}
+`, rName))
+}
+
 func testAccIAMRole(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -66,10 +66,12 @@ func resourceVPCIngressConnection() *schema.Resource {
 			names.AttrName: {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"service_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
+				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
 			names.AttrStatus: {
@@ -145,8 +147,28 @@ func resourceVPCIngressConnectionRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceVPCIngressConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// Tags only.
-	return resourceVPCIngressConnectionRead(ctx, d, meta)
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppRunnerClient(ctx)
+
+	if d.HasChange("ingress_vpc_configuration") {
+		input := &apprunner.UpdateVpcIngressConnectionInput{
+			IngressVpcConfiguration: expandIngressVPCConfiguration(d.Get("ingress_vpc_configuration").([]interface{})),
+			VpcIngressConnectionArn: aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateVpcIngressConnection(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating App Runner VPC Ingress Connection (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitVPCIngressConnectionUpdated(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for App Runner VPC Ingress Connection (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceVPCIngressConnectionRead(ctx, d, meta)...)
 }
 
 func resourceVPCIngressConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -241,6 +263,26 @@ func waitVPCIngressConnectionCreated(ctx context.Context, conn *apprunner.Client
 	return nil, err
 }
 
+func waitVPCIngressConnectionUpdated(ctx context.Context, conn *apprunner.Client, arn string) (*types.VpcIngressConnection, error) {
+	const (
+		timeout = 2 * time.Minute
+	)
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VpcIngressConnectionStatusPendingUpdate),
+		Target:  enum.Slice(types.VpcIngressConnectionStatusAvailable),
+		Refresh: statusVPCIngressConnection(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.VpcIngressConnection); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitVPCIngressConnectionDeleted(ctx context.Context, conn *apprunner.Client, arn string) (*types.VpcIngressConnection, error) {
 	const (
 		timeout = 2 * time.Minute
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -56,6 +57,41 @@ func TestAccAppRunnerVPCIngressConnection_basic(t *testing.T) {
 	})
 }
 
+func TestAccAppRunnerVPCIngressConnection_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_apprunner_vpc_ingress_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppRunnerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCIngressConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCIngressConnectionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIngressConnectionExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "ingress_vpc_configuration.0.vpc_endpoint_id", "aws_vpc_endpoint.test", names.AttrID),
+				),
+			},
+			{
+				Config: testAccVPCIngressConnectionConfig_updatedEndpoint(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCIngressConnectionExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "ingress_vpc_configuration.0.vpc_endpoint_id", "aws_vpc_endpoint.test2", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.VpcIngressConnectionStatusAvailable)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAppRunnerVPCIngressConnection_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -222,6 +258,36 @@ resource "aws_apprunner_vpc_ingress_connection" "test" {
 `, rName))
 }
 
+func testAccVPCIngressConnectionConfig_updatedEndpoint(rName string) string {
+	return acctest.ConfigCompose(testAccVPCIngressConnectionConfig_base(rName), fmt.Sprintf(`
+resource "aws_vpc_endpoint" "test2" {
+  vpc_id            = aws_vpc.test.id
+  service_name      = "com.amazonaws.${data.aws_region.current.name}.apprunner.requests"
+  vpc_endpoint_type = "Interface"
+
+  subnet_ids = aws_subnet.test[*].id
+
+  security_group_ids = [
+    aws_vpc.test.default_security_group_id,
+  ]
+
+  tags = {
+    Name = "%[1]s-2"
+  }
+}
+
+resource "aws_apprunner_vpc_ingress_connection" "test" {
+  name        = %[1]q
+  service_arn = aws_apprunner_service.test.arn
+
+  ingress_vpc_configuration {
+    vpc_id          = aws_vpc.test.id
+    vpc_endpoint_id = aws_vpc_endpoint.test2.id
+  }
+}
+`, rName))
+}
+
 func testAccVPCIngressConnectionConfig_tags1(rName string, tagKey1 string, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccVPCIngressConnectionConfig_base(rName), fmt.Sprintf(`
 resource "aws_apprunner_vpc_ingress_connection" "test" {
@@ -56,10 +56,22 @@ func ResourceFleet() *schema.Resource {
 				Required: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"active_user_sessions": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"actual_user_sessions": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
 						"available": {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
+						"available_user_sessions": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
 						"desired_instances": {
 							Type:     schema.TypeInt,
 							Optional: true,
@@ -614,6 +626,18 @@ func flattenComputeCapacity(apiObject *appstream.ComputeCapacityStatus) map[stri
 		tfMap["running"] = aws.Int64Value(v)
 	}
 
+	if v := apiObject.ActiveUserSessions; v != nil {
+		tfMap["active_user_sessions"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.ActualUserSessions; v != nil {
+		tfMap["actual_user_sessions"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.AvailableUserSessions; v != nil {
+		tfMap["available_user_sessions"] = aws.Int64Value(v)
+	}
+
 	if reflect.DeepEqual(map[string]interface{}{}, tfMap) {
 		return nil
 	}
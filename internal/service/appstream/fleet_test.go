@@ -333,6 +333,8 @@ func TestAccAppStreamFleet_multiSession(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, instanceType),
 					resource.TestCheckResourceAttr(resourceName, "max_sessions_per_instance", "5"),
 					resource.TestCheckResourceAttr(resourceName, "compute_capacity.0.desired_sessions", acctest.CtOne),
+					resource.TestCheckResourceAttrSet(resourceName, "compute_capacity.0.actual_user_sessions"),
+					resource.TestCheckResourceAttrSet(resourceName, "compute_capacity.0.available_user_sessions"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrState, appstream.FleetStateRunning),
 					acctest.CheckResourceAttrRFC3339(resourceName, names.AttrCreatedTime),
 				),
@@ -61,6 +61,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceUsageReportSubscription,
+			TypeName: "aws_appstream_usage_report_subscription",
+			Name:     "Usage Report Subscription",
+		},
 		{
 			Factory:  ResourceUser,
 			TypeName: "aws_appstream_user",
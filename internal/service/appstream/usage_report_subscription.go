@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_appstream_usage_report_subscription", name="Usage Report Subscription")
+func ResourceUsageReportSubscription() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUsageReportSubscriptionCreate,
+		ReadWithoutTimeout:   resourceUsageReportSubscriptionRead,
+		DeleteWithoutTimeout: resourceUsageReportSubscriptionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"s3_bucket_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrSchedule: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceUsageReportSubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppStreamConn(ctx)
+
+	_, err := conn.CreateUsageReportSubscriptionWithContext(ctx, &appstream.CreateUsageReportSubscriptionInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppStream Usage Report Subscription: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	return append(diags, resourceUsageReportSubscriptionRead(ctx, d, meta)...)
+}
+
+func resourceUsageReportSubscriptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppStreamConn(ctx)
+
+	subscription, err := FindUsageReportSubscription(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppStream Usage Report Subscription (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppStream Usage Report Subscription (%s): %s", d.Id(), err)
+	}
+
+	d.Set("s3_bucket_name", subscription.S3BucketName)
+	d.Set(names.AttrSchedule, subscription.Schedule)
+
+	return diags
+}
+
+func resourceUsageReportSubscriptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppStreamConn(ctx)
+
+	log.Printf("[DEBUG] Deleting AppStream Usage Report Subscription: %s", d.Id())
+	_, err := conn.DeleteUsageReportSubscriptionWithContext(ctx, &appstream.DeleteUsageReportSubscriptionInput{})
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting AppStream Usage Report Subscription (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// FindUsageReportSubscription returns the usage report subscription for the current region.
+func FindUsageReportSubscription(ctx context.Context, conn *appstream.AppStream) (*appstream.UsageReportSubscription, error) {
+	input := &appstream.DescribeUsageReportSubscriptionsInput{}
+
+	output, err := conn.DescribeUsageReportSubscriptionsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.UsageReportSubscriptions) == 0 || output.UsageReportSubscriptions[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.UsageReportSubscriptions); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.UsageReportSubscriptions[0], nil
+}
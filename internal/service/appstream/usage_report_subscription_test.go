@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appstream_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappstream "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAppStreamUsageReportSubscription_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v appstream.UsageReportSubscription
+	resourceName := "aws_appstream_usage_report_subscription.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsageReportSubscriptionDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageReportSubscriptionConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsageReportSubscriptionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "s3_bucket_name"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrSchedule, appstream.UsageReportScheduleDaily),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppStreamUsageReportSubscription_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v appstream.UsageReportSubscription
+	resourceName := "aws_appstream_usage_report_subscription.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsageReportSubscriptionDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageReportSubscriptionConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsageReportSubscriptionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfappstream.ResourceUsageReportSubscription(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckUsageReportSubscriptionExists(ctx context.Context, n string, v *appstream.UsageReportSubscription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AppStream Usage Report Subscription ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamConn(ctx)
+
+		output, err := tfappstream.FindUsageReportSubscription(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckUsageReportSubscriptionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppStreamConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appstream_usage_report_subscription" {
+				continue
+			}
+
+			_, err := tfappstream.FindUsageReportSubscription(ctx, conn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppStream Usage Report Subscription %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testAccUsageReportSubscriptionConfig_basic = `
+resource "aws_appstream_usage_report_subscription" "test" {}
+`
@@ -0,0 +1,447 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billingconductor
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/billingconductor"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// The maximum number of accounts that can be (dis)associated in a single call.
+	accountAssociationBatchSize = 30
+)
+
+// @SDKResource("aws_billingconductor_billing_group", name="Billing Group")
+// @Tags(identifierAttribute="arn")
+func resourceBillingGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBillingGroupCreate,
+		ReadWithoutTimeout:   resourceBillingGroupRead,
+		UpdateWithoutTimeout: resourceBillingGroupUpdate,
+		DeleteWithoutTimeout: resourceBillingGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_grouping": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_associate": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"linked_account_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidAccountID,
+							},
+						},
+					},
+				},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"computation_preference": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pricing_plan_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"primary_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			names.AttrSize: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceBillingGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BillingConductorConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &billingconductor.CreateBillingGroupInput{
+		AccountGrouping:       expandAccountGrouping(d.Get("account_grouping").([]interface{})[0].(map[string]interface{})),
+		ComputationPreference: expandComputationPreference(d.Get("computation_preference").([]interface{})[0].(map[string]interface{})),
+		Name:                  aws.String(name),
+		Tags:                  getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("primary_account_id"); ok {
+		input.PrimaryAccountId = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateBillingGroupWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Billing Conductor Billing Group (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	return append(diags, resourceBillingGroupRead(ctx, d, meta)...)
+}
+
+func resourceBillingGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BillingConductorConn(ctx)
+
+	group, err := findBillingGroupByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Billing Conductor Billing Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Billing Conductor Billing Group (%s): %s", d.Id(), err)
+	}
+
+	accountIDs, err := findLinkedAccountIDsByBillingGroupARN(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Billing Conductor Billing Group (%s) account associations: %s", d.Id(), err)
+	}
+
+	autoAssociate := group.AccountGrouping != nil && aws.BoolValue(group.AccountGrouping.AutoAssociate)
+	if autoAssociate {
+		// Accounts that join the organization are associated automatically.
+		// Only track the accounts that are managed by Terraform to avoid perpetual differences.
+		if v, ok := d.GetOk("account_grouping.0.linked_account_ids"); ok {
+			configured := v.(*schema.Set)
+			accountIDs = tfslices.Filter(accountIDs, func(v string) bool {
+				return configured.Contains(v)
+			})
+		}
+	}
+
+	if err := d.Set("account_grouping", []interface{}{map[string]interface{}{
+		"auto_associate":     autoAssociate,
+		"linked_account_ids": accountIDs,
+	}}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting account_grouping: %s", err)
+	}
+	d.Set(names.AttrARN, group.Arn)
+	if group.ComputationPreference != nil {
+		if err := d.Set("computation_preference", []interface{}{flattenComputationPreference(group.ComputationPreference)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting computation_preference: %s", err)
+		}
+	} else {
+		d.Set("computation_preference", nil)
+	}
+	d.Set(names.AttrDescription, group.Description)
+	d.Set(names.AttrName, group.Name)
+	d.Set("primary_account_id", group.PrimaryAccountId)
+	d.Set(names.AttrSize, group.Size)
+	d.Set(names.AttrStatus, group.Status)
+	d.Set("status_reason", group.StatusReason)
+
+	return diags
+}
+
+func resourceBillingGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BillingConductorConn(ctx)
+
+	if d.HasChange("account_grouping.0.linked_account_ids") {
+		o, n := d.GetChange("account_grouping.0.linked_account_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		for _, chunk := range tfslices.Chunks(flex.ExpandStringSet(os.Difference(ns)), accountAssociationBatchSize) {
+			input := &billingconductor.DisassociateAccountsInput{
+				AccountIds: chunk,
+				Arn:        aws.String(d.Id()),
+			}
+
+			_, err := conn.DisassociateAccountsWithContext(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "disassociating Billing Conductor Billing Group (%s) accounts: %s", d.Id(), err)
+			}
+		}
+
+		for _, chunk := range tfslices.Chunks(flex.ExpandStringSet(ns.Difference(os)), accountAssociationBatchSize) {
+			input := &billingconductor.AssociateAccountsInput{
+				AccountIds: chunk,
+				Arn:        aws.String(d.Id()),
+			}
+
+			_, err := conn.AssociateAccountsWithContext(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "associating Billing Conductor Billing Group (%s) accounts: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChanges("account_grouping.0.auto_associate", "computation_preference", names.AttrDescription, names.AttrName) {
+		input := &billingconductor.UpdateBillingGroupInput{
+			Arn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("account_grouping.0.auto_associate") {
+			input.AccountGrouping = &billingconductor.UpdateBillingGroupAccountGrouping{
+				AutoAssociate: aws.Bool(d.Get("account_grouping.0.auto_associate").(bool)),
+			}
+		}
+
+		if d.HasChange("computation_preference") {
+			input.ComputationPreference = expandComputationPreference(d.Get("computation_preference").([]interface{})[0].(map[string]interface{}))
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange(names.AttrName) {
+			input.Name = aws.String(d.Get(names.AttrName).(string))
+		}
+
+		_, err := conn.UpdateBillingGroupWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Billing Conductor Billing Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceBillingGroupRead(ctx, d, meta)...)
+}
+
+func resourceBillingGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BillingConductorConn(ctx)
+
+	// Billing groups that automatically associate accounts cannot be deleted.
+	if d.Get("account_grouping.0.auto_associate").(bool) {
+		input := &billingconductor.UpdateBillingGroupInput{
+			AccountGrouping: &billingconductor.UpdateBillingGroupAccountGrouping{
+				AutoAssociate: aws.Bool(false),
+			},
+			Arn: aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateBillingGroupWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, billingconductor.ErrCodeResourceNotFoundException) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "disabling Billing Conductor Billing Group (%s) automatic account association: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[INFO] Deleting Billing Conductor Billing Group: %s", d.Id())
+	_, err := conn.DeleteBillingGroupWithContext(ctx, &billingconductor.DeleteBillingGroupInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, billingconductor.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Billing Conductor Billing Group (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findBillingGroupByARN(ctx context.Context, conn *billingconductor.BillingConductor, arn string) (*billingconductor.BillingGroupListElement, error) {
+	input := &billingconductor.ListBillingGroupsInput{
+		Filters: &billingconductor.ListBillingGroupsFilter{
+			Arns: aws.StringSlice([]string{arn}),
+		},
+	}
+
+	output, err := findBillingGroups(ctx, conn, input, tfslices.PredicateTrue[*billingconductor.BillingGroupListElement]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findBillingGroups(ctx context.Context, conn *billingconductor.BillingConductor, input *billingconductor.ListBillingGroupsInput, filter tfslices.Predicate[*billingconductor.BillingGroupListElement]) ([]*billingconductor.BillingGroupListElement, error) {
+	var output []*billingconductor.BillingGroupListElement
+
+	err := conn.ListBillingGroupsPagesWithContext(ctx, input, func(page *billingconductor.ListBillingGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.BillingGroups {
+			if v != nil && filter(v) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, billingconductor.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findLinkedAccountIDsByBillingGroupARN(ctx context.Context, conn *billingconductor.BillingConductor, arn string) ([]string, error) {
+	input := &billingconductor.ListAccountAssociationsInput{
+		Filters: &billingconductor.ListAccountAssociationsFilter{
+			Association: aws.String(arn),
+		},
+	}
+	var output []string
+
+	err := conn.ListAccountAssociationsPagesWithContext(ctx, input, func(page *billingconductor.ListAccountAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LinkedAccounts {
+			if v != nil {
+				output = append(output, aws.StringValue(v.AccountId))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, billingconductor.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func expandAccountGrouping(tfMap map[string]interface{}) *billingconductor.AccountGrouping {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &billingconductor.AccountGrouping{}
+
+	if v, ok := tfMap["auto_associate"].(bool); ok && v {
+		apiObject.AutoAssociate = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["linked_account_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LinkedAccountIds = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandComputationPreference(tfMap map[string]interface{}) *billingconductor.ComputationPreference {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &billingconductor.ComputationPreference{}
+
+	if v, ok := tfMap["pricing_plan_arn"].(string); ok && v != "" {
+		apiObject.PricingPlanArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenComputationPreference(apiObject *billingconductor.ComputationPreference) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.PricingPlanArn; v != nil {
+		tfMap["pricing_plan_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
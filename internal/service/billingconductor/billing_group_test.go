@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billingconductor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/billingconductor"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbillingconductor "github.com/hashicorp/terraform-provider-aws/internal/service/billingconductor"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBillingConductorBillingGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v billingconductor.BillingGroupListElement
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_billingconductor_billing_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, billingconductor.EndpointsID)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BillingConductorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckBillingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBillingGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBillingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "account_grouping.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "account_grouping.0.auto_associate", "false"),
+					resource.TestCheckResourceAttr(resourceName, "account_grouping.0.linked_account_ids.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "account_grouping.0.linked_account_ids.*", "data.aws_caller_identity.member", names.AttrAccountID),
+					acctest.MatchResourceAttrGlobalARN(resourceName, names.AttrARN, "billingconductor", regexache.MustCompile(`billinggroup/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "computation_preference.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(resourceName, "computation_preference.0.pricing_plan_arn", "aws_billingconductor_pricing_plan.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_account_id", "data.aws_caller_identity.member", names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrSize, acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBillingGroupConfig_basic(rNameUpdated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBillingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBillingConductorBillingGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v billingconductor.BillingGroupListElement
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_billingconductor_billing_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, billingconductor.EndpointsID)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BillingConductorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckBillingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBillingGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBillingGroupExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfbillingconductor.ResourceBillingGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBillingConductorBillingGroup_autoAssociate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v billingconductor.BillingGroupListElement
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_billingconductor_billing_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, billingconductor.EndpointsID)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BillingConductorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckBillingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBillingGroupConfig_autoAssociate(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBillingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "account_grouping.0.auto_associate", "true"),
+					resource.TestCheckResourceAttr(resourceName, "account_grouping.0.linked_account_ids.#", acctest.CtOne),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"account_grouping.0.linked_account_ids"},
+			},
+			{
+				Config: testAccBillingGroupConfig_autoAssociate(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBillingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "account_grouping.0.auto_associate", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBillingGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BillingConductorConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_billingconductor_billing_group" {
+				continue
+			}
+
+			_, err := tfbillingconductor.FindBillingGroupByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Billing Conductor Billing Group %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBillingGroupExists(ctx context.Context, n string, v *billingconductor.BillingGroupListElement) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BillingConductorConn(ctx)
+
+		output, err := tfbillingconductor.FindBillingGroupByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccBillingGroupConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_billingconductor_pricing_plan" "test" {
+  name = %[1]q
+}
+`, rName))
+}
+
+func testAccBillingGroupConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBillingGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_billingconductor_billing_group" "test" {
+  name               = %[1]q
+  primary_account_id = data.aws_caller_identity.member.account_id
+
+  account_grouping {
+    linked_account_ids = [data.aws_caller_identity.member.account_id]
+  }
+
+  computation_preference {
+    pricing_plan_arn = aws_billingconductor_pricing_plan.test.arn
+  }
+}
+`, rName))
+}
+
+func testAccBillingGroupConfig_autoAssociate(rName string, autoAssociate bool) string {
+	return acctest.ConfigCompose(testAccBillingGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_billingconductor_billing_group" "test" {
+  name               = %[1]q
+  primary_account_id = data.aws_caller_identity.member.account_id
+
+  account_grouping {
+    auto_associate     = %[2]t
+    linked_account_ids = [data.aws_caller_identity.member.account_id]
+  }
+
+  computation_preference {
+    pricing_plan_arn = aws_billingconductor_pricing_plan.test.arn
+  }
+}
+`, rName, autoAssociate))
+}
@@ -0,0 +1,536 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billingconductor
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/billingconductor"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_billingconductor_custom_line_item", name="Custom Line Item")
+// @Tags(identifierAttribute="arn")
+func resourceCustomLineItem() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCustomLineItemCreate,
+		ReadWithoutTimeout:   resourceCustomLineItemRead,
+		UpdateWithoutTimeout: resourceCustomLineItemUpdate,
+		DeleteWithoutTimeout: resourceCustomLineItemDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAccountID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"association_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"billing_group_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"billing_period_range": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"exclusive_end_billing_period": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"inclusive_start_billing_period": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"charge_details": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flat": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"charge_details.0.flat", "charge_details.0.percentage"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"charge_value": {
+										Type:         schema.TypeFloat,
+										Required:     true,
+										ValidateFunc: validation.FloatAtLeast(0),
+									},
+								},
+							},
+						},
+						"line_item_filter": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(billingconductor.LineItemFilterAttributeName_Values(), false),
+									},
+									"match_option": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(billingconductor.MatchOption_Values(), false),
+									},
+									names.AttrValues: {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(billingconductor.LineItemFilterValue_Values(), false),
+										},
+									},
+								},
+							},
+						},
+						"percentage": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"charge_details.0.flat", "charge_details.0.percentage"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"associated_values": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+									"percentage_value": {
+										Type:         schema.TypeFloat,
+										Required:     true,
+										ValidateFunc: validation.FloatBetween(0, 10000),
+									},
+								},
+							},
+						},
+						names.AttrType: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(billingconductor.CustomLineItemType_Values(), false),
+						},
+					},
+				},
+			},
+			"currency_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"product_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCustomLineItemCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BillingConductorConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &billingconductor.CreateCustomLineItemInput{
+		BillingGroupArn: aws.String(d.Get("billing_group_arn").(string)),
+		ChargeDetails:   expandCustomLineItemChargeDetails(d.Get("charge_details").([]interface{})[0].(map[string]interface{})),
+		Description:     aws.String(d.Get(names.AttrDescription).(string)),
+		Name:            aws.String(name),
+		Tags:            getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrAccountID); ok {
+		input.AccountId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("billing_period_range"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.BillingPeriodRange = expandCustomLineItemBillingPeriodRange(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateCustomLineItemWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Billing Conductor Custom Line Item (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	return append(diags, resourceCustomLineItemRead(ctx, d, meta)...)
+}
+
+func resourceCustomLineItemRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BillingConductorConn(ctx)
+
+	item, err := findCustomLineItemByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Billing Conductor Custom Line Item (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Billing Conductor Custom Line Item (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrAccountID, item.AccountId)
+	d.Set(names.AttrARN, item.Arn)
+	d.Set("association_size", item.AssociationSize)
+	d.Set("billing_group_arn", item.BillingGroupArn)
+	if item.ChargeDetails != nil {
+		// The values a percentage charge applies to are only returned on creation.
+		associatedValues := d.Get("charge_details.0.percentage.0.associated_values")
+		if err := d.Set("charge_details", []interface{}{flattenListCustomLineItemChargeDetails(item.ChargeDetails, associatedValues)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting charge_details: %s", err)
+		}
+	} else {
+		d.Set("charge_details", nil)
+	}
+	d.Set("currency_code", item.CurrencyCode)
+	d.Set(names.AttrDescription, item.Description)
+	d.Set(names.AttrName, item.Name)
+	d.Set("product_code", item.ProductCode)
+
+	return diags
+}
+
+func resourceCustomLineItemUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BillingConductorConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &billingconductor.UpdateCustomLineItemInput{
+			Arn: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("billing_period_range"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.BillingPeriodRange = expandCustomLineItemBillingPeriodRange(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if d.HasChange("charge_details") {
+			input.ChargeDetails = expandUpdateCustomLineItemChargeDetails(d.Get("charge_details").([]interface{})[0].(map[string]interface{}))
+
+			// An empty list removes all filters.
+			if d.HasChange("charge_details.0.line_item_filter") && input.ChargeDetails.LineItemFilters == nil {
+				input.ChargeDetails.LineItemFilters = []*billingconductor.LineItemFilter{}
+			}
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange(names.AttrName) {
+			input.Name = aws.String(d.Get(names.AttrName).(string))
+		}
+
+		_, err := conn.UpdateCustomLineItemWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Billing Conductor Custom Line Item (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCustomLineItemRead(ctx, d, meta)...)
+}
+
+func resourceCustomLineItemDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BillingConductorConn(ctx)
+
+	input := &billingconductor.DeleteCustomLineItemInput{
+		Arn: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("billing_period_range"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.BillingPeriodRange = expandCustomLineItemBillingPeriodRange(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[INFO] Deleting Billing Conductor Custom Line Item: %s", d.Id())
+	_, err := conn.DeleteCustomLineItemWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, billingconductor.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Billing Conductor Custom Line Item (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findCustomLineItemByARN(ctx context.Context, conn *billingconductor.BillingConductor, arn string) (*billingconductor.CustomLineItemListElement, error) {
+	input := &billingconductor.ListCustomLineItemsInput{
+		Filters: &billingconductor.ListCustomLineItemsFilter{
+			Arns: aws.StringSlice([]string{arn}),
+		},
+	}
+
+	output, err := findCustomLineItems(ctx, conn, input, tfslices.PredicateTrue[*billingconductor.CustomLineItemListElement]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findCustomLineItems(ctx context.Context, conn *billingconductor.BillingConductor, input *billingconductor.ListCustomLineItemsInput, filter tfslices.Predicate[*billingconductor.CustomLineItemListElement]) ([]*billingconductor.CustomLineItemListElement, error) {
+	var output []*billingconductor.CustomLineItemListElement
+
+	err := conn.ListCustomLineItemsPagesWithContext(ctx, input, func(page *billingconductor.ListCustomLineItemsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CustomLineItems {
+			if v != nil && filter(v) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, billingconductor.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func expandCustomLineItemBillingPeriodRange(tfMap map[string]interface{}) *billingconductor.CustomLineItemBillingPeriodRange {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &billingconductor.CustomLineItemBillingPeriodRange{}
+
+	if v, ok := tfMap["exclusive_end_billing_period"].(string); ok && v != "" {
+		apiObject.ExclusiveEndBillingPeriod = aws.String(v)
+	}
+
+	if v, ok := tfMap["inclusive_start_billing_period"].(string); ok && v != "" {
+		apiObject.InclusiveStartBillingPeriod = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCustomLineItemChargeDetails(tfMap map[string]interface{}) *billingconductor.CustomLineItemChargeDetails {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &billingconductor.CustomLineItemChargeDetails{}
+
+	if v, ok := tfMap["flat"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Flat = &billingconductor.CustomLineItemFlatChargeDetails{
+			ChargeValue: aws.Float64(v[0].(map[string]interface{})["charge_value"].(float64)),
+		}
+	}
+
+	if v, ok := tfMap["line_item_filter"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LineItemFilters = expandLineItemFilters(v.List())
+	}
+
+	if v, ok := tfMap["percentage"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		percentage := v[0].(map[string]interface{})
+
+		apiObject.Percentage = &billingconductor.CustomLineItemPercentageChargeDetails{
+			PercentageValue: aws.Float64(percentage["percentage_value"].(float64)),
+		}
+
+		if v, ok := percentage["associated_values"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Percentage.AssociatedValues = flex.ExpandStringSet(v)
+		}
+	}
+
+	if v, ok := tfMap[names.AttrType].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandUpdateCustomLineItemChargeDetails(tfMap map[string]interface{}) *billingconductor.UpdateCustomLineItemChargeDetails {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &billingconductor.UpdateCustomLineItemChargeDetails{}
+
+	if v, ok := tfMap["flat"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Flat = &billingconductor.UpdateCustomLineItemFlatChargeDetails{
+			ChargeValue: aws.Float64(v[0].(map[string]interface{})["charge_value"].(float64)),
+		}
+	}
+
+	if v, ok := tfMap["line_item_filter"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LineItemFilters = expandLineItemFilters(v.List())
+	}
+
+	if v, ok := tfMap["percentage"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Percentage = &billingconductor.UpdateCustomLineItemPercentageChargeDetails{
+			PercentageValue: aws.Float64(v[0].(map[string]interface{})["percentage_value"].(float64)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandLineItemFilters(tfList []interface{}) []*billingconductor.LineItemFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*billingconductor.LineItemFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &billingconductor.LineItemFilter{}
+
+		if v, ok := tfMap["attribute"].(string); ok && v != "" {
+			apiObject.Attribute = aws.String(v)
+		}
+
+		if v, ok := tfMap["match_option"].(string); ok && v != "" {
+			apiObject.MatchOption = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrValues].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Values = flex.ExpandStringSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenListCustomLineItemChargeDetails(apiObject *billingconductor.ListCustomLineItemChargeDetails, associatedValues interface{}) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Flat; v != nil {
+		tfMap["flat"] = []interface{}{map[string]interface{}{
+			"charge_value": aws.Float64Value(v.ChargeValue),
+		}}
+	}
+
+	if v := apiObject.LineItemFilters; v != nil {
+		tfMap["line_item_filter"] = flattenLineItemFilters(v)
+	}
+
+	if v := apiObject.Percentage; v != nil {
+		tfMap["percentage"] = []interface{}{map[string]interface{}{
+			"associated_values": associatedValues,
+			"percentage_value":  aws.Float64Value(v.PercentageValue),
+		}}
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap[names.AttrType] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenLineItemFilters(apiObjects []*billingconductor.LineItemFilter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"attribute":      aws.StringValue(apiObject.Attribute),
+			"match_option":   aws.StringValue(apiObject.MatchOption),
+			names.AttrValues: aws.StringValueSlice(apiObject.Values),
+		})
+	}
+
+	return tfList
+}
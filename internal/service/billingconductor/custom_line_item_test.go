@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billingconductor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/billingconductor"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbillingconductor "github.com/hashicorp/terraform-provider-aws/internal/service/billingconductor"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBillingConductorCustomLineItem_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v billingconductor.CustomLineItemListElement
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_billingconductor_custom_line_item.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, billingconductor.EndpointsID)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BillingConductorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckCustomLineItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomLineItemConfig_flat(rName, 100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomLineItemExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrGlobalARN(resourceName, names.AttrARN, "billingconductor", regexache.MustCompile(`customlineitem/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "billing_group_arn", "aws_billingconductor_billing_group.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "charge_details.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "charge_details.0.flat.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "charge_details.0.flat.0.charge_value", "100"),
+					resource.TestCheckResourceAttr(resourceName, "charge_details.0.percentage.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "charge_details.0.type", "FEE"),
+					resource.TestCheckResourceAttrSet(resourceName, "currency_code"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCustomLineItemConfig_flat(rName, 200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomLineItemExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "charge_details.0.flat.0.charge_value", "200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBillingConductorCustomLineItem_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v billingconductor.CustomLineItemListElement
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_billingconductor_custom_line_item.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, billingconductor.EndpointsID)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BillingConductorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckCustomLineItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomLineItemConfig_flat(rName, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomLineItemExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfbillingconductor.ResourceCustomLineItem(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBillingConductorCustomLineItem_percentage(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v billingconductor.CustomLineItemListElement
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_billingconductor_custom_line_item.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, billingconductor.EndpointsID)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BillingConductorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckCustomLineItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomLineItemConfig_percentage(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomLineItemExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "charge_details.0.flat.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "charge_details.0.line_item_filter.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "charge_details.0.line_item_filter.*", map[string]string{
+						"attribute":    "LINE_ITEM_TYPE",
+						"match_option": "NOT_EQUAL",
+						"values.#":     acctest.CtOne,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "charge_details.0.percentage.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "charge_details.0.percentage.0.associated_values.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "charge_details.0.percentage.0.associated_values.*", "aws_billingconductor_billing_group.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "charge_details.0.percentage.0.percentage_value", "10"),
+					resource.TestCheckResourceAttr(resourceName, "charge_details.0.type", "CREDIT"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"charge_details.0.percentage.0.associated_values"},
+			},
+			{
+				Config: testAccCustomLineItemConfig_percentage(rName, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomLineItemExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "charge_details.0.percentage.0.percentage_value", "20"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCustomLineItemDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BillingConductorConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_billingconductor_custom_line_item" {
+				continue
+			}
+
+			_, err := tfbillingconductor.FindCustomLineItemByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Billing Conductor Custom Line Item %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCustomLineItemExists(ctx context.Context, n string, v *billingconductor.CustomLineItemListElement) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BillingConductorConn(ctx)
+
+		output, err := tfbillingconductor.FindCustomLineItemByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCustomLineItemConfig_flat(rName string, chargeValue int) string {
+	return acctest.ConfigCompose(testAccBillingGroupConfig_basic(rName), fmt.Sprintf(`
+resource "aws_billingconductor_custom_line_item" "test" {
+  name              = %[1]q
+  description       = %[1]q
+  billing_group_arn = aws_billingconductor_billing_group.test.arn
+
+  charge_details {
+    type = "FEE"
+
+    flat {
+      charge_value = %[2]d
+    }
+  }
+}
+`, rName, chargeValue))
+}
+
+func testAccCustomLineItemConfig_percentage(rName string, percentageValue int) string {
+	return acctest.ConfigCompose(testAccBillingGroupConfig_basic(rName), fmt.Sprintf(`
+resource "aws_billingconductor_custom_line_item" "test" {
+  name              = %[1]q
+  description       = %[1]q
+  billing_group_arn = aws_billingconductor_billing_group.test.arn
+
+  charge_details {
+    type = "CREDIT"
+
+    percentage {
+      percentage_value  = %[2]d
+      associated_values = [aws_billingconductor_billing_group.test.arn]
+    }
+
+    line_item_filter {
+      attribute    = "LINE_ITEM_TYPE"
+      match_option = "NOT_EQUAL"
+      values       = ["SAVINGS_PLAN_NEGATION"]
+    }
+  }
+}
+`, rName, percentageValue))
+}
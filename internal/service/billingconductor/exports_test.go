@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billingconductor
+
+// Exports for use in tests only.
+var (
+	ResourceBillingGroup   = resourceBillingGroup
+	ResourceCustomLineItem = resourceCustomLineItem
+	ResourcePricingPlan    = resourcePricingPlan
+	ResourcePricingRule    = resourcePricingRule
+
+	FindBillingGroupByARN   = findBillingGroupByARN
+	FindCustomLineItemByARN = findCustomLineItemByARN
+	FindPricingPlanByARN    = findPricingPlanByARN
+	FindPricingRuleByARN    = findPricingRuleByARN
+)
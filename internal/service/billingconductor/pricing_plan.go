@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billingconductor
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/billingconductor"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_billingconductor_pricing_plan", name="Pricing Plan")
+// @Tags(identifierAttribute="arn")
+func resourcePricingPlan() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePricingPlanCreate,
+		ReadWithoutTimeout:   resourcePricingPlanRead,
+		UpdateWithoutTimeout: resourcePricingPlanUpdate,
+		DeleteWithoutTimeout: resourcePricingPlanDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_+=.@-]+$`), "must contain only alphanumeric characters and _+=.@-"),
+				),
+			},
+			"pricing_rule_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			names.AttrSize: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePricingPlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BillingConductorConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &billingconductor.CreatePricingPlanInput{
+		Name: aws.String(name),
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("pricing_rule_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.PricingRuleArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	output, err := conn.CreatePricingPlanWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Billing Conductor Pricing Plan (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	return append(diags, resourcePricingPlanRead(ctx, d, meta)...)
+}
+
+func resourcePricingPlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BillingConductorConn(ctx)
+
+	plan, err := findPricingPlanByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Billing Conductor Pricing Plan (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Billing Conductor Pricing Plan (%s): %s", d.Id(), err)
+	}
+
+	ruleARNs, err := findPricingRuleARNsByPricingPlanARN(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Billing Conductor Pricing Plan (%s) pricing rules: %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, plan.Arn)
+	d.Set(names.AttrDescription, plan.Description)
+	d.Set(names.AttrName, plan.Name)
+	d.Set("pricing_rule_arns", aws.StringValueSlice(ruleARNs))
+	d.Set(names.AttrSize, plan.Size)
+
+	return diags
+}
+
+func resourcePricingPlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BillingConductorConn(ctx)
+
+	if d.HasChanges(names.AttrDescription, names.AttrName) {
+		input := &billingconductor.UpdatePricingPlanInput{
+			Arn:         aws.String(d.Id()),
+			Description: aws.String(d.Get(names.AttrDescription).(string)),
+			Name:        aws.String(d.Get(names.AttrName).(string)),
+		}
+
+		_, err := conn.UpdatePricingPlanWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Billing Conductor Pricing Plan (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("pricing_rule_arns") {
+		o, n := d.GetChange("pricing_rule_arns")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if del := os.Difference(ns); del.Len() > 0 {
+			input := &billingconductor.DisassociatePricingRulesInput{
+				Arn:             aws.String(d.Id()),
+				PricingRuleArns: flex.ExpandStringSet(del),
+			}
+
+			_, err := conn.DisassociatePricingRulesWithContext(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "disassociating Billing Conductor Pricing Plan (%s) pricing rules: %s", d.Id(), err)
+			}
+		}
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			input := &billingconductor.AssociatePricingRulesInput{
+				Arn:             aws.String(d.Id()),
+				PricingRuleArns: flex.ExpandStringSet(add),
+			}
+
+			_, err := conn.AssociatePricingRulesWithContext(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "associating Billing Conductor Pricing Plan (%s) pricing rules: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourcePricingPlanRead(ctx, d, meta)...)
+}
+
+func resourcePricingPlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BillingConductorConn(ctx)
+
+	log.Printf("[INFO] Deleting Billing Conductor Pricing Plan: %s", d.Id())
+	_, err := conn.DeletePricingPlanWithContext(ctx, &billingconductor.DeletePricingPlanInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, billingconductor.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Billing Conductor Pricing Plan (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findPricingPlanByARN(ctx context.Context, conn *billingconductor.BillingConductor, arn string) (*billingconductor.PricingPlanListElement, error) {
+	input := &billingconductor.ListPricingPlansInput{
+		Filters: &billingconductor.ListPricingPlansFilter{
+			Arns: aws.StringSlice([]string{arn}),
+		},
+	}
+
+	output, err := findPricingPlans(ctx, conn, input, tfslices.PredicateTrue[*billingconductor.PricingPlanListElement]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findPricingPlans(ctx context.Context, conn *billingconductor.BillingConductor, input *billingconductor.ListPricingPlansInput, filter tfslices.Predicate[*billingconductor.PricingPlanListElement]) ([]*billingconductor.PricingPlanListElement, error) {
+	var output []*billingconductor.PricingPlanListElement
+
+	err := conn.ListPricingPlansPagesWithContext(ctx, input, func(page *billingconductor.ListPricingPlansOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PricingPlans {
+			if v != nil && filter(v) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, billingconductor.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findPricingRuleARNsByPricingPlanARN(ctx context.Context, conn *billingconductor.BillingConductor, arn string) ([]*string, error) {
+	input := &billingconductor.ListPricingRulesAssociatedToPricingPlanInput{
+		PricingPlanArn: aws.String(arn),
+	}
+	var output []*string
+
+	err := conn.ListPricingRulesAssociatedToPricingPlanPagesWithContext(ctx, input, func(page *billingconductor.ListPricingRulesAssociatedToPricingPlanOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.PricingRuleArns...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, billingconductor.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
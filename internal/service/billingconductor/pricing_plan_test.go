@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billingconductor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/billingconductor"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbillingconductor "github.com/hashicorp/terraform-provider-aws/internal/service/billingconductor"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBillingConductorPricingPlan_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v billingconductor.PricingPlanListElement
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_billingconductor_pricing_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, billingconductor.EndpointsID)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BillingConductorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPricingPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPricingPlanConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPricingPlanExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrGlobalARN(resourceName, names.AttrARN, "billingconductor", regexache.MustCompile(`pricingplan/.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "pricing_rule_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBillingConductorPricingPlan_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v billingconductor.PricingPlanListElement
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_billingconductor_pricing_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, billingconductor.EndpointsID)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BillingConductorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPricingPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPricingPlanConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPricingPlanExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfbillingconductor.ResourcePricingPlan(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBillingConductorPricingPlan_pricingRules(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v billingconductor.PricingPlanListElement
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_billingconductor_pricing_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, billingconductor.EndpointsID)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BillingConductorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPricingPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPricingPlanConfig_pricingRules(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPricingPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "pricing_rule_arns.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "pricing_rule_arns.*", "aws_billingconductor_pricing_rule.test.0", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrSize, acctest.CtOne),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPricingPlanConfig_pricingRules(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPricingPlanExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "pricing_rule_arns.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "pricing_rule_arns.*", "aws_billingconductor_pricing_rule.test.0", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "pricing_rule_arns.*", "aws_billingconductor_pricing_rule.test.1", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrSize, "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPricingPlanDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BillingConductorConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_billingconductor_pricing_plan" {
+				continue
+			}
+
+			_, err := tfbillingconductor.FindPricingPlanByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Billing Conductor Pricing Plan %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPricingPlanExists(ctx context.Context, n string, v *billingconductor.PricingPlanListElement) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BillingConductorConn(ctx)
+
+		output, err := tfbillingconductor.FindPricingPlanByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPricingPlanConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_billingconductor_pricing_plan" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccPricingPlanConfig_pricingRules(rName string, ruleCount int) string {
+	return fmt.Sprintf(`
+resource "aws_billingconductor_pricing_rule" "test" {
+  count = 2
+
+  name                = "%[1]s-${count.index}"
+  scope               = "GLOBAL"
+  type                = "MARKUP"
+  modifier_percentage = 10
+}
+
+resource "aws_billingconductor_pricing_plan" "test" {
+  name              = %[1]q
+  pricing_rule_arns = slice(aws_billingconductor_pricing_rule.test[*].arn, 0, %[2]d)
+}
+`, rName, ruleCount)
+}
@@ -0,0 +1,371 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billingconductor
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/billingconductor"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_billingconductor_pricing_rule", name="Pricing Rule")
+// @Tags(identifierAttribute="arn")
+func resourcePricingRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePricingRuleCreate,
+		ReadWithoutTimeout:   resourcePricingRuleRead,
+		UpdateWithoutTimeout: resourcePricingRuleUpdate,
+		DeleteWithoutTimeout: resourcePricingRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associated_pricing_plan_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"billing_entity": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"modifier_percentage": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_+=.@-]+$`), "must contain only alphanumeric characters and _+=.@-"),
+				),
+			},
+			"operation": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrScope: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(billingconductor.PricingRuleScope_Values(), false),
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"tiering": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"free_tier": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"activated": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(billingconductor.PricingRuleType_Values(), false),
+			},
+			"usage_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePricingRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BillingConductorConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &billingconductor.CreatePricingRuleInput{
+		Name:  aws.String(name),
+		Scope: aws.String(d.Get(names.AttrScope).(string)),
+		Tags:  getTagsIn(ctx),
+		Type:  aws.String(d.Get(names.AttrType).(string)),
+	}
+
+	if v, ok := d.GetOk("billing_entity"); ok {
+		input.BillingEntity = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("modifier_percentage"); ok {
+		input.ModifierPercentage = aws.Float64(v.(float64))
+	}
+
+	if v, ok := d.GetOk("operation"); ok {
+		input.Operation = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("service"); ok {
+		input.Service = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tiering"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Tiering = expandCreateTieringInput(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("usage_type"); ok {
+		input.UsageType = aws.String(v.(string))
+	}
+
+	output, err := conn.CreatePricingRuleWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Billing Conductor Pricing Rule (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	return append(diags, resourcePricingRuleRead(ctx, d, meta)...)
+}
+
+func resourcePricingRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BillingConductorConn(ctx)
+
+	rule, err := findPricingRuleByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Billing Conductor Pricing Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Billing Conductor Pricing Rule (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, rule.Arn)
+	d.Set("associated_pricing_plan_count", rule.AssociatedPricingPlanCount)
+	d.Set("billing_entity", rule.BillingEntity)
+	d.Set(names.AttrDescription, rule.Description)
+	d.Set("modifier_percentage", rule.ModifierPercentage)
+	d.Set(names.AttrName, rule.Name)
+	d.Set("operation", rule.Operation)
+	d.Set(names.AttrScope, rule.Scope)
+	d.Set("service", rule.Service)
+	if rule.Tiering != nil {
+		if err := d.Set("tiering", []interface{}{flattenTiering(rule.Tiering)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting tiering: %s", err)
+		}
+	} else {
+		d.Set("tiering", nil)
+	}
+	d.Set(names.AttrType, rule.Type)
+	d.Set("usage_type", rule.UsageType)
+
+	return diags
+}
+
+func resourcePricingRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BillingConductorConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &billingconductor.UpdatePricingRuleInput{
+			Arn: aws.String(d.Id()),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange("modifier_percentage") {
+			input.ModifierPercentage = aws.Float64(d.Get("modifier_percentage").(float64))
+		}
+
+		if d.HasChange(names.AttrName) {
+			input.Name = aws.String(d.Get(names.AttrName).(string))
+		}
+
+		if d.HasChange("tiering") {
+			if v, ok := d.GetOk("tiering"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Tiering = expandUpdateTieringInput(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange(names.AttrType) {
+			input.Type = aws.String(d.Get(names.AttrType).(string))
+		}
+
+		_, err := conn.UpdatePricingRuleWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Billing Conductor Pricing Rule (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePricingRuleRead(ctx, d, meta)...)
+}
+
+func resourcePricingRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BillingConductorConn(ctx)
+
+	log.Printf("[INFO] Deleting Billing Conductor Pricing Rule: %s", d.Id())
+	_, err := conn.DeletePricingRuleWithContext(ctx, &billingconductor.DeletePricingRuleInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, billingconductor.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Billing Conductor Pricing Rule (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findPricingRuleByARN(ctx context.Context, conn *billingconductor.BillingConductor, arn string) (*billingconductor.PricingRuleListElement, error) {
+	input := &billingconductor.ListPricingRulesInput{
+		Filters: &billingconductor.ListPricingRulesFilter{
+			Arns: aws.StringSlice([]string{arn}),
+		},
+	}
+
+	output, err := findPricingRules(ctx, conn, input, tfslices.PredicateTrue[*billingconductor.PricingRuleListElement]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findPricingRules(ctx context.Context, conn *billingconductor.BillingConductor, input *billingconductor.ListPricingRulesInput, filter tfslices.Predicate[*billingconductor.PricingRuleListElement]) ([]*billingconductor.PricingRuleListElement, error) {
+	var output []*billingconductor.PricingRuleListElement
+
+	err := conn.ListPricingRulesPagesWithContext(ctx, input, func(page *billingconductor.ListPricingRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PricingRules {
+			if v != nil && filter(v) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, billingconductor.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func expandCreateTieringInput(tfMap map[string]interface{}) *billingconductor.CreateTieringInput_ {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &billingconductor.CreateTieringInput_{}
+
+	if v, ok := tfMap["free_tier"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FreeTier = &billingconductor.CreateFreeTierConfig{
+			Activated: aws.Bool(v[0].(map[string]interface{})["activated"].(bool)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandUpdateTieringInput(tfMap map[string]interface{}) *billingconductor.UpdateTieringInput_ {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &billingconductor.UpdateTieringInput_{}
+
+	if v, ok := tfMap["free_tier"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FreeTier = &billingconductor.UpdateFreeTierConfig{
+			Activated: aws.Bool(v[0].(map[string]interface{})["activated"].(bool)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenTiering(apiObject *billingconductor.Tiering) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.FreeTier; v != nil {
+		tfMap["free_tier"] = []interface{}{map[string]interface{}{
+			"activated": aws.BoolValue(v.Activated),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billingconductor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/billingconductor"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbillingconductor "github.com/hashicorp/terraform-provider-aws/internal/service/billingconductor"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBillingConductorPricingRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v billingconductor.PricingRuleListElement
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_billingconductor_pricing_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, billingconductor.EndpointsID)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BillingConductorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPricingRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPricingRuleConfig_basic(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPricingRuleExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrGlobalARN(resourceName, names.AttrARN, "billingconductor", regexache.MustCompile(`pricingrule/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "modifier_percentage", "10"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrScope, "GLOBAL"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tiering.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "MARKUP"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPricingRuleConfig_basic(rName, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPricingRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "modifier_percentage", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBillingConductorPricingRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v billingconductor.PricingRuleListElement
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_billingconductor_pricing_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, billingconductor.EndpointsID)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BillingConductorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPricingRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPricingRuleConfig_basic(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPricingRuleExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfbillingconductor.ResourcePricingRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBillingConductorPricingRule_tiering(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v billingconductor.PricingRuleListElement
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_billingconductor_pricing_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, billingconductor.EndpointsID)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BillingConductorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPricingRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPricingRuleConfig_tiering(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPricingRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrScope, "GLOBAL"),
+					resource.TestCheckResourceAttr(resourceName, "tiering.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tiering.0.free_tier.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tiering.0.free_tier.0.activated", "false"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "TIERING"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPricingRuleConfig_tiering(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPricingRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tiering.0.free_tier.0.activated", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPricingRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BillingConductorConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_billingconductor_pricing_rule" {
+				continue
+			}
+
+			_, err := tfbillingconductor.FindPricingRuleByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Billing Conductor Pricing Rule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPricingRuleExists(ctx context.Context, n string, v *billingconductor.PricingRuleListElement) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BillingConductorConn(ctx)
+
+		output, err := tfbillingconductor.FindPricingRuleByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPricingRuleConfig_basic(rName string, modifierPercentage int) string {
+	return fmt.Sprintf(`
+resource "aws_billingconductor_pricing_rule" "test" {
+  name                = %[1]q
+  scope               = "GLOBAL"
+  type                = "MARKUP"
+  modifier_percentage = %[2]d
+}
+`, rName, modifierPercentage)
+}
+
+func testAccPricingRuleConfig_tiering(rName string, activated bool) string {
+	return fmt.Sprintf(`
+resource "aws_billingconductor_pricing_rule" "test" {
+  name  = %[1]q
+  scope = "GLOBAL"
+  type  = "TIERING"
+
+  tiering {
+    free_tier {
+      activated = %[2]t
+    }
+  }
+}
+`, rName, activated)
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package braket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/service/braket"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Device")
+func newDeviceDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &deviceDataSource{}, nil
+}
+
+type deviceDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *deviceDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_braket_device"
+}
+
+func (d *deviceDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"device_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"device_capabilities": schema.StringAttribute{
+				Computed: true,
+			},
+			"device_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"device_status": schema.StringAttribute{
+				Computed: true,
+			},
+			"device_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"execution_windows": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[executionWindowModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[executionWindowModel](ctx),
+			},
+			names.AttrID: framework.IDAttribute(),
+			"max_shots": schema.Int64Attribute{
+				Computed: true,
+			},
+			"min_shots": schema.Int64Attribute{
+				Computed: true,
+			},
+			"provider_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"queue_info": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[deviceQueueInfoModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[deviceQueueInfoModel](ctx),
+			},
+		},
+	}
+}
+
+func (d *deviceDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data deviceDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().BraketConn(ctx)
+
+	output, err := findDeviceByARN(ctx, conn, data.DeviceARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Braket Device (%s)", data.DeviceARN.ValueString()), err.Error())
+
+		return
+	}
+
+	capabilities, err := protocol.EncodeJSONValue(output.DeviceCapabilities, protocol.NoEscape)
+
+	if err != nil {
+		response.Diagnostics.AddError("encoding Braket Device capabilities", err.Error())
+
+		return
+	}
+
+	data.DeviceCapabilities = types.StringValue(capabilities)
+	data.DeviceName = fwflex.StringToFramework(ctx, output.DeviceName)
+	data.DeviceStatus = fwflex.StringToFramework(ctx, output.DeviceStatus)
+	data.DeviceType = fwflex.StringToFramework(ctx, output.DeviceType)
+	data.ID = data.DeviceARN.StringValue
+	data.ProviderName = fwflex.StringToFramework(ctx, output.ProviderName)
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.DeviceQueueInfo, &data.QueueInfo)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Availability windows and shot limits are only published as part of the device capabilities document.
+	service, _ := output.DeviceCapabilities["service"].(map[string]interface{})
+
+	var windows []executionWindowModel
+	if tfList, ok := service["executionWindows"].([]interface{}); ok {
+		for _, tfMapRaw := range tfList {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			window := executionWindowModel{
+				ExecutionDay:    types.StringNull(),
+				WindowEndHour:   types.StringNull(),
+				WindowStartHour: types.StringNull(),
+			}
+			if v, ok := tfMap["executionDay"].(string); ok {
+				window.ExecutionDay = types.StringValue(v)
+			}
+			if v, ok := tfMap["windowEndHour"].(string); ok {
+				window.WindowEndHour = types.StringValue(v)
+			}
+			if v, ok := tfMap["windowStartHour"].(string); ok {
+				window.WindowStartHour = types.StringValue(v)
+			}
+
+			windows = append(windows, window)
+		}
+	}
+	data.ExecutionWindows = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, windows)
+
+	data.MaxShots = types.Int64Null()
+	data.MinShots = types.Int64Null()
+	if tfList, ok := service["shotsRange"].([]interface{}); ok && len(tfList) == 2 {
+		if v, ok := tfList[0].(float64); ok {
+			data.MinShots = types.Int64Value(int64(v))
+		}
+		if v, ok := tfList[1].(float64); ok {
+			data.MaxShots = types.Int64Value(int64(v))
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findDeviceByARN(ctx context.Context, conn *braket.Braket, arn string) (*braket.GetDeviceOutput, error) {
+	input := &braket.GetDeviceInput{
+		DeviceArn: aws.String(arn),
+	}
+
+	output, err := conn.GetDeviceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, braket.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type deviceDataSourceModel struct {
+	DeviceARN          fwtypes.ARN                                           `tfsdk:"device_arn"`
+	DeviceCapabilities types.String                                          `tfsdk:"device_capabilities"`
+	DeviceName         types.String                                          `tfsdk:"device_name"`
+	DeviceStatus       types.String                                          `tfsdk:"device_status"`
+	DeviceType         types.String                                          `tfsdk:"device_type"`
+	ExecutionWindows   fwtypes.ListNestedObjectValueOf[executionWindowModel] `tfsdk:"execution_windows"`
+	ID                 types.String                                          `tfsdk:"id"`
+	MaxShots           types.Int64                                           `tfsdk:"max_shots"`
+	MinShots           types.Int64                                           `tfsdk:"min_shots"`
+	ProviderName       types.String                                          `tfsdk:"provider_name"`
+	QueueInfo          fwtypes.ListNestedObjectValueOf[deviceQueueInfoModel] `tfsdk:"queue_info"`
+}
+
+type deviceQueueInfoModel struct {
+	Queue         types.String `tfsdk:"queue"`
+	QueuePriority types.String `tfsdk:"queue_priority"`
+	QueueSize     types.String `tfsdk:"queue_size"`
+}
+
+type executionWindowModel struct {
+	ExecutionDay    types.String `tfsdk:"execution_day"`
+	WindowEndHour   types.String `tfsdk:"window_end_hour"`
+	WindowStartHour types.String `tfsdk:"window_start_hour"`
+}
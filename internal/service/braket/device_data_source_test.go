@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package braket_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/braket"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBraketDeviceDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_braket_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, braket.EndpointsID)
+			acctest.PreCheckRegion(t, names.USEast1RegionID, names.USWest2RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BraketServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "device_capabilities"),
+					resource.TestCheckResourceAttr(dataSourceName, "device_name", "SV1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "device_status"),
+					resource.TestCheckResourceAttr(dataSourceName, "device_type", braket.DeviceTypeSimulator),
+					resource.TestCheckResourceAttrSet(dataSourceName, "execution_windows.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "max_shots"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "min_shots"),
+					resource.TestCheckResourceAttr(dataSourceName, "provider_name", "Amazon Braket"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "queue_info.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDeviceDataSourceConfig_basic = `
+data "aws_braket_device" "test" {
+  device_arn = "arn:aws:braket:::device/quantum-simulator/amazon/sv1"
+}
+`
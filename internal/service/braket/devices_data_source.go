@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package braket
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/braket"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Devices")
+func newDevicesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &devicesDataSource{}, nil
+}
+
+type devicesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *devicesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_braket_devices"
+}
+
+func (d *devicesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"device_status": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(braket.DeviceStatus_Values()...),
+				},
+			},
+			"device_type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(braket.DeviceType_Values()...),
+				},
+			},
+			"devices": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[deviceSummaryModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[deviceSummaryModel](ctx),
+			},
+			names.AttrID: framework.IDAttribute(),
+			"provider_name": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *devicesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data devicesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().BraketConn(ctx)
+
+	input := &braket.SearchDevicesInput{
+		Filters: []*braket.SearchDevicesFilter{},
+	}
+	for name, v := range map[string]types.String{
+		"deviceStatus": data.DeviceStatus,
+		"deviceType":   data.DeviceType,
+		"providerName": data.ProviderName,
+	} {
+		if !v.IsNull() {
+			input.Filters = append(input.Filters, &braket.SearchDevicesFilter{
+				Name:   aws.String(name),
+				Values: aws.StringSlice([]string{v.ValueString()}),
+			})
+		}
+	}
+
+	devices, err := findDevices(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Braket Devices", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, devices, &data.Devices)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(d.Meta().Region)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findDevices(ctx context.Context, conn *braket.Braket, input *braket.SearchDevicesInput) ([]*braket.DeviceSummary, error) {
+	var output []*braket.DeviceSummary
+
+	err := conn.SearchDevicesPagesWithContext(ctx, input, func(page *braket.SearchDevicesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Devices {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+type devicesDataSourceModel struct {
+	DeviceStatus types.String                                        `tfsdk:"device_status"`
+	DeviceType   types.String                                        `tfsdk:"device_type"`
+	Devices      fwtypes.ListNestedObjectValueOf[deviceSummaryModel] `tfsdk:"devices"`
+	ID           types.String                                        `tfsdk:"id"`
+	ProviderName types.String                                        `tfsdk:"provider_name"`
+}
+
+type deviceSummaryModel struct {
+	DeviceARN    types.String `tfsdk:"device_arn"`
+	DeviceName   types.String `tfsdk:"device_name"`
+	DeviceStatus types.String `tfsdk:"device_status"`
+	DeviceType   types.String `tfsdk:"device_type"`
+	ProviderName types.String `tfsdk:"provider_name"`
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package braket_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/braket"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBraketDevicesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_braket_devices.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, braket.EndpointsID)
+			acctest.PreCheckRegion(t, names.USEast1RegionID, names.USWest2RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BraketServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDevicesDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "devices.#", 0),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "devices.*", map[string]string{
+						"device_arn":  "arn:aws:braket:::device/quantum-simulator/amazon/sv1",
+						"device_type": braket.DeviceTypeSimulator,
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccDevicesDataSourceConfig_basic = `
+data "aws_braket_devices" "test" {
+  device_type   = "SIMULATOR"
+  provider_name = "Amazon Braket"
+}
+`
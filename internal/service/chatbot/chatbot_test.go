@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Slack channel configurations need a Slack workspace that has already been authorized
+// in the AWS Chatbot console, so the tests share one workspace and channel and run serially.
+func TestAccChatbot_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"SlackChannelConfiguration": {
+			"basic":         testAccSlackChannelConfiguration_basic,
+			"disappears":    testAccSlackChannelConfiguration_disappears,
+			"update":        testAccSlackChannelConfiguration_update,
+			"customActions": testAccSlackChannelConfiguration_customActions,
+			names.AttrTags:  testAccSlackChannelConfiguration_tags,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
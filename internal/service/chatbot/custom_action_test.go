@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfchatbot "github.com/hashicorp/terraform-provider-aws/internal/service/chatbot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccChatbotCustomAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_custom_action.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomActionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomActionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action_name", rName),
+					resource.TestCheckNoResourceAttr(resourceName, "alias_name"),
+					resource.TestCheckResourceAttr(resourceName, "attachment.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "custom_action_arn"),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.command_text", "cloudwatch describe-alarms"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccChatbotCustomAction_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_custom_action.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomActionExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfchatbot.ResourceCustomAction, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccChatbotCustomAction_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_custom_action.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomActionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomActionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.command_text", "cloudwatch describe-alarms"),
+				),
+			},
+			{
+				Config: testAccCustomActionConfig_attachment(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomActionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alias_name", "alarms"),
+					resource.TestCheckResourceAttr(resourceName, "attachment.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "attachment.0.button_text", "Describe alarm"),
+					resource.TestCheckResourceAttr(resourceName, "attachment.0.criteria.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "attachment.0.criteria.0.operator", "HAS_VALUE"),
+					resource.TestCheckResourceAttr(resourceName, "attachment.0.criteria.0.variable_name", "AlarmName"),
+					resource.TestCheckResourceAttr(resourceName, "attachment.0.notification_type", "CloudWatch"),
+					resource.TestCheckResourceAttr(resourceName, "attachment.0.variables.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "attachment.0.variables.AlarmName", "event.detail.alarmName"),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.command_text", "cloudwatch describe-alarms --alarm-names $AlarmName"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCustomActionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_chatbot_custom_action" {
+				continue
+			}
+
+			_, err := tfchatbot.FindCustomActionByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Chatbot Custom Action %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCustomActionExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotClient(ctx)
+
+		_, err := tfchatbot.FindCustomActionByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCustomActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_chatbot_custom_action" "test" {
+  action_name = %[1]q
+
+  definition {
+    command_text = "cloudwatch describe-alarms"
+  }
+}
+`, rName)
+}
+
+func testAccCustomActionConfig_attachment(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_chatbot_custom_action" "test" {
+  action_name = %[1]q
+  alias_name  = "alarms"
+
+  attachment {
+    button_text       = "Describe alarm"
+    notification_type = "CloudWatch"
+
+    criteria {
+      operator      = "HAS_VALUE"
+      variable_name = "AlarmName"
+    }
+
+    variables = {
+      AlarmName = "event.detail.alarmName"
+    }
+  }
+
+  definition {
+    command_text = "cloudwatch describe-alarms --alarm-names $AlarmName"
+  }
+}
+`, rName)
+}
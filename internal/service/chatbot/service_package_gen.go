@@ -17,7 +17,22 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newCustomActionResource,
+			Name:    "Custom Action",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "custom_action_arn",
+			},
+		},
+		{
+			Factory: newSlackChannelConfigurationResource,
+			Name:    "Slack Channel Configuration",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "chat_configuration_arn",
+			},
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
@@ -0,0 +1,591 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/chatbot"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chatbot/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Slack Channel Configuration")
+// @Tags(identifierAttribute="chat_configuration_arn")
+func newSlackChannelConfigurationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &slackChannelConfigurationResource{}
+
+	r.SetDefaultCreateTimeout(20 * time.Minute)
+	r.SetDefaultUpdateTimeout(20 * time.Minute)
+	r.SetDefaultDeleteTimeout(20 * time.Minute)
+
+	return r, nil
+}
+
+type slackChannelConfigurationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*slackChannelConfigurationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_chatbot_slack_channel_configuration"
+}
+
+func (r *slackChannelConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"chat_configuration_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"configuration_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_action_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"guardrail_policy_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"iam_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"logging_level": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Values[loggingLevel]()...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"slack_channel_id": schema.StringAttribute{
+				Required: true,
+			},
+			"slack_channel_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"slack_team_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"slack_team_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sns_topic_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"user_authorization_required": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *slackChannelConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data slackChannelConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ChatbotClient(ctx)
+
+	input := &chatbot.CreateSlackChannelConfigurationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateSlackChannelConfiguration(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Chatbot Slack Channel Configuration (%s)", data.ConfigurationName.ValueString()), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.ChannelConfiguration.ChatConfigurationArn)
+	data.ChatConfigurationARN = fwflex.StringValueToFramework(ctx, arn)
+	data.setID()
+
+	channelConfiguration, err := waitSlackChannelConfigurationAvailable(ctx, conn, arn, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Chatbot Slack Channel Configuration (%s) create", arn), err.Error())
+
+		return
+	}
+
+	if !data.CustomActionARNs.IsUnknown() {
+		for _, v := range fwflex.ExpandFrameworkStringValueSet(ctx, data.CustomActionARNs) {
+			if err := associateToConfiguration(ctx, conn, arn, v); err != nil {
+				response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+				response.Diagnostics.AddError(fmt.Sprintf("associating Chatbot Slack Channel Configuration (%s) custom action (%s)", arn, v), err.Error())
+
+				return
+			}
+		}
+	}
+
+	associations, err := findAssociationsByConfigurationARN(ctx, conn, arn)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Chatbot Slack Channel Configuration (%s) associations", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, channelConfiguration, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(fwflex.Flatten(ctx, associations, &data.CustomActionARNs)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *slackChannelConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data slackChannelConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().ChatbotClient(ctx)
+
+	arn := data.ChatConfigurationARN.ValueString()
+	output, err := findSlackChannelConfigurationByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Chatbot Slack Channel Configuration (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	associations, err := findAssociationsByConfigurationARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Chatbot Slack Channel Configuration (%s) associations", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, associations, &data.CustomActionARNs)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *slackChannelConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new slackChannelConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ChatbotClient(ctx)
+
+	arn := new.ChatConfigurationARN.ValueString()
+
+	// Guardrail policies and SNS topics are updated in place so that the channel keeps its history.
+	if !new.GuardrailPolicyARNs.Equal(old.GuardrailPolicyARNs) ||
+		!new.IAMRoleARN.Equal(old.IAMRoleARN) ||
+		!new.LoggingLevel.Equal(old.LoggingLevel) ||
+		!new.SlackChannelID.Equal(old.SlackChannelID) ||
+		!new.SlackChannelName.Equal(old.SlackChannelName) ||
+		!new.SNSTopicARNs.Equal(old.SNSTopicARNs) ||
+		!new.UserAuthorizationRequired.Equal(old.UserAuthorizationRequired) {
+		input := &chatbot.UpdateSlackChannelConfigurationInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateSlackChannelConfiguration(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Chatbot Slack Channel Configuration (%s)", arn), err.Error())
+
+			return
+		}
+
+		output, err := waitSlackChannelConfigurationAvailable(ctx, conn, arn, r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Chatbot Slack Channel Configuration (%s) update", arn), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !new.CustomActionARNs.IsUnknown() && !new.CustomActionARNs.Equal(old.CustomActionARNs) {
+		o, n := fwflex.ExpandFrameworkStringValueSet(ctx, old.CustomActionARNs), fwflex.ExpandFrameworkStringValueSet(ctx, new.CustomActionARNs)
+
+		for _, v := range o.Difference(n) {
+			if err := disassociateFromConfiguration(ctx, conn, arn, v); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("disassociating Chatbot Slack Channel Configuration (%s) custom action (%s)", arn, v), err.Error())
+
+				return
+			}
+		}
+
+		for _, v := range n.Difference(o) {
+			if err := associateToConfiguration(ctx, conn, arn, v); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("associating Chatbot Slack Channel Configuration (%s) custom action (%s)", arn, v), err.Error())
+
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *slackChannelConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data slackChannelConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ChatbotClient(ctx)
+
+	arn := data.ChatConfigurationARN.ValueString()
+	_, err := conn.DeleteSlackChannelConfiguration(ctx, &chatbot.DeleteSlackChannelConfigurationInput{
+		ChatConfigurationArn: aws.String(arn),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Chatbot Slack Channel Configuration (%s)", arn), err.Error())
+
+		return
+	}
+
+	if _, err := waitSlackChannelConfigurationDeleted(ctx, conn, arn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Chatbot Slack Channel Configuration (%s) delete", arn), err.Error())
+
+		return
+	}
+}
+
+func (r *slackChannelConfigurationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findSlackChannelConfigurationByARN(ctx context.Context, conn *chatbot.Client, arn string) (*awstypes.SlackChannelConfiguration, error) {
+	input := &chatbot.DescribeSlackChannelConfigurationsInput{
+		ChatConfigurationArn: aws.String(arn),
+	}
+
+	return findSlackChannelConfiguration(ctx, conn, input)
+}
+
+func findSlackChannelConfiguration(ctx context.Context, conn *chatbot.Client, input *chatbot.DescribeSlackChannelConfigurationsInput) (*awstypes.SlackChannelConfiguration, error) {
+	output, err := findSlackChannelConfigurations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findSlackChannelConfigurations(ctx context.Context, conn *chatbot.Client, input *chatbot.DescribeSlackChannelConfigurationsInput) ([]awstypes.SlackChannelConfiguration, error) {
+	var output []awstypes.SlackChannelConfiguration
+
+	pages := chatbot.NewDescribeSlackChannelConfigurationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.SlackChannelConfigurations...)
+	}
+
+	return output, nil
+}
+
+// findAssociationsByConfigurationARN returns the ARNs of the resources, such as custom actions, associated with a channel configuration.
+func findAssociationsByConfigurationARN(ctx context.Context, conn *chatbot.Client, arn string) ([]string, error) {
+	input := &chatbot.ListAssociationsInput{
+		ChatConfiguration: aws.String(arn),
+	}
+	var output []string
+
+	pages := chatbot.NewListAssociationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Associations {
+			output = append(output, aws.ToString(v.Resource))
+		}
+	}
+
+	return output, nil
+}
+
+func associateToConfiguration(ctx context.Context, conn *chatbot.Client, configurationARN, resourceARN string) error {
+	_, err := conn.AssociateToConfiguration(ctx, &chatbot.AssociateToConfigurationInput{
+		ChatConfiguration: aws.String(configurationARN),
+		Resource:          aws.String(resourceARN),
+	})
+
+	return err
+}
+
+func disassociateFromConfiguration(ctx context.Context, conn *chatbot.Client, configurationARN, resourceARN string) error {
+	_, err := conn.DisassociateFromConfiguration(ctx, &chatbot.DisassociateFromConfigurationInput{
+		ChatConfiguration: aws.String(configurationARN),
+		Resource:          aws.String(resourceARN),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
+const (
+	slackChannelConfigurationStatusAvailable = "available"
+)
+
+func statusSlackChannelConfiguration(ctx context.Context, conn *chatbot.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSlackChannelConfigurationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, slackChannelConfigurationStatusAvailable, nil
+	}
+}
+
+func waitSlackChannelConfigurationAvailable(ctx context.Context, conn *chatbot.Client, arn string, timeout time.Duration) (*awstypes.SlackChannelConfiguration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{},
+		Target:                    []string{slackChannelConfigurationStatusAvailable},
+		Refresh:                   statusSlackChannelConfiguration(ctx, conn, arn),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SlackChannelConfiguration); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSlackChannelConfigurationDeleted(ctx context.Context, conn *chatbot.Client, arn string, timeout time.Duration) (*awstypes.SlackChannelConfiguration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{slackChannelConfigurationStatusAvailable},
+		Target:  []string{},
+		Refresh: statusSlackChannelConfiguration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SlackChannelConfiguration); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type loggingLevel string
+
+const (
+	loggingLevelError loggingLevel = "ERROR"
+	loggingLevelInfo  loggingLevel = "INFO"
+	loggingLevelNone  loggingLevel = "NONE"
+)
+
+func (loggingLevel) Values() []loggingLevel {
+	return []loggingLevel{
+		loggingLevelError,
+		loggingLevelInfo,
+		loggingLevelNone,
+	}
+}
+
+type slackChannelConfigurationResourceModel struct {
+	ChatConfigurationARN      types.String                     `tfsdk:"chat_configuration_arn"`
+	ConfigurationName         types.String                     `tfsdk:"configuration_name"`
+	CustomActionARNs          fwtypes.SetValueOf[types.String] `tfsdk:"custom_action_arns"`
+	GuardrailPolicyARNs       fwtypes.SetValueOf[types.String] `tfsdk:"guardrail_policy_arns"`
+	IAMRoleARN                fwtypes.ARN                      `tfsdk:"iam_role_arn"`
+	ID                        types.String                     `tfsdk:"id"`
+	LoggingLevel              types.String                     `tfsdk:"logging_level"`
+	SlackChannelID            types.String                     `tfsdk:"slack_channel_id"`
+	SlackChannelName          types.String                     `tfsdk:"slack_channel_name"`
+	SlackTeamID               types.String                     `tfsdk:"slack_team_id"`
+	SlackTeamName             types.String                     `tfsdk:"slack_team_name"`
+	SNSTopicARNs              fwtypes.SetValueOf[types.String] `tfsdk:"sns_topic_arns"`
+	State                     types.String                     `tfsdk:"state"`
+	Tags                      types.Map                        `tfsdk:"tags"`
+	TagsAll                   types.Map                        `tfsdk:"tags_all"`
+	Timeouts                  timeouts.Value                   `tfsdk:"timeouts"`
+	UserAuthorizationRequired types.Bool                       `tfsdk:"user_authorization_required"`
+}
+
+func (data *slackChannelConfigurationResourceModel) InitFromID() error {
+	data.ChatConfigurationARN = data.ID
+
+	return nil
+}
+
+func (data *slackChannelConfigurationResourceModel) setID() {
+	data.ID = data.ChatConfigurationARN
+}
@@ -0,0 +1,406 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfchatbot "github.com/hashicorp/terraform-provider-aws/internal/service/chatbot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	envSlackTeamID    = "CHATBOT_SLACK_TEAM_ID"
+	envSlackChannelID = "CHATBOT_SLACK_CHANNEL_ID"
+)
+
+func testAccSlackChannelConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_slack_channel_configuration.test"
+	teamID := acctest.SkipIfEnvVarNotSet(t, envSlackTeamID)
+	channelID := acctest.SkipIfEnvVarNotSet(t, envSlackChannelID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlackChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "chat_configuration_arn", "chatbot", regexache.MustCompile(fmt.Sprintf(`chat-configuration/slack-channel/%s$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "configuration_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "custom_action_arns.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "logging_level", "ERROR"),
+					resource.TestCheckResourceAttr(resourceName, "slack_channel_id", channelID),
+					resource.TestCheckResourceAttrSet(resourceName, "slack_channel_name"),
+					resource.TestCheckResourceAttr(resourceName, "slack_team_id", teamID),
+					resource.TestCheckResourceAttrSet(resourceName, "slack_team_name"),
+					resource.TestCheckResourceAttr(resourceName, "sns_topic_arns.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSlackChannelConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_slack_channel_configuration.test"
+	teamID := acctest.SkipIfEnvVarNotSet(t, envSlackTeamID)
+	channelID := acctest.SkipIfEnvVarNotSet(t, envSlackChannelID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlackChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfchatbot.ResourceSlackChannelConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccSlackChannelConfiguration_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_slack_channel_configuration.test"
+	teamID := acctest.SkipIfEnvVarNotSet(t, envSlackTeamID)
+	channelID := acctest.SkipIfEnvVarNotSet(t, envSlackChannelID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlackChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "guardrail_policy_arns.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "logging_level", "ERROR"),
+					resource.TestCheckResourceAttr(resourceName, "sns_topic_arns.#", acctest.CtOne),
+				),
+			},
+			{
+				Config: testAccSlackChannelConfigurationConfig_updated(rName, teamID, channelID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "guardrail_policy_arns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "logging_level", "INFO"),
+					resource.TestCheckResourceAttr(resourceName, "sns_topic_arns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "user_authorization_required", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSlackChannelConfiguration_customActions(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_slack_channel_configuration.test"
+	teamID := acctest.SkipIfEnvVarNotSet(t, envSlackTeamID)
+	channelID := acctest.SkipIfEnvVarNotSet(t, envSlackChannelID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlackChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_customActions(rName, teamID, channelID, "aws_chatbot_custom_action.test1.custom_action_arn"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_action_arns.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "custom_action_arns.*", "aws_chatbot_custom_action.test1", "custom_action_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSlackChannelConfigurationConfig_customActions(rName, teamID, channelID, "aws_chatbot_custom_action.test1.custom_action_arn", "aws_chatbot_custom_action.test2.custom_action_arn"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_action_arns.#", "2"),
+				),
+			},
+			{
+				Config: testAccSlackChannelConfigurationConfig_customActions(rName, teamID, channelID, "aws_chatbot_custom_action.test2.custom_action_arn"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_action_arns.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "custom_action_arns.*", "aws_chatbot_custom_action.test2", "custom_action_arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSlackChannelConfiguration_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_slack_channel_configuration.test"
+	teamID := acctest.SkipIfEnvVarNotSet(t, envSlackTeamID)
+	channelID := acctest.SkipIfEnvVarNotSet(t, envSlackChannelID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlackChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_tags1(rName, teamID, channelID, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSlackChannelConfigurationConfig_tags2(rName, teamID, channelID, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSlackChannelConfigurationConfig_tags1(rName, teamID, channelID, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSlackChannelConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_chatbot_slack_channel_configuration" {
+				continue
+			}
+
+			_, err := tfchatbot.FindSlackChannelConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Chatbot Slack Channel Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSlackChannelConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotClient(ctx)
+
+		_, err := tfchatbot.FindSlackChannelConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccSlackChannelConfigurationConfig_base(rName string, topicCount int) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "chatbot.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_sns_topic" "test" {
+  count = %[2]d
+
+  name = "%[1]s-${count.index}"
+}
+`, rName, topicCount)
+}
+
+func testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID string) string {
+	return acctest.ConfigCompose(testAccSlackChannelConfigurationConfig_base(rName, 1), fmt.Sprintf(`
+resource "aws_chatbot_slack_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  slack_channel_id   = %[3]q
+  slack_team_id      = %[2]q
+
+  guardrail_policy_arns = ["arn:${data.aws_partition.current.partition}:iam::aws:policy/ReadOnlyAccess"]
+  logging_level         = "ERROR"
+  sns_topic_arns        = aws_sns_topic.test[*].arn
+}
+`, rName, teamID, channelID))
+}
+
+func testAccSlackChannelConfigurationConfig_updated(rName, teamID, channelID string) string {
+	return acctest.ConfigCompose(testAccSlackChannelConfigurationConfig_base(rName, 2), fmt.Sprintf(`
+resource "aws_chatbot_slack_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  slack_channel_id   = %[3]q
+  slack_team_id      = %[2]q
+
+  guardrail_policy_arns = [
+    "arn:${data.aws_partition.current.partition}:iam::aws:policy/ReadOnlyAccess",
+    "arn:${data.aws_partition.current.partition}:iam::aws:policy/CloudWatchReadOnlyAccess",
+  ]
+  logging_level               = "INFO"
+  sns_topic_arns              = aws_sns_topic.test[*].arn
+  user_authorization_required = true
+}
+`, rName, teamID, channelID))
+}
+
+func testAccSlackChannelConfigurationConfig_customActions(rName, teamID, channelID string, customActionARNs ...string) string {
+	return acctest.ConfigCompose(testAccSlackChannelConfigurationConfig_base(rName, 1), fmt.Sprintf(`
+resource "aws_chatbot_custom_action" "test1" {
+  action_name = "%[1]s-1"
+
+  definition {
+    command_text = "cloudwatch describe-alarms"
+  }
+}
+
+resource "aws_chatbot_custom_action" "test2" {
+  action_name = "%[1]s-2"
+
+  definition {
+    command_text = "lambda list-functions"
+  }
+}
+
+resource "aws_chatbot_slack_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  slack_channel_id   = %[3]q
+  slack_team_id      = %[2]q
+
+  custom_action_arns = [%[4]s]
+}
+`, rName, teamID, channelID, strings.Join(customActionARNs, ", ")))
+}
+
+func testAccSlackChannelConfigurationConfig_tags1(rName, teamID, channelID, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccSlackChannelConfigurationConfig_base(rName, 1), fmt.Sprintf(`
+resource "aws_chatbot_slack_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  slack_channel_id   = %[3]q
+  slack_team_id      = %[2]q
+
+  tags = {
+    %[4]q = %[5]q
+  }
+}
+`, rName, teamID, channelID, tagKey1, tagValue1))
+}
+
+func testAccSlackChannelConfigurationConfig_tags2(rName, teamID, channelID, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccSlackChannelConfigurationConfig_base(rName, 1), fmt.Sprintf(`
+resource "aws_chatbot_slack_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  slack_channel_id   = %[3]q
+  slack_team_id      = %[2]q
+
+  tags = {
+    %[4]q = %[5]q
+    %[6]q = %[7]q
+  }
+}
+`, rName, teamID, channelID, tagKey1, tagValue1, tagKey2, tagValue2))
+}
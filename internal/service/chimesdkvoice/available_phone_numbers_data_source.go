@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chimesdkvoice
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/chimesdkvoice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chimesdkvoice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_chimesdkvoice_available_phone_numbers", name="Available Phone Numbers")
+func DataSourceAvailablePhoneNumbers() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAvailablePhoneNumbersRead,
+
+		Schema: map[string]*schema.Schema{
+			"area_code": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"toll_free_prefix"},
+			},
+			"city": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"toll_free_prefix"},
+			},
+			"country": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"e164_phone_numbers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 500),
+			},
+			"phone_number_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.PhoneNumberType](),
+			},
+			"state": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"toll_free_prefix"},
+			},
+			"toll_free_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(3, 3),
+			},
+		},
+	}
+}
+
+func dataSourceAvailablePhoneNumbersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ChimeSDKVoiceClient(ctx)
+
+	input := &chimesdkvoice.SearchAvailablePhoneNumbersInput{}
+
+	if v, ok := d.GetOk("area_code"); ok {
+		input.AreaCode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("city"); ok {
+		input.City = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("country"); ok {
+		input.Country = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("phone_number_type"); ok {
+		input.PhoneNumberType = awstypes.PhoneNumberType(v.(string))
+	}
+
+	if v, ok := d.GetOk("state"); ok {
+		input.State = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("toll_free_prefix"); ok {
+		input.TollFreePrefix = aws.String(v.(string))
+	}
+
+	maxResults := d.Get("max_results").(int)
+	if maxResults > 0 {
+		input.MaxResults = aws.Int32(int32(maxResults))
+	}
+
+	phoneNumbers, err := findAvailablePhoneNumbers(ctx, conn, input, maxResults)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "searching Chime SDK Voice available phone numbers: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("e164_phone_numbers", phoneNumbers)
+
+	return diags
+}
+
+// findAvailablePhoneNumbers returns the available phone numbers matching the input.
+// If limit is positive, at most limit phone numbers are returned.
+func findAvailablePhoneNumbers(ctx context.Context, conn *chimesdkvoice.Client, input *chimesdkvoice.SearchAvailablePhoneNumbersInput, limit int) ([]string, error) {
+	var output []string
+
+	pages := chimesdkvoice.NewSearchAvailablePhoneNumbersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.E164PhoneNumbers...)
+
+		if limit > 0 && len(output) >= limit {
+			return output[:limit], nil
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chimesdkvoice_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccChimeSDKVoiceAvailablePhoneNumbersDataSource_areaCode(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_chimesdkvoice_available_phone_numbers.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, names.USEast1RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChimeSDKVoiceServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAvailablePhoneNumbersDataSourceConfig_areaCode,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "e164_phone_numbers.#", "2"),
+					resource.TestMatchResourceAttr(dataSourceName, "e164_phone_numbers.0", regexache.MustCompile(`^\+1206\d{7}$`)),
+				),
+			},
+		},
+	})
+}
+
+const testAccAvailablePhoneNumbersDataSourceConfig_areaCode = `
+data "aws_chimesdkvoice_available_phone_numbers" "test" {
+  area_code         = "206"
+  country           = "US"
+  phone_number_type = "Local"
+  max_results       = 2
+}
+`
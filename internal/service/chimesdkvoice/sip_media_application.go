@@ -15,8 +15,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -37,6 +39,26 @@ func ResourceSipMediaApplication() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"alexa_skill_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alexa_skill_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"alexa_skill_status": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.AlexaSkillStatus](),
+						},
+					},
+				},
+			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -89,6 +111,18 @@ func resourceSipMediaApplicationCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	d.SetId(aws.ToString(resp.SipMediaApplication.SipMediaApplicationId))
+
+	if v, ok := d.GetOk("alexa_skill_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input := &chimesdkvoice.PutSipMediaApplicationAlexaSkillConfigurationInput{
+			SipMediaApplicationAlexaSkillConfiguration: expandSipMediaApplicationAlexaSkillConfiguration(v.([]interface{})[0].(map[string]interface{})),
+			SipMediaApplicationId:                      aws.String(d.Id()),
+		}
+
+		if _, err := conn.PutSipMediaApplicationAlexaSkillConfiguration(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "putting Chime Sip Media Application (%s) Alexa Skill configuration: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceSipMediaApplicationRead(ctx, d, meta)...)
 }
 
@@ -111,6 +145,19 @@ func resourceSipMediaApplicationRead(ctx context.Context, d *schema.ResourceData
 	d.Set(names.AttrName, resp.Name)
 	d.Set(names.AttrEndpoints, flattenSipMediaApplicationEndpoints(resp.Endpoints))
 
+	alexaSkillConfiguration, err := findSIPMediaApplicationAlexaSkillConfigurationByID(ctx, conn, d.Id())
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("alexa_skill_configuration", nil)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading Chime Sip Media Application (%s) Alexa Skill configuration: %s", d.Id(), err)
+	default:
+		if err := d.Set("alexa_skill_configuration", []interface{}{flattenSipMediaApplicationAlexaSkillConfiguration(alexaSkillConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting alexa_skill_configuration: %s", err)
+		}
+	}
+
 	return diags
 }
 
@@ -130,6 +177,20 @@ func resourceSipMediaApplicationUpdate(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
+	if d.HasChange("alexa_skill_configuration") {
+		input := &chimesdkvoice.PutSipMediaApplicationAlexaSkillConfigurationInput{
+			SipMediaApplicationId: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("alexa_skill_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.SipMediaApplicationAlexaSkillConfiguration = expandSipMediaApplicationAlexaSkillConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if _, err := conn.PutSipMediaApplicationAlexaSkillConfiguration(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "putting Chime Sip Media Application (%s) Alexa Skill configuration: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceSipMediaApplicationRead(ctx, d, meta)...)
 }
 
@@ -177,6 +238,37 @@ func flattenSipMediaApplicationEndpoints(apiObject []awstypes.SipMediaApplicatio
 	return rawSipMediaApplicationEndpoints
 }
 
+func expandSipMediaApplicationAlexaSkillConfiguration(tfMap map[string]interface{}) *awstypes.SipMediaApplicationAlexaSkillConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.SipMediaApplicationAlexaSkillConfiguration{}
+
+	if v, ok := tfMap["alexa_skill_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AlexaSkillIds = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["alexa_skill_status"].(string); ok && v != "" {
+		apiObject.AlexaSkillStatus = awstypes.AlexaSkillStatus(v)
+	}
+
+	return apiObject
+}
+
+func flattenSipMediaApplicationAlexaSkillConfiguration(apiObject *awstypes.SipMediaApplicationAlexaSkillConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"alexa_skill_ids":    flex.FlattenStringValueSet(apiObject.AlexaSkillIds),
+		"alexa_skill_status": string(apiObject.AlexaSkillStatus),
+	}
+
+	return tfMap
+}
+
 func findSIPMediaApplicationByID(ctx context.Context, conn *chimesdkvoice.Client, id string) (*awstypes.SipMediaApplication, error) {
 	in := &chimesdkvoice.GetSipMediaApplicationInput{
 		SipMediaApplicationId: aws.String(id),
@@ -201,3 +293,28 @@ func findSIPMediaApplicationByID(ctx context.Context, conn *chimesdkvoice.Client
 
 	return resp.SipMediaApplication, nil
 }
+
+func findSIPMediaApplicationAlexaSkillConfigurationByID(ctx context.Context, conn *chimesdkvoice.Client, id string) (*awstypes.SipMediaApplicationAlexaSkillConfiguration, error) {
+	input := &chimesdkvoice.GetSipMediaApplicationAlexaSkillConfigurationInput{
+		SipMediaApplicationId: aws.String(id),
+	}
+
+	output, err := conn.GetSipMediaApplicationAlexaSkillConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SipMediaApplicationAlexaSkillConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SipMediaApplicationAlexaSkillConfiguration, nil
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsAnalysisTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var analysisTemplate cleanrooms.GetAnalysisTemplateOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	associationName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_cleanrooms_analysis_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalysisTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalysisTemplateConfig_basic(rName, associationName, TEST_DESCRIPTION),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisTemplateExists(ctx, resourceName, &analysisTemplate),
+					resource.TestCheckResourceAttr(resourceName, "analysis_parameter.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "analysis_parameter.0.default_value", "x"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_parameter.0.name", "value"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_parameter.0.type", "VARCHAR"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "collaboration_id", "aws_cleanrooms_collaboration.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, TEST_DESCRIPTION),
+					resource.TestCheckResourceAttr(resourceName, names.AttrFormat, "SQL"),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_identifier", "aws_cleanrooms_membership.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "source.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.Project", TEST_TAG),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnalysisTemplateConfig_basic(rName, associationName, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisTemplateExists(ctx, resourceName, &analysisTemplate),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsAnalysisTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var analysisTemplate cleanrooms.GetAnalysisTemplateOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	associationName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_cleanrooms_analysis_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalysisTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalysisTemplateConfig_basic(rName, associationName, TEST_DESCRIPTION),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisTemplateExists(ctx, resourceName, &analysisTemplate),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceAnalysisTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAnalysisTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_analysis_template" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfcleanrooms.FindAnalysisTemplateByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.CleanRooms, create.ErrActionCheckingDestroyed, tfcleanrooms.ResNameAnalysisTemplate, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAnalysisTemplateExists(ctx context.Context, name string, analysisTemplate *cleanrooms.GetAnalysisTemplateOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameAnalysisTemplate, name, errors.New("not found"))
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		output, err := tfcleanrooms.FindAnalysisTemplateByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		if err != nil {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameAnalysisTemplate, rs.Primary.ID, err)
+		}
+
+		*analysisTemplate = *output
+
+		return nil
+	}
+}
+
+func testAccAnalysisTemplateConfig_basic(rName, associationName, description string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableAssociationConfig_basic(rName, associationName, TEST_DESCRIPTION), fmt.Sprintf(`
+resource "aws_cleanrooms_analysis_template" "test" {
+  name                  = %[1]q
+  description           = %[2]q
+  membership_identifier = aws_cleanrooms_membership.test.id
+  format                = "SQL"
+
+  source {
+    text = "SELECT my_column_1 FROM ${aws_cleanrooms_configured_table_association.test.name} WHERE my_column_2 = :value"
+  }
+
+  analysis_parameter {
+    name          = "value"
+    type          = "VARCHAR"
+    default_value = "x"
+  }
+
+  tags = {
+    Project = %[3]q
+  }
+}
+`, rName, description, TEST_TAG))
+}
@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_configured_table_analysis_rule")
+func ResourceConfiguredTableAnalysisRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredTableAnalysisRuleCreate,
+		ReadWithoutTimeout:   resourceConfiguredTableAnalysisRuleRead,
+		UpdateWithoutTimeout: resourceConfiguredTableAnalysisRuleUpdate,
+		DeleteWithoutTimeout: resourceConfiguredTableAnalysisRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"analysis_rule_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configured_table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configured_table_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrCreateTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_analyses": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_analysis_providers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"differential_privacy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrName: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameConfiguredTableAnalysisRule = "Configured Table Analysis Rule"
+)
+
+func resourceConfiguredTableAnalysisRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	configuredTableID := d.Get("configured_table_identifier").(string)
+	input := &cleanrooms.CreateConfiguredTableAnalysisRuleInput{
+		AnalysisRulePolicy:        expandConfiguredTableAnalysisRuleCustomPolicy(d.Get("custom").([]interface{})),
+		AnalysisRuleType:          types.ConfiguredTableAnalysisRuleTypeCustom,
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	_, err := conn.CreateConfiguredTableAnalysisRule(ctx, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAnalysisRule, configuredTableID, err)
+	}
+
+	d.SetId(configuredTableID)
+
+	return append(diags, resourceConfiguredTableAnalysisRuleRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAnalysisRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	out, err := findConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, d.Id(), types.ConfiguredTableAnalysisRuleTypeCustom)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Configured Table Analysis Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	analysisRule := out.AnalysisRule
+	d.Set("analysis_rule_type", analysisRule.Type)
+	d.Set("configured_table_arn", analysisRule.ConfiguredTableArn)
+	d.Set("configured_table_identifier", analysisRule.ConfiguredTableId)
+	d.Set(names.AttrCreateTime, analysisRule.CreateTime.String())
+	if err := d.Set("custom", flattenConfiguredTableAnalysisRuleCustomPolicy(analysisRule.Policy)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting custom: %s", err)
+	}
+	d.Set("update_time", analysisRule.UpdateTime.String())
+
+	return diags
+}
+
+func resourceConfiguredTableAnalysisRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	input := &cleanrooms.UpdateConfiguredTableAnalysisRuleInput{
+		AnalysisRulePolicy:        expandConfiguredTableAnalysisRuleCustomPolicy(d.Get("custom").([]interface{})),
+		AnalysisRuleType:          types.ConfiguredTableAnalysisRuleTypeCustom,
+		ConfiguredTableIdentifier: aws.String(d.Id()),
+	}
+
+	_, err := conn.UpdateConfiguredTableAnalysisRule(ctx, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	return append(diags, resourceConfiguredTableAnalysisRuleRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAnalysisRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	log.Printf("[INFO] Deleting Clean Rooms Configured Table Analysis Rule %s", d.Id())
+	_, err := conn.DeleteConfiguredTableAnalysisRule(ctx, &cleanrooms.DeleteConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          types.ConfiguredTableAnalysisRuleTypeCustom,
+		ConfiguredTableIdentifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findConfiguredTableAnalysisRuleByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, configuredTableID string, analysisRuleType types.ConfiguredTableAnalysisRuleType) (*cleanrooms.GetConfiguredTableAnalysisRuleOutput, error) {
+	in := &cleanrooms.GetConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          analysisRuleType,
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	out, err := conn.GetConfiguredTableAnalysisRule(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AnalysisRule == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func expandConfiguredTableAnalysisRuleCustomPolicy(tfList []interface{}) types.ConfiguredTableAnalysisRulePolicy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := types.AnalysisRuleCustom{
+		AllowedAnalyses: flex.ExpandStringValueSet(tfMap["allowed_analyses"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["allowed_analysis_providers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedAnalysisProviders = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["differential_privacy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DifferentialPrivacy = expandDifferentialPrivacyConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return &types.ConfiguredTableAnalysisRulePolicyMemberV1{
+		Value: &types.ConfiguredTableAnalysisRulePolicyV1MemberCustom{
+			Value: apiObject,
+		},
+	}
+}
+
+func expandDifferentialPrivacyConfiguration(tfMap map[string]interface{}) *types.DifferentialPrivacyConfiguration {
+	apiObject := &types.DifferentialPrivacyConfiguration{}
+
+	for _, tfMapRaw := range tfMap["column"].(*schema.Set).List() {
+		column, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject.Columns = append(apiObject.Columns, types.DifferentialPrivacyColumn{
+			Name: aws.String(column[names.AttrName].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func flattenConfiguredTableAnalysisRuleCustomPolicy(apiObject types.ConfiguredTableAnalysisRulePolicy) []interface{} {
+	v1, ok := apiObject.(*types.ConfiguredTableAnalysisRulePolicyMemberV1)
+	if !ok {
+		return nil
+	}
+
+	custom, ok := v1.Value.(*types.ConfiguredTableAnalysisRulePolicyV1MemberCustom)
+	if !ok {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"allowed_analyses":           custom.Value.AllowedAnalyses,
+		"allowed_analysis_providers": custom.Value.AllowedAnalysisProviders,
+	}
+
+	if v := custom.Value.DifferentialPrivacy; v != nil {
+		var columns []interface{}
+
+		for _, column := range v.Columns {
+			columns = append(columns, map[string]interface{}{
+				names.AttrName: aws.ToString(column.Name),
+			})
+		}
+
+		m["differential_privacy"] = []interface{}{map[string]interface{}{
+			"column": columns,
+		}}
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var analysisRule cleanrooms.GetConfiguredTableAnalysisRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &analysisRule),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", "CUSTOM"),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_arn", "aws_cleanrooms_configured_table.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_identifier", "aws_cleanrooms_configured_table.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "custom.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "custom.0.allowed_analyses.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttr(resourceName, "custom.0.allowed_analyses.*", "ANY_QUERY"),
+					resource.TestCheckResourceAttr(resourceName, "custom.0.differential_privacy.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_differentialPrivacy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &analysisRule),
+					resource.TestCheckResourceAttr(resourceName, "custom.0.differential_privacy.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "custom.0.differential_privacy.0.column.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "custom.0.differential_privacy.0.column.*", map[string]string{
+						names.AttrName: "my_column_1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var analysisRule cleanrooms.GetConfiguredTableAnalysisRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &analysisRule),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceConfiguredTableAnalysisRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfiguredTableAnalysisRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_configured_table_analysis_rule" {
+				continue
+			}
+
+			_, err := tfcleanrooms.FindConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, rs.Primary.ID, types.ConfiguredTableAnalysisRuleTypeCustom)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.CleanRooms, create.ErrActionCheckingDestroyed, tfcleanrooms.ResNameConfiguredTableAnalysisRule, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfiguredTableAnalysisRuleExists(ctx context.Context, name string, analysisRule *cleanrooms.GetConfiguredTableAnalysisRuleOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTableAnalysisRule, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		output, err := tfcleanrooms.FindConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, rs.Primary.ID, types.ConfiguredTableAnalysisRuleTypeCustom)
+
+		if err != nil {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTableAnalysisRule, rs.Primary.ID, err)
+		}
+
+		*analysisRule = *output
+
+		return nil
+	}
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableAssociationConfig_base(rName), `
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_identifier = aws_cleanrooms_configured_table.test.id
+
+  custom {
+    allowed_analyses = ["ANY_QUERY"]
+  }
+}
+`)
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_differentialPrivacy(rName string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableAssociationConfig_base(rName), `
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_identifier = aws_cleanrooms_configured_table.test.id
+
+  custom {
+    allowed_analyses = ["ANY_QUERY"]
+
+    differential_privacy {
+      column {
+        name = "my_column_1"
+      }
+    }
+  }
+}
+`)
+}
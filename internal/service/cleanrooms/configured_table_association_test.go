@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsConfiguredTableAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var association cleanrooms.GetConfiguredTableAssociationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	associationName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_cleanrooms_configured_table_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAssociationConfig_basic(rName, associationName, TEST_DESCRIPTION),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_arn", "aws_cleanrooms_configured_table.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_identifier", "aws_cleanrooms_configured_table.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, TEST_DESCRIPTION),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_arn", "aws_cleanrooms_membership.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_identifier", "aws_cleanrooms_membership.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, associationName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "tags.Project", TEST_TAG),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfiguredTableAssociationConfig_basic(rName, associationName, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var association cleanrooms.GetConfiguredTableAssociationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	associationName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_cleanrooms_configured_table_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAssociationConfig_basic(rName, associationName, TEST_DESCRIPTION),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAssociationExists(ctx, resourceName, &association),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceConfiguredTableAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfiguredTableAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_configured_table_association" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfcleanrooms.FindConfiguredTableAssociationByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.CleanRooms, create.ErrActionCheckingDestroyed, tfcleanrooms.ResNameConfiguredTableAssociation, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfiguredTableAssociationExists(ctx context.Context, name string, association *cleanrooms.GetConfiguredTableAssociationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTableAssociation, name, errors.New("not found"))
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		output, err := tfcleanrooms.FindConfiguredTableAssociationByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		if err != nil {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTableAssociation, rs.Primary.ID, err)
+		}
+
+		*association = *output
+
+		return nil
+	}
+}
+
+// testAccConfiguredTableAssociationConfig_base creates a membership, a Glue
+// backed configured table and the IAM role Clean Rooms assumes to read it.
+func testAccConfiguredTableAssociationConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccMembershipConfig_basic(rName, "DISABLED"), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = replace(%[1]q, "-", "_")
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = replace(%[1]q, "-", "_")
+  database_name = aws_glue_catalog_database.test.name
+
+  storage_descriptor {
+    location = "s3://${aws_s3_bucket.test.bucket}"
+
+    columns {
+      name = "my_column_1"
+      type = "string"
+    }
+
+    columns {
+      name = "my_column_2"
+      type = "string"
+    }
+  }
+}
+
+resource "aws_cleanrooms_configured_table" "test" {
+  name            = %[1]q
+  analysis_method = "DIRECT_QUERY"
+  allowed_columns = ["my_column_1", "my_column_2"]
+
+  table_reference {
+    database_name = aws_glue_catalog_database.test.name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "cleanrooms.amazonaws.com" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "glue:GetDatabase",
+        "glue:GetDatabases",
+        "glue:GetTable",
+        "glue:GetTables",
+        "glue:GetPartition",
+        "glue:GetPartitions",
+        "glue:BatchGetPartition",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+      }, {
+      Action   = ["s3:GetObject", "s3:GetBucketLocation", "s3:ListBucket"]
+      Effect   = "Allow"
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccConfiguredTableAssociationConfig_basic(rName, associationName, description string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableAssociationConfig_base(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table_association" "test" {
+  name                        = %[1]q
+  description                 = %[2]q
+  membership_identifier       = aws_cleanrooms_membership.test.id
+  configured_table_identifier = aws_cleanrooms_configured_table.test.id
+  role_arn                    = aws_iam_role.test.arn
+
+  tags = {
+    Project = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, associationName, description, TEST_TAG))
+}
@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+// Exports for use in tests only.
+var (
+	FindAnalysisTemplateByTwoPartKey            = findAnalysisTemplateByTwoPartKey
+	FindConfiguredTableAnalysisRuleByTwoPartKey = findConfiguredTableAnalysisRuleByTwoPartKey
+	FindConfiguredTableAssociationByTwoPartKey  = findConfiguredTableAssociationByTwoPartKey
+	FindIDMappingTableByTwoPartKey              = findIDMappingTableByTwoPartKey
+	FindMembershipByID                          = findMembershipByID
+	FindPrivacyBudgetTemplateByTwoPartKey       = findPrivacyBudgetTemplateByTwoPartKey
+)
@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_id_mapping_table")
+// @Tags(identifierAttribute="arn")
+func ResourceIDMappingTable() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIDMappingTableCreate,
+		ReadWithoutTimeout:   resourceIDMappingTableRead,
+		UpdateWithoutTimeout: resourceIDMappingTableUpdate,
+		DeleteWithoutTimeout: resourceIDMappingTableDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreateTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"input_reference_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_reference_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"manage_resource_policies": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"input_reference_properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id_mapping_table_input_source": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id_namespace_association_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrType: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrKMSKeyARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"membership_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"membership_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameIDMappingTable = "ID Mapping Table"
+
+	idMappingTableIDPartCount = 2
+)
+
+func resourceIDMappingTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &cleanrooms.CreateIdMappingTableInput{
+		InputReferenceConfig: expandIDMappingTableInputReferenceConfig(d.Get("input_reference_config").([]interface{})),
+		MembershipIdentifier: aws.String(d.Get("membership_identifier").(string)),
+		Name:                 aws.String(name),
+		Tags:                 getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrKMSKeyARN); ok {
+		input.KmsKeyArn = aws.String(v.(string))
+	}
+
+	out, err := conn.CreateIdMappingTable(ctx, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameIDMappingTable, name, err)
+	}
+
+	if out == nil || out.IdMappingTable == nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameIDMappingTable, name, errors.New("empty output"))
+	}
+
+	id, err := flex.FlattenResourceId([]string{aws.ToString(out.IdMappingTable.MembershipId), aws.ToString(out.IdMappingTable.Id)}, idMappingTableIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameIDMappingTable, name, err)
+	}
+	d.SetId(id)
+
+	return append(diags, resourceIDMappingTableRead(ctx, d, meta)...)
+}
+
+func resourceIDMappingTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), idMappingTableIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNameIDMappingTable, d.Id(), err)
+	}
+
+	out, err := findIDMappingTableByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms ID Mapping Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNameIDMappingTable, d.Id(), err)
+	}
+
+	table := out.IdMappingTable
+	d.Set(names.AttrARN, table.Arn)
+	d.Set("collaboration_arn", table.CollaborationArn)
+	d.Set("collaboration_id", table.CollaborationId)
+	d.Set(names.AttrCreateTime, table.CreateTime.String())
+	d.Set(names.AttrDescription, table.Description)
+	if err := d.Set("input_reference_config", flattenIDMappingTableInputReferenceConfig(table.InputReferenceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting input_reference_config: %s", err)
+	}
+	if err := d.Set("input_reference_properties", flattenIDMappingTableInputReferenceProperties(table.InputReferenceProperties)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting input_reference_properties: %s", err)
+	}
+	d.Set(names.AttrKMSKeyARN, table.KmsKeyArn)
+	d.Set("membership_arn", table.MembershipArn)
+	d.Set("membership_identifier", table.MembershipId)
+	d.Set(names.AttrName, table.Name)
+	d.Set("update_time", table.UpdateTime.String())
+
+	return diags
+}
+
+func resourceIDMappingTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		parts, err := flex.ExpandResourceId(d.Id(), idMappingTableIDPartCount, false)
+		if err != nil {
+			return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNameIDMappingTable, d.Id(), err)
+		}
+
+		input := &cleanrooms.UpdateIdMappingTableInput{
+			IdMappingTableIdentifier: aws.String(parts[1]),
+			MembershipIdentifier:     aws.String(parts[0]),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange(names.AttrKMSKeyARN) {
+			input.KmsKeyArn = aws.String(d.Get(names.AttrKMSKeyARN).(string))
+		}
+
+		_, err = conn.UpdateIdMappingTable(ctx, input)
+		if err != nil {
+			return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNameIDMappingTable, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceIDMappingTableRead(ctx, d, meta)...)
+}
+
+func resourceIDMappingTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), idMappingTableIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNameIDMappingTable, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting Clean Rooms ID Mapping Table %s", d.Id())
+	_, err = conn.DeleteIdMappingTable(ctx, &cleanrooms.DeleteIdMappingTableInput{
+		IdMappingTableIdentifier: aws.String(parts[1]),
+		MembershipIdentifier:     aws.String(parts[0]),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNameIDMappingTable, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findIDMappingTableByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, tableID string) (*cleanrooms.GetIdMappingTableOutput, error) {
+	in := &cleanrooms.GetIdMappingTableInput{
+		IdMappingTableIdentifier: aws.String(tableID),
+		MembershipIdentifier:     aws.String(membershipID),
+	}
+
+	out, err := conn.GetIdMappingTable(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.IdMappingTable == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func expandIDMappingTableInputReferenceConfig(tfList []interface{}) *types.IdMappingTableInputReferenceConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &types.IdMappingTableInputReferenceConfig{
+		InputReferenceArn:      aws.String(tfMap["input_reference_arn"].(string)),
+		ManageResourcePolicies: aws.Bool(tfMap["manage_resource_policies"].(bool)),
+	}
+}
+
+func flattenIDMappingTableInputReferenceConfig(apiObject *types.IdMappingTableInputReferenceConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"input_reference_arn":      aws.ToString(apiObject.InputReferenceArn),
+		"manage_resource_policies": aws.ToBool(apiObject.ManageResourcePolicies),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenIDMappingTableInputReferenceProperties(apiObject *types.IdMappingTableInputReferenceProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var sources []interface{}
+	for _, v := range apiObject.IdMappingTableInputSource {
+		sources = append(sources, map[string]interface{}{
+			"id_namespace_association_id": aws.ToString(v.IdNamespaceAssociationId),
+			names.AttrType:                v.Type,
+		})
+	}
+
+	m := map[string]interface{}{
+		"id_mapping_table_input_source": sources,
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// An ID mapping table needs a membership whose collaboration has both ID
+// namespaces of an Entity Resolution ID mapping workflow associated, so the
+// tests use an existing membership and workflow.
+func TestAccCleanRoomsIDMappingTable_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	membershipID := acctest.SkipIfEnvVarNotSet(t, "CLEANROOMS_MEMBERSHIP_ID")
+	workflowARN := acctest.SkipIfEnvVarNotSet(t, "CLEANROOMS_ID_MAPPING_WORKFLOW_ARN")
+
+	var table cleanrooms.GetIdMappingTableOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_id_mapping_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDMappingTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDMappingTableConfig_basic(rName, membershipID, workflowARN, TEST_DESCRIPTION),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDMappingTableExists(ctx, resourceName, &table),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "collaboration_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, TEST_DESCRIPTION),
+					resource.TestCheckResourceAttr(resourceName, "input_reference_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "input_reference_config.0.input_reference_arn", workflowARN),
+					resource.TestCheckResourceAttr(resourceName, "input_reference_config.0.manage_resource_policies", "true"),
+					resource.TestCheckResourceAttr(resourceName, "input_reference_properties.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "input_reference_properties.0.id_mapping_table_input_source.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "membership_identifier", membershipID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.Project", TEST_TAG),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIDMappingTableConfig_basic(rName, membershipID, workflowARN, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDMappingTableExists(ctx, resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsIDMappingTable_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	membershipID := acctest.SkipIfEnvVarNotSet(t, "CLEANROOMS_MEMBERSHIP_ID")
+	workflowARN := acctest.SkipIfEnvVarNotSet(t, "CLEANROOMS_ID_MAPPING_WORKFLOW_ARN")
+
+	var table cleanrooms.GetIdMappingTableOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_id_mapping_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDMappingTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDMappingTableConfig_basic(rName, membershipID, workflowARN, TEST_DESCRIPTION),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDMappingTableExists(ctx, resourceName, &table),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceIDMappingTable(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIDMappingTableDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_id_mapping_table" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfcleanrooms.FindIDMappingTableByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.CleanRooms, create.ErrActionCheckingDestroyed, tfcleanrooms.ResNameIDMappingTable, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIDMappingTableExists(ctx context.Context, name string, table *cleanrooms.GetIdMappingTableOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameIDMappingTable, name, errors.New("not found"))
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		output, err := tfcleanrooms.FindIDMappingTableByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		if err != nil {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameIDMappingTable, rs.Primary.ID, err)
+		}
+
+		*table = *output
+
+		return nil
+	}
+}
+
+func testAccIDMappingTableConfig_basic(rName, membershipID, workflowARN, description string) string {
+	return fmt.Sprintf(`
+resource "aws_cleanrooms_id_mapping_table" "test" {
+  name                  = %[1]q
+  description           = %[4]q
+  membership_identifier = %[2]q
+
+  input_reference_config {
+    input_reference_arn      = %[3]q
+    manage_resource_policies = true
+  }
+
+  tags = {
+    Project = %[5]q
+  }
+}
+`, rName, membershipID, workflowARN, description, TEST_TAG)
+}
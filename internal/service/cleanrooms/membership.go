@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_membership")
+// @Tags(identifierAttribute="arn")
+func ResourceMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMembershipCreate,
+		ReadWithoutTimeout:   resourceMembershipRead,
+		UpdateWithoutTimeout: resourceMembershipUpdate,
+		DeleteWithoutTimeout: resourceMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"collaboration_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreateTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"member_abilities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"query_log_status": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.MembershipQueryLogStatus](),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameMembership = "Membership"
+)
+
+func resourceMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	collaborationID := d.Get("collaboration_identifier").(string)
+	input := &cleanrooms.CreateMembershipInput{
+		CollaborationIdentifier: aws.String(collaborationID),
+		QueryLogStatus:          types.MembershipQueryLogStatus(d.Get("query_log_status").(string)),
+		Tags:                    getTagsIn(ctx),
+	}
+
+	out, err := conn.CreateMembership(ctx, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameMembership, collaborationID, err)
+	}
+
+	if out == nil || out.Membership == nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameMembership, collaborationID, errors.New("empty output"))
+	}
+	d.SetId(aws.ToString(out.Membership.Id))
+
+	return append(diags, resourceMembershipRead(ctx, d, meta)...)
+}
+
+func resourceMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	out, err := findMembershipByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNameMembership, d.Id(), err)
+	}
+
+	membership := out.Membership
+	d.Set(names.AttrARN, membership.Arn)
+	d.Set("collaboration_arn", membership.CollaborationArn)
+	d.Set("collaboration_id", membership.CollaborationId)
+	d.Set("collaboration_identifier", membership.CollaborationId)
+	d.Set("collaboration_name", membership.CollaborationName)
+	d.Set(names.AttrCreateTime, membership.CreateTime.String())
+	d.Set("member_abilities", membership.MemberAbilities)
+	d.Set("query_log_status", membership.QueryLogStatus)
+	d.Set(names.AttrStatus, membership.Status)
+	d.Set("update_time", membership.UpdateTime.String())
+
+	return diags
+}
+
+func resourceMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &cleanrooms.UpdateMembershipInput{
+			MembershipIdentifier: aws.String(d.Id()),
+		}
+
+		if d.HasChanges("query_log_status") {
+			input.QueryLogStatus = types.MembershipQueryLogStatus(d.Get("query_log_status").(string))
+		}
+
+		_, err := conn.UpdateMembership(ctx, input)
+		if err != nil {
+			return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNameMembership, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMembershipRead(ctx, d, meta)...)
+}
+
+func resourceMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	log.Printf("[INFO] Deleting Clean Rooms Membership %s", d.Id())
+	_, err := conn.DeleteMembership(ctx, &cleanrooms.DeleteMembershipInput{
+		MembershipIdentifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNameMembership, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findMembershipByID(ctx context.Context, conn *cleanrooms.Client, id string) (*cleanrooms.GetMembershipOutput, error) {
+	in := &cleanrooms.GetMembershipInput{
+		MembershipIdentifier: aws.String(id),
+	}
+
+	out, err := conn.GetMembership(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Membership == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	// A membership that has been left is still returned by the API.
+	if status := out.Membership.Status; status == types.MembershipStatusRemoved || status == types.MembershipStatusCollaborationDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: in,
+		}
+	}
+
+	return out, nil
+}
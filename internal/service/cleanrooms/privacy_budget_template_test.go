@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsPrivacyBudgetTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var template cleanrooms.GetPrivacyBudgetTemplateOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	associationName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_cleanrooms_privacy_budget_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPrivacyBudgetTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivacyBudgetTemplateConfig_basic(rName, associationName, 1, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivacyBudgetTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "auto_refresh", "CALENDAR_MONTH"),
+					resource.TestCheckResourceAttrPair(resourceName, "collaboration_id", "aws_cleanrooms_collaboration.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_identifier", "aws_cleanrooms_membership.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.epsilon", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.users_noise_per_query", "10"),
+					resource.TestCheckResourceAttr(resourceName, "privacy_budget_type", "DIFFERENTIAL_PRIVACY"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Project", TEST_TAG),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPrivacyBudgetTemplateConfig_basic(rName, associationName, 5, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivacyBudgetTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.epsilon", "5"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.users_noise_per_query", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsPrivacyBudgetTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var template cleanrooms.GetPrivacyBudgetTemplateOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	associationName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_cleanrooms_privacy_budget_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPrivacyBudgetTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivacyBudgetTemplateConfig_basic(rName, associationName, 1, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivacyBudgetTemplateExists(ctx, resourceName, &template),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourcePrivacyBudgetTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPrivacyBudgetTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_privacy_budget_template" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfcleanrooms.FindPrivacyBudgetTemplateByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.CleanRooms, create.ErrActionCheckingDestroyed, tfcleanrooms.ResNamePrivacyBudgetTemplate, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPrivacyBudgetTemplateExists(ctx context.Context, name string, template *cleanrooms.GetPrivacyBudgetTemplateOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNamePrivacyBudgetTemplate, name, errors.New("not found"))
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		output, err := tfcleanrooms.FindPrivacyBudgetTemplateByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		if err != nil {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNamePrivacyBudgetTemplate, rs.Primary.ID, err)
+		}
+
+		*template = *output
+
+		return nil
+	}
+}
+
+func testAccPrivacyBudgetTemplateConfig_basic(rName, associationName string, epsilon, usersNoisePerQuery int) string {
+	return acctest.ConfigCompose(testAccConfiguredTableAssociationConfig_basic(rName, associationName, TEST_DESCRIPTION), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_identifier = aws_cleanrooms_configured_table.test.id
+
+  custom {
+    allowed_analyses = ["ANY_QUERY"]
+
+    differential_privacy {
+      column {
+        name = "my_column_1"
+      }
+    }
+  }
+}
+
+resource "aws_cleanrooms_privacy_budget_template" "test" {
+  membership_identifier = aws_cleanrooms_membership.test.id
+  auto_refresh          = "CALENDAR_MONTH"
+  privacy_budget_type   = "DIFFERENTIAL_PRIVACY"
+
+  parameters {
+    epsilon               = %[1]d
+    users_noise_per_query = %[2]d
+  }
+
+  tags = {
+    Project = %[3]q
+  }
+
+  depends_on = [aws_cleanrooms_configured_table_analysis_rule.test, aws_cleanrooms_configured_table_association.test]
+}
+`, epsilon, usersNoisePerQuery, TEST_TAG))
+}
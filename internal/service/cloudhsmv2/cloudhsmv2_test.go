@@ -15,9 +15,14 @@ func TestAccCloudHSMV2_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"Cluster": {
-			"basic":        testAccCluster_basic,
-			"disappears":   testAccCluster_disappears,
-			names.AttrTags: testAccCluster_tags,
+			"basic":                 testAccCluster_basic,
+			"backupRetentionPolicy": testAccCluster_backupRetentionPolicy,
+			"disappears":            testAccCluster_disappears,
+			"hsmType":               testAccCluster_hsmType,
+			names.AttrTags:          testAccCluster_tags,
+		},
+		"ClusterActivation": {
+			"basic": testAccClusterActivation_basic,
 		},
 		"Hsm": {
 			"availabilityZone": testAccHSM_AvailabilityZone,
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudhsmv2
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_cloudhsm_v2_cluster_activation", name="Cluster Activation")
+func resourceClusterActivation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceClusterActivationCreate,
+		ReadWithoutTimeout:   resourceClusterActivationRead,
+		DeleteWithoutTimeout: resourceClusterActivationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signed_cert": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 5000),
+			},
+			"trust_anchor": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 5000),
+			},
+		},
+	}
+}
+
+func resourceClusterActivationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudHSMV2Client(ctx)
+
+	clusterID := d.Get("cluster_id").(string)
+	input := &cloudhsmv2.InitializeClusterInput{
+		ClusterId:   aws.String(clusterID),
+		SignedCert:  aws.String(d.Get("signed_cert").(string)),
+		TrustAnchor: aws.String(d.Get("trust_anchor").(string)),
+	}
+
+	_, err := conn.InitializeCluster(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "initializing CloudHSMv2 Cluster (%s): %s", clusterID, err)
+	}
+
+	d.SetId(clusterID)
+
+	if _, err := waitClusterInitialized(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudHSMv2 Cluster (%s) initialize: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceClusterActivationRead(ctx, d, meta)...)
+}
+
+func resourceClusterActivationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudHSMV2Client(ctx)
+
+	cluster, err := findInitializedClusterByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudHSMv2 Cluster Activation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudHSMv2 Cluster Activation (%s): %s", d.Id(), err)
+	}
+
+	if v := cluster.Certificates; v != nil {
+		d.Set("cluster_certificate", v.ClusterCertificate)
+	} else {
+		d.Set("cluster_certificate", nil)
+	}
+	d.Set("cluster_id", cluster.ClusterId)
+	d.Set("cluster_state", cluster.State)
+
+	return diags
+}
+
+func resourceClusterActivationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// An initialized cluster cannot be returned to the uninitialized state.
+	log.Printf("[WARN] CloudHSMv2 Cluster (%s) remains initialized, removing activation from state", d.Id())
+
+	return diags
+}
+
+// findInitializedClusterByID returns the cluster only once it has been
+// initialized, i.e. claimed with a signed cluster certificate.
+func findInitializedClusterByID(ctx context.Context, conn *cloudhsmv2.Client, id string) (*types.Cluster, error) {
+	output, err := findClusterByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch state := output.State; state {
+	case types.ClusterStateCreateInProgress, types.ClusterStateUninitialized:
+		return nil, &retry.NotFoundError{
+			Message: string(state),
+		}
+	}
+
+	return output, nil
+}
+
+func waitClusterInitialized(ctx context.Context, conn *cloudhsmv2.Client, id string, timeout time.Duration) (*types.Cluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.ClusterStateUninitialized, types.ClusterStateInitializeInProgress),
+		Target:     enum.Slice(types.ClusterStateInitialized, types.ClusterStateActive),
+		Refresh:    statusCluster(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Cluster); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StateMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
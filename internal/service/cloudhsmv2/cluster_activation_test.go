@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudhsmv2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudhsmv2 "github.com/hashicorp/terraform-provider-aws/internal/service/cloudhsmv2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccClusterActivation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cloudhsm_v2_cluster_activation.test"
+	clusterResourceName := "aws_cloudhsm_v2_cluster.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudHSMV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"tls": {
+				Source:            "hashicorp/tls",
+				VersionConstraint: "4.0.4",
+			},
+		},
+		CheckDestroy: testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterActivationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterActivationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "cluster_certificate"),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_id", clusterResourceName, "cluster_id"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_state", string(types.ClusterStateInitialized)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"signed_cert", "trust_anchor"},
+			},
+		},
+	})
+}
+
+func testAccCheckClusterActivationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudHSMV2Client(ctx)
+
+		_, err := tfcloudhsmv2.FindInitializedClusterByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccClusterActivationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccHSMConfig_base(rName), `
+resource "aws_cloudhsm_v2_hsm" "test" {
+  cluster_id = aws_cloudhsm_v2_cluster.test.cluster_id
+  subnet_id  = aws_subnet.test[0].id
+}
+
+data "aws_cloudhsm_v2_cluster" "test" {
+  cluster_id = aws_cloudhsm_v2_cluster.test.cluster_id
+
+  depends_on = [aws_cloudhsm_v2_hsm.test]
+}
+
+resource "tls_private_key" "ca" {
+  algorithm = "RSA"
+}
+
+resource "tls_self_signed_cert" "ca" {
+  private_key_pem = tls_private_key.ca.private_key_pem
+
+  subject {
+    common_name = "example.com"
+  }
+
+  validity_period_hours = 12
+  is_ca_certificate     = true
+
+  allowed_uses = [
+    "cert_signing",
+    "crl_signing",
+  ]
+}
+
+resource "tls_locally_signed_cert" "cluster" {
+  cert_request_pem      = data.aws_cloudhsm_v2_cluster.test.cluster_certificates[0].cluster_csr
+  ca_private_key_pem    = tls_private_key.ca.private_key_pem
+  ca_cert_pem           = tls_self_signed_cert.ca.cert_pem
+  validity_period_hours = 12
+
+  allowed_uses = [
+    "key_encipherment",
+    "digital_signature",
+    "server_auth",
+  ]
+}
+
+resource "aws_cloudhsm_v2_cluster_activation" "test" {
+  cluster_id   = aws_cloudhsm_v2_cluster.test.cluster_id
+  signed_cert  = tls_locally_signed_cert.cluster.cert_pem
+  trust_anchor = tls_self_signed_cert.ca.cert_pem
+}
+`)
+}
@@ -5,9 +5,11 @@ package cloudhsmv2
 
 // Exports for use in tests only.
 var (
-	ResourceCluster = resourceCluster
-	ResourceHSM     = resourceHSM
+	ResourceCluster           = resourceCluster
+	ResourceClusterActivation = resourceClusterActivation
+	ResourceHSM               = resourceHSM
 
-	FindClusterByID     = findClusterByID
-	FindHSMByTwoPartKey = findHSMByTwoPartKey
+	FindClusterByID            = findClusterByID
+	FindHSMByTwoPartKey        = findHSMByTwoPartKey
+	FindInitializedClusterByID = findInitializedClusterByID
 )
@@ -40,6 +40,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceClusterActivation,
+			TypeName: "aws_cloudhsm_v2_cluster_activation",
+			Name:     "Cluster Activation",
+		},
 		{
 			Factory:  resourceHSM,
 			TypeName: "aws_cloudhsm_v2_hsm",
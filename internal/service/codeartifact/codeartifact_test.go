@@ -33,6 +33,12 @@ func TestAccCodeArtifact_serial(t *testing.T) {
 			"disappearsDomain": testAccDomainPermissionsPolicy_Disappears_domain,
 			"ignoreEquivalent": testAccDomainPermissionsPolicy_ignoreEquivalent,
 		},
+		"PackageGroup": {
+			"basic":              testAccPackageGroup_basic,
+			"disappears":         testAccPackageGroup_disappears,
+			"originRestrictions": testAccPackageGroup_originRestrictions,
+			names.AttrTags:       testAccPackageGroup_tags,
+		},
 		"Repository": {
 			"basic":               testAccRepository_basic,
 			names.AttrDescription: testAccRepository_description,
@@ -46,6 +52,9 @@ func TestAccCodeArtifact_serial(t *testing.T) {
 			"basic":         testAccRepositoryEndpointDataSource_basic,
 			names.AttrOwner: testAccRepositoryEndpointDataSource_owner,
 		},
+		"RepositoryEndpointsDataSource": {
+			"basic": testAccRepositoryEndpointsDataSource_basic,
+		},
 		"RepositoryPermissionsPolicy": {
 			"basic":            testAccRepositoryPermissionsPolicy_basic,
 			"disappears":       testAccRepositoryPermissionsPolicy_disappears,
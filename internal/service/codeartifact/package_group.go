@@ -0,0 +1,415 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	packageGroupResourceIDPartCount = 3
+)
+
+// @SDKResource("aws_codeartifact_package_group", name="Package Group")
+// @Tags(identifierAttribute="arn")
+func resourcePackageGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePackageGroupCreate,
+		ReadWithoutTimeout:   resourcePackageGroupRead,
+		UpdateWithoutTimeout: resourcePackageGroupUpdate,
+		DeleteWithoutTimeout: resourcePackageGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_info": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			names.AttrCreatedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			names.AttrDomain: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"origin_restriction": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 3,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_repositories": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrMode: {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice(enum.Slice(
+								types.PackageGroupOriginRestrictionModeAllow,
+								types.PackageGroupOriginRestrictionModeAllowSpecificRepositories,
+								types.PackageGroupOriginRestrictionModeBlock,
+							), false),
+						},
+						"restriction_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.PackageGroupOriginRestrictionType](),
+						},
+					},
+				},
+			},
+			"pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(2, 520),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePackageGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	domainName := d.Get(names.AttrDomain).(string)
+	var owner string
+	if v, ok := d.GetOk("domain_owner"); ok {
+		owner = v.(string)
+	} else {
+		owner = meta.(*conns.AWSClient).AccountID
+	}
+	pattern := d.Get("pattern").(string)
+	id := errs.Must(flex.FlattenResourceId([]string{owner, domainName, pattern}, packageGroupResourceIDPartCount, false))
+	input := &codeartifact.CreatePackageGroupInput{
+		Domain:       aws.String(domainName),
+		DomainOwner:  aws.String(owner),
+		PackageGroup: aws.String(pattern),
+		Tags:         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("contact_info"); ok {
+		input.ContactInfo = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.CreatePackageGroup(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CodeArtifact Package Group (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if v, ok := d.GetOk("origin_restriction"); ok && v.(*schema.Set).Len() > 0 {
+		if err := updatePackageGroupOriginConfiguration(ctx, conn, owner, domainName, pattern, nil, v.(*schema.Set).List()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CodeArtifact Package Group (%s) origin configuration: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePackageGroupRead(ctx, d, meta)...)
+}
+
+func resourcePackageGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	owner, domainName, pattern := parts[0], parts[1], parts[2]
+	packageGroup, err := findPackageGroupByThreePartKey(ctx, conn, owner, domainName, pattern)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeArtifact Package Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeArtifact Package Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, packageGroup.Arn)
+	d.Set("contact_info", packageGroup.ContactInfo)
+	if packageGroup.CreatedTime != nil {
+		d.Set(names.AttrCreatedTime, aws.ToTime(packageGroup.CreatedTime).Format(time.RFC3339))
+	}
+	d.Set(names.AttrDescription, packageGroup.Description)
+	d.Set(names.AttrDomain, packageGroup.DomainName)
+	d.Set("domain_owner", packageGroup.DomainOwner)
+	d.Set("pattern", packageGroup.Pattern)
+
+	var tfList []interface{}
+	if packageGroup.OriginConfiguration != nil {
+		for restrictionType, restriction := range packageGroup.OriginConfiguration.Restrictions {
+			// Inherited restrictions are the default and aren't managed by this resource.
+			if restriction.Mode == types.PackageGroupOriginRestrictionModeInherit {
+				continue
+			}
+
+			tfMap := map[string]interface{}{
+				names.AttrMode:     string(restriction.Mode),
+				"restriction_type": string(restrictionType),
+			}
+
+			if restriction.Mode == types.PackageGroupOriginRestrictionModeAllowSpecificRepositories {
+				repositories, err := findAllowedRepositoriesForPackageGroup(ctx, conn, owner, domainName, pattern, types.PackageGroupOriginRestrictionType(restrictionType))
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "reading CodeArtifact Package Group (%s) allowed repositories (%s): %s", d.Id(), restrictionType, err)
+				}
+
+				tfMap["allowed_repositories"] = repositories
+			}
+
+			tfList = append(tfList, tfMap)
+		}
+	}
+	if err := d.Set("origin_restriction", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting origin_restriction: %s", err)
+	}
+
+	return diags
+}
+
+func resourcePackageGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	owner, domainName, pattern := parts[0], parts[1], parts[2]
+
+	if d.HasChanges("contact_info", names.AttrDescription) {
+		input := &codeartifact.UpdatePackageGroupInput{
+			ContactInfo:  aws.String(d.Get("contact_info").(string)),
+			Description:  aws.String(d.Get(names.AttrDescription).(string)),
+			Domain:       aws.String(domainName),
+			DomainOwner:  aws.String(owner),
+			PackageGroup: aws.String(pattern),
+		}
+
+		_, err := conn.UpdatePackageGroup(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CodeArtifact Package Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("origin_restriction") {
+		o, n := d.GetChange("origin_restriction")
+
+		if err := updatePackageGroupOriginConfiguration(ctx, conn, owner, domainName, pattern, o.(*schema.Set).List(), n.(*schema.Set).List()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CodeArtifact Package Group (%s) origin configuration: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePackageGroupRead(ctx, d, meta)...)
+}
+
+func resourcePackageGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting CodeArtifact Package Group: %s", d.Id())
+	_, err = conn.DeletePackageGroup(ctx, &codeartifact.DeletePackageGroupInput{
+		Domain:       aws.String(parts[1]),
+		DomainOwner:  aws.String(parts[0]),
+		PackageGroup: aws.String(parts[2]),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CodeArtifact Package Group (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// updatePackageGroupOriginConfiguration applies the difference between the old and new origin restrictions.
+// Restriction types that are no longer configured revert to INHERIT.
+func updatePackageGroupOriginConfiguration(ctx context.Context, conn *codeartifact.Client, owner, domainName, pattern string, o, n []interface{}) error {
+	oldModes, oldRepositories := expandPackageGroupOriginRestrictions(o)
+	newModes, newRepositories := expandPackageGroupOriginRestrictions(n)
+
+	input := &codeartifact.UpdatePackageGroupOriginConfigurationInput{
+		Domain:       aws.String(domainName),
+		DomainOwner:  aws.String(owner),
+		PackageGroup: aws.String(pattern),
+		Restrictions: make(map[string]types.PackageGroupOriginRestrictionMode),
+	}
+
+	for _, restrictionType := range enum.EnumValues[types.PackageGroupOriginRestrictionType]() {
+		oldMode, newMode := oldModes[restrictionType], newModes[restrictionType]
+
+		if newMode == "" {
+			newMode = types.PackageGroupOriginRestrictionModeInherit
+		}
+
+		if oldMode != newMode {
+			input.Restrictions[string(restrictionType)] = newMode
+		}
+
+		for _, v := range newRepositories[restrictionType].Difference(oldRepositories[restrictionType]).List() {
+			input.AddAllowedRepositories = append(input.AddAllowedRepositories, types.PackageGroupAllowedRepository{
+				OriginRestrictionType: restrictionType,
+				RepositoryName:        aws.String(v.(string)),
+			})
+		}
+
+		for _, v := range oldRepositories[restrictionType].Difference(newRepositories[restrictionType]).List() {
+			input.RemoveAllowedRepositories = append(input.RemoveAllowedRepositories, types.PackageGroupAllowedRepository{
+				OriginRestrictionType: restrictionType,
+				RepositoryName:        aws.String(v.(string)),
+			})
+		}
+	}
+
+	if len(input.Restrictions) == 0 && len(input.AddAllowedRepositories) == 0 && len(input.RemoveAllowedRepositories) == 0 {
+		return nil
+	}
+
+	_, err := conn.UpdatePackageGroupOriginConfiguration(ctx, input)
+
+	return err
+}
+
+func findPackageGroupByThreePartKey(ctx context.Context, conn *codeartifact.Client, owner, domainName, pattern string) (*types.PackageGroupDescription, error) {
+	input := &codeartifact.DescribePackageGroupInput{
+		Domain:       aws.String(domainName),
+		DomainOwner:  aws.String(owner),
+		PackageGroup: aws.String(pattern),
+	}
+
+	output, err := conn.DescribePackageGroup(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PackageGroup == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.PackageGroup, nil
+}
+
+func findAllowedRepositoriesForPackageGroup(ctx context.Context, conn *codeartifact.Client, owner, domainName, pattern string, restrictionType types.PackageGroupOriginRestrictionType) ([]string, error) {
+	input := &codeartifact.ListAllowedRepositoriesForGroupInput{
+		Domain:                aws.String(domainName),
+		DomainOwner:           aws.String(owner),
+		OriginRestrictionType: restrictionType,
+		PackageGroup:          aws.String(pattern),
+	}
+	var output []string
+
+	pages := codeartifact.NewListAllowedRepositoriesForGroupPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AllowedRepositories...)
+	}
+
+	return output, nil
+}
+
+func expandPackageGroupOriginRestrictions(tfList []interface{}) (map[types.PackageGroupOriginRestrictionType]types.PackageGroupOriginRestrictionMode, map[types.PackageGroupOriginRestrictionType]*schema.Set) {
+	modes := make(map[types.PackageGroupOriginRestrictionType]types.PackageGroupOriginRestrictionMode)
+	repositories := make(map[types.PackageGroupOriginRestrictionType]*schema.Set)
+
+	for _, restrictionType := range enum.EnumValues[types.PackageGroupOriginRestrictionType]() {
+		repositories[restrictionType] = schema.NewSet(schema.HashString, nil)
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		restrictionType := types.PackageGroupOriginRestrictionType(tfMap["restriction_type"].(string))
+		modes[restrictionType] = types.PackageGroupOriginRestrictionMode(tfMap[names.AttrMode].(string))
+
+		if v, ok := tfMap["allowed_repositories"].(*schema.Set); ok {
+			repositories[restrictionType] = v
+		}
+	}
+
+	return modes, repositories
+}
@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodeartifact "github.com/hashicorp/terraform-provider-aws/internal/service/codeartifact"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccPackageGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeartifact_package_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CodeArtifactEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageGroupConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "contact_info", ""),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedTime),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDomain, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_owner", "aws_codeartifact_domain.test", names.AttrOwner),
+					resource.TestCheckResourceAttr(resourceName, "origin_restriction.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "pattern", fmt.Sprintf("/npm/%s/*", rName)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPackageGroupConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPackageGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeartifact_package_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CodeArtifactEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageGroupConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcodeartifact.ResourcePackageGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccPackageGroup_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeartifact_package_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CodeArtifactEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageGroupConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPackageGroupConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccPackageGroupConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPackageGroup_originRestrictions(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeartifact_package_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CodeArtifactEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageGroupConfig_originRestrictions(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "origin_restriction.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "origin_restriction.*", map[string]string{
+						names.AttrMode:     "BLOCK",
+						"restriction_type": "PUBLISH",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "origin_restriction.*", map[string]string{
+						"allowed_repositories.#": acctest.CtOne,
+						names.AttrMode:           "ALLOW_SPECIFIC_REPOSITORIES",
+						"restriction_type":       "EXTERNAL_UPSTREAM",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "origin_restriction.*.allowed_repositories.*", fmt.Sprintf("%s-1", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPackageGroupConfig_originRestrictionsUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "origin_restriction.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "origin_restriction.*", map[string]string{
+						"allowed_repositories.#": "2",
+						names.AttrMode:           "ALLOW_SPECIFIC_REPOSITORIES",
+						"restriction_type":       "EXTERNAL_UPSTREAM",
+					}),
+				),
+			},
+			{
+				Config: testAccPackageGroupConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "origin_restriction.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPackageGroupExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeArtifactClient(ctx)
+
+		_, err := tfcodeartifact.FindPackageGroupByThreePartKey(ctx, conn, rs.Primary.Attributes["domain_owner"], rs.Primary.Attributes[names.AttrDomain], rs.Primary.Attributes["pattern"])
+
+		return err
+	}
+}
+
+func testAccCheckPackageGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_codeartifact_package_group" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).CodeArtifactClient(ctx)
+
+			_, err := tfcodeartifact.FindPackageGroupByThreePartKey(ctx, conn, rs.Primary.Attributes["domain_owner"], rs.Primary.Attributes[names.AttrDomain], rs.Primary.Attributes["pattern"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CodeArtifact Package Group %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccPackageGroupConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccRepositoryConfig_base(rName), fmt.Sprintf(`
+resource "aws_codeartifact_package_group" "test" {
+  domain      = aws_codeartifact_domain.test.domain
+  pattern     = "/npm/%[1]s/*"
+  description = %[2]q
+}
+`, rName, description))
+}
+
+func testAccPackageGroupConfig_originRestrictionsBase(rName string) string {
+	return acctest.ConfigCompose(testAccRepositoryConfig_base(rName), fmt.Sprintf(`
+resource "aws_codeartifact_repository" "test" {
+  count = 2
+
+  repository = "%[1]s-${count.index}"
+  domain     = aws_codeartifact_domain.test.domain
+}
+`, rName))
+}
+
+func testAccPackageGroupConfig_originRestrictions(rName string) string {
+	return acctest.ConfigCompose(testAccPackageGroupConfig_originRestrictionsBase(rName), fmt.Sprintf(`
+resource "aws_codeartifact_package_group" "test" {
+  domain      = aws_codeartifact_domain.test.domain
+  pattern     = "/npm/%[1]s/*"
+  description = "test"
+
+  origin_restriction {
+    restriction_type = "PUBLISH"
+    mode             = "BLOCK"
+  }
+
+  origin_restriction {
+    restriction_type     = "EXTERNAL_UPSTREAM"
+    mode                 = "ALLOW_SPECIFIC_REPOSITORIES"
+    allowed_repositories = [aws_codeartifact_repository.test[1].repository]
+  }
+}
+`, rName))
+}
+
+func testAccPackageGroupConfig_originRestrictionsUpdated(rName string) string {
+	return acctest.ConfigCompose(testAccPackageGroupConfig_originRestrictionsBase(rName), fmt.Sprintf(`
+resource "aws_codeartifact_package_group" "test" {
+  domain      = aws_codeartifact_domain.test.domain
+  pattern     = "/npm/%[1]s/*"
+  description = "test"
+
+  origin_restriction {
+    restriction_type     = "EXTERNAL_UPSTREAM"
+    mode                 = "ALLOW_SPECIFIC_REPOSITORIES"
+    allowed_repositories = aws_codeartifact_repository.test[*].repository
+  }
+}
+`, rName))
+}
+
+func testAccPackageGroupConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccRepositoryConfig_base(rName), fmt.Sprintf(`
+resource "aws_codeartifact_package_group" "test" {
+  domain  = aws_codeartifact_domain.test.domain
+  pattern = "/npm/%[1]s/*"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccPackageGroupConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccRepositoryConfig_base(rName), fmt.Sprintf(`
+resource "aws_codeartifact_package_group" "test" {
+  domain  = aws_codeartifact_domain.test.domain
+  pattern = "/npm/%[1]s/*"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
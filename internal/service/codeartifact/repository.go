@@ -219,8 +219,10 @@ func resourceRepositoryUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		if d.HasChange("upstream") {
-			if v, ok := d.GetOk("upstream"); ok && len(v.([]interface{})) > 0 {
-				input.Upstreams = expandUpstreams(v.([]interface{}))
+			// Upstreams are replaced as a whole, in order. An empty list removes all upstreams.
+			input.Upstreams = expandUpstreams(d.Get("upstream").([]interface{}))
+			if input.Upstreams == nil {
+				input.Upstreams = []types.UpstreamRepository{}
 			}
 		}
 
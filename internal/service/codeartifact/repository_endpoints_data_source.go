@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_codeartifact_repository_endpoints", name="Repository Endpoints")
+func dataSourceRepositoryEndpoints() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRepositoryEndpointsRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrDomain: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"domain_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repository_endpoints": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceRepositoryEndpointsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	domainName := d.Get(names.AttrDomain).(string)
+	var domainOwner string
+	if v, ok := d.GetOk("domain_owner"); ok {
+		domainOwner = v.(string)
+	} else {
+		domainOwner = meta.(*conns.AWSClient).AccountID
+	}
+	repositoryName := d.Get("repository").(string)
+	endpoints := make(map[string]string)
+
+	for _, format := range enum.EnumValues[types.PackageFormat]() {
+		input := &codeartifact.GetRepositoryEndpointInput{
+			Domain:      aws.String(domainName),
+			DomainOwner: aws.String(domainOwner),
+			Format:      format,
+			Repository:  aws.String(repositoryName),
+		}
+
+		output, err := conn.GetRepositoryEndpoint(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading CodeArtifact Repository Endpoint (%s): %s", format, err)
+		}
+
+		endpoints[string(format)] = aws.ToString(output.RepositoryEndpoint)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", domainOwner, domainName, repositoryName))
+	d.Set("domain_owner", domainOwner)
+	d.Set("repository_endpoints", endpoints)
+
+	return diags
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRepositoryEndpointsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_codeartifact_repository_endpoints.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CodeArtifactEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryEndpointsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, "domain_owner"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "repository_endpoints.npm"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "repository_endpoints.pypi"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "repository_endpoints.maven"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "repository_endpoints.nuget"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRepositoryEndpointsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccCheckRepositoryEndpointBaseConfig(rName),
+		`
+data "aws_codeartifact_repository_endpoints" "test" {
+  domain     = aws_codeartifact_domain.test.domain
+  repository = aws_codeartifact_repository.test.repository
+}
+`)
+}
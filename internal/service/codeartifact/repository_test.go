@@ -10,6 +10,7 @@ import (
 
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -199,6 +200,20 @@ func testAccRepository_upstreams(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "upstream.1.repository_name", fmt.Sprintf("%s-upstream2", rName)),
 				),
 			},
+			{
+				Config: testAccRepositoryConfig_upstreams2Reordered(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "upstream.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "upstream.0.repository_name", fmt.Sprintf("%s-upstream2", rName)),
+					resource.TestCheckResourceAttr(resourceName, "upstream.1.repository_name", fmt.Sprintf("%s-upstream1", rName)),
+				),
+			},
 			{
 				Config: testAccRepositoryConfig_upstreams1(rName),
 				Check: resource.ComposeTestCheckFunc(
@@ -207,6 +222,18 @@ func testAccRepository_upstreams(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "upstream.0.repository_name", fmt.Sprintf("%s-upstream1", rName)),
 				),
 			},
+			{
+				Config: testAccRepositoryConfig_upstreamsRemoved(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "upstream.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -410,6 +437,47 @@ resource "aws_codeartifact_repository" "test" {
 `, rName)
 }
 
+func testAccRepositoryConfig_upstreams2Reordered(rName string) string {
+	return testAccRepositoryConfig_base(rName) + fmt.Sprintf(`
+resource "aws_codeartifact_repository" "upstream1" {
+  repository = "%[1]s-upstream1"
+  domain     = aws_codeartifact_domain.test.domain
+}
+
+resource "aws_codeartifact_repository" "upstream2" {
+  repository = "%[1]s-upstream2"
+  domain     = aws_codeartifact_domain.test.domain
+}
+
+resource "aws_codeartifact_repository" "test" {
+  repository = %[1]q
+  domain     = aws_codeartifact_domain.test.domain
+
+  upstream {
+    repository_name = aws_codeartifact_repository.upstream2.repository
+  }
+
+  upstream {
+    repository_name = aws_codeartifact_repository.upstream1.repository
+  }
+}
+`, rName)
+}
+
+func testAccRepositoryConfig_upstreamsRemoved(rName string) string {
+	return testAccRepositoryConfig_base(rName) + fmt.Sprintf(`
+resource "aws_codeartifact_repository" "upstream1" {
+  repository = "%[1]s-upstream1"
+  domain     = aws_codeartifact_domain.test.domain
+}
+
+resource "aws_codeartifact_repository" "test" {
+  repository = %[1]q
+  domain     = aws_codeartifact_domain.test.domain
+}
+`, rName)
+}
+
 func testAccRepositoryConfig_externalConnection(rName string) string {
 	return testAccRepositoryConfig_base(rName) + fmt.Sprintf(`
 resource "aws_codeartifact_repository" "test" {
@@ -34,6 +34,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_codeartifact_repository_endpoint",
 			Name:     "Repository Endpoint",
 		},
+		{
+			Factory:  dataSourceRepositoryEndpoints,
+			TypeName: "aws_codeartifact_repository_endpoints",
+			Name:     "Repository Endpoints",
+		},
 	}
 }
 
@@ -52,6 +57,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_codeartifact_domain_permissions_policy",
 			Name:     "Domain Permissions Policy",
 		},
+		{
+			Factory:  resourcePackageGroup,
+			TypeName: "aws_codeartifact_package_group",
+			Name:     "Package Group",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  resourceRepository,
 			TypeName: "aws_codeartifact_repository",
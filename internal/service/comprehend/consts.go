@@ -19,3 +19,6 @@ const documentClassifierCreatedDelay = 15 * time.Minute
 const documentClassifierStoppedDelay = 0
 const documentClassifierDeletedDelay = 5 * time.Minute
 const documentClassifierPollInterval = 1 * time.Minute
+
+const flywheelPollInterval = 30 * time.Second
+const flywheelIterationPollInterval = 1 * time.Minute
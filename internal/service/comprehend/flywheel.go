@@ -0,0 +1,586 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package comprehend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_comprehend_flywheel", name="Flywheel")
+// @Tags(identifierAttribute="id")
+func ResourceFlywheel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFlywheelCreate,
+		ReadWithoutTimeout:   resourceFlywheelRead,
+		UpdateWithoutTimeout: resourceFlywheelUpdate,
+		DeleteWithoutTimeout: resourceFlywheelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"active_model_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_access_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"data_lake_s3_uri": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				// On return, the data lake location has the flywheel's own path appended
+				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+					return newValue != "" && strings.HasPrefix(oldValue, strings.TrimRight(newValue, "/"))
+				},
+			},
+			"data_security_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_lake_kms_key_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: tfkms.DiffSuppressKey,
+							ValidateFunc:     tfkms.ValidateKey,
+						},
+						"model_kms_key_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: tfkms.DiffSuppressKey,
+							ValidateFunc:     tfkms.ValidateKey,
+						},
+						"volume_kms_key_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: tfkms.DiffSuppressKey,
+							ValidateFunc:     tfkms.ValidateKey,
+						},
+						names.AttrVPCConfig: {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrSecurityGroupIDs: {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									names.AttrSubnets: {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"latest_flywheel_iteration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"model_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.ModelType](),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validModelName,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"task_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"document_classification_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"labels": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1000,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									names.AttrMode: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.DocumentClassifierMode](),
+									},
+								},
+							},
+							ConflictsWith: []string{"task_config.0.entity_recognition_config"},
+						},
+						"entity_recognition_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"entity_types": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 25,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrType: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+							ConflictsWith: []string{"task_config.0.document_classification_config"},
+						},
+						"language_code": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.LanguageCode](),
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFlywheelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ComprehendClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	in := &comprehend.CreateFlywheelInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		DataAccessRoleArn:  aws.String(d.Get("data_access_role_arn").(string)),
+		DataLakeS3Uri:      aws.String(d.Get("data_lake_s3_uri").(string)),
+		FlywheelName:       aws.String(name),
+		Tags:               getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("active_model_arn"); ok {
+		in.ActiveModelArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("data_security_config"); ok {
+		in.DataSecurityConfig = expandFlywheelDataSecurityConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("model_type"); ok {
+		in.ModelType = types.ModelType(v.(string))
+	}
+
+	if v, ok := d.GetOk("task_config"); ok {
+		in.TaskConfig = expandFlywheelTaskConfig(v.([]interface{}))
+	}
+
+	// Because the IAM credentials are evaluated when the data lake is created, we need to ensure we wait for the IAM propagation delay
+	out, err := tfresource.RetryWhenIsAErrorMessageContains[*types.InvalidRequestException](ctx, iamPropagationTimeout, func() (interface{}, error) {
+		return conn.CreateFlywheel(ctx, in)
+	}, "Failed to assume role")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Comprehend Flywheel (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(out.(*comprehend.CreateFlywheelOutput).FlywheelArn))
+
+	if _, err := waitFlywheelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Comprehend Flywheel (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceFlywheelRead(ctx, d, meta)...)
+}
+
+func resourceFlywheelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ComprehendClient(ctx)
+
+	out, err := FindFlywheelByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Comprehend Flywheel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Comprehend Flywheel (%s): %s", d.Id(), err)
+	}
+
+	d.Set("active_model_arn", out.ActiveModelArn)
+	d.Set(names.AttrARN, out.FlywheelArn)
+	d.Set("data_access_role_arn", out.DataAccessRoleArn)
+	d.Set("data_lake_s3_uri", out.DataLakeS3Uri)
+	d.Set("latest_flywheel_iteration", out.LatestFlywheelIteration)
+	d.Set("model_type", out.ModelType)
+	d.Set(names.AttrStatus, out.Status)
+
+	// DescribeFlywheel() doesn't return the flywheel name
+	name, err := FlywheelParseARN(aws.ToString(out.FlywheelArn))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Comprehend Flywheel (%s): %s", d.Id(), err)
+	}
+	d.Set(names.AttrName, name)
+
+	if err := d.Set("data_security_config", flattenFlywheelDataSecurityConfig(out.DataSecurityConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting data_security_config: %s", err)
+	}
+
+	if err := d.Set("task_config", flattenFlywheelTaskConfig(out.TaskConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting task_config: %s", err)
+	}
+
+	return diags
+}
+
+func resourceFlywheelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ComprehendClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		in := &comprehend.UpdateFlywheelInput{
+			FlywheelArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("active_model_arn") {
+			in.ActiveModelArn = aws.String(d.Get("active_model_arn").(string))
+		}
+
+		if d.HasChange("data_access_role_arn") {
+			in.DataAccessRoleArn = aws.String(d.Get("data_access_role_arn").(string))
+		}
+
+		if d.HasChange("data_security_config") {
+			in.DataSecurityConfig = expandFlywheelUpdateDataSecurityConfig(d.Get("data_security_config").([]interface{}))
+		}
+
+		_, err := conn.UpdateFlywheel(ctx, in)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Comprehend Flywheel (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitFlywheelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Comprehend Flywheel (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceFlywheelRead(ctx, d, meta)...)
+}
+
+func resourceFlywheelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ComprehendClient(ctx)
+
+	log.Printf("[INFO] Deleting Comprehend Flywheel (%s)", d.Id())
+
+	// A flywheel cannot be deleted while an iteration is in progress.
+	_, err := tfresource.RetryWhenIsA[*types.ResourceInUseException](ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteFlywheel(ctx, &comprehend.DeleteFlywheelInput{
+			FlywheelArn: aws.String(d.Id()),
+		})
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Comprehend Flywheel (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFlywheelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Comprehend Flywheel (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindFlywheelByID(ctx context.Context, conn *comprehend.Client, id string) (*types.FlywheelProperties, error) {
+	in := &comprehend.DescribeFlywheelInput{
+		FlywheelArn: aws.String(id),
+	}
+
+	out, err := conn.DescribeFlywheel(ctx, in)
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || out.FlywheelProperties == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.FlywheelProperties, nil
+}
+
+func statusFlywheel(ctx context.Context, conn *comprehend.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindFlywheelByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func waitFlywheelActive(ctx context.Context, conn *comprehend.Client, id string, timeout time.Duration) (*types.FlywheelProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(types.FlywheelStatusCreating, types.FlywheelStatusUpdating),
+		Target:       enum.Slice(types.FlywheelStatusActive),
+		Refresh:      statusFlywheel(ctx, conn, id),
+		PollInterval: flywheelPollInterval,
+		Timeout:      timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if output, ok := outputRaw.(*types.FlywheelProperties); ok {
+		if output.Status == types.FlywheelStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+		}
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlywheelDeleted(ctx context.Context, conn *comprehend.Client, id string, timeout time.Duration) (*types.FlywheelProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(types.FlywheelStatusActive, types.FlywheelStatusDeleting),
+		Target:       []string{},
+		Refresh:      statusFlywheel(ctx, conn, id),
+		PollInterval: flywheelPollInterval,
+		Timeout:      timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if output, ok := outputRaw.(*types.FlywheelProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandFlywheelDataSecurityConfig(tfList []interface{}) *types.DataSecurityConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	a := &types.DataSecurityConfig{
+		VpcConfig: expandVPCConfig(tfMap[names.AttrVPCConfig].([]interface{})),
+	}
+
+	if v, ok := tfMap["data_lake_kms_key_id"].(string); ok && v != "" {
+		a.DataLakeKmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["model_kms_key_id"].(string); ok && v != "" {
+		a.ModelKmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["volume_kms_key_id"].(string); ok && v != "" {
+		a.VolumeKmsKeyId = aws.String(v)
+	}
+
+	return a
+}
+
+func expandFlywheelUpdateDataSecurityConfig(tfList []interface{}) *types.UpdateDataSecurityConfig {
+	a := &types.UpdateDataSecurityConfig{}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return a
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	a.VpcConfig = expandVPCConfig(tfMap[names.AttrVPCConfig].([]interface{}))
+
+	if v, ok := tfMap["model_kms_key_id"].(string); ok && v != "" {
+		a.ModelKmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["volume_kms_key_id"].(string); ok && v != "" {
+		a.VolumeKmsKeyId = aws.String(v)
+	}
+
+	return a
+}
+
+func flattenFlywheelDataSecurityConfig(apiObject *types.DataSecurityConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"data_lake_kms_key_id": aws.ToString(apiObject.DataLakeKmsKeyId),
+		"model_kms_key_id":     aws.ToString(apiObject.ModelKmsKeyId),
+		"volume_kms_key_id":    aws.ToString(apiObject.VolumeKmsKeyId),
+		names.AttrVPCConfig:    flattenVPCConfig(apiObject.VpcConfig),
+	}
+
+	return []interface{}{m}
+}
+
+func expandFlywheelTaskConfig(tfList []interface{}) *types.TaskConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	a := &types.TaskConfig{
+		LanguageCode: types.LanguageCode(tfMap["language_code"].(string)),
+	}
+
+	if v, ok := tfMap["document_classification_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+
+		a.DocumentClassificationConfig = &types.DocumentClassificationConfig{
+			Labels: flex.ExpandStringValueSet(m["labels"].(*schema.Set)),
+			Mode:   types.DocumentClassifierMode(m[names.AttrMode].(string)),
+		}
+	}
+
+	if v, ok := tfMap["entity_recognition_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+
+		a.EntityRecognitionConfig = &types.EntityRecognitionConfig{
+			EntityTypes: expandEntityTypes(m["entity_types"].(*schema.Set)),
+		}
+	}
+
+	return a
+}
+
+func flattenFlywheelTaskConfig(apiObject *types.TaskConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"language_code": apiObject.LanguageCode,
+	}
+
+	if v := apiObject.DocumentClassificationConfig; v != nil {
+		m["document_classification_config"] = []interface{}{map[string]interface{}{
+			"labels":       flex.FlattenStringValueSet(v.Labels),
+			names.AttrMode: v.Mode,
+		}}
+	}
+
+	if v := apiObject.EntityRecognitionConfig; v != nil {
+		m["entity_recognition_config"] = []interface{}{map[string]interface{}{
+			"entity_types": flattenEntityTypes(v.EntityTypes),
+		}}
+	}
+
+	return []interface{}{m}
+}
+
+func FlywheelParseARN(arnString string) (string, error) {
+	arn, err := arn.Parse(arnString)
+	if err != nil {
+		return "", err
+	}
+	re := regexache.MustCompile(`^flywheel/([[:alnum:]-]+)`)
+	matches := re.FindStringSubmatch(arn.Resource)
+	if len(matches) != 2 {
+		return "", fmt.Errorf("unable to parse %q", arnString)
+	}
+	name := matches[1]
+
+	return name, nil
+}
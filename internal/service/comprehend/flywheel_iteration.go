@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package comprehend
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const flywheelIterationIDPartCount = 2
+
+// @SDKResource("aws_comprehend_flywheel_iteration", name="Flywheel Iteration")
+func ResourceFlywheelIteration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFlywheelIterationCreate,
+		ReadWithoutTimeout:   resourceFlywheelIterationRead,
+		DeleteWithoutTimeout: resourceFlywheelIterationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"evaluated_model_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"evaluation_manifest_s3_prefix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"flywheel_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"flywheel_iteration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trained_model_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTriggers: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceFlywheelIterationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ComprehendClient(ctx)
+
+	flywheelARN := d.Get("flywheel_arn").(string)
+	in := &comprehend.StartFlywheelIterationInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		FlywheelArn:        aws.String(flywheelARN),
+	}
+
+	out, err := conn.StartFlywheelIteration(ctx, in)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Comprehend Flywheel (%s) iteration: %s", flywheelARN, err)
+	}
+
+	iterationID := aws.ToString(out.FlywheelIterationId)
+	id, err := flex.FlattenResourceId([]string{flywheelARN, iterationID}, flywheelIterationIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitFlywheelIterationCompleted(ctx, conn, flywheelARN, iterationID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Comprehend Flywheel Iteration (%s) complete: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceFlywheelIterationRead(ctx, d, meta)...)
+}
+
+func resourceFlywheelIterationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ComprehendClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), flywheelIterationIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	out, err := FindFlywheelIterationByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Comprehend Flywheel Iteration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Comprehend Flywheel Iteration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("evaluated_model_arn", out.EvaluatedModelArn)
+	d.Set("evaluation_manifest_s3_prefix", out.EvaluationManifestS3Prefix)
+	d.Set("flywheel_arn", out.FlywheelArn)
+	d.Set("flywheel_iteration_id", out.FlywheelIterationId)
+	d.Set(names.AttrStatus, out.Status)
+	d.Set("trained_model_arn", out.TrainedModelArn)
+
+	return diags
+}
+
+func resourceFlywheelIterationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Flywheel iterations cannot be deleted; they are removed along with their flywheel.
+	log.Printf("[DEBUG] Removing Comprehend Flywheel Iteration (%s) from state", d.Id())
+
+	return diags
+}
+
+func FindFlywheelIterationByTwoPartKey(ctx context.Context, conn *comprehend.Client, flywheelARN, iterationID string) (*types.FlywheelIterationProperties, error) {
+	in := &comprehend.DescribeFlywheelIterationInput{
+		FlywheelArn:         aws.String(flywheelARN),
+		FlywheelIterationId: aws.String(iterationID),
+	}
+
+	out, err := conn.DescribeFlywheelIteration(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.FlywheelIterationProperties == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.FlywheelIterationProperties, nil
+}
+
+func statusFlywheelIteration(ctx context.Context, conn *comprehend.Client, flywheelARN, iterationID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindFlywheelIterationByTwoPartKey(ctx, conn, flywheelARN, iterationID)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func waitFlywheelIterationCompleted(ctx context.Context, conn *comprehend.Client, flywheelARN, iterationID string, timeout time.Duration) (*types.FlywheelIterationProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(types.FlywheelIterationStatusTraining, types.FlywheelIterationStatusEvaluating),
+		Target:       enum.Slice(types.FlywheelIterationStatusCompleted),
+		Refresh:      statusFlywheelIteration(ctx, conn, flywheelARN, iterationID),
+		PollInterval: flywheelIterationPollInterval,
+		Timeout:      timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if output, ok := outputRaw.(*types.FlywheelIterationProperties); ok {
+		if output.Status == types.FlywheelIterationStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+		}
+		return output, err
+	}
+
+	return nil, err
+}
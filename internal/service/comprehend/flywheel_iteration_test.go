@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package comprehend_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/comprehend/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfcomprehend "github.com/hashicorp/terraform-provider-aws/internal/service/comprehend"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccComprehendFlywheelIteration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_flywheel_iteration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComprehendEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComprehendServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlywheelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlywheelIterationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlywheelIterationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "flywheel_arn", "aws_comprehend_flywheel.test", names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "flywheel_iteration_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.FlywheelIterationStatusCompleted)),
+					resource.TestCheckResourceAttrSet(resourceName, "trained_model_arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTriggers},
+			},
+		},
+	})
+}
+
+func testAccCheckFlywheelIterationExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Comprehend Flywheel Iteration is set")
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendClient(ctx)
+
+		_, err = tfcomprehend.FindFlywheelIterationByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		return err
+	}
+}
+
+func testAccFlywheelIterationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDocumentClassifierConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iam_role_policy" "data_lake" {
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "s3:PutObject",
+        "s3:DeleteObject",
+      ]
+      Resource = "${aws_s3_bucket.test.arn}/flywheel/*"
+    }]
+  })
+}
+
+resource "aws_comprehend_flywheel" "test" {
+  name                 = %[1]q
+  active_model_arn     = aws_comprehend_document_classifier.test.arn
+  data_access_role_arn = aws_iam_role.test.arn
+  data_lake_s3_uri     = "s3://${aws_s3_bucket.test.bucket}/flywheel/"
+
+  depends_on = [aws_iam_role_policy.data_lake]
+}
+
+resource "aws_comprehend_flywheel_iteration" "test" {
+  flywheel_arn = aws_comprehend_flywheel.test.arn
+
+  triggers = {
+    active_model_arn = aws_comprehend_flywheel.test.active_model_arn
+  }
+}
+`, rName))
+}
@@ -0,0 +1,379 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package comprehend_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcomprehend "github.com/hashicorp/terraform-provider-aws/internal/service/comprehend"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccComprehendFlywheel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var flywheel types.FlywheelProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_flywheel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComprehendEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComprehendServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlywheelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlywheelConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlywheelExists(ctx, resourceName, &flywheel),
+					resource.TestCheckResourceAttr(resourceName, "active_model_arn", ""),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "comprehend", regexache.MustCompile(fmt.Sprintf(`flywheel/%s$`, rName))),
+					resource.TestCheckResourceAttrPair(resourceName, "data_access_role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "data_security_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "model_type", string(types.ModelTypeDocumentClassifier)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.FlywheelStatusActive)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "task_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "task_config.0.document_classification_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "task_config.0.document_classification_config.0.labels.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "task_config.0.document_classification_config.0.mode", string(types.DocumentClassifierModeMultiClass)),
+					resource.TestCheckResourceAttr(resourceName, "task_config.0.entity_recognition_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "task_config.0.language_code", "en"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The data lake location is returned with the flywheel's own path appended.
+				ImportStateVerifyIgnore: []string{"data_lake_s3_uri"},
+			},
+		},
+	})
+}
+
+func TestAccComprehendFlywheel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var flywheel types.FlywheelProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_flywheel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComprehendEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComprehendServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlywheelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlywheelConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlywheelExists(ctx, resourceName, &flywheel),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcomprehend.ResourceFlywheel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccComprehendFlywheel_entityRecognizer(t *testing.T) {
+	ctx := acctest.Context(t)
+	var flywheel types.FlywheelProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_flywheel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComprehendEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComprehendServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlywheelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlywheelConfig_entityRecognizer(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlywheelExists(ctx, resourceName, &flywheel),
+					resource.TestCheckResourceAttr(resourceName, "model_type", string(types.ModelTypeEntityRecognizer)),
+					resource.TestCheckResourceAttr(resourceName, "task_config.0.document_classification_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "task_config.0.entity_recognition_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "task_config.0.entity_recognition_config.0.entity_types.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "task_config.0.entity_recognition_config.0.entity_types.*", map[string]string{
+						names.AttrType: "ENGINEER",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "task_config.0.entity_recognition_config.0.entity_types.*", map[string]string{
+						names.AttrType: "MANAGER",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComprehendFlywheel_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var flywheel types.FlywheelProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_flywheel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComprehendEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComprehendServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlywheelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlywheelConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlywheelExists(ctx, resourceName, &flywheel),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"data_lake_s3_uri"},
+			},
+			{
+				Config: testAccFlywheelConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlywheelExists(ctx, resourceName, &flywheel),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFlywheelConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlywheelExists(ctx, resourceName, &flywheel),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFlywheelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_comprehend_flywheel" {
+				continue
+			}
+
+			_, err := tfcomprehend.FindFlywheelByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Comprehend Flywheel %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFlywheelExists(ctx context.Context, name string, flywheel *types.FlywheelProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Comprehend Flywheel is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendClient(ctx)
+
+		output, err := tfcomprehend.FindFlywheelByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*flywheel = *output
+
+		return nil
+	}
+}
+
+func testAccFlywheelConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		testAccDocumentClassifierS3BucketConfig(rName),
+		fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "comprehend.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "s3:GetObject",
+        "s3:PutObject",
+        "s3:DeleteObject",
+        "s3:ListBucket",
+      ]
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccFlywheelConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFlywheelConfig_base(rName), fmt.Sprintf(`
+resource "aws_comprehend_flywheel" "test" {
+  name                 = %[1]q
+  data_access_role_arn = aws_iam_role.test.arn
+  data_lake_s3_uri     = "s3://${aws_s3_bucket.test.bucket}/flywheel/"
+  model_type           = "DOCUMENT_CLASSIFIER"
+
+  task_config {
+    language_code = "en"
+
+    document_classification_config {
+      mode   = "MULTI_CLASS"
+      labels = ["ENGINEER", "MANAGER"]
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccFlywheelConfig_entityRecognizer(rName string) string {
+	return acctest.ConfigCompose(testAccFlywheelConfig_base(rName), fmt.Sprintf(`
+resource "aws_comprehend_flywheel" "test" {
+  name                 = %[1]q
+  data_access_role_arn = aws_iam_role.test.arn
+  data_lake_s3_uri     = "s3://${aws_s3_bucket.test.bucket}/flywheel/"
+  model_type           = "ENTITY_RECOGNIZER"
+
+  task_config {
+    language_code = "en"
+
+    entity_recognition_config {
+      entity_types {
+        type = "ENGINEER"
+      }
+      entity_types {
+        type = "MANAGER"
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccFlywheelConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccFlywheelConfig_base(rName), fmt.Sprintf(`
+resource "aws_comprehend_flywheel" "test" {
+  name                 = %[1]q
+  data_access_role_arn = aws_iam_role.test.arn
+  data_lake_s3_uri     = "s3://${aws_s3_bucket.test.bucket}/flywheel/"
+  model_type           = "DOCUMENT_CLASSIFIER"
+
+  task_config {
+    language_code = "en"
+
+    document_classification_config {
+      mode   = "MULTI_CLASS"
+      labels = ["ENGINEER", "MANAGER"]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccFlywheelConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccFlywheelConfig_base(rName), fmt.Sprintf(`
+resource "aws_comprehend_flywheel" "test" {
+  name                 = %[1]q
+  data_access_role_arn = aws_iam_role.test.arn
+  data_lake_s3_uri     = "s3://${aws_s3_bucket.test.bucket}/flywheel/"
+  model_type           = "DOCUMENT_CLASSIFIER"
+
+  task_config {
+    language_code = "en"
+
+    document_classification_config {
+      mode   = "MULTI_CLASS"
+      labels = ["ENGINEER", "MANAGER"]
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -44,6 +44,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  ResourceFlywheel,
+			TypeName: "aws_comprehend_flywheel",
+			Name:     "Flywheel",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
+		},
+		{
+			Factory:  ResourceFlywheelIteration,
+			TypeName: "aws_comprehend_flywheel_iteration",
+			Name:     "Flywheel Iteration",
+		},
 	}
 }
 
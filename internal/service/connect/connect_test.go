@@ -28,11 +28,12 @@ func TestAccConnect_serial(t *testing.T) {
 			"dataSource_name": testAccContactFlowDataSource_name,
 		},
 		"ContactFlowModule": {
-			"basic":           testAccContactFlowModule_basic,
-			"disappears":      testAccContactFlowModule_disappears,
-			"filename":        testAccContactFlowModule_filename,
-			"dataSource_id":   testAccContactFlowModuleDataSource_contactFlowModuleID,
-			"dataSource_name": testAccContactFlowModuleDataSource_name,
+			"basic":              testAccContactFlowModule_basic,
+			"disappears":         testAccContactFlowModule_disappears,
+			"filename":           testAccContactFlowModule_filename,
+			"dataSource_id":      testAccContactFlowModuleDataSource_contactFlowModuleID,
+			"dataSource_name":    testAccContactFlowModuleDataSource_name,
+			"dataSource_content": testAccContactFlowModuleContentDataSource_basic,
 		},
 		"HoursOfOperation": {
 			"basic":           testAccHoursOfOperation_basic,
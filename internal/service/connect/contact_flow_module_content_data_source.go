@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_connect_contact_flow_module_content")
+func DataSourceContactFlowModuleContent() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceContactFlowModuleContentRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrContent: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"placeholders": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validation.MapKeyMatch(
+					regexache.MustCompile(`^[0-9A-Za-z_.-]+$`),
+					"must contain only alphanumeric characters, underscores, hyphens and periods",
+				),
+			},
+			"template": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceContactFlowModuleContentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	content, err := renderContactFlowContent(d.Get("template").(string), d.Get("placeholders").(map[string]interface{}))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "rendering Connect Contact Flow Module content: %s", err)
+	}
+
+	if err := validContactFlowContent(content); err != nil {
+		return sdkdiag.AppendErrorf(diags, "validating Connect Contact Flow Module content: %s", err)
+	}
+
+	content, err = structure.NormalizeJsonString(content)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "normalizing Connect Contact Flow Module content: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(content)))
+	d.Set(names.AttrContent, content)
+
+	return diags
+}
+
+var contactFlowPlaceholderRegexp = regexache.MustCompile(`{{\s*([0-9A-Za-z_.-]+)\s*}}`)
+
+// renderContactFlowContent replaces each "{{ name }}" placeholder in the template with its value.
+// Values are JSON-escaped so that the rendered content remains valid JSON.
+func renderContactFlowContent(template string, placeholders map[string]interface{}) (string, error) {
+	var missing []string
+
+	content := contactFlowPlaceholderRegexp.ReplaceAllStringFunc(template, func(s string) string {
+		name := contactFlowPlaceholderRegexp.FindStringSubmatch(s)[1]
+
+		v, ok := placeholders[name]
+		if !ok {
+			missing = append(missing, name)
+			return s
+		}
+
+		b, _ := json.Marshal(v.(string))
+
+		// Strip the enclosing quotes.
+		return string(b[1 : len(b)-1])
+	})
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return "", fmt.Errorf("no value provided for placeholders: %s", strings.Join(missing, ", "))
+	}
+
+	return content, nil
+}
+
+type contactFlowTransition struct {
+	NextAction string `json:"NextAction"`
+}
+
+type contactFlowAction struct {
+	Identifier  *string `json:"Identifier"`
+	Type        *string `json:"Type"`
+	Transitions *struct {
+		contactFlowTransition
+		Conditions []contactFlowTransition `json:"Conditions"`
+		Errors     []contactFlowTransition `json:"Errors"`
+	} `json:"Transitions"`
+}
+
+type contactFlowDocument struct {
+	Actions     []contactFlowAction `json:"Actions"`
+	StartAction *string             `json:"StartAction"`
+	Version     *string             `json:"Version"`
+}
+
+// validContactFlowContent checks the structure of content in the Amazon Connect Flow language:
+// required top-level fields, unique action identifiers and references between actions.
+// Action types and parameters aren't checked; Amazon Connect validates them when the flow is saved.
+// See https://docs.aws.amazon.com/connect/latest/APIReference/flow-language.html.
+func validContactFlowContent(content string) error {
+	var document contactFlowDocument
+
+	if err := json.Unmarshal([]byte(content), &document); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	if aws.StringValue(document.Version) == "" {
+		return fmt.Errorf("missing required field Version")
+	}
+
+	if aws.StringValue(document.StartAction) == "" {
+		return fmt.Errorf("missing required field StartAction")
+	}
+
+	if len(document.Actions) == 0 {
+		return fmt.Errorf("at least one action is required in Actions")
+	}
+
+	identifiers := make(map[string]struct{}, len(document.Actions))
+	for i, action := range document.Actions {
+		if aws.StringValue(action.Identifier) == "" {
+			return fmt.Errorf("action %d: missing required field Identifier", i)
+		}
+
+		identifier := aws.StringValue(action.Identifier)
+		if _, ok := identifiers[identifier]; ok {
+			return fmt.Errorf("action %d: duplicate Identifier %q", i, identifier)
+		}
+		identifiers[identifier] = struct{}{}
+
+		if aws.StringValue(action.Type) == "" {
+			return fmt.Errorf("action %q: missing required field Type", identifier)
+		}
+	}
+
+	if _, ok := identifiers[aws.StringValue(document.StartAction)]; !ok {
+		return fmt.Errorf("start action %q does not reference an action", aws.StringValue(document.StartAction))
+	}
+
+	for _, action := range document.Actions {
+		if action.Transitions == nil {
+			continue
+		}
+
+		transitions := []contactFlowTransition{action.Transitions.contactFlowTransition}
+		transitions = append(transitions, action.Transitions.Conditions...)
+		transitions = append(transitions, action.Transitions.Errors...)
+
+		for _, transition := range transitions {
+			if transition.NextAction == "" {
+				continue
+			}
+
+			if _, ok := identifiers[transition.NextAction]; !ok {
+				return fmt.Errorf("action %q: NextAction %q does not reference an action", aws.StringValue(action.Identifier), transition.NextAction)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRenderContactFlowContent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		template     string
+		placeholders map[string]interface{}
+		expected     string
+		wantErr      bool
+	}{
+		"no placeholders": {
+			template: `{"Text":"Hello"}`,
+			expected: `{"Text":"Hello"}`,
+		},
+		"substitution": {
+			template: `{"LambdaFunctionARN":"{{lambda_arn}}","Text":"{{ greeting }}"}`,
+			placeholders: map[string]interface{}{
+				"greeting":   "Hello",
+				"lambda_arn": "arn:aws:lambda:us-west-2:123456789012:function:example", //lintignore:AWSAT003,AWSAT005
+			},
+			expected: `{"LambdaFunctionARN":"arn:aws:lambda:us-west-2:123456789012:function:example","Text":"Hello"}`, //lintignore:AWSAT003,AWSAT005
+		},
+		"escaped value": {
+			template: `{"Text":"{{greeting}}"}`,
+			placeholders: map[string]interface{}{
+				"greeting": `Say "hello"`,
+			},
+			expected: `{"Text":"Say \"hello\""}`,
+		},
+		"missing placeholder": {
+			template: `{"Text":"{{greeting}}","Other":"{{other}}"}`,
+			placeholders: map[string]interface{}{
+				"greeting": "Hello",
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfconnect.RenderContactFlowContent(testCase.template, testCase.placeholders)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("error = %v, wantErr = %v", err, want)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %q, expected %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestValidContactFlowContent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content string
+		wantErr bool
+	}{
+		"valid": {
+			content: `{"Version":"2019-10-30","StartAction":"a","Actions":[{"Identifier":"a","Type":"MessageParticipant","Transitions":{"NextAction":"b","Errors":[{"NextAction":"b","ErrorType":"NoMatchingError"}],"Conditions":[]}},{"Identifier":"b","Type":"DisconnectParticipant","Transitions":{}}]}`,
+		},
+		"invalid JSON": {
+			content: `{"Version":`,
+			wantErr: true,
+		},
+		"missing Version": {
+			content: `{"StartAction":"a","Actions":[{"Identifier":"a","Type":"DisconnectParticipant"}]}`,
+			wantErr: true,
+		},
+		"missing StartAction": {
+			content: `{"Version":"2019-10-30","Actions":[{"Identifier":"a","Type":"DisconnectParticipant"}]}`,
+			wantErr: true,
+		},
+		"no Actions": {
+			content: `{"Version":"2019-10-30","StartAction":"a","Actions":[]}`,
+			wantErr: true,
+		},
+		"missing Type": {
+			content: `{"Version":"2019-10-30","StartAction":"a","Actions":[{"Identifier":"a"}]}`,
+			wantErr: true,
+		},
+		"duplicate Identifier": {
+			content: `{"Version":"2019-10-30","StartAction":"a","Actions":[{"Identifier":"a","Type":"DisconnectParticipant"},{"Identifier":"a","Type":"DisconnectParticipant"}]}`,
+			wantErr: true,
+		},
+		"unknown StartAction": {
+			content: `{"Version":"2019-10-30","StartAction":"b","Actions":[{"Identifier":"a","Type":"DisconnectParticipant"}]}`,
+			wantErr: true,
+		},
+		"unknown NextAction": {
+			content: `{"Version":"2019-10-30","StartAction":"a","Actions":[{"Identifier":"a","Type":"MessageParticipant","Transitions":{"Conditions":[{"NextAction":"c"}]}}]}`,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfconnect.ValidContactFlowContent(testCase.content)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("error = %v, wantErr = %v", err, want)
+			}
+		})
+	}
+}
+
+func TestAccConnectContactFlowModuleContentDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccContactFlowModuleContentDataSourceConfig_missingPlaceholder(),
+				ExpectError: regexache.MustCompile(`no value provided for placeholders: message`),
+			},
+			{
+				Config:      testAccContactFlowModuleContentDataSourceConfig_unknownNextAction(),
+				ExpectError: regexache.MustCompile(`NextAction "missing" does not reference an action`),
+			},
+		},
+	})
+}
+
+func testAccContactFlowModuleContentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_contact_flow_module.test"
+	datasourceName := "data.aws_connect_contact_flow_module_content.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContactFlowModuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactFlowModuleContentDataSourceConfig_basic(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceName, names.AttrID),
+					resource.TestMatchResourceAttr(datasourceName, names.AttrContent, regexache.MustCompile(fmt.Sprintf(`"Text":"Hello from %s"`, rName2))),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrContent, datasourceName, names.AttrContent),
+				),
+			},
+		},
+	})
+}
+
+func testAccContactFlowModuleContentDataSourceConfig_template() string {
+	return `
+  template = <<JSON
+{
+  "Version": "2019-10-30",
+  "StartAction": "12345678-1234-1234-1234-123456789012",
+  "Actions": [
+    {
+      "Identifier": "12345678-1234-1234-1234-123456789012",
+      "Parameters": {
+        "Text": "{{ message }}"
+      },
+      "Transitions": {
+        "NextAction": "{{ next_action }}",
+        "Errors": [],
+        "Conditions": []
+      },
+      "Type": "MessageParticipant"
+    },
+    {
+      "Identifier": "abcdef-abcd-abcd-abcd-abcdefghijkl",
+      "Type": "DisconnectParticipant",
+      "Parameters": {},
+      "Transitions": {}
+    }
+  ],
+  "Settings": {
+    "InputParameters": [],
+    "OutputParameters": [],
+    "Transitions": [
+      {
+        "DisplayName": "Success",
+        "ReferenceName": "Success",
+        "Description": ""
+      },
+      {
+        "DisplayName": "Error",
+        "ReferenceName": "Error",
+        "Description": ""
+      }
+    ]
+  }
+}
+JSON
+`
+}
+
+func testAccContactFlowModuleContentDataSourceConfig_basic(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccContactFlowModuleConfig_base(rName),
+		fmt.Sprintf(`
+data "aws_connect_contact_flow_module_content" "test" {
+%[2]s
+  placeholders = {
+    message     = "Hello from %[1]s"
+    next_action = "abcdef-abcd-abcd-abcd-abcdefghijkl"
+  }
+}
+
+resource "aws_connect_contact_flow_module" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  content     = data.aws_connect_contact_flow_module_content.test.content
+}
+`, rName2, testAccContactFlowModuleContentDataSourceConfig_template()))
+}
+
+func testAccContactFlowModuleContentDataSourceConfig_missingPlaceholder() string {
+	return fmt.Sprintf(`
+data "aws_connect_contact_flow_module_content" "test" {
+%[1]s
+  placeholders = {
+    next_action = "abcdef-abcd-abcd-abcd-abcdefghijkl"
+  }
+}
+`, testAccContactFlowModuleContentDataSourceConfig_template())
+}
+
+func testAccContactFlowModuleContentDataSourceConfig_unknownNextAction() string {
+	return fmt.Sprintf(`
+data "aws_connect_contact_flow_module_content" "test" {
+%[1]s
+  placeholders = {
+    message     = "Hello"
+    next_action = "missing"
+  }
+}
+`, testAccContactFlowModuleContentDataSourceConfig_template())
+}
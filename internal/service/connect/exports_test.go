@@ -0,0 +1,10 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+// Exports for use in tests only.
+var (
+	RenderContactFlowContent = renderContactFlowContent
+	ValidContactFlowContent  = validContactFlowContent
+)
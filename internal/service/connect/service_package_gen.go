@@ -37,6 +37,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceContactFlowModule,
 			TypeName: "aws_connect_contact_flow_module",
 		},
+		{
+			Factory:  DataSourceContactFlowModuleContent,
+			TypeName: "aws_connect_contact_flow_module_content",
+		},
 		{
 			Factory:  DataSourceHoursOfOperation,
 			TypeName: "aws_connect_hours_of_operation",
@@ -0,0 +1,440 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package customerprofiles
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/customerprofiles"
+	"github.com/aws/aws-sdk-go-v2/service/customerprofiles/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	calculatedAttributeDefinitionResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_customerprofiles_calculated_attribute_definition", name="Calculated Attribute Definition")
+func ResourceCalculatedAttributeDefinition() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCalculatedAttributeDefinitionCreate,
+		ReadWithoutTimeout:   resourceCalculatedAttributeDefinitionRead,
+		UpdateWithoutTimeout: resourceCalculatedAttributeDefinitionUpdate,
+		DeleteWithoutTimeout: resourceCalculatedAttributeDefinitionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"attribute_details": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAttributes: {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 2,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"expression": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+					},
+				},
+			},
+			"calculated_attribute_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"conditions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"object_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+						"range": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrUnit: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.Unit](),
+									},
+									names.AttrValue: {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 366),
+									},
+								},
+							},
+						},
+						"threshold": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"operator": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.Operator](),
+									},
+									names.AttrValue: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrCreatedAt: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			names.AttrDomainName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"last_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"statistic": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.Statistic](),
+			},
+		},
+	}
+}
+
+func resourceCalculatedAttributeDefinitionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CustomerProfilesClient(ctx)
+
+	domainName := d.Get(names.AttrDomainName).(string)
+	name := d.Get("calculated_attribute_name").(string)
+	id := errs.Must(flex.FlattenResourceId([]string{domainName, name}, calculatedAttributeDefinitionResourceIDPartCount, false))
+	input := &customerprofiles.CreateCalculatedAttributeDefinitionInput{
+		AttributeDetails:        expandAttributeDetails(d.Get("attribute_details").([]interface{})),
+		CalculatedAttributeName: aws.String(name),
+		DomainName:              aws.String(domainName),
+		Statistic:               types.Statistic(d.Get("statistic").(string)),
+	}
+
+	if v, ok := d.GetOk("conditions"); ok {
+		input.Conditions = expandConditions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateCalculatedAttributeDefinition(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Customer Profiles Calculated Attribute Definition (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceCalculatedAttributeDefinitionRead(ctx, d, meta)...)
+}
+
+func resourceCalculatedAttributeDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CustomerProfilesClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), calculatedAttributeDefinitionResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domainName, name := parts[0], parts[1]
+	output, err := FindCalculatedAttributeDefinitionByTwoPartKey(ctx, conn, domainName, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Customer Profiles Calculated Attribute Definition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Customer Profiles Calculated Attribute Definition (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("attribute_details", flattenAttributeDetails(output.AttributeDetails)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting attribute_details: %s", err)
+	}
+	d.Set("calculated_attribute_name", output.CalculatedAttributeName)
+	if err := d.Set("conditions", flattenConditions(output.Conditions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting conditions: %s", err)
+	}
+	if output.CreatedAt != nil {
+		d.Set(names.AttrCreatedAt, aws.ToTime(output.CreatedAt).Format(time.RFC3339))
+	}
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("display_name", output.DisplayName)
+	d.Set(names.AttrDomainName, domainName)
+	if output.LastUpdatedAt != nil {
+		d.Set("last_updated_at", aws.ToTime(output.LastUpdatedAt).Format(time.RFC3339))
+	}
+	d.Set("statistic", output.Statistic)
+
+	return diags
+}
+
+func resourceCalculatedAttributeDefinitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CustomerProfilesClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), calculatedAttributeDefinitionResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &customerprofiles.UpdateCalculatedAttributeDefinitionInput{
+		CalculatedAttributeName: aws.String(parts[1]),
+		DomainName:              aws.String(parts[0]),
+	}
+
+	if d.HasChange("conditions") {
+		input.Conditions = expandConditions(d.Get("conditions").([]interface{}))
+	}
+
+	if d.HasChange(names.AttrDescription) {
+		input.Description = aws.String(d.Get(names.AttrDescription).(string))
+	}
+
+	if d.HasChange("display_name") {
+		input.DisplayName = aws.String(d.Get("display_name").(string))
+	}
+
+	_, err = conn.UpdateCalculatedAttributeDefinition(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Customer Profiles Calculated Attribute Definition (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCalculatedAttributeDefinitionRead(ctx, d, meta)...)
+}
+
+func resourceCalculatedAttributeDefinitionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CustomerProfilesClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), calculatedAttributeDefinitionResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Customer Profiles Calculated Attribute Definition: %s", d.Id())
+	_, err = conn.DeleteCalculatedAttributeDefinition(ctx, &customerprofiles.DeleteCalculatedAttributeDefinitionInput{
+		CalculatedAttributeName: aws.String(parts[1]),
+		DomainName:              aws.String(parts[0]),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Customer Profiles Calculated Attribute Definition (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindCalculatedAttributeDefinitionByTwoPartKey(ctx context.Context, conn *customerprofiles.Client, domainName, name string) (*customerprofiles.GetCalculatedAttributeDefinitionOutput, error) {
+	input := &customerprofiles.GetCalculatedAttributeDefinitionInput{
+		CalculatedAttributeName: aws.String(name),
+		DomainName:              aws.String(domainName),
+	}
+
+	output, err := conn.GetCalculatedAttributeDefinition(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandAttributeDetails(tfList []interface{}) *types.AttributeDetails {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.AttributeDetails{}
+
+	if v, ok := tfMap[names.AttrAttributes].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.Attributes = append(apiObject.Attributes, types.AttributeItem{
+				Name: aws.String(tfMap[names.AttrName].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["expression"].(string); ok && v != "" {
+		apiObject.Expression = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandConditions(tfList []interface{}) *types.Conditions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.Conditions{}
+
+	if v, ok := tfMap["object_count"].(int); ok && v != 0 {
+		apiObject.ObjectCount = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Range = &types.Range{
+			Unit:  types.Unit(tfMap[names.AttrUnit].(string)),
+			Value: aws.Int32(int32(tfMap[names.AttrValue].(int))),
+		}
+	}
+
+	if v, ok := tfMap["threshold"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Threshold = &types.Threshold{
+			Operator: types.Operator(tfMap["operator"].(string)),
+			Value:    aws.String(tfMap[names.AttrValue].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenAttributeDetails(apiObject *types.AttributeDetails) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var attributes []interface{}
+
+	for _, v := range apiObject.Attributes {
+		attributes = append(attributes, map[string]interface{}{
+			names.AttrName: aws.ToString(v.Name),
+		})
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrAttributes: attributes,
+		"expression":         aws.ToString(apiObject.Expression),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenConditions(apiObject *types.Conditions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"object_count": aws.ToInt32(apiObject.ObjectCount),
+	}
+
+	if v := apiObject.Range; v != nil {
+		tfMap["range"] = []interface{}{map[string]interface{}{
+			names.AttrUnit:  string(v.Unit),
+			names.AttrValue: aws.ToInt32(v.Value),
+		}}
+	}
+
+	if v := apiObject.Threshold; v != nil {
+		tfMap["threshold"] = []interface{}{map[string]interface{}{
+			"operator":      string(v.Operator),
+			names.AttrValue: aws.ToString(v.Value),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package customerprofiles_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/customerprofiles"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCustomerProfilesCalculatedAttributeDefinition_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_customerprofiles_calculated_attribute_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CustomerProfilesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCalculatedAttributeDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCalculatedAttributeDefinitionConfig_basic(rName, "total spend", 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCalculatedAttributeDefinitionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attribute_details.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "attribute_details.0.attributes.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "attribute_details.0.attributes.0.name", "_order.TotalPrice"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_details.0.expression", "{_order.TotalPrice}"),
+					resource.TestCheckResourceAttr(resourceName, "calculated_attribute_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "conditions.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.range.0.unit", "DAYS"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.range.0.value", "30"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "total spend"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrDomainName, "aws_customerprofiles_domain.test", names.AttrDomainName),
+					resource.TestCheckResourceAttr(resourceName, "statistic", "SUM"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCalculatedAttributeDefinitionConfig_basic(rName, "total spend in the last quarter", 90),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCalculatedAttributeDefinitionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.range.0.value", "90"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "total spend in the last quarter"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCustomerProfilesCalculatedAttributeDefinition_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_customerprofiles_calculated_attribute_definition.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CustomerProfilesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCalculatedAttributeDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCalculatedAttributeDefinitionConfig_basic(rName, "total spend", 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCalculatedAttributeDefinitionExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, customerprofiles.ResourceCalculatedAttributeDefinition(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCalculatedAttributeDefinitionExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CustomerProfilesClient(ctx)
+
+		_, err = customerprofiles.FindCalculatedAttributeDefinitionByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		return err
+	}
+}
+
+func testAccCheckCalculatedAttributeDefinitionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CustomerProfilesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_customerprofiles_calculated_attribute_definition" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = customerprofiles.FindCalculatedAttributeDefinitionByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Customer Profiles Calculated Attribute Definition %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCalculatedAttributeDefinitionConfig_basic(rName, description string, days int) string {
+	return fmt.Sprintf(`
+resource "aws_customerprofiles_domain" "test" {
+  domain_name             = %[1]q
+  default_expiration_days = 365
+}
+
+resource "aws_customerprofiles_calculated_attribute_definition" "test" {
+  domain_name               = aws_customerprofiles_domain.test.domain_name
+  calculated_attribute_name = %[1]q
+  description               = %[2]q
+  statistic                 = "SUM"
+
+  attribute_details {
+    attributes {
+      name = "_order.TotalPrice"
+    }
+
+    expression = "{_order.TotalPrice}"
+  }
+
+  conditions {
+    range {
+      unit  = "DAYS"
+      value = %[3]d
+    }
+  }
+}
+`, rName, description, days)
+}
@@ -12,8 +12,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/customerprofiles"
 	"github.com/aws/aws-sdk-go-v2/service/customerprofiles/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -171,23 +173,31 @@ func ResourceDomain() *schema.Resource {
 						"matching_rules": {
 							Type:     schema.TypeSet,
 							Optional: true,
+							MaxItems: 15,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									names.AttrRule: {
 										Type:     schema.TypeList,
 										Required: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
+										MinItems: 1,
+										MaxItems: 20,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 255),
+										},
 									},
 								},
 							},
 						},
 						"max_allowed_rule_level_for_matching": {
-							Type:     schema.TypeInt,
-							Optional: true,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 15),
 						},
 						"max_allowed_rule_level_for_merging": {
-							Type:     schema.TypeInt,
-							Optional: true,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 15),
 						},
 						names.AttrStatus: {
 							Type:             schema.TypeString,
@@ -202,8 +212,36 @@ func ResourceDomain() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			validateRuleBasedMatchingCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+// validateRuleBasedMatchingCustomizeDiff checks that the maximum rule levels used for matching and
+// merging refer to configured matching rules.
+func validateRuleBasedMatchingCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	tfList, ok := d.Get("rule_based_matching").([]interface{})
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	v, ok := tfMap["matching_rules"].(*schema.Set)
+	if !ok || v.Len() == 0 {
+		return nil
+	}
+
+	rules := v.Len()
+
+	for _, key := range []string{"max_allowed_rule_level_for_matching", "max_allowed_rule_level_for_merging"} {
+		if level, ok := tfMap[key].(int); ok && level > rules {
+			return fmt.Errorf("rule_based_matching.0.%s (%d) must not exceed the number of matching_rules (%d)", key, level, rules)
+		}
 	}
+
+	return nil
 }
 
 func conflictResolutionSchema() *schema.Schema {
@@ -28,6 +28,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceCalculatedAttributeDefinition,
+			TypeName: "aws_customerprofiles_calculated_attribute_definition",
+			Name:     "Calculated Attribute Definition",
+		},
 		{
 			Factory:  ResourceDomain,
 			TypeName: "aws_customerprofiles_domain",
@@ -35,6 +40,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceEventStream,
+			TypeName: "aws_customerprofiles_event_stream",
+			Name:     "Event Stream",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceProfile,
 			TypeName: "aws_customerprofiles_profile",
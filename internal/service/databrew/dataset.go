@@ -0,0 +1,568 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_databrew_dataset", name="Dataset")
+// @Tags(identifierAttribute="arn")
+func resourceDataset() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDatasetCreate,
+		ReadWithoutTimeout:   resourceDatasetRead,
+		UpdateWithoutTimeout: resourceDatasetUpdate,
+		DeleteWithoutTimeout: resourceDatasetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrFormat: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.InputFormat](),
+			},
+			"format_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"csv": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delimiter": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1),
+									},
+									"header_row": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"excel": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"header_row": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+									"sheet_indexes": {
+										Type:          schema.TypeList,
+										Optional:      true,
+										MaxItems:      1,
+										ConflictsWith: []string{"format_options.0.excel.0.sheet_names"},
+										Elem: &schema.Schema{
+											Type:         schema.TypeInt,
+											ValidateFunc: validation.IntBetween(0, 200),
+										},
+									},
+									"sheet_names": {
+										Type:          schema.TypeList,
+										Optional:      true,
+										MaxItems:      1,
+										ConflictsWith: []string{"format_options.0.excel.0.sheet_indexes"},
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 31),
+										},
+									},
+								},
+							},
+						},
+						names.AttrJSON: {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"multi_line": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"input": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_catalog_input_definition": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"input.0.data_catalog_input_definition", "input.0.database_input_definition", "input.0.s3_input_definition"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCatalogID: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									names.AttrDatabaseName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									names.AttrTableName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"temp_directory": s3LocationSchema(false),
+								},
+							},
+						},
+						"database_input_definition": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"input.0.data_catalog_input_definition", "input.0.database_input_definition", "input.0.s3_input_definition"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"database_table_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ExactlyOneOf: []string{"input.0.database_input_definition.0.database_table_name", "input.0.database_input_definition.0.query_string"},
+									},
+									"glue_connection_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"query_string": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ExactlyOneOf: []string{"input.0.database_input_definition.0.database_table_name", "input.0.database_input_definition.0.query_string"},
+									},
+									"temp_directory": s3LocationSchema(false),
+								},
+							},
+						},
+						"s3_input_definition": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"input.0.data_catalog_input_definition", "input.0.database_input_definition", "input.0.s3_input_definition"},
+							Elem:         s3LocationResource(),
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			names.AttrSource: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceDatasetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &databrew.CreateDatasetInput{
+		Input: expandDatasetInput(d.Get("input").([]interface{})),
+		Name:  aws.String(name),
+		Tags:  getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrFormat); ok {
+		input.Format = awstypes.InputFormat(v.(string))
+	}
+
+	if v, ok := d.GetOk("format_options"); ok {
+		input.FormatOptions = expandFormatOptions(v.([]interface{}))
+	}
+
+	_, err := conn.CreateDataset(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DataBrew Dataset (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceDatasetRead(ctx, d, meta)...)
+}
+
+func resourceDatasetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	output, err := findDatasetByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DataBrew Dataset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DataBrew Dataset (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.ResourceArn)
+	d.Set(names.AttrFormat, output.Format)
+	if err := d.Set("format_options", flattenFormatOptions(output.FormatOptions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting format_options: %s", err)
+	}
+	if err := d.Set("input", flattenDatasetInput(output.Input)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting input: %s", err)
+	}
+	d.Set(names.AttrName, output.Name)
+	d.Set(names.AttrSource, output.Source)
+
+	return diags
+}
+
+func resourceDatasetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &databrew.UpdateDatasetInput{
+			Input: expandDatasetInput(d.Get("input").([]interface{})),
+			Name:  aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk(names.AttrFormat); ok {
+			input.Format = awstypes.InputFormat(v.(string))
+		}
+
+		if v, ok := d.GetOk("format_options"); ok {
+			input.FormatOptions = expandFormatOptions(v.([]interface{}))
+		}
+
+		_, err := conn.UpdateDataset(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DataBrew Dataset (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDatasetRead(ctx, d, meta)...)
+}
+
+func resourceDatasetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	log.Printf("[DEBUG] Deleting DataBrew Dataset: %s", d.Id())
+	_, err := conn.DeleteDataset(ctx, &databrew.DeleteDatasetInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DataBrew Dataset (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDatasetByName(ctx context.Context, conn *databrew.Client, name string) (*databrew.DescribeDatasetOutput, error) {
+	input := &databrew.DescribeDatasetInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeDataset(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func s3LocationResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrBucket: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(3, 63),
+			},
+			"bucket_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			names.AttrKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1280),
+			},
+		},
+	}
+}
+
+func s3LocationSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		MaxItems: 1,
+		Elem:     s3LocationResource(),
+	}
+}
+
+func expandDatasetInput(tfList []interface{}) *awstypes.Input {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.Input{}
+
+	if v, ok := tfMap["data_catalog_input_definition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		definition := &awstypes.DataCatalogInputDefinition{
+			DatabaseName:  aws.String(tfMap[names.AttrDatabaseName].(string)),
+			TableName:     aws.String(tfMap[names.AttrTableName].(string)),
+			TempDirectory: expandS3Location(tfMap["temp_directory"].([]interface{})),
+		}
+
+		if v, ok := tfMap[names.AttrCatalogID].(string); ok && v != "" {
+			definition.CatalogId = aws.String(v)
+		}
+
+		apiObject.DataCatalogInputDefinition = definition
+	}
+
+	if v, ok := tfMap["database_input_definition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		definition := &awstypes.DatabaseInputDefinition{
+			GlueConnectionName: aws.String(tfMap["glue_connection_name"].(string)),
+			TempDirectory:      expandS3Location(tfMap["temp_directory"].([]interface{})),
+		}
+
+		if v, ok := tfMap["database_table_name"].(string); ok && v != "" {
+			definition.DatabaseTableName = aws.String(v)
+		}
+
+		if v, ok := tfMap["query_string"].(string); ok && v != "" {
+			definition.QueryString = aws.String(v)
+		}
+
+		apiObject.DatabaseInputDefinition = definition
+	}
+
+	if v, ok := tfMap["s3_input_definition"].([]interface{}); ok {
+		apiObject.S3InputDefinition = expandS3Location(v)
+	}
+
+	return apiObject
+}
+
+func flattenDatasetInput(apiObject *awstypes.Input) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DataCatalogInputDefinition; v != nil {
+		tfMap["data_catalog_input_definition"] = []interface{}{map[string]interface{}{
+			names.AttrCatalogID:    aws.ToString(v.CatalogId),
+			names.AttrDatabaseName: aws.ToString(v.DatabaseName),
+			names.AttrTableName:    aws.ToString(v.TableName),
+			"temp_directory":       flattenS3Location(v.TempDirectory),
+		}}
+	}
+
+	if v := apiObject.DatabaseInputDefinition; v != nil {
+		tfMap["database_input_definition"] = []interface{}{map[string]interface{}{
+			"database_table_name":  aws.ToString(v.DatabaseTableName),
+			"glue_connection_name": aws.ToString(v.GlueConnectionName),
+			"query_string":         aws.ToString(v.QueryString),
+			"temp_directory":       flattenS3Location(v.TempDirectory),
+		}}
+	}
+
+	if v := apiObject.S3InputDefinition; v != nil {
+		tfMap["s3_input_definition"] = flattenS3Location(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandS3Location(tfList []interface{}) *awstypes.S3Location {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.S3Location{
+		Bucket: aws.String(tfMap[names.AttrBucket].(string)),
+	}
+
+	if v, ok := tfMap["bucket_owner"].(string); ok && v != "" {
+		apiObject.BucketOwner = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrKey].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3Location(apiObject *awstypes.S3Location) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		names.AttrBucket: aws.ToString(apiObject.Bucket),
+		"bucket_owner":   aws.ToString(apiObject.BucketOwner),
+		names.AttrKey:    aws.ToString(apiObject.Key),
+	}}
+}
+
+func expandFormatOptions(tfList []interface{}) *awstypes.FormatOptions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.FormatOptions{}
+
+	if v, ok := tfMap["csv"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		options := &awstypes.CsvOptions{
+			HeaderRow: aws.Bool(tfMap["header_row"].(bool)),
+		}
+
+		if v, ok := tfMap["delimiter"].(string); ok && v != "" {
+			options.Delimiter = aws.String(v)
+		}
+
+		apiObject.Csv = options
+	}
+
+	if v, ok := tfMap["excel"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		options := &awstypes.ExcelOptions{
+			HeaderRow: aws.Bool(tfMap["header_row"].(bool)),
+		}
+
+		if v, ok := tfMap["sheet_indexes"].([]interface{}); ok && len(v) > 0 {
+			options.SheetIndexes = flex.ExpandInt32ValueList(v)
+		}
+
+		if v, ok := tfMap["sheet_names"].([]interface{}); ok && len(v) > 0 {
+			options.SheetNames = flex.ExpandStringValueList(v)
+		}
+
+		apiObject.Excel = options
+	}
+
+	if v, ok := tfMap[names.AttrJSON].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Json = &awstypes.JsonOptions{
+			MultiLine: tfMap["multi_line"].(bool),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenFormatOptions(apiObject *awstypes.FormatOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Csv; v != nil {
+		tfMap["csv"] = []interface{}{map[string]interface{}{
+			"delimiter":  aws.ToString(v.Delimiter),
+			"header_row": aws.ToBool(v.HeaderRow),
+		}}
+	}
+
+	if v := apiObject.Excel; v != nil {
+		tfMap["excel"] = []interface{}{map[string]interface{}{
+			"header_row":    aws.ToBool(v.HeaderRow),
+			"sheet_indexes": flex.FlattenInt32ValueList(v.SheetIndexes),
+			"sheet_names":   v.SheetNames,
+		}}
+	}
+
+	if v := apiObject.Json; v != nil {
+		tfMap[names.AttrJSON] = []interface{}{map[string]interface{}{
+			"multi_line": v.MultiLine,
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+// Exports for use in tests only.
+var (
+	ResourceDataset    = resourceDataset
+	ResourceProfileJob = resourceProfileJob
+	ResourceProject    = resourceProject
+	ResourceRecipe     = resourceRecipe
+	ResourceRecipeJob  = resourceRecipeJob
+	ResourceSchedule   = resourceSchedule
+
+	FindDatasetByName          = findDatasetByName
+	FindJobByName              = findJobByName
+	FindProjectByName          = findProjectByName
+	FindRecipeByTwoPartKey     = findRecipeByTwoPartKey
+	FindScheduleByName         = findScheduleByName
+	RecipeVersionLatestWorking = recipeVersionLatestWorking
+)
@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_databrew_profile_job", name="Profile Job")
+// @Tags(identifierAttribute="arn")
+func resourceProfileJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProfileJobCreate,
+		ReadWithoutTimeout:   resourceProfileJobRead,
+		UpdateWithoutTimeout: resourceProfileJobUpdate,
+		DeleteWithoutTimeout: resourceJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dataset_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"encryption_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				RequiredWith: []string{"encryption_mode"},
+			},
+			"encryption_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.EncryptionMode](),
+			},
+			"job_sample": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMode: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.SampleMode](),
+						},
+						names.AttrSize: {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"log_subscription": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.LogSubscriptionEnable,
+				ValidateDiagFunc: enum.Validate[awstypes.LogSubscription](),
+			},
+			"max_capacity": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 240),
+			},
+			"output_location": s3LocationSchema(true),
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrTimeout: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2880,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func resourceProfileJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &databrew.CreateProfileJobInput{
+		DatasetName:     aws.String(d.Get("dataset_name").(string)),
+		LogSubscription: awstypes.LogSubscription(d.Get("log_subscription").(string)),
+		MaxCapacity:     int32(d.Get("max_capacity").(int)),
+		MaxRetries:      int32(d.Get("max_retries").(int)),
+		Name:            aws.String(name),
+		OutputLocation:  expandS3Location(d.Get("output_location").([]interface{})),
+		RoleArn:         aws.String(d.Get(names.AttrRoleARN).(string)),
+		Tags:            getTagsIn(ctx),
+		Timeout:         int32(d.Get(names.AttrTimeout).(int)),
+	}
+
+	if v, ok := d.GetOk("encryption_key_arn"); ok {
+		input.EncryptionKeyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption_mode"); ok {
+		input.EncryptionMode = awstypes.EncryptionMode(v.(string))
+	}
+
+	if v, ok := d.GetOk("job_sample"); ok {
+		input.JobSample = expandJobSample(v.([]interface{}))
+	}
+
+	_, err := conn.CreateProfileJob(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DataBrew Profile Job (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceProfileJobRead(ctx, d, meta)...)
+}
+
+func resourceProfileJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	output, err := findJobByName(ctx, conn, d.Id(), awstypes.JobTypeProfile)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DataBrew Profile Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DataBrew Profile Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.ResourceArn)
+	d.Set("dataset_name", output.DatasetName)
+	d.Set("encryption_key_arn", output.EncryptionKeyArn)
+	d.Set("encryption_mode", output.EncryptionMode)
+	if err := d.Set("job_sample", flattenJobSample(output.JobSample)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting job_sample: %s", err)
+	}
+	d.Set("log_subscription", output.LogSubscription)
+	d.Set("max_capacity", output.MaxCapacity)
+	d.Set("max_retries", output.MaxRetries)
+	d.Set(names.AttrName, output.Name)
+	// Profile jobs report their output location as the single output.
+	if len(output.Outputs) > 0 {
+		if err := d.Set("output_location", flattenS3Location(output.Outputs[0].Location)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting output_location: %s", err)
+		}
+	}
+	d.Set(names.AttrRoleARN, output.RoleArn)
+	d.Set(names.AttrTimeout, output.Timeout)
+
+	return diags
+}
+
+func resourceProfileJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &databrew.UpdateProfileJobInput{
+			LogSubscription: awstypes.LogSubscription(d.Get("log_subscription").(string)),
+			MaxCapacity:     int32(d.Get("max_capacity").(int)),
+			MaxRetries:      int32(d.Get("max_retries").(int)),
+			Name:            aws.String(d.Id()),
+			OutputLocation:  expandS3Location(d.Get("output_location").([]interface{})),
+			RoleArn:         aws.String(d.Get(names.AttrRoleARN).(string)),
+			Timeout:         int32(d.Get(names.AttrTimeout).(int)),
+		}
+
+		if v, ok := d.GetOk("encryption_key_arn"); ok {
+			input.EncryptionKeyArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("encryption_mode"); ok {
+			input.EncryptionMode = awstypes.EncryptionMode(v.(string))
+		}
+
+		if v, ok := d.GetOk("job_sample"); ok {
+			input.JobSample = expandJobSample(v.([]interface{}))
+		}
+
+		_, err := conn.UpdateProfileJob(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DataBrew Profile Job (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceProfileJobRead(ctx, d, meta)...)
+}
+
+func expandJobSample(tfList []interface{}) *awstypes.JobSample {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.JobSample{}
+
+	if v, ok := tfMap[names.AttrMode].(string); ok && v != "" {
+		apiObject.Mode = awstypes.SampleMode(v)
+	}
+
+	if v, ok := tfMap[names.AttrSize].(int); ok && v > 0 {
+		apiObject.Size = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenJobSample(apiObject *awstypes.JobSample) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		names.AttrMode: string(apiObject.Mode),
+		names.AttrSize: aws.ToInt64(apiObject.Size),
+	}}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfdatabrew "github.com/hashicorp/terraform-provider-aws/internal/service/databrew"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewProfileJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeJobOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_databrew_profile_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx, "aws_databrew_profile_job", awstypes.JobTypeProfile),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileJobConfig_basic(rName, 2880),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, awstypes.JobTypeProfile, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "databrew", fmt.Sprintf("job/%s", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "dataset_name", "aws_databrew_dataset.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "job_sample.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "job_sample.0.mode", "CUSTOM_ROWS"),
+					resource.TestCheckResourceAttr(resourceName, "job_sample.0.size", "1000"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "output_location.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(resourceName, "output_location.0.bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrTimeout, "2880"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProfileJobConfig_basic(rName, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, awstypes.JobTypeProfile, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrTimeout, "60"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataBrewProfileJob_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeJobOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_databrew_profile_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx, "aws_databrew_profile_job", awstypes.JobTypeProfile),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileJobConfig_basic(rName, 2880),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, awstypes.JobTypeProfile, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdatabrew.ResourceProfileJob(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccProfileJobConfig_basic(rName string, timeout int) string {
+	return acctest.ConfigCompose(testAccRoleConfig_base(rName), fmt.Sprintf(`
+resource "aws_databrew_profile_job" "test" {
+  name         = %[1]q
+  dataset_name = aws_databrew_dataset.test.name
+  role_arn     = aws_iam_role.test.arn
+  timeout      = %[2]d
+
+  job_sample {
+    mode = "CUSTOM_ROWS"
+    size = 1000
+  }
+
+  output_location {
+    bucket = aws_s3_bucket.test.bucket
+    key    = "profile/"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test, aws_iam_role_policy.test]
+}
+`, rName, timeout))
+}
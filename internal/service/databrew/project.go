@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_databrew_project", name="Project")
+// @Tags(identifierAttribute="arn")
+func resourceProject() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProjectCreate,
+		ReadWithoutTimeout:   resourceProjectRead,
+		UpdateWithoutTimeout: resourceProjectUpdate,
+		DeleteWithoutTimeout: resourceProjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dataset_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"recipe_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"sample": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrSize: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 5000),
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.SampleType](),
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &databrew.CreateProjectInput{
+		DatasetName: aws.String(d.Get("dataset_name").(string)),
+		Name:        aws.String(name),
+		RecipeName:  aws.String(d.Get("recipe_name").(string)),
+		RoleArn:     aws.String(d.Get(names.AttrRoleARN).(string)),
+		Tags:        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("sample"); ok {
+		input.Sample = expandSample(v.([]interface{}))
+	}
+
+	_, err := conn.CreateProject(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DataBrew Project (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceProjectRead(ctx, d, meta)...)
+}
+
+func resourceProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	output, err := findProjectByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DataBrew Project (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DataBrew Project (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.ResourceArn)
+	d.Set("dataset_name", output.DatasetName)
+	d.Set(names.AttrName, output.Name)
+	d.Set("recipe_name", output.RecipeName)
+	d.Set(names.AttrRoleARN, output.RoleArn)
+	if err := d.Set("sample", flattenSample(output.Sample)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting sample: %s", err)
+	}
+
+	return diags
+}
+
+func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &databrew.UpdateProjectInput{
+			Name:    aws.String(d.Id()),
+			RoleArn: aws.String(d.Get(names.AttrRoleARN).(string)),
+		}
+
+		if v, ok := d.GetOk("sample"); ok {
+			input.Sample = expandSample(v.([]interface{}))
+		}
+
+		_, err := conn.UpdateProject(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DataBrew Project (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceProjectRead(ctx, d, meta)...)
+}
+
+func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	log.Printf("[DEBUG] Deleting DataBrew Project: %s", d.Id())
+	_, err := conn.DeleteProject(ctx, &databrew.DeleteProjectInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DataBrew Project (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findProjectByName(ctx context.Context, conn *databrew.Client, name string) (*databrew.DescribeProjectOutput, error) {
+	input := &databrew.DescribeProjectInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeProject(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandSample(tfList []interface{}) *awstypes.Sample {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.Sample{
+		Type: awstypes.SampleType(tfMap[names.AttrType].(string)),
+	}
+
+	if v, ok := tfMap[names.AttrSize].(int); ok && v > 0 {
+		apiObject.Size = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenSample(apiObject *awstypes.Sample) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		names.AttrSize: aws.ToInt32(apiObject.Size),
+		names.AttrType: string(apiObject.Type),
+	}}
+}
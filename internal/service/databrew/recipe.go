@@ -0,0 +1,435 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	recipeVersionLatestWorking = "LATEST_WORKING"
+)
+
+// @SDKResource("aws_databrew_recipe", name="Recipe")
+// @Tags(identifierAttribute="arn")
+func resourceRecipe() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRecipeCreate,
+		ReadWithoutTimeout:   resourceRecipeRead,
+		UpdateWithoutTimeout: resourceRecipeUpdate,
+		DeleteWithoutTimeout: resourceRecipeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"recipe_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"step": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"operation": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									names.AttrParameters: {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"condition_expression": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCondition: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"target_column": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									names.AttrValue: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceRecipeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &databrew.CreateRecipeInput{
+		Name:  aws.String(name),
+		Steps: expandRecipeSteps(d.Get("step").([]interface{})),
+		Tags:  getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateRecipe(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DataBrew Recipe (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	// Jobs can only reference published recipe versions.
+	if err := publishRecipe(ctx, conn, d.Id(), d.Get(names.AttrDescription).(string)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return append(diags, resourceRecipeRead(ctx, d, meta)...)
+}
+
+func resourceRecipeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	output, err := findRecipeByTwoPartKey(ctx, conn, d.Id(), recipeVersionLatestWorking)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DataBrew Recipe (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DataBrew Recipe (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.ResourceArn)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set(names.AttrName, output.Name)
+	if err := d.Set("step", flattenRecipeSteps(output.Steps)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting step: %s", err)
+	}
+
+	version, err := findLatestPublishedRecipeVersion(ctx, conn, d.Id())
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("recipe_version", nil)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading DataBrew Recipe (%s) versions: %s", d.Id(), err)
+	default:
+		d.Set("recipe_version", version.RecipeVersion)
+	}
+
+	return diags
+}
+
+func resourceRecipeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &databrew.UpdateRecipeInput{
+			Description: aws.String(d.Get(names.AttrDescription).(string)),
+			Name:        aws.String(d.Id()),
+			Steps:       expandRecipeSteps(d.Get("step").([]interface{})),
+		}
+
+		_, err := conn.UpdateRecipe(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DataBrew Recipe (%s): %s", d.Id(), err)
+		}
+
+		if err := publishRecipe(ctx, conn, d.Id(), d.Get(names.AttrDescription).(string)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceRecipeRead(ctx, d, meta)...)
+}
+
+func resourceRecipeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	// The working version can only be deleted once all published versions are gone.
+	versions, err := findPublishedRecipeVersions(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DataBrew Recipe (%s) versions: %s", d.Id(), err)
+	}
+
+	if len(versions) > 0 {
+		input := &databrew.BatchDeleteRecipeVersionInput{
+			Name: aws.String(d.Id()),
+		}
+		for _, v := range versions {
+			input.RecipeVersions = append(input.RecipeVersions, aws.ToString(v.RecipeVersion))
+		}
+
+		log.Printf("[DEBUG] Deleting DataBrew Recipe (%s) versions", d.Id())
+		output, err := conn.BatchDeleteRecipeVersion(ctx, input)
+
+		if err == nil && output != nil && len(output.Errors) > 0 {
+			v := output.Errors[0]
+			err = fmt.Errorf("%s (%s): %s", aws.ToString(v.RecipeVersion), aws.ToString(v.ErrorCode), aws.ToString(v.ErrorMessage))
+		}
+
+		if err != nil && !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return sdkdiag.AppendErrorf(diags, "deleting DataBrew Recipe (%s) versions: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting DataBrew Recipe: %s", d.Id())
+	_, err = conn.DeleteRecipeVersion(ctx, &databrew.DeleteRecipeVersionInput{
+		Name:          aws.String(d.Id()),
+		RecipeVersion: aws.String(recipeVersionLatestWorking),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DataBrew Recipe (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func publishRecipe(ctx context.Context, conn *databrew.Client, name, description string) error {
+	input := &databrew.PublishRecipeInput{
+		Name: aws.String(name),
+	}
+
+	if description != "" {
+		input.Description = aws.String(description)
+	}
+
+	_, err := conn.PublishRecipe(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("publishing DataBrew Recipe (%s): %w", name, err)
+	}
+
+	return nil
+}
+
+func findRecipeByTwoPartKey(ctx context.Context, conn *databrew.Client, name, version string) (*databrew.DescribeRecipeOutput, error) {
+	input := &databrew.DescribeRecipeInput{
+		Name:          aws.String(name),
+		RecipeVersion: aws.String(version),
+	}
+
+	output, err := conn.DescribeRecipe(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findPublishedRecipeVersions(ctx context.Context, conn *databrew.Client, name string) ([]awstypes.Recipe, error) {
+	input := &databrew.ListRecipeVersionsInput{
+		Name: aws.String(name),
+	}
+	var output []awstypes.Recipe
+
+	pages := databrew.NewListRecipeVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Recipes...)
+	}
+
+	return output, nil
+}
+
+func findLatestPublishedRecipeVersion(ctx context.Context, conn *databrew.Client, name string) (*awstypes.Recipe, error) {
+	versions, err := findPublishedRecipeVersions(ctx, conn, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *awstypes.Recipe
+	for i, v := range versions {
+		if latest == nil || aws.ToTime(v.PublishedDate).After(aws.ToTime(latest.PublishedDate)) {
+			latest = &versions[i]
+		}
+	}
+
+	if latest == nil {
+		return nil, tfresource.NewEmptyResultError(name)
+	}
+
+	return latest, nil
+}
+
+func expandRecipeSteps(tfList []interface{}) []awstypes.RecipeStep {
+	var apiObjects []awstypes.RecipeStep
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.RecipeStep{}
+
+		if v, ok := tfMap[names.AttrAction].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			action := &awstypes.RecipeAction{
+				Operation: aws.String(tfMap["operation"].(string)),
+			}
+
+			if v, ok := tfMap[names.AttrParameters].(map[string]interface{}); ok && len(v) > 0 {
+				action.Parameters = flex.ExpandStringValueMap(v)
+			}
+
+			apiObject.Action = action
+		}
+
+		if v, ok := tfMap["condition_expression"].([]interface{}); ok {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				expression := awstypes.ConditionExpression{
+					Condition:    aws.String(tfMap[names.AttrCondition].(string)),
+					TargetColumn: aws.String(tfMap["target_column"].(string)),
+				}
+
+				if v, ok := tfMap[names.AttrValue].(string); ok && v != "" {
+					expression.Value = aws.String(v)
+				}
+
+				apiObject.ConditionExpressions = append(apiObject.ConditionExpressions, expression)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenRecipeSteps(apiObjects []awstypes.RecipeStep) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Action; v != nil {
+			tfMap[names.AttrAction] = []interface{}{map[string]interface{}{
+				"operation":          aws.ToString(v.Operation),
+				names.AttrParameters: v.Parameters,
+			}}
+		}
+
+		var expressions []interface{}
+		for _, v := range apiObject.ConditionExpressions {
+			expressions = append(expressions, map[string]interface{}{
+				names.AttrCondition: aws.ToString(v.Condition),
+				"target_column":     aws.ToString(v.TargetColumn),
+				names.AttrValue:     aws.ToString(v.Value),
+			})
+		}
+		tfMap["condition_expression"] = expressions
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,447 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_databrew_recipe_job", name="Recipe Job")
+// @Tags(identifierAttribute="arn")
+func resourceRecipeJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRecipeJobCreate,
+		ReadWithoutTimeout:   resourceRecipeJobRead,
+		UpdateWithoutTimeout: resourceRecipeJobUpdate,
+		DeleteWithoutTimeout: resourceJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dataset_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				RequiredWith:  []string{"recipe"},
+				ConflictsWith: []string{"project_name"},
+			},
+			"encryption_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				RequiredWith: []string{"encryption_mode"},
+			},
+			"encryption_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.EncryptionMode](),
+			},
+			"log_subscription": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.LogSubscriptionEnable,
+				ValidateDiagFunc: enum.Validate[awstypes.LogSubscription](),
+			},
+			"max_capacity": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 240),
+			},
+			"output": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compression_format": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.CompressionFormat](),
+						},
+						names.AttrFormat: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.OutputFormat](),
+						},
+						"format_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"csv": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delimiter": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"location": s3LocationSchema(true),
+						"max_output_files": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 999),
+						},
+						"overwrite": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"partition_columns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 200,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"project_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ExactlyOneOf:  []string{"dataset_name", "project_name"},
+				ConflictsWith: []string{"recipe"},
+			},
+			"recipe": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"recipe_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrTimeout: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2880,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func resourceRecipeJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &databrew.CreateRecipeJobInput{
+		LogSubscription: awstypes.LogSubscription(d.Get("log_subscription").(string)),
+		MaxCapacity:     int32(d.Get("max_capacity").(int)),
+		MaxRetries:      int32(d.Get("max_retries").(int)),
+		Name:            aws.String(name),
+		Outputs:         expandOutputs(d.Get("output").([]interface{})),
+		RoleArn:         aws.String(d.Get(names.AttrRoleARN).(string)),
+		Tags:            getTagsIn(ctx),
+		Timeout:         int32(d.Get(names.AttrTimeout).(int)),
+	}
+
+	if v, ok := d.GetOk("dataset_name"); ok {
+		input.DatasetName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption_key_arn"); ok {
+		input.EncryptionKeyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption_mode"); ok {
+		input.EncryptionMode = awstypes.EncryptionMode(v.(string))
+	}
+
+	if v, ok := d.GetOk("project_name"); ok {
+		input.ProjectName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("recipe"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		input.RecipeReference = &awstypes.RecipeReference{
+			Name: aws.String(tfMap[names.AttrName].(string)),
+		}
+
+		if v, ok := tfMap["recipe_version"].(string); ok && v != "" {
+			input.RecipeReference.RecipeVersion = aws.String(v)
+		}
+	}
+
+	_, err := conn.CreateRecipeJob(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DataBrew Recipe Job (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceRecipeJobRead(ctx, d, meta)...)
+}
+
+func resourceRecipeJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	output, err := findJobByName(ctx, conn, d.Id(), awstypes.JobTypeRecipe)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DataBrew Recipe Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DataBrew Recipe Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.ResourceArn)
+	d.Set("dataset_name", output.DatasetName)
+	d.Set("encryption_key_arn", output.EncryptionKeyArn)
+	d.Set("encryption_mode", output.EncryptionMode)
+	d.Set("log_subscription", output.LogSubscription)
+	d.Set("max_capacity", output.MaxCapacity)
+	d.Set("max_retries", output.MaxRetries)
+	d.Set(names.AttrName, output.Name)
+	if err := d.Set("output", flattenOutputs(output.Outputs)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output: %s", err)
+	}
+	d.Set("project_name", output.ProjectName)
+	if v := output.RecipeReference; v != nil && aws.ToString(output.ProjectName) == "" {
+		if err := d.Set("recipe", []interface{}{map[string]interface{}{
+			names.AttrName:   aws.ToString(v.Name),
+			"recipe_version": aws.ToString(v.RecipeVersion),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting recipe: %s", err)
+		}
+	} else {
+		d.Set("recipe", nil)
+	}
+	d.Set(names.AttrRoleARN, output.RoleArn)
+	d.Set(names.AttrTimeout, output.Timeout)
+
+	return diags
+}
+
+func resourceRecipeJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &databrew.UpdateRecipeJobInput{
+			LogSubscription: awstypes.LogSubscription(d.Get("log_subscription").(string)),
+			MaxCapacity:     int32(d.Get("max_capacity").(int)),
+			MaxRetries:      int32(d.Get("max_retries").(int)),
+			Name:            aws.String(d.Id()),
+			Outputs:         expandOutputs(d.Get("output").([]interface{})),
+			RoleArn:         aws.String(d.Get(names.AttrRoleARN).(string)),
+			Timeout:         int32(d.Get(names.AttrTimeout).(int)),
+		}
+
+		if v, ok := d.GetOk("encryption_key_arn"); ok {
+			input.EncryptionKeyArn = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("encryption_mode"); ok {
+			input.EncryptionMode = awstypes.EncryptionMode(v.(string))
+		}
+
+		_, err := conn.UpdateRecipeJob(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DataBrew Recipe Job (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRecipeJobRead(ctx, d, meta)...)
+}
+
+func resourceJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	log.Printf("[DEBUG] Deleting DataBrew Job: %s", d.Id())
+	_, err := conn.DeleteJob(ctx, &databrew.DeleteJobInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DataBrew Job (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findJobByName(ctx context.Context, conn *databrew.Client, name string, jobType awstypes.JobType) (*databrew.DescribeJobOutput, error) {
+	input := &databrew.DescribeJobInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if v := output.Type; v != jobType {
+		return nil, &retry.NotFoundError{
+			Message:     "job type: " + string(v),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func expandOutputs(tfList []interface{}) []awstypes.Output {
+	var apiObjects []awstypes.Output
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.Output{
+			Location:  expandS3Location(tfMap["location"].([]interface{})),
+			Overwrite: tfMap["overwrite"].(bool),
+		}
+
+		if v, ok := tfMap["compression_format"].(string); ok && v != "" {
+			apiObject.CompressionFormat = awstypes.CompressionFormat(v)
+		}
+
+		if v, ok := tfMap[names.AttrFormat].(string); ok && v != "" {
+			apiObject.Format = awstypes.OutputFormat(v)
+		}
+
+		if v, ok := tfMap["format_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.FormatOptions = &awstypes.OutputFormatOptions{}
+
+			if v, ok := v[0].(map[string]interface{})["csv"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				apiObject.FormatOptions.Csv = &awstypes.CsvOutputOptions{}
+
+				if v, ok := v[0].(map[string]interface{})["delimiter"].(string); ok && v != "" {
+					apiObject.FormatOptions.Csv.Delimiter = aws.String(v)
+				}
+			}
+		}
+
+		if v, ok := tfMap["max_output_files"].(int); ok && v > 0 {
+			apiObject.MaxOutputFiles = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["partition_columns"].([]interface{}); ok && len(v) > 0 {
+			apiObject.PartitionColumns = flex.ExpandStringValueList(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenOutputs(apiObjects []awstypes.Output) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"compression_format": string(apiObject.CompressionFormat),
+			names.AttrFormat:     string(apiObject.Format),
+			"location":           flattenS3Location(apiObject.Location),
+			"max_output_files":   aws.ToInt32(apiObject.MaxOutputFiles),
+			"overwrite":          apiObject.Overwrite,
+			"partition_columns":  apiObject.PartitionColumns,
+		}
+
+		if v := apiObject.FormatOptions; v != nil && v.Csv != nil {
+			tfMap["format_options"] = []interface{}{map[string]interface{}{
+				"csv": []interface{}{map[string]interface{}{
+					"delimiter": aws.ToString(v.Csv.Delimiter),
+				}},
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
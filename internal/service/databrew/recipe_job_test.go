@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatabrew "github.com/hashicorp/terraform-provider-aws/internal/service/databrew"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewRecipeJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeJobOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_databrew_recipe_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx, "aws_databrew_recipe_job", awstypes.JobTypeRecipe),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeJobConfig_basic(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, awstypes.JobTypeRecipe, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "databrew", fmt.Sprintf("job/%s", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "dataset_name", "aws_databrew_dataset.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "log_subscription", "ENABLE"),
+					resource.TestCheckResourceAttr(resourceName, "max_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "output.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "output.0.format", "CSV"),
+					resource.TestCheckResourceAttrPair(resourceName, "output.0.location.0.bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "output.0.location.0.key", "output/"),
+					resource.TestCheckResourceAttr(resourceName, "project_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "recipe.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(resourceName, "recipe.0.name", "aws_databrew_recipe.test", names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, "recipe.0.recipe_version", "aws_databrew_recipe.test", "recipe_version"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrTimeout, "2880"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRecipeJobConfig_basic(rName, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, awstypes.JobTypeRecipe, &v),
+					resource.TestCheckResourceAttr(resourceName, "max_capacity", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataBrewRecipeJob_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeJobOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_databrew_recipe_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx, "aws_databrew_recipe_job", awstypes.JobTypeRecipe),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeJobConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, awstypes.JobTypeRecipe, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdatabrew.ResourceRecipeJob(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckJobDestroy(ctx context.Context, resourceType string, jobType awstypes.JobType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataBrewClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			_, err := tfdatabrew.FindJobByName(ctx, conn, rs.Primary.ID, jobType)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataBrew Job %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckJobExists(ctx context.Context, n string, jobType awstypes.JobType, v *databrew.DescribeJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataBrewClient(ctx)
+
+		output, err := tfdatabrew.FindJobByName(ctx, conn, rs.Primary.ID, jobType)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRecipeJobConfig_basic(rName string, maxCapacity int) string {
+	return acctest.ConfigCompose(testAccRoleConfig_base(rName), testAccRecipeConfig_basic(rName, "name"), fmt.Sprintf(`
+resource "aws_databrew_recipe_job" "test" {
+  name         = %[1]q
+  dataset_name = aws_databrew_dataset.test.name
+  max_capacity = %[2]d
+  role_arn     = aws_iam_role.test.arn
+
+  output {
+    format = "CSV"
+
+    location {
+      bucket = aws_s3_bucket.test.bucket
+      key    = "output/"
+    }
+  }
+
+  recipe {
+    name           = aws_databrew_recipe.test.name
+    recipe_version = aws_databrew_recipe.test.recipe_version
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test, aws_iam_role_policy.test]
+}
+`, rName, maxCapacity))
+}
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatabrew "github.com/hashicorp/terraform-provider-aws/internal/service/databrew"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewRecipe_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeRecipeOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_databrew_recipe.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecipeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeConfig_basic(rName, "name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "databrew", fmt.Sprintf("recipe/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "recipe_version", "1.0"),
+					resource.TestCheckResourceAttr(resourceName, "step.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "step.0.action.0.operation", "UPPER_CASE"),
+					resource.TestCheckResourceAttr(resourceName, "step.0.action.0.parameters.sourceColumn", "name"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRecipeConfig_basic(rName, "id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "recipe_version", "2.0"),
+					resource.TestCheckResourceAttr(resourceName, "step.0.action.0.parameters.sourceColumn", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataBrewRecipe_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeRecipeOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_databrew_recipe.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecipeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeConfig_basic(rName, "name"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecipeExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdatabrew.ResourceRecipe(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDataBrewRecipe_conditionExpression(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v databrew.DescribeRecipeOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_databrew_recipe.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecipeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeConfig_conditionExpression(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
+					resource.TestCheckResourceAttr(resourceName, "step.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "step.1.condition_expression.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "step.1.condition_expression.0.condition", "IS_NOT"),
+					resource.TestCheckResourceAttr(resourceName, "step.1.condition_expression.0.target_column", "name"),
+					resource.TestCheckResourceAttr(resourceName, "step.1.condition_expression.0.value", "[\"test\"]"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRecipeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataBrewClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_databrew_recipe" {
+				continue
+			}
+
+			_, err := tfdatabrew.FindRecipeByTwoPartKey(ctx, conn, rs.Primary.ID, tfdatabrew.RecipeVersionLatestWorking)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataBrew Recipe %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecipeExists(ctx context.Context, n string, v *databrew.DescribeRecipeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataBrewClient(ctx)
+
+		output, err := tfdatabrew.FindRecipeByTwoPartKey(ctx, conn, rs.Primary.ID, tfdatabrew.RecipeVersionLatestWorking)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRecipeConfig_basic(rName, column string) string {
+	return fmt.Sprintf(`
+resource "aws_databrew_recipe" "test" {
+  name = %[1]q
+
+  step {
+    action {
+      operation = "UPPER_CASE"
+      parameters = {
+        sourceColumn = %[2]q
+      }
+    }
+  }
+}
+`, rName, column)
+}
+
+func testAccRecipeConfig_conditionExpression(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_databrew_recipe" "test" {
+  name        = %[1]q
+  description = "test"
+
+  step {
+    action {
+      operation = "UPPER_CASE"
+      parameters = {
+        sourceColumn = "name"
+      }
+    }
+  }
+
+  step {
+    action {
+      operation = "DELETE"
+    }
+
+    condition_expression {
+      condition     = "IS_NOT"
+      target_column = "name"
+      value         = "[\"test\"]"
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_databrew_schedule", name="Schedule")
+// @Tags(identifierAttribute="arn")
+func resourceSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceScheduleCreate,
+		ReadWithoutTimeout:   resourceScheduleRead,
+		UpdateWithoutTimeout: resourceScheduleUpdate,
+		DeleteWithoutTimeout: resourceScheduleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cron_expression": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"job_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 50,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &databrew.CreateScheduleInput{
+		CronExpression: aws.String(d.Get("cron_expression").(string)),
+		Name:           aws.String(name),
+		Tags:           getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("job_names"); ok && v.(*schema.Set).Len() > 0 {
+		input.JobNames = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	_, err := conn.CreateSchedule(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DataBrew Schedule (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceScheduleRead(ctx, d, meta)...)
+}
+
+func resourceScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	output, err := findScheduleByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DataBrew Schedule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DataBrew Schedule (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.ResourceArn)
+	d.Set("cron_expression", output.CronExpression)
+	d.Set("job_names", output.JobNames)
+	d.Set(names.AttrName, output.Name)
+
+	return diags
+}
+
+func resourceScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &databrew.UpdateScheduleInput{
+			CronExpression: aws.String(d.Get("cron_expression").(string)),
+			JobNames:       flex.ExpandStringValueSet(d.Get("job_names").(*schema.Set)),
+			Name:           aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateSchedule(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DataBrew Schedule (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceScheduleRead(ctx, d, meta)...)
+}
+
+func resourceScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DataBrewClient(ctx)
+
+	log.Printf("[DEBUG] Deleting DataBrew Schedule: %s", d.Id())
+	_, err := conn.DeleteSchedule(ctx, &databrew.DeleteScheduleInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DataBrew Schedule (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findScheduleByName(ctx context.Context, conn *databrew.Client, name string) (*databrew.DescribeScheduleOutput, error) {
+	input := &databrew.DescribeScheduleInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeSchedule(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -90,6 +90,12 @@ func (r *resourceDomain) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"root_domain_unit_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
 		},
@@ -194,7 +200,7 @@ func (r *resourceDomain) Create(ctx context.Context, req resource.CreateRequest,
 	plan.PortalUrl = flex.StringToFramework(ctx, out.PortalUrl)
 
 	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
-	_, err = waitDomainCreated(ctx, conn, plan.ID.ValueString(), createTimeout)
+	domain, err := waitDomainCreated(ctx, conn, plan.ID.ValueString(), createTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			create.ProblemStandardMessage(names.DataZone, create.ErrActionWaitingForCreation, ResNameDomain, plan.Name.String(), err),
@@ -203,6 +209,8 @@ func (r *resourceDomain) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	plan.RootDomainUnitId = flex.StringToFramework(ctx, domain.RootDomainUnitId)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -235,6 +243,7 @@ func (r *resourceDomain) Read(ctx context.Context, req resource.ReadRequest, res
 	state.KmsKeyIdentifier = flex.StringToFrameworkARN(ctx, out.KmsKeyIdentifier)
 	state.Name = flex.StringToFramework(ctx, out.Name)
 	state.PortalUrl = flex.StringToFramework(ctx, out.PortalUrl)
+	state.RootDomainUnitId = flex.StringToFramework(ctx, out.RootDomainUnitId)
 
 	if out.SingleSignOn.Type == awstypes.AuthType("DISABLED") && state.SingleSignOn.IsNull() {
 		// Do not set single sign on in state if it was null and response is DISABLED as this is equivalent
@@ -477,6 +486,7 @@ type domainResourceModel struct {
 	KmsKeyIdentifier    fwtypes.ARN    `tfsdk:"kms_key_identifier"`
 	Name                types.String   `tfsdk:"name"`
 	PortalUrl           types.String   `tfsdk:"portal_url"`
+	RootDomainUnitId    types.String   `tfsdk:"root_domain_unit_id"`
 	SingleSignOn        types.List     `tfsdk:"single_sign_on"`
 	Tags                types.Map      `tfsdk:"tags"`
 	TagsAll             types.Map      `tfsdk:"tags_all"`
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataZoneDomainUnit_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var domainunit datazone.GetDomainUnitOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_domain_unit.test"
+	domainName := "aws_datazone_domain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainUnitDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainUnitConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainUnitExists(ctx, resourceName, &domainunit),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_identifier", domainName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "parent_domain_unit_identifier", domainName, "root_domain_unit_id"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccDomainUnitImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccDataZoneDomainUnit_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var domainunit datazone.GetDomainUnitOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_domain_unit.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainUnitDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainUnitConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainUnitExists(ctx, resourceName, &domainunit),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatazone.ResourceDomainUnit, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDataZoneDomainUnit_update(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var domainunit datazone.GetDomainUnitOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := fmt.Sprintf("%s-updated", rName)
+	resourceName := "aws_datazone_domain_unit.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainUnitDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainUnitConfig_description(rName, rName, "description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainUnitExists(ctx, resourceName, &domainunit),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccDomainUnitImportStateIdFunc(resourceName),
+			},
+			{
+				Config: testAccDomainUnitConfig_description(rName, rNameUpdated, "description updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainUnitExists(ctx, resourceName, &domainunit),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDomainUnitDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_datazone_domain_unit" {
+				continue
+			}
+
+			_, err := tfdatazone.FindDomainUnitByID(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameDomainUnit, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameDomainUnit, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDomainUnitExists(ctx context.Context, name string, domainunit *datazone.GetDomainUnitOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameDomainUnit, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameDomainUnit, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+		resp, err := tfdatazone.FindDomainUnitByID(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameDomainUnit, rs.Primary.ID, err)
+		}
+
+		*domainunit = *resp
+
+		return nil
+	}
+}
+
+func testAccDomainUnitImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["domain_identifier"], rs.Primary.ID), nil
+	}
+}
+
+func testAccDomainUnitConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccDomainConfig_basic(rName),
+		fmt.Sprintf(`
+resource "aws_datazone_domain_unit" "test" {
+  domain_identifier             = aws_datazone_domain.test.id
+  parent_domain_unit_identifier = aws_datazone_domain.test.root_domain_unit_id
+  name                          = %[1]q
+}
+`, rName),
+	)
+}
+
+func testAccDomainUnitConfig_description(rName, name, description string) string {
+	return acctest.ConfigCompose(
+		testAccDomainConfig_basic(rName),
+		fmt.Sprintf(`
+resource "aws_datazone_domain_unit" "test" {
+  domain_identifier             = aws_datazone_domain.test.id
+  parent_domain_unit_identifier = aws_datazone_domain.test.root_domain_unit_id
+  name                          = %[1]q
+  description                   = %[2]q
+}
+`, name, description),
+	)
+}
@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Entity Owner")
+func newResourceEntityOwner(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceEntityOwner{}, nil
+}
+
+const (
+	ResNameEntityOwner = "Entity Owner"
+
+	entityOwnerIDPartCount = 5
+
+	ownerTypeGroup = "GROUP"
+	ownerTypeUser  = "USER"
+)
+
+type resourceEntityOwner struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[entityOwnerResourceModel]
+}
+
+func (r *resourceEntityOwner) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_datazone_entity_owner"
+}
+
+func (r *resourceEntityOwner) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.DataZoneEntityType](),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"owner_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner_type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ownerTypeGroup, ownerTypeUser),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceEntityOwner) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan entityOwnerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts := []string{
+		plan.DomainIdentifier.ValueString(),
+		plan.EntityType.ValueString(),
+		plan.EntityIdentifier.ValueString(),
+		plan.OwnerType.ValueString(),
+		plan.OwnerIdentifier.ValueString(),
+	}
+
+	id, err := intflex.FlattenResourceId(parts, entityOwnerIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionFlatteningResourceId, ResNameEntityOwner, plan.EntityIdentifier.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	plan.ID = types.StringValue(id)
+
+	in := &datazone.AddEntityOwnerInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		DomainIdentifier: aws.String(plan.DomainIdentifier.ValueString()),
+		EntityIdentifier: aws.String(plan.EntityIdentifier.ValueString()),
+		EntityType:       awstypes.DataZoneEntityType(plan.EntityType.ValueString()),
+		Owner:            expandOwnerProperties(plan.OwnerType.ValueString(), plan.OwnerIdentifier.ValueString()),
+	}
+
+	_, err = conn.AddEntityOwner(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameEntityOwner, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceEntityOwner) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state entityOwnerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := intflex.ExpandResourceId(state.ID.ValueString(), entityOwnerIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionExpandingResourceId, ResNameEntityOwner, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	// split ID and write constituent parts to state to support import
+	state.DomainIdentifier = types.StringValue(parts[0])
+	state.EntityType = types.StringValue(parts[1])
+	state.EntityIdentifier = types.StringValue(parts[2])
+	state.OwnerType = types.StringValue(parts[3])
+	state.OwnerIdentifier = types.StringValue(parts[4])
+
+	err = findEntityOwner(ctx, conn, parts[0], parts[1], parts[2], parts[3], parts[4])
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameEntityOwner, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceEntityOwner) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state entityOwnerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.RemoveEntityOwnerInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		DomainIdentifier: aws.String(state.DomainIdentifier.ValueString()),
+		EntityIdentifier: aws.String(state.EntityIdentifier.ValueString()),
+		EntityType:       awstypes.DataZoneEntityType(state.EntityType.ValueString()),
+		Owner:            expandOwnerProperties(state.OwnerType.ValueString(), state.OwnerIdentifier.ValueString()),
+	}
+
+	_, err := conn.RemoveEntityOwner(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameEntityOwner, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceEntityOwner) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+}
+
+// findEntityOwner returns a NotFoundError if the owner is not one of the entity's owners.
+func findEntityOwner(ctx context.Context, conn *datazone.Client, domainId, entityType, entityId, ownerType, ownerId string) error {
+	in := &datazone.ListEntityOwnersInput{
+		DomainIdentifier: aws.String(domainId),
+		EntityIdentifier: aws.String(entityId),
+		EntityType:       awstypes.DataZoneEntityType(entityType),
+	}
+
+	// Owners are listed by profile ID, while they can be added by IAM ARN or group name.
+	profileId, err := findProfileID(ctx, conn, domainId, ownerType, ownerId)
+	if err != nil {
+		return err
+	}
+
+	pages := datazone.NewListEntityOwnersPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if isResourceMissing(err) {
+			return &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, owner := range page.Owners {
+			if t, id := flattenOwnerPropertiesOutput(owner); t == ownerType && id == profileId {
+				return nil
+			}
+		}
+	}
+
+	return &retry.NotFoundError{
+		Message:     fmt.Sprintf("%s owner %s not found", ownerType, ownerId),
+		LastRequest: in,
+	}
+}
+
+// findProfileID returns the ID of the user or group profile that the identifier refers to.
+func findProfileID(ctx context.Context, conn *datazone.Client, domainId, profileType, identifier string) (string, error) {
+	switch profileType {
+	case ownerTypeGroup:
+		in := &datazone.GetGroupProfileInput{
+			DomainIdentifier: aws.String(domainId),
+			GroupIdentifier:  aws.String(identifier),
+		}
+
+		out, err := conn.GetGroupProfile(ctx, in)
+		if isResourceMissing(err) {
+			return "", &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+		if err != nil {
+			return "", err
+		}
+
+		return aws.ToString(out.Id), nil
+	case ownerTypeUser:
+		in := &datazone.GetUserProfileInput{
+			DomainIdentifier: aws.String(domainId),
+			UserIdentifier:   aws.String(identifier),
+		}
+
+		out, err := conn.GetUserProfile(ctx, in)
+		if isResourceMissing(err) {
+			return "", &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+		if err != nil {
+			return "", err
+		}
+
+		return aws.ToString(out.Id), nil
+	}
+
+	return identifier, nil
+}
+
+func expandOwnerProperties(ownerType, ownerId string) awstypes.OwnerProperties {
+	switch ownerType {
+	case ownerTypeGroup:
+		return &awstypes.OwnerPropertiesMemberGroup{
+			Value: awstypes.OwnerGroupProperties{
+				GroupIdentifier: aws.String(ownerId),
+			},
+		}
+	case ownerTypeUser:
+		return &awstypes.OwnerPropertiesMemberUser{
+			Value: awstypes.OwnerUserProperties{
+				UserIdentifier: aws.String(ownerId),
+			},
+		}
+	}
+
+	return nil
+}
+
+func flattenOwnerPropertiesOutput(apiObject awstypes.OwnerPropertiesOutput) (string, string) {
+	switch v := apiObject.(type) {
+	case *awstypes.OwnerPropertiesOutputMemberGroup:
+		return ownerTypeGroup, aws.ToString(v.Value.GroupId)
+	case *awstypes.OwnerPropertiesOutputMemberUser:
+		return ownerTypeUser, aws.ToString(v.Value.UserId)
+	}
+
+	return "", ""
+}
+
+type entityOwnerResourceModel struct {
+	DomainIdentifier types.String `tfsdk:"domain_identifier"`
+	EntityIdentifier types.String `tfsdk:"entity_identifier"`
+	EntityType       types.String `tfsdk:"entity_type"`
+	ID               types.String `tfsdk:"id"`
+	OwnerIdentifier  types.String `tfsdk:"owner_identifier"`
+	OwnerType        types.String `tfsdk:"owner_type"`
+}
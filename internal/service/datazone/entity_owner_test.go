@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataZoneEntityOwner_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_entity_owner.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEntityOwnerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityOwnerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityOwnerExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_identifier", "aws_datazone_domain.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "entity_identifier", "aws_datazone_domain_unit.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "entity_type", "DOMAIN_UNIT"),
+					resource.TestCheckResourceAttrPair(resourceName, "owner_identifier", "aws_iam_role.owner", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "owner_type", "USER"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDataZoneEntityOwner_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_entity_owner.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEntityOwnerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityOwnerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityOwnerExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatazone.ResourceEntityOwner, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEntityOwnerDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_datazone_entity_owner" {
+				continue
+			}
+
+			err := tfdatazone.FindEntityOwner(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["entity_type"], rs.Primary.Attributes["entity_identifier"], rs.Primary.Attributes["owner_type"], rs.Primary.Attributes["owner_identifier"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameEntityOwner, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameEntityOwner, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEntityOwnerExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameEntityOwner, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameEntityOwner, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+		err := tfdatazone.FindEntityOwner(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["entity_type"], rs.Primary.Attributes["entity_identifier"], rs.Primary.Attributes["owner_type"], rs.Primary.Attributes["owner_identifier"])
+
+		if err != nil {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameEntityOwner, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccEntityOwnerConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccDomainUnitConfig_basic(rName),
+		fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_iam_role" "owner" {
+  name = "%[1]s-owner"
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Action = "sts:AssumeRole"
+        Effect = "Allow"
+        Principal = {
+          AWS = data.aws_caller_identity.current.account_id
+        }
+      },
+    ]
+  })
+}
+
+resource "aws_datazone_entity_owner" "test" {
+  domain_identifier = aws_datazone_domain.test.id
+  entity_identifier = aws_datazone_domain_unit.test.id
+  entity_type       = "DOMAIN_UNIT"
+  owner_identifier  = aws_iam_role.owner.arn
+  owner_type        = "USER"
+}
+`, rName),
+	)
+}
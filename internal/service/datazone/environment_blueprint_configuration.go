@@ -11,6 +11,8 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -81,6 +84,32 @@ func (r *resourceEnvironmentBlueprintConfiguration) Schema(ctx context.Context,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"provisioning_configuration": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"lake_formation_configuration": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"location_registration_exclude_s3_locations": schema.ListAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"location_registration_role": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Optional:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -117,6 +146,22 @@ func (r *resourceEnvironmentBlueprintConfiguration) Create(ctx context.Context,
 		in.RegionalParameters = tfMap
 	}
 
+	if !plan.ProvisioningConfiguration.IsNull() {
+		var tfList []provisioningConfigurationModel
+		resp.Diagnostics.Append(plan.ProvisioningConfiguration.ElementsAs(ctx, &tfList, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		provisioningConfigurations, d := expandProvisioningConfigurations(ctx, tfList)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		in.ProvisioningConfigurations = provisioningConfigurations
+	}
+
 	out, err := conn.PutEnvironmentBlueprintConfiguration(ctx, in)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -165,6 +210,10 @@ func (r *resourceEnvironmentBlueprintConfiguration) Read(ctx context.Context, re
 	state.ManageAccessRoleArn = flex.StringToFrameworkARN(ctx, out.ManageAccessRoleArn)
 	state.ProvisioningRoleArn = flex.StringToFrameworkARN(ctx, out.ProvisioningRoleArn)
 
+	provisioningConfiguration, d := flattenProvisioningConfigurations(ctx, out.ProvisioningConfigurations)
+	resp.Diagnostics.Append(d...)
+	state.ProvisioningConfiguration = provisioningConfiguration
+
 	regionalParameters, d := flattenRegionalParameters(ctx, &out.RegionalParameters)
 	resp.Diagnostics.Append(d...)
 	state.RegionalParameters = regionalParameters
@@ -184,6 +233,7 @@ func (r *resourceEnvironmentBlueprintConfiguration) Update(ctx context.Context,
 
 	if !plan.EnabledRegions.Equal(state.EnabledRegions) ||
 		!plan.ManageAccessRoleArn.Equal(state.ManageAccessRoleArn) ||
+		!plan.ProvisioningConfiguration.Equal(state.ProvisioningConfiguration) ||
 		!plan.ProvisioningRoleArn.Equal(state.ProvisioningRoleArn) ||
 		!plan.RegionalParameters.Equal(state.RegionalParameters) {
 		in := &datazone.PutEnvironmentBlueprintConfigurationInput{
@@ -210,6 +260,22 @@ func (r *resourceEnvironmentBlueprintConfiguration) Update(ctx context.Context,
 			in.RegionalParameters = tfMap
 		}
 
+		if !plan.ProvisioningConfiguration.IsNull() {
+			var tfList []provisioningConfigurationModel
+			resp.Diagnostics.Append(plan.ProvisioningConfiguration.ElementsAs(ctx, &tfList, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			provisioningConfigurations, d := expandProvisioningConfigurations(ctx, tfList)
+			resp.Diagnostics.Append(d...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			in.ProvisioningConfigurations = provisioningConfigurations
+		}
+
 		out, err := conn.PutEnvironmentBlueprintConfiguration(ctx, in)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -332,11 +398,106 @@ func flattenEnabledRegions(ctx context.Context, apiList []string) basetypes.List
 	return flex.FlattenFrameworkStringValueList(ctx, apiList)
 }
 
+func expandProvisioningConfigurations(ctx context.Context, tfList []provisioningConfigurationModel) ([]awstypes.ProvisioningConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(tfList) == 0 {
+		return nil, diags
+	}
+
+	apiObjects := make([]awstypes.ProvisioningConfiguration, 0, len(tfList))
+
+	for _, tfObj := range tfList {
+		var lakeFormationConfigurations []lakeFormationConfigurationModel
+		diags.Append(tfObj.LakeFormationConfiguration.ElementsAs(ctx, &lakeFormationConfigurations, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if len(lakeFormationConfigurations) == 0 {
+			continue
+		}
+
+		lakeFormationConfiguration := lakeFormationConfigurations[0]
+		apiObject := awstypes.LakeFormationConfiguration{
+			LocationRegistrationExcludeS3Locations: flex.ExpandFrameworkStringValueList(ctx, lakeFormationConfiguration.LocationRegistrationExcludeS3Locations),
+		}
+
+		if !lakeFormationConfiguration.LocationRegistrationRole.IsNull() {
+			apiObject.LocationRegistrationRole = aws.String(lakeFormationConfiguration.LocationRegistrationRole.ValueString())
+		}
+
+		apiObjects = append(apiObjects, &awstypes.ProvisioningConfigurationMemberLakeFormationConfiguration{
+			Value: apiObject,
+		})
+	}
+
+	return apiObjects, diags
+}
+
+func flattenProvisioningConfigurations(ctx context.Context, apiObjects []awstypes.ProvisioningConfiguration) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: provisioningConfigurationAttrTypes}
+
+	if len(apiObjects) == 0 {
+		return types.ListNull(elemType), diags
+	}
+
+	elems := []attr.Value{}
+
+	for _, apiObject := range apiObjects {
+		v, ok := apiObject.(*awstypes.ProvisioningConfigurationMemberLakeFormationConfiguration)
+		if !ok {
+			continue
+		}
+
+		lakeFormationConfiguration, d := types.ObjectValue(lakeFormationConfigurationAttrTypes, map[string]attr.Value{
+			"location_registration_exclude_s3_locations": flex.FlattenFrameworkStringValueList(ctx, v.Value.LocationRegistrationExcludeS3Locations),
+			"location_registration_role":                 flex.StringToFrameworkARN(ctx, v.Value.LocationRegistrationRole),
+		})
+		diags.Append(d...)
+
+		lakeFormationConfigurations, d := types.ListValue(types.ObjectType{AttrTypes: lakeFormationConfigurationAttrTypes}, []attr.Value{lakeFormationConfiguration})
+		diags.Append(d...)
+
+		elem, d := types.ObjectValue(provisioningConfigurationAttrTypes, map[string]attr.Value{
+			"lake_formation_configuration": lakeFormationConfigurations,
+		})
+		diags.Append(d...)
+
+		elems = append(elems, elem)
+	}
+
+	listVal, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+
+	return listVal, diags
+}
+
 type environmentBlueprintConfigurationResourceModel struct {
-	DomainId               types.String `tfsdk:"domain_id"`
-	EnabledRegions         types.List   `tfsdk:"enabled_regions"`
-	EnvironmentBlueprintId types.String `tfsdk:"environment_blueprint_id"`
-	ManageAccessRoleArn    fwtypes.ARN  `tfsdk:"manage_access_role_arn"`
-	ProvisioningRoleArn    fwtypes.ARN  `tfsdk:"provisioning_role_arn"`
-	RegionalParameters     types.Map    `tfsdk:"regional_parameters"`
+	DomainId                  types.String `tfsdk:"domain_id"`
+	EnabledRegions            types.List   `tfsdk:"enabled_regions"`
+	EnvironmentBlueprintId    types.String `tfsdk:"environment_blueprint_id"`
+	ManageAccessRoleArn       fwtypes.ARN  `tfsdk:"manage_access_role_arn"`
+	ProvisioningConfiguration types.List   `tfsdk:"provisioning_configuration"`
+	ProvisioningRoleArn       fwtypes.ARN  `tfsdk:"provisioning_role_arn"`
+	RegionalParameters        types.Map    `tfsdk:"regional_parameters"`
+}
+
+type provisioningConfigurationModel struct {
+	LakeFormationConfiguration types.List `tfsdk:"lake_formation_configuration"`
+}
+
+type lakeFormationConfigurationModel struct {
+	LocationRegistrationExcludeS3Locations types.List  `tfsdk:"location_registration_exclude_s3_locations"`
+	LocationRegistrationRole               fwtypes.ARN `tfsdk:"location_registration_role"`
+}
+
+var lakeFormationConfigurationAttrTypes = map[string]attr.Type{
+	"location_registration_exclude_s3_locations": types.ListType{ElemType: types.StringType},
+	"location_registration_role":                 fwtypes.ARNType,
+}
+
+var provisioningConfigurationAttrTypes = map[string]attr.Type{
+	"lake_formation_configuration": types.ListType{ElemType: types.ObjectType{AttrTypes: lakeFormationConfigurationAttrTypes}},
 }
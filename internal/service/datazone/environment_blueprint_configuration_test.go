@@ -226,6 +226,52 @@ func TestAccDataZoneEnvironmentBlueprintConfiguration_provisioning_role_arn(t *t
 	})
 }
 
+func TestAccDataZoneEnvironmentBlueprintConfiguration_provisioning_configuration(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var environmentblueprintconfiguration datazone.GetEnvironmentBlueprintConfigurationOutput
+	domainName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_environment_blueprint_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentBlueprintConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentBlueprintConfigurationConfig_provisioning_configuration(domainName, "s3://"+domainName+"-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentBlueprintConfigurationExists(ctx, resourceName, &environmentblueprintconfiguration),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_configuration.0.lake_formation_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_configuration.0.lake_formation_configuration.0.location_registration_exclude_s3_locations.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_configuration.0.lake_formation_configuration.0.location_registration_exclude_s3_locations.0", "s3://"+domainName+"-1"),
+					resource.TestCheckResourceAttrPair(resourceName, "provisioning_configuration.0.lake_formation_configuration.0.location_registration_role", "aws_iam_role.domain_execution_role", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    testAccEnvironmentBlueprintConfigurationImportStateIdFunc(resourceName),
+				ImportStateVerifyIdentifierAttribute: "environment_blueprint_id",
+			},
+			{
+				Config: testAccEnvironmentBlueprintConfigurationConfig_provisioning_configuration(domainName, "s3://"+domainName+"-2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentBlueprintConfigurationExists(ctx, resourceName, &environmentblueprintconfiguration),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_configuration.0.lake_formation_configuration.0.location_registration_exclude_s3_locations.0", "s3://"+domainName+"-2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDataZoneEnvironmentBlueprintConfiguration_regional_parameters(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -365,6 +411,26 @@ resource "aws_datazone_environment_blueprint_configuration" "test" {
 	)
 }
 
+func testAccEnvironmentBlueprintConfigurationConfig_provisioning_configuration(domainName, excludeLocation string) string {
+	return acctest.ConfigCompose(
+		testAccEnvironmentBlueprintDataSourceConfig_basic(domainName),
+		fmt.Sprintf(`
+resource "aws_datazone_environment_blueprint_configuration" "test" {
+  domain_id                = aws_datazone_domain.test.id
+  environment_blueprint_id = data.aws_datazone_environment_blueprint.test.id
+  enabled_regions          = []
+
+  provisioning_configuration {
+    lake_formation_configuration {
+      location_registration_exclude_s3_locations = [%[1]q]
+      location_registration_role                 = aws_iam_role.domain_execution_role.arn
+    }
+  }
+}
+`, excludeLocation),
+	)
+}
+
 func testAccEnvironmentBlueprintConfigurationConfig_regional_parameters(domainName, region, key, value string) string {
 	return acctest.ConfigCompose(
 		testAccEnvironmentBlueprintDataSourceConfig_basic(domainName),
@@ -6,6 +6,13 @@ package datazone
 // Exports for use in tests only.
 var (
 	ResourceDomain                            = newResourceDomain
+	ResourceDomainUnit                        = newResourceDomainUnit
+	ResourceEntityOwner                       = newResourceEntityOwner
 	ResourceEnvironmentBlueprintConfiguration = newResourceEnvironmentBlueprintConfiguration
-	IsResourceMissing                         = isResourceMissing
+	ResourcePolicyGrant                       = newResourcePolicyGrant
+
+	FindDomainUnitByID = findDomainUnitByID
+	FindEntityOwner    = findEntityOwner
+	FindPolicyGrant    = findPolicyGrant
+	IsResourceMissing  = isResourceMissing
 )
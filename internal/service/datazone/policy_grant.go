@@ -0,0 +1,490 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Policy Grant")
+func newResourcePolicyGrant(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourcePolicyGrant{}, nil
+}
+
+const (
+	ResNamePolicyGrant = "Policy Grant"
+
+	policyGrantIDPartCount = 6
+
+	principalTypeDomainUnit = "DOMAIN_UNIT"
+	principalTypeGroup      = "GROUP"
+	principalTypeProject    = "PROJECT"
+	principalTypeUser       = "USER"
+)
+
+// policyGrantPolicyTypes are the managed policy types whose grant detail can be expressed
+// by the include_child_domain_units and domain_unit_id arguments.
+var policyGrantPolicyTypes = []awstypes.ManagedPolicyType{
+	awstypes.ManagedPolicyTypeAddToProjectMemberPool,
+	awstypes.ManagedPolicyTypeCreateAssetType,
+	awstypes.ManagedPolicyTypeCreateDomainUnit,
+	awstypes.ManagedPolicyTypeCreateEnvironment,
+	awstypes.ManagedPolicyTypeCreateEnvironmentProfile,
+	awstypes.ManagedPolicyTypeCreateFormType,
+	awstypes.ManagedPolicyTypeCreateGlossary,
+	awstypes.ManagedPolicyTypeCreateProject,
+	awstypes.ManagedPolicyTypeDelegateCreateEnvironmentProfile,
+	awstypes.ManagedPolicyTypeOverrideDomainUnitOwners,
+	awstypes.ManagedPolicyTypeOverrideProjectOwners,
+}
+
+type resourcePolicyGrant struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[policyGrantResourceModel]
+}
+
+func (r *resourcePolicyGrant) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_datazone_policy_grant"
+}
+
+func (r *resourcePolicyGrant) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_unit_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.TargetEntityType](),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"include_child_domain_units": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(policyGrantPolicyTypes...)...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_designation": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(append(enum.Values[awstypes.ProjectDesignation](), enum.Values[awstypes.DomainUnitDesignation]()...)...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(principalTypeDomainUnit, principalTypeGroup, principalTypeProject, principalTypeUser),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourcePolicyGrant) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan policyGrantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts := []string{
+		plan.DomainIdentifier.ValueString(),
+		plan.EntityType.ValueString(),
+		plan.EntityIdentifier.ValueString(),
+		plan.PolicyType.ValueString(),
+		plan.PrincipalType.ValueString(),
+		plan.PrincipalIdentifier.ValueString(),
+	}
+
+	id, err := intflex.FlattenResourceId(parts, policyGrantIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionFlatteningResourceId, ResNamePolicyGrant, plan.EntityIdentifier.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	plan.ID = types.StringValue(id)
+
+	in := &datazone.AddPolicyGrantInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		Detail:           expandPolicyGrantDetail(ctx, awstypes.ManagedPolicyType(plan.PolicyType.ValueString()), plan.IncludeChildDomainUnits, plan.DomainUnitId),
+		DomainIdentifier: aws.String(plan.DomainIdentifier.ValueString()),
+		EntityIdentifier: aws.String(plan.EntityIdentifier.ValueString()),
+		EntityType:       awstypes.TargetEntityType(plan.EntityType.ValueString()),
+		PolicyType:       awstypes.ManagedPolicyType(plan.PolicyType.ValueString()),
+		Principal:        expandPolicyGrantPrincipal(plan.PrincipalType.ValueString(), plan.PrincipalIdentifier.ValueString(), plan.PrincipalDesignation.ValueString()),
+	}
+
+	_, err = conn.AddPolicyGrant(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNamePolicyGrant, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := findPolicyGrant(ctx, conn, parts[0], parts[1], parts[2], parts[3], parts[4], parts[5], plan.PrincipalDesignation.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNamePolicyGrant, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	includeChildDomainUnits, _ := flattenPolicyGrantDetail(out.Detail)
+	plan.IncludeChildDomainUnits = flex.BoolToFramework(ctx, includeChildDomainUnits)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourcePolicyGrant) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state policyGrantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := intflex.ExpandResourceId(state.ID.ValueString(), policyGrantIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionExpandingResourceId, ResNamePolicyGrant, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	// split ID and write constituent parts to state to support import
+	state.DomainIdentifier = types.StringValue(parts[0])
+	state.EntityType = types.StringValue(parts[1])
+	state.EntityIdentifier = types.StringValue(parts[2])
+	state.PolicyType = types.StringValue(parts[3])
+	state.PrincipalType = types.StringValue(parts[4])
+	state.PrincipalIdentifier = types.StringValue(parts[5])
+
+	out, err := findPolicyGrant(ctx, conn, parts[0], parts[1], parts[2], parts[3], parts[4], parts[5], state.PrincipalDesignation.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNamePolicyGrant, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	_, _, designation := flattenPolicyGrantPrincipal(out.Principal)
+	state.PrincipalDesignation = flex.StringValueToFramework(ctx, designation)
+
+	includeChildDomainUnits, domainUnitId := flattenPolicyGrantDetail(out.Detail)
+	state.DomainUnitId = flex.StringToFramework(ctx, domainUnitId)
+	state.IncludeChildDomainUnits = flex.BoolToFramework(ctx, includeChildDomainUnits)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourcePolicyGrant) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state policyGrantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.RemovePolicyGrantInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		DomainIdentifier: aws.String(state.DomainIdentifier.ValueString()),
+		EntityIdentifier: aws.String(state.EntityIdentifier.ValueString()),
+		EntityType:       awstypes.TargetEntityType(state.EntityType.ValueString()),
+		PolicyType:       awstypes.ManagedPolicyType(state.PolicyType.ValueString()),
+		Principal:        expandPolicyGrantPrincipal(state.PrincipalType.ValueString(), state.PrincipalIdentifier.ValueString(), state.PrincipalDesignation.ValueString()),
+	}
+
+	_, err := conn.RemovePolicyGrant(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNamePolicyGrant, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourcePolicyGrant) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+}
+
+// findPolicyGrant returns the entity's grant of the policy to the principal.
+// An empty designation matches any designation of the principal.
+func findPolicyGrant(ctx context.Context, conn *datazone.Client, domainId, entityType, entityId, policyType, principalType, principalId, designation string) (*awstypes.PolicyGrantMember, error) {
+	in := &datazone.ListPolicyGrantsInput{
+		DomainIdentifier: aws.String(domainId),
+		EntityIdentifier: aws.String(entityId),
+		EntityType:       awstypes.TargetEntityType(entityType),
+		PolicyType:       awstypes.ManagedPolicyType(policyType),
+	}
+
+	// User and group principals are listed by profile ID, while they can be granted by IAM ARN or group name.
+	switch principalType {
+	case principalTypeGroup, principalTypeUser:
+		profileId, err := findProfileID(ctx, conn, domainId, principalType, principalId)
+		if err != nil {
+			return nil, err
+		}
+		principalId = profileId
+	}
+
+	pages := datazone.NewListPolicyGrantsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.GrantList {
+			t, id, d := flattenPolicyGrantPrincipal(v.Principal)
+
+			if t == principalType && id == principalId && (designation == "" || d == designation) {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		Message:     fmt.Sprintf("%s grant to %s principal %s not found", policyType, principalType, principalId),
+		LastRequest: in,
+	}
+}
+
+func expandPolicyGrantPrincipal(principalType, principalId, designation string) awstypes.PolicyGrantPrincipal {
+	switch principalType {
+	case principalTypeDomainUnit:
+		return &awstypes.PolicyGrantPrincipalMemberDomainUnit{
+			Value: awstypes.DomainUnitPolicyGrantPrincipal{
+				DomainUnitDesignation: awstypes.DomainUnitDesignation(designation),
+				DomainUnitIdentifier:  aws.String(principalId),
+			},
+		}
+	case principalTypeGroup:
+		return &awstypes.PolicyGrantPrincipalMemberGroup{
+			Value: &awstypes.GroupPolicyGrantPrincipalMemberGroupIdentifier{
+				Value: principalId,
+			},
+		}
+	case principalTypeProject:
+		return &awstypes.PolicyGrantPrincipalMemberProject{
+			Value: awstypes.ProjectPolicyGrantPrincipal{
+				ProjectDesignation: awstypes.ProjectDesignation(designation),
+				ProjectIdentifier:  aws.String(principalId),
+			},
+		}
+	case principalTypeUser:
+		return &awstypes.PolicyGrantPrincipalMemberUser{
+			Value: &awstypes.UserPolicyGrantPrincipalMemberUserIdentifier{
+				Value: principalId,
+			},
+		}
+	}
+
+	return nil
+}
+
+// flattenPolicyGrantPrincipal returns the principal's type, identifier and designation.
+func flattenPolicyGrantPrincipal(apiObject awstypes.PolicyGrantPrincipal) (string, string, string) {
+	switch v := apiObject.(type) {
+	case *awstypes.PolicyGrantPrincipalMemberDomainUnit:
+		return principalTypeDomainUnit, aws.ToString(v.Value.DomainUnitIdentifier), string(v.Value.DomainUnitDesignation)
+	case *awstypes.PolicyGrantPrincipalMemberGroup:
+		if v, ok := v.Value.(*awstypes.GroupPolicyGrantPrincipalMemberGroupIdentifier); ok {
+			return principalTypeGroup, v.Value, ""
+		}
+	case *awstypes.PolicyGrantPrincipalMemberProject:
+		return principalTypeProject, aws.ToString(v.Value.ProjectIdentifier), string(v.Value.ProjectDesignation)
+	case *awstypes.PolicyGrantPrincipalMemberUser:
+		if v, ok := v.Value.(*awstypes.UserPolicyGrantPrincipalMemberUserIdentifier); ok {
+			return principalTypeUser, v.Value, ""
+		}
+	}
+
+	return "", "", ""
+}
+
+// expandPolicyGrantDetail returns the grant detail that matches the managed policy type.
+func expandPolicyGrantDetail(ctx context.Context, policyType awstypes.ManagedPolicyType, includeChildDomainUnits types.Bool, domainUnitId types.String) awstypes.PolicyGrantDetail {
+	include := flex.BoolFromFramework(ctx, includeChildDomainUnits)
+
+	switch policyType {
+	case awstypes.ManagedPolicyTypeAddToProjectMemberPool:
+		return &awstypes.PolicyGrantDetailMemberAddToProjectMemberPool{
+			Value: awstypes.AddToProjectMemberPoolPolicyGrantDetail{IncludeChildDomainUnits: include},
+		}
+	case awstypes.ManagedPolicyTypeCreateAssetType:
+		return &awstypes.PolicyGrantDetailMemberCreateAssetType{
+			Value: awstypes.CreateAssetTypePolicyGrantDetail{IncludeChildDomainUnits: include},
+		}
+	case awstypes.ManagedPolicyTypeCreateDomainUnit:
+		return &awstypes.PolicyGrantDetailMemberCreateDomainUnit{
+			Value: awstypes.CreateDomainUnitPolicyGrantDetail{IncludeChildDomainUnits: include},
+		}
+	case awstypes.ManagedPolicyTypeCreateEnvironment:
+		return &awstypes.PolicyGrantDetailMemberCreateEnvironment{
+			Value: awstypes.Unit{},
+		}
+	case awstypes.ManagedPolicyTypeCreateEnvironmentProfile:
+		return &awstypes.PolicyGrantDetailMemberCreateEnvironmentProfile{
+			Value: awstypes.CreateEnvironmentProfilePolicyGrantDetail{DomainUnitId: flex.StringFromFramework(ctx, domainUnitId)},
+		}
+	case awstypes.ManagedPolicyTypeCreateFormType:
+		return &awstypes.PolicyGrantDetailMemberCreateFormType{
+			Value: awstypes.CreateFormTypePolicyGrantDetail{IncludeChildDomainUnits: include},
+		}
+	case awstypes.ManagedPolicyTypeCreateGlossary:
+		return &awstypes.PolicyGrantDetailMemberCreateGlossary{
+			Value: awstypes.CreateGlossaryPolicyGrantDetail{IncludeChildDomainUnits: include},
+		}
+	case awstypes.ManagedPolicyTypeCreateProject:
+		return &awstypes.PolicyGrantDetailMemberCreateProject{
+			Value: awstypes.CreateProjectPolicyGrantDetail{IncludeChildDomainUnits: include},
+		}
+	case awstypes.ManagedPolicyTypeDelegateCreateEnvironmentProfile:
+		return &awstypes.PolicyGrantDetailMemberDelegateCreateEnvironmentProfile{
+			Value: awstypes.Unit{},
+		}
+	case awstypes.ManagedPolicyTypeOverrideDomainUnitOwners:
+		return &awstypes.PolicyGrantDetailMemberOverrideDomainUnitOwners{
+			Value: awstypes.OverrideDomainUnitOwnersPolicyGrantDetail{IncludeChildDomainUnits: include},
+		}
+	case awstypes.ManagedPolicyTypeOverrideProjectOwners:
+		return &awstypes.PolicyGrantDetailMemberOverrideProjectOwners{
+			Value: awstypes.OverrideProjectOwnersPolicyGrantDetail{IncludeChildDomainUnits: include},
+		}
+	}
+
+	return nil
+}
+
+// flattenPolicyGrantDetail returns the grant detail's include child domain units flag and domain unit ID.
+func flattenPolicyGrantDetail(apiObject awstypes.PolicyGrantDetail) (*bool, *string) {
+	switch v := apiObject.(type) {
+	case *awstypes.PolicyGrantDetailMemberAddToProjectMemberPool:
+		return v.Value.IncludeChildDomainUnits, nil
+	case *awstypes.PolicyGrantDetailMemberCreateAssetType:
+		return v.Value.IncludeChildDomainUnits, nil
+	case *awstypes.PolicyGrantDetailMemberCreateDomainUnit:
+		return v.Value.IncludeChildDomainUnits, nil
+	case *awstypes.PolicyGrantDetailMemberCreateEnvironmentProfile:
+		return nil, v.Value.DomainUnitId
+	case *awstypes.PolicyGrantDetailMemberCreateFormType:
+		return v.Value.IncludeChildDomainUnits, nil
+	case *awstypes.PolicyGrantDetailMemberCreateGlossary:
+		return v.Value.IncludeChildDomainUnits, nil
+	case *awstypes.PolicyGrantDetailMemberCreateProject:
+		return v.Value.IncludeChildDomainUnits, nil
+	case *awstypes.PolicyGrantDetailMemberOverrideDomainUnitOwners:
+		return v.Value.IncludeChildDomainUnits, nil
+	case *awstypes.PolicyGrantDetailMemberOverrideProjectOwners:
+		return v.Value.IncludeChildDomainUnits, nil
+	}
+
+	return nil, nil
+}
+
+type policyGrantResourceModel struct {
+	DomainIdentifier        types.String `tfsdk:"domain_identifier"`
+	DomainUnitId            types.String `tfsdk:"domain_unit_id"`
+	EntityIdentifier        types.String `tfsdk:"entity_identifier"`
+	EntityType              types.String `tfsdk:"entity_type"`
+	ID                      types.String `tfsdk:"id"`
+	IncludeChildDomainUnits types.Bool   `tfsdk:"include_child_domain_units"`
+	PolicyType              types.String `tfsdk:"policy_type"`
+	PrincipalDesignation    types.String `tfsdk:"principal_designation"`
+	PrincipalIdentifier     types.String `tfsdk:"principal_identifier"`
+	PrincipalType           types.String `tfsdk:"principal_type"`
+}
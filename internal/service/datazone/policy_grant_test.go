@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataZonePolicyGrant_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_policy_grant.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyGrantConfig_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyGrantExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_identifier", "aws_datazone_domain.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "entity_identifier", "aws_datazone_domain.test", "root_domain_unit_id"),
+					resource.TestCheckResourceAttr(resourceName, "entity_type", "DOMAIN_UNIT"),
+					resource.TestCheckResourceAttr(resourceName, "include_child_domain_units", "true"),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "CREATE_DOMAIN_UNIT"),
+					resource.TestCheckResourceAttr(resourceName, "principal_designation", "OWNER"),
+					resource.TestCheckResourceAttrPair(resourceName, "principal_identifier", "aws_datazone_domain_unit.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "principal_type", "DOMAIN_UNIT"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPolicyGrantConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyGrantExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "include_child_domain_units", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataZonePolicyGrant_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_policy_grant.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyGrantConfig_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyGrantExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatazone.ResourcePolicyGrant, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPolicyGrantDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_datazone_policy_grant" {
+				continue
+			}
+
+			_, err := tfdatazone.FindPolicyGrant(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["entity_type"], rs.Primary.Attributes["entity_identifier"], rs.Primary.Attributes["policy_type"], rs.Primary.Attributes["principal_type"], rs.Primary.Attributes["principal_identifier"], rs.Primary.Attributes["principal_designation"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNamePolicyGrant, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNamePolicyGrant, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPolicyGrantExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNamePolicyGrant, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNamePolicyGrant, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+		_, err := tfdatazone.FindPolicyGrant(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["entity_type"], rs.Primary.Attributes["entity_identifier"], rs.Primary.Attributes["policy_type"], rs.Primary.Attributes["principal_type"], rs.Primary.Attributes["principal_identifier"], rs.Primary.Attributes["principal_designation"])
+
+		if err != nil {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNamePolicyGrant, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccPolicyGrantConfig_basic(rName string, includeChildDomainUnits bool) string {
+	return acctest.ConfigCompose(
+		testAccDomainUnitConfig_basic(rName),
+		fmt.Sprintf(`
+resource "aws_datazone_policy_grant" "test" {
+  domain_identifier = aws_datazone_domain.test.id
+  entity_identifier = aws_datazone_domain.test.root_domain_unit_id
+  entity_type       = "DOMAIN_UNIT"
+  policy_type       = "CREATE_DOMAIN_UNIT"
+
+  principal_type        = "DOMAIN_UNIT"
+  principal_identifier  = aws_datazone_domain_unit.test.id
+  principal_designation = "OWNER"
+
+  include_child_domain_units = %[1]t
+}
+`, includeChildDomainUnits),
+	)
+}
@@ -32,10 +32,22 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory: newResourceDomainUnit,
+			Name:    "Domain Unit",
+		},
+		{
+			Factory: newResourceEntityOwner,
+			Name:    "Entity Owner",
+		},
 		{
 			Factory: newResourceEnvironmentBlueprintConfiguration,
 			Name:    "Environment Blueprint Configuration",
 		},
+		{
+			Factory: newResourcePolicyGrant,
+			Name:    "Policy Grant",
+		},
 	}
 }
 
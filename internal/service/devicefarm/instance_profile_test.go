@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/devicefarm"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -69,6 +70,58 @@ func TestAccDeviceFarmInstanceProfile_basic(t *testing.T) {
 	})
 }
 
+func TestAccDeviceFarmInstanceProfile_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var profile devicefarm.InstanceProfile
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_devicefarm_instance_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, devicefarm.EndpointsID)
+			// Currently, DeviceFarm is only supported in us-west-2
+			// https://docs.aws.amazon.com/general/latest/gr/devicefarm.html
+			acctest.PreCheckRegion(t, endpoints.UsWest2RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeviceFarmServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceProfileConfig_settings(rName, "first", true, false, "com.example.one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceProfileExists(ctx, resourceName, &profile),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+					resource.TestCheckResourceAttr(resourceName, "package_cleanup", "true"),
+					resource.TestCheckResourceAttr(resourceName, "reboot_after_use", "false"),
+					resource.TestCheckResourceAttr(resourceName, "exclude_app_packages_from_cleanup.#", acctest.CtOne),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInstanceProfileConfig_settings(rName, "second", false, true, "com.example.two"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceProfileExists(ctx, resourceName, &profile),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "second"),
+					resource.TestCheckResourceAttr(resourceName, "package_cleanup", "false"),
+					resource.TestCheckResourceAttr(resourceName, "reboot_after_use", "true"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "exclude_app_packages_from_cleanup.*", "com.example.two"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDeviceFarmInstanceProfile_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var profile devicefarm.InstanceProfile
@@ -212,6 +265,18 @@ resource "aws_devicefarm_instance_profile" "test" {
 `, rName)
 }
 
+func testAccInstanceProfileConfig_settings(rName, description string, packageCleanup, rebootAfterUse bool, excludedPackage string) string {
+	return fmt.Sprintf(`
+resource "aws_devicefarm_instance_profile" "test" {
+  name                              = %[1]q
+  description                       = %[2]q
+  package_cleanup                   = %[3]t
+  reboot_after_use                  = %[4]t
+  exclude_app_packages_from_cleanup = [%[5]q]
+}
+`, rName, description, packageCleanup, rebootAfterUse, excludedPackage)
+}
+
 func testAccInstanceProfileConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_devicefarm_instance_profile" "test" {
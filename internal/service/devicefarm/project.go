@@ -11,10 +11,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/devicefarm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -50,9 +52,40 @@ func ResourceProject() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrVPCConfig: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrSecurityGroupIDs: {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrSubnetIDs: {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrVPCID: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// The VPC configuration can be changed but not removed.
+			customdiff.ForceNewIfChange(names.AttrVPCConfig, func(_ context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
+			}),
+		),
 	}
 }
 
@@ -69,6 +102,10 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.DefaultJobTimeoutMinutes = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := d.GetOk(names.AttrVPCConfig); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VpcConfig = expandVPCConfig(v.([]interface{}))
+	}
+
 	output, err := conn.CreateProjectWithContext(ctx, input)
 
 	if err != nil {
@@ -104,6 +141,9 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set(names.AttrName, project.Name)
 	d.Set(names.AttrARN, arn)
 	d.Set("default_job_timeout_minutes", project.DefaultJobTimeoutMinutes)
+	if err := d.Set(names.AttrVPCConfig, flattenVPCConfig(project.VpcConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting vpc_config: %s", err)
+	}
 
 	return diags
 }
@@ -125,6 +165,10 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.DefaultJobTimeoutMinutes = aws.Int64(int64(d.Get("default_job_timeout_minutes").(int)))
 		}
 
+		if d.HasChange(names.AttrVPCConfig) {
+			input.VpcConfig = expandVPCConfig(d.Get(names.AttrVPCConfig).([]interface{}))
+		}
+
 		_, err := conn.UpdateProjectWithContext(ctx, input)
 
 		if err != nil {
@@ -154,3 +198,33 @@ func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, meta int
 
 	return diags
 }
+
+func expandVPCConfig(l []interface{}) *devicefarm.VpcConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &devicefarm.VpcConfig{
+		VpcId:            aws.String(m[names.AttrVPCID].(string)),
+		SubnetIds:        flex.ExpandStringSet(m[names.AttrSubnetIDs].(*schema.Set)),
+		SecurityGroupIds: flex.ExpandStringSet(m[names.AttrSecurityGroupIDs].(*schema.Set)),
+	}
+
+	return config
+}
+
+func flattenVPCConfig(conf *devicefarm.VpcConfig) []interface{} {
+	if conf == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		names.AttrVPCID:            aws.StringValue(conf.VpcId),
+		names.AttrSubnetIDs:        flex.FlattenStringSet(conf.SubnetIds),
+		names.AttrSecurityGroupIDs: flex.FlattenStringSet(conf.SecurityGroupIds),
+	}
+
+	return []interface{}{m}
+}
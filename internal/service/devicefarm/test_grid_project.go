@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/devicefarm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -77,7 +78,13 @@ func ResourceTestGridProject() *schema.Resource {
 				},
 			},
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// The VPC configuration can be changed but not removed.
+			customdiff.ForceNewIfChange(names.AttrVPCConfig, func(_ context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
+			}),
+		),
 	}
 }
 
@@ -157,6 +164,10 @@ func resourceTestGridProjectUpdate(ctx context.Context, d *schema.ResourceData,
 			input.Description = aws.String(d.Get(names.AttrDescription).(string))
 		}
 
+		if d.HasChange(names.AttrVPCConfig) {
+			input.VpcConfig = expandTestGridProjectVPCConfig(d.Get(names.AttrVPCConfig).([]interface{}))
+		}
+
 		_, err := conn.UpdateTestGridProjectWithContext(ctx, input)
 
 		if err != nil {
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/devicefarm"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -92,6 +93,8 @@ func TestAccDeviceFarmTestGridProject_vpc(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", acctest.CtOne),
 					resource.TestCheckResourceAttrPair(resourceName, "vpc_config.0.vpc_id", "aws_vpc.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.subnet_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.security_group_ids.#", "2"),
 				),
 			},
 			{
@@ -99,6 +102,20 @@ func TestAccDeviceFarmTestGridProject_vpc(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccTestGridProjectConfig_projectVPCUpdated(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectTestGridProjectExists(ctx, resourceName, &proj),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.subnet_ids.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.security_group_ids.#", acctest.CtOne),
+				),
+			},
 		},
 	})
 }
@@ -246,7 +263,7 @@ resource "aws_devicefarm_test_grid_project" "test" {
 `, rName)
 }
 
-func testAccTestGridProjectConfig_projectVPC(rName string) string {
+func testAccTestGridProjectConfig_baseVPC(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigAvailableAZsNoOptIn(),
 		fmt.Sprintf(`
@@ -274,7 +291,11 @@ resource "aws_security_group" "test" {
     cidr_blocks = [aws_vpc.test.cidr_block]
   }
 }
+`, rName))
+}
 
+func testAccTestGridProjectConfig_projectVPC(rName string) string {
+	return acctest.ConfigCompose(testAccTestGridProjectConfig_baseVPC(rName), fmt.Sprintf(`
 resource "aws_devicefarm_test_grid_project" "test" {
   name = %[1]q
 
@@ -287,6 +308,20 @@ resource "aws_devicefarm_test_grid_project" "test" {
 `, rName))
 }
 
+func testAccTestGridProjectConfig_projectVPCUpdated(rName string) string {
+	return acctest.ConfigCompose(testAccTestGridProjectConfig_baseVPC(rName), fmt.Sprintf(`
+resource "aws_devicefarm_test_grid_project" "test" {
+  name = %[1]q
+
+  vpc_config {
+    vpc_id             = aws_vpc.test.id
+    subnet_ids         = [aws_subnet.test[0].id]
+    security_group_ids = [aws_security_group.test[0].id]
+  }
+}
+`, rName))
+}
+
 func testAccTestGridProjectConfig_projectTags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_devicefarm_test_grid_project" "test" {
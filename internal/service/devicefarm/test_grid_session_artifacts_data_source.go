@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devicefarm
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_devicefarm_test_grid_session_artifacts", name="Test Grid Session Artifacts")
+func DataSourceTestGridSessionArtifacts() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTestGridSessionArtifactsRead,
+
+		Schema: map[string]*schema.Schema{
+			"artifacts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filename": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrURL: {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"session_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(devicefarm.TestGridSessionArtifactCategory_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceTestGridSessionArtifactsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeviceFarmConn(ctx)
+
+	sessionARN := d.Get("session_arn").(string)
+	input := &devicefarm.ListTestGridSessionArtifactsInput{
+		SessionArn: aws.String(sessionARN),
+	}
+
+	if v, ok := d.GetOk(names.AttrType); ok {
+		input.Type = aws.String(v.(string))
+	}
+
+	artifacts, err := findTestGridSessionArtifacts(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DeviceFarm Test Grid Session (%s) artifacts: %s", sessionARN, err)
+	}
+
+	d.SetId(sessionARN)
+	if err := d.Set("artifacts", flattenTestGridSessionArtifacts(artifacts)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting artifacts: %s", err)
+	}
+
+	return diags
+}
+
+func findTestGridSessionArtifacts(ctx context.Context, conn *devicefarm.DeviceFarm, input *devicefarm.ListTestGridSessionArtifactsInput) ([]*devicefarm.TestGridSessionArtifact, error) {
+	var output []*devicefarm.TestGridSessionArtifact
+
+	err := conn.ListTestGridSessionArtifactsPagesWithContext(ctx, input, func(page *devicefarm.ListTestGridSessionArtifactsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Artifacts {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func flattenTestGridSessionArtifacts(apiObjects []*devicefarm.TestGridSessionArtifact) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"filename":     aws.StringValue(apiObject.Filename),
+			names.AttrType: aws.StringValue(apiObject.Type),
+			names.AttrURL:  aws.StringValue(apiObject.Url),
+		})
+	}
+
+	return tfList
+}
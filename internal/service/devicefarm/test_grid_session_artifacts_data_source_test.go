@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devicefarm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDeviceFarmTestGridSessionArtifactsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	// Test grid sessions are started by Selenium clients, not by Terraform.
+	sessionARN := acctest.SkipIfEnvVarNotSet(t, "DEVICEFARM_TEST_GRID_SESSION_ARN")
+	dataSourceName := "data.aws_devicefarm_test_grid_session_artifacts.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, devicefarm.EndpointsID)
+			// Currently, DeviceFarm is only supported in us-west-2
+			// https://docs.aws.amazon.com/general/latest/gr/devicefarm.html
+			acctest.PreCheckRegion(t, endpoints.UsWest2RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeviceFarmServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTestGridSessionArtifactsDataSourceConfig_basic(sessionARN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "session_arn", sessionARN),
+					resource.TestCheckResourceAttrSet(dataSourceName, "artifacts.#"),
+				),
+			},
+			{
+				Config: testAccTestGridSessionArtifactsDataSourceConfig_type(sessionARN, devicefarm.TestGridSessionArtifactCategoryLog),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrType, devicefarm.TestGridSessionArtifactCategoryLog),
+					resource.TestCheckResourceAttrSet(dataSourceName, "artifacts.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTestGridSessionArtifactsDataSourceConfig_basic(sessionARN string) string {
+	return fmt.Sprintf(`
+data "aws_devicefarm_test_grid_session_artifacts" "test" {
+  session_arn = %[1]q
+}
+`, sessionARN)
+}
+
+func testAccTestGridSessionArtifactsDataSourceConfig_type(sessionARN, artifactType string) string {
+	return fmt.Sprintf(`
+data "aws_devicefarm_test_grid_session_artifacts" "test" {
+  session_arn = %[1]q
+  type        = %[2]q
+}
+`, sessionARN, artifactType)
+}
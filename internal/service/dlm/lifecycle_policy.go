@@ -41,6 +41,12 @@ func ResourceLifecyclePolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"default_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(dlm.DefaultPolicyTypeValues_Values(), false),
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -132,6 +138,31 @@ func ResourceLifecyclePolicy() *schema.Resource {
 								},
 							},
 						},
+						"copy_tags": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"create_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 7),
+						},
+						"cross_region_copy_target": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 3,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target_region": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidRegionName,
+									},
+								},
+							},
+						},
 						"event_source": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -174,6 +205,45 @@ func ResourceLifecyclePolicy() *schema.Resource {
 								},
 							},
 						},
+						"exclusions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_boot_volumes": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"exclude_tags": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"exclude_volume_types": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 6,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"extend_deletion": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"policy_language": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(dlm.PolicyLanguageValues_Values(), false),
+						},
+						names.AttrResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"resource_types": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -216,6 +286,12 @@ func ResourceLifecyclePolicy() *schema.Resource {
 							Default:      dlm.PolicyTypeValuesEbsSnapshotManagement,
 							ValidateFunc: validation.StringInSlice(dlm.PolicyTypeValues_Values(), false),
 						},
+						"retain_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(2, 14),
+						},
 						names.AttrSchedule: {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -223,6 +299,51 @@ func ResourceLifecyclePolicy() *schema.Resource {
 							MaxItems: 4,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"archive_rule": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"archive_retain_rule": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"retention_archive_tier": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"count": {
+																			Type:         schema.TypeInt,
+																			Optional:     true,
+																			ValidateFunc: validation.IntBetween(1, 1000),
+																		},
+																		names.AttrInterval: {
+																			Type:         schema.TypeInt,
+																			Optional:     true,
+																			ValidateFunc: validation.IntAtLeast(1),
+																		},
+																		"interval_unit": {
+																			Type:     schema.TypeString,
+																			Optional: true,
+																			ValidateFunc: validation.StringInSlice(
+																				dlm.RetentionIntervalUnitValues_Values(),
+																				false,
+																			),
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
 									"copy_tags": {
 										Type:     schema.TypeBool,
 										Optional: true,
@@ -504,14 +625,19 @@ func resourceLifecyclePolicyCreate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DLMConn(ctx)
 
+	defaultPolicy := d.Get("default_policy").(string)
 	input := dlm.CreateLifecyclePolicyInput{
 		Description:      aws.String(d.Get(names.AttrDescription).(string)),
 		ExecutionRoleArn: aws.String(d.Get(names.AttrExecutionRoleARN).(string)),
-		PolicyDetails:    expandPolicyDetails(d.Get("policy_details").([]interface{})),
+		PolicyDetails:    expandPolicyDetails(d.Get("policy_details").([]interface{}), defaultPolicy),
 		State:            aws.String(d.Get(names.AttrState).(string)),
 		Tags:             getTagsIn(ctx),
 	}
 
+	if defaultPolicy != "" {
+		input.DefaultPolicy = aws.String(defaultPolicy)
+	}
+
 	log.Printf("[INFO] Creating DLM lifecycle policy: %s", input)
 	out, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
 		return conn.CreateLifecyclePolicyWithContext(ctx, &input)
@@ -546,6 +672,12 @@ func resourceLifecyclePolicyRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	d.Set(names.AttrARN, out.Policy.PolicyArn)
+	// Default policies are reported as a flag, their type is the policy's resource type.
+	if aws.BoolValue(out.Policy.DefaultPolicy) && out.Policy.PolicyDetails != nil {
+		d.Set("default_policy", out.Policy.PolicyDetails.ResourceType)
+	} else {
+		d.Set("default_policy", nil)
+	}
 	d.Set(names.AttrDescription, out.Policy.Description)
 	d.Set(names.AttrExecutionRoleARN, out.Policy.ExecutionRoleArn)
 	d.Set(names.AttrState, out.Policy.State)
@@ -577,7 +709,7 @@ func resourceLifecyclePolicyUpdate(ctx context.Context, d *schema.ResourceData,
 			input.State = aws.String(d.Get(names.AttrState).(string))
 		}
 		if d.HasChange("policy_details") {
-			input.PolicyDetails = expandPolicyDetails(d.Get("policy_details").([]interface{}))
+			input.PolicyDetails = expandPolicyDetails(d.Get("policy_details").([]interface{}), d.Get("default_policy").(string))
 		}
 
 		log.Printf("[INFO] Updating lifecycle policy %s", d.Id())
@@ -608,7 +740,7 @@ func resourceLifecyclePolicyDelete(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
-func expandPolicyDetails(cfg []interface{}) *dlm.PolicyDetails {
+func expandPolicyDetails(cfg []interface{}, defaultPolicy string) *dlm.PolicyDetails {
 	if len(cfg) == 0 || cfg[0] == nil {
 		return nil
 	}
@@ -618,6 +750,34 @@ func expandPolicyDetails(cfg []interface{}) *dlm.PolicyDetails {
 	policyDetails := &dlm.PolicyDetails{
 		PolicyType: aws.String(policyType),
 	}
+	if v, ok := m["policy_language"].(string); ok && v != "" {
+		policyDetails.PolicyLanguage = aws.String(v)
+	}
+	// These arguments are only supported by default policies.
+	if defaultPolicy != "" {
+		if policyDetails.PolicyLanguage == nil {
+			policyDetails.PolicyLanguage = aws.String(dlm.PolicyLanguageValuesSimplified)
+		}
+		if v, ok := m["copy_tags"].(bool); ok {
+			policyDetails.CopyTags = aws.Bool(v)
+		}
+		if v, ok := m["create_interval"].(int); ok && v > 0 {
+			policyDetails.CreateInterval = aws.Int64(int64(v))
+		}
+		if v, ok := m["cross_region_copy_target"].(*schema.Set); ok && v.Len() > 0 {
+			policyDetails.CrossRegionCopyTargets = expandCrossRegionCopyTargets(v.List())
+		}
+		if v, ok := m["exclusions"].([]interface{}); ok && len(v) > 0 {
+			policyDetails.Exclusions = expandExclusions(v)
+		}
+		if v, ok := m["extend_deletion"].(bool); ok {
+			policyDetails.ExtendDeletion = aws.Bool(v)
+		}
+		if v, ok := m["retain_interval"].(int); ok && v > 0 {
+			policyDetails.RetainInterval = aws.Int64(int64(v))
+		}
+		policyDetails.ResourceType = aws.String(defaultPolicy)
+	}
 	if v, ok := m["resource_types"].([]interface{}); ok && len(v) > 0 {
 		policyDetails.ResourceTypes = flex.ExpandStringList(v)
 	}
@@ -652,6 +812,17 @@ func flattenPolicyDetails(policyDetails *dlm.PolicyDetails) []map[string]interfa
 	result[names.AttrSchedule] = flattenSchedules(policyDetails.Schedules)
 	result["target_tags"] = flattenTags(policyDetails.TargetTags)
 	result["policy_type"] = aws.StringValue(policyDetails.PolicyType)
+	result["policy_language"] = aws.StringValue(policyDetails.PolicyLanguage)
+	result[names.AttrResourceType] = aws.StringValue(policyDetails.ResourceType)
+	result["copy_tags"] = aws.BoolValue(policyDetails.CopyTags)
+	result["create_interval"] = aws.Int64Value(policyDetails.CreateInterval)
+	result["cross_region_copy_target"] = flattenCrossRegionCopyTargets(policyDetails.CrossRegionCopyTargets)
+	result["extend_deletion"] = aws.BoolValue(policyDetails.ExtendDeletion)
+	result["retain_interval"] = aws.Int64Value(policyDetails.RetainInterval)
+
+	if policyDetails.Exclusions != nil {
+		result["exclusions"] = flattenExclusions(policyDetails.Exclusions)
+	}
 
 	if policyDetails.Parameters != nil {
 		result[names.AttrParameters] = flattenParameters(policyDetails.Parameters)
@@ -665,6 +836,9 @@ func expandSchedules(cfg []interface{}) []*dlm.Schedule {
 	for i, c := range cfg {
 		schedule := &dlm.Schedule{}
 		m := c.(map[string]interface{})
+		if v, ok := m["archive_rule"].([]interface{}); ok && len(v) > 0 {
+			schedule.ArchiveRule = expandArchiveRule(v)
+		}
 		if v, ok := m["copy_tags"]; ok {
 			schedule.CopyTags = aws.Bool(v.(bool))
 		}
@@ -706,6 +880,7 @@ func flattenSchedules(schedules []*dlm.Schedule) []map[string]interface{} {
 	result := make([]map[string]interface{}, len(schedules))
 	for i, s := range schedules {
 		m := make(map[string]interface{})
+		m["archive_rule"] = flattenArchiveRule(s.ArchiveRule)
 		m["copy_tags"] = aws.BoolValue(s.CopyTags)
 		m["create_rule"] = flattenCreateRule(s.CreateRule)
 		m["cross_region_copy_rule"] = flattenCrossRegionCopyRules(s.CrossRegionCopyRules)
@@ -732,6 +907,141 @@ func flattenSchedules(schedules []*dlm.Schedule) []map[string]interface{} {
 	return result
 }
 
+func expandCrossRegionCopyTargets(l []interface{}) []*dlm.CrossRegionCopyTarget {
+	var targets []*dlm.CrossRegionCopyTarget
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		targets = append(targets, &dlm.CrossRegionCopyTarget{
+			TargetRegion: aws.String(m["target_region"].(string)),
+		})
+	}
+
+	return targets
+}
+
+func flattenCrossRegionCopyTargets(targets []*dlm.CrossRegionCopyTarget) []interface{} {
+	var result []interface{}
+
+	for _, target := range targets {
+		if target == nil {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"target_region": aws.StringValue(target.TargetRegion),
+		})
+	}
+
+	return result
+}
+
+func expandExclusions(cfg []interface{}) *dlm.Exclusions {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+	exclusions := &dlm.Exclusions{}
+
+	if v, ok := m["exclude_boot_volumes"].(bool); ok {
+		exclusions.ExcludeBootVolumes = aws.Bool(v)
+	}
+
+	if v, ok := m["exclude_tags"].(map[string]interface{}); ok && len(v) > 0 {
+		exclusions.ExcludeTags = expandTags(v)
+	}
+
+	if v, ok := m["exclude_volume_types"].([]interface{}); ok && len(v) > 0 {
+		exclusions.ExcludeVolumeTypes = flex.ExpandStringList(v)
+	}
+
+	return exclusions
+}
+
+func flattenExclusions(exclusions *dlm.Exclusions) []map[string]interface{} {
+	result := make(map[string]interface{})
+	result["exclude_boot_volumes"] = aws.BoolValue(exclusions.ExcludeBootVolumes)
+	result["exclude_tags"] = flattenTags(exclusions.ExcludeTags)
+	result["exclude_volume_types"] = flex.FlattenStringList(exclusions.ExcludeVolumeTypes)
+
+	return []map[string]interface{}{result}
+}
+
+func expandArchiveRule(cfg []interface{}) *dlm.ArchiveRule {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+	rule := &dlm.ArchiveRule{}
+
+	if v, ok := m["archive_retain_rule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		rule.RetainRule = &dlm.ArchiveRetainRule{}
+
+		if v, ok := v[0].(map[string]interface{})["retention_archive_tier"].([]interface{}); ok && len(v) > 0 {
+			rule.RetainRule.RetentionArchiveTier = expandRetentionArchiveTier(v)
+		}
+	}
+
+	return rule
+}
+
+func flattenArchiveRule(rule *dlm.ArchiveRule) []interface{} {
+	if rule == nil || rule.RetainRule == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"archive_retain_rule": []interface{}{
+			map[string]interface{}{
+				"retention_archive_tier": flattenRetentionArchiveTier(rule.RetainRule.RetentionArchiveTier),
+			},
+		},
+	}
+
+	return []interface{}{m}
+}
+
+func expandRetentionArchiveTier(cfg []interface{}) *dlm.RetentionArchiveTier {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return &dlm.RetentionArchiveTier{}
+	}
+	m := cfg[0].(map[string]interface{})
+	tier := &dlm.RetentionArchiveTier{}
+
+	if v, ok := m["count"].(int); ok && v > 0 {
+		tier.Count = aws.Int64(int64(v))
+	}
+
+	if v, ok := m[names.AttrInterval].(int); ok && v > 0 {
+		tier.Interval = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["interval_unit"].(string); ok && v != "" {
+		tier.IntervalUnit = aws.String(v)
+	}
+
+	return tier
+}
+
+func flattenRetentionArchiveTier(tier *dlm.RetentionArchiveTier) []interface{} {
+	if tier == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"count":            aws.Int64Value(tier.Count),
+		names.AttrInterval: aws.Int64Value(tier.Interval),
+		"interval_unit":    aws.StringValue(tier.IntervalUnit),
+	}
+
+	return []interface{}{m}
+}
+
 func expandActions(cfg []interface{}) []*dlm.Action {
 	actions := make([]*dlm.Action, len(cfg))
 	for i, c := range cfg {
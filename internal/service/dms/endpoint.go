@@ -125,6 +125,48 @@ func ResourceEndpoint() *schema.Resource {
 				Optional:         true,
 				DiffSuppressFunc: suppressExtraConnectionAttributesDiffs,
 			},
+			"ibm_db2_settings": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"current_lsn": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"keep_csv_files": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"load_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_file_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_k_bytes_per_read": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"set_data_capture_changes": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"write_buffer_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
 			"kafka_settings": {
 				Type:             schema.TypeList,
 				Optional:         true,
@@ -757,6 +799,43 @@ func ResourceEndpoint() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"test_connection_replication_instance_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"timestream_settings": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cdc_inserts_and_updates": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						names.AttrDatabaseName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"enable_magnetic_store_writes": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"magnetic_duration": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 73000),
+						},
+						"memory_duration": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 8766),
+						},
+					},
+				},
+			},
 			names.AttrUsername: {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -990,31 +1069,36 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 			expandTopLevelConnectionInfo(d, input)
 		}
 	case engineNameDB2, engineNameDB2zOS:
+		settings := &dms.IBMDb2Settings{}
+		if v, ok := d.GetOk("ibm_db2_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			settings = expandIBMDb2Settings(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		if _, ok := d.GetOk("secrets_manager_arn"); ok {
-			input.IBMDb2Settings = &dms.IBMDb2Settings{
-				SecretsManagerAccessRoleArn: aws.String(d.Get("secrets_manager_access_role_arn").(string)),
-				SecretsManagerSecretId:      aws.String(d.Get("secrets_manager_arn").(string)),
-				DatabaseName:                aws.String(d.Get(names.AttrDatabaseName).(string)),
-			}
+			settings.SecretsManagerAccessRoleArn = aws.String(d.Get("secrets_manager_access_role_arn").(string))
+			settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
+			settings.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
 		} else {
-			input.IBMDb2Settings = &dms.IBMDb2Settings{
-				Username:     aws.String(d.Get(names.AttrUsername).(string)),
-				Password:     aws.String(d.Get(names.AttrPassword).(string)),
-				ServerName:   aws.String(d.Get("server_name").(string)),
-				Port:         aws.Int64(int64(d.Get(names.AttrPort).(int))),
-				DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
-			}
+			settings.Username = aws.String(d.Get(names.AttrUsername).(string))
+			settings.Password = aws.String(d.Get(names.AttrPassword).(string))
+			settings.ServerName = aws.String(d.Get("server_name").(string))
+			settings.Port = aws.Int64(int64(d.Get(names.AttrPort).(int)))
+			settings.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
 
 			// Set connection info in top-level namespace as well
 			expandTopLevelConnectionInfo(d, input)
 		}
+
+		input.IBMDb2Settings = settings
 	case engineNameS3:
 		input.S3Settings = expandS3Settings(d.Get("s3_settings").([]interface{})[0].(map[string]interface{}))
+	case engineNameTimestream:
+		input.TimestreamSettings = expandTimestreamSettings(d.Get("timestream_settings").([]interface{})[0].(map[string]interface{}))
 	default:
 		expandTopLevelConnectionInfo(d, input)
 	}
 
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate),
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate),
 		func() (interface{}, error) {
 			return conn.CreateEndpointWithContext(ctx, input)
 		},
@@ -1026,6 +1110,14 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(endpointID)
 
+	if v, ok := d.GetOk("test_connection_replication_instance_arn"); ok {
+		endpointARN := aws.StringValue(outputRaw.(*dms.CreateEndpointOutput).Endpoint.EndpointArn)
+
+		if err := testEndpointConnection(ctx, conn, endpointARN, v.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "testing DMS Endpoint (%s) connection: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceEndpointRead(ctx, d, meta)...)
 }
 
@@ -1070,7 +1162,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			}
 		}
 
-		if d.HasChangesExcept("pause_replication_tasks") {
+		if d.HasChangesExcept("pause_replication_tasks", "test_connection_replication_instance_arn") {
 			input := &dms.ModifyEndpointInput{
 				EndpointArn: aws.String(endpointARN),
 			}
@@ -1352,32 +1444,40 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			case engineNameDB2, engineNameDB2zOS:
 				if d.HasChanges(
 					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName, "secrets_manager_access_role_arn",
-					"secrets_manager_arn") {
+					"secrets_manager_arn", "ibm_db2_settings") {
+					settings := &dms.IBMDb2Settings{}
+					if v, ok := d.GetOk("ibm_db2_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+						settings = expandIBMDb2Settings(v.([]interface{})[0].(map[string]interface{}))
+					}
+
 					if _, ok := d.GetOk("secrets_manager_arn"); ok {
-						input.IBMDb2Settings = &dms.IBMDb2Settings{
-							DatabaseName:                aws.String(d.Get(names.AttrDatabaseName).(string)),
-							SecretsManagerAccessRoleArn: aws.String(d.Get("secrets_manager_access_role_arn").(string)),
-							SecretsManagerSecretId:      aws.String(d.Get("secrets_manager_arn").(string)),
-						}
+						settings.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
+						settings.SecretsManagerAccessRoleArn = aws.String(d.Get("secrets_manager_access_role_arn").(string))
+						settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
 					} else {
-						input.IBMDb2Settings = &dms.IBMDb2Settings{
-							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
-							ServerName:   aws.String(d.Get("server_name").(string)),
-							Port:         aws.Int64(int64(d.Get(names.AttrPort).(int))),
-							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
-						}
+						settings.Username = aws.String(d.Get(names.AttrUsername).(string))
+						settings.Password = aws.String(d.Get(names.AttrPassword).(string))
+						settings.ServerName = aws.String(d.Get("server_name").(string))
+						settings.Port = aws.Int64(int64(d.Get(names.AttrPort).(int)))
+						settings.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
 						input.EngineName = aws.String(engineName) // Must be included (should be 'db2')
 
 						// Update connection info in top-level namespace as well
 						expandTopLevelConnectionInfoModify(d, input)
 					}
+
+					input.IBMDb2Settings = settings
 				}
 			case engineNameS3:
 				if d.HasChanges("s3_settings") {
 					input.S3Settings = expandS3Settings(d.Get("s3_settings").([]interface{})[0].(map[string]interface{}))
 					input.EngineName = aws.String(engineName)
 				}
+			case engineNameTimestream:
+				if d.HasChanges("timestream_settings") {
+					input.TimestreamSettings = expandTimestreamSettings(d.Get("timestream_settings").([]interface{})[0].(map[string]interface{}))
+					input.EngineName = aws.String(engineName)
+				}
 			default:
 				if d.HasChange(names.AttrDatabaseName) {
 					input.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
@@ -1405,6 +1505,12 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating DMS Endpoint (%s): %s", d.Id(), err)
 			}
+
+			if v, ok := d.GetOk("test_connection_replication_instance_arn"); ok {
+				if err := testEndpointConnection(ctx, conn, endpointARN, v.(string)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "testing DMS Endpoint (%s) connection: %s", d.Id(), err)
+				}
+			}
 		}
 
 		if pauseTasks && len(tasks) > 0 {
@@ -1467,6 +1573,10 @@ func requireEngineSettingsCustomizeDiff(_ context.Context, diff *schema.Resource
 		if v, ok := diff.GetOk("s3_settings"); !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
 			return fmt.Errorf("s3_settings must be set when engine_name = %q", engineName)
 		}
+	case engineNameTimestream:
+		if v, ok := diff.GetOk("timestream_settings"); !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+			return fmt.Errorf("timestream_settings must be set when engine_name = %q", engineName)
+		}
 	}
 
 	return nil
@@ -1670,10 +1780,17 @@ func resourceEndpointSetState(d *schema.ResourceData, endpoint *dms.Endpoint) er
 		} else {
 			flattenTopLevelConnectionInfo(d, endpoint)
 		}
+		if err := d.Set("ibm_db2_settings", flattenIBMDb2Settings(endpoint.IBMDb2Settings)); err != nil {
+			return fmt.Errorf("setting ibm_db2_settings: %w", err)
+		}
 	case engineNameS3:
 		if err := d.Set("s3_settings", flattenS3Settings(endpoint.S3Settings)); err != nil {
 			return fmt.Errorf("setting s3_settings for DMS: %s", err)
 		}
+	case engineNameTimestream:
+		if err := d.Set("timestream_settings", flattenTimestreamSettings(endpoint.TimestreamSettings)); err != nil {
+			return fmt.Errorf("setting timestream_settings: %w", err)
+		}
 	default:
 		d.Set(names.AttrDatabaseName, endpoint.DatabaseName)
 		d.Set(names.AttrPort, endpoint.Port)
@@ -1781,6 +1898,44 @@ func startEndpointReplicationTasks(ctx context.Context, conn *dms.DatabaseMigrat
 	return nil
 }
 
+func testEndpointConnection(ctx context.Context, conn *dms.DatabaseMigrationService, endpointARN, replicationInstanceARN string) error {
+	_, err := conn.TestConnectionWithContext(ctx, &dms.TestConnectionInput{
+		EndpointArn:            aws.String(endpointARN),
+		ReplicationInstanceArn: aws.String(replicationInstanceARN),
+	})
+
+	// A test that is already in progress is awaited below.
+	if err != nil && !tfawserr.ErrMessageContains(err, dms.ErrCodeInvalidResourceStateFault, "already being tested") {
+		return err
+	}
+
+	input := &dms.DescribeConnectionsInput{
+		Filters: []*dms.Filter{
+			{
+				Name:   aws.String("endpoint-arn"),
+				Values: aws.StringSlice([]string{endpointARN}),
+			},
+			{
+				Name:   aws.String("replication-instance-arn"),
+				Values: aws.StringSlice([]string{replicationInstanceARN}),
+			},
+		},
+	}
+
+	if err := conn.WaitUntilTestConnectionSucceedsWithContext(ctx, input); err != nil {
+		// Surface the reason the connection test failed, if any.
+		if output, _ := conn.DescribeConnectionsWithContext(ctx, input); output != nil && len(output.Connections) > 0 {
+			if v := aws.StringValue(output.Connections[0].LastFailureMessage); v != "" {
+				return fmt.Errorf("%w: %s", err, v)
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
 func findReplicationTasksByEndpointARN(ctx context.Context, conn *dms.DatabaseMigrationService, arn string) ([]*dms.ReplicationTask, error) {
 	input := &dms.DescribeReplicationTasksInput{
 		Filters: []*dms.Filter{
@@ -2281,6 +2436,70 @@ func flattenPostgreSQLSettings(apiObject *dms.PostgreSQLSettings) []map[string]i
 	return []map[string]interface{}{tfMap}
 }
 
+func expandIBMDb2Settings(tfMap map[string]interface{}) *dms.IBMDb2Settings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dms.IBMDb2Settings{}
+
+	if v, ok := tfMap["current_lsn"].(string); ok && v != "" {
+		apiObject.CurrentLsn = aws.String(v)
+	}
+	if v, ok := tfMap["keep_csv_files"].(bool); ok {
+		apiObject.KeepCsvFiles = aws.Bool(v)
+	}
+	if v, ok := tfMap["load_timeout"].(int); ok && v != 0 {
+		apiObject.LoadTimeout = aws.Int64(int64(v))
+	}
+	if v, ok := tfMap["max_file_size"].(int); ok && v != 0 {
+		apiObject.MaxFileSize = aws.Int64(int64(v))
+	}
+	if v, ok := tfMap["max_k_bytes_per_read"].(int); ok && v != 0 {
+		apiObject.MaxKBytesPerRead = aws.Int64(int64(v))
+	}
+	if v, ok := tfMap["set_data_capture_changes"].(bool); ok {
+		apiObject.SetDataCaptureChanges = aws.Bool(v)
+	}
+	if v, ok := tfMap["write_buffer_size"].(int); ok && v != 0 {
+		apiObject.WriteBufferSize = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenIBMDb2Settings(apiObject *dms.IBMDb2Settings) []map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CurrentLsn; v != nil {
+		tfMap["current_lsn"] = aws.StringValue(v)
+	}
+	if v := apiObject.KeepCsvFiles; v != nil {
+		tfMap["keep_csv_files"] = aws.BoolValue(v)
+	}
+	if v := apiObject.LoadTimeout; v != nil {
+		tfMap["load_timeout"] = aws.Int64Value(v)
+	}
+	if v := apiObject.MaxFileSize; v != nil {
+		tfMap["max_file_size"] = aws.Int64Value(v)
+	}
+	if v := apiObject.MaxKBytesPerRead; v != nil {
+		tfMap["max_k_bytes_per_read"] = aws.Int64Value(v)
+	}
+	if v := apiObject.SetDataCaptureChanges; v != nil {
+		tfMap["set_data_capture_changes"] = aws.BoolValue(v)
+	}
+	if v := apiObject.WriteBufferSize; v != nil {
+		tfMap["write_buffer_size"] = aws.Int64Value(v)
+	}
+
+	return []map[string]interface{}{tfMap}
+}
+
 func expandS3Settings(tfMap map[string]interface{}) *dms.S3Settings {
 	if tfMap == nil {
 		return nil
@@ -2616,6 +2835,58 @@ func engineSettingsToSet(l []interface{}) *schema.Set {
 	return s
 }
 
+func expandTimestreamSettings(tfMap map[string]interface{}) *dms.TimestreamSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dms.TimestreamSettings{}
+
+	if v, ok := tfMap["cdc_inserts_and_updates"].(bool); ok {
+		apiObject.CdcInsertsAndUpdates = aws.Bool(v)
+	}
+	if v, ok := tfMap[names.AttrDatabaseName].(string); ok && v != "" {
+		apiObject.DatabaseName = aws.String(v)
+	}
+	if v, ok := tfMap["enable_magnetic_store_writes"].(bool); ok {
+		apiObject.EnableMagneticStoreWrites = aws.Bool(v)
+	}
+	if v, ok := tfMap["magnetic_duration"].(int); ok {
+		apiObject.MagneticDuration = aws.Int64(int64(v))
+	}
+	if v, ok := tfMap["memory_duration"].(int); ok {
+		apiObject.MemoryDuration = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenTimestreamSettings(apiObject *dms.TimestreamSettings) []map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CdcInsertsAndUpdates; v != nil {
+		tfMap["cdc_inserts_and_updates"] = aws.BoolValue(v)
+	}
+	if v := apiObject.DatabaseName; v != nil {
+		tfMap[names.AttrDatabaseName] = aws.StringValue(v)
+	}
+	if v := apiObject.EnableMagneticStoreWrites; v != nil {
+		tfMap["enable_magnetic_store_writes"] = aws.BoolValue(v)
+	}
+	if v := apiObject.MagneticDuration; v != nil {
+		tfMap["magnetic_duration"] = aws.Int64Value(v)
+	}
+	if v := apiObject.MemoryDuration; v != nil {
+		tfMap["memory_duration"] = aws.Int64Value(v)
+	}
+
+	return []map[string]interface{}{tfMap}
+}
+
 func expandTopLevelConnectionInfo(d *schema.ResourceData, input *dms.CreateEndpointInput) {
 	input.Username = aws.String(d.Get(names.AttrUsername).(string))
 	input.Password = aws.String(d.Get(names.AttrPassword).(string))
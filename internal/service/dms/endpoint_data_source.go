@@ -73,6 +73,42 @@ func DataSourceEndpoint() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"ibm_db2_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"current_lsn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"keep_csv_files": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"load_timeout": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_file_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_k_bytes_per_read": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"set_data_capture_changes": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"write_buffer_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"kafka_settings": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -561,6 +597,34 @@ func DataSourceEndpoint() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"timestream_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cdc_inserts_and_updates": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						names.AttrDatabaseName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enable_magnetic_store_writes": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"magnetic_duration": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory_duration": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrUsername: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -1997,6 +1997,80 @@ func TestAccDMSEndpoint_redis(t *testing.T) {
 	})
 }
 
+func TestAccDMSEndpoint_timestream(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dms_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointConfig_timestream(rName, 24, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint_arn"),
+					resource.TestCheckResourceAttr(resourceName, "engine_name", "timestream"),
+					resource.TestCheckResourceAttr(resourceName, "timestream_settings.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "timestream_settings.0.cdc_inserts_and_updates", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "timestream_settings.0.database_name", "aws_timestreamwrite_database.test", names.AttrDatabaseName),
+					resource.TestCheckResourceAttr(resourceName, "timestream_settings.0.enable_magnetic_store_writes", "false"),
+					resource.TestCheckResourceAttr(resourceName, "timestream_settings.0.magnetic_duration", "30"),
+					resource.TestCheckResourceAttr(resourceName, "timestream_settings.0.memory_duration", "24"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEndpointConfig_timestream(rName, 48, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "timestream_settings.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "timestream_settings.0.cdc_inserts_and_updates", "true"),
+					resource.TestCheckResourceAttr(resourceName, "timestream_settings.0.enable_magnetic_store_writes", "true"),
+					resource.TestCheckResourceAttr(resourceName, "timestream_settings.0.memory_duration", "48"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDMSEndpoint_db2zOS_settings(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dms_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointConfig_db2zOSSettings(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ibm_db2_settings.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "ibm_db2_settings.0.max_k_bytes_per_read", "128"),
+					resource.TestCheckResourceAttr(resourceName, "ibm_db2_settings.0.set_data_capture_changes", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrPassword},
+			},
+		},
+	})
+}
+
 func TestAccDMSEndpoint_Redshift_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_dms_endpoint.test"
@@ -4458,6 +4532,49 @@ resource "aws_dms_endpoint" "test" {
 `, rName)
 }
 
+func testAccEndpointConfig_timestream(rName string, memoryDuration int, cdc bool) string {
+	return fmt.Sprintf(`
+resource "aws_timestreamwrite_database" "test" {
+  database_name = %[1]q
+}
+
+resource "aws_dms_endpoint" "test" {
+  endpoint_id   = %[1]q
+  endpoint_type = "target"
+  engine_name   = "timestream"
+
+  timestream_settings {
+    cdc_inserts_and_updates      = %[3]t
+    database_name                = aws_timestreamwrite_database.test.database_name
+    enable_magnetic_store_writes = %[3]t
+    magnetic_duration            = 30
+    memory_duration              = %[2]d
+  }
+}
+`, rName, memoryDuration, cdc)
+}
+
+func testAccEndpointConfig_db2zOSSettings(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dms_endpoint" "test" {
+  database_name = "tf-test-dms-db"
+  endpoint_id   = %[1]q
+  endpoint_type = "source"
+  engine_name   = "db2-zos"
+  password      = "tftest"
+  port          = 27017
+  server_name   = "tftest"
+  ssl_mode      = "none"
+  username      = "tftest"
+
+  ibm_db2_settings {
+    max_k_bytes_per_read     = 128
+    set_data_capture_changes = true
+  }
+}
+`, rName)
+}
+
 func testAccEndpointConfig_redshiftBase(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInExclude("usw2-az2"), fmt.Sprintf(`
 resource "aws_redshift_cluster" "test" {
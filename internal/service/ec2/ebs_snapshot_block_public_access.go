@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ebs_snapshot_block_public_access", name="EBS Snapshot Block Public Access")
+func ResourceEBSSnapshotBlockPublicAccess() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEBSSnapshotBlockPublicAccessPut,
+		ReadWithoutTimeout:   resourceEBSSnapshotBlockPublicAccessRead,
+		UpdateWithoutTimeout: resourceEBSSnapshotBlockPublicAccessPut,
+		DeleteWithoutTimeout: resourceEBSSnapshotBlockPublicAccessDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrState: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.SnapshotBlockPublicAccessState](),
+			},
+		},
+	}
+}
+
+func resourceEBSSnapshotBlockPublicAccessPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	state := d.Get(names.AttrState).(string)
+
+	if state == string(types.SnapshotBlockPublicAccessStateUnblocked) {
+		input := &ec2.DisableSnapshotBlockPublicAccessInput{}
+
+		_, err := conn.DisableSnapshotBlockPublicAccess(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "disabling EBS Snapshot Block Public Access: %s", err)
+		}
+	} else {
+		input := &ec2.EnableSnapshotBlockPublicAccessInput{
+			State: types.SnapshotBlockPublicAccessState(state),
+		}
+
+		_, err := conn.EnableSnapshotBlockPublicAccess(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "enabling EBS Snapshot Block Public Access: %s", err)
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	if err := WaitSnapshotBlockPublicAccessState(ctx, conn, state, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EBS Snapshot Block Public Access state (%s): %s", state, err)
+	}
+
+	return append(diags, resourceEBSSnapshotBlockPublicAccessRead(ctx, d, meta)...)
+}
+
+func resourceEBSSnapshotBlockPublicAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	output, err := FindSnapshotBlockPublicAccessState(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EBS Snapshot Block Public Access %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EBS Snapshot Block Public Access (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrState, output)
+
+	return diags
+}
+
+func resourceEBSSnapshotBlockPublicAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	log.Printf("[DEBUG] Deleting EBS Snapshot Block Public Access: %s", d.Id())
+	_, err := conn.DisableSnapshotBlockPublicAccess(ctx, &ec2.DisableSnapshotBlockPublicAccessInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling EBS Snapshot Block Public Access: %s", err)
+	}
+
+	if err := WaitSnapshotBlockPublicAccessState(ctx, conn, string(types.SnapshotBlockPublicAccessStateUnblocked), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EBS Snapshot Block Public Access disable: %s", err)
+	}
+
+	return diags
+}
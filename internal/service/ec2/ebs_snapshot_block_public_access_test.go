@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2EBSSnapshotBlockPublicAccess_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		"basic": testAccEBSSnapshotBlockPublicAccess_basic,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccEBSSnapshotBlockPublicAccess_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ebs_snapshot_block_public_access.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEBSSnapshotBlockPublicAccessDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSSnapshotBlockPublicAccessConfig_basic("block-all-sharing"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "block-all-sharing"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEBSSnapshotBlockPublicAccessConfig_basic("block-new-sharing"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "block-new-sharing"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEBSSnapshotBlockPublicAccessDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindSnapshotBlockPublicAccessState(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if output != "unblocked" {
+			return fmt.Errorf("EBS Snapshot Block Public Access state is %s, expected unblocked", output)
+		}
+
+		return nil
+	}
+}
+
+func testAccEBSSnapshotBlockPublicAccessConfig_basic(state string) string {
+	return fmt.Sprintf(`
+resource "aws_ebs_snapshot_block_public_access" "test" {
+  state = %[1]q
+}
+`, state)
+}
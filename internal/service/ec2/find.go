@@ -6887,6 +6887,21 @@ func FindImageBlockPublicAccessState(ctx context.Context, conn *ec2_sdkv2.Client
 	return output.ImageBlockPublicAccessState, nil
 }
 
+func FindSnapshotBlockPublicAccessState(ctx context.Context, conn *ec2_sdkv2.Client) (string, error) {
+	input := &ec2_sdkv2.GetSnapshotBlockPublicAccessStateInput{}
+	output, err := conn.GetSnapshotBlockPublicAccessState(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.State == "" {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return string(output.State), nil
+}
+
 func FindVerifiedAccessEndpoint(ctx context.Context, conn *ec2_sdkv2.Client, input *ec2_sdkv2.DescribeVerifiedAccessEndpointsInput) (*awstypes.VerifiedAccessEndpoint, error) {
 	output, err := FindVerifiedAccessEndpoints(ctx, conn, input)
 
@@ -516,6 +516,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  ResourceEBSSnapshotBlockPublicAccess,
+			TypeName: "aws_ebs_snapshot_block_public_access",
+			Name:     "EBS Snapshot Block Public Access",
+		},
 		{
 			Factory:  ResourceEBSSnapshotCopy,
 			TypeName: "aws_ebs_snapshot_copy",
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var (
+	// accountSettingValidValues are the values accepted for each account setting.
+	accountSettingValidValues = map[string][]string{
+		accountSettingNameBasicScanTypeVersion: {"AWS_NATIVE", "CLAIR"},
+		accountSettingNameRegistryPolicyScope:  {"V1", "V2"},
+	}
+
+	// accountSettingDefaultValues are the values restored when an account setting is destroyed.
+	accountSettingDefaultValues = map[string]string{
+		accountSettingNameBasicScanTypeVersion: "AWS_NATIVE",
+		accountSettingNameRegistryPolicyScope:  "V2",
+	}
+)
+
+// @SDKResource("aws_ecr_account_setting", name="Account Setting")
+func resourceAccountSetting() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccountSettingPut,
+		ReadWithoutTimeout:   resourceAccountSettingRead,
+		UpdateWithoutTimeout: resourceAccountSettingPut,
+		DeleteWithoutTimeout: resourceAccountSettingDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(accountSettingName_Values(), false),
+			},
+			names.AttrValue: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+
+		CustomizeDiff: resourceAccountSettingCustomizeDiff,
+	}
+}
+
+func resourceAccountSettingPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &ecr.PutAccountSettingInput{
+		Name:  aws.String(name),
+		Value: aws.String(d.Get(names.AttrValue).(string)),
+	}
+
+	_, err := conn.PutAccountSetting(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting ECR Account Setting (%s): %s", name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(name)
+	}
+
+	return append(diags, resourceAccountSettingRead(ctx, d, meta)...)
+}
+
+func resourceAccountSettingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRClient(ctx)
+
+	output, err := findAccountSettingByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ECR Account Setting (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECR Account Setting (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrName, output.Name)
+	d.Set(names.AttrValue, output.Value)
+
+	return diags
+}
+
+func resourceAccountSettingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECRClient(ctx)
+
+	// Account settings can't be deleted, so restore the default value.
+	value, ok := accountSettingDefaultValues[d.Id()]
+	if !ok {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Resetting ECR Account Setting (%s) to default: %s", d.Id(), value)
+	_, err := conn.PutAccountSetting(ctx, &ecr.PutAccountSettingInput{
+		Name:  aws.String(d.Id()),
+		Value: aws.String(value),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "resetting ECR Account Setting (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceAccountSettingCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	name, value := d.Get(names.AttrName).(string), d.Get(names.AttrValue).(string)
+
+	if name == "" || value == "" {
+		return nil
+	}
+
+	if values, ok := accountSettingValidValues[name]; ok && !slices.Contains(values, value) {
+		return fmt.Errorf("%q is not a valid value for ECR Account Setting %s, expected one of %q", value, name, values)
+	}
+
+	return nil
+}
+
+func findAccountSettingByName(ctx context.Context, conn *ecr.Client, name string) (*ecr.GetAccountSettingOutput, error) {
+	input := &ecr.GetAccountSettingInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetAccountSetting(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Value == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
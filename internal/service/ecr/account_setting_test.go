@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfecr "github.com/hashicorp/terraform-provider-aws/internal/service/ecr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECRAccountSetting_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		"basicScanTypeVersion": testAccAccountSetting_basicScanTypeVersion,
+		"registryPolicyScope":  testAccAccountSetting_registryPolicyScope,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccAccountSetting_basicScanTypeVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ecr_account_setting.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountSettingDefault(ctx, "BASIC_SCAN_TYPE_VERSION", "AWS_NATIVE"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSettingConfig_basic("BASIC_SCAN_TYPE_VERSION", "CLAIR"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSettingValue(ctx, resourceName, "CLAIR"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, "BASIC_SCAN_TYPE_VERSION"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrValue, "CLAIR"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccountSettingConfig_basic("BASIC_SCAN_TYPE_VERSION", "AWS_NATIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSettingValue(ctx, resourceName, "AWS_NATIVE"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrValue, "AWS_NATIVE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountSetting_registryPolicyScope(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ecr_account_setting.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountSettingDefault(ctx, "REGISTRY_POLICY_SCOPE", "V2"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAccountSettingConfig_basic("REGISTRY_POLICY_SCOPE", "CLAIR"),
+				ExpectError: regexache.MustCompile(`is not a valid value for ECR Account Setting REGISTRY_POLICY_SCOPE`),
+			},
+			{
+				Config: testAccAccountSettingConfig_basic("REGISTRY_POLICY_SCOPE", "V1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSettingValue(ctx, resourceName, "V1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, "REGISTRY_POLICY_SCOPE"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrValue, "V1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccountSettingValue(ctx context.Context, n, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRClient(ctx)
+
+		output, err := tfecr.FindAccountSettingByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(output.Value); got != value {
+			return fmt.Errorf("ECR Account Setting %s value = %s, want %s", rs.Primary.ID, got, value)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckAccountSettingDefault verifies that destroying the resource restored the default value.
+func testAccCheckAccountSettingDefault(ctx context.Context, name, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRClient(ctx)
+
+		output, err := tfecr.FindAccountSettingByName(ctx, conn, name)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(output.Value); got != value {
+			return fmt.Errorf("ECR Account Setting %s value = %s after destroy, want %s", name, got, value)
+		}
+
+		return nil
+	}
+}
+
+func testAccAccountSettingConfig_basic(name, value string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_account_setting" "test" {
+  name  = %[1]q
+  value = %[2]q
+}
+`, name, value)
+}
@@ -10,3 +10,15 @@ import (
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	accountSettingNameBasicScanTypeVersion = "BASIC_SCAN_TYPE_VERSION"
+	accountSettingNameRegistryPolicyScope  = "REGISTRY_POLICY_SCOPE"
+)
+
+func accountSettingName_Values() []string {
+	return []string{
+		accountSettingNameBasicScanTypeVersion,
+		accountSettingNameRegistryPolicyScope,
+	}
+}
@@ -13,6 +13,7 @@ var (
 	ResourceRepository                    = resourceRepository
 	ResourceRepositoryPolicy              = resourceRepositoryPolicy
 
+	FindAccountSettingByName                   = findAccountSettingByName
 	FindLifecyclePolicyByRepositoryName        = findLifecyclePolicyByRepositoryName
 	FindPullThroughCacheRuleByRepositoryPrefix = findPullThroughCacheRuleByRepositoryPrefix
 	FindRegistryPolicy                         = findRegistryPolicy
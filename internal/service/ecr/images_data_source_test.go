@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECRImagesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	registry, repo := "137112412989", "amazonlinux"
+	dataSourceName := "data.aws_ecr_images.test"
+	mostRecentDataSourceName := "data.aws_ecr_images.most_recent"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesDataSourceConfig_basic(registry, repo),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "images.#", 2),
+					resource.TestCheckResourceAttrSet(dataSourceName, "images.0.image_digest"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "images.0.image_pushed_at"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "images.0.image_size_in_bytes"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "images.0.image_uri"),
+					resource.TestCheckResourceAttr(dataSourceName, "registry_id", registry),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrRepositoryName, repo),
+					resource.TestCheckResourceAttr(mostRecentDataSourceName, "images.#", "2"),
+					resource.TestCheckResourceAttrPair(mostRecentDataSourceName, "images.0.image_digest", "data.aws_ecr_image.most_recent", "image_digest"),
+				),
+			},
+		},
+	})
+}
+
+func testAccImagesDataSourceConfig_basic(reg, repo string) string {
+	return fmt.Sprintf(`
+data "aws_ecr_images" "test" {
+  registry_id     = %[1]q
+  repository_name = %[2]q
+  tag_status      = "TAGGED"
+}
+
+data "aws_ecr_images" "most_recent" {
+  registry_id       = %[1]q
+  repository_name   = %[2]q
+  most_recent_count = 2
+}
+
+data "aws_ecr_image" "most_recent" {
+  registry_id     = %[1]q
+  repository_name = %[2]q
+  most_recent     = true
+}
+`, reg, repo)
+}
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// notationTrustStoreAWSSigner is the Notation trust store installed by the AWS Signer plugin.
+	notationTrustStoreAWSSigner = "signingAuthority:aws-signer-ts"
+)
+
+// @FrameworkDataSource(name="Notation Trust Policy Document")
+func newNotationTrustPolicyDocumentDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &notationTrustPolicyDocumentDataSource{}, nil
+}
+
+type notationTrustPolicyDocumentDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *notationTrustPolicyDocumentDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_ecr_notation_trust_policy_document"
+}
+
+func (d *notationTrustPolicyDocumentDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrJSON: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"trust_policy": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[notationTrustPolicyDocumentTrustPolicy](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"registry_scopes": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"signature_verification_level": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("strict", "permissive", "audit", "skip"),
+							},
+						},
+						"trust_stores": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"trusted_identities": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *notationTrustPolicyDocumentDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data notationTrustPolicyDocumentDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	trustPolicies, diags := data.TrustPolicies.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	document := &notationTrustPolicyDocument{
+		Version: "1.0",
+	}
+
+	for _, v := range trustPolicies {
+		trustPolicy := &notationTrustPolicy{
+			Name:           v.Name.ValueString(),
+			RegistryScopes: fwflex.ExpandFrameworkStringValueList(ctx, v.RegistryScopes),
+			SignatureVerification: notationSignatureVerification{
+				Level: v.SignatureVerificationLevel.ValueString(),
+			},
+			TrustStores:       fwflex.ExpandFrameworkStringValueList(ctx, v.TrustStores),
+			TrustedIdentities: fwflex.ExpandFrameworkStringValueList(ctx, v.TrustedIdentities),
+		}
+
+		// Default values.
+		if trustPolicy.SignatureVerification.Level == "" {
+			trustPolicy.SignatureVerification.Level = "strict"
+		}
+
+		// Signatures aren't verified at the skip level, so no trust stores or identities are allowed.
+		if trustPolicy.SignatureVerification.Level == "skip" {
+			if len(trustPolicy.TrustStores) > 0 || len(trustPolicy.TrustedIdentities) > 0 {
+				response.Diagnostics.AddError("Invalid trust policy", "trust_stores and trusted_identities must not be set when signature_verification_level is skip")
+				return
+			}
+		} else {
+			if len(trustPolicy.TrustStores) == 0 {
+				trustPolicy.TrustStores = []string{notationTrustStoreAWSSigner}
+			}
+
+			if len(trustPolicy.TrustedIdentities) == 0 {
+				response.Diagnostics.AddError("Invalid trust policy", "trusted_identities must be set unless signature_verification_level is skip")
+				return
+			}
+		}
+
+		document.TrustPolicies = append(document.TrustPolicies, trustPolicy)
+	}
+
+	bytes, err := json.MarshalIndent(document, "", "  ")
+
+	if err != nil {
+		response.Diagnostics.AddError("Marshalling Notation trust policy to JSON", err.Error())
+	}
+
+	data.JSON = types.StringValue(string(bytes))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type notationTrustPolicyDocumentDataSourceModel struct {
+	JSON          types.String                                                            `tfsdk:"json"`
+	TrustPolicies fwtypes.ListNestedObjectValueOf[notationTrustPolicyDocumentTrustPolicy] `tfsdk:"trust_policy"`
+}
+
+type notationTrustPolicyDocumentTrustPolicy struct {
+	Name                       types.String                      `tfsdk:"name"`
+	RegistryScopes             fwtypes.ListValueOf[types.String] `tfsdk:"registry_scopes"`
+	SignatureVerificationLevel types.String                      `tfsdk:"signature_verification_level"`
+	TrustStores                fwtypes.ListValueOf[types.String] `tfsdk:"trust_stores"`
+	TrustedIdentities          fwtypes.ListValueOf[types.String] `tfsdk:"trusted_identities"`
+}
+
+type notationTrustPolicyDocument struct {
+	Version       string                 `json:"version"`
+	TrustPolicies []*notationTrustPolicy `json:"trustPolicies"`
+}
+
+type notationTrustPolicy struct {
+	Name                  string                        `json:"name"`
+	RegistryScopes        []string                      `json:"registryScopes"`
+	SignatureVerification notationSignatureVerification `json:"signatureVerification"`
+	TrustStores           []string                      `json:"trustStores,omitempty"`
+	TrustedIdentities     []string                      `json:"trustedIdentities,omitempty"`
+}
+
+type notationSignatureVerification struct {
+	Level string `json:"level"`
+}
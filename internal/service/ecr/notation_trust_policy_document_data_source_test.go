@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECRNotationTrustPolicyDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ecr_notation_trust_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotationTrustPolicyDocumentDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrJMES(dataSourceName, names.AttrJSON, "version", "1.0"),
+					acctest.CheckResourceAttrJMES(dataSourceName, names.AttrJSON, "length(trustPolicies)", "2"),
+					acctest.CheckResourceAttrJMES(dataSourceName, names.AttrJSON, "trustPolicies[0].signatureVerification.level", "strict"),
+					acctest.CheckResourceAttrJMES(dataSourceName, names.AttrJSON, "trustPolicies[0].trustStores[0]", "signingAuthority:aws-signer-ts"),
+					acctest.CheckResourceAttrJMESPair(dataSourceName, names.AttrJSON, "trustPolicies[0].registryScopes[0]", "aws_ecr_repository.test", "repository_url"),
+					acctest.CheckResourceAttrJMESPair(dataSourceName, names.AttrJSON, "trustPolicies[0].trustedIdentities[0]", "aws_signer_signing_profile.test", names.AttrARN),
+					acctest.CheckResourceAttrJMES(dataSourceName, names.AttrJSON, "trustPolicies[1].signatureVerification.level", "skip"),
+					acctest.CheckResourceAttrJMES(dataSourceName, names.AttrJSON, "length(trustPolicies[1].trustStores || `[]`)", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRNotationTrustPolicyDocumentDataSource_missingTrustedIdentities(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNotationTrustPolicyDocumentDataSourceConfig_missingTrustedIdentities,
+				ExpectError: regexache.MustCompile(`trusted_identities must be set`),
+			},
+		},
+	})
+}
+
+func testAccNotationTrustPolicyDocumentDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+}
+
+resource "aws_signer_signing_profile" "test" {
+  platform_id = "Notation-OCI-SHA384-ECDSA"
+  name_prefix = "tf_acc_test"
+}
+
+data "aws_ecr_notation_trust_policy_document" "test" {
+  trust_policy {
+    name               = "signed"
+    registry_scopes    = [aws_ecr_repository.test.repository_url]
+    trusted_identities = [aws_signer_signing_profile.test.arn]
+  }
+
+  trust_policy {
+    name                         = "unsigned"
+    registry_scopes              = ["*"]
+    signature_verification_level = "skip"
+  }
+}
+`, rName)
+}
+
+const testAccNotationTrustPolicyDocumentDataSourceConfig_missingTrustedIdentities = `
+data "aws_ecr_notation_trust_policy_document" "test" {
+  trust_policy {
+    name            = "signed"
+    registry_scopes = ["*"]
+  }
+}
+`
@@ -40,6 +40,19 @@ func resourcePullThroughCacheRule() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"credential_valid": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"credential_validation_failure": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"ecr_repository_prefix": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -60,6 +73,23 @@ func resourcePullThroughCacheRule() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"upstream_repository_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 30),
+					validation.StringMatch(
+						regexache.MustCompile(`^((?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*/?|ROOT)$`),
+						"must only include alphanumeric, underscore, period, hyphen, or slash characters, or be ROOT"),
+				),
+			},
+			"validate_credentials": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -78,6 +108,14 @@ func resourcePullThroughCacheRuleCreate(ctx context.Context, d *schema.ResourceD
 		input.CredentialArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("custom_role_arn"); ok {
+		input.CustomRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("upstream_repository_prefix"); ok {
+		input.UpstreamRepositoryPrefix = aws.String(v.(string))
+	}
+
 	_, err := conn.CreatePullThroughCacheRule(ctx, input)
 
 	if err != nil {
@@ -106,9 +144,29 @@ func resourcePullThroughCacheRuleRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	d.Set("credential_arn", rule.CredentialArn)
+	d.Set("custom_role_arn", rule.CustomRoleArn)
 	d.Set("ecr_repository_prefix", rule.EcrRepositoryPrefix)
 	d.Set("registry_id", rule.RegistryId)
 	d.Set("upstream_registry_url", rule.UpstreamRegistryUrl)
+	d.Set("upstream_repository_prefix", rule.UpstreamRepositoryPrefix)
+
+	// Validate on every read so that credentials rotated or revoked outside of Terraform are detected.
+	if d.Get("validate_credentials").(bool) && aws.ToString(rule.CredentialArn) != "" {
+		output, err := conn.ValidatePullThroughCacheRule(ctx, &ecr.ValidatePullThroughCacheRuleInput{
+			EcrRepositoryPrefix: rule.EcrRepositoryPrefix,
+			RegistryId:          rule.RegistryId,
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "validating ECR Pull Through Cache Rule (%s): %s", d.Id(), err)
+		}
+
+		d.Set("credential_valid", output.IsValid)
+		d.Set("credential_validation_failure", output.Failure)
+	} else {
+		d.Set("credential_valid", nil)
+		d.Set("credential_validation_failure", nil)
+	}
 
 	return diags
 }
@@ -117,19 +175,26 @@ func resourcePullThroughCacheRuleUpdate(ctx context.Context, d *schema.ResourceD
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECRClient(ctx)
 
-	repositoryPrefix := d.Get("ecr_repository_prefix").(string)
-	input := &ecr.UpdatePullThroughCacheRuleInput{
-		CredentialArn:       aws.String(d.Get("credential_arn").(string)),
-		EcrRepositoryPrefix: aws.String(repositoryPrefix),
-	}
+	if d.HasChanges("credential_arn", "custom_role_arn") {
+		repositoryPrefix := d.Get("ecr_repository_prefix").(string)
+		input := &ecr.UpdatePullThroughCacheRuleInput{
+			EcrRepositoryPrefix: aws.String(repositoryPrefix),
+		}
 
-	_, err := conn.UpdatePullThroughCacheRule(ctx, input)
+		if v, ok := d.GetOk("credential_arn"); ok {
+			input.CredentialArn = aws.String(v.(string))
+		}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating ECR Pull Through Cache Rule (%s): %s", repositoryPrefix, err)
-	}
+		if v, ok := d.GetOk("custom_role_arn"); ok {
+			input.CustomRoleArn = aws.String(v.(string))
+		}
 
-	d.SetId(repositoryPrefix)
+		_, err := conn.UpdatePullThroughCacheRule(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating ECR Pull Through Cache Rule (%s): %s", repositoryPrefix, err)
+		}
+	}
 
 	return append(diags, resourcePullThroughCacheRuleRead(ctx, d, meta)...)
 }
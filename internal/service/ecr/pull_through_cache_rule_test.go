@@ -11,6 +11,7 @@ import (
 	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -79,6 +80,94 @@ func TestAccECRPullThroughCacheRule_credentialARN(t *testing.T) {
 	})
 }
 
+func TestAccECRPullThroughCacheRule_credentialRotation(t *testing.T) {
+	ctx := acctest.Context(t)
+	repositoryPrefix := "tf-test-" + sdkacctest.RandString(8)
+	resourceName := "aws_ecr_pull_through_cache_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPullThroughCacheRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPullThroughCacheRuleConfig_credentialRotation(repositoryPrefix, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPullThroughCacheRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "credential_arn", "aws_secretsmanager_secret.test.0", names.AttrARN),
+					// The secrets don't hold valid Docker Hub credentials.
+					resource.TestCheckResourceAttr(resourceName, "credential_valid", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "credential_validation_failure"),
+					resource.TestCheckResourceAttr(resourceName, "validate_credentials", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credential_valid", "credential_validation_failure", "validate_credentials"},
+			},
+			{
+				Config: testAccPullThroughCacheRuleConfig_credentialRotation(repositoryPrefix, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPullThroughCacheRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "credential_arn", "aws_secretsmanager_secret.test.1", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "credential_valid", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRPullThroughCacheRule_customRoleARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	repositoryPrefix := "tf-test-" + sdkacctest.RandString(8)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecr_pull_through_cache_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPullThroughCacheRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPullThroughCacheRuleConfig_customRoleARN(rName, repositoryPrefix, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPullThroughCacheRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "custom_role_arn", "aws_iam_role.test.0", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "ecr_repository_prefix", repositoryPrefix),
+					resource.TestCheckResourceAttr(resourceName, "upstream_repository_prefix", "upstream"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPullThroughCacheRuleConfig_customRoleARN(rName, repositoryPrefix, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPullThroughCacheRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "custom_role_arn", "aws_iam_role.test.1", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "upstream_repository_prefix", "upstream"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccECRPullThroughCacheRule_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	repositoryPrefix := "tf-test-" + sdkacctest.RandString(8)
@@ -222,6 +311,89 @@ resource "aws_ecr_pull_through_cache_rule" "test" {
 `, repositoryPrefix)
 }
 
+func testAccPullThroughCacheRuleConfig_credentialRotation(repositoryPrefix string, secretIndex int) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  count = 2
+
+  name                    = "ecr-pullthroughcache/%[1]s-${count.index}"
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  count = 2
+
+  secret_id     = aws_secretsmanager_secret.test[count.index].id
+  secret_string = jsonencode({ username = "test", accessToken = "test" })
+}
+
+resource "aws_ecr_pull_through_cache_rule" "test" {
+  ecr_repository_prefix = %[1]q
+  upstream_registry_url = "registry-1.docker.io"
+  credential_arn        = aws_secretsmanager_secret.test[%[2]d].arn
+  validate_credentials  = true
+
+  depends_on = [aws_secretsmanager_secret_version.test]
+}
+`, repositoryPrefix, secretIndex)
+}
+
+func testAccPullThroughCacheRuleConfig_customRoleARN(rName, repositoryPrefix string, roleIndex int) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = ["sts:AssumeRole", "sts:TagSession"]
+      Effect = "Allow"
+      Principal = {
+        Service = "pullthroughcache.ecr.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  count = 2
+
+  name = %[1]q
+  role = aws_iam_role.test[count.index].id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "ecr:BatchGetImage",
+        "ecr:GetAuthorizationToken",
+        "ecr:GetDownloadUrlForLayer",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_ecr_pull_through_cache_rule" "test" {
+  ecr_repository_prefix      = %[2]q
+  upstream_registry_url      = "${data.aws_caller_identity.current.account_id}.dkr.ecr.${data.aws_region.current.name}.${data.aws_partition.current.dns_suffix}"
+  upstream_repository_prefix = "upstream"
+  custom_role_arn            = aws_iam_role.test[%[3]d].arn
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, repositoryPrefix, roleIndex)
+}
+
 func testAccPullThroughCacheRuleConfig_failWhenAlreadyExist(repositoryPrefix string) string {
 	return fmt.Sprintf(`
 resource "aws_ecr_pull_through_cache_rule" "test" {
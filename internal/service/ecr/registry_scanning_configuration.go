@@ -5,6 +5,7 @@ package ecr
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/YakDriver/regexache"
@@ -42,12 +43,13 @@ func resourceRegistryScanningConfiguration() *schema.Resource {
 				Type:     schema.TypeSet,
 				Optional: true,
 				MinItems: 0,
-				MaxItems: 100,
+				MaxItems: 2,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"repository_filter": {
 							Type:     schema.TypeSet,
 							MinItems: 1,
+							MaxItems: 100,
 							Required: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -56,7 +58,7 @@ func resourceRegistryScanningConfiguration() *schema.Resource {
 										Required: true,
 										ValidateFunc: validation.All(
 											validation.StringLenBetween(1, 256),
-											validation.StringMatch(regexache.MustCompile(`^[0-9a-z*](?:[0-9a-z_./*-]?[0-9a-z*]+)*$`), "must contain only lowercase alphanumeric, dot, underscore, hyphen, wildcard, and colon characters"),
+											validation.StringMatch(regexache.MustCompile(`^[0-9a-z*](?:[0-9a-z_./*-]?[0-9a-z*]+)*$`), "must contain only lowercase alphanumeric, dot, underscore, hyphen, slash, and wildcard (*) characters, and must not start or end with a separator"),
 										),
 									},
 									"filter_type": {
@@ -81,6 +83,8 @@ func resourceRegistryScanningConfiguration() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[types.ScanType](),
 			},
 		},
+
+		CustomizeDiff: resourceRegistryScanningConfigurationCustomizeDiff,
 	}
 }
 
@@ -148,6 +152,66 @@ func resourceRegistryScanningConfigurationDelete(ctx context.Context, d *schema.
 	return diags
 }
 
+func resourceRegistryScanningConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	scanType := types.ScanType(d.Get("scan_type").(string))
+	frequencies := make(map[types.ScanFrequency]bool)
+	filters := make(map[string]types.ScanFrequency)
+
+	for _, tfMapRaw := range d.Get(names.AttrRule).(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		frequency := types.ScanFrequency(tfMap["scan_frequency"].(string))
+		if frequency == "" {
+			continue
+		}
+
+		if frequencies[frequency] {
+			return fmt.Errorf("only one rule with scan_frequency %s is allowed", frequency)
+		}
+		frequencies[frequency] = true
+
+		switch scanType {
+		case types.ScanTypeBasic:
+			if frequency != types.ScanFrequencyManual && frequency != types.ScanFrequencyScanOnPush {
+				return fmt.Errorf("scan_frequency %s is not supported with scan_type %s, only %s and %s are", frequency, scanType, types.ScanFrequencyManual, types.ScanFrequencyScanOnPush)
+			}
+		case types.ScanTypeEnhanced:
+			if frequency != types.ScanFrequencyContinuousScan && frequency != types.ScanFrequencyScanOnPush {
+				return fmt.Errorf("scan_frequency %s is not supported with scan_type %s, only %s and %s are", frequency, scanType, types.ScanFrequencyContinuousScan, types.ScanFrequencyScanOnPush)
+			}
+		}
+
+		v, ok := tfMap["repository_filter"].(*schema.Set)
+		if !ok {
+			continue
+		}
+
+		// A repository that matches both a continuous scan and a scan on push filter is scanned continuously,
+		// so repeating a filter in both rules has no effect.
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			filter := tfMap[names.AttrFilter].(string)
+			if filter == "" {
+				continue
+			}
+
+			if other, ok := filters[filter]; ok {
+				return fmt.Errorf("repository_filter %q is set in both the %s and %s rules", filter, other, frequency)
+			}
+			filters[filter] = frequency
+		}
+	}
+
+	return nil
+}
+
 func findRegistryScanningConfiguration(ctx context.Context, conn *ecr.Client) (*ecr.GetRegistryScanningConfigurationOutput, error) {
 	input := &ecr.GetRegistryScanningConfigurationInput{}
 
@@ -217,7 +281,7 @@ func flattenScanningConfigurationRules(r []types.RegistryScanningRule) interface
 	out := make([]map[string]interface{}, len(r))
 	for i, rule := range r {
 		m := make(map[string]interface{})
-		m["scan_frequency"] = rule.ScanFrequency
+		m["scan_frequency"] = string(rule.ScanFrequency)
 		m["repository_filter"] = flattenScanningConfigurationFilters(rule.RepositoryFilters)
 		out[i] = m
 	}
@@ -233,7 +297,7 @@ func flattenScanningConfigurationFilters(l []types.ScanningRepositoryFilter) []i
 	for i, filter := range l {
 		out[i] = map[string]interface{}{
 			names.AttrFilter: aws.ToString(filter.Filter),
-			"filter_type":    filter.FilterType,
+			"filter_type":    string(filter.FilterType),
 		}
 	}
 
@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -21,8 +23,9 @@ func TestAccECRScanningConfiguration_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]func(t *testing.T){
-		"basic":  testAccRegistryScanningConfiguration_basic,
-		"update": testAccRegistryScanningConfiguration_update,
+		"basic":      testAccRegistryScanningConfiguration_basic,
+		"update":     testAccRegistryScanningConfiguration_update,
+		"validation": testAccRegistryScanningConfiguration_validation,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -111,6 +114,14 @@ func testAccRegistryScanningConfiguration_update(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "scan_type", "ENHANCED"),
 				),
 			},
+			{
+				Config: testAccRegistryScanningConfigurationConfig_twoRulesReordered(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
 			{
 				Config: testAccRegistryScanningConfigurationConfig_basic(),
 				Check: resource.ComposeTestCheckFunc(
@@ -124,6 +135,31 @@ func testAccRegistryScanningConfiguration_update(t *testing.T) {
 	})
 }
 
+func testAccRegistryScanningConfiguration_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRegistryScanningConfigurationConfig_rule("BASIC", "CONTINUOUS_SCAN", "example"),
+				ExpectError: regexache.MustCompile(`scan_frequency CONTINUOUS_SCAN is not supported with scan_type BASIC`),
+			},
+			{
+				Config:      testAccRegistryScanningConfigurationConfig_rule("ENHANCED", "SCAN_ON_PUSH", "-example"),
+				ExpectError: regexache.MustCompile(`must contain only lowercase alphanumeric`),
+			},
+			{
+				Config:      testAccRegistryScanningConfigurationConfig_duplicateFilter(),
+				ExpectError: regexache.MustCompile(`repository_filter "example" is set in both`),
+			},
+		},
+	})
+}
+
 func testAccRegistryScanningConfigurationExists(ctx context.Context, n string, v *ecr.GetRegistryScanningConfigurationOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, ok := s.RootModule().Resources[n]
@@ -189,3 +225,62 @@ resource "aws_ecr_registry_scanning_configuration" "test" {
 }
 `
 }
+
+func testAccRegistryScanningConfigurationConfig_twoRulesReordered() string {
+	return `
+resource "aws_ecr_registry_scanning_configuration" "test" {
+  scan_type = "ENHANCED"
+  rule {
+    scan_frequency = "SCAN_ON_PUSH"
+    repository_filter {
+      filter      = "*"
+      filter_type = "WILDCARD"
+    }
+  }
+  rule {
+    scan_frequency = "CONTINUOUS_SCAN"
+    repository_filter {
+      filter      = "example"
+      filter_type = "WILDCARD"
+    }
+  }
+}
+`
+}
+
+func testAccRegistryScanningConfigurationConfig_rule(scanType, scanFrequency, filter string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_registry_scanning_configuration" "test" {
+  scan_type = %[1]q
+  rule {
+    scan_frequency = %[2]q
+    repository_filter {
+      filter      = %[3]q
+      filter_type = "WILDCARD"
+    }
+  }
+}
+`, scanType, scanFrequency, filter)
+}
+
+func testAccRegistryScanningConfigurationConfig_duplicateFilter() string {
+	return `
+resource "aws_ecr_registry_scanning_configuration" "test" {
+  scan_type = "ENHANCED"
+  rule {
+    scan_frequency = "CONTINUOUS_SCAN"
+    repository_filter {
+      filter      = "example"
+      filter_type = "WILDCARD"
+    }
+  }
+  rule {
+    scan_frequency = "SCAN_ON_PUSH"
+    repository_filter {
+      filter      = "example"
+      filter_type = "WILDCARD"
+    }
+  }
+}
+`
+}
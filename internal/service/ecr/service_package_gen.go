@@ -20,6 +20,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newLifecyclePolicyDocumentDataSource,
 			Name:    "Lifecycle Policy Document",
 		},
+		{
+			Factory: newNotationTrustPolicyDocumentDataSource,
+			Name:    "Notation Trust Policy Document",
+		},
 		{
 			Factory: newRepositoriesDataSource,
 			Name:    "Repositories",
@@ -43,6 +47,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_ecr_image",
 			Name:     "Image",
 		},
+		{
+			Factory:  dataSourceImages,
+			TypeName: "aws_ecr_images",
+			Name:     "Images",
+		},
 		{
 			Factory:  dataSourcePullThroughCacheRule,
 			TypeName: "aws_ecr_pull_through_cache_rule",
@@ -58,6 +67,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceAccountSetting,
+			TypeName: "aws_ecr_account_setting",
+			Name:     "Account Setting",
+		},
 		{
 			Factory:  resourceLifecyclePolicy,
 			TypeName: "aws_ecr_lifecycle_policy",
@@ -6,7 +6,7 @@ package ecs
 import (
 	"context"
 
-	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -81,24 +81,24 @@ func DataSourceCluster() *schema.Resource {
 func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+	conn := meta.(*conns.AWSClient).ECSClient(ctx)
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	clusterName := d.Get(names.AttrClusterName).(string)
-	cluster, err := FindClusterByNameOrARN(ctx, conn, d.Get(names.AttrClusterName).(string))
+	cluster, err := findClusterByNameOrARNV2(ctx, conn, d.Get(names.AttrClusterName).(string))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading ECS Cluster (%s): %s", clusterName, err)
 	}
 
-	d.SetId(aws.StringValue(cluster.ClusterArn))
+	d.SetId(aws.ToString(cluster.ClusterArn))
 	d.Set(names.AttrARN, cluster.ClusterArn)
 	d.Set("pending_tasks_count", cluster.PendingTasksCount)
 	d.Set("running_tasks_count", cluster.RunningTasksCount)
 	d.Set("registered_container_instances_count", cluster.RegisteredContainerInstancesCount)
 	d.Set(names.AttrStatus, cluster.Status)
 
-	tags := KeyValueTags(ctx, cluster.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	tags := keyValueTagsV2(ctx, cluster.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 	if err := d.Set(names.AttrTags, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
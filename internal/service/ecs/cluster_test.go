@@ -265,6 +265,39 @@ func TestAccECSCluster_configuration(t *testing.T) {
 	})
 }
 
+func TestAccECSCluster_managedStorageConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster1 ecs.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_managedStorageConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.execute_command_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.managed_storage_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.managed_storage_configuration.0.fargate_ephemeral_storage_kms_key_id", "aws_kms_key.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.managed_storage_configuration.0.kms_key_id", "aws_kms_key.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckClusterDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn(ctx)
@@ -408,3 +441,90 @@ resource "aws_ecs_cluster" "test" {
 }
 `, rName, enable)
 }
+
+func testAccClusterConfig_managedStorageConfiguration(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Id      = "ECSClusterFargatePolicy"
+    Statement = [
+      {
+        Sid    = "Enable IAM User Permissions"
+        Effect = "Allow"
+        Principal = {
+          AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+        }
+        Action   = "kms:*"
+        Resource = "*"
+      },
+      {
+        Sid    = "Allow generate data key access for Fargate tasks."
+        Effect = "Allow"
+        Principal = {
+          Service = "fargate.amazonaws.com"
+        }
+        Action = [
+          "kms:GenerateDataKeyWithoutPlaintext"
+        ]
+        Condition = {
+          StringEquals = {
+            "kms:EncryptionContext:aws:ecs:clusterAccount" = [
+              data.aws_caller_identity.current.account_id
+            ]
+            "kms:EncryptionContext:aws:ecs:clusterName" = [
+              %[1]q
+            ]
+          }
+        }
+        Resource = "*"
+      },
+      {
+        Sid    = "Allow grant creation permission for Fargate tasks."
+        Effect = "Allow"
+        Principal = {
+          Service = "fargate.amazonaws.com"
+        }
+        Action = [
+          "kms:CreateGrant"
+        ]
+        Condition = {
+          StringEquals = {
+            "kms:EncryptionContext:aws:ecs:clusterAccount" = [
+              data.aws_caller_identity.current.account_id
+            ]
+            "kms:EncryptionContext:aws:ecs:clusterName" = [
+              %[1]q
+            ]
+          }
+          "ForAllValues:StringEquals" = {
+            "kms:GrantOperations" = [
+              "Decrypt"
+            ]
+          }
+        }
+        Resource = "*"
+      }
+    ]
+  })
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+
+  configuration {
+    managed_storage_configuration {
+      fargate_ephemeral_storage_kms_key_id = aws_kms_key.test.id
+      kms_key_id                           = aws_kms_key.test.arn
+    }
+  }
+}
+`, rName)
+}
@@ -57,6 +57,22 @@ func statusCapacityProviderUpdate(ctx context.Context, conn *ecs.ECS, arn string
 	}
 }
 
+func statusCluster(ctx context.Context, conn *ecs.ECS, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, err := FindClusterByNameOrARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return cluster, aws.StringValue(cluster.Status), err
+	}
+}
+
 func statusServiceNoTags(ctx context.Context, conn *ecs.ECS, id, cluster string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		service, err := FindServiceNoTagsByID(ctx, conn, id, cluster)
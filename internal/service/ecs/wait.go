@@ -65,6 +65,24 @@ func waitCapacityProviderUpdated(ctx context.Context, conn *ecs.ECS, arn string)
 	return nil, err
 }
 
+func waitClusterAvailable(ctx context.Context, conn *ecs.ECS, arn string) (*ecs.Cluster, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{clusterStatusProvisioning},
+		Target:  []string{clusterStatusActive},
+		Refresh: statusCluster(ctx, conn, arn),
+		Timeout: clusterAvailableTimeout,
+		Delay:   clusterAvailableDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.(*ecs.Cluster); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
 // waitServiceStable waits for an ECS Service to reach the status "ACTIVE" and have all desired tasks running. Does not return tags.
 func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster string, timeout time.Duration) (*ecs.Service, error) {
 	input := &ecs.DescribeServicesInput{
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_eks_access_policy_associations_exclusive", name="Access Policy Associations Exclusive")
+func resourceAccessPolicyAssociationsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccessPolicyAssociationsExclusivePut,
+		ReadWithoutTimeout:   resourceAccessPolicyAssociationsExclusiveRead,
+		UpdateWithoutTimeout: resourceAccessPolicyAssociationsExclusivePut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrClusterName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validClusterName,
+			},
+			"policy_association": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_scope": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"namespaces": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									names.AttrType: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"policy_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceAccessPolicyAssociationsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName := d.Get(names.AttrClusterName).(string)
+	principalARN := d.Get("principal_arn").(string)
+	id := accessEntryCreateResourceID(clusterName, principalARN)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if err := syncAccessPolicyAssociations(ctx, conn, clusterName, principalARN, d.Get("policy_association").(*schema.Set).List(), timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "synchronizing EKS Access Policy Associations (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return append(diags, resourceAccessPolicyAssociationsExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceAccessPolicyAssociationsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName, principalARN, err := accessEntryParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findAccessPolicyAssociationsByTwoPartKey(ctx, conn, clusterName, principalARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EKS Access Policy Associations Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Access Policy Associations (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrClusterName, clusterName)
+	if err := d.Set("policy_association", flattenAssociatedAccessPolicies(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting policy_association: %s", err)
+	}
+	d.Set("principal_arn", principalARN)
+
+	return diags
+}
+
+// syncAccessPolicyAssociations associates the configured access policies with the principal's access entry,
+// updating the access scope of existing associations, and disassociates all other access policies.
+// Associations are retried for up to timeout while a new access entry propagates.
+func syncAccessPolicyAssociations(ctx context.Context, conn *eks.Client, clusterName, principalARN string, tfList []interface{}, timeout time.Duration) error {
+	output, err := findAccessPolicyAssociationsByTwoPartKey(ctx, conn, clusterName, principalARN)
+
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]*types.AccessScope)
+	for _, v := range output {
+		existing[aws.ToString(v.PolicyArn)] = v.AccessScope
+	}
+
+	configured := make(map[string]bool)
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		policyARN := tfMap["policy_arn"].(string)
+		accessScope := expandAccessScope(tfMap["access_scope"].([]interface{}))
+		configured[policyARN] = true
+
+		if v, ok := existing[policyARN]; ok && accessScopeEqual(v, accessScope) {
+			continue
+		}
+
+		input := &eks.AssociateAccessPolicyInput{
+			AccessScope:  accessScope,
+			ClusterName:  aws.String(clusterName),
+			PolicyArn:    aws.String(policyARN),
+			PrincipalArn: aws.String(principalARN),
+		}
+
+		_, err := tfresource.RetryWhenIsAErrorMessageContains[*types.ResourceNotFoundException](ctx, timeout, func() (interface{}, error) {
+			return conn.AssociateAccessPolicy(ctx, input)
+		}, "The specified principalArn could not be found")
+
+		if err != nil {
+			return fmt.Errorf("associating access policy (%s): %w", policyARN, err)
+		}
+	}
+
+	for policyARN := range existing {
+		if configured[policyARN] {
+			continue
+		}
+
+		_, err := conn.DisassociateAccessPolicy(ctx, &eks.DisassociateAccessPolicyInput{
+			ClusterName:  aws.String(clusterName),
+			PolicyArn:    aws.String(policyARN),
+			PrincipalArn: aws.String(principalARN),
+		})
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("disassociating access policy (%s): %w", policyARN, err)
+		}
+	}
+
+	return nil
+}
+
+func findAccessPolicyAssociationsByTwoPartKey(ctx context.Context, conn *eks.Client, clusterName, principalARN string) ([]types.AssociatedAccessPolicy, error) {
+	input := &eks.ListAssociatedAccessPoliciesInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(principalARN),
+	}
+
+	return findAssociatedAccessPolicies(ctx, conn, input, tfslices.PredicateTrue[*types.AssociatedAccessPolicy]())
+}
+
+func accessScopeEqual(a, b *types.AccessScope) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	if a.Type != b.Type {
+		return false
+	}
+
+	x, y := slices.Clone(a.Namespaces), slices.Clone(b.Namespaces)
+	slices.Sort(x)
+	slices.Sort(y)
+
+	return slices.Equal(x, y)
+}
+
+func flattenAssociatedAccessPolicies(apiObjects []types.AssociatedAccessPolicy) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"access_scope": flattenAccessScope(apiObject.AccessScope),
+			"policy_arn":   aws.ToString(apiObject.PolicyArn),
+		})
+	}
+
+	return tfList
+}
@@ -83,6 +83,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_eks_access_policy_association",
 			Name:     "Access Policy Association",
 		},
+		{
+			Factory:  resourceAccessPolicyAssociationsExclusive,
+			TypeName: "aws_eks_access_policy_associations_exclusive",
+			Name:     "Access Policy Associations Exclusive",
+		},
 		{
 			Factory:  resourceAddon,
 			TypeName: "aws_eks_addon",
@@ -0,0 +1,431 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elastictranscoder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	mediaConvertAudioSelectorName = "Audio Selector 1"
+)
+
+// @SDKDataSource("aws_elastictranscoder_mediaconvert_job_template", name="MediaConvert Job Template")
+func DataSourceMediaConvertJobTemplate() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMediaConvertJobTemplateRead,
+
+		Schema: map[string]*schema.Schema{
+			"pipeline_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"preset_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrRole: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"settings_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMediaConvertJobTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticTranscoderConn(ctx)
+
+	fileGroupSettings := map[string]interface{}{}
+
+	if v, ok := d.GetOk("pipeline_id"); ok {
+		pipelineID := v.(string)
+		output, err := conn.ReadPipelineWithContext(ctx, &elastictranscoder.ReadPipelineInput{
+			Id: aws.String(pipelineID),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Elastic Transcoder Pipeline (%s): %s", pipelineID, err)
+		}
+
+		pipeline := output.Pipeline
+		bucket := aws.StringValue(pipeline.OutputBucket)
+		if bucket == "" && pipeline.ContentConfig != nil {
+			bucket = aws.StringValue(pipeline.ContentConfig.Bucket)
+		}
+
+		if bucket != "" {
+			fileGroupSettings["Destination"] = fmt.Sprintf("s3://%s/", bucket)
+		}
+
+		d.Set(names.AttrRole, pipeline.Role)
+	}
+
+	presetIDs := flex.ExpandStringValueList(d.Get("preset_ids").([]interface{}))
+	outputs := make([]interface{}, 0, len(presetIDs))
+
+	for _, presetID := range presetIDs {
+		output, err := conn.ReadPresetWithContext(ctx, &elastictranscoder.ReadPresetInput{
+			Id: aws.String(presetID),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Elastic Transcoder Preset (%s): %s", presetID, err)
+		}
+
+		mcOutput, err := mediaConvertOutputFromPreset(output.Preset)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "converting Elastic Transcoder Preset (%s): %s", presetID, err)
+		}
+
+		outputs = append(outputs, mcOutput)
+	}
+
+	settings := map[string]interface{}{
+		"Inputs": []interface{}{
+			map[string]interface{}{
+				"AudioSelectors": map[string]interface{}{
+					mediaConvertAudioSelectorName: map[string]interface{}{
+						"DefaultSelection": "DEFAULT",
+					},
+				},
+				"VideoSelector": map[string]interface{}{},
+			},
+		},
+		"OutputGroups": []interface{}{
+			map[string]interface{}{
+				"Name": "File Group",
+				"OutputGroupSettings": map[string]interface{}{
+					"FileGroupSettings": fileGroupSettings,
+					"Type":              "FILE_GROUP_SETTINGS",
+				},
+				"Outputs": outputs,
+			},
+		},
+	}
+
+	b, err := json.Marshal(settings)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "encoding MediaConvert job template settings: %s", err)
+	}
+
+	d.SetId(strings.Join(presetIDs, ","))
+	d.Set("settings_json", string(b))
+
+	return diags
+}
+
+// mediaConvertOutputFromPreset returns the MediaConvert output, in the JSON
+// shape used by CreateJobTemplate, that is the closest match to the specified
+// Elastic Transcoder preset. Thumbnails and watermarks are not converted.
+func mediaConvertOutputFromPreset(preset *elastictranscoder.Preset) (map[string]interface{}, error) {
+	container := aws.StringValue(preset.Container)
+	var mcContainer string
+
+	switch container {
+	case "mp4":
+		mcContainer = "MP4"
+	case "ts":
+		mcContainer = "M2TS"
+	case "mxf":
+		mcContainer = "MXF"
+	case "webm":
+		mcContainer = "WEBM"
+	case "flac", "mp3", "wav":
+		mcContainer = "RAW"
+	default:
+		return nil, fmt.Errorf("container %q has no MediaConvert equivalent", container)
+	}
+
+	output := map[string]interface{}{
+		"ContainerSettings": map[string]interface{}{
+			"Container": mcContainer,
+		},
+		"Extension":    container,
+		"NameModifier": "-" + aws.StringValue(preset.Id),
+	}
+
+	if preset.Video != nil {
+		videoDescription, err := mediaConvertVideoDescriptionFromPreset(preset.Video)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output["VideoDescription"] = videoDescription
+	}
+
+	if preset.Audio != nil && aws.StringValue(preset.Audio.Channels) != "0" {
+		codecSettings, err := mediaConvertAudioCodecSettingsFromPreset(preset.Audio)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output["AudioDescriptions"] = []interface{}{
+			map[string]interface{}{
+				"AudioSourceName": mediaConvertAudioSelectorName,
+				"CodecSettings":   codecSettings,
+			},
+		}
+	}
+
+	return output, nil
+}
+
+func mediaConvertVideoDescriptionFromPreset(video *elastictranscoder.VideoParameters) (map[string]interface{}, error) {
+	codec := aws.StringValue(video.Codec)
+	codecOptions := aws.StringValueMap(video.CodecOptions)
+	settings := map[string]interface{}{}
+
+	var mcCodec, settingsKey string
+
+	switch codec {
+	case "H.264":
+		mcCodec, settingsKey = "H_264", "H264Settings"
+
+		switch v := strings.ToLower(codecOptions["Profile"]); v {
+		case "":
+		case "baseline", "main", "high":
+			settings["CodecProfile"] = strings.ToUpper(v)
+		case "high10":
+			settings["CodecProfile"] = "HIGH_10BIT"
+		case "high422":
+			settings["CodecProfile"] = "HIGH_422"
+		default:
+			return nil, fmt.Errorf("H.264 profile %q has no MediaConvert equivalent", v)
+		}
+
+		if v := codecOptions["Level"]; v != "" && v != "1b" {
+			settings["CodecLevel"] = "LEVEL_" + strings.ReplaceAll(v, ".", "_")
+		}
+	case "mpeg2":
+		mcCodec, settingsKey = "MPEG2", "Mpeg2Settings"
+	case "vp8":
+		mcCodec, settingsKey = "VP8", "Vp8Settings"
+	case "vp9":
+		mcCodec, settingsKey = "VP9", "Vp9Settings"
+	default:
+		return nil, fmt.Errorf("video codec %q has no MediaConvert equivalent", codec)
+	}
+
+	maxBitrate, err := kilobitsToBits(codecOptions["MaxBitRate"])
+
+	if err != nil {
+		return nil, fmt.Errorf("video MaxBitRate: %w", err)
+	}
+
+	if v := aws.StringValue(video.BitRate); v == "auto" {
+		// Only H.264 offers a quality-defined rate control mode.
+		if mcCodec != "H_264" || maxBitrate == 0 {
+			return nil, fmt.Errorf("video bit rate %q requires the H.264 codec and a MaxBitRate codec option", v)
+		}
+
+		settings["RateControlMode"] = "QVBR"
+		settings["MaxBitrate"] = maxBitrate
+	} else {
+		bitrate, err := kilobitsToBits(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("video bit rate: %w", err)
+		}
+
+		settings["RateControlMode"] = "VBR"
+		settings["Bitrate"] = bitrate
+
+		if maxBitrate > 0 {
+			settings["MaxBitrate"] = maxBitrate
+		}
+	}
+
+	switch v := aws.StringValue(video.FrameRate); v {
+	case "", "auto":
+		settings["FramerateControl"] = "INITIALIZE_FROM_SOURCE"
+	default:
+		numerator, denominator, err := frameRateToFraction(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		settings["FramerateControl"] = "SPECIFIED"
+		settings["FramerateNumerator"] = numerator
+		settings["FramerateDenominator"] = denominator
+	}
+
+	if v := aws.StringValue(video.KeyframesMaxDist); v != "" {
+		gopSize, err := strconv.Atoi(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("video keyframes max distance: %w", err)
+		}
+
+		settings["GopSize"] = gopSize
+
+		if mcCodec == "H_264" || mcCodec == "MPEG2" {
+			settings["GopSizeUnits"] = "FRAMES"
+		}
+	}
+
+	videoDescription := map[string]interface{}{
+		"CodecSettings": map[string]interface{}{
+			"Codec":     mcCodec,
+			settingsKey: settings,
+		},
+	}
+
+	width, height := aws.StringValue(video.MaxWidth), aws.StringValue(video.MaxHeight)
+	if v := aws.StringValue(video.Resolution); v != "" && v != "auto" {
+		width, height, _ = strings.Cut(v, "x")
+	}
+
+	if v, err := strconv.Atoi(width); err == nil {
+		videoDescription["Width"] = v
+	}
+	if v, err := strconv.Atoi(height); err == nil {
+		videoDescription["Height"] = v
+	}
+
+	if aws.StringValue(video.SizingPolicy) == "Stretch" {
+		videoDescription["ScalingBehavior"] = "STRETCH_TO_OUTPUT"
+	}
+
+	return videoDescription, nil
+}
+
+func mediaConvertAudioCodecSettingsFromPreset(audio *elastictranscoder.AudioParameters) (map[string]interface{}, error) {
+	codec := aws.StringValue(audio.Codec)
+	settings := map[string]interface{}{}
+
+	bitrate, err := kilobitsToBits(aws.StringValue(audio.BitRate))
+
+	if err != nil {
+		return nil, fmt.Errorf("audio bit rate: %w", err)
+	}
+
+	sampleRate, _ := strconv.Atoi(aws.StringValue(audio.SampleRate))
+	channels, _ := strconv.Atoi(aws.StringValue(audio.Channels))
+
+	var bitDepth int
+	if audio.CodecOptions != nil {
+		bitDepth, _ = strconv.Atoi(aws.StringValue(audio.CodecOptions.BitDepth))
+	}
+
+	var mcCodec, settingsKey string
+
+	switch codec {
+	case "AAC":
+		mcCodec, settingsKey = "AAC", "AacSettings"
+
+		if channels == 1 {
+			settings["CodingMode"] = "CODING_MODE_1_0"
+		} else {
+			settings["CodingMode"] = "CODING_MODE_2_0"
+		}
+
+		if audio.CodecOptions != nil {
+			switch v := aws.StringValue(audio.CodecOptions.Profile); v {
+			case "AAC-LC":
+				settings["CodecProfile"] = "LC"
+			case "HE-AAC":
+				settings["CodecProfile"] = "HEV1"
+			case "HE-AACv2":
+				settings["CodecProfile"] = "HEV2"
+			}
+		}
+	case "flac":
+		mcCodec, settingsKey = "FLAC", "FlacSettings"
+	case "mp2":
+		mcCodec, settingsKey = "MP2", "Mp2Settings"
+	case "mp3":
+		mcCodec, settingsKey = "MP3", "Mp3Settings"
+		settings["RateControlMode"] = "CBR"
+	case "pcm":
+		mcCodec, settingsKey = "WAV", "WavSettings"
+	case "vorbis":
+		mcCodec, settingsKey = "VORBIS", "VorbisSettings"
+	default:
+		return nil, fmt.Errorf("audio codec %q has no MediaConvert equivalent", codec)
+	}
+
+	switch mcCodec {
+	case "AAC", "MP2", "MP3":
+		if bitrate > 0 {
+			settings["Bitrate"] = bitrate
+		}
+	case "FLAC", "WAV":
+		if bitDepth > 0 {
+			settings["BitDepth"] = bitDepth
+		}
+	}
+
+	if mcCodec != "AAC" && channels > 0 {
+		settings["Channels"] = channels
+	}
+
+	if sampleRate > 0 {
+		settings["SampleRate"] = sampleRate
+	}
+
+	return map[string]interface{}{
+		"Codec":     mcCodec,
+		settingsKey: settings,
+	}, nil
+}
+
+// kilobitsToBits converts an Elastic Transcoder bit rate, in kilobits per
+// second, to the bits per second expected by MediaConvert.
+func kilobitsToBits(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	v, err := strconv.Atoi(s)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return v * 1000, nil
+}
+
+func frameRateToFraction(s string) (int, int, error) {
+	switch s {
+	case "23.97":
+		return 24000, 1001, nil
+	case "29.97":
+		return 30000, 1001, nil
+	case "59.94":
+		return 60000, 1001, nil
+	}
+
+	v, err := strconv.Atoi(s)
+
+	if err != nil {
+		return 0, 0, fmt.Errorf("frame rate %q has no MediaConvert equivalent", s)
+	}
+
+	return v, 1, nil
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elastictranscoder_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/google/go-cmp/cmp"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfet "github.com/hashicorp/terraform-provider-aws/internal/service/elastictranscoder"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestMediaConvertOutputFromPreset(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		preset      *elastictranscoder.Preset
+		expected    string
+		expectError bool
+	}{
+		"h264 aac": {
+			preset: &elastictranscoder.Preset{
+				Id:        aws.String("1351620000001-000010"),
+				Container: aws.String("mp4"),
+				Audio: &elastictranscoder.AudioParameters{
+					BitRate:    aws.String("160"),
+					Channels:   aws.String("2"),
+					Codec:      aws.String("AAC"),
+					SampleRate: aws.String("44100"),
+					CodecOptions: &elastictranscoder.AudioCodecOptions{
+						Profile: aws.String("AAC-LC"),
+					},
+				},
+				Video: &elastictranscoder.VideoParameters{
+					BitRate:          aws.String("5400"),
+					Codec:            aws.String("H.264"),
+					FrameRate:        aws.String("29.97"),
+					KeyframesMaxDist: aws.String("90"),
+					MaxHeight:        aws.String("720"),
+					MaxWidth:         aws.String("1280"),
+					SizingPolicy:     aws.String("ShrinkToFit"),
+					CodecOptions: map[string]*string{
+						"Level":   aws.String("3.1"),
+						"Profile": aws.String("main"),
+					},
+				},
+			},
+			expected: `{
+  "AudioDescriptions": [{
+    "AudioSourceName": "Audio Selector 1",
+    "CodecSettings": {
+      "AacSettings": {"Bitrate": 160000, "CodecProfile": "LC", "CodingMode": "CODING_MODE_2_0", "SampleRate": 44100},
+      "Codec": "AAC"
+    }
+  }],
+  "ContainerSettings": {"Container": "MP4"},
+  "Extension": "mp4",
+  "NameModifier": "-1351620000001-000010",
+  "VideoDescription": {
+    "CodecSettings": {
+      "Codec": "H_264",
+      "H264Settings": {
+        "Bitrate": 5400000,
+        "CodecLevel": "LEVEL_3_1",
+        "CodecProfile": "MAIN",
+        "FramerateControl": "SPECIFIED",
+        "FramerateDenominator": 1001,
+        "FramerateNumerator": 30000,
+        "GopSize": 90,
+        "GopSizeUnits": "FRAMES",
+        "RateControlMode": "VBR"
+      }
+    },
+    "Height": 720,
+    "Width": 1280
+  }
+}`,
+		},
+		"audio only": {
+			preset: &elastictranscoder.Preset{
+				Id:        aws.String("1351620000001-300040"),
+				Container: aws.String("mp3"),
+				Audio: &elastictranscoder.AudioParameters{
+					BitRate:    aws.String("128"),
+					Channels:   aws.String("2"),
+					Codec:      aws.String("mp3"),
+					SampleRate: aws.String("44100"),
+				},
+			},
+			expected: `{
+  "AudioDescriptions": [{
+    "AudioSourceName": "Audio Selector 1",
+    "CodecSettings": {
+      "Codec": "MP3",
+      "Mp3Settings": {"Bitrate": 128000, "Channels": 2, "RateControlMode": "CBR", "SampleRate": 44100}
+    }
+  }],
+  "ContainerSettings": {"Container": "RAW"},
+  "Extension": "mp3",
+  "NameModifier": "-1351620000001-300040"
+}`,
+		},
+		"automatic bit rate": {
+			preset: &elastictranscoder.Preset{
+				Id:        aws.String("test"),
+				Container: aws.String("webm"),
+				Video: &elastictranscoder.VideoParameters{
+					BitRate: aws.String("auto"),
+					Codec:   aws.String("vp8"),
+				},
+			},
+			expectError: true,
+		},
+		"unsupported container": {
+			preset: &elastictranscoder.Preset{
+				Id:        aws.String("test"),
+				Container: aws.String("gif"),
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := tfet.MediaConvertOutputFromPreset(testCase.preset)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got, want interface{}
+
+			b, err := json.Marshal(output)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := json.Unmarshal(b, &got); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := json.Unmarshal([]byte(testCase.expected), &want); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("unexpected diff (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccElasticTranscoderMediaConvertJobTemplateDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_elastictranscoder_mediaconvert_job_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticTranscoderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConvertJobTemplateDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrRole, "aws_iam_role.test", names.AttrARN),
+					acctest.CheckResourceAttrJMES(dataSourceName, "settings_json", "OutputGroups[0].OutputGroupSettings.FileGroupSettings.Destination", fmt.Sprintf("s3://%s/", rName)),
+					acctest.CheckResourceAttrJMES(dataSourceName, "settings_json", "OutputGroups[0].Outputs[0].ContainerSettings.Container", "MP4"),
+					acctest.CheckResourceAttrJMES(dataSourceName, "settings_json", "OutputGroups[0].Outputs[0].AudioDescriptions[0].CodecSettings.Codec", "MP3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMediaConvertJobTemplateDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPipelineConfig_basic(rName),
+		testAccPresetConfig_basic(rName),
+		`
+data "aws_elastictranscoder_mediaconvert_job_template" "test" {
+  pipeline_id = aws_elastictranscoder_pipeline.test.id
+  preset_ids  = [aws_elastictranscoder_preset.test.id]
+}
+`)
+}
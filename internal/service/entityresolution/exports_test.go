@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+// Exports for use in tests only.
+var (
+	ResourceIDNamespace      = resourceIDNamespace
+	ResourceMatchingWorkflow = resourceMatchingWorkflow
+	ResourceSchemaMapping    = resourceSchemaMapping
+
+	FindIDNamespaceByName      = findIDNamespaceByName
+	FindMatchingWorkflowByName = findMatchingWorkflowByName
+	FindSchemaMappingByName    = findSchemaMappingByName
+)
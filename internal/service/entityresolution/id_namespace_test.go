@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/entityresolution"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfentityresolution "github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEntityResolutionIDNamespace_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v entityresolution.GetIdNamespaceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_id_namespace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDNamespaceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDNamespaceConfig_basic(rName, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIDNamespaceExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "entityresolution", fmt.Sprintf("idnamespace/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+					resource.TestCheckResourceAttr(resourceName, "input_source_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(resourceName, "input_source_config.0.input_source_arn", "aws_glue_catalog_table.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "input_source_config.0.schema_name", "aws_entityresolution_schema_mapping.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "SOURCE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIDNamespaceConfig_basic(rName, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIDNamespaceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionIDNamespace_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v entityresolution.GetIdNamespaceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_id_namespace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIDNamespaceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIDNamespaceConfig_basic(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIDNamespaceExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfentityresolution.ResourceIDNamespace(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIDNamespaceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_entityresolution_id_namespace" {
+				continue
+			}
+
+			_, err := tfentityresolution.FindIDNamespaceByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Entity Resolution ID Namespace %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIDNamespaceExists(ctx context.Context, n string, v *entityresolution.GetIdNamespaceOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		output, err := tfentityresolution.FindIDNamespaceByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccIDNamespaceConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccMatchingWorkflowConfig_base(rName), fmt.Sprintf(`
+resource "aws_entityresolution_id_namespace" "test" {
+  name        = %[1]q
+  description = %[2]q
+  type        = "SOURCE"
+  role_arn    = aws_iam_role.test.arn
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.name
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, description))
+}
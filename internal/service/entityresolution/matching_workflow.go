@@ -0,0 +1,602 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/entityresolution"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/entityresolution/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_entityresolution_matching_workflow", name="Matching Workflow")
+// @Tags(identifierAttribute="arn")
+func resourceMatchingWorkflow() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMatchingWorkflowCreate,
+		ReadWithoutTimeout:   resourceMatchingWorkflowRead,
+		UpdateWithoutTimeout: resourceMatchingWorkflowUpdate,
+		DeleteWithoutTimeout: resourceMatchingWorkflowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"incremental_run_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"incremental_run_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.IncrementalRunType](),
+						},
+					},
+				},
+			},
+			"input_source_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_normalization": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"input_source_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"schema_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"output_source_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_normalization": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"kms_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"output": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 750,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hashed": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									names.AttrName: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+								},
+							},
+						},
+						"output_s3_path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"resolution_techniques": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"intermediate_source_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"intermediate_s3_path": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+											},
+										},
+									},
+									"provider_service_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"resolution_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ResolutionType](),
+						},
+						"rule_based_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute_matching_model": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.AttributeMatchingModel](),
+									},
+									"rule": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 15,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"matching_keys": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													MaxItems: 15,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"rule_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceMatchingWorkflowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &entityresolution.CreateMatchingWorkflowInput{
+		InputSourceConfig:    expandInputSources(d.Get("input_source_config").([]interface{})),
+		OutputSourceConfig:   expandOutputSources(d.Get("output_source_config").([]interface{})),
+		ResolutionTechniques: expandResolutionTechniques(d.Get("resolution_techniques").([]interface{})),
+		RoleArn:              aws.String(d.Get(names.AttrRoleARN).(string)),
+		Tags:                 getTagsIn(ctx),
+		WorkflowName:         aws.String(name),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("incremental_run_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.IncrementalRunConfig = expandIncrementalRunConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.CreateMatchingWorkflow(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Entity Resolution Matching Workflow (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceMatchingWorkflowRead(ctx, d, meta)...)
+}
+
+func resourceMatchingWorkflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionClient(ctx)
+
+	output, err := findMatchingWorkflowByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Entity Resolution Matching Workflow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Entity Resolution Matching Workflow (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.WorkflowArn)
+	d.Set(names.AttrDescription, output.Description)
+	if output.IncrementalRunConfig != nil {
+		if err := d.Set("incremental_run_config", []interface{}{flattenIncrementalRunConfig(output.IncrementalRunConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting incremental_run_config: %s", err)
+		}
+	} else {
+		d.Set("incremental_run_config", nil)
+	}
+	if err := d.Set("input_source_config", flattenInputSources(output.InputSourceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting input_source_config: %s", err)
+	}
+	d.Set(names.AttrName, output.WorkflowName)
+	if err := d.Set("output_source_config", flattenOutputSources(output.OutputSourceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output_source_config: %s", err)
+	}
+	if output.ResolutionTechniques != nil {
+		if err := d.Set("resolution_techniques", []interface{}{flattenResolutionTechniques(output.ResolutionTechniques)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting resolution_techniques: %s", err)
+		}
+	} else {
+		d.Set("resolution_techniques", nil)
+	}
+	d.Set(names.AttrRoleARN, output.RoleArn)
+
+	return diags
+}
+
+func resourceMatchingWorkflowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &entityresolution.UpdateMatchingWorkflowInput{
+			Description:          aws.String(d.Get(names.AttrDescription).(string)),
+			InputSourceConfig:    expandInputSources(d.Get("input_source_config").([]interface{})),
+			OutputSourceConfig:   expandOutputSources(d.Get("output_source_config").([]interface{})),
+			ResolutionTechniques: expandResolutionTechniques(d.Get("resolution_techniques").([]interface{})),
+			RoleArn:              aws.String(d.Get(names.AttrRoleARN).(string)),
+			WorkflowName:         aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("incremental_run_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.IncrementalRunConfig = expandIncrementalRunConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err := conn.UpdateMatchingWorkflow(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Entity Resolution Matching Workflow (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMatchingWorkflowRead(ctx, d, meta)...)
+}
+
+func resourceMatchingWorkflowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Entity Resolution Matching Workflow: %s", d.Id())
+	_, err := conn.DeleteMatchingWorkflow(ctx, &entityresolution.DeleteMatchingWorkflowInput{
+		WorkflowName: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Entity Resolution Matching Workflow (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findMatchingWorkflowByName(ctx context.Context, conn *entityresolution.Client, name string) (*entityresolution.GetMatchingWorkflowOutput, error) {
+	input := &entityresolution.GetMatchingWorkflowInput{
+		WorkflowName: aws.String(name),
+	}
+
+	output, err := conn.GetMatchingWorkflow(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandIncrementalRunConfig(tfMap map[string]interface{}) *awstypes.IncrementalRunConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.IncrementalRunConfig{}
+
+	if v, ok := tfMap["incremental_run_type"].(string); ok && v != "" {
+		apiObject.IncrementalRunType = awstypes.IncrementalRunType(v)
+	}
+
+	return apiObject
+}
+
+func expandInputSources(tfList []interface{}) []awstypes.InputSource {
+	var apiObjects []awstypes.InputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.InputSource{
+			ApplyNormalization: aws.Bool(tfMap["apply_normalization"].(bool)),
+			InputSourceARN:     aws.String(tfMap["input_source_arn"].(string)),
+			SchemaName:         aws.String(tfMap["schema_name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandOutputSources(tfList []interface{}) []awstypes.OutputSource {
+	var apiObjects []awstypes.OutputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.OutputSource{
+			ApplyNormalization: aws.Bool(tfMap["apply_normalization"].(bool)),
+			Output:             expandOutputAttributes(tfMap["output"].([]interface{})),
+			OutputS3Path:       aws.String(tfMap["output_s3_path"].(string)),
+		}
+
+		if v, ok := tfMap["kms_arn"].(string); ok && v != "" {
+			apiObject.KMSArn = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandOutputAttributes(tfList []interface{}) []awstypes.OutputAttribute {
+	var apiObjects []awstypes.OutputAttribute
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.OutputAttribute{
+			Hashed: aws.Bool(tfMap["hashed"].(bool)),
+			Name:   aws.String(tfMap[names.AttrName].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandResolutionTechniques(tfList []interface{}) *awstypes.ResolutionTechniques {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &awstypes.ResolutionTechniques{
+		ResolutionType: awstypes.ResolutionType(tfMap["resolution_type"].(string)),
+	}
+
+	if v, ok := tfMap["provider_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ProviderProperties = expandProviderProperties(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["rule_based_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RuleBasedProperties = expandRuleBasedProperties(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandProviderProperties(tfMap map[string]interface{}) *awstypes.ProviderProperties {
+	apiObject := &awstypes.ProviderProperties{
+		ProviderServiceArn: aws.String(tfMap["provider_service_arn"].(string)),
+	}
+
+	if v, ok := tfMap["intermediate_source_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.IntermediateSourceConfiguration = &awstypes.IntermediateSourceConfiguration{
+			IntermediateS3Path: aws.String(v[0].(map[string]interface{})["intermediate_s3_path"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandRuleBasedProperties(tfMap map[string]interface{}) *awstypes.RuleBasedProperties {
+	apiObject := &awstypes.RuleBasedProperties{
+		AttributeMatchingModel: awstypes.AttributeMatchingModel(tfMap["attribute_matching_model"].(string)),
+	}
+
+	for _, tfMapRaw := range tfMap["rule"].([]interface{}) {
+		rule, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject.Rules = append(apiObject.Rules, awstypes.Rule{
+			MatchingKeys: flex.ExpandStringValueList(rule["matching_keys"].([]interface{})),
+			RuleName:     aws.String(rule["rule_name"].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func flattenIncrementalRunConfig(apiObject *awstypes.IncrementalRunConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"incremental_run_type": string(apiObject.IncrementalRunType),
+	}
+}
+
+func flattenInputSources(apiObjects []awstypes.InputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"apply_normalization": aws.ToBool(apiObject.ApplyNormalization),
+			"input_source_arn":    aws.ToString(apiObject.InputSourceARN),
+			"schema_name":         aws.ToString(apiObject.SchemaName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenOutputSources(apiObjects []awstypes.OutputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"apply_normalization": aws.ToBool(apiObject.ApplyNormalization),
+			"kms_arn":             aws.ToString(apiObject.KMSArn),
+			"output":              flattenOutputAttributes(apiObject.Output),
+			"output_s3_path":      aws.ToString(apiObject.OutputS3Path),
+		})
+	}
+
+	return tfList
+}
+
+func flattenOutputAttributes(apiObjects []awstypes.OutputAttribute) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"hashed":       aws.ToBool(apiObject.Hashed),
+			names.AttrName: aws.ToString(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
+
+func flattenResolutionTechniques(apiObject *awstypes.ResolutionTechniques) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"resolution_type": string(apiObject.ResolutionType),
+	}
+
+	if v := apiObject.ProviderProperties; v != nil {
+		providerProperties := map[string]interface{}{
+			"provider_service_arn": aws.ToString(v.ProviderServiceArn),
+		}
+
+		if v := v.IntermediateSourceConfiguration; v != nil {
+			providerProperties["intermediate_source_configuration"] = []interface{}{map[string]interface{}{
+				"intermediate_s3_path": aws.ToString(v.IntermediateS3Path),
+			}}
+		}
+
+		tfMap["provider_properties"] = []interface{}{providerProperties}
+	}
+
+	if v := apiObject.RuleBasedProperties; v != nil {
+		var rules []interface{}
+
+		for _, rule := range v.Rules {
+			rules = append(rules, map[string]interface{}{
+				"matching_keys": rule.MatchingKeys,
+				"rule_name":     aws.ToString(rule.RuleName),
+			})
+		}
+
+		tfMap["rule_based_properties"] = []interface{}{map[string]interface{}{
+			"attribute_matching_model": string(v.AttributeMatchingModel),
+			"rule":                     rules,
+		}}
+	}
+
+	return tfMap
+}
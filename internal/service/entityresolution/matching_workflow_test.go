@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/entityresolution"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfentityresolution "github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEntityResolutionMatchingWorkflow_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v entityresolution.GetMatchingWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_matching_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchingWorkflowConfig_basic(rName, "ONE_TO_ONE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "entityresolution", fmt.Sprintf("matchingworkflow/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "incremental_run_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "input_source_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(resourceName, "input_source_config.0.input_source_arn", "aws_glue_catalog_table.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "input_source_config.0.schema_name", "aws_entityresolution_schema_mapping.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "output_source_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "output_source_config.0.output.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.resolution_type", "RULE_MATCHING"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.attribute_matching_model", "ONE_TO_ONE"),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.rule.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMatchingWorkflowConfig_basic(rName, "MANY_TO_MANY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "resolution_techniques.0.rule_based_properties.0.attribute_matching_model", "MANY_TO_MANY"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionMatchingWorkflow_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v entityresolution.GetMatchingWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_matching_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchingWorkflowConfig_basic(rName, "ONE_TO_ONE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfentityresolution.ResourceMatchingWorkflow(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionMatchingWorkflow_incrementalRunConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v entityresolution.GetMatchingWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_matching_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMatchingWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMatchingWorkflowConfig_incrementalRunConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "incremental_run_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "incremental_run_config.0.incremental_run_type", "IMMEDIATE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMatchingWorkflowConfig_basic(rName, "ONE_TO_ONE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMatchingWorkflowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "incremental_run_config.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMatchingWorkflowDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_entityresolution_matching_workflow" {
+				continue
+			}
+
+			_, err := tfentityresolution.FindMatchingWorkflowByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Entity Resolution Matching Workflow %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMatchingWorkflowExists(ctx context.Context, n string, v *entityresolution.GetMatchingWorkflowOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		output, err := tfentityresolution.FindMatchingWorkflowByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccMatchingWorkflowConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccSchemaMappingConfig_basic(rName, "test"), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = replace(%[1]q, "-", "_")
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = replace(%[1]q, "-", "_")
+  database_name = aws_glue_catalog_database.test.name
+
+  storage_descriptor {
+    location = "s3://${aws_s3_bucket.test.bucket}/input/"
+
+    columns {
+      name = "id"
+      type = "string"
+    }
+
+    columns {
+      name = "name"
+      type = "string"
+    }
+
+    columns {
+      name = "email"
+      type = "string"
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "entityresolution.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "glue:GetDatabase",
+        "glue:GetTable",
+        "glue:GetPartition",
+        "glue:GetPartitions",
+        "glue:GetSchema",
+        "glue:GetSchemaVersion",
+        "glue:BatchGetPartition",
+        "s3:GetObject",
+        "s3:ListBucket",
+        "s3:PutObject",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccMatchingWorkflowConfig_basic(rName, attributeMatchingModel string) string {
+	return acctest.ConfigCompose(testAccMatchingWorkflowConfig_base(rName), fmt.Sprintf(`
+resource "aws_entityresolution_matching_workflow" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.name
+  }
+
+  output_source_config {
+    output_s3_path = "s3://${aws_s3_bucket.test.bucket}/output/"
+
+    output {
+      name = "id"
+    }
+
+    output {
+      name = "email"
+    }
+  }
+
+  resolution_techniques {
+    resolution_type = "RULE_MATCHING"
+
+    rule_based_properties {
+      attribute_matching_model = %[2]q
+
+      rule {
+        rule_name     = "rule1"
+        matching_keys = ["email"]
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, attributeMatchingModel))
+}
+
+func testAccMatchingWorkflowConfig_incrementalRunConfig(rName string) string {
+	return acctest.ConfigCompose(testAccMatchingWorkflowConfig_base(rName), fmt.Sprintf(`
+resource "aws_entityresolution_matching_workflow" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  incremental_run_config {
+    incremental_run_type = "IMMEDIATE"
+  }
+
+  input_source_config {
+    input_source_arn = aws_glue_catalog_table.test.arn
+    schema_name      = aws_entityresolution_schema_mapping.test.name
+  }
+
+  output_source_config {
+    output_s3_path = "s3://${aws_s3_bucket.test.bucket}/output/"
+
+    output {
+      name = "id"
+    }
+
+    output {
+      name = "email"
+    }
+  }
+
+  resolution_techniques {
+    resolution_type = "RULE_MATCHING"
+
+    rule_based_properties {
+      attribute_matching_model = "ONE_TO_ONE"
+
+      rule {
+        rule_name     = "rule1"
+        matching_keys = ["email"]
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
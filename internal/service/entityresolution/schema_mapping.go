@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/entityresolution"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/entityresolution/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_entityresolution_schema_mapping", name="Schema Mapping")
+// @Tags(identifierAttribute="arn")
+func resourceSchemaMapping() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSchemaMappingCreate,
+		ReadWithoutTimeout:   resourceSchemaMappingRead,
+		UpdateWithoutTimeout: resourceSchemaMappingUpdate,
+		DeleteWithoutTimeout: resourceSchemaMappingDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"has_workflows": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"mapped_input_field": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 2,
+				MaxItems: 35,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"group_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"match_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"sub_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.SchemaAttributeType](),
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceSchemaMappingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &entityresolution.CreateSchemaMappingInput{
+		MappedInputFields: expandSchemaInputAttributes(d.Get("mapped_input_field").([]interface{})),
+		SchemaName:        aws.String(name),
+		Tags:              getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateSchemaMapping(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Entity Resolution Schema Mapping (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceSchemaMappingRead(ctx, d, meta)...)
+}
+
+func resourceSchemaMappingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionClient(ctx)
+
+	output, err := findSchemaMappingByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Entity Resolution Schema Mapping (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Entity Resolution Schema Mapping (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.SchemaArn)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("has_workflows", output.HasWorkflows)
+	if err := d.Set("mapped_input_field", flattenSchemaInputAttributes(output.MappedInputFields)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting mapped_input_field: %s", err)
+	}
+	d.Set(names.AttrName, output.SchemaName)
+
+	return diags
+}
+
+func resourceSchemaMappingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &entityresolution.UpdateSchemaMappingInput{
+			Description:       aws.String(d.Get(names.AttrDescription).(string)),
+			MappedInputFields: expandSchemaInputAttributes(d.Get("mapped_input_field").([]interface{})),
+			SchemaName:        aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateSchemaMapping(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Entity Resolution Schema Mapping (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceSchemaMappingRead(ctx, d, meta)...)
+}
+
+func resourceSchemaMappingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EntityResolutionClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Entity Resolution Schema Mapping: %s", d.Id())
+	_, err := conn.DeleteSchemaMapping(ctx, &entityresolution.DeleteSchemaMappingInput{
+		SchemaName: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Entity Resolution Schema Mapping (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findSchemaMappingByName(ctx context.Context, conn *entityresolution.Client, name string) (*entityresolution.GetSchemaMappingOutput, error) {
+	input := &entityresolution.GetSchemaMappingInput{
+		SchemaName: aws.String(name),
+	}
+
+	output, err := conn.GetSchemaMapping(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandSchemaInputAttributes(tfList []interface{}) []awstypes.SchemaInputAttribute {
+	var apiObjects []awstypes.SchemaInputAttribute
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.SchemaInputAttribute{
+			FieldName: aws.String(tfMap["field_name"].(string)),
+			Type:      awstypes.SchemaAttributeType(tfMap[names.AttrType].(string)),
+		}
+
+		if v, ok := tfMap["group_name"].(string); ok && v != "" {
+			apiObject.GroupName = aws.String(v)
+		}
+
+		if v, ok := tfMap["match_key"].(string); ok && v != "" {
+			apiObject.MatchKey = aws.String(v)
+		}
+
+		if v, ok := tfMap["sub_type"].(string); ok && v != "" {
+			apiObject.SubType = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenSchemaInputAttributes(apiObjects []awstypes.SchemaInputAttribute) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"field_name":   aws.ToString(apiObject.FieldName),
+			"group_name":   aws.ToString(apiObject.GroupName),
+			"match_key":    aws.ToString(apiObject.MatchKey),
+			"sub_type":     aws.ToString(apiObject.SubType),
+			names.AttrType: string(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
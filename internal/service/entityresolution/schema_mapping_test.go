@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package entityresolution_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/entityresolution"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfentityresolution "github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEntityResolutionSchemaMapping_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v entityresolution.GetSchemaMappingOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_schema_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaMappingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaMappingConfig_basic(rName, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "entityresolution", fmt.Sprintf("schemamapping/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+					resource.TestCheckResourceAttr(resourceName, "has_workflows", "false"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.0.field_name", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.0.type", "UNIQUE_ID"),
+					resource.TestCheckResourceAttr(resourceName, "mapped_input_field.1.match_key", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSchemaMappingConfig_basic(rName, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEntityResolutionSchemaMapping_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v entityresolution.GetSchemaMappingOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_entityresolution_schema_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EntityResolutionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaMappingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaMappingConfig_basic(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaMappingExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfentityresolution.ResourceSchemaMapping(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSchemaMappingDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_entityresolution_schema_mapping" {
+				continue
+			}
+
+			_, err := tfentityresolution.FindSchemaMappingByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Entity Resolution Schema Mapping %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSchemaMappingExists(ctx context.Context, n string, v *entityresolution.GetSchemaMappingOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EntityResolutionClient(ctx)
+
+		output, err := tfentityresolution.FindSchemaMappingByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSchemaMappingConfig_basic(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_entityresolution_schema_mapping" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  mapped_input_field {
+    field_name = "id"
+    type       = "UNIQUE_ID"
+  }
+
+  mapped_input_field {
+    field_name = "name"
+    match_key  = "name"
+    type       = "NAME"
+  }
+
+  mapped_input_field {
+    field_name = "email"
+    match_key  = "email"
+    type       = "EMAIL_ADDRESS"
+  }
+}
+`, rName, description)
+}
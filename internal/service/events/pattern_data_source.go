@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudwatch_event_pattern", name="Event Pattern")
+func dataSourcePattern() *schema.Resource {
+	validNumber := func(v interface{}, k string) (ws []string, es []error) {
+		if _, err := strconv.ParseFloat(v.(string), 64); err != nil {
+			es = append(es, fmt.Errorf("%q (%s) must be a number", k, v))
+		}
+		return
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePatternRead,
+
+		Schema: map[string]*schema.Schema{
+			"detail_type": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"field": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"anything_but": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"anything_but_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"anything_but_suffix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"cidr": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsCIDR,
+							},
+						},
+						"equals_ignore_case": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"exists": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"numeric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"equal": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validNumber,
+									},
+									"greater_than": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validNumber,
+									},
+									"greater_than_or_equal": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validNumber,
+									},
+									"less_than": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validNumber,
+									},
+									"less_than_or_equal": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validNumber,
+									},
+								},
+							},
+						},
+						names.AttrPrefix: {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"suffix": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrValues: {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"wildcard": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			names.AttrJSON: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrSource: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourcePatternRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var tfList []interface{}
+	for _, v := range []struct {
+		key  string
+		name string
+	}{
+		{key: "detail_type", name: "detail-type"},
+		{key: names.AttrSource, name: names.AttrSource},
+	} {
+		if values := d.Get(v.key).([]interface{}); len(values) > 0 {
+			tfList = append(tfList, map[string]interface{}{
+				names.AttrName:   v.name,
+				names.AttrValues: values,
+			})
+		}
+	}
+	tfList = append(tfList, d.Get("field").([]interface{})...)
+
+	if len(tfList) == 0 {
+		return sdkdiag.AppendErrorf(diags, "building EventBridge event pattern: at least one of detail_type, field or source must be specified")
+	}
+
+	pattern, err := expandPattern(tfList)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "building EventBridge event pattern: %s", err)
+	}
+
+	// Keep the numeric operators readable, e.g. ">=" instead of "\u003e=".
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(pattern); err != nil {
+		return sdkdiag.AppendErrorf(diags, "building EventBridge event pattern: %s", err)
+	}
+
+	jsonString := strings.TrimSuffix(buf.String(), "\n")
+
+	if _, errs := validateEventPatternValue()(jsonString, names.AttrJSON); len(errs) > 0 {
+		return sdkdiag.AppendErrorf(diags, "building EventBridge event pattern: %s", errors.Join(errs...))
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	d.Set(names.AttrJSON, jsonString)
+
+	return diags
+}
+
+// expandPattern builds an event pattern from the field blocks.
+// Dot-separated field names denote nested properties, e.g. "detail.state".
+func expandPattern(tfList []interface{}) (map[string]interface{}, error) {
+	pattern := make(map[string]interface{})
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+		conditions, err := expandPatternConditions(tfMap)
+
+		if err != nil {
+			return nil, fmt.Errorf("field (%s): %w", name, err)
+		}
+
+		if len(conditions) == 0 {
+			return nil, fmt.Errorf("field (%s): at least one condition must be specified", name)
+		}
+
+		path := strings.Split(name, ".")
+		parent := pattern
+		for i, v := range path {
+			if i == len(path)-1 {
+				if _, ok := parent[v]; ok {
+					return nil, fmt.Errorf("field (%s): duplicate field", name)
+				}
+
+				parent[v] = conditions
+				break
+			}
+
+			switch child := parent[v].(type) {
+			case nil:
+				m := make(map[string]interface{})
+				parent[v] = m
+				parent = m
+			case map[string]interface{}:
+				parent = child
+			default:
+				return nil, fmt.Errorf("field (%s): conflicts with field (%s)", name, strings.Join(path[:i+1], "."))
+			}
+		}
+	}
+
+	return pattern, nil
+}
+
+func expandPatternConditions(tfMap map[string]interface{}) ([]interface{}, error) {
+	var conditions []interface{}
+
+	for _, v := range tfMap[names.AttrValues].([]interface{}) {
+		conditions = append(conditions, v.(string))
+	}
+
+	// Blocks built from the detail_type and source arguments only contain values.
+
+	if v, ok := tfMap["anything_but"].([]interface{}); ok && len(v) > 0 {
+		conditions = append(conditions, map[string]interface{}{"anything-but": expandPatternStrings(v)})
+	}
+
+	if v, ok := tfMap["anything_but_prefix"].(string); ok && v != "" {
+		conditions = append(conditions, map[string]interface{}{"anything-but": map[string]interface{}{"prefix": v}})
+	}
+
+	if v, ok := tfMap["anything_but_suffix"].(string); ok && v != "" {
+		conditions = append(conditions, map[string]interface{}{"anything-but": map[string]interface{}{"suffix": v}})
+	}
+
+	if v, ok := tfMap["cidr"].([]interface{}); ok {
+		for _, v := range v {
+			conditions = append(conditions, map[string]interface{}{"cidr": v.(string)})
+		}
+	}
+
+	if v, ok := tfMap["equals_ignore_case"].([]interface{}); ok {
+		for _, v := range v {
+			conditions = append(conditions, map[string]interface{}{"equals-ignore-case": v.(string)})
+		}
+	}
+
+	if v, ok := tfMap["exists"].(string); ok && v != "" {
+		conditions = append(conditions, map[string]interface{}{"exists": v == "true"})
+	}
+
+	if v, ok := tfMap["numeric"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("numeric: at least one operator must be specified")
+			}
+
+			numeric, err := expandPatternNumeric(tfMap)
+
+			if err != nil {
+				return nil, fmt.Errorf("numeric: %w", err)
+			}
+
+			conditions = append(conditions, map[string]interface{}{"numeric": numeric})
+		}
+	}
+
+	if v, ok := tfMap[names.AttrPrefix].([]interface{}); ok {
+		for _, v := range v {
+			conditions = append(conditions, map[string]interface{}{"prefix": v.(string)})
+		}
+	}
+
+	if v, ok := tfMap["suffix"].([]interface{}); ok {
+		for _, v := range v {
+			conditions = append(conditions, map[string]interface{}{"suffix": v.(string)})
+		}
+	}
+
+	if v, ok := tfMap["wildcard"].([]interface{}); ok {
+		for _, v := range v {
+			conditions = append(conditions, map[string]interface{}{"wildcard": v.(string)})
+		}
+	}
+
+	return conditions, nil
+}
+
+func expandPatternNumeric(tfMap map[string]interface{}) ([]interface{}, error) {
+	var numeric []interface{}
+
+	if v := tfMap["equal"].(string); v != "" {
+		for _, k := range []string{"greater_than", "greater_than_or_equal", "less_than", "less_than_or_equal"} {
+			if tfMap[k].(string) != "" {
+				return nil, fmt.Errorf(`"equal" conflicts with %q`, k)
+			}
+		}
+
+		return []interface{}{"=", json.Number(v)}, nil
+	}
+
+	if tfMap["greater_than"].(string) != "" && tfMap["greater_than_or_equal"].(string) != "" {
+		return nil, fmt.Errorf(`"greater_than" conflicts with "greater_than_or_equal"`)
+	}
+
+	if tfMap["less_than"].(string) != "" && tfMap["less_than_or_equal"].(string) != "" {
+		return nil, fmt.Errorf(`"less_than" conflicts with "less_than_or_equal"`)
+	}
+
+	for _, bound := range [][2]string{{"greater_than", ">"}, {"greater_than_or_equal", ">="}, {"less_than", "<"}, {"less_than_or_equal", "<="}} {
+		if v := tfMap[bound[0]].(string); v != "" {
+			numeric = append(numeric, bound[1], json.Number(v))
+		}
+	}
+
+	if len(numeric) == 0 {
+		return nil, fmt.Errorf("at least one operator must be specified")
+	}
+
+	return numeric, nil
+}
+
+func expandPatternStrings(tfList []interface{}) []string {
+	apiObjects := make([]string, 0, len(tfList))
+
+	for _, v := range tfList {
+		apiObjects = append(apiObjects, v.(string))
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEventsPatternDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_event_pattern.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatternDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrJSON, `{"detail":{"instance-id":[{"prefix":"i-"}],"state":["running",{"anything-but":["pending","stopping"]}]},"detail-type":["EC2 Instance State-change Notification"],"source":["aws.ec2"]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsPatternDataSource_numeric(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_event_pattern.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatternDataSourceConfig_numeric,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrJSON, `{"detail":{"c-count":[{"numeric":[">",0,"<=",5]}],"d-count":[{"numeric":["=",3.5]}],"x-limit":[{"exists":false}]},"source":["example.orders"]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsPatternDataSource_errors(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPatternDataSourceConfig_noCondition,
+				ExpectError: regexache.MustCompile(`at least one condition must be specified`),
+			},
+			{
+				Config:      testAccPatternDataSourceConfig_conflictingFields,
+				ExpectError: regexache.MustCompile(`conflicts with field \(detail\)`),
+			},
+		},
+	})
+}
+
+const testAccPatternDataSourceConfig_basic = `
+data "aws_cloudwatch_event_pattern" "test" {
+  source      = ["aws.ec2"]
+  detail_type = ["EC2 Instance State-change Notification"]
+
+  field {
+    name         = "detail.state"
+    values       = ["running"]
+    anything_but = ["pending", "stopping"]
+  }
+
+  field {
+    name   = "detail.instance-id"
+    prefix = ["i-"]
+  }
+}
+`
+
+const testAccPatternDataSourceConfig_numeric = `
+data "aws_cloudwatch_event_pattern" "test" {
+  source = ["example.orders"]
+
+  field {
+    name = "detail.c-count"
+
+    numeric {
+      greater_than       = 0
+      less_than_or_equal = 5
+    }
+  }
+
+  field {
+    name = "detail.d-count"
+
+    numeric {
+      equal = 3.5
+    }
+  }
+
+  field {
+    name   = "detail.x-limit"
+    exists = false
+  }
+}
+`
+
+const testAccPatternDataSourceConfig_noCondition = `
+data "aws_cloudwatch_event_pattern" "test" {
+  field {
+    name = "detail.state"
+  }
+}
+`
+
+const testAccPatternDataSourceConfig_conflictingFields = `
+data "aws_cloudwatch_event_pattern" "test" {
+  field {
+    name   = "detail"
+    exists = true
+  }
+
+  field {
+    name   = "detail.state"
+    values = ["running"]
+  }
+}
+`
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_cloudwatch_event_pattern_match", name="Event Pattern Match")
+func dataSourcePatternMatch() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePatternMatchRead,
+
+		Schema: map[string]*schema.Schema{
+			"event": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"event_pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEventPatternValue(),
+			},
+			"result": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePatternMatchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EventsClient(ctx)
+
+	event := d.Get("event").(string)
+	pattern, _ := ruleEventPatternJSONDecoder(d.Get("event_pattern").(string))
+	input := &eventbridge.TestEventPatternInput{
+		Event:        aws.String(event),
+		EventPattern: aws.String(pattern),
+	}
+
+	output, err := conn.TestEventPattern(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "testing EventBridge event pattern: %s", err)
+	}
+
+	if output == nil {
+		return sdkdiag.AppendErrorf(diags, "testing EventBridge event pattern: %s", tfresource.NewEmptyResultError(input))
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(pattern + event)))
+	d.Set("result", output.Result)
+
+	return diags
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEventsPatternMatchDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_event_pattern_match.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatternMatchDataSourceConfig_basic("running"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result", "true"),
+				),
+			},
+			{
+				Config: testAccPatternMatchDataSourceConfig_basic("stopped"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPatternMatchDataSourceConfig_basic(state string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_cloudwatch_event_pattern" "test" {
+  source = ["aws.ec2"]
+
+  field {
+    name   = "detail.state"
+    values = ["running"]
+  }
+}
+
+data "aws_cloudwatch_event_pattern_match" "test" {
+  event_pattern = data.aws_cloudwatch_event_pattern.test.json
+
+  event = jsonencode({
+    id            = "7bf73129-1428-4cd3-a780-95db273d1602"
+    "detail-type" = "EC2 Instance State-change Notification"
+    source        = "aws.ec2"
+    account       = data.aws_caller_identity.current.account_id
+    time          = "2015-11-11T21:29:54Z"
+    region        = data.aws_region.current.name
+    resources     = []
+    detail = {
+      "instance-id" = "i-abcd1111"
+      state         = %[1]q
+    }
+  })
+}
+`, state)
+}
@@ -34,6 +34,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_cloudwatch_event_connection",
 			Name:     "Connection",
 		},
+		{
+			Factory:  dataSourcePattern,
+			TypeName: "aws_cloudwatch_event_pattern",
+			Name:     "Event Pattern",
+		},
+		{
+			Factory:  dataSourcePatternMatch,
+			TypeName: "aws_cloudwatch_event_pattern_match",
+			Name:     "Event Pattern Match",
+		},
 		{
 			Factory:  dataSourceSource,
 			TypeName: "aws_cloudwatch_event_source",
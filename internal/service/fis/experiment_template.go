@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -129,6 +130,98 @@ func ResourceExperimentTemplate() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringLenBetween(0, 512),
 			},
+			"experiment_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_targeting": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.AccountTargeting](),
+						},
+						"empty_target_resolution_mode": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.EmptyTargetResolutionMode](),
+						},
+					},
+				},
+			},
+			"experiment_report_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_sources": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cloudwatch_dashboard": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dashboard_identifier": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"outputs": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_configuration": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrBucketName: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												names.AttrPrefix: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"post_experiment_duration": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^PT([1-9]\d*M|[1-9]\d*H|[1-9]\d*H[1-9]\d*M)$`), "must be an ISO 8601 duration in minutes or hours, e.g. PT10M"),
+						},
+						"pre_experiment_duration": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^PT([1-9]\d*M|[1-9]\d*H|[1-9]\d*H[1-9]\d*M)$`), "must be an ISO 8601 duration in minutes or hours, e.g. PT10M"),
+						},
+					},
+				},
+			},
 			"log_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -289,13 +382,15 @@ func resourceExperimentTemplateCreate(ctx context.Context, d *schema.ResourceDat
 	conn := meta.(*conns.AWSClient).FISClient(ctx)
 
 	input := &fis.CreateExperimentTemplateInput{
-		Actions:          expandExperimentTemplateActions(d.Get(names.AttrAction).(*schema.Set)),
-		ClientToken:      aws.String(id.UniqueId()),
-		Description:      aws.String(d.Get(names.AttrDescription).(string)),
-		LogConfiguration: expandExperimentTemplateLogConfiguration(d.Get("log_configuration").([]interface{})),
-		RoleArn:          aws.String(d.Get(names.AttrRoleARN).(string)),
-		StopConditions:   expandExperimentTemplateStopConditions(d.Get("stop_condition").(*schema.Set)),
-		Tags:             getTagsIn(ctx),
+		Actions:                       expandExperimentTemplateActions(d.Get(names.AttrAction).(*schema.Set)),
+		ClientToken:                   aws.String(id.UniqueId()),
+		Description:                   aws.String(d.Get(names.AttrDescription).(string)),
+		ExperimentOptions:             expandExperimentTemplateExperimentOptions(d.Get("experiment_options").([]interface{})),
+		ExperimentReportConfiguration: expandExperimentTemplateReportConfiguration(d.Get("experiment_report_configuration").([]interface{})),
+		LogConfiguration:              expandExperimentTemplateLogConfiguration(d.Get("log_configuration").([]interface{})),
+		RoleArn:                       aws.String(d.Get(names.AttrRoleARN).(string)),
+		StopConditions:                expandExperimentTemplateStopConditions(d.Get("stop_condition").(*schema.Set)),
+		Tags:                          getTagsIn(ctx),
 	}
 
 	targets, err := expandExperimentTemplateTargets(d.Get(names.AttrTarget).(*schema.Set))
@@ -352,6 +447,14 @@ func resourceExperimentTemplateRead(ctx context.Context, d *schema.ResourceData,
 		return create.AppendDiagSettingError(diags, names.FIS, ResNameExperimentTemplate, d.Id(), names.AttrAction, err)
 	}
 
+	if err := d.Set("experiment_options", flattenExperimentTemplateExperimentOptions(experimentTemplate.ExperimentOptions)); err != nil {
+		return create.AppendDiagSettingError(diags, names.FIS, ResNameExperimentTemplate, d.Id(), "experiment_options", err)
+	}
+
+	if err := d.Set("experiment_report_configuration", flattenExperimentTemplateReportConfiguration(experimentTemplate.ExperimentReportConfiguration)); err != nil {
+		return create.AppendDiagSettingError(diags, names.FIS, ResNameExperimentTemplate, d.Id(), "experiment_report_configuration", err)
+	}
+
 	if err := d.Set("log_configuration", flattenExperimentTemplateLogConfiguration(experimentTemplate.LogConfiguration)); err != nil {
 		return create.AppendDiagSettingError(diags, names.FIS, ResNameExperimentTemplate, d.Id(), "log_configuration", err)
 	}
@@ -387,6 +490,14 @@ func resourceExperimentTemplateUpdate(ctx context.Context, d *schema.ResourceDat
 			input.Description = aws.String(d.Get(names.AttrDescription).(string))
 		}
 
+		if d.HasChange("experiment_options") {
+			input.ExperimentOptions = expandExperimentTemplateExperimentOptionsForUpdate(d.Get("experiment_options").([]interface{}))
+		}
+
+		if d.HasChange("experiment_report_configuration") {
+			input.ExperimentReportConfiguration = expandExperimentTemplateReportConfigurationForUpdate(d.Get("experiment_report_configuration").([]interface{}))
+		}
+
 		if d.HasChange("log_configuration") {
 			config := expandExperimentTemplateLogConfigurationForUpdate(d.Get("log_configuration").([]interface{}))
 			input.LogConfiguration = config
@@ -742,6 +853,130 @@ func expandExperimentTemplateLogConfigurationForUpdate(l []interface{}) *types.U
 	return &config
 }
 
+func expandExperimentTemplateExperimentOptions(l []interface{}) *types.CreateExperimentTemplateExperimentOptionsInput {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	raw := l[0].(map[string]interface{})
+	config := types.CreateExperimentTemplateExperimentOptionsInput{}
+
+	if v, ok := raw["account_targeting"].(string); ok && v != "" {
+		config.AccountTargeting = types.AccountTargeting(v)
+	}
+
+	if v, ok := raw["empty_target_resolution_mode"].(string); ok && v != "" {
+		config.EmptyTargetResolutionMode = types.EmptyTargetResolutionMode(v)
+	}
+
+	return &config
+}
+
+func expandExperimentTemplateExperimentOptionsForUpdate(l []interface{}) *types.UpdateExperimentTemplateExperimentOptionsInput {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	raw := l[0].(map[string]interface{})
+	config := types.UpdateExperimentTemplateExperimentOptionsInput{}
+
+	if v, ok := raw["empty_target_resolution_mode"].(string); ok && v != "" {
+		config.EmptyTargetResolutionMode = types.EmptyTargetResolutionMode(v)
+	}
+
+	return &config
+}
+
+func expandExperimentTemplateReportConfiguration(l []interface{}) *types.CreateExperimentTemplateReportConfigurationInput {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	raw := l[0].(map[string]interface{})
+	config := types.CreateExperimentTemplateReportConfigurationInput{
+		DataSources: expandExperimentTemplateReportConfigurationDataSources(raw["data_sources"].([]interface{})),
+		Outputs:     expandExperimentTemplateReportConfigurationOutputs(raw["outputs"].([]interface{})),
+	}
+
+	if v, ok := raw["post_experiment_duration"].(string); ok && v != "" {
+		config.PostExperimentDuration = aws.String(v)
+	}
+
+	if v, ok := raw["pre_experiment_duration"].(string); ok && v != "" {
+		config.PreExperimentDuration = aws.String(v)
+	}
+
+	return &config
+}
+
+func expandExperimentTemplateReportConfigurationForUpdate(l []interface{}) *types.UpdateExperimentTemplateReportConfigurationInput {
+	if len(l) == 0 || l[0] == nil {
+		// An empty configuration removes the experiment report configuration.
+		return &types.UpdateExperimentTemplateReportConfigurationInput{}
+	}
+
+	raw := l[0].(map[string]interface{})
+	config := types.UpdateExperimentTemplateReportConfigurationInput{
+		DataSources: expandExperimentTemplateReportConfigurationDataSources(raw["data_sources"].([]interface{})),
+		Outputs:     expandExperimentTemplateReportConfigurationOutputs(raw["outputs"].([]interface{})),
+	}
+
+	if v, ok := raw["post_experiment_duration"].(string); ok && v != "" {
+		config.PostExperimentDuration = aws.String(v)
+	}
+
+	if v, ok := raw["pre_experiment_duration"].(string); ok && v != "" {
+		config.PreExperimentDuration = aws.String(v)
+	}
+
+	return &config
+}
+
+func expandExperimentTemplateReportConfigurationDataSources(l []interface{}) *types.ExperimentTemplateReportConfigurationDataSourcesInput {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	raw := l[0].(map[string]interface{})
+	config := types.ExperimentTemplateReportConfigurationDataSourcesInput{}
+
+	if v, ok := raw["cloudwatch_dashboard"].([]interface{}); ok && len(v) > 0 {
+		for _, m := range v {
+			if m == nil {
+				continue
+			}
+
+			config.CloudWatchDashboards = append(config.CloudWatchDashboards, types.ReportConfigurationCloudWatchDashboardInput{
+				DashboardIdentifier: aws.String(m.(map[string]interface{})["dashboard_identifier"].(string)),
+			})
+		}
+	}
+
+	return &config
+}
+
+func expandExperimentTemplateReportConfigurationOutputs(l []interface{}) *types.ExperimentTemplateReportConfigurationOutputsInput {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	raw := l[0].(map[string]interface{})
+	config := types.ExperimentTemplateReportConfigurationOutputsInput{}
+
+	if v, ok := raw["s3_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		raw := v[0].(map[string]interface{})
+		s3Config := types.ReportConfigurationS3OutputInput{
+			BucketName: aws.String(raw[names.AttrBucketName].(string)),
+		}
+		if v, ok := raw[names.AttrPrefix].(string); ok && v != "" {
+			s3Config.Prefix = aws.String(v)
+		}
+		config.S3Configuration = &s3Config
+	}
+
+	return &config
+}
+
 func expandExperimentTemplateActionParameteres(l *schema.Set) map[string]string {
 	if l.Len() == 0 {
 		return nil
@@ -888,6 +1123,71 @@ func flattenExperimentTemplateLogConfiguration(configured *types.ExperimentTempl
 	return dataResources
 }
 
+func flattenExperimentTemplateExperimentOptions(configured *types.ExperimentTemplateExperimentOptions) []map[string]interface{} {
+	if configured == nil {
+		return make([]map[string]interface{}, 0)
+	}
+
+	dataResources := make([]map[string]interface{}, 1)
+	dataResources[0] = make(map[string]interface{})
+	dataResources[0]["account_targeting"] = configured.AccountTargeting
+	dataResources[0]["empty_target_resolution_mode"] = configured.EmptyTargetResolutionMode
+
+	return dataResources
+}
+
+func flattenExperimentTemplateReportConfiguration(configured *types.ExperimentTemplateReportConfiguration) []map[string]interface{} {
+	if configured == nil {
+		return make([]map[string]interface{}, 0)
+	}
+
+	dataResources := make([]map[string]interface{}, 1)
+	dataResources[0] = make(map[string]interface{})
+	dataResources[0]["data_sources"] = flattenExperimentTemplateReportConfigurationDataSources(configured.DataSources)
+	dataResources[0]["outputs"] = flattenExperimentTemplateReportConfigurationOutputs(configured.Outputs)
+	dataResources[0]["post_experiment_duration"] = aws.ToString(configured.PostExperimentDuration)
+	dataResources[0]["pre_experiment_duration"] = aws.ToString(configured.PreExperimentDuration)
+
+	return dataResources
+}
+
+func flattenExperimentTemplateReportConfigurationDataSources(configured *types.ExperimentTemplateReportConfigurationDataSources) []map[string]interface{} {
+	if configured == nil || len(configured.CloudWatchDashboards) == 0 {
+		return make([]map[string]interface{}, 0)
+	}
+
+	dashboards := make([]map[string]interface{}, 0, len(configured.CloudWatchDashboards))
+	for _, v := range configured.CloudWatchDashboards {
+		dashboards = append(dashboards, map[string]interface{}{
+			"dashboard_identifier": aws.ToString(v.DashboardIdentifier),
+		})
+	}
+
+	dataResources := make([]map[string]interface{}, 1)
+	dataResources[0] = make(map[string]interface{})
+	dataResources[0]["cloudwatch_dashboard"] = dashboards
+
+	return dataResources
+}
+
+func flattenExperimentTemplateReportConfigurationOutputs(configured *types.ExperimentTemplateReportConfigurationOutputs) []map[string]interface{} {
+	if configured == nil || configured.S3Configuration == nil {
+		return make([]map[string]interface{}, 0)
+	}
+
+	s3Config := make(map[string]interface{})
+	s3Config[names.AttrBucketName] = aws.ToString(configured.S3Configuration.BucketName)
+	if aws.ToString(configured.S3Configuration.Prefix) != "" {
+		s3Config[names.AttrPrefix] = aws.ToString(configured.S3Configuration.Prefix)
+	}
+
+	dataResources := make([]map[string]interface{}, 1)
+	dataResources[0] = make(map[string]interface{})
+	dataResources[0]["s3_configuration"] = []map[string]interface{}{s3Config}
+
+	return dataResources
+}
+
 func flattenCloudWatchLogsConfiguration(configured *types.ExperimentTemplateCloudWatchLogsLogConfiguration) []map[string]interface{} {
 	if configured == nil {
 		return make([]map[string]interface{}, 0)
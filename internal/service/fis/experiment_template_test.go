@@ -419,6 +419,86 @@ func TestAccFISExperimentTemplate_loggingConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccFISExperimentTemplate_experimentOptions(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fis_experiment_template.test"
+	var conf types.ExperimentTemplate
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, fis.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExperimentTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentTemplateConfig_experimentOptions(rName, "multi-account", "fail"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccExperimentTemplateExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.0.account_targeting", "multi-account"),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.0.empty_target_resolution_mode", "fail"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccExperimentTemplateConfig_experimentOptions(rName, "multi-account", "skip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccExperimentTemplateExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.0.account_targeting", "multi-account"),
+					resource.TestCheckResourceAttr(resourceName, "experiment_options.0.empty_target_resolution_mode", "skip"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFISExperimentTemplate_reportConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fis_experiment_template.test"
+	var conf types.ExperimentTemplate
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, fis.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExperimentTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentTemplateConfig_reportConfiguration(rName, "PT10M", "PT5M"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccExperimentTemplateExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "experiment_report_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "experiment_report_configuration.0.data_sources.0.cloudwatch_dashboard.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(resourceName, "experiment_report_configuration.0.data_sources.0.cloudwatch_dashboard.0.dashboard_identifier", "aws_cloudwatch_dashboard.test", "dashboard_arn"),
+					resource.TestCheckResourceAttr(resourceName, "experiment_report_configuration.0.outputs.0.s3_configuration.0.bucket_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "experiment_report_configuration.0.outputs.0.s3_configuration.0.prefix", "reports"),
+					resource.TestCheckResourceAttr(resourceName, "experiment_report_configuration.0.post_experiment_duration", "PT10M"),
+					resource.TestCheckResourceAttr(resourceName, "experiment_report_configuration.0.pre_experiment_duration", "PT5M"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccExperimentTemplateConfig_reportConfiguration(rName, "PT20M", "PT1H"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccExperimentTemplateExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "experiment_report_configuration.0.post_experiment_duration", "PT20M"),
+					resource.TestCheckResourceAttr(resourceName, "experiment_report_configuration.0.pre_experiment_duration", "PT1H"),
+				),
+			},
+		},
+	})
+}
+
 func testAccExperimentTemplateExists(ctx context.Context, resourceName string, config *types.ExperimentTemplate) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -1067,3 +1147,118 @@ resource "aws_fis_experiment_template" "test" {
 }
 `, rName, desc, actionName, actionDesc, actionID, actionTargetK, actionTargetV, targetResType, targetSelectMode, targetResTagK, targetResTagV)
 }
+
+func testAccExperimentTemplateConfig_baseWait(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = [
+          "fis.${data.aws_partition.current.dns_suffix}",
+        ]
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+`, rName)
+}
+
+func testAccExperimentTemplateConfig_experimentOptions(rName, accountTargeting, emptyTargetResolutionMode string) string {
+	return acctest.ConfigCompose(testAccExperimentTemplateConfig_baseWait(rName), fmt.Sprintf(`
+resource "aws_fis_experiment_template" "test" {
+  description = %[1]q
+  role_arn    = aws_iam_role.test.arn
+
+  stop_condition {
+    source = "none"
+  }
+
+  action {
+    name      = "wait"
+    action_id = "aws:fis:wait"
+
+    parameter {
+      key   = "duration"
+      value = "PT1M"
+    }
+  }
+
+  experiment_options {
+    account_targeting            = %[2]q
+    empty_target_resolution_mode = %[3]q
+  }
+}
+`, rName, accountTargeting, emptyTargetResolutionMode))
+}
+
+func testAccExperimentTemplateConfig_reportConfiguration(rName, postDuration, preDuration string) string {
+	return acctest.ConfigCompose(testAccExperimentTemplateConfig_baseWait(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = %[1]q
+
+  dashboard_body = jsonencode({
+    widgets = [{
+      type   = "text"
+      x      = 0
+      y      = 0
+      width  = 6
+      height = 6
+
+      properties = {
+        markdown = "FIS experiment report"
+      }
+    }]
+  })
+}
+
+resource "aws_fis_experiment_template" "test" {
+  description = %[1]q
+  role_arn    = aws_iam_role.test.arn
+
+  stop_condition {
+    source = "none"
+  }
+
+  action {
+    name      = "wait"
+    action_id = "aws:fis:wait"
+
+    parameter {
+      key   = "duration"
+      value = "PT1M"
+    }
+  }
+
+  experiment_report_configuration {
+    data_sources {
+      cloudwatch_dashboard {
+        dashboard_identifier = aws_cloudwatch_dashboard.test.dashboard_arn
+      }
+    }
+
+    outputs {
+      s3_configuration {
+        bucket_name = aws_s3_bucket.test.bucket
+        prefix      = "reports"
+      }
+    }
+
+    post_experiment_duration = %[2]q
+    pre_experiment_duration  = %[3]q
+  }
+}
+`, rName, postDuration, preDuration))
+}
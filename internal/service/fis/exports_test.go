@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fis
+
+// Exports for use in tests only.
+var (
+	ResourceSafetyLever                = resourceSafetyLever
+	ResourceTargetAccountConfiguration = resourceTargetAccountConfiguration
+
+	FindSafetyLeverByID                        = findSafetyLeverByID
+	FindTargetAccountConfigurationByTwoPartKey = findTargetAccountConfigurationByTwoPartKey
+)
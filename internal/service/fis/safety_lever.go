@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fis
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fis"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/fis/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Each account has a single safety lever per Region.
+	defaultSafetyLeverID = "default"
+)
+
+// @SDKResource("aws_fis_safety_lever", name="Safety Lever")
+func resourceSafetyLever() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSafetyLeverPut,
+		ReadWithoutTimeout:   resourceSafetyLeverRead,
+		UpdateWithoutTimeout: resourceSafetyLeverPut,
+		DeleteWithoutTimeout: resourceSafetyLeverDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reason": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.SafetyLeverStatusInput](),
+			},
+		},
+	}
+}
+
+func resourceSafetyLeverPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FISClient(ctx)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	status := awstypes.SafetyLeverStatusInput(d.Get(names.AttrStatus).(string))
+	if err := updateSafetyLeverState(ctx, conn, defaultSafetyLeverID, status, d.Get("reason").(string), timeout); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(defaultSafetyLeverID)
+	}
+
+	return append(diags, resourceSafetyLeverRead(ctx, d, meta)...)
+}
+
+func resourceSafetyLeverRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FISClient(ctx)
+
+	lever, err := findSafetyLeverByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] FIS Safety Lever (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading FIS Safety Lever (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, lever.Arn)
+	d.Set("reason", lever.State.Reason)
+	d.Set(names.AttrStatus, lever.State.Status)
+
+	return diags
+}
+
+func resourceSafetyLeverDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FISClient(ctx)
+
+	lever, err := findSafetyLeverByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading FIS Safety Lever (%s): %s", d.Id(), err)
+	}
+
+	// Destroying the resource restores the default, disengaged, state of the lever.
+	if lever.State.Status == awstypes.SafetyLeverStatusDisengaged {
+		return diags
+	}
+
+	if err := updateSafetyLeverState(ctx, conn, d.Id(), awstypes.SafetyLeverStatusInputDisengaged, "Disengaged by Terraform", d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func updateSafetyLeverState(ctx context.Context, conn *fis.Client, id string, status awstypes.SafetyLeverStatusInput, reason string, timeout time.Duration) error {
+	input := &fis.UpdateSafetyLeverStateInput{
+		Id: aws.String(id),
+		State: &awstypes.UpdateSafetyLeverStateInput{
+			Reason: aws.String(reason),
+			Status: status,
+		},
+	}
+
+	_, err := conn.UpdateSafetyLeverState(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("updating FIS Safety Lever (%s) state: %w", id, err)
+	}
+
+	if _, err := waitSafetyLeverStatus(ctx, conn, id, awstypes.SafetyLeverStatus(status), timeout); err != nil {
+		return fmt.Errorf("waiting for FIS Safety Lever (%s) %s: %w", id, status, err)
+	}
+
+	return nil
+}
+
+func findSafetyLeverByID(ctx context.Context, conn *fis.Client, id string) (*awstypes.SafetyLever, error) {
+	input := &fis.GetSafetyLeverInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetSafetyLever(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SafetyLever == nil || output.SafetyLever.State == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SafetyLever, nil
+}
+
+func statusSafetyLever(ctx context.Context, conn *fis.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSafetyLeverByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State.Status), nil
+	}
+}
+
+// waitSafetyLeverStatus waits for the lever to reach the target status. Engaging the
+// lever stops all running experiments, which the lever reports as "engaging".
+func waitSafetyLeverStatus(ctx context.Context, conn *fis.Client, id string, target awstypes.SafetyLeverStatus, timeout time.Duration) (*awstypes.SafetyLever, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SafetyLeverStatusEngaging),
+		Target:  enum.Slice(target),
+		Refresh: statusSafetyLever(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SafetyLever); ok {
+		return output, err
+	}
+
+	return nil, err
+}
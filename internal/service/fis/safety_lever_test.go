@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fis_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffis "github.com/hashicorp/terraform-provider-aws/internal/service/fis"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The safety lever is a per-account, per-Region singleton and engaging it stops
+// every running experiment, so the test is serialized and opt-in.
+func TestAccFISSafetyLever_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	acctest.SkipIfEnvVarNotSet(t, "FIS_SAFETY_LEVER_TESTS_ENABLED")
+	resourceName := "aws_fis_safety_lever.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FISServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSafetyLeverDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSafetyLeverConfig_basic("engaged", "Incident in progress"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSafetyLeverExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrID, "default"),
+					resource.TestCheckResourceAttr(resourceName, "reason", "Incident in progress"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "engaged"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSafetyLeverConfig_basic("disengaged", "Incident resolved"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSafetyLeverExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "reason", "Incident resolved"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "disengaged"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSafetyLeverDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FISClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_fis_safety_lever" {
+				continue
+			}
+
+			output, err := tffis.FindSafetyLeverByID(ctx, conn, rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			if status := output.State.Status; status != "disengaged" {
+				return fmt.Errorf("FIS Safety Lever %s is still %s", rs.Primary.ID, status)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSafetyLeverExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FISClient(ctx)
+
+		_, err := tffis.FindSafetyLeverByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccSafetyLeverConfig_basic(status, reason string) string {
+	return fmt.Sprintf(`
+resource "aws_fis_safety_lever" "test" {
+  status = %[1]q
+  reason = %[2]q
+}
+`, status, reason)
+}
@@ -34,6 +34,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Experiment Template",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  resourceSafetyLever,
+			TypeName: "aws_fis_safety_lever",
+			Name:     "Safety Lever",
+		},
+		{
+			Factory:  resourceTargetAccountConfiguration,
+			TypeName: "aws_fis_target_account_configuration",
+			Name:     "Target Account Configuration",
+		},
 	}
 }
 
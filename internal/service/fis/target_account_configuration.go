@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fis
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fis"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/fis/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_fis_target_account_configuration", name="Target Account Configuration")
+func resourceTargetAccountConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTargetAccountConfigurationCreate,
+		ReadWithoutTimeout:   resourceTargetAccountConfigurationRead,
+		UpdateWithoutTimeout: resourceTargetAccountConfigurationUpdate,
+		DeleteWithoutTimeout: resourceTargetAccountConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAccountID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenAtMost(512),
+			},
+			"experiment_template_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceTargetAccountConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FISClient(ctx)
+
+	templateID, accountID := d.Get("experiment_template_id").(string), d.Get(names.AttrAccountID).(string)
+	id := targetAccountConfigurationCreateResourceID(templateID, accountID)
+	input := &fis.CreateTargetAccountConfigurationInput{
+		AccountId:            aws.String(accountID),
+		ClientToken:          aws.String(sdkid.UniqueId()),
+		ExperimentTemplateId: aws.String(templateID),
+		RoleArn:              aws.String(d.Get(names.AttrRoleARN).(string)),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateTargetAccountConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating FIS Target Account Configuration (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceTargetAccountConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceTargetAccountConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FISClient(ctx)
+
+	templateID, accountID, err := targetAccountConfigurationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findTargetAccountConfigurationByTwoPartKey(ctx, conn, templateID, accountID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] FIS Target Account Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading FIS Target Account Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrAccountID, output.AccountId)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("experiment_template_id", templateID)
+	d.Set(names.AttrRoleARN, output.RoleArn)
+
+	return diags
+}
+
+func resourceTargetAccountConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FISClient(ctx)
+
+	templateID, accountID, err := targetAccountConfigurationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &fis.UpdateTargetAccountConfigurationInput{
+		AccountId:            aws.String(accountID),
+		Description:          aws.String(d.Get(names.AttrDescription).(string)),
+		ExperimentTemplateId: aws.String(templateID),
+		RoleArn:              aws.String(d.Get(names.AttrRoleARN).(string)),
+	}
+
+	_, err = conn.UpdateTargetAccountConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating FIS Target Account Configuration (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTargetAccountConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceTargetAccountConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FISClient(ctx)
+
+	templateID, accountID, err := targetAccountConfigurationParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting FIS Target Account Configuration: %s", d.Id())
+	_, err = conn.DeleteTargetAccountConfiguration(ctx, &fis.DeleteTargetAccountConfigurationInput{
+		AccountId:            aws.String(accountID),
+		ExperimentTemplateId: aws.String(templateID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting FIS Target Account Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const targetAccountConfigurationResourceIDSeparator = ","
+
+func targetAccountConfigurationCreateResourceID(templateID, accountID string) string {
+	parts := []string{templateID, accountID}
+	id := strings.Join(parts, targetAccountConfigurationResourceIDSeparator)
+
+	return id
+}
+
+func targetAccountConfigurationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, targetAccountConfigurationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected EXPERIMENT-TEMPLATE-ID%[2]sACCOUNT-ID", id, targetAccountConfigurationResourceIDSeparator)
+}
+
+func findTargetAccountConfigurationByTwoPartKey(ctx context.Context, conn *fis.Client, templateID, accountID string) (*awstypes.TargetAccountConfiguration, error) {
+	input := &fis.GetTargetAccountConfigurationInput{
+		AccountId:            aws.String(accountID),
+		ExperimentTemplateId: aws.String(templateID),
+	}
+
+	output, err := conn.GetTargetAccountConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TargetAccountConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TargetAccountConfiguration, nil
+}
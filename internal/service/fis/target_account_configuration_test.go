@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fis_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffis "github.com/hashicorp/terraform-provider-aws/internal/service/fis"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccFISTargetAccountConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fis_target_account_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FISServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckTargetAccountConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetAccountConfigurationConfig_basic(rName, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTargetAccountConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrAccountID, "data.aws_caller_identity.target", names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+					resource.TestCheckResourceAttrPair(resourceName, "experiment_template_id", "aws_fis_experiment_template.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.target", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTargetAccountConfigurationConfig_basic(rName, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTargetAccountConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFISTargetAccountConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fis_target_account_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FISServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckTargetAccountConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetAccountConfigurationConfig_basic(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetAccountConfigurationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tffis.ResourceTargetAccountConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTargetAccountConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FISClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_fis_target_account_configuration" {
+				continue
+			}
+
+			_, err := tffis.FindTargetAccountConfigurationByTwoPartKey(ctx, conn, rs.Primary.Attributes["experiment_template_id"], rs.Primary.Attributes[names.AttrAccountID])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("FIS Target Account Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTargetAccountConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FISClient(ctx)
+
+		_, err := tffis.FindTargetAccountConfigurationByTwoPartKey(ctx, conn, rs.Primary.Attributes["experiment_template_id"], rs.Primary.Attributes[names.AttrAccountID])
+
+		return err
+	}
+}
+
+func testAccTargetAccountConfigurationConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "target" {
+  provider = "awsalternate"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "fis.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_iam_role" "target" {
+  provider = "awsalternate"
+
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        AWS = aws_iam_role.test.arn
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_fis_experiment_template" "test" {
+  description = %[1]q
+  role_arn    = aws_iam_role.test.arn
+
+  stop_condition {
+    source = "none"
+  }
+
+  action {
+    name      = "stop-instances"
+    action_id = "aws:ec2:stop-instances"
+
+    target {
+      key   = "Instances"
+      value = "instances"
+    }
+  }
+
+  target {
+    name           = "instances"
+    resource_type  = "aws:ec2:instance"
+    selection_mode = "ALL"
+
+    resource_tag {
+      key   = "chaos-ready"
+      value = "true"
+    }
+  }
+
+  experiment_options {
+    account_targeting = "multi-account"
+  }
+}
+
+resource "aws_fis_target_account_configuration" "test" {
+  experiment_template_id = aws_fis_experiment_template.test.id
+  account_id             = data.aws_caller_identity.target.account_id
+  role_arn               = aws_iam_role.target.arn
+  description            = %[2]q
+}
+`, rName, description))
+}
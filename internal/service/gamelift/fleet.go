@@ -49,6 +49,19 @@ func ResourceFleet() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"anywhere_configuration": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cost": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -58,10 +71,10 @@ func ResourceFleet() *schema.Resource {
 				Computed: true,
 			},
 			"build_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ExactlyOneOf: []string{"build_id", "script_id"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"script_id"},
 			},
 			"certificate_configuration": {
 				Type:     schema.TypeList,
@@ -80,6 +93,64 @@ func ResourceFleet() *schema.Resource {
 					},
 				},
 			},
+			"compute_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(gamelift.ComputeType_Values(), false),
+			},
+			"container_groups_configuration": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_port_range": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"from_port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+									"to_port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+								},
+							},
+						},
+						"container_group_definition_names": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 2,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"desired_replica_container_groups_per_instance": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_replica_container_groups_per_instance": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrDescription: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -117,7 +188,7 @@ func ResourceFleet() *schema.Resource {
 			},
 			"ec2_instance_type": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(gamelift.EC2InstanceType_Values(), false),
 			},
@@ -134,6 +205,13 @@ func ResourceFleet() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 				Optional:     true,
 			},
+			"locations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"log_paths": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -230,10 +308,10 @@ func ResourceFleet() *schema.Resource {
 				Computed: true,
 			},
 			"script_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ExactlyOneOf: []string{"build_id", "script_id"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"build_id"},
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -248,9 +326,12 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
 
 	input := &gamelift.CreateFleetInput{
-		EC2InstanceType: aws.String(d.Get("ec2_instance_type").(string)),
-		Name:            aws.String(d.Get(names.AttrName).(string)),
-		Tags:            getTagsIn(ctx),
+		Name: aws.String(d.Get(names.AttrName).(string)),
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("anywhere_configuration"); ok {
+		input.AnywhereConfiguration = expandAnywhereConfiguration(v.([]interface{}))
 	}
 
 	if v, ok := d.GetOk("build_id"); ok {
@@ -261,9 +342,20 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.ScriptId = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("compute_type"); ok {
+		input.ComputeType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("container_groups_configuration"); ok {
+		input.ContainerGroupsConfiguration = expandContainerGroupsConfiguration(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk(names.AttrDescription); ok {
 		input.Description = aws.String(v.(string))
 	}
+	if v, ok := d.GetOk("ec2_instance_type"); ok {
+		input.EC2InstanceType = aws.String(v.(string))
+	}
 	if v, ok := d.GetOk("fleet_type"); ok {
 		input.FleetType = aws.String(v.(string))
 	}
@@ -275,6 +367,10 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.InstanceRoleArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("locations"); ok && v.(*schema.Set).Len() > 0 {
+		input.Locations = expandLocationConfigurations(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk("metric_groups"); ok {
 		input.MetricGroups = flex.ExpandStringList(v.([]interface{}))
 	}
@@ -343,8 +439,15 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	arn := aws.StringValue(fleet.FleetArn)
+	if err := d.Set("anywhere_configuration", flattenAnywhereConfiguration(fleet.AnywhereConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting anywhere_configuration: %s", err)
+	}
 	d.Set("build_arn", fleet.BuildArn)
 	d.Set("build_id", fleet.BuildId)
+	d.Set("compute_type", fleet.ComputeType)
+	if err := d.Set("container_groups_configuration", flattenContainerGroupsAttributes(fleet.ContainerGroupsAttributes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting container_groups_configuration: %s", err)
+	}
 	d.Set(names.AttrDescription, fleet.Description)
 	d.Set(names.AttrARN, arn)
 	d.Set("log_paths", aws.StringValueSlice(fleet.LogPaths))
@@ -366,6 +469,20 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return sdkdiag.AppendErrorf(diags, "setting resource_creation_limit_policy: %s", err)
 	}
 
+	locations, err := findFleetLocationsByID(ctx, conn, d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Fleet (%s) locations: %s", d.Id(), err)
+	}
+
+	// The home Region is always included in the fleet's locations.
+	var remoteLocations []string
+	for _, v := range locations {
+		if v != meta.(*conns.AWSClient).Region {
+			remoteLocations = append(remoteLocations, v)
+		}
+	}
+	d.Set("locations", remoteLocations)
+
 	portInput := &gamelift.DescribeFleetPortSettingsInput{
 		FleetId: aws.String(d.Id()),
 	}
@@ -388,15 +505,21 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	log.Printf("[INFO] Updating GameLift Fleet: %s", d.Id())
 
-	if d.HasChanges(names.AttrDescription, "metric_groups", names.AttrName, "new_game_session_protection_policy", "resource_creation_limit_policy") {
-		_, err := conn.UpdateFleetAttributesWithContext(ctx, &gamelift.UpdateFleetAttributesInput{
+	if d.HasChanges("anywhere_configuration", names.AttrDescription, "metric_groups", names.AttrName, "new_game_session_protection_policy", "resource_creation_limit_policy") {
+		input := &gamelift.UpdateFleetAttributesInput{
 			Description:                    aws.String(d.Get(names.AttrDescription).(string)),
 			FleetId:                        aws.String(d.Id()),
 			MetricGroups:                   flex.ExpandStringList(d.Get("metric_groups").([]interface{})),
 			Name:                           aws.String(d.Get(names.AttrName).(string)),
 			NewGameSessionProtectionPolicy: aws.String(d.Get("new_game_session_protection_policy").(string)),
 			ResourceCreationLimitPolicy:    expandResourceCreationLimitPolicy(d.Get("resource_creation_limit_policy").([]interface{})),
-		})
+		}
+
+		if d.HasChange("anywhere_configuration") {
+			input.AnywhereConfiguration = expandAnywhereConfiguration(d.Get("anywhere_configuration").([]interface{}))
+		}
+
+		_, err := conn.UpdateFleetAttributesWithContext(ctx, input)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating for GameLift Fleet attributes (%s): %s", d.Id(), err)
 		}
@@ -617,6 +740,119 @@ func flattenCertificateConfiguration(config *gamelift.CertificateConfiguration)
 	return []interface{}{m}
 }
 
+func expandAnywhereConfiguration(cfg []interface{}) *gamelift.AnywhereConfiguration {
+	if len(cfg) < 1 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]interface{})
+
+	return &gamelift.AnywhereConfiguration{
+		Cost: aws.String(m["cost"].(string)),
+	}
+}
+
+func flattenAnywhereConfiguration(config *gamelift.AnywhereConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := make(map[string]interface{})
+	m["cost"] = aws.StringValue(config.Cost)
+
+	return []interface{}{m}
+}
+
+func expandContainerGroupsConfiguration(cfg []interface{}) *gamelift.ContainerGroupsConfiguration {
+	if len(cfg) < 1 || cfg[0] == nil {
+		return nil
+	}
+	out := gamelift.ContainerGroupsConfiguration{}
+	m := cfg[0].(map[string]interface{})
+
+	if v, ok := m["connection_port_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		r := v[0].(map[string]interface{})
+		out.ConnectionPortRange = &gamelift.ConnectionPortRange{
+			FromPort: aws.Int64(int64(r["from_port"].(int))),
+			ToPort:   aws.Int64(int64(r["to_port"].(int))),
+		}
+	}
+	if v, ok := m["container_group_definition_names"].([]interface{}); ok && len(v) > 0 {
+		out.ContainerGroupDefinitionNames = flex.ExpandStringList(v)
+	}
+	if v, ok := m["desired_replica_container_groups_per_instance"].(int); ok && v > 0 {
+		out.DesiredReplicaContainerGroupsPerInstance = aws.Int64(int64(v))
+	}
+
+	return &out
+}
+
+func flattenContainerGroupsAttributes(attributes *gamelift.ContainerGroupsAttributes) []interface{} {
+	if attributes == nil {
+		return []interface{}{}
+	}
+
+	m := make(map[string]interface{})
+
+	if v := attributes.ConnectionPortRange; v != nil {
+		m["connection_port_range"] = []interface{}{map[string]interface{}{
+			"from_port": aws.Int64Value(v.FromPort),
+			"to_port":   aws.Int64Value(v.ToPort),
+		}}
+	}
+
+	var definitionNames []string
+	for _, v := range attributes.ContainerGroupDefinitionProperties {
+		definitionNames = append(definitionNames, aws.StringValue(v.ContainerGroupDefinitionName))
+	}
+	m["container_group_definition_names"] = definitionNames
+
+	if v := attributes.ContainerGroupsPerInstance; v != nil {
+		m["desired_replica_container_groups_per_instance"] = aws.Int64Value(v.DesiredReplicaContainerGroupsPerInstance)
+		m["max_replica_container_groups_per_instance"] = aws.Int64Value(v.MaxReplicaContainerGroupsPerInstance)
+	}
+
+	return []interface{}{m}
+}
+
+func expandLocationConfigurations(cfgs []interface{}) []*gamelift.LocationConfiguration {
+	var out []*gamelift.LocationConfiguration
+
+	for _, v := range cfgs {
+		out = append(out, &gamelift.LocationConfiguration{
+			Location: aws.String(v.(string)),
+		})
+	}
+
+	return out
+}
+
+func findFleetLocationsByID(ctx context.Context, conn *gamelift.GameLift, id string) ([]string, error) {
+	input := &gamelift.DescribeFleetLocationAttributesInput{
+		FleetId: aws.String(id),
+	}
+	var output []string
+
+	err := conn.DescribeFleetLocationAttributesPagesWithContext(ctx, input, func(page *gamelift.DescribeFleetLocationAttributesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LocationAttributes {
+			if v != nil && v.LocationState != nil {
+				output = append(output, aws.StringValue(v.LocationState.Location))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func DiffPortSettings(oldPerms, newPerms []interface{}) (a []*gamelift.IpPermission, r []*gamelift.IpPermission) {
 OUTER:
 	for i, op := range oldPerms {
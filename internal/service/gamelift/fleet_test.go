@@ -585,6 +585,51 @@ func TestAccGameLiftFleet_script(t *testing.T) {
 	})
 }
 
+func TestAccGameLiftFleet_anywhere(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf gamelift.FleetAttributes
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_anywhere(rName, "10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "anywhere_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "anywhere_configuration.0.cost", "10"),
+					resource.TestCheckResourceAttr(resourceName, "compute_type", "ANYWHERE"),
+					resource.TestCheckResourceAttr(resourceName, "locations.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "locations.*", "aws_gamelift_location.test", names.AttrName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"runtime_configuration"},
+			},
+			{
+				Config: testAccFleetConfig_anywhere(rName, "20"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "anywhere_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "anywhere_configuration.0.cost", "20"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGameLiftFleet_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1002,3 +1047,34 @@ resource "aws_gamelift_fleet" "test" {
 }
 `, rName)
 }
+
+func testAccFleetConfig_anywhere(rName, cost string) string {
+	return fmt.Sprintf(`
+resource "aws_gamelift_location" "test" {
+  name = "custom-%[1]s"
+}
+
+resource "aws_gamelift_script" "test" {
+  name     = %[1]q
+  zip_file = "test-fixtures/script.zip"
+}
+
+resource "aws_gamelift_fleet" "test" {
+  compute_type = "ANYWHERE"
+  locations    = [aws_gamelift_location.test.name]
+  name         = %[1]q
+  script_id    = aws_gamelift_script.test.id
+
+  anywhere_configuration {
+    cost = %[2]q
+  }
+
+  runtime_configuration {
+    server_process {
+      concurrent_executions = 1
+      launch_path           = "/local/game/lol"
+    }
+  }
+}
+`, rName, cost)
+}
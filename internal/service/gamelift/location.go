@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_gamelift_location", name="Location")
+// @Tags(identifierAttribute="arn")
+func ResourceLocation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLocationCreate,
+		ReadWithoutTimeout:   resourceLocationRead,
+		UpdateWithoutTimeout: resourceLocationUpdate,
+		DeleteWithoutTimeout: resourceLocationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(8, 64),
+					validation.StringMatch(regexache.MustCompile(`^custom-[0-9A-Za-z-]+$`), "must begin with custom- and contain only alphanumeric characters and hyphens"),
+				),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceLocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &gamelift.CreateLocationInput{
+		LocationName: aws.String(name),
+		Tags:         getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateLocationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating GameLift Location (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Location.LocationName))
+
+	return append(diags, resourceLocationRead(ctx, d, meta)...)
+}
+
+func resourceLocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	location, err := FindLocationByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GameLift Location (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Location (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, location.LocationArn)
+	d.Set(names.AttrName, location.LocationName)
+
+	return diags
+}
+
+func resourceLocationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceLocationRead(ctx, d, meta)...)
+}
+
+func resourceLocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftConn(ctx)
+
+	log.Printf("[INFO] Deleting GameLift Location: %s", d.Id())
+	_, err := conn.DeleteLocationWithContext(ctx, &gamelift.DeleteLocationInput{
+		LocationName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting GameLift Location (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
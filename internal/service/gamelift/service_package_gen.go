@@ -45,6 +45,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceCompute,
+			TypeName: "aws_gamelift_compute",
+			Name:     "Compute",
+		},
+		{
+			Factory:  ResourceContainerGroupDefinition,
+			TypeName: "aws_gamelift_container_group_definition",
+			Name:     "Container Group Definition",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceFleet,
 			TypeName: "aws_gamelift_fleet",
@@ -69,6 +82,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceLocation,
+			TypeName: "aws_gamelift_location",
+			Name:     "Location",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceScript,
 			TypeName: "aws_gamelift_script",
@@ -28,6 +28,22 @@ func statusBuild(ctx context.Context, conn *gamelift.GameLift, id string) retry.
 	}
 }
 
+func statusContainerGroupDefinition(ctx context.Context, conn *gamelift.GameLift, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindContainerGroupDefinitionByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
 func statusFleet(ctx context.Context, conn *gamelift.GameLift, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindFleetByID(ctx, conn, id)
@@ -7,7 +7,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
@@ -37,6 +39,10 @@ func ResourceCatalogTable() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -364,7 +370,6 @@ func ResourceCatalogTable() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 				MaxItems: 3,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -543,6 +548,14 @@ func resourceCatalogTableUpdate(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "updating Glue Catalog Table (%s): %s", d.Id(), err)
 	}
 
+	if d.HasChange("partition_index") {
+		o, n := d.GetChange("partition_index")
+
+		if err := updateTablePartitionIndexes(ctx, conn, catalogID, dbName, name, o.([]interface{}), n.([]interface{}), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Glue Catalog Table (%s) partition indexes: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceCatalogTableRead(ctx, d, meta)...)
 }
 
@@ -690,6 +703,66 @@ func expandTablePartitionIndex(m map[string]interface{}) *glue.PartitionIndex {
 	return partitionIndex
 }
 
+// updateTablePartitionIndexes deletes removed partition indexes and creates added ones.
+// Partition indexes cannot be modified, so an index whose keys change is deleted and created again.
+func updateTablePartitionIndexes(ctx context.Context, conn *glue.Glue, catalogID, dbName, tableName string, o, n []interface{}, timeout time.Duration) error {
+	os, ns := make(map[string]*glue.PartitionIndex), make(map[string]*glue.PartitionIndex)
+	for _, v := range expandTablePartitionIndexes(o) {
+		os[aws.StringValue(v.IndexName)] = v
+	}
+	for _, v := range expandTablePartitionIndexes(n) {
+		ns[aws.StringValue(v.IndexName)] = v
+	}
+
+	for indexName, v := range os {
+		if nv, ok := ns[indexName]; ok && slices.Equal(aws.StringValueSlice(nv.Keys), aws.StringValueSlice(v.Keys)) {
+			continue
+		}
+
+		_, err := conn.DeletePartitionIndexWithContext(ctx, &glue.DeletePartitionIndexInput{
+			CatalogId:    aws.String(catalogID),
+			DatabaseName: aws.String(dbName),
+			IndexName:    aws.String(indexName),
+			TableName:    aws.String(tableName),
+		})
+
+		if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting partition index (%s): %w", indexName, err)
+		}
+
+		if _, err := waitPartitionIndexDeleted(ctx, conn, createPartitionIndexID(catalogID, dbName, tableName, indexName), timeout); err != nil {
+			return fmt.Errorf("waiting for partition index (%s) delete: %w", indexName, err)
+		}
+	}
+
+	for indexName, v := range ns {
+		if ov, ok := os[indexName]; ok && slices.Equal(aws.StringValueSlice(ov.Keys), aws.StringValueSlice(v.Keys)) {
+			continue
+		}
+
+		_, err := conn.CreatePartitionIndexWithContext(ctx, &glue.CreatePartitionIndexInput{
+			CatalogId:      aws.String(catalogID),
+			DatabaseName:   aws.String(dbName),
+			PartitionIndex: v,
+			TableName:      aws.String(tableName),
+		})
+
+		if err != nil {
+			return fmt.Errorf("creating partition index (%s): %w", indexName, err)
+		}
+
+		if _, err := waitPartitionIndexCreated(ctx, conn, createPartitionIndexID(catalogID, dbName, tableName, indexName), timeout); err != nil {
+			return fmt.Errorf("waiting for partition index (%s) create: %w", indexName, err)
+		}
+	}
+
+	return nil
+}
+
 func expandStorageDescriptor(l []interface{}) *glue.StorageDescriptor {
 	if len(l) == 0 || l[0] == nil {
 		return nil
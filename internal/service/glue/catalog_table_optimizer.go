@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Table optimizer types not yet known to the AWS SDK.
+const (
+	tableOptimizerTypeOrphanFileDeletion = "orphan_file_deletion"
+	tableOptimizerTypeRetention          = "retention"
+)
+
+func tableOptimizerType_Values() []string {
+	return append(glue.TableOptimizerType_Values(), tableOptimizerTypeOrphanFileDeletion, tableOptimizerTypeRetention)
+}
+
+// @SDKResource("aws_glue_catalog_table_optimizer", name="Catalog Table Optimizer")
+func ResourceCatalogTableOptimizer() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCatalogTableOptimizerCreate,
+		ReadWithoutTimeout:   resourceCatalogTableOptimizerRead,
+		UpdateWithoutTimeout: resourceCatalogTableOptimizerUpdate,
+		DeleteWithoutTimeout: resourceCatalogTableOptimizerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrCatalogID: {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrConfiguration: {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						names.AttrRoleARN: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			names.AttrDatabaseName: {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			names.AttrTableName: {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			names.AttrType: {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(tableOptimizerType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceCatalogTableOptimizerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	catalogID := createCatalogID(d, meta.(*conns.AWSClient).AccountID)
+	dbName := d.Get(names.AttrDatabaseName).(string)
+	tableName := d.Get(names.AttrTableName).(string)
+	optimizerType := d.Get(names.AttrType).(string)
+	id := createTableOptimizerID(catalogID, dbName, tableName, optimizerType)
+
+	input := &glue.CreateTableOptimizerInput{
+		CatalogId:                   aws.String(catalogID),
+		DatabaseName:                aws.String(dbName),
+		TableName:                   aws.String(tableName),
+		TableOptimizerConfiguration: expandTableOptimizerConfiguration(d.Get(names.AttrConfiguration).([]interface{})),
+		Type:                        aws.String(optimizerType),
+	}
+
+	// The role may not yet be assumable by Glue.
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateTableOptimizerWithContext(ctx, input)
+	}, glue.ErrCodeAccessDeniedException, "role")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Glue Catalog Table Optimizer (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceCatalogTableOptimizerRead(ctx, d, meta)...)
+}
+
+func resourceCatalogTableOptimizerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	catalogID, dbName, tableName, optimizerType, err := readTableOptimizerID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	optimizer, err := FindTableOptimizer(ctx, conn, catalogID, dbName, tableName, optimizerType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Glue Catalog Table Optimizer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Glue Catalog Table Optimizer (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrCatalogID, catalogID)
+	if err := d.Set(names.AttrConfiguration, flattenTableOptimizerConfiguration(optimizer.Configuration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+	}
+	d.Set(names.AttrDatabaseName, dbName)
+	d.Set(names.AttrTableName, tableName)
+	d.Set(names.AttrType, optimizer.Type)
+
+	return diags
+}
+
+func resourceCatalogTableOptimizerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	catalogID, dbName, tableName, optimizerType, err := readTableOptimizerID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &glue.UpdateTableOptimizerInput{
+		CatalogId:                   aws.String(catalogID),
+		DatabaseName:                aws.String(dbName),
+		TableName:                   aws.String(tableName),
+		TableOptimizerConfiguration: expandTableOptimizerConfiguration(d.Get(names.AttrConfiguration).([]interface{})),
+		Type:                        aws.String(optimizerType),
+	}
+
+	_, err = conn.UpdateTableOptimizerWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Glue Catalog Table Optimizer (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCatalogTableOptimizerRead(ctx, d, meta)...)
+}
+
+func resourceCatalogTableOptimizerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn(ctx)
+
+	catalogID, dbName, tableName, optimizerType, err := readTableOptimizerID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Glue Catalog Table Optimizer: %s", d.Id())
+	_, err = conn.DeleteTableOptimizerWithContext(ctx, &glue.DeleteTableOptimizerInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		TableName:    aws.String(tableName),
+		Type:         aws.String(optimizerType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Glue Catalog Table Optimizer (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindTableOptimizer(ctx context.Context, conn *glue.Glue, catalogID, dbName, tableName, optimizerType string) (*glue.TableOptimizer, error) {
+	input := &glue.GetTableOptimizerInput{
+		CatalogId:    aws.String(catalogID),
+		DatabaseName: aws.String(dbName),
+		TableName:    aws.String(tableName),
+		Type:         aws.String(optimizerType),
+	}
+
+	output, err := conn.GetTableOptimizerWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TableOptimizer == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TableOptimizer, nil
+}
+
+func createTableOptimizerID(catalogID, dbName, tableName, optimizerType string) string {
+	return fmt.Sprintf("%s:%s:%s:%s", catalogID, dbName, tableName, optimizerType)
+}
+
+func readTableOptimizerID(id string) (string, string, string, string, error) {
+	idParts := strings.Split(id, ":")
+	if len(idParts) != 4 {
+		return "", "", "", "", fmt.Errorf("expected ID in format catalog-id:database-name:table-name:type, received: %s", id)
+	}
+	return idParts[0], idParts[1], idParts[2], idParts[3], nil
+}
+
+func expandTableOptimizerConfiguration(tfList []interface{}) *glue.TableOptimizerConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &glue.TableOptimizerConfiguration{
+		Enabled: aws.Bool(tfMap[names.AttrEnabled].(bool)),
+		RoleArn: aws.String(tfMap[names.AttrRoleARN].(string)),
+	}
+}
+
+func flattenTableOptimizerConfiguration(apiObject *glue.TableOptimizerConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		names.AttrEnabled: aws.BoolValue(apiObject.Enabled),
+		names.AttrRoleARN: aws.StringValue(apiObject.RoleArn),
+	}}
+}
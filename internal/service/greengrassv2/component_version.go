@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package greengrassv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/greengrassv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/greengrassv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	componentRecipeFormatVersion = "2020-01-25"
+)
+
+// @SDKResource("aws_greengrassv2_component_version", name="Component Version")
+// @Tags(identifierAttribute="arn")
+func resourceComponentVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceComponentVersionCreate,
+		ReadWithoutTimeout:   resourceComponentVersionRead,
+		UpdateWithoutTimeout: resourceComponentVersionUpdate,
+		DeleteWithoutTimeout: resourceComponentVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			resourceComponentVersionCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inline_recipe": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validComponentRecipe,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONOrYAMLDiffs,
+			},
+			"publisher": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceComponentVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Client(ctx)
+
+	input := &greengrassv2.CreateComponentVersionInput{
+		InlineRecipe: []byte(d.Get("inline_recipe").(string)),
+		Tags:         getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateComponentVersion(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Greengrass V2 Component Version: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.Arn))
+
+	if _, err := waitComponentVersionDeployable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Greengrass V2 Component Version (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceComponentVersionRead(ctx, d, meta)...)
+}
+
+func resourceComponentVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Client(ctx)
+
+	output, err := findComponentVersionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Greengrass V2 Component Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Greengrass V2 Component Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	d.Set("component_name", output.ComponentName)
+	d.Set("component_version", output.ComponentVersion)
+	d.Set("creation_timestamp", aws.ToTime(output.CreationTimestamp).Format(time.RFC3339))
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("publisher", output.Publisher)
+	if output.Status != nil {
+		d.Set(names.AttrStatus, output.Status.ComponentState)
+	} else {
+		d.Set(names.AttrStatus, nil)
+	}
+
+	// The service returns a normalized recipe, so the configured recipe is only set on import.
+	if d.Get("inline_recipe").(string) == "" {
+		recipe, err := findComponentRecipeByARN(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Greengrass V2 Component Version (%s) recipe: %s", d.Id(), err)
+		}
+
+		d.Set("inline_recipe", recipe)
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceComponentVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceComponentVersionRead(ctx, d, meta)...)
+}
+
+func resourceComponentVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Client(ctx)
+
+	log.Printf("[DEBUG] Deleting Greengrass V2 Component Version: %s", d.Id())
+	_, err := conn.DeleteComponent(ctx, &greengrassv2.DeleteComponentInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Greengrass V2 Component Version (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourceComponentVersionCustomizeDiff exposes the component name and version declared in the recipe
+// during plan, so that deployments can reference them before the component version exists.
+func resourceComponentVersionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("inline_recipe") {
+		return nil
+	}
+
+	if !d.NewValueKnown("inline_recipe") {
+		return nil
+	}
+
+	recipe, err := parseComponentRecipe(d.Get("inline_recipe").(string))
+
+	if err != nil {
+		return err
+	}
+
+	if err := d.SetNew("component_name", recipe.ComponentName); err != nil {
+		return err
+	}
+
+	return d.SetNew("component_version", recipe.ComponentVersion)
+}
+
+func findComponentVersionByARN(ctx context.Context, conn *greengrassv2.Client, arn string) (*greengrassv2.DescribeComponentOutput, error) {
+	input := &greengrassv2.DescribeComponentInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeComponent(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findComponentRecipeByARN(ctx context.Context, conn *greengrassv2.Client, arn string) (string, error) {
+	input := &greengrassv2.GetComponentInput{
+		Arn:                aws.String(arn),
+		RecipeOutputFormat: awstypes.RecipeOutputFormatJson,
+	}
+
+	output, err := conn.GetComponent(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return string(output.Recipe), nil
+}
+
+func statusComponentVersion(ctx context.Context, conn *greengrassv2.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findComponentVersionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Status == nil {
+			return nil, "", nil
+		}
+
+		return output, string(output.Status.ComponentState), nil
+	}
+}
+
+func waitComponentVersionDeployable(ctx context.Context, conn *greengrassv2.Client, arn string, timeout time.Duration) (*greengrassv2.DescribeComponentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.CloudComponentStateRequested, awstypes.CloudComponentStateInitiated),
+		Target:  enum.Slice(awstypes.CloudComponentStateDeployable),
+		Refresh: statusComponentVersion(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*greengrassv2.DescribeComponentOutput); ok {
+		if status := output.Status; status != nil && status.ComponentState == awstypes.CloudComponentStateFailed {
+			tfresource.SetLastError(err, componentStatusError(status))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func componentStatusError(apiObject *awstypes.CloudComponentStatus) error {
+	errs := []error{errors.New(aws.ToString(apiObject.Message))}
+
+	for k, v := range apiObject.Errors {
+		errs = append(errs, fmt.Errorf("%s: %s", k, v))
+	}
+
+	return errors.Join(errs...)
+}
+
+type componentRecipe struct {
+	RecipeFormatVersion string `yaml:"RecipeFormatVersion"`
+	ComponentName       string `yaml:"ComponentName"`
+	ComponentVersion    string `yaml:"ComponentVersion"`
+}
+
+var componentVersionRegexp = regexache.MustCompile(`^\d+\.\d+\.\d+$`)
+
+// parseComponentRecipe parses a JSON or YAML component recipe and checks the fields the service requires.
+func parseComponentRecipe(s string) (*componentRecipe, error) {
+	var recipe componentRecipe
+
+	// YAML is a superset of JSON.
+	if err := yaml.Unmarshal([]byte(s), &recipe); err != nil {
+		return nil, fmt.Errorf("parsing recipe: %w", err)
+	}
+
+	var errs []error
+
+	if recipe.RecipeFormatVersion != componentRecipeFormatVersion {
+		errs = append(errs, fmt.Errorf("RecipeFormatVersion must be %q", componentRecipeFormatVersion))
+	}
+
+	if recipe.ComponentName == "" {
+		errs = append(errs, errors.New("ComponentName is required"))
+	}
+
+	if !componentVersionRegexp.MatchString(recipe.ComponentVersion) {
+		errs = append(errs, errors.New("ComponentVersion must be a semantic version of the form major.minor.patch"))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("invalid recipe: %w", err)
+	}
+
+	return &recipe, nil
+}
+
+func validComponentRecipe(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := parseComponentRecipe(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
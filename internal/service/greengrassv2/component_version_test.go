@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package greengrassv2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/greengrassv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/greengrassv2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgreengrassv2 "github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidComponentRecipe(t *testing.T) {
+	t.Parallel()
+
+	validRecipes := []string{
+		`{"RecipeFormatVersion": "2020-01-25", "ComponentName": "com.example.Hello", "ComponentVersion": "1.0.0"}`,
+		`
+RecipeFormatVersion: "2020-01-25"
+ComponentName: com.example.Hello
+ComponentVersion: 1.10.2
+Manifests:
+  - Platform:
+      os: linux
+    Lifecycle:
+      Run: echo hello
+`,
+	}
+	for _, v := range validRecipes {
+		_, errors := tfgreengrassv2.ValidComponentRecipe(v, "inline_recipe")
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid recipe: %q", v, errors)
+		}
+	}
+
+	invalidRecipes := []string{
+		``,
+		`{"RecipeFormatVersion": "2020-01-25", "ComponentName": "com.example.Hello"}`,
+		`{"RecipeFormatVersion": "2020-01-25", "ComponentName": "com.example.Hello", "ComponentVersion": "1.0"}`,
+		`{"RecipeFormatVersion": "2019-01-01", "ComponentName": "com.example.Hello", "ComponentVersion": "1.0.0"}`,
+		`{"RecipeFormatVersion": "2020-01-25", "ComponentVersion": "1.0.0"}`,
+		`RecipeFormatVersion: [`,
+	}
+	for _, v := range invalidRecipes {
+		_, errors := tfgreengrassv2.ValidComponentRecipe(v, "inline_recipe")
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid recipe", v)
+		}
+	}
+}
+
+func TestAccGreengrassV2ComponentVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v greengrassv2.DescribeComponentOutput
+	rName := fmt.Sprintf("com.example.%s", sdkacctest.RandString(10))
+	resourceName := "aws_greengrassv2_component_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GreengrassV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentVersionConfig_basic(rName, "1.0.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckComponentVersionExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "greengrass", fmt.Sprintf("components:%s:versions:1.0.0", rName)),
+					resource.TestCheckResourceAttr(resourceName, "component_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "component_version", "1.0.0"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Test component"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.CloudComponentStateDeployable)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"inline_recipe"},
+			},
+			{
+				Config: testAccComponentVersionConfig_basic(rName, "1.0.1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckComponentVersionExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "greengrass", fmt.Sprintf("components:%s:versions:1.0.1", rName)),
+					resource.TestCheckResourceAttr(resourceName, "component_version", "1.0.1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2ComponentVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v greengrassv2.DescribeComponentOutput
+	rName := fmt.Sprintf("com.example.%s", sdkacctest.RandString(10))
+	resourceName := "aws_greengrassv2_component_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GreengrassV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentVersionConfig_basic(rName, "1.0.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfgreengrassv2.ResourceComponentVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2ComponentVersion_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v greengrassv2.DescribeComponentOutput
+	rName := fmt.Sprintf("com.example.%s", sdkacctest.RandString(10))
+	resourceName := "aws_greengrassv2_component_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GreengrassV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentVersionConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"inline_recipe"},
+			},
+			{
+				Config: testAccComponentVersionConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccComponentVersionConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComponentVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_greengrassv2_component_version" {
+				continue
+			}
+
+			_, err := tfgreengrassv2.FindComponentVersionByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Greengrass V2 Component Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckComponentVersionExists(ctx context.Context, n string, v *greengrassv2.DescribeComponentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Client(ctx)
+
+		output, err := tfgreengrassv2.FindComponentVersionByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccComponentVersionConfig_basic(rName, version string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrassv2_component_version" "test" {
+  inline_recipe = yamlencode({
+    RecipeFormatVersion  = "2020-01-25"
+    ComponentName        = %[1]q
+    ComponentVersion     = %[2]q
+    ComponentDescription = "Test component"
+    ComponentPublisher   = "Terraform"
+    Manifests = [{
+      Platform = {
+        os = "linux"
+      }
+      Lifecycle = {
+        Run = "echo Hello"
+      }
+    }]
+  })
+}
+`, rName, version)
+}
+
+func testAccComponentVersionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrassv2_component_version" "test" {
+  inline_recipe = jsonencode({
+    RecipeFormatVersion = "2020-01-25"
+    ComponentName       = %[1]q
+    ComponentVersion    = "1.0.0"
+    Manifests = [{
+      Platform = {
+        os = "linux"
+      }
+      Lifecycle = {
+        Run = "echo Hello"
+      }
+    }]
+  })
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccComponentVersionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_greengrassv2_component_version" "test" {
+  inline_recipe = jsonencode({
+    RecipeFormatVersion = "2020-01-25"
+    ComponentName       = %[1]q
+    ComponentVersion    = "1.0.0"
+    Manifests = [{
+      Platform = {
+        os = "linux"
+      }
+      Lifecycle = {
+        Run = "echo Hello"
+      }
+    }]
+  })
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package greengrassv2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/greengrassv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/greengrassv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_greengrassv2_core_device", name="Core Device")
+func dataSourceCoreDevice() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCoreDeviceRead,
+
+		Schema: map[string]*schema.Schema{
+			"architecture": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"core_device_thing_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"core_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_status_update_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"platform": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceCoreDeviceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Client(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	thingName := d.Get("core_device_thing_name").(string)
+	output, err := findCoreDeviceByThingName(ctx, conn, thingName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Greengrass V2 Core Device (%s): %s", thingName, err)
+	}
+
+	d.SetId(aws.ToString(output.CoreDeviceThingName))
+	d.Set("architecture", output.Architecture)
+	d.Set("core_device_thing_name", output.CoreDeviceThingName)
+	d.Set("core_version", output.CoreVersion)
+	if output.LastStatusUpdateTimestamp != nil {
+		d.Set("last_status_update_timestamp", aws.ToTime(output.LastStatusUpdateTimestamp).Format(time.RFC3339))
+	} else {
+		d.Set("last_status_update_timestamp", nil)
+	}
+	d.Set("platform", output.Platform)
+	d.Set(names.AttrStatus, output.Status)
+
+	if err := d.Set(names.AttrTags, KeyValueTags(ctx, output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
+
+func findCoreDeviceByThingName(ctx context.Context, conn *greengrassv2.Client, thingName string) (*greengrassv2.GetCoreDeviceOutput, error) {
+	input := &greengrassv2.GetCoreDeviceInput{
+		CoreDeviceThingName: aws.String(thingName),
+	}
+
+	output, err := conn.GetCoreDevice(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package greengrassv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGreengrassV2CoreDeviceDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	// Core devices register themselves when the Greengrass nucleus is installed and can't be created by Terraform.
+	thingName := acctest.SkipIfEnvVarNotSet(t, "GREENGRASSV2_CORE_DEVICE_THING_NAME")
+	dataSourceName := "data.aws_greengrassv2_core_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GreengrassV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoreDeviceDataSourceConfig_basic(thingName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "architecture"),
+					resource.TestCheckResourceAttr(dataSourceName, "core_device_thing_name", thingName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "core_version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "last_status_update_timestamp"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "platform"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrStatus),
+				),
+			},
+		},
+	})
+}
+
+func testAccCoreDeviceDataSourceConfig_basic(thingName string) string {
+	return fmt.Sprintf(`
+data "aws_greengrassv2_core_device" "test" {
+  core_device_thing_name = %[1]q
+}
+`, thingName)
+}
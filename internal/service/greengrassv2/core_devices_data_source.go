@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package greengrassv2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/greengrassv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/greengrassv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_greengrassv2_core_devices", name="Core Devices")
+func dataSourceCoreDevices() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCoreDevicesRead,
+
+		Schema: map[string]*schema.Schema{
+			"core_device_thing_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"core_devices": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"core_device_thing_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_status_update_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.CoreDeviceStatus](),
+			},
+			"thing_group_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceCoreDevicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Client(ctx)
+
+	input := &greengrassv2.ListCoreDevicesInput{}
+
+	if v, ok := d.GetOk(names.AttrStatus); ok {
+		input.Status = awstypes.CoreDeviceStatus(v.(string))
+	}
+
+	if v, ok := d.GetOk("thing_group_arn"); ok {
+		input.ThingGroupArn = aws.String(v.(string))
+	}
+
+	coreDevices, err := findCoreDevices(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Greengrass V2 Core Devices: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	thingNames := make([]string, 0, len(coreDevices))
+	for _, v := range coreDevices {
+		thingNames = append(thingNames, aws.ToString(v.CoreDeviceThingName))
+	}
+	d.Set("core_device_thing_names", thingNames)
+	if err := d.Set("core_devices", flattenCoreDevices(coreDevices)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting core_devices: %s", err)
+	}
+
+	return diags
+}
+
+func findCoreDevices(ctx context.Context, conn *greengrassv2.Client, input *greengrassv2.ListCoreDevicesInput) ([]awstypes.CoreDevice, error) {
+	var output []awstypes.CoreDevice
+
+	pages := greengrassv2.NewListCoreDevicesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CoreDevices...)
+	}
+
+	return output, nil
+}
+
+func flattenCoreDevices(apiObjects []awstypes.CoreDevice) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"core_device_thing_name": aws.ToString(apiObject.CoreDeviceThingName),
+			names.AttrStatus:         string(apiObject.Status),
+		}
+
+		if v := apiObject.LastStatusUpdateTimestamp; v != nil {
+			tfMap["last_status_update_timestamp"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
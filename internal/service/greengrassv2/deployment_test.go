@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package greengrassv2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/greengrassv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/greengrassv2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgreengrassv2 "github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGreengrassV2Deployment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v greengrassv2.GetDeploymentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	componentName := fmt.Sprintf("com.example.%s", sdkacctest.RandString(10))
+	resourceName := "aws_greengrassv2_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GreengrassV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName, componentName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "component.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "component.*", map[string]string{
+						"component_name":    componentName,
+						"component_version": "1.0.0",
+					}),
+					resource.TestCheckResourceAttrSet(resourceName, "deployment_id"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "deployment_status", string(awstypes.DeploymentStatusActive)),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "revision_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrTargetARN, "aws_iot_thing_group.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2Deployment_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v greengrassv2.GetDeploymentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	componentName := fmt.Sprintf("com.example.%s", sdkacctest.RandString(10))
+	resourceName := "aws_greengrassv2_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GreengrassV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName, componentName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfgreengrassv2.ResourceDeployment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2Deployment_iotJobConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 greengrassv2.GetDeploymentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	componentName := fmt.Sprintf("com.example.%s", sdkacctest.RandString(10))
+	resourceName := "aws_greengrassv2_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GreengrassV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_iotJobConfiguration(rName, componentName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.0.component_update_policy.0.action", string(awstypes.DeploymentComponentUpdatePolicyActionSkipNotifyComponents)),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.0.component_update_policy.0.timeout_in_seconds", "120"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_policies.0.failure_handling_policy", string(awstypes.DeploymentFailureHandlingPolicyDoNothing)),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.abort_config.0.abort_criteria.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.abort_config.0.abort_criteria.0.action", string(awstypes.IoTJobAbortActionCancel)),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.abort_config.0.abort_criteria.0.failure_type", string(awstypes.IoTJobExecutionFailureTypeFailed)),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.job_executions_rollout_config.0.exponential_rate.0.base_rate_per_minute", "5"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.job_executions_rollout_config.0.maximum_per_minute", "50"),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.timeout_config.0.in_progress_timeout_in_minutes", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDeploymentConfig_iotJobConfiguration(rName, componentName, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v2),
+					testAccCheckDeploymentRevised(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "iot_job_configuration.0.timeout_config.0.in_progress_timeout_in_minutes", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGreengrassV2Deployment_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v greengrassv2.GetDeploymentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	componentName := fmt.Sprintf("com.example.%s", sdkacctest.RandString(10))
+	resourceName := "aws_greengrassv2_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GreengrassV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_tags1(rName, componentName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDeploymentConfig_tags2(rName, componentName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDeploymentConfig_tags1(rName, componentName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_greengrassv2_deployment" {
+				continue
+			}
+
+			_, err := tfgreengrassv2.FindDeploymentByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Greengrass V2 Deployment %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDeploymentExists(ctx context.Context, n string, v *greengrassv2.GetDeploymentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GreengrassV2Client(ctx)
+
+		output, err := tfgreengrassv2.FindDeploymentByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDeploymentRevised(before, after *greengrassv2.GetDeploymentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := *before.DeploymentId, *after.DeploymentId; before == after {
+			return fmt.Errorf("Greengrass V2 Deployment (%s) not revised", before)
+		}
+
+		return nil
+	}
+}
+
+func testAccDeploymentConfig_base(rName, componentName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_greengrassv2_component_version" "test" {
+  inline_recipe = jsonencode({
+    RecipeFormatVersion = "2020-01-25"
+    ComponentName       = %[2]q
+    ComponentVersion    = "1.0.0"
+    Manifests = [{
+      Platform = {
+        os = "linux"
+      }
+      Lifecycle = {
+        Run = "echo Hello"
+      }
+    }]
+  })
+}
+`, rName, componentName)
+}
+
+func testAccDeploymentConfig_basic(rName, componentName string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName, componentName), fmt.Sprintf(`
+resource "aws_greengrassv2_deployment" "test" {
+  deployment_name = %[1]q
+  target_arn      = aws_iot_thing_group.test.arn
+
+  component {
+    component_name    = aws_greengrassv2_component_version.test.component_name
+    component_version = aws_greengrassv2_component_version.test.component_version
+  }
+}
+`, rName))
+}
+
+func testAccDeploymentConfig_iotJobConfiguration(rName, componentName string, timeout int) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName, componentName), fmt.Sprintf(`
+resource "aws_greengrassv2_deployment" "test" {
+  deployment_name = %[1]q
+  target_arn      = aws_iot_thing_group.test.arn
+
+  component {
+    component_name    = aws_greengrassv2_component_version.test.component_name
+    component_version = aws_greengrassv2_component_version.test.component_version
+
+    configuration_update {
+      merge = jsonencode({
+        Message = "Hello"
+      })
+    }
+  }
+
+  deployment_policies {
+    failure_handling_policy = "DO_NOTHING"
+
+    component_update_policy {
+      action             = "SKIP_NOTIFY_COMPONENTS"
+      timeout_in_seconds = 120
+    }
+  }
+
+  iot_job_configuration {
+    abort_config {
+      abort_criteria {
+        action                        = "CANCEL"
+        failure_type                  = "FAILED"
+        min_number_of_executed_things = 1
+        threshold_percentage          = 50
+      }
+    }
+
+    job_executions_rollout_config {
+      maximum_per_minute = 50
+
+      exponential_rate {
+        base_rate_per_minute = 5
+        increment_factor     = 2
+
+        rate_increase_criteria {
+          number_of_succeeded_things = 5
+        }
+      }
+    }
+
+    timeout_config {
+      in_progress_timeout_in_minutes = %[2]d
+    }
+  }
+}
+`, rName, timeout))
+}
+
+func testAccDeploymentConfig_tags1(rName, componentName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName, componentName), fmt.Sprintf(`
+resource "aws_greengrassv2_deployment" "test" {
+  deployment_name = %[1]q
+  target_arn      = aws_iot_thing_group.test.arn
+
+  component {
+    component_name    = aws_greengrassv2_component_version.test.component_name
+    component_version = aws_greengrassv2_component_version.test.component_version
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccDeploymentConfig_tags2(rName, componentName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName, componentName), fmt.Sprintf(`
+resource "aws_greengrassv2_deployment" "test" {
+  deployment_name = %[1]q
+  target_arn      = aws_iot_thing_group.test.arn
+
+  component {
+    component_name    = aws_greengrassv2_component_version.test.component_name
+    component_version = aws_greengrassv2_component_version.test.component_version
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
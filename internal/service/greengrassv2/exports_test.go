@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package greengrassv2
+
+// Exports for use in tests only.
+var (
+	ResourceComponentVersion = resourceComponentVersion
+	ResourceDeployment       = resourceDeployment
+
+	FindComponentVersionByARN = findComponentVersionByARN
+	FindDeploymentByID        = findDeploymentByID
+	ValidComponentRecipe      = validComponentRecipe
+)
@@ -5,12 +5,22 @@ package iam
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
 	"log"
+	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -52,11 +62,18 @@ func resourceOpenIDConnectProvider() *schema.Resource {
 					ValidateFunc: validation.StringLenBetween(1, 255),
 				},
 			},
+			"compute_thumbprint": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"thumbprint_list"},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"thumbprint_list": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
+				Computed: true,
+				MaxItems: 5,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validation.StringLenBetween(40, 40),
@@ -71,7 +88,10 @@ func resourceOpenIDConnectProvider() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceOpenIDConnectProviderCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -80,10 +100,14 @@ func resourceOpenIDConnectProviderCreate(ctx context.Context, d *schema.Resource
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
 	input := &iam.CreateOpenIDConnectProviderInput{
-		ClientIDList:   flex.ExpandStringValueSet(d.Get("client_id_list").(*schema.Set)),
-		Tags:           getTagsIn(ctx),
-		ThumbprintList: flex.ExpandStringValueList(d.Get("thumbprint_list").([]interface{})),
-		Url:            aws.String(d.Get(names.AttrURL).(string)),
+		ClientIDList: flex.ExpandStringValueSet(d.Get("client_id_list").(*schema.Set)),
+		Tags:         getTagsIn(ctx),
+		Url:          aws.String(d.Get(names.AttrURL).(string)),
+	}
+
+	// If no thumbprints are specified, IAM retrieves the thumbprint of the top intermediate CA itself.
+	if v, ok := d.GetOk("thumbprint_list"); ok && len(v.([]interface{})) > 0 {
+		input.ThumbprintList = flex.ExpandStringValueList(v.([]interface{}))
 	}
 
 	output, err := conn.CreateOpenIDConnectProvider(ctx, input)
@@ -185,6 +209,125 @@ func resourceOpenIDConnectProviderDelete(ctx context.Context, d *schema.Resource
 	return diags
 }
 
+func resourceOpenIDConnectProviderCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("compute_thumbprint").(bool) {
+		return nil
+	}
+
+	if !d.NewValueKnown(names.AttrURL) {
+		return d.SetNewComputed("thumbprint_list")
+	}
+
+	// Only contact the identity provider when the thumbprint may need to change,
+	// so that plans for existing providers don't depend on its availability.
+	if d.Id() != "" && d.NewValueKnown("thumbprint_list") && !d.HasChanges(names.AttrURL, "compute_thumbprint", "thumbprint_list") {
+		if len(d.Get("thumbprint_list").([]interface{})) > 0 {
+			return nil
+		}
+	}
+
+	thumbprint, err := openIDConnectProviderThumbprint(ctx, meta.(*conns.AWSClient).HTTPClient(ctx), d.Get(names.AttrURL).(string))
+
+	if err != nil {
+		return fmt.Errorf("computing IAM OIDC Provider thumbprint: %w", err)
+	}
+
+	// Keep the current thumbprints while they still include the one served by the provider.
+	for _, v := range d.Get("thumbprint_list").([]interface{}) {
+		if strings.EqualFold(v.(string), thumbprint) {
+			return nil
+		}
+	}
+
+	return d.SetNew("thumbprint_list", []string{thumbprint})
+}
+
+// openIDConnectProviderThumbprint returns the hex-encoded SHA-1 hash of the top intermediate CA certificate
+// served by the host of the provider's JSON Web Key Set.
+// Requests are made with the provider's HTTP client so that proxy and custom CA bundle settings apply.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_providers_create_oidc_verify-thumbprint.html.
+func openIDConnectProviderThumbprint(ctx context.Context, client *http.Client, issuerURL string) (string, error) {
+	if client == nil {
+		client = cleanhttp.DefaultClient()
+	}
+
+	// IAM returns the URL without a scheme.
+	if !strings.Contains(issuerURL, "://") {
+		issuerURL = "https://" + issuerURL
+	}
+
+	configurationURL := strings.TrimSuffix(issuerURL, "/") + "/.well-known/openid-configuration"
+	response, err := openIDConnectProviderGet(ctx, client, configurationURL)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+
+	if err != nil {
+		return "", fmt.Errorf("reading response body (%s): %w", configurationURL, err)
+	}
+
+	var configuration struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+
+	if err := json.Unmarshal(body, &configuration); err != nil {
+		return "", fmt.Errorf("parsing OpenID configuration (%s): %w", configurationURL, err)
+	}
+
+	jwksURL, err := url.Parse(configuration.JWKSURI)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing JWKS URI (%s): %w", configuration.JWKSURI, err)
+	}
+
+	if jwksURL.Scheme != "https" || jwksURL.Hostname() == "" {
+		return "", fmt.Errorf("OpenID configuration (%s) does not contain a valid JWKS URI", configurationURL)
+	}
+
+	response, err = openIDConnectProviderGet(ctx, client, jwksURL.String())
+
+	if err != nil {
+		return "", err
+	}
+
+	defer response.Body.Close()
+
+	if response.TLS == nil || len(response.TLS.PeerCertificates) == 0 {
+		return "", fmt.Errorf("no certificates presented by %s", jwksURL.Host)
+	}
+
+	certificates := response.TLS.PeerCertificates
+	hash := sha1.Sum(certificates[len(certificates)-1].Raw)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+func openIDConnectProviderGet(ctx context.Context, client *http.Client, requestURL string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.Do(request)
+
+	if err != nil {
+		return nil, fmt.Errorf("HTTP GET (%s): %w", requestURL, err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		response.Body.Close()
+		return nil, fmt.Errorf("HTTP GET (%s): unexpected status %s", requestURL, response.Status)
+	}
+
+	return response, nil
+}
+
 func findOpenIDConnectProviderByARN(ctx context.Context, conn *iam.Client, arn string) (*iam.GetOpenIDConnectProviderOutput, error) {
 	input := &iam.GetOpenIDConnectProviderInput{
 		OpenIDConnectProviderArn: aws.String(arn),
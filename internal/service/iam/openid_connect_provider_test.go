@@ -5,11 +5,18 @@ package iam_test
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/hex"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -18,6 +25,44 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestOpenIDConnectProviderThumbprint(t *testing.T) {
+	t.Parallel()
+
+	var jwksURI string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			fmt.Fprintf(w, `{"jwks_uri": %q}`, jwksURI)
+		case "/keys":
+			fmt.Fprint(w, `{"keys": []}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	jwksURI = server.URL + "/keys"
+	hash := sha1.Sum(server.Certificate().Raw)
+	want := hex.EncodeToString(hash[:])
+
+	for _, issuerURL := range []string{server.URL, strings.TrimPrefix(server.URL, "https://") + "/"} {
+		// The test server's certificate is only trusted by its own client.
+		got, err := tfiam.OpenIDConnectProviderThumbprint(context.Background(), server.Client(), issuerURL)
+
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", issuerURL, err)
+		}
+
+		if got != want {
+			t.Errorf("%s: got %s, want %s", issuerURL, got, want)
+		}
+	}
+
+	if _, err := tfiam.OpenIDConnectProviderThumbprint(context.Background(), http.DefaultClient, server.URL); err == nil {
+		t.Error("expected error with a client that doesn't trust the server's certificate")
+	}
+}
+
 func TestAccIAMOpenIDConnectProvider_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rString := sdkacctest.RandString(5)
@@ -118,6 +163,50 @@ func TestAccIAMOpenIDConnectProvider_clientIDListOrder(t *testing.T) {
 	})
 }
 
+func TestAccIAMOpenIDConnectProvider_thumbprint(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_iam_openid_connect_provider.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpenIDConnectProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenIDConnectProviderConfig_thumbprintOmitted,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpenIDConnectProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "thumbprint_list.#", acctest.CtOne),
+				),
+			},
+			{
+				Config: testAccOpenIDConnectProviderConfig_computeThumbprint,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpenIDConnectProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "compute_thumbprint", "true"),
+					resource.TestMatchResourceAttr(resourceName, "thumbprint_list.0", regexache.MustCompile(`^[0-9a-f]{40}$`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"compute_thumbprint"},
+			},
+			{
+				// The URL in state has no scheme and the thumbprint isn't recomputed without changes.
+				Config: testAccOpenIDConnectProviderConfig_computeThumbprint,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckOpenIDConnectProviderDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
@@ -222,3 +311,25 @@ resource "aws_iam_openid_connect_provider" "test" {
 }
 `, rName)
 }
+
+const testAccOpenIDConnectProviderConfig_thumbprintOmitted = `
+resource "aws_iam_openid_connect_provider" "test" {
+  url = "https://token.actions.githubusercontent.com"
+
+  client_id_list = [
+    "sts.amazonaws.com",
+  ]
+}
+`
+
+const testAccOpenIDConnectProviderConfig_computeThumbprint = `
+resource "aws_iam_openid_connect_provider" "test" {
+  url = "https://token.actions.githubusercontent.com"
+
+  client_id_list = [
+    "sts.amazonaws.com",
+  ]
+
+  compute_thumbprint = true
+}
+`
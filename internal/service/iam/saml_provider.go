@@ -5,8 +5,12 @@ package iam
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
@@ -14,7 +18,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -54,8 +60,20 @@ func resourceSAMLProvider() *schema.Resource {
 			},
 			"saml_metadata_document": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(1000, 10000000),
+				ExactlyOneOf: []string{"saml_metadata_document", "saml_metadata_document_url"},
+			},
+			"saml_metadata_document_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"saml_metadata_document_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+				ExactlyOneOf: []string{"saml_metadata_document", "saml_metadata_document_url"},
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -65,7 +83,10 @@ func resourceSAMLProvider() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceSAMLProviderCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -75,9 +96,10 @@ func resourceSAMLProviderCreate(ctx context.Context, d *schema.ResourceData, met
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
 	name := d.Get(names.AttrName).(string)
+	document := d.Get("saml_metadata_document").(string)
 	input := &iam.CreateSAMLProviderInput{
 		Name:                 aws.String(name),
-		SAMLMetadataDocument: aws.String(d.Get("saml_metadata_document").(string)),
+		SAMLMetadataDocument: aws.String(document),
 		Tags:                 getTagsIn(ctx),
 	}
 
@@ -96,6 +118,7 @@ func resourceSAMLProviderCreate(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	d.SetId(aws.ToString(output.SAMLProviderArn))
+	d.Set("saml_metadata_document_hash", samlMetadataDocumentHash(document))
 
 	// For partitions not supporting tag-on-create, attempt tag after create.
 	if tags := getTagsIn(ctx); input.Tags == nil && len(tags) > 0 {
@@ -140,6 +163,11 @@ func resourceSAMLProviderRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set(names.AttrARN, d.Id())
 	d.Set(names.AttrName, name)
 	d.Set("saml_metadata_document", output.SAMLMetadataDocument)
+	// The hash is of the document that was sent to IAM, which needn't match the one IAM returns byte for byte.
+	// Only fall back to hashing the returned document when there is no hash yet, e.g. after import.
+	if d.Get("saml_metadata_document_hash").(string) == "" {
+		d.Set("saml_metadata_document_hash", samlMetadataDocumentHash(aws.ToString(output.SAMLMetadataDocument)))
+	}
 	if output.ValidUntil != nil {
 		d.Set("valid_until", aws.ToTime(output.ValidUntil).Format(time.RFC3339))
 	} else {
@@ -156,10 +184,11 @@ func resourceSAMLProviderUpdate(ctx context.Context, d *schema.ResourceData, met
 
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChange("saml_metadata_document") {
+		document := d.Get("saml_metadata_document").(string)
 		input := &iam.UpdateSAMLProviderInput{
 			SAMLProviderArn:      aws.String(d.Id()),
-			SAMLMetadataDocument: aws.String(d.Get("saml_metadata_document").(string)),
+			SAMLMetadataDocument: aws.String(document),
 		}
 
 		_, err := conn.UpdateSAMLProvider(ctx, input)
@@ -167,6 +196,8 @@ func resourceSAMLProviderUpdate(ctx context.Context, d *schema.ResourceData, met
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating IAM SAML Provider (%s): %s", d.Id(), err)
 		}
+
+		d.Set("saml_metadata_document_hash", samlMetadataDocumentHash(document))
 	}
 
 	return append(diags, resourceSAMLProviderRead(ctx, d, meta)...)
@@ -193,6 +224,90 @@ func resourceSAMLProviderDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
+func resourceSAMLProviderCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("saml_metadata_document_url") {
+		if err := d.SetNewComputed("saml_metadata_document"); err != nil {
+			return err
+		}
+
+		return d.SetNewComputed("saml_metadata_document_hash")
+	}
+
+	if v, ok := d.GetOk("saml_metadata_document_url"); ok {
+		// Fetch the current metadata so that IdP certificate rotations show up as a diff.
+		document, err := readSAMLMetadataDocument(ctx, meta.(*conns.AWSClient).HTTPClient(ctx), v.(string))
+
+		if err != nil {
+			return err
+		}
+
+		if hash := samlMetadataDocumentHash(document); hash != d.Get("saml_metadata_document_hash").(string) {
+			if err := d.SetNew("saml_metadata_document", document); err != nil {
+				return err
+			}
+
+			return d.SetNew("saml_metadata_document_hash", hash)
+		}
+
+		return nil
+	}
+
+	if d.HasChange("saml_metadata_document") {
+		if !d.NewValueKnown("saml_metadata_document") {
+			return d.SetNewComputed("saml_metadata_document_hash")
+		}
+
+		return d.SetNew("saml_metadata_document_hash", samlMetadataDocumentHash(d.Get("saml_metadata_document").(string)))
+	}
+
+	return nil
+}
+
+// readSAMLMetadataDocument fetches a SAML metadata document.
+// The provider's HTTP client is used so that proxy and custom CA bundle settings apply.
+func readSAMLMetadataDocument(ctx context.Context, client *http.Client, url string) (string, error) {
+	if client == nil {
+		client = cleanhttp.DefaultClient()
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+
+	if err != nil {
+		return "", err
+	}
+
+	response, err := client.Do(request)
+
+	if err != nil {
+		return "", fmt.Errorf("HTTP GET (%s): %w", url, err)
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("HTTP GET (%s): unexpected status %s", url, response.Status)
+	}
+
+	// SAML metadata documents are limited to 10,000,000 characters.
+	body, err := io.ReadAll(io.LimitReader(response.Body, 10000000+1))
+
+	if err != nil {
+		return "", fmt.Errorf("reading response body (%s): %w", url, err)
+	}
+
+	if n := len(body); n < 1000 || n > 10000000 {
+		return "", fmt.Errorf("SAML metadata document (%s) length (%d) must be between 1000 and 10000000", url, n)
+	}
+
+	return string(body), nil
+}
+
+func samlMetadataDocumentHash(document string) string {
+	hash := sha256.Sum256([]byte(document))
+
+	return hex.EncodeToString(hash[:])
+}
+
 func findSAMLProviderByARN(ctx context.Context, conn *iam.Client, arn string) (*iam.GetSAMLProviderOutput, error) {
 	input := &iam.GetSAMLProviderInput{
 		SAMLProviderArn: aws.String(arn),
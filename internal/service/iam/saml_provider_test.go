@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -38,6 +40,7 @@ func TestAccIAMSAMLProvider_basic(t *testing.T) {
 					acctest.CheckResourceAttrGlobalARN(resourceName, names.AttrARN, "iam", fmt.Sprintf("saml-provider/%s", rName)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttrSet(resourceName, "saml_metadata_document"),
+					resource.TestMatchResourceAttr(resourceName, "saml_metadata_document_hash", regexache.MustCompile(`^[0-9a-f]{64}$`)),
 					resource.TestCheckResourceAttrSet(resourceName, "valid_until"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 				),
@@ -50,10 +53,61 @@ func TestAccIAMSAMLProvider_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "saml_metadata_document"),
 				),
 			},
+			{
+				Config: testAccSAMLProviderConfig_update(rName, idpEntityIdModified),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"saml_metadata_document_hash"},
+			},
+		},
+	})
+}
+
+func TestAccIAMSAMLProvider_metadataDocumentURL(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "IAM_SAML_METADATA_DOCUMENT_URL"
+	url := acctest.SkipIfEnvVarNotSet(t, key)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_saml_provider.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSAMLProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSAMLProviderConfig_metadataDocumentURL(rName, url),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSAMLProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "saml_metadata_document"),
+					resource.TestMatchResourceAttr(resourceName, "saml_metadata_document_hash", regexache.MustCompile(`^[0-9a-f]{64}$`)),
+					resource.TestCheckResourceAttr(resourceName, "saml_metadata_document_url", url),
+				),
+			},
+			{
+				// Re-fetching an unchanged document must not produce a diff.
+				Config: testAccSAMLProviderConfig_metadataDocumentURL(rName, url),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				// The hash is of the fetched document, which IAM may not return byte for byte.
+				ImportStateVerifyIgnore: []string{"saml_metadata_document_hash", "saml_metadata_document_url"},
 			},
 		},
 	})
@@ -192,6 +246,15 @@ resource "aws_iam_saml_provider" "test" {
 `, rName, idpEntityIdModified)
 }
 
+func testAccSAMLProviderConfig_metadataDocumentURL(rName, url string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_saml_provider" "test" {
+  name                       = %[1]q
+  saml_metadata_document_url = %[2]q
+}
+`, rName, url)
+}
+
 func testAccSAMLProviderConfig_tags1(rName, idpEntityId, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_saml_provider" "test" {
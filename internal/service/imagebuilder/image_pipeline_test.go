@@ -644,6 +644,47 @@ func TestAccImageBuilderImagePipeline_tags(t *testing.T) {
 	})
 }
 
+func TestAccImageBuilderImagePipeline_workflows(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_image_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ImageBuilderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImagePipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagePipelineConfig_workflows(rName, "ABORT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagePipelineExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role", "aws_iam_role.test_execute", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "workflow.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(resourceName, "workflow.0.workflow_arn", "aws_imagebuilder_workflow.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "workflow.0.on_failure", "ABORT"),
+					resource.TestCheckResourceAttr(resourceName, "workflow.0.parameter.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "workflow.0.parameter.0.name", "waitForActionAtEnd"),
+					resource.TestCheckResourceAttr(resourceName, "workflow.0.parameter.0.value", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccImagePipelineConfig_workflows(rName, "CONTINUE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagePipelineExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "workflow.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "workflow.0.on_failure", "CONTINUE"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckImagePipelineDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ImageBuilderConn(ctx)
@@ -1105,3 +1146,89 @@ resource "aws_imagebuilder_image_pipeline" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2))
 }
+
+func testAccImagePipelineConfig_workflows(rName, onFailure string) string {
+	return acctest.ConfigCompose(testAccImagePipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_role" "test_execute" {
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "imagebuilder.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+  name = join("-", [%[1]q, "execute"])
+}
+
+data "aws_iam_policy" "AWSServiceRoleForImageBuilder" {
+  arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/aws-service-role/AWSServiceRoleForImageBuilder"
+}
+
+resource "aws_iam_policy" "test_execute" {
+  name   = join("-", [%[1]q, "execute"])
+  policy = data.aws_iam_policy.AWSServiceRoleForImageBuilder.policy
+}
+
+resource "aws_iam_role_policy_attachment" "test_execute" {
+  policy_arn = aws_iam_policy.test_execute.arn
+  role       = aws_iam_role.test_execute.name
+}
+
+resource "aws_imagebuilder_workflow" "test" {
+  name    = %[1]q
+  version = "1.0.0"
+  type    = "TEST"
+
+  data = <<-EOT
+  name: test-image
+  description: Workflow to test an image
+  schemaVersion: 1.0
+
+  parameters:
+    - name: waitForActionAtEnd
+      type: boolean
+
+  steps:
+    - name: LaunchTestInstance
+      action: LaunchInstance
+      onFailure: Abort
+      inputs:
+        waitFor: "ssmAgent"
+
+    - name: TerminateTestInstance
+      action: TerminateInstance
+      onFailure: Continue
+      inputs:
+        instanceId.$: "$.stepOutputs.LaunchTestInstance.instanceId"
+
+    - name: WaitForActionAtEnd
+      action: WaitForAction
+      if:
+        booleanEquals: true
+        value: "$.parameters.waitForActionAtEnd"
+  EOT
+}
+
+resource "aws_imagebuilder_image_pipeline" "test" {
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.test.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+  name                             = %[1]q
+  execution_role                   = aws_iam_role.test_execute.arn
+
+  workflow {
+    workflow_arn = aws_imagebuilder_workflow.test.arn
+    on_failure   = %[2]q
+
+    parameter {
+      name  = "waitForActionAtEnd"
+      value = "false"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test_execute]
+}
+`, rName, onFailure))
+}
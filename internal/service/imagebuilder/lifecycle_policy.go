@@ -0,0 +1,834 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package imagebuilder
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_imagebuilder_lifecycle_policy", name="Lifecycle Policy")
+// @Tags(identifierAttribute="id")
+func ResourceLifecyclePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLifecyclePolicyCreate,
+		ReadWithoutTimeout:   resourceLifecyclePolicyRead,
+		UpdateWithoutTimeout: resourceLifecyclePolicyUpdate,
+		DeleteWithoutTimeout: resourceLifecyclePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"execution_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[-_A-Za-z0-9]{1,128}$`), "valid name must be provided"),
+			},
+			"policy_detail": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 3,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"include_resources": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"amis": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"containers": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"snapshots": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+											},
+										},
+									},
+									names.AttrType: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(imagebuilder.LifecyclePolicyDetailActionType_Values(), false),
+									},
+								},
+							},
+						},
+						"exclusion_rules": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"amis": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"is_public": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"last_launched": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															names.AttrUnit: {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(imagebuilder.LifecyclePolicyTimeUnit_Values(), false),
+															},
+															names.AttrValue: {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ValidateFunc: validation.IntBetween(1, 365),
+															},
+														},
+													},
+												},
+												"regions": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: verify.ValidRegionName,
+													},
+												},
+												"shared_accounts": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: verify.ValidAccountID,
+													},
+												},
+												"tag_map": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"tag_map": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						names.AttrFilter: {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"retain_at_least": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 10),
+									},
+									names.AttrType: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(imagebuilder.LifecyclePolicyDetailFilterType_Values(), false),
+									},
+									names.AttrUnit: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(imagebuilder.LifecyclePolicyTimeUnit_Values(), false),
+									},
+									names.AttrValue: {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 1000),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"resource_selection": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recipe": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 50,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrName: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[-_A-Za-z0-9{][-_A-Za-z0-9\s:{}]+[-_A-Za-z0-9}]$`), "valid recipe name must be provided"),
+									},
+									"semantic_version": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9]+\.[0-9]+\.[0-9]+$`), "valid semantic version must be provided"),
+									},
+								},
+							},
+						},
+						"tag_map": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			names.AttrResourceType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(imagebuilder.LifecyclePolicyResourceType_Values(), false),
+			},
+			names.AttrStatus: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      imagebuilder.LifecyclePolicyStatusEnabled,
+				ValidateFunc: validation.StringInSlice(imagebuilder.LifecyclePolicyStatus_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceLifecyclePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ImageBuilderConn(ctx)
+
+	input := &imagebuilder.CreateLifecyclePolicyInput{
+		ClientToken:   aws.String(id.UniqueId()),
+		ExecutionRole: aws.String(d.Get("execution_role").(string)),
+		Name:          aws.String(d.Get(names.AttrName).(string)),
+		ResourceType:  aws.String(d.Get(names.AttrResourceType).(string)),
+		Status:        aws.String(d.Get(names.AttrStatus).(string)),
+		Tags:          getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("policy_detail"); ok && len(v.([]interface{})) > 0 {
+		input.PolicyDetails = expandLifecyclePolicyDetails(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("resource_selection"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ResourceSelection = expandLifecyclePolicyResourceSelection(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateLifecyclePolicyWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Image Builder Lifecycle Policy: %s", err)
+	}
+
+	if output == nil {
+		return sdkdiag.AppendErrorf(diags, "creating Image Builder Lifecycle Policy: empty response")
+	}
+
+	d.SetId(aws.StringValue(output.LifecyclePolicyArn))
+
+	return append(diags, resourceLifecyclePolicyRead(ctx, d, meta)...)
+}
+
+func resourceLifecyclePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ImageBuilderConn(ctx)
+
+	input := &imagebuilder.GetLifecyclePolicyInput{
+		LifecyclePolicyArn: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetLifecyclePolicyWithContext(ctx, input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Image Builder Lifecycle Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Image Builder Lifecycle Policy (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.LifecyclePolicy == nil {
+		return sdkdiag.AppendErrorf(diags, "getting Image Builder Lifecycle Policy (%s): empty response", d.Id())
+	}
+
+	lifecyclePolicy := output.LifecyclePolicy
+
+	d.Set(names.AttrARN, lifecyclePolicy.Arn)
+	d.Set(names.AttrDescription, lifecyclePolicy.Description)
+	d.Set("execution_role", lifecyclePolicy.ExecutionRole)
+	d.Set(names.AttrName, lifecyclePolicy.Name)
+	if err := d.Set("policy_detail", flattenLifecyclePolicyDetails(lifecyclePolicy.PolicyDetails)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting policy_detail: %s", err)
+	}
+	if lifecyclePolicy.ResourceSelection != nil {
+		if err := d.Set("resource_selection", []interface{}{flattenLifecyclePolicyResourceSelection(lifecyclePolicy.ResourceSelection)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting resource_selection: %s", err)
+		}
+	} else {
+		d.Set("resource_selection", nil)
+	}
+	d.Set(names.AttrResourceType, lifecyclePolicy.ResourceType)
+	d.Set(names.AttrStatus, lifecyclePolicy.Status)
+
+	setTagsOut(ctx, lifecyclePolicy.Tags)
+
+	return diags
+}
+
+func resourceLifecyclePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ImageBuilderConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &imagebuilder.UpdateLifecyclePolicyInput{
+			ClientToken:        aws.String(id.UniqueId()),
+			ExecutionRole:      aws.String(d.Get("execution_role").(string)),
+			LifecyclePolicyArn: aws.String(d.Id()),
+			ResourceType:       aws.String(d.Get(names.AttrResourceType).(string)),
+			Status:             aws.String(d.Get(names.AttrStatus).(string)),
+		}
+
+		if v, ok := d.GetOk(names.AttrDescription); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("policy_detail"); ok && len(v.([]interface{})) > 0 {
+			input.PolicyDetails = expandLifecyclePolicyDetails(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("resource_selection"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ResourceSelection = expandLifecyclePolicyResourceSelection(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err := conn.UpdateLifecyclePolicyWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Image Builder Lifecycle Policy (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceLifecyclePolicyRead(ctx, d, meta)...)
+}
+
+func resourceLifecyclePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ImageBuilderConn(ctx)
+
+	input := &imagebuilder.DeleteLifecyclePolicyInput{
+		LifecyclePolicyArn: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteLifecyclePolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Image Builder Lifecycle Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandLifecyclePolicyDetails(tfList []interface{}) []*imagebuilder.LifecyclePolicyDetail {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*imagebuilder.LifecyclePolicyDetail
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandLifecyclePolicyDetail(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandLifecyclePolicyDetail(tfMap map[string]interface{}) *imagebuilder.LifecyclePolicyDetail {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.LifecyclePolicyDetail{}
+
+	if v, ok := tfMap[names.AttrAction].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Action = expandLifecyclePolicyDetailAction(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["exclusion_rules"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ExclusionRules = expandLifecyclePolicyDetailExclusionRules(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap[names.AttrFilter].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Filter = expandLifecyclePolicyDetailFilter(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandLifecyclePolicyDetailAction(tfMap map[string]interface{}) *imagebuilder.LifecyclePolicyDetailAction {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.LifecyclePolicyDetailAction{}
+
+	if v, ok := tfMap["include_resources"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.IncludeResources = expandLifecyclePolicyDetailActionIncludeResources(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap[names.AttrType].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLifecyclePolicyDetailActionIncludeResources(tfMap map[string]interface{}) *imagebuilder.LifecyclePolicyDetailActionIncludeResources {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.LifecyclePolicyDetailActionIncludeResources{}
+
+	if v, ok := tfMap["amis"].(bool); ok {
+		apiObject.Amis = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["containers"].(bool); ok {
+		apiObject.Containers = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["snapshots"].(bool); ok {
+		apiObject.Snapshots = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandLifecyclePolicyDetailExclusionRules(tfMap map[string]interface{}) *imagebuilder.LifecyclePolicyDetailExclusionRules {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.LifecyclePolicyDetailExclusionRules{}
+
+	if v, ok := tfMap["amis"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Amis = expandLifecyclePolicyDetailExclusionRulesAMIs(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["tag_map"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.TagMap = flex.ExpandStringMap(v)
+	}
+
+	return apiObject
+}
+
+func expandLifecyclePolicyDetailExclusionRulesAMIs(tfMap map[string]interface{}) *imagebuilder.LifecyclePolicyDetailExclusionRulesAmis {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.LifecyclePolicyDetailExclusionRulesAmis{}
+
+	if v, ok := tfMap["is_public"].(bool); ok {
+		apiObject.IsPublic = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["last_launched"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LastLaunched = expandLifecyclePolicyDetailExclusionRulesAMIsLastLaunched(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["regions"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Regions = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["shared_accounts"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SharedAccounts = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["tag_map"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.TagMap = flex.ExpandStringMap(v)
+	}
+
+	return apiObject
+}
+
+func expandLifecyclePolicyDetailExclusionRulesAMIsLastLaunched(tfMap map[string]interface{}) *imagebuilder.LifecyclePolicyDetailExclusionRulesAmisLastLaunched {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.LifecyclePolicyDetailExclusionRulesAmisLastLaunched{}
+
+	if v, ok := tfMap[names.AttrUnit].(string); ok && v != "" {
+		apiObject.Unit = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrValue].(int); ok && v != 0 {
+		apiObject.Value = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandLifecyclePolicyDetailFilter(tfMap map[string]interface{}) *imagebuilder.LifecyclePolicyDetailFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.LifecyclePolicyDetailFilter{}
+
+	if v, ok := tfMap["retain_at_least"].(int); ok && v != 0 {
+		apiObject.RetainAtLeast = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap[names.AttrType].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrUnit].(string); ok && v != "" {
+		apiObject.Unit = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrValue].(int); ok && v != 0 {
+		apiObject.Value = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandLifecyclePolicyResourceSelection(tfMap map[string]interface{}) *imagebuilder.LifecyclePolicyResourceSelection {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &imagebuilder.LifecyclePolicyResourceSelection{}
+
+	if v, ok := tfMap["recipe"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Recipes = expandLifecyclePolicyResourceSelectionRecipes(v.List())
+	}
+
+	if v, ok := tfMap["tag_map"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.TagMap = flex.ExpandStringMap(v)
+	}
+
+	return apiObject
+}
+
+func expandLifecyclePolicyResourceSelectionRecipes(tfList []interface{}) []*imagebuilder.LifecyclePolicyResourceSelectionRecipe {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*imagebuilder.LifecyclePolicyResourceSelectionRecipe
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &imagebuilder.LifecyclePolicyResourceSelectionRecipe{}
+
+		if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["semantic_version"].(string); ok && v != "" {
+			apiObject.SemanticVersion = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLifecyclePolicyDetails(apiObjects []*imagebuilder.LifecyclePolicyDetail) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenLifecyclePolicyDetail(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenLifecyclePolicyDetail(apiObject *imagebuilder.LifecyclePolicyDetail) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Action; v != nil {
+		tfMap[names.AttrAction] = []interface{}{flattenLifecyclePolicyDetailAction(v)}
+	}
+
+	if v := apiObject.ExclusionRules; v != nil {
+		tfMap["exclusion_rules"] = []interface{}{flattenLifecyclePolicyDetailExclusionRules(v)}
+	}
+
+	if v := apiObject.Filter; v != nil {
+		tfMap[names.AttrFilter] = []interface{}{flattenLifecyclePolicyDetailFilter(v)}
+	}
+
+	return tfMap
+}
+
+func flattenLifecyclePolicyDetailAction(apiObject *imagebuilder.LifecyclePolicyDetailAction) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.IncludeResources; v != nil {
+		tfMap["include_resources"] = []interface{}{flattenLifecyclePolicyDetailActionIncludeResources(v)}
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap[names.AttrType] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenLifecyclePolicyDetailActionIncludeResources(apiObject *imagebuilder.LifecyclePolicyDetailActionIncludeResources) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Amis; v != nil {
+		tfMap["amis"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.Containers; v != nil {
+		tfMap["containers"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.Snapshots; v != nil {
+		tfMap["snapshots"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenLifecyclePolicyDetailExclusionRules(apiObject *imagebuilder.LifecyclePolicyDetailExclusionRules) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Amis; v != nil {
+		tfMap["amis"] = []interface{}{flattenLifecyclePolicyDetailExclusionRulesAMIs(v)}
+	}
+
+	if v := apiObject.TagMap; v != nil {
+		tfMap["tag_map"] = aws.StringValueMap(v)
+	}
+
+	return tfMap
+}
+
+func flattenLifecyclePolicyDetailExclusionRulesAMIs(apiObject *imagebuilder.LifecyclePolicyDetailExclusionRulesAmis) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.IsPublic; v != nil {
+		tfMap["is_public"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.LastLaunched; v != nil {
+		tfMap["last_launched"] = []interface{}{flattenLifecyclePolicyDetailExclusionRulesAMIsLastLaunched(v)}
+	}
+
+	if v := apiObject.Regions; v != nil {
+		tfMap["regions"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.SharedAccounts; v != nil {
+		tfMap["shared_accounts"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.TagMap; v != nil {
+		tfMap["tag_map"] = aws.StringValueMap(v)
+	}
+
+	return tfMap
+}
+
+func flattenLifecyclePolicyDetailExclusionRulesAMIsLastLaunched(apiObject *imagebuilder.LifecyclePolicyDetailExclusionRulesAmisLastLaunched) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Unit; v != nil {
+		tfMap[names.AttrUnit] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Value; v != nil {
+		tfMap[names.AttrValue] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenLifecyclePolicyDetailFilter(apiObject *imagebuilder.LifecyclePolicyDetailFilter) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.RetainAtLeast; v != nil {
+		tfMap["retain_at_least"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap[names.AttrType] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Unit; v != nil {
+		tfMap[names.AttrUnit] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Value; v != nil {
+		tfMap[names.AttrValue] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenLifecyclePolicyResourceSelection(apiObject *imagebuilder.LifecyclePolicyResourceSelection) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Recipes; v != nil {
+		tfMap["recipe"] = flattenLifecyclePolicyResourceSelectionRecipes(v)
+	}
+
+	if v := apiObject.TagMap; v != nil {
+		tfMap["tag_map"] = aws.StringValueMap(v)
+	}
+
+	return tfMap
+}
+
+func flattenLifecyclePolicyResourceSelectionRecipes(apiObjects []*imagebuilder.LifecyclePolicyResourceSelectionRecipe) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Name; v != nil {
+			tfMap[names.AttrName] = aws.StringValue(v)
+		}
+
+		if v := apiObject.SemanticVersion; v != nil {
+			tfMap["semantic_version"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
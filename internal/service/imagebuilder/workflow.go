@@ -5,6 +5,7 @@ package imagebuilder
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/YakDriver/regexache"
@@ -20,6 +21,7 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"gopkg.in/yaml.v2"
 )
 
 // @SDKResource("aws_imagebuilder_workflow", name="Workflow")
@@ -51,7 +53,10 @@ func ResourceWorkflow() *schema.Resource {
 				Computed:     true,
 				ForceNew:     true,
 				ExactlyOneOf: []string{"data", names.AttrURI},
-				ValidateFunc: validation.StringLenBetween(1, 16000),
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 16000),
+					validWorkflowData,
+				),
 			},
 			"date_created": {
 				Type:     schema.TypeString,
@@ -168,6 +173,10 @@ func resourceWorkflowRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diags
 	}
 
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Image Builder Workflow (%s): %s", d.Id(), err)
+	}
+
 	if output == nil || output.Workflow == nil {
 		return sdkdiag.AppendErrorf(diags, "getting Image Builder Workflow (%s): empty response", d.Id())
 	}
@@ -219,3 +228,48 @@ func resourceWorkflowDelete(ctx context.Context, d *schema.ResourceData, meta in
 
 	return diags
 }
+
+// validWorkflowData performs client-side validation of the steps in a workflow document.
+// Each step must have a unique name and an action.
+func validWorkflowData(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok || value == "" {
+		return
+	}
+
+	var document struct {
+		Steps []struct {
+			Action string `yaml:"action"`
+			Name   string `yaml:"name"`
+		} `yaml:"steps"`
+	}
+
+	if err := yaml.Unmarshal([]byte(value), &document); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid workflow document: %w", k, err))
+		return
+	}
+
+	if len(document.Steps) == 0 {
+		errors = append(errors, fmt.Errorf("%q must contain at least one step", k))
+		return
+	}
+
+	stepNames := make(map[string]struct{})
+	for i, step := range document.Steps {
+		if step.Name == "" {
+			errors = append(errors, fmt.Errorf("%q step %d must have a name", k, i))
+			continue
+		}
+
+		if _, ok := stepNames[step.Name]; ok {
+			errors = append(errors, fmt.Errorf("%q step name %q is not unique", k, step.Name))
+		}
+		stepNames[step.Name] = struct{}{}
+
+		if step.Action == "" {
+			errors = append(errors, fmt.Errorf("%q step %q must have an action", k, step.Name))
+		}
+	}
+
+	return
+}
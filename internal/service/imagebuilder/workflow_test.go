@@ -21,6 +21,72 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestValidWorkflowData(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		data     string
+		expected int
+	}{
+		{
+			name: "valid",
+			data: `
+schemaVersion: 1.0
+steps:
+  - name: LaunchTestInstance
+    action: LaunchInstance
+  - name: TerminateTestInstance
+    action: TerminateInstance
+`,
+			expected: 0,
+		},
+		{
+			name:     "invalid YAML",
+			data:     "steps: [",
+			expected: 1,
+		},
+		{
+			name:     "no steps",
+			data:     "schemaVersion: 1.0",
+			expected: 1,
+		},
+		{
+			name: "missing name and action",
+			data: `
+steps:
+  - action: LaunchInstance
+  - name: TerminateTestInstance
+`,
+			expected: 2,
+		},
+		{
+			name: "duplicate step name",
+			data: `
+steps:
+  - name: LaunchTestInstance
+    action: LaunchInstance
+  - name: LaunchTestInstance
+    action: TerminateInstance
+`,
+			expected: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := tfimagebuilder.ValidWorkflowData(testCase.data, "data")
+
+			if got, want := len(errs), testCase.expected; got != want {
+				t.Errorf("got %d errors, expected %d: %v", got, want, errs)
+			}
+		})
+	}
+}
+
 func TestAccImageBuilderWorkflow_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -49,18 +49,23 @@ func resourceMonitor() *schema.Resource {
 			"health_events_config": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"availability_local_health_events_config": localHealthEventsConfigSchema(),
 						"availability_score_threshold": {
-							Type:     schema.TypeFloat,
-							Optional: true,
-							Default:  95.0,
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Default:      95.0,
+							ValidateFunc: validation.FloatBetween(0, 100),
 						},
+						"performance_local_health_events_config": localHealthEventsConfigSchema(),
 						"performance_score_threshold": {
-							Type:     schema.TypeFloat,
-							Optional: true,
-							Default:  95.0,
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Default:      95.0,
+							ValidateFunc: validation.FloatBetween(0, 100),
 						},
 					},
 				},
@@ -101,9 +106,14 @@ func resourceMonitor() *schema.Resource {
 			"max_city_networks_to_monitor": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.IntBetween(1, 500000),
 				AtLeastOneOf: []string{"traffic_percentage_to_monitor", "max_city_networks_to_monitor"},
 			},
+			"metrics_namespace": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"monitor_name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -132,6 +142,7 @@ func resourceMonitor() *schema.Resource {
 			"traffic_percentage_to_monitor": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.IntBetween(1, 100),
 				AtLeastOneOf: []string{"traffic_percentage_to_monitor", "max_city_networks_to_monitor"},
 			},
@@ -140,6 +151,37 @@ func resourceMonitor() *schema.Resource {
 	}
 }
 
+func localHealthEventsConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"health_score_threshold": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.FloatBetween(0, 100),
+				},
+				"min_traffic_impact": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.FloatBetween(0, 100),
+				},
+				names.AttrStatus: {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Computed:         true,
+					ValidateDiagFunc: enum.Validate[types.LocalHealthEventsConfigStatus](),
+				},
+			},
+		},
+	}
+}
+
 const (
 	errCodeResourceNotFoundException = "ResourceNotFoundException"
 )
@@ -227,6 +269,8 @@ func resourceMonitorRead(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	d.Set(names.AttrARN, monitor.MonitorArn)
+	// Internet Monitor publishes each monitor's metrics to its own CloudWatch namespace.
+	d.Set("metrics_namespace", "AWS/InternetMonitor/"+aws.ToString(monitor.MonitorName))
 	if err := d.Set("health_events_config", flattenHealthEventsConfig(monitor.HealthEventsConfig)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting health_events_config: %s", err)
 	}
@@ -262,8 +306,11 @@ func resourceMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.InternetMeasurementsLogDelivery = expandInternetMeasurementsLogDelivery(d.Get("internet_measurements_log_delivery").([]interface{}))
 		}
 
+		// Zero is not a valid traffic limit. A limit that is no longer configured is left unchanged.
 		if d.HasChange("max_city_networks_to_monitor") {
-			input.MaxCityNetworksToMonitor = aws.Int32(int32(d.Get("max_city_networks_to_monitor").(int)))
+			if v := d.Get("max_city_networks_to_monitor").(int); v > 0 {
+				input.MaxCityNetworksToMonitor = aws.Int32(int32(v))
+			}
 		}
 
 		if d.HasChange(names.AttrResources) {
@@ -283,7 +330,9 @@ func resourceMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if d.HasChange("traffic_percentage_to_monitor") {
-			input.TrafficPercentageToMonitor = aws.Int32(int32(d.Get("traffic_percentage_to_monitor").(int)))
+			if v := d.Get("traffic_percentage_to_monitor").(int); v > 0 {
+				input.TrafficPercentageToMonitor = aws.Int32(int32(v))
+			}
 		}
 
 		_, err := conn.UpdateMonitor(ctx, input)
@@ -425,6 +474,37 @@ func expandHealthEventsConfig(tfList []interface{}) *types.HealthEventsConfig {
 		apiObject.PerformanceScoreThreshold = v
 	}
 
+	if v, ok := tfMap["availability_local_health_events_config"].([]interface{}); ok {
+		apiObject.AvailabilityLocalHealthEventsConfig = expandLocalHealthEventsConfig(v)
+	}
+
+	if v, ok := tfMap["performance_local_health_events_config"].([]interface{}); ok {
+		apiObject.PerformanceLocalHealthEventsConfig = expandLocalHealthEventsConfig(v)
+	}
+
+	return apiObject
+}
+
+func expandLocalHealthEventsConfig(tfList []interface{}) *types.LocalHealthEventsConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.LocalHealthEventsConfig{}
+
+	if v, ok := tfMap["health_score_threshold"].(float64); ok && v != 0.0 {
+		apiObject.HealthScoreThreshold = v
+	}
+
+	if v, ok := tfMap["min_traffic_impact"].(float64); ok && v != 0.0 {
+		apiObject.MinTrafficImpact = v
+	}
+
+	if v, ok := tfMap[names.AttrStatus].(string); ok && v != "" {
+		apiObject.Status = types.LocalHealthEventsConfigStatus(v)
+	}
+
 	return apiObject
 }
 
@@ -472,8 +552,24 @@ func flattenHealthEventsConfig(apiObject *types.HealthEventsConfig) []interface{
 	}
 
 	tfMap := map[string]interface{}{
-		"availability_score_threshold": apiObject.AvailabilityScoreThreshold,
-		"performance_score_threshold":  apiObject.PerformanceScoreThreshold,
+		"availability_local_health_events_config": flattenLocalHealthEventsConfig(apiObject.AvailabilityLocalHealthEventsConfig),
+		"availability_score_threshold":            apiObject.AvailabilityScoreThreshold,
+		"performance_local_health_events_config":  flattenLocalHealthEventsConfig(apiObject.PerformanceLocalHealthEventsConfig),
+		"performance_score_threshold":             apiObject.PerformanceScoreThreshold,
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenLocalHealthEventsConfig(apiObject *types.LocalHealthEventsConfig) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"health_score_threshold": apiObject.HealthScoreThreshold,
+		"min_traffic_impact":     apiObject.MinTrafficImpact,
+		names.AttrStatus:         apiObject.Status,
 	}
 
 	return []interface{}{tfMap}
@@ -38,6 +38,7 @@ func TestAccInternetMonitorMonitor_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "health_events_config.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "internet_measurements_log_delivery.#", acctest.CtOne),
 					resource.TestCheckResourceAttr(resourceName, "max_city_networks_to_monitor", "0"),
+					resource.TestCheckResourceAttr(resourceName, "metrics_namespace", "AWS/InternetMonitor/"+rName),
 					resource.TestCheckResourceAttr(resourceName, "monitor_name", rName),
 					resource.TestCheckResourceAttr(resourceName, "resources.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
@@ -163,6 +164,60 @@ func TestAccInternetMonitorMonitor_healthEventsConfig(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "health_events_config.0.performance_score_threshold", "85"),
 				),
 			},
+			{
+				Config: testAccMonitorConfig_healthEventsConfigLocal(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "health_events_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "health_events_config.0.availability_local_health_events_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "health_events_config.0.availability_local_health_events_config.0.health_score_threshold", "70"),
+					resource.TestCheckResourceAttr(resourceName, "health_events_config.0.availability_local_health_events_config.0.min_traffic_impact", "5"),
+					resource.TestCheckResourceAttr(resourceName, "health_events_config.0.availability_local_health_events_config.0.status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "health_events_config.0.performance_local_health_events_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "health_events_config.0.performance_local_health_events_config.0.status", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccInternetMonitorMonitor_trafficLimits(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_internetmonitor_monitor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.InternetMonitorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorConfig_trafficLimits(rName, 1, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_city_networks_to_monitor", "10"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_percentage_to_monitor", acctest.CtOne),
+				),
+			},
+			{
+				Config: testAccMonitorConfig_trafficLimits(rName, 5, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_city_networks_to_monitor", "20"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_percentage_to_monitor", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -283,6 +338,40 @@ resource "aws_internetmonitor_monitor" "test" {
 `, rName)
 }
 
+func testAccMonitorConfig_healthEventsConfigLocal(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_internetmonitor_monitor" "test" {
+  monitor_name                 = %[1]q
+  max_city_networks_to_monitor = 2
+
+  health_events_config {
+    availability_score_threshold = 75
+    performance_score_threshold  = 85
+
+    availability_local_health_events_config {
+      health_score_threshold = 70
+      min_traffic_impact     = 5
+      status                 = "ENABLED"
+    }
+
+    performance_local_health_events_config {
+      status = "DISABLED"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccMonitorConfig_trafficLimits(rName string, trafficPercentage, maxCityNetworks int) string {
+	return fmt.Sprintf(`
+resource "aws_internetmonitor_monitor" "test" {
+  monitor_name                  = %[1]q
+  traffic_percentage_to_monitor = %[2]d
+  max_city_networks_to_monitor  = %[3]d
+}
+`, rName, trafficPercentage, maxCityNetworks)
+}
+
 func testAccMonitorConfig_log(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
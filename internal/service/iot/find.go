@@ -125,6 +125,37 @@ func FindThingGroupMembership(ctx context.Context, conn *iot.IoT, thingGroupName
 	return nil
 }
 
+func FindThingNamesInThingGroup(ctx context.Context, conn *iot.IoT, thingGroupName string) ([]string, error) {
+	input := &iot.ListThingsInThingGroupInput{
+		ThingGroupName: aws.String(thingGroupName),
+	}
+
+	var output []string
+
+	err := conn.ListThingsInThingGroupPagesWithContext(ctx, input, func(page *iot.ListThingsInThingGroupOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, aws.StringValueSlice(page.Things)...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func FindTopicRuleByName(ctx context.Context, conn *iot.IoT, name string) (*iot.GetTopicRuleOutput, error) {
 	// GetTopicRule returns unhelpful errors such as
 	//	"An error occurred (UnauthorizedException) when calling the GetTopicRule operation: Access to topic rule 'xxxxxxxx' was denied"
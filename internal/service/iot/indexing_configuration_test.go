@@ -17,6 +17,7 @@ func TestAccIoTIndexingConfiguration_serial(t *testing.T) {
 	testCases := map[string]func(t *testing.T){
 		"basic":         testAccIndexingConfiguration_basic,
 		"allAttributes": testAccIndexingConfiguration_allAttributes,
+		"searchIndex":   testAccSearchIndexDataSource_basic,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
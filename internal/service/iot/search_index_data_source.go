@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_iot_search_index")
+func DataSourceSearchIndex() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSearchIndexRead,
+
+		Schema: map[string]*schema.Schema{
+			"index_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "AWS_Things",
+			},
+			"query_string": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"query_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"thing_group_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"thing_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"parent_group_names": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"thing_group_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"thing_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"thing_group_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"thing_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"things": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"connected": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"shadow": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"thing_group_names": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"thing_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"thing_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"thing_type_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSearchIndexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	queryString := d.Get("query_string").(string)
+	input := &iot.SearchIndexInput{
+		IndexName:   aws.String(d.Get("index_name").(string)),
+		QueryString: aws.String(queryString),
+	}
+
+	if v, ok := d.GetOk("query_version"); ok {
+		input.QueryVersion = aws.String(v.(string))
+	}
+
+	things, thingGroups, err := findSearchIndexResults(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "searching IoT fleet index (%s): %s", queryString, err)
+	}
+
+	d.SetId(queryString)
+
+	thingNames := make([]string, 0, len(things))
+	for _, v := range things {
+		thingNames = append(thingNames, aws.StringValue(v.ThingName))
+	}
+	d.Set("thing_names", thingNames)
+	if err := d.Set("things", flattenThingDocuments(things)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting things: %s", err)
+	}
+
+	thingGroupNames := make([]string, 0, len(thingGroups))
+	for _, v := range thingGroups {
+		thingGroupNames = append(thingGroupNames, aws.StringValue(v.ThingGroupName))
+	}
+	d.Set("thing_group_names", thingGroupNames)
+	if err := d.Set("thing_groups", flattenThingGroupDocuments(thingGroups)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting thing_groups: %s", err)
+	}
+
+	return diags
+}
+
+func findSearchIndexResults(ctx context.Context, conn *iot.IoT, input *iot.SearchIndexInput) ([]*iot.ThingDocument, []*iot.ThingGroupDocument, error) {
+	var things []*iot.ThingDocument
+	var thingGroups []*iot.ThingGroupDocument
+
+	for {
+		output, err := conn.SearchIndexWithContext(ctx, input)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if output == nil {
+			break
+		}
+
+		for _, v := range output.Things {
+			if v != nil {
+				things = append(things, v)
+			}
+		}
+
+		for _, v := range output.ThingGroups {
+			if v != nil {
+				thingGroups = append(thingGroups, v)
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return things, thingGroups, nil
+}
+
+func flattenThingDocuments(apiObjects []*iot.ThingDocument) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"attributes":        aws.StringValueMap(apiObject.Attributes),
+			"shadow":            aws.StringValue(apiObject.Shadow),
+			"thing_group_names": aws.StringValueSlice(apiObject.ThingGroupNames),
+			"thing_id":          aws.StringValue(apiObject.ThingId),
+			"thing_name":        aws.StringValue(apiObject.ThingName),
+			"thing_type_name":   aws.StringValue(apiObject.ThingTypeName),
+		}
+
+		if v := apiObject.Connectivity; v != nil {
+			tfMap["connected"] = aws.BoolValue(v.Connected)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenThingGroupDocuments(apiObjects []*iot.ThingGroupDocument) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"attributes":              aws.StringValueMap(apiObject.Attributes),
+			"parent_group_names":      aws.StringValueSlice(apiObject.ParentGroupNames),
+			"thing_group_description": aws.StringValue(apiObject.ThingGroupDescription),
+			"thing_group_id":          aws.StringValue(apiObject.ThingGroupId),
+			"thing_group_name":        aws.StringValue(apiObject.ThingGroupName),
+		})
+	}
+
+	return tfList
+}
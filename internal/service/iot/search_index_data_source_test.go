@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Run serially with the indexing configuration tests as fleet indexing is a Region-wide setting.
+func testAccSearchIndexDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iot_search_index.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				// Things are indexed asynchronously, so they are created before the index is searched.
+				Config: testAccSearchIndexDataSourceConfig_base(rName),
+			},
+			{
+				Config: testAccSearchIndexDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "index_name", "AWS_Things"),
+					resource.TestCheckResourceAttr(dataSourceName, "thing_names.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "things.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "things.0.attributes.fleet", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "thing_groups.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSearchIndexDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_indexing_configuration" "test" {
+  thing_indexing_configuration {
+    thing_indexing_mode = "REGISTRY"
+  }
+}
+
+resource "aws_iot_thing" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+
+  attributes = {
+    fleet = %[1]q
+  }
+
+  depends_on = [aws_iot_indexing_configuration.test]
+}
+`, rName)
+}
+
+func testAccSearchIndexDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSearchIndexDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_iot_search_index" "test" {
+  query_string = "attributes.fleet:\"%[1]s\""
+
+  depends_on = [aws_iot_thing.test]
+}
+`, rName))
+}
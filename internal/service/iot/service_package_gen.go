@@ -34,6 +34,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_iot_registration_code",
 			Name:     "Registration Code",
 		},
+		{
+			Factory:  DataSourceSearchIndex,
+			TypeName: "aws_iot_search_index",
+		},
 	}
 }
 
@@ -123,6 +127,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceThingGroupBulkMembership,
+			TypeName: "aws_iot_thing_group_bulk_membership",
+		},
 		{
 			Factory:  ResourceThingGroupMembership,
 			TypeName: "aws_iot_thing_group_membership",
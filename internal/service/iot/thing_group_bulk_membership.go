@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_iot_thing_group_bulk_membership")
+func ResourceThingGroupBulkMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceThingGroupBulkMembershipCreate,
+		ReadWithoutTimeout:   resourceThingGroupBulkMembershipRead,
+		UpdateWithoutTimeout: resourceThingGroupBulkMembershipUpdate,
+		DeleteWithoutTimeout: resourceThingGroupBulkMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceThingGroupBulkMembershipImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"override_dynamic_group": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"thing_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"thing_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceThingGroupBulkMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	thingGroupName := d.Get("thing_group_name").(string)
+	thingNames := flex.ExpandStringValueSet(d.Get("thing_names").(*schema.Set))
+
+	if err := addThingsToThingGroup(ctx, conn, thingGroupName, thingNames, d.Get("override_dynamic_group").(bool)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "adding IoT Things to IoT Thing Group (%s): %s", thingGroupName, err)
+	}
+
+	d.SetId(thingGroupName)
+
+	return append(diags, resourceThingGroupBulkMembershipRead(ctx, d, meta)...)
+}
+
+func resourceThingGroupBulkMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	members, err := FindThingNamesInThingGroup(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Thing Group Bulk Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Thing Group Bulk Membership (%s): %s", d.Id(), err)
+	}
+
+	// Only the things managed by this resource are tracked, other members of the group are ignored.
+	managed := flex.ExpandStringValueSet(d.Get("thing_names").(*schema.Set))
+
+	d.Set("thing_group_name", d.Id())
+	d.Set("thing_names", slices.DeleteFunc(members, func(name string) bool {
+		return !slices.Contains(managed, name)
+	}))
+
+	return diags
+}
+
+func resourceThingGroupBulkMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	if d.HasChange("thing_names") {
+		o, n := d.GetChange("thing_names")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		add, del := flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
+
+		if err := removeThingsFromThingGroup(ctx, conn, d.Id(), del); err != nil {
+			return sdkdiag.AppendErrorf(diags, "removing IoT Things from IoT Thing Group (%s): %s", d.Id(), err)
+		}
+
+		if err := addThingsToThingGroup(ctx, conn, d.Id(), add, d.Get("override_dynamic_group").(bool)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "adding IoT Things to IoT Thing Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceThingGroupBulkMembershipRead(ctx, d, meta)...)
+}
+
+func resourceThingGroupBulkMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Thing Group Bulk Membership: %s", d.Id())
+	err := removeThingsFromThingGroup(ctx, conn, d.Id(), flex.ExpandStringValueSet(d.Get("thing_names").(*schema.Set)))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Thing Group Bulk Membership (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceThingGroupBulkMembershipImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	// Every current member of the group is adopted on import.
+	members, err := FindThingNamesInThingGroup(ctx, conn, d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("thing_names", members)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func addThingsToThingGroup(ctx context.Context, conn *iot.IoT, thingGroupName string, thingNames []string, overrideDynamicGroups bool) error {
+	for _, thingName := range thingNames {
+		input := &iot.AddThingToThingGroupInput{
+			ThingGroupName: aws.String(thingGroupName),
+			ThingName:      aws.String(thingName),
+		}
+
+		if overrideDynamicGroups {
+			input.OverrideDynamicGroups = aws.Bool(true)
+		}
+
+		if _, err := conn.AddThingToThingGroupWithContext(ctx, input); err != nil {
+			return fmt.Errorf("IoT Thing (%s): %w", thingName, err)
+		}
+	}
+
+	return nil
+}
+
+func removeThingsFromThingGroup(ctx context.Context, conn *iot.IoT, thingGroupName string, thingNames []string) error {
+	for _, thingName := range thingNames {
+		_, err := conn.RemoveThingFromThingGroupWithContext(ctx, &iot.RemoveThingFromThingGroupInput{
+			ThingGroupName: aws.String(thingGroupName),
+			ThingName:      aws.String(thingName),
+		})
+
+		if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("IoT Thing (%s): %w", thingName, err)
+		}
+	}
+
+	return nil
+}
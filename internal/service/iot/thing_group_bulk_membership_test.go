@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiot "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTThingGroupBulkMembership_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_thing_group_bulk_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckThingGroupBulkMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThingGroupBulkMembershipConfig_basic(rName, 0, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThingGroupBulkMembershipExists(ctx, resourceName, rName+"-0", rName+"-1"),
+					resource.TestCheckNoResourceAttr(resourceName, "override_dynamic_group"),
+					resource.TestCheckResourceAttr(resourceName, "thing_group_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "thing_names.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTThingGroupBulkMembership_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_thing_group_bulk_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckThingGroupBulkMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThingGroupBulkMembershipConfig_basic(rName, 0, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThingGroupBulkMembershipExists(ctx, resourceName, rName+"-0", rName+"-1"),
+				),
+			},
+			{
+				Config: testAccThingGroupBulkMembershipConfig_basic(rName, 1, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThingGroupBulkMembershipExists(ctx, resourceName, rName+"-1", rName+"-2"),
+					resource.TestCheckResourceAttr(resourceName, "thing_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "thing_names.*", rName+"-1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "thing_names.*", rName+"-2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckThingGroupBulkMembershipExists(ctx context.Context, n string, want ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Thing Group Bulk Membership ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn(ctx)
+
+		members, err := tfiot.FindThingNamesInThingGroup(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		slices.Sort(members)
+		if !slices.Equal(members, want) {
+			return fmt.Errorf("IoT Thing Group (%s) members = %v, want %v", rs.Primary.ID, members, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckThingGroupBulkMembershipDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iot_thing_group_bulk_membership" {
+				continue
+			}
+
+			members, err := tfiot.FindThingNamesInThingGroup(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(members) > 0 {
+				return fmt.Errorf("IoT Thing Group Bulk Membership %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccThingGroupBulkMembershipConfig_basic(rName string, members ...int) string {
+	thingNames := make([]string, 0, len(members))
+	for _, i := range members {
+		thingNames = append(thingNames, fmt.Sprintf("aws_iot_thing.test[%d].name", i))
+	}
+
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_iot_thing" "test" {
+  count = 3
+
+  name = "%[1]s-${count.index}"
+}
+
+resource "aws_iot_thing_group_bulk_membership" "test" {
+  thing_group_name = aws_iot_thing_group.test.name
+  thing_names      = [%[2]s]
+}
+`, rName, strings.Join(thingNames, ", "))
+}
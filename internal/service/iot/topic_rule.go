@@ -643,6 +643,60 @@ func ResourceTopicRule() *schema.Resource {
 							},
 							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
 						},
+						"location": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"device_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"latitude": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"longitude": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									names.AttrRoleARN: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"timestamp": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrUnit: {
+													Type:     schema.TypeString,
+													Optional: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														"SECONDS",
+														"MILLISECONDS",
+														"MICROSECONDS",
+														"NANOSECONDS",
+													}, false),
+												},
+												names.AttrValue: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"tracker_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
+						},
 						"republish": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -1010,6 +1064,58 @@ func ResourceTopicRule() *schema.Resource {
 					},
 				},
 			},
+			"location": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"latitude": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"longitude": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrRoleARN: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"timestamp": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrUnit: {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"SECONDS",
+											"MILLISECONDS",
+											"MICROSECONDS",
+											"NANOSECONDS",
+										}, false),
+									},
+									names.AttrValue: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"tracker_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			names.AttrName: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -1209,6 +1315,7 @@ var topicRuleErrorActionExactlyOneOf = []string{
 	"error_action.0.kafka",
 	"error_action.0.kinesis",
 	"error_action.0.lambda",
+	"error_action.0.location",
 	"error_action.0.republish",
 	"error_action.0.s3",
 	"error_action.0.sns",
@@ -1331,6 +1438,10 @@ func resourceTopicRuleRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "setting lambda: %s", err)
 	}
 
+	if err := d.Set("location", flattenLocationActions(output.Rule.Actions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting location: %s", err)
+	}
+
 	if err := d.Set("republish", flattenRepublishActions(output.Rule.Actions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting republish: %s", err)
 	}
@@ -1805,6 +1916,60 @@ func expandLambdaAction(tfList []interface{}) *iot.LambdaAction {
 	return apiObject
 }
 
+func expandLocationAction(tfList []interface{}) *iot.LocationAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iot.LocationAction{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["device_id"].(string); ok && v != "" {
+		apiObject.DeviceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["latitude"].(string); ok && v != "" {
+		apiObject.Latitude = aws.String(v)
+	}
+
+	if v, ok := tfMap["longitude"].(string); ok && v != "" {
+		apiObject.Longitude = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrRoleARN].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["timestamp"].([]interface{}); ok {
+		apiObject.Timestamp = expandLocationTimestamp(v)
+	}
+
+	if v, ok := tfMap["tracker_name"].(string); ok && v != "" {
+		apiObject.TrackerName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLocationTimestamp(tfList []interface{}) *iot.LocationTimestamp {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iot.LocationTimestamp{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap[names.AttrUnit].(string); ok && v != "" {
+		apiObject.Unit = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrValue].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func expandRepublishAction(tfList []interface{}) *iot.RepublishAction {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -2145,6 +2310,17 @@ func expandTopicRulePayload(d *schema.ResourceData) *iot.TopicRulePayload {
 		actions = append(actions, &iot.Action{Lambda: action})
 	}
 
+	// Legacy root attribute handling
+	for _, tfMapRaw := range d.Get("location").(*schema.Set).List() {
+		action := expandLocationAction([]interface{}{tfMapRaw})
+
+		if action == nil {
+			continue
+		}
+
+		actions = append(actions, &iot.Action{Location: action})
+	}
+
 	// Legacy root attribute handling
 	for _, tfMapRaw := range d.Get("republish").(*schema.Set).List() {
 		action := expandRepublishAction([]interface{}{tfMapRaw})
@@ -2351,6 +2527,16 @@ func expandTopicRulePayload(d *schema.ResourceData) *iot.TopicRulePayload {
 
 					iotErrorAction = &iot.Action{Lambda: action}
 				}
+			case "location":
+				for _, tfMapRaw := range v.([]interface{}) {
+					action := expandLocationAction([]interface{}{tfMapRaw})
+
+					if action == nil {
+						continue
+					}
+
+					iotErrorAction = &iot.Action{Location: action}
+				}
 			case "republish":
 				for _, tfMapRaw := range v.([]interface{}) {
 					action := expandRepublishAction([]interface{}{tfMapRaw})
@@ -3019,6 +3205,74 @@ func flattenLambdaAction(apiObject *iot.LambdaAction) []interface{} {
 	return []interface{}{tfMap}
 }
 
+func flattenLocationActions(actions []*iot.Action) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, action := range actions {
+		if action == nil {
+			continue
+		}
+
+		if v := action.Location; v != nil {
+			results = append(results, flattenLocationAction(v)...)
+		}
+	}
+
+	return results
+}
+
+func flattenLocationAction(apiObject *iot.LocationAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.DeviceId; v != nil {
+		tfMap["device_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Latitude; v != nil {
+		tfMap["latitude"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Longitude; v != nil {
+		tfMap["longitude"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RoleArn; v != nil {
+		tfMap[names.AttrRoleARN] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Timestamp; v != nil {
+		tfMap["timestamp"] = flattenLocationTimestamp(v)
+	}
+
+	if v := apiObject.TrackerName; v != nil {
+		tfMap["tracker_name"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenLocationTimestamp(apiObject *iot.LocationTimestamp) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.Unit; v != nil {
+		tfMap[names.AttrUnit] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Value; v != nil {
+		tfMap[names.AttrValue] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenPutItemInput(apiObject *iot.PutItemInput) []interface{} {
 	if apiObject == nil {
 		return nil
@@ -3382,6 +3636,10 @@ func flattenErrorAction(errorAction *iot.Action) []map[string]interface{} {
 		results = append(results, map[string]interface{}{"lambda": flattenLambdaActions(input)})
 		return results
 	}
+	if errorAction.Location != nil {
+		results = append(results, map[string]interface{}{"location": flattenLocationActions(input)})
+		return results
+	}
 	if errorAction.Republish != nil {
 		results = append(results, map[string]interface{}{"republish": flattenRepublishActions(input)})
 		return results
@@ -0,0 +1,757 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iottwinmaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iottwinmaker"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iottwinmaker/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Component Type")
+// @Tags(identifierAttribute="arn")
+func newComponentTypeResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &componentTypeResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type componentTypeResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*componentTypeResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iottwinmaker_component_type"
+}
+
+func (r *componentTypeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	dataTypeType := fwtypes.StringEnumType[awstypes.Type]()
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"component_type_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_.:-]+$`), "must contain only alphanumeric characters, periods, colons, hyphens and underscores"),
+				},
+			},
+			"component_type_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+				},
+			},
+			"creation_date_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2048),
+				},
+			},
+			"extends_from": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"is_abstract": schema.BoolAttribute{
+				Computed: true,
+			},
+			"is_schema_initialized": schema.BoolAttribute{
+				Computed: true,
+			},
+			"is_singleton": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"update_date_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"workspace_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"composite_component_type": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[compositeComponentTypeModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"component_type_id": schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"function": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[functionModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"required_properties": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						names.AttrScope: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.Scope](),
+							Optional:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"implemented_by": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataConnectorModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"is_native": schema.BoolAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"lambda": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[lambdaFunctionModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrARN: schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"property_definition": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[propertyDefinitionModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrConfiguration: schema.MapAttribute{
+							CustomType:  fwtypes.MapOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						names.AttrDisplayName: schema.StringAttribute{
+							Optional: true,
+						},
+						"is_external_id": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						"is_required_in_entity": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						"is_stored_externally": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						"is_time_series": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"data_type": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataTypeModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrType: schema.StringAttribute{
+										CustomType: dataTypeType,
+										Required:   true,
+									},
+									"unit_of_measure": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"nested_type": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[nestedDataTypeModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrType: schema.StringAttribute{
+													CustomType: dataTypeType,
+													Required:   true,
+												},
+												"unit_of_measure": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+									"relationship": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[relationshipModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"relationship_type": schema.StringAttribute{
+													Optional: true,
+												},
+												"target_component_type_id": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						names.AttrDefaultValue: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataValueModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"boolean_value": schema.BoolAttribute{
+										Optional: true,
+									},
+									"double_value": schema.Float64Attribute{
+										Optional: true,
+									},
+									names.AttrExpression: schema.StringAttribute{
+										Optional: true,
+									},
+									"integer_value": schema.Int64Attribute{
+										Optional: true,
+									},
+									"long_value": schema.Int64Attribute{
+										Optional: true,
+									},
+									"string_value": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"property_group": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[propertyGroupModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"group_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.GroupType](),
+							Required:   true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"property_names": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *componentTypeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data componentTypeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTTwinMakerClient(ctx)
+
+	input := &iottwinmaker.CreateComponentTypeInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateComponentType(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IoT TwinMaker Component Type (%s)", data.ComponentTypeID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	output, err := waitComponentTypeCreated(ctx, conn, data.WorkspaceID.ValueString(), data.ComponentTypeID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for IoT TwinMaker Component Type (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenComponentType(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *componentTypeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data componentTypeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IoTTwinMakerClient(ctx)
+
+	output, err := findComponentTypeByTwoPartKey(ctx, conn, data.WorkspaceID.ValueString(), data.ComponentTypeID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT TwinMaker Component Type (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenComponentType(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *componentTypeResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new componentTypeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTTwinMakerClient(ctx)
+
+	workspaceID, componentTypeID := new.WorkspaceID.ValueString(), new.ComponentTypeID.ValueString()
+
+	if !new.ComponentTypeName.Equal(old.ComponentTypeName) ||
+		!new.CompositeComponentTypes.Equal(old.CompositeComponentTypes) ||
+		!new.Description.Equal(old.Description) ||
+		!new.ExtendsFrom.Equal(old.ExtendsFrom) ||
+		!new.Functions.Equal(old.Functions) ||
+		!new.IsSingleton.Equal(old.IsSingleton) ||
+		!new.PropertyDefinitions.Equal(old.PropertyDefinitions) ||
+		!new.PropertyGroups.Equal(old.PropertyGroups) {
+		input := &iottwinmaker.UpdateComponentTypeInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateComponentType(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating IoT TwinMaker Component Type (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitComponentTypeUpdated(ctx, conn, workspaceID, componentTypeID, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for IoT TwinMaker Component Type (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findComponentTypeByTwoPartKey(ctx, conn, workspaceID, componentTypeID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT TwinMaker Component Type (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(flattenComponentType(ctx, output, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *componentTypeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data componentTypeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTTwinMakerClient(ctx)
+
+	workspaceID, componentTypeID := data.WorkspaceID.ValueString(), data.ComponentTypeID.ValueString()
+	_, err := conn.DeleteComponentType(ctx, &iottwinmaker.DeleteComponentTypeInput{
+		ComponentTypeId: aws.String(componentTypeID),
+		WorkspaceId:     aws.String(workspaceID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting IoT TwinMaker Component Type (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitComponentTypeDeleted(ctx, conn, workspaceID, componentTypeID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for IoT TwinMaker Component Type (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *componentTypeResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findComponentTypeByTwoPartKey(ctx context.Context, conn *iottwinmaker.Client, workspaceID, componentTypeID string) (*iottwinmaker.GetComponentTypeOutput, error) {
+	input := &iottwinmaker.GetComponentTypeInput{
+		ComponentTypeId: aws.String(componentTypeID),
+		WorkspaceId:     aws.String(workspaceID),
+	}
+
+	output, err := conn.GetComponentType(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusComponentType(ctx context.Context, conn *iottwinmaker.Client, workspaceID, componentTypeID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findComponentTypeByTwoPartKey(ctx, conn, workspaceID, componentTypeID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Status == nil {
+			return output, "", nil
+		}
+
+		return output, string(output.Status.State), nil
+	}
+}
+
+func waitComponentTypeCreated(ctx context.Context, conn *iottwinmaker.Client, workspaceID, componentTypeID string, timeout time.Duration) (*iottwinmaker.GetComponentTypeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StateCreating),
+		Target:  enum.Slice(awstypes.StateActive),
+		Refresh: statusComponentType(ctx, conn, workspaceID, componentTypeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iottwinmaker.GetComponentTypeOutput); ok {
+		if status := output.Status; status != nil && status.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(status.Error.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitComponentTypeUpdated(ctx context.Context, conn *iottwinmaker.Client, workspaceID, componentTypeID string, timeout time.Duration) (*iottwinmaker.GetComponentTypeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StateUpdating),
+		Target:  enum.Slice(awstypes.StateActive),
+		Refresh: statusComponentType(ctx, conn, workspaceID, componentTypeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iottwinmaker.GetComponentTypeOutput); ok {
+		if status := output.Status; status != nil && status.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(status.Error.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitComponentTypeDeleted(ctx context.Context, conn *iottwinmaker.Client, workspaceID, componentTypeID string, timeout time.Duration) (*iottwinmaker.GetComponentTypeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StateActive, awstypes.StateDeleting),
+		Target:  []string{},
+		Refresh: statusComponentType(ctx, conn, workspaceID, componentTypeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iottwinmaker.GetComponentTypeOutput); ok {
+		if status := output.Status; status != nil && status.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(status.Error.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// flattenComponentType drops the functions, property definitions, property groups and composite
+// component types inherited from the types in extends_from, which are not managed by this resource.
+func flattenComponentType(ctx context.Context, output *iottwinmaker.GetComponentTypeOutput, data *componentTypeResourceModel) diag.Diagnostics {
+	maps.DeleteFunc(output.CompositeComponentTypes, func(_ string, v awstypes.CompositeComponentTypeResponse) bool {
+		return aws.ToBool(v.IsInherited)
+	})
+	maps.DeleteFunc(output.Functions, func(_ string, v awstypes.FunctionResponse) bool {
+		return aws.ToBool(v.IsInherited)
+	})
+	maps.DeleteFunc(output.PropertyDefinitions, func(_ string, v awstypes.PropertyDefinitionResponse) bool {
+		return aws.ToBool(v.IsInherited)
+	})
+	maps.DeleteFunc(output.PropertyGroups, func(_ string, v awstypes.PropertyGroupResponse) bool {
+		return aws.ToBool(v.IsInherited)
+	})
+
+	return fwflex.Flatten(ctx, output, data)
+}
+
+type componentTypeResourceModel struct {
+	ARN                     types.String                                                `tfsdk:"arn"`
+	ComponentTypeID         types.String                                                `tfsdk:"component_type_id"`
+	ComponentTypeName       types.String                                                `tfsdk:"component_type_name"`
+	CompositeComponentTypes fwtypes.SetNestedObjectValueOf[compositeComponentTypeModel] `tfsdk:"composite_component_type"`
+	CreationDateTime        timetypes.RFC3339                                           `tfsdk:"creation_date_time"`
+	Description             types.String                                                `tfsdk:"description"`
+	ExtendsFrom             fwtypes.ListValueOf[types.String]                           `tfsdk:"extends_from"`
+	Functions               fwtypes.SetNestedObjectValueOf[functionModel]               `tfsdk:"function"`
+	ID                      types.String                                                `tfsdk:"id"`
+	IsAbstract              types.Bool                                                  `tfsdk:"is_abstract"`
+	IsSchemaInitialized     types.Bool                                                  `tfsdk:"is_schema_initialized"`
+	IsSingleton             types.Bool                                                  `tfsdk:"is_singleton"`
+	PropertyDefinitions     fwtypes.SetNestedObjectValueOf[propertyDefinitionModel]     `tfsdk:"property_definition"`
+	PropertyGroups          fwtypes.SetNestedObjectValueOf[propertyGroupModel]          `tfsdk:"property_group"`
+	Tags                    types.Map                                                   `tfsdk:"tags"`
+	TagsAll                 types.Map                                                   `tfsdk:"tags_all"`
+	Timeouts                timeouts.Value                                              `tfsdk:"timeouts"`
+	UpdateDateTime          timetypes.RFC3339                                           `tfsdk:"update_date_time"`
+	WorkspaceID             types.String                                                `tfsdk:"workspace_id"`
+}
+
+const (
+	componentTypeResourceIDPartCount = 2
+)
+
+func (data *componentTypeResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, componentTypeResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.WorkspaceID = types.StringValue(parts[0])
+	data.ComponentTypeID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *componentTypeResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.WorkspaceID.ValueString(), data.ComponentTypeID.ValueString()}, componentTypeResourceIDPartCount, false)))
+}
+
+type compositeComponentTypeModel struct {
+	ComponentTypeID types.String `tfsdk:"component_type_id"`
+	MapBlockKey     types.String `tfsdk:"name"`
+}
+
+type functionModel struct {
+	ImplementedBy      fwtypes.ListNestedObjectValueOf[dataConnectorModel] `tfsdk:"implemented_by"`
+	MapBlockKey        types.String                                        `tfsdk:"name"`
+	RequiredProperties fwtypes.ListValueOf[types.String]                   `tfsdk:"required_properties"`
+	Scope              fwtypes.StringEnum[awstypes.Scope]                  `tfsdk:"scope"`
+}
+
+type dataConnectorModel struct {
+	IsNative types.Bool                                           `tfsdk:"is_native"`
+	Lambda   fwtypes.ListNestedObjectValueOf[lambdaFunctionModel] `tfsdk:"lambda"`
+}
+
+type lambdaFunctionModel struct {
+	ARN fwtypes.ARN `tfsdk:"arn"`
+}
+
+type propertyDefinitionModel struct {
+	Configuration      fwtypes.MapValueOf[types.String]                `tfsdk:"configuration"`
+	DataType           fwtypes.ListNestedObjectValueOf[dataTypeModel]  `tfsdk:"data_type"`
+	DefaultValue       fwtypes.ListNestedObjectValueOf[dataValueModel] `tfsdk:"default_value"`
+	DisplayName        types.String                                    `tfsdk:"display_name"`
+	IsExternalID       types.Bool                                      `tfsdk:"is_external_id"`
+	IsRequiredInEntity types.Bool                                      `tfsdk:"is_required_in_entity"`
+	IsStoredExternally types.Bool                                      `tfsdk:"is_stored_externally"`
+	IsTimeSeries       types.Bool                                      `tfsdk:"is_time_series"`
+	MapBlockKey        types.String                                    `tfsdk:"name"`
+}
+
+type dataTypeModel struct {
+	NestedType    fwtypes.ListNestedObjectValueOf[nestedDataTypeModel] `tfsdk:"nested_type"`
+	Relationship  fwtypes.ListNestedObjectValueOf[relationshipModel]   `tfsdk:"relationship"`
+	Type          fwtypes.StringEnum[awstypes.Type]                    `tfsdk:"type"`
+	UnitOfMeasure types.String                                         `tfsdk:"unit_of_measure"`
+}
+
+type nestedDataTypeModel struct {
+	Type          fwtypes.StringEnum[awstypes.Type] `tfsdk:"type"`
+	UnitOfMeasure types.String                      `tfsdk:"unit_of_measure"`
+}
+
+type relationshipModel struct {
+	RelationshipType      types.String `tfsdk:"relationship_type"`
+	TargetComponentTypeID types.String `tfsdk:"target_component_type_id"`
+}
+
+type dataValueModel struct {
+	BooleanValue types.Bool    `tfsdk:"boolean_value"`
+	DoubleValue  types.Float64 `tfsdk:"double_value"`
+	Expression   types.String  `tfsdk:"expression"`
+	IntegerValue types.Int64   `tfsdk:"integer_value"`
+	LongValue    types.Int64   `tfsdk:"long_value"`
+	StringValue  types.String  `tfsdk:"string_value"`
+}
+
+type propertyGroupModel struct {
+	GroupType     fwtypes.StringEnum[awstypes.GroupType] `tfsdk:"group_type"`
+	MapBlockKey   types.String                           `tfsdk:"name"`
+	PropertyNames fwtypes.ListValueOf[types.String]      `tfsdk:"property_names"`
+}
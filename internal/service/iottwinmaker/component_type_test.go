@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iottwinmaker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/iottwinmaker"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iottwinmaker/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiottwinmaker "github.com/hashicorp/terraform-provider-aws/internal/service/iottwinmaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTTwinMakerComponentType_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetComponentTypeOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iottwinmaker_component_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTTwinMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckComponentTypeExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iottwinmaker", fmt.Sprintf("workspace/%[1]s/component-type/%[1]s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "component_type_id", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date_time"),
+					resource.TestCheckResourceAttr(resourceName, "is_abstract", "false"),
+					resource.TestCheckResourceAttr(resourceName, "is_singleton", "false"),
+					resource.TestCheckResourceAttr(resourceName, "property_definition.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "property_definition.*", map[string]string{
+						names.AttrName:          "temperature",
+						"data_type.#":           acctest.CtOne,
+						"data_type.0.type":      string(awstypes.TypeDouble),
+						"is_required_in_entity": "false",
+						"is_time_series":        "false",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", "aws_iottwinmaker_workspace.test", "workspace_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTTwinMakerComponentType_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetComponentTypeOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iottwinmaker_component_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTTwinMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckComponentTypeExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfiottwinmaker.ResourceComponentType, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTTwinMakerComponentType_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetComponentTypeOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iottwinmaker_component_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTTwinMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckComponentTypeExists(ctx, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "property_definition.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "property_group.#", "0"),
+				),
+			},
+			{
+				Config: testAccComponentTypeConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckComponentTypeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "property_definition.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "property_definition.*", map[string]string{
+						names.AttrName:                 "status",
+						"data_type.0.type":             string(awstypes.TypeString),
+						"default_value.#":              acctest.CtOne,
+						"default_value.0.string_value": "OK",
+						"is_required_in_entity":        "false",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "property_group.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "property_group.*", map[string]string{
+						names.AttrName:     "readings",
+						"group_type":       string(awstypes.GroupTypeTabular),
+						"property_names.#": "2",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComponentTypeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTTwinMakerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iottwinmaker_component_type" {
+				continue
+			}
+
+			_, err := tfiottwinmaker.FindComponentTypeByTwoPartKey(ctx, conn, rs.Primary.Attributes["workspace_id"], rs.Primary.Attributes["component_type_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT TwinMaker Component Type %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckComponentTypeExists(ctx context.Context, n string, v *iottwinmaker.GetComponentTypeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTTwinMakerClient(ctx)
+
+		output, err := tfiottwinmaker.FindComponentTypeByTwoPartKey(ctx, conn, rs.Primary.Attributes["workspace_id"], rs.Primary.Attributes["component_type_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccComponentTypeConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_component_type" "test" {
+  workspace_id      = aws_iottwinmaker_workspace.test.workspace_id
+  component_type_id = %[1]q
+
+  property_definition {
+    name = "temperature"
+
+    data_type {
+      type = "DOUBLE"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccComponentTypeConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_component_type" "test" {
+  workspace_id      = aws_iottwinmaker_workspace.test.workspace_id
+  component_type_id = %[1]q
+  description       = "updated"
+
+  property_definition {
+    name = "temperature"
+
+    data_type {
+      type = "DOUBLE"
+    }
+  }
+
+  property_definition {
+    name = "status"
+
+    data_type {
+      type = "STRING"
+    }
+
+    default_value {
+      string_value = "OK"
+    }
+  }
+
+  property_group {
+    name           = "readings"
+    group_type     = "TABULAR"
+    property_names = ["temperature", "status"]
+  }
+}
+`, rName))
+}
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iottwinmaker
+
+// Exports for use in tests only.
+var (
+	ResourceComponentType = newComponentTypeResource
+	ResourcePricingPlan   = newPricingPlanResource
+	ResourceScene         = newSceneResource
+	ResourceSyncJob       = newSyncJobResource
+	ResourceWorkspace     = newWorkspaceResource
+
+	FindComponentTypeByTwoPartKey = findComponentTypeByTwoPartKey
+	FindPricingPlan               = findPricingPlan
+	FindSceneByTwoPartKey         = findSceneByTwoPartKey
+	FindSyncJobByTwoPartKey       = findSyncJobByTwoPartKey
+	FindWorkspaceByID             = findWorkspaceByID
+)
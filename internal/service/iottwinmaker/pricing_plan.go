@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iottwinmaker
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/iottwinmaker"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iottwinmaker/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Pricing Plan")
+func newPricingPlanResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &pricingPlanResource{}
+
+	return r, nil
+}
+
+type pricingPlanResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpDelete
+}
+
+func (*pricingPlanResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iottwinmaker_pricing_plan"
+}
+
+func (r *pricingPlanResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bundle_names": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 10),
+				},
+			},
+			"current_pricing_plan": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[pricingPlanModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[pricingPlanModel](ctx),
+			},
+			names.AttrID: framework.IDAttribute(),
+			"pending_pricing_plan": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[pricingPlanModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[pricingPlanModel](ctx),
+			},
+			"pricing_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PricingMode](),
+				Required:   true,
+			},
+		},
+	}
+}
+
+func (r *pricingPlanResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data pricingPlanResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTTwinMakerClient(ctx)
+
+	input := &iottwinmaker.UpdatePricingPlanInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.UpdatePricingPlan(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating IoT TwinMaker Pricing Plan", err.Error())
+
+		return
+	}
+
+	output, err := findPricingPlan(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading IoT TwinMaker Pricing Plan", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(r.Meta().AccountID)
+
+	response.Diagnostics.Append(flattenPricingPlan(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *pricingPlanResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data pricingPlanResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTTwinMakerClient(ctx)
+
+	output, err := findPricingPlan(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("reading IoT TwinMaker Pricing Plan", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenPricingPlan(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *pricingPlanResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data pricingPlanResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTTwinMakerClient(ctx)
+
+	input := &iottwinmaker.UpdatePricingPlanInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.UpdatePricingPlan(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("updating IoT TwinMaker Pricing Plan", err.Error())
+
+		return
+	}
+
+	output, err := findPricingPlan(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading IoT TwinMaker Pricing Plan", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(flattenPricingPlan(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findPricingPlan(ctx context.Context, conn *iottwinmaker.Client) (*iottwinmaker.GetPricingPlanOutput, error) {
+	input := &iottwinmaker.GetPricingPlanInput{}
+
+	output, err := conn.GetPricingPlan(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CurrentPricingPlan == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// flattenPricingPlan reports the configured pricing mode and bundles from the pending plan while
+// a change is waiting to take effect, so that the change doesn't show up as drift.
+func flattenPricingPlan(ctx context.Context, output *iottwinmaker.GetPricingPlanOutput, data *pricingPlanResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, output, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	plan := output.CurrentPricingPlan
+	if output.PendingPricingPlan != nil {
+		plan = output.PendingPricingPlan
+	}
+
+	data.PricingMode = fwtypes.StringEnumValue(plan.PricingMode)
+	if plan.BundleInformation != nil {
+		data.BundleNames = fwflex.FlattenFrameworkStringValueListOfString(ctx, plan.BundleInformation.BundleNames)
+	} else {
+		data.BundleNames = fwtypes.NewListValueOfNull[types.String](ctx)
+	}
+
+	return diags
+}
+
+type pricingPlanResourceModel struct {
+	BundleNames        fwtypes.ListValueOf[types.String]                 `tfsdk:"bundle_names"`
+	CurrentPricingPlan fwtypes.ListNestedObjectValueOf[pricingPlanModel] `tfsdk:"current_pricing_plan"`
+	ID                 types.String                                      `tfsdk:"id"`
+	PendingPricingPlan fwtypes.ListNestedObjectValueOf[pricingPlanModel] `tfsdk:"pending_pricing_plan"`
+	PricingMode        fwtypes.StringEnum[awstypes.PricingMode]          `tfsdk:"pricing_mode"`
+}
+
+type pricingPlanModel struct {
+	BillableEntityCount types.Int64                                             `tfsdk:"billable_entity_count"`
+	BundleInformation   fwtypes.ListNestedObjectValueOf[bundleInformationModel] `tfsdk:"bundle_information"`
+	EffectiveDateTime   timetypes.RFC3339                                       `tfsdk:"effective_date_time"`
+	PricingMode         fwtypes.StringEnum[awstypes.PricingMode]                `tfsdk:"pricing_mode"`
+	UpdateDateTime      timetypes.RFC3339                                       `tfsdk:"update_date_time"`
+	UpdateReason        fwtypes.StringEnum[awstypes.UpdateReason]               `tfsdk:"update_reason"`
+}
+
+type bundleInformationModel struct {
+	BundleNames fwtypes.ListValueOf[types.String]        `tfsdk:"bundle_names"`
+	PricingTier fwtypes.StringEnum[awstypes.PricingTier] `tfsdk:"pricing_tier"`
+}
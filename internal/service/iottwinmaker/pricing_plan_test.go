@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iottwinmaker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iottwinmaker/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiottwinmaker "github.com/hashicorp/terraform-provider-aws/internal/service/iottwinmaker"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTTwinMakerPricingPlan_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		"basic": testAccPricingPlan_basic,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccPricingPlan_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_iottwinmaker_pricing_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTTwinMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPricingPlanConfig_basic(string(awstypes.PricingModeBasic)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPricingPlanExists(ctx, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "bundle_names"),
+					resource.TestCheckResourceAttr(resourceName, "current_pricing_plan.#", acctest.CtOne),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "pricing_mode", string(awstypes.PricingModeBasic)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPricingPlanExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTTwinMakerClient(ctx)
+
+		_, err := tfiottwinmaker.FindPricingPlan(ctx, conn)
+
+		return err
+	}
+}
+
+func testAccPricingPlanConfig_basic(pricingMode string) string {
+	return fmt.Sprintf(`
+resource "aws_iottwinmaker_pricing_plan" "test" {
+  pricing_mode = %[1]q
+}
+`, pricingMode)
+}
@@ -0,0 +1,360 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iottwinmaker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iottwinmaker"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iottwinmaker/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Scene")
+// @Tags(identifierAttribute="arn")
+func newSceneResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &sceneResource{}
+
+	return r, nil
+}
+
+type sceneResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*sceneResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iottwinmaker_scene"
+}
+
+func (r *sceneResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"capabilities": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(50),
+				},
+			},
+			"content_location": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[sS]3://[0-9A-Za-z._/-]+$`), "must be an S3 URL"),
+				},
+			},
+			"creation_date_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2048),
+				},
+			},
+			"generated_scene_metadata": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"scene_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_][0-9A-Za-z_-]*[0-9A-Za-z]+$`), "must start with an alphanumeric character or underscore, end with an alphanumeric character and contain only alphanumeric characters, hyphens and underscores"),
+				},
+			},
+			"scene_metadata": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Map{
+					mapvalidator.SizeAtMost(50),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"update_date_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"workspace_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *sceneResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data sceneResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTTwinMakerClient(ctx)
+
+	input := &iottwinmaker.CreateSceneInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateScene(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IoT TwinMaker Scene (%s)", data.SceneID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	output, err := findSceneByTwoPartKey(ctx, conn, data.WorkspaceID.ValueString(), data.SceneID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT TwinMaker Scene (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *sceneResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data sceneResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IoTTwinMakerClient(ctx)
+
+	output, err := findSceneByTwoPartKey(ctx, conn, data.WorkspaceID.ValueString(), data.SceneID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT TwinMaker Scene (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *sceneResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new sceneResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTTwinMakerClient(ctx)
+
+	workspaceID, sceneID := new.WorkspaceID.ValueString(), new.SceneID.ValueString()
+
+	if !new.Capabilities.Equal(old.Capabilities) ||
+		!new.ContentLocation.Equal(old.ContentLocation) ||
+		!new.Description.Equal(old.Description) ||
+		!new.SceneMetadata.Equal(old.SceneMetadata) {
+		input := &iottwinmaker.UpdateSceneInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateScene(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating IoT TwinMaker Scene (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findSceneByTwoPartKey(ctx, conn, workspaceID, sceneID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT TwinMaker Scene (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *sceneResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data sceneResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTTwinMakerClient(ctx)
+
+	_, err := conn.DeleteScene(ctx, &iottwinmaker.DeleteSceneInput{
+		SceneId:     aws.String(data.SceneID.ValueString()),
+		WorkspaceId: aws.String(data.WorkspaceID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting IoT TwinMaker Scene (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *sceneResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findSceneByTwoPartKey(ctx context.Context, conn *iottwinmaker.Client, workspaceID, sceneID string) (*iottwinmaker.GetSceneOutput, error) {
+	input := &iottwinmaker.GetSceneInput{
+		SceneId:     aws.String(sceneID),
+		WorkspaceId: aws.String(workspaceID),
+	}
+
+	output, err := conn.GetScene(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type sceneResourceModel struct {
+	ARN                    types.String                      `tfsdk:"arn"`
+	Capabilities           fwtypes.ListValueOf[types.String] `tfsdk:"capabilities"`
+	ContentLocation        types.String                      `tfsdk:"content_location"`
+	CreationDateTime       timetypes.RFC3339                 `tfsdk:"creation_date_time"`
+	Description            types.String                      `tfsdk:"description"`
+	GeneratedSceneMetadata fwtypes.MapValueOf[types.String]  `tfsdk:"generated_scene_metadata"`
+	ID                     types.String                      `tfsdk:"id"`
+	SceneID                types.String                      `tfsdk:"scene_id"`
+	SceneMetadata          fwtypes.MapValueOf[types.String]  `tfsdk:"scene_metadata"`
+	Tags                   types.Map                         `tfsdk:"tags"`
+	TagsAll                types.Map                         `tfsdk:"tags_all"`
+	UpdateDateTime         timetypes.RFC3339                 `tfsdk:"update_date_time"`
+	WorkspaceID            types.String                      `tfsdk:"workspace_id"`
+}
+
+const (
+	sceneResourceIDPartCount = 2
+)
+
+func (data *sceneResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, sceneResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.WorkspaceID = types.StringValue(parts[0])
+	data.SceneID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *sceneResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.WorkspaceID.ValueString(), data.SceneID.ValueString()}, sceneResourceIDPartCount, false)))
+}
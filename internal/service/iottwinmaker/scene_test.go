@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iottwinmaker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/iottwinmaker"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiottwinmaker "github.com/hashicorp/terraform-provider-aws/internal/service/iottwinmaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTTwinMakerScene_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetSceneOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iottwinmaker_scene.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTTwinMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSceneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSceneConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSceneExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iottwinmaker", fmt.Sprintf("workspace/%[1]s/scene/%[1]s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "content_location", fmt.Sprintf("s3://%s/scene.json", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date_time"),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "scene_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "update_date_time"),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", "aws_iottwinmaker_workspace.test", "workspace_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTTwinMakerScene_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetSceneOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iottwinmaker_scene.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTTwinMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSceneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSceneConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSceneExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfiottwinmaker.ResourceScene, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTTwinMakerScene_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetSceneOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iottwinmaker_scene.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTTwinMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSceneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSceneConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSceneExists(ctx, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "scene_metadata.%", "0"),
+				),
+			},
+			{
+				Config: testAccSceneConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSceneExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "content_location", fmt.Sprintf("s3://%s/scene-updated.json", rName)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "scene_metadata.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "scene_metadata.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSceneDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTTwinMakerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iottwinmaker_scene" {
+				continue
+			}
+
+			_, err := tfiottwinmaker.FindSceneByTwoPartKey(ctx, conn, rs.Primary.Attributes["workspace_id"], rs.Primary.Attributes["scene_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT TwinMaker Scene %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSceneExists(ctx context.Context, n string, v *iottwinmaker.GetSceneOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTTwinMakerClient(ctx)
+
+		output, err := tfiottwinmaker.FindSceneByTwoPartKey(ctx, conn, rs.Primary.Attributes["workspace_id"], rs.Primary.Attributes["scene_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSceneConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), `
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "scene.json"
+  content = jsonencode({ specVersion = "1.0", version = "1", unit = "meters", nodes = [], rootNodeIndexes = [] })
+}
+
+resource "aws_s3_object" "test2" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "scene-updated.json"
+  content = jsonencode({ specVersion = "1.0", version = "2", unit = "meters", nodes = [], rootNodeIndexes = [] })
+}
+`)
+}
+
+func testAccSceneConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSceneConfig_base(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_scene" "test" {
+  workspace_id     = aws_iottwinmaker_workspace.test.workspace_id
+  scene_id         = %[1]q
+  content_location = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+}
+`, rName))
+}
+
+func testAccSceneConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccSceneConfig_base(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_scene" "test" {
+  workspace_id     = aws_iottwinmaker_workspace.test.workspace_id
+  scene_id         = %[1]q
+  content_location = "s3://${aws_s3_object.test2.bucket}/${aws_s3_object.test2.key}"
+  description      = "updated"
+
+  scene_metadata = {
+    key1 = "value1"
+  }
+}
+`, rName))
+}
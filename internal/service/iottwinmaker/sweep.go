@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iottwinmaker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iottwinmaker"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_iottwinmaker_component_type", &resource.Sweeper{
+		Name: "aws_iottwinmaker_component_type",
+		F:    sweepComponentTypes,
+	})
+
+	resource.AddTestSweepers("aws_iottwinmaker_scene", &resource.Sweeper{
+		Name: "aws_iottwinmaker_scene",
+		F:    sweepScenes,
+	})
+
+	resource.AddTestSweepers("aws_iottwinmaker_sync_job", &resource.Sweeper{
+		Name: "aws_iottwinmaker_sync_job",
+		F:    sweepSyncJobs,
+	})
+
+	resource.AddTestSweepers("aws_iottwinmaker_workspace", &resource.Sweeper{
+		Name: "aws_iottwinmaker_workspace",
+		F:    sweepWorkspaces,
+		Dependencies: []string{
+			"aws_iottwinmaker_component_type",
+			"aws_iottwinmaker_scene",
+			"aws_iottwinmaker_sync_job",
+		},
+	})
+}
+
+func sweepComponentTypes(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.IoTTwinMakerClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	workspaceIDs, err := listWorkspaceIDs(ctx, conn)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT TwinMaker Component Type sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT TwinMaker Workspaces (%s): %w", region, err)
+	}
+
+	for _, workspaceID := range workspaceIDs {
+		input := &iottwinmaker.ListComponentTypesInput{
+			WorkspaceId: aws.String(workspaceID),
+		}
+
+		pages := iottwinmaker.NewListComponentTypesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return fmt.Errorf("error listing IoT TwinMaker Component Types (%s): %w", region, err)
+			}
+
+			for _, v := range page.ComponentTypeSummaries {
+				componentTypeID := aws.ToString(v.ComponentTypeId)
+
+				// Built-in component types can't be deleted.
+				if strings.HasPrefix(componentTypeID, "com.amazon.") {
+					continue
+				}
+
+				sweepResources = append(sweepResources, framework.NewSweepResource(newComponentTypeResource, client,
+					framework.NewAttribute(names.AttrID, errs.Must(flex.FlattenResourceId([]string{workspaceID, componentTypeID}, componentTypeResourceIDPartCount, false))),
+					framework.NewAttribute("component_type_id", componentTypeID),
+					framework.NewAttribute("workspace_id", workspaceID),
+				))
+			}
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT TwinMaker Component Types (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepScenes(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.IoTTwinMakerClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	workspaceIDs, err := listWorkspaceIDs(ctx, conn)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT TwinMaker Scene sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT TwinMaker Workspaces (%s): %w", region, err)
+	}
+
+	for _, workspaceID := range workspaceIDs {
+		input := &iottwinmaker.ListScenesInput{
+			WorkspaceId: aws.String(workspaceID),
+		}
+
+		pages := iottwinmaker.NewListScenesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return fmt.Errorf("error listing IoT TwinMaker Scenes (%s): %w", region, err)
+			}
+
+			for _, v := range page.SceneSummaries {
+				sceneID := aws.ToString(v.SceneId)
+
+				sweepResources = append(sweepResources, framework.NewSweepResource(newSceneResource, client,
+					framework.NewAttribute(names.AttrID, errs.Must(flex.FlattenResourceId([]string{workspaceID, sceneID}, sceneResourceIDPartCount, false))),
+					framework.NewAttribute("scene_id", sceneID),
+					framework.NewAttribute("workspace_id", workspaceID),
+				))
+			}
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT TwinMaker Scenes (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepSyncJobs(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.IoTTwinMakerClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	workspaceIDs, err := listWorkspaceIDs(ctx, conn)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT TwinMaker Sync Job sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT TwinMaker Workspaces (%s): %w", region, err)
+	}
+
+	for _, workspaceID := range workspaceIDs {
+		input := &iottwinmaker.ListSyncJobsInput{
+			WorkspaceId: aws.String(workspaceID),
+		}
+
+		pages := iottwinmaker.NewListSyncJobsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return fmt.Errorf("error listing IoT TwinMaker Sync Jobs (%s): %w", region, err)
+			}
+
+			for _, v := range page.SyncJobSummaries {
+				syncSource := aws.ToString(v.SyncSource)
+
+				sweepResources = append(sweepResources, framework.NewSweepResource(newSyncJobResource, client,
+					framework.NewAttribute(names.AttrID, errs.Must(flex.FlattenResourceId([]string{workspaceID, syncSource}, syncJobResourceIDPartCount, false))),
+					framework.NewAttribute("sync_source", syncSource),
+					framework.NewAttribute("workspace_id", workspaceID),
+				))
+			}
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT TwinMaker Sync Jobs (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepWorkspaces(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.IoTTwinMakerClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	workspaceIDs, err := listWorkspaceIDs(ctx, conn)
+
+	if awsv2.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT TwinMaker Workspace sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT TwinMaker Workspaces (%s): %w", region, err)
+	}
+
+	for _, workspaceID := range workspaceIDs {
+		sweepResources = append(sweepResources, framework.NewSweepResource(newWorkspaceResource, client,
+			framework.NewAttribute(names.AttrID, workspaceID),
+			framework.NewAttribute("workspace_id", workspaceID),
+		))
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT TwinMaker Workspaces (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listWorkspaceIDs(ctx context.Context, conn *iottwinmaker.Client) ([]string, error) {
+	var workspaceIDs []string
+
+	pages := iottwinmaker.NewListWorkspacesPaginator(conn, &iottwinmaker.ListWorkspacesInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.WorkspaceSummaries {
+			workspaceIDs = append(workspaceIDs, aws.ToString(v.WorkspaceId))
+		}
+	}
+
+	return workspaceIDs, nil
+}
@@ -0,0 +1,361 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iottwinmaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iottwinmaker"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iottwinmaker/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Sync Job")
+// @Tags(identifierAttribute="arn")
+func newSyncJobResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &syncJobResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type syncJobResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[syncJobResourceModel]
+	framework.WithTimeouts
+}
+
+func (*syncJobResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iottwinmaker_sync_job"
+}
+
+func (r *syncJobResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"creation_date_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.SyncJobState](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sync_role": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sync_source": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_]+$`), "must contain only alphanumeric characters and underscores"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"update_date_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *syncJobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data syncJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTTwinMakerClient(ctx)
+
+	input := &iottwinmaker.CreateSyncJobInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateSyncJob(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IoT TwinMaker Sync Job (%s)", data.SyncSource.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	output, err := waitSyncJobCreated(ctx, conn, data.WorkspaceID.ValueString(), data.SyncSource.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for IoT TwinMaker Sync Job (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.State = fwtypes.StringEnumValue(output.Status.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *syncJobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data syncJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IoTTwinMakerClient(ctx)
+
+	output, err := findSyncJobByTwoPartKey(ctx, conn, data.WorkspaceID.ValueString(), data.SyncSource.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT TwinMaker Sync Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.State = fwtypes.StringEnumValue(output.Status.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *syncJobResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data syncJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTTwinMakerClient(ctx)
+
+	workspaceID, syncSource := data.WorkspaceID.ValueString(), data.SyncSource.ValueString()
+	_, err := conn.DeleteSyncJob(ctx, &iottwinmaker.DeleteSyncJobInput{
+		SyncSource:  aws.String(syncSource),
+		WorkspaceId: aws.String(workspaceID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting IoT TwinMaker Sync Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitSyncJobDeleted(ctx, conn, workspaceID, syncSource, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for IoT TwinMaker Sync Job (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *syncJobResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findSyncJobByTwoPartKey(ctx context.Context, conn *iottwinmaker.Client, workspaceID, syncSource string) (*iottwinmaker.GetSyncJobOutput, error) {
+	input := &iottwinmaker.GetSyncJobInput{
+		SyncSource:  aws.String(syncSource),
+		WorkspaceId: aws.String(workspaceID),
+	}
+
+	output, err := conn.GetSyncJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Status == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusSyncJob(ctx context.Context, conn *iottwinmaker.Client, workspaceID, syncSource string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSyncJobByTwoPartKey(ctx, conn, workspaceID, syncSource)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status.State), nil
+	}
+}
+
+func waitSyncJobCreated(ctx context.Context, conn *iottwinmaker.Client, workspaceID, syncSource string, timeout time.Duration) (*iottwinmaker.GetSyncJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SyncJobStateCreating, awstypes.SyncJobStateInitializing),
+		Target:  enum.Slice(awstypes.SyncJobStateActive),
+		Refresh: statusSyncJob(ctx, conn, workspaceID, syncSource),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iottwinmaker.GetSyncJobOutput); ok {
+		if output.Status.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Status.Error.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSyncJobDeleted(ctx context.Context, conn *iottwinmaker.Client, workspaceID, syncSource string, timeout time.Duration) (*iottwinmaker.GetSyncJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SyncJobStateActive, awstypes.SyncJobStateDeleting, awstypes.SyncJobStateError),
+		Target:  []string{},
+		Refresh: statusSyncJob(ctx, conn, workspaceID, syncSource),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iottwinmaker.GetSyncJobOutput); ok {
+		if output.Status.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Status.Error.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type syncJobResourceModel struct {
+	ARN              types.String                              `tfsdk:"arn"`
+	CreationDateTime timetypes.RFC3339                         `tfsdk:"creation_date_time"`
+	ID               types.String                              `tfsdk:"id"`
+	State            fwtypes.StringEnum[awstypes.SyncJobState] `tfsdk:"state"`
+	SyncRole         fwtypes.ARN                               `tfsdk:"sync_role"`
+	SyncSource       types.String                              `tfsdk:"sync_source"`
+	Tags             types.Map                                 `tfsdk:"tags"`
+	TagsAll          types.Map                                 `tfsdk:"tags_all"`
+	Timeouts         timeouts.Value                            `tfsdk:"timeouts"`
+	UpdateDateTime   timetypes.RFC3339                         `tfsdk:"update_date_time"`
+	WorkspaceID      types.String                              `tfsdk:"workspace_id"`
+}
+
+const (
+	syncJobResourceIDPartCount = 2
+)
+
+func (data *syncJobResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, syncJobResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.WorkspaceID = types.StringValue(parts[0])
+	data.SyncSource = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *syncJobResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.WorkspaceID.ValueString(), data.SyncSource.ValueString()}, syncJobResourceIDPartCount, false)))
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iottwinmaker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/iottwinmaker"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iottwinmaker/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiottwinmaker "github.com/hashicorp/terraform-provider-aws/internal/service/iottwinmaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTTwinMakerSyncJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetSyncJobOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iottwinmaker_sync_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTTwinMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSyncJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSyncJobConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSyncJobExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iottwinmaker", fmt.Sprintf("workspace/%s/sync-job/SITEWISE", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date_time"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(awstypes.SyncJobStateActive)),
+					resource.TestCheckResourceAttrPair(resourceName, "sync_role", "aws_iam_role.sync", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "sync_source", "SITEWISE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", "aws_iottwinmaker_workspace.test", "workspace_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTTwinMakerSyncJob_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetSyncJobOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iottwinmaker_sync_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTTwinMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSyncJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSyncJobConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSyncJobExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfiottwinmaker.ResourceSyncJob, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSyncJobDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTTwinMakerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iottwinmaker_sync_job" {
+				continue
+			}
+
+			_, err := tfiottwinmaker.FindSyncJobByTwoPartKey(ctx, conn, rs.Primary.Attributes["workspace_id"], rs.Primary.Attributes["sync_source"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT TwinMaker Sync Job %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSyncJobExists(ctx context.Context, n string, v *iottwinmaker.GetSyncJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTTwinMakerClient(ctx)
+
+		output, err := tfiottwinmaker.FindSyncJobByTwoPartKey(ctx, conn, rs.Primary.Attributes["workspace_id"], rs.Primary.Attributes["sync_source"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSyncJobConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iam_role" "sync" {
+  name = "%[1]s-sync"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "iottwinmaker.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "sync" {
+  name = %[1]q
+  role = aws_iam_role.sync.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "iotsitewise:DescribeAsset",
+        "iotsitewise:DescribeAssetModel",
+        "iotsitewise:ListAssets",
+        "iotsitewise:ListAssetModels",
+        "iottwinmaker:*",
+      ]
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iottwinmaker_sync_job" "test" {
+  workspace_id = aws_iottwinmaker_workspace.test.workspace_id
+  sync_source  = "SITEWISE"
+  sync_role    = aws_iam_role.sync.arn
+
+  depends_on = [aws_iam_role_policy.sync]
+}
+`, rName))
+}
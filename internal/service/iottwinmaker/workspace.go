@@ -0,0 +1,319 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iottwinmaker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iottwinmaker"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iottwinmaker/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Workspace")
+// @Tags(identifierAttribute="arn")
+func newWorkspaceResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &workspaceResource{}
+
+	return r, nil
+}
+
+type workspaceResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*workspaceResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iottwinmaker_workspace"
+}
+
+func (r *workspaceResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"creation_date_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2048),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"linked_services": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrRole: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"s3_location": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"update_date_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"workspace_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_][0-9A-Za-z_-]*[0-9A-Za-z]+$`), "must start with an alphanumeric character or underscore, end with an alphanumeric character and contain only alphanumeric characters, hyphens and underscores"),
+				},
+			},
+		},
+	}
+}
+
+func (r *workspaceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data workspaceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTTwinMakerClient(ctx)
+
+	input := &iottwinmaker.CreateWorkspaceInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	workspaceID := data.WorkspaceID.ValueString()
+	_, err := conn.CreateWorkspace(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IoT TwinMaker Workspace (%s)", workspaceID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	output, err := findWorkspaceByID(ctx, conn, workspaceID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT TwinMaker Workspace (%s)", workspaceID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *workspaceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data workspaceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IoTTwinMakerClient(ctx)
+
+	output, err := findWorkspaceByID(ctx, conn, data.WorkspaceID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT TwinMaker Workspace (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *workspaceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new workspaceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTTwinMakerClient(ctx)
+
+	workspaceID := new.WorkspaceID.ValueString()
+
+	if !new.Description.Equal(old.Description) ||
+		!new.Role.Equal(old.Role) ||
+		!new.S3Location.Equal(old.S3Location) {
+		input := &iottwinmaker.UpdateWorkspaceInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateWorkspace(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating IoT TwinMaker Workspace (%s)", workspaceID), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findWorkspaceByID(ctx, conn, workspaceID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT TwinMaker Workspace (%s)", workspaceID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *workspaceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data workspaceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTTwinMakerClient(ctx)
+
+	workspaceID := data.WorkspaceID.ValueString()
+	_, err := conn.DeleteWorkspace(ctx, &iottwinmaker.DeleteWorkspaceInput{
+		WorkspaceId: aws.String(workspaceID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting IoT TwinMaker Workspace (%s)", workspaceID), err.Error())
+
+		return
+	}
+}
+
+func (r *workspaceResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findWorkspaceByID(ctx context.Context, conn *iottwinmaker.Client, id string) (*iottwinmaker.GetWorkspaceOutput, error) {
+	input := &iottwinmaker.GetWorkspaceInput{
+		WorkspaceId: aws.String(id),
+	}
+
+	output, err := conn.GetWorkspace(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type workspaceResourceModel struct {
+	ARN              types.String                      `tfsdk:"arn"`
+	CreationDateTime timetypes.RFC3339                 `tfsdk:"creation_date_time"`
+	Description      types.String                      `tfsdk:"description"`
+	ID               types.String                      `tfsdk:"id"`
+	LinkedServices   fwtypes.ListValueOf[types.String] `tfsdk:"linked_services"`
+	Role             fwtypes.ARN                       `tfsdk:"role"`
+	S3Location       fwtypes.ARN                       `tfsdk:"s3_location"`
+	Tags             types.Map                         `tfsdk:"tags"`
+	TagsAll          types.Map                         `tfsdk:"tags_all"`
+	UpdateDateTime   timetypes.RFC3339                 `tfsdk:"update_date_time"`
+	WorkspaceID      types.String                      `tfsdk:"workspace_id"`
+}
+
+func (data *workspaceResourceModel) InitFromID() error {
+	data.WorkspaceID = data.ID
+
+	return nil
+}
+
+func (data *workspaceResourceModel) setID() {
+	data.ID = data.WorkspaceID
+}
@@ -111,6 +111,51 @@ func TestAccIVSRecordingConfiguration_update(t *testing.T) {
 	})
 }
 
+func TestAccIVSRecordingConfiguration_thumbnailAndRendition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 ivs.RecordingConfiguration
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivs_recording_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, ivs.EndpointsID)
+			testAccRecordingConfigurationPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordingConfigurationConfig_thumbnailAndRendition(bucketName, "HD", "SEQUENTIAL", "ALL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordingConfigurationExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "thumbnail_configuration.0.resolution", "HD"),
+					resource.TestCheckResourceAttr(resourceName, "thumbnail_configuration.0.storage.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttr(resourceName, "thumbnail_configuration.0.storage.*", "SEQUENTIAL"),
+					resource.TestCheckResourceAttr(resourceName, "rendition_configuration.0.rendition_selection", "ALL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRecordingConfigurationConfig_thumbnailAndRendition(bucketName, "SD", "LATEST", "NONE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordingConfigurationExists(ctx, resourceName, &v2),
+					testAccCheckRecordingConfigurationRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "thumbnail_configuration.0.resolution", "SD"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "thumbnail_configuration.0.storage.*", "LATEST"),
+					resource.TestCheckResourceAttr(resourceName, "rendition_configuration.0.rendition_selection", "NONE"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIVSRecordingConfiguration_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var recordingconfiguration ivs.RecordingConfiguration
@@ -353,6 +398,31 @@ resource "aws_ivs_recording_configuration" "test" {
 `, rName, recordingReconnectWindowSeconds, recordingMode, targetIntervalSeconds))
 }
 
+func testAccRecordingConfigurationConfig_thumbnailAndRendition(bucketName, resolution, storage, renditionSelection string) string {
+	return acctest.ConfigCompose(
+		testAccRecordingConfigurationConfig_s3Bucket(bucketName),
+		fmt.Sprintf(`
+resource "aws_ivs_recording_configuration" "test" {
+  destination_configuration {
+    s3 {
+      bucket_name = aws_s3_bucket.test.id
+    }
+  }
+
+  thumbnail_configuration {
+    recording_mode          = "INTERVAL"
+    resolution              = %[1]q
+    storage                 = [%[2]q]
+    target_interval_seconds = 30
+  }
+
+  rendition_configuration {
+    rendition_selection = %[3]q
+  }
+}
+`, resolution, storage, renditionSelection))
+}
+
 func testAccRecordingConfigurationConfig_tags1(bucketName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(
 		testAccRecordingConfigurationConfig_s3Bucket(bucketName),
@@ -179,7 +179,10 @@ func resourceRoomRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set("maximum_message_length", out.MaximumMessageLength)
 	d.Set("maximum_message_rate_per_second", out.MaximumMessageRatePerSecond)
 
-	if err := d.Set("message_review_handler", flattenMessageReviewHandler(out.MessageReviewHandler)); err != nil {
+	if v := out.MessageReviewHandler; v != nil && aws.ToString(v.Uri) == "" && len(d.Get("message_review_handler").([]interface{})) == 0 {
+		// A removed handler is reported back with an empty URI.
+		d.Set("message_review_handler", nil)
+	} else if err := d.Set("message_review_handler", flattenMessageReviewHandler(out.MessageReviewHandler)); err != nil {
 		return create.AppendDiagError(diags, names.IVSChat, create.ErrActionSetting, ResNameRoom, d.Id(), err)
 	}
 
@@ -215,7 +218,14 @@ func resourceRoomUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	if d.HasChanges("message_review_handler") {
-		in.MessageReviewHandler = expandMessageReviewHandler(d.Get("message_review_handler").([]interface{}))
+		if v := d.Get("message_review_handler").([]interface{}); len(v) > 0 {
+			in.MessageReviewHandler = expandMessageReviewHandler(v)
+		} else {
+			// Removing the handler is done by setting an empty URI.
+			in.MessageReviewHandler = &types.MessageReviewHandler{
+				Uri: aws.String(""),
+			}
+		}
 		update = true
 	}
 
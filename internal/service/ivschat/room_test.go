@@ -278,6 +278,41 @@ func TestAccIVSChatRoom_update_remove_messageReviewHandler_uri(t *testing.T) {
 	})
 }
 
+func TestAccIVSChatRoom_update_remove_messageReviewHandler(t *testing.T) {
+	ctx := acctest.Context(t)
+	var room1, room2 ivschat.GetRoomOutput
+
+	resourceName := "aws_ivschat_room.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IVSChatEndpointID)
+			testAccPreCheckRoom(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSChatServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoomDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoomConfig_messageReviewHandler(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoomExists(ctx, resourceName, &room1),
+					resource.TestCheckResourceAttr(resourceName, "message_review_handler.#", acctest.CtOne),
+				),
+			},
+			{
+				Config: testAccRoomConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoomExists(ctx, resourceName, &room2),
+					testAccCheckRoomNotRecreated(&room1, &room2),
+					resource.TestCheckResourceAttr(resourceName, "message_review_handler.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckRoomDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IVSChatClient(ctx)
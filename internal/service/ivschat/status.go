@@ -52,6 +52,7 @@ func statusRoom(ctx context.Context, conn *ivschat.Client, id string, updateDeta
 				(aws.ToInt32(updateDetails.MaximumMessageRatePerSecond) != 0 && updateDetails.MaximumMessageRatePerSecond == out.MaximumMessageRatePerSecond) ||
 				(updateDetails.MessageReviewHandler != nil && out.MessageReviewHandler != nil &&
 					(updateDetails.MessageReviewHandler.FallbackResult == out.MessageReviewHandler.FallbackResult || aws.ToString(updateDetails.MessageReviewHandler.Uri) == aws.ToString(out.MessageReviewHandler.Uri))) ||
+				(updateDetails.MessageReviewHandler != nil && aws.ToString(updateDetails.MessageReviewHandler.Uri) == "" && out.MessageReviewHandler == nil) ||
 				(updateDetails.Name != nil && aws.ToString(updateDetails.Name) == aws.ToString(out.Name)) ||
 				(updateDetails.LoggingConfigurationIdentifiers != nil &&
 					(reflect.DeepEqual(updateDetails.LoggingConfigurationIdentifiers, out.LoggingConfigurationIdentifiers) || (len(updateDetails.LoggingConfigurationIdentifiers) == 0 && out.LoggingConfigurationIdentifiers == nil))) {
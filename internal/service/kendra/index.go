@@ -519,7 +519,12 @@ func resourceIndexRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if err := d.Set("user_group_resolution_configuration", flattenUserGroupResolutionConfiguration(resp.UserGroupResolutionConfiguration)); err != nil {
+	userGroupResolutionConfiguration := resp.UserGroupResolutionConfiguration
+	// Once user group resolution has been turned off the API reports a mode of NONE.
+	if v := userGroupResolutionConfiguration; v != nil && v.UserGroupResolutionMode == types.UserGroupResolutionModeNone && len(d.Get("user_group_resolution_configuration").([]interface{})) == 0 {
+		userGroupResolutionConfiguration = nil
+	}
+	if err := d.Set("user_group_resolution_configuration", flattenUserGroupResolutionConfiguration(userGroupResolutionConfiguration)); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
@@ -560,7 +565,14 @@ func resourceIndexUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.UserContextPolicy = types.UserContextPolicy(d.Get("user_context_policy").(string))
 		}
 		if d.HasChange("user_group_resolution_configuration") {
-			input.UserGroupResolutionConfiguration = expandUserGroupResolutionConfiguration(d.Get("user_group_resolution_configuration").([]interface{}))
+			if v := expandUserGroupResolutionConfiguration(d.Get("user_group_resolution_configuration").([]interface{})); v != nil {
+				input.UserGroupResolutionConfiguration = v
+			} else {
+				// Removing the configuration turns off user group resolution through IAM Identity Center.
+				input.UserGroupResolutionConfiguration = &types.UserGroupResolutionConfiguration{
+					UserGroupResolutionMode: types.UserGroupResolutionModeNone,
+				}
+			}
 		}
 		if d.HasChange("user_token_configurations") {
 			input.UserTokenConfigurations = expandUserTokenConfigurations(d.Get("user_token_configurations").([]interface{}))
@@ -780,7 +792,7 @@ func expandRelevance(relevance []interface{}, documentAttributeValueType string)
 		result.Freshness = aws.Bool(v)
 	}
 
-	if v, ok := tfMap["importance"].(int); ok {
+	if v, ok := tfMap["importance"].(int); ok && v > 0 {
 		result.Importance = aws.Int32(int32(v))
 	}
 
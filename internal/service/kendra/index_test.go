@@ -437,6 +437,41 @@ func TestAccKendraIndex_updateUserGroupResolutionConfigurationMode(t *testing.T)
 	})
 }
 
+func TestAccKendraIndex_removeUserGroupResolutionConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var index kendra.DescribeIndexOutput
+
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName3 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	description := "description"
+	resourceName := "aws_kendra_index.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KendraServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIndexDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexConfig_userGroupResolutionMode(rName, rName2, rName3, string(types.UserGroupResolutionModeAwsSso)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIndexExists(ctx, resourceName, &index),
+					resource.TestCheckResourceAttr(resourceName, "user_group_resolution_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "user_group_resolution_configuration.0.user_group_resolution_mode", string(types.UserGroupResolutionModeAwsSso)),
+				),
+			},
+			{
+				Config: testAccIndexConfig_basic(rName, rName2, rName3, description),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIndexExists(ctx, resourceName, &index),
+					resource.TestCheckResourceAttr(resourceName, "user_group_resolution_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKendraIndex_addDocumentMetadataConfigurationUpdates(t *testing.T) {
 	ctx := acctest.Context(t)
 	var index kendra.DescribeIndexOutput
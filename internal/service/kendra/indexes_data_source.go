@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kendra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/kendra"
+	"github.com/aws/aws-sdk-go-v2/service/kendra/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_kendra_indexes")
+func DataSourceIndexes() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceIndexesRead,
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"edition": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.IndexEdition](),
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			names.AttrNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.IndexStatus](),
+			},
+		},
+	}
+}
+
+func dataSourceIndexesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	indexes, err := findIndexes(ctx, conn, &kendra.ListIndicesInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Kendra Indexes: %s", err)
+	}
+
+	var arns, ids, indexNames []string
+
+	for _, index := range indexes {
+		if v, ok := d.GetOk("edition"); ok && string(index.Edition) != v.(string) {
+			continue
+		}
+
+		if v, ok := d.GetOk(names.AttrStatus); ok && string(index.Status) != v.(string) {
+			continue
+		}
+
+		if v, ok := d.GetOk("name_regex"); ok && !regexache.MustCompile(v.(string)).MatchString(aws.ToString(index.Name)) {
+			continue
+		}
+
+		id := aws.ToString(index.Id)
+
+		arns = append(arns, arn.ARN{
+			Partition: meta.(*conns.AWSClient).Partition,
+			Service:   "kendra",
+			Region:    meta.(*conns.AWSClient).Region,
+			AccountID: meta.(*conns.AWSClient).AccountID,
+			Resource:  fmt.Sprintf("index/%s", id),
+		}.String())
+		ids = append(ids, id)
+		indexNames = append(indexNames, aws.ToString(index.Name))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+	d.Set("ids", ids)
+	d.Set(names.AttrNames, indexNames)
+
+	return diags
+}
+
+func findIndexes(ctx context.Context, conn *kendra.Client, input *kendra.ListIndicesInput) ([]types.IndexConfigurationSummary, error) {
+	var output []types.IndexConfigurationSummary
+
+	pages := kendra.NewListIndicesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.IndexConfigurationSummaryItems...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kendra_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKendraIndexesDataSource_nameRegex(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_kendra_indexes.test"
+	resourceName := "aws_kendra_index.test"
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName3 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KendraServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexesDataSourceConfig_nameRegex(rName, rName2, rName3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "arns.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(datasourceName, "arns.0", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(datasourceName, "ids.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(datasourceName, "ids.0", resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(datasourceName, "names.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(datasourceName, "names.0", resourceName, names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func testAccIndexesDataSourceConfig_nameRegex(rName, rName2, rName3 string) string {
+	return acctest.ConfigCompose(
+		testAccIndexConfigBase(rName, rName2),
+		fmt.Sprintf(`
+resource "aws_kendra_index" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.access_cw.arn
+}
+
+data "aws_kendra_indexes" "test" {
+  name_regex = "^${aws_kendra_index.test.name}$"
+  status     = "ACTIVE"
+}
+`, rName3))
+}
@@ -36,6 +36,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceIndex,
 			TypeName: "aws_kendra_index",
 		},
+		{
+			Factory:  DataSourceIndexes,
+			TypeName: "aws_kendra_indexes",
+		},
 		{
 			Factory:  DataSourceQuerySuggestionsBlockList,
 			TypeName: "aws_kendra_query_suggestions_block_list",
@@ -5,6 +5,8 @@ package kms
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
@@ -43,24 +46,75 @@ func resourceCustomKeyStore() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"cloud_hsm_cluster_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 			},
+			"connection_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"custom_key_store_name": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"custom_key_store_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.CustomKeyStoreType](),
+			},
 			"key_store_password": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(7, 32)),
 			},
 			"trust_anchor_certificate": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 			},
+			"xks_proxy_authentication_credential": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_key_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(20, 30),
+						},
+						"raw_secret_access_key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(43, 64),
+						},
+					},
+				},
+			},
+			"xks_proxy_connectivity": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.XksProxyConnectivityType](),
+			},
+			"xks_proxy_uri_endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"xks_proxy_uri_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"xks_proxy_vpc_endpoint_service_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
+
+		CustomizeDiff: resourceCustomKeyStoreCustomizeDiff,
 	}
 }
 
@@ -70,10 +124,46 @@ func resourceCustomKeyStoreCreate(ctx context.Context, d *schema.ResourceData, m
 
 	name := d.Get("custom_key_store_name").(string)
 	input := &kms.CreateCustomKeyStoreInput{
-		CloudHsmClusterId:      aws.String(d.Get("cloud_hsm_cluster_id").(string)),
-		CustomKeyStoreName:     aws.String(name),
-		KeyStorePassword:       aws.String(d.Get("key_store_password").(string)),
-		TrustAnchorCertificate: aws.String(d.Get("trust_anchor_certificate").(string)),
+		CustomKeyStoreName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("custom_key_store_type"); ok {
+		input.CustomKeyStoreType = awstypes.CustomKeyStoreType(v.(string))
+	}
+
+	switch customKeyStoreType(d) {
+	case awstypes.CustomKeyStoreTypeAwsCloudhsm:
+		if v, ok := d.GetOk("cloud_hsm_cluster_id"); ok {
+			input.CloudHsmClusterId = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("key_store_password"); ok {
+			input.KeyStorePassword = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("trust_anchor_certificate"); ok {
+			input.TrustAnchorCertificate = aws.String(v.(string))
+		}
+	case awstypes.CustomKeyStoreTypeExternalKeyStore:
+		if v, ok := d.GetOk("xks_proxy_authentication_credential"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.XksProxyAuthenticationCredential = expandXksProxyAuthenticationCredential(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("xks_proxy_connectivity"); ok {
+			input.XksProxyConnectivity = awstypes.XksProxyConnectivityType(v.(string))
+		}
+
+		if v, ok := d.GetOk("xks_proxy_uri_endpoint"); ok {
+			input.XksProxyUriEndpoint = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("xks_proxy_uri_path"); ok {
+			input.XksProxyUriPath = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("xks_proxy_vpc_endpoint_service_name"); ok {
+			input.XksProxyVpcEndpointServiceName = aws.String(v.(string))
+		}
 	}
 
 	output, err := conn.CreateCustomKeyStore(ctx, input)
@@ -104,9 +194,30 @@ func resourceCustomKeyStoreRead(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	d.Set("cloud_hsm_cluster_id", output.CloudHsmClusterId)
+	d.Set("connection_state", output.ConnectionState)
 	d.Set("custom_key_store_name", output.CustomKeyStoreName)
+	d.Set("custom_key_store_type", output.CustomKeyStoreType)
 	d.Set("key_store_password", d.Get("key_store_password"))
 	d.Set("trust_anchor_certificate", output.TrustAnchorCertificate)
+	if v := output.XksProxyConfiguration; v != nil {
+		// The raw secret access key is never returned by the API.
+		if v.AccessKeyId != nil {
+			d.Set("xks_proxy_authentication_credential", []interface{}{map[string]interface{}{
+				"access_key_id":         aws.ToString(v.AccessKeyId),
+				"raw_secret_access_key": d.Get("xks_proxy_authentication_credential.0.raw_secret_access_key"),
+			}})
+		}
+		d.Set("xks_proxy_connectivity", v.Connectivity)
+		d.Set("xks_proxy_uri_endpoint", v.UriEndpoint)
+		d.Set("xks_proxy_uri_path", v.UriPath)
+		d.Set("xks_proxy_vpc_endpoint_service_name", v.VpcEndpointServiceName)
+	} else {
+		d.Set("xks_proxy_authentication_credential", nil)
+		d.Set("xks_proxy_connectivity", nil)
+		d.Set("xks_proxy_uri_endpoint", nil)
+		d.Set("xks_proxy_uri_path", nil)
+		d.Set("xks_proxy_vpc_endpoint_service_name", nil)
+	}
 
 	return diags
 }
@@ -116,8 +227,13 @@ func resourceCustomKeyStoreUpdate(ctx context.Context, d *schema.ResourceData, m
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
 	input := &kms.UpdateCustomKeyStoreInput{
-		CloudHsmClusterId: aws.String(d.Get("cloud_hsm_cluster_id").(string)),
-		CustomKeyStoreId:  aws.String(d.Id()),
+		CustomKeyStoreId: aws.String(d.Id()),
+	}
+
+	if customKeyStoreType(d) == awstypes.CustomKeyStoreTypeAwsCloudhsm {
+		if v, ok := d.GetOk("cloud_hsm_cluster_id"); ok {
+			input.CloudHsmClusterId = aws.String(v.(string))
+		}
 	}
 
 	if d.HasChange("custom_key_store_name") {
@@ -128,12 +244,51 @@ func resourceCustomKeyStoreUpdate(ctx context.Context, d *schema.ResourceData, m
 		input.KeyStorePassword = aws.String(d.Get("key_store_password").(string))
 	}
 
+	if d.HasChange("xks_proxy_authentication_credential") {
+		if v, ok := d.GetOk("xks_proxy_authentication_credential"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.XksProxyAuthenticationCredential = expandXksProxyAuthenticationCredential(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
+	if d.HasChange("xks_proxy_connectivity") {
+		input.XksProxyConnectivity = awstypes.XksProxyConnectivityType(d.Get("xks_proxy_connectivity").(string))
+	}
+
+	if d.HasChange("xks_proxy_uri_endpoint") {
+		input.XksProxyUriEndpoint = aws.String(d.Get("xks_proxy_uri_endpoint").(string))
+	}
+
+	if d.HasChange("xks_proxy_uri_path") {
+		input.XksProxyUriPath = aws.String(d.Get("xks_proxy_uri_path").(string))
+	}
+
+	if d.HasChange("xks_proxy_vpc_endpoint_service_name") {
+		input.XksProxyVpcEndpointServiceName = aws.String(d.Get("xks_proxy_vpc_endpoint_service_name").(string))
+	}
+
+	// Some properties can only be changed while the custom key store is disconnected.
+	// Disconnect it for the update and connect it again afterwards.
+	reconnect := false
+	if d.HasChanges("key_store_password", "xks_proxy_connectivity", "xks_proxy_uri_endpoint", "xks_proxy_vpc_endpoint_service_name") && d.Get("connection_state").(string) == string(awstypes.ConnectionStateTypeConnected) {
+		if err := disconnectCustomKeyStore(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating KMS Custom Key Store (%s): %s", d.Id(), err)
+		}
+
+		reconnect = true
+	}
+
 	_, err := conn.UpdateCustomKeyStore(ctx, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating KMS Custom Key Store (%s): %s", d.Id(), err)
 	}
 
+	if reconnect {
+		if err := connectCustomKeyStore(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating KMS Custom Key Store (%s): %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceCustomKeyStoreRead(ctx, d, meta)...)
 }
 
@@ -141,18 +296,121 @@ func resourceCustomKeyStoreDelete(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
+	// A custom key store must be disconnected before it can be deleted.
+	if state := d.Get("connection_state").(string); state != "" && state != string(awstypes.ConnectionStateTypeDisconnected) {
+		if err := disconnectCustomKeyStore(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			if errs.IsA[*awstypes.CustomKeyStoreNotFoundException](err) {
+				return diags
+			}
+
+			return sdkdiag.AppendErrorf(diags, "deleting KMS Custom Key Store (%s): %s", d.Id(), err)
+		}
+	}
+
 	log.Printf("[INFO] Deleting KMS Custom Key Store: %s", d.Id())
 	_, err := conn.DeleteCustomKeyStore(ctx, &kms.DeleteCustomKeyStoreInput{
 		CustomKeyStoreId: aws.String(d.Id()),
 	})
 
-	if errs.IsA[*awstypes.NotFoundException](err) {
+	if errs.IsA[*awstypes.NotFoundException](err) || errs.IsA[*awstypes.CustomKeyStoreNotFoundException](err) {
 		return diags
 	}
 
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting KMS Custom Key Store (%s): %s", d.Id(), err)
+	}
+
 	return diags
 }
 
+func resourceCustomKeyStoreCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+	typ := awstypes.CustomKeyStoreTypeAwsCloudhsm
+	if v := config.GetAttr("custom_key_store_type"); !v.IsKnown() {
+		return nil
+	} else if !v.IsNull() {
+		typ = awstypes.CustomKeyStoreType(v.AsString())
+	}
+
+	// Arguments that must be set for each type of custom key store.
+	// All arguments of the other type must not be set.
+	cloudHSMArgs := []string{"cloud_hsm_cluster_id", "key_store_password", "trust_anchor_certificate"}
+	externalKeyStoreArgs := []string{"xks_proxy_authentication_credential", "xks_proxy_connectivity", "xks_proxy_uri_endpoint", "xks_proxy_uri_path"}
+
+	var required, forbidden []string
+	switch typ {
+	case awstypes.CustomKeyStoreTypeAwsCloudhsm:
+		required = cloudHSMArgs
+		forbidden = append(externalKeyStoreArgs, "xks_proxy_vpc_endpoint_service_name")
+	case awstypes.CustomKeyStoreTypeExternalKeyStore:
+		required = externalKeyStoreArgs
+		forbidden = cloudHSMArgs
+
+		if v := config.GetAttr("xks_proxy_connectivity"); v.IsKnown() && !v.IsNull() {
+			if awstypes.XksProxyConnectivityType(v.AsString()) == awstypes.XksProxyConnectivityTypeVpcEndpointService {
+				required = append(required, "xks_proxy_vpc_endpoint_service_name")
+			} else {
+				forbidden = append(forbidden, "xks_proxy_vpc_endpoint_service_name")
+			}
+		}
+	}
+
+	for _, k := range required {
+		if v := config.GetAttr(k); v.IsKnown() && (v.IsNull() || (v.CanIterateElements() && v.LengthInt() == 0)) {
+			return fmt.Errorf("%q is required when custom_key_store_type is %s", k, typ)
+		}
+	}
+
+	for _, k := range forbidden {
+		if v := config.GetAttr(k); !v.IsKnown() || (!v.IsNull() && !(v.CanIterateElements() && v.LengthInt() == 0)) {
+			return fmt.Errorf("%q must not be set when custom_key_store_type is %s", k, typ)
+		}
+	}
+
+	return nil
+}
+
+// customKeyStoreType returns the configured type of custom key store, defaulting to AWS_CLOUDHSM.
+func customKeyStoreType(d *schema.ResourceData) awstypes.CustomKeyStoreType {
+	if v, ok := d.GetOk("custom_key_store_type"); ok {
+		return awstypes.CustomKeyStoreType(v.(string))
+	}
+
+	return awstypes.CustomKeyStoreTypeAwsCloudhsm
+}
+
+func connectCustomKeyStore(ctx context.Context, conn *kms.Client, id string, timeout time.Duration) error {
+	_, err := conn.ConnectCustomKeyStore(ctx, &kms.ConnectCustomKeyStoreInput{
+		CustomKeyStoreId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("connecting: %w", err)
+	}
+
+	if _, err := waitCustomKeyStoreConnected(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for connection: %w", err)
+	}
+
+	return nil
+}
+
+func disconnectCustomKeyStore(ctx context.Context, conn *kms.Client, id string, timeout time.Duration) error {
+	_, err := conn.DisconnectCustomKeyStore(ctx, &kms.DisconnectCustomKeyStoreInput{
+		CustomKeyStoreId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("disconnecting: %w", err)
+	}
+
+	if _, err := waitCustomKeyStoreDisconnected(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for disconnection: %w", err)
+	}
+
+	return nil
+}
+
 func findCustomKeyStoreByID(ctx context.Context, conn *kms.Client, id string) (*awstypes.CustomKeyStoresListEntry, error) {
 	input := &kms.DescribeCustomKeyStoresInput{
 		CustomKeyStoreId: aws.String(id),
@@ -198,3 +456,81 @@ func findCustomKeyStores(ctx context.Context, conn *kms.Client, input *kms.Descr
 
 	return output, nil
 }
+
+func statusCustomKeyStoreConnectionState(ctx context.Context, conn *kms.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findCustomKeyStoreByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.ConnectionState), nil
+	}
+}
+
+func waitCustomKeyStoreConnected(ctx context.Context, conn *kms.Client, id string, timeout time.Duration) (*awstypes.CustomKeyStoresListEntry, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.ConnectionStateTypeConnecting, awstypes.ConnectionStateTypeDisconnected),
+		Target:     enum.Slice(awstypes.ConnectionStateTypeConnected),
+		Refresh:    statusCustomKeyStoreConnectionState(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CustomKeyStoresListEntry); ok {
+		if output.ConnectionState == awstypes.ConnectionStateTypeFailed {
+			tfresource.SetLastError(err, errors.New(string(output.ConnectionErrorCode)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCustomKeyStoreDisconnected(ctx context.Context, conn *kms.Client, id string, timeout time.Duration) (*awstypes.CustomKeyStoresListEntry, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.ConnectionStateTypeConnected, awstypes.ConnectionStateTypeConnecting, awstypes.ConnectionStateTypeDisconnecting),
+		Target:     enum.Slice(awstypes.ConnectionStateTypeDisconnected),
+		Refresh:    statusCustomKeyStoreConnectionState(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.CustomKeyStoresListEntry); ok {
+		if output.ConnectionState == awstypes.ConnectionStateTypeFailed {
+			tfresource.SetLastError(err, errors.New(string(output.ConnectionErrorCode)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandXksProxyAuthenticationCredential(tfMap map[string]interface{}) *awstypes.XksProxyAuthenticationCredentialType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.XksProxyAuthenticationCredentialType{}
+
+	if v, ok := tfMap["access_key_id"].(string); ok && v != "" {
+		apiObject.AccessKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["raw_secret_access_key"].(string); ok && v != "" {
+		apiObject.RawSecretAccessKey = aws.String(v)
+	}
+
+	return apiObject
+}
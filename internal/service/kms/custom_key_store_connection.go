@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_kms_custom_key_store_connection", name="Custom Key Store Connection")
+func resourceCustomKeyStoreConnection() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCustomKeyStoreConnectionCreate,
+		ReadWithoutTimeout:   resourceCustomKeyStoreConnectionRead,
+		DeleteWithoutTimeout: resourceCustomKeyStoreConnectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"connection_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_key_store_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceCustomKeyStoreConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	id := d.Get("custom_key_store_id").(string)
+
+	if err := connectCustomKeyStore(ctx, conn, id, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating KMS Custom Key Store Connection (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceCustomKeyStoreConnectionRead(ctx, d, meta)...)
+}
+
+func resourceCustomKeyStoreConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	output, err := findConnectedCustomKeyStoreByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] KMS Custom Key Store Connection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Custom Key Store Connection (%s): %s", d.Id(), err)
+	}
+
+	d.Set("connection_state", output.ConnectionState)
+	d.Set("custom_key_store_id", output.CustomKeyStoreId)
+
+	return diags
+}
+
+func resourceCustomKeyStoreConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	log.Printf("[INFO] Deleting KMS Custom Key Store Connection: %s", d.Id())
+	err := disconnectCustomKeyStore(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete))
+
+	if errs.IsA[*awstypes.CustomKeyStoreNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting KMS Custom Key Store Connection (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// findConnectedCustomKeyStoreByID returns the custom key store unless it is disconnected.
+func findConnectedCustomKeyStoreByID(ctx context.Context, conn *kms.Client, id string) (*awstypes.CustomKeyStoresListEntry, error) {
+	output, err := findCustomKeyStoreByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch state := output.ConnectionState; state {
+	case awstypes.ConnectionStateTypeDisconnected, awstypes.ConnectionStateTypeDisconnecting:
+		return nil, &retry.NotFoundError{
+			Message: string(state),
+		}
+	}
+
+	return output, nil
+}
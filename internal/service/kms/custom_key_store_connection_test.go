@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccCustomKeyStoreConnection_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	clusterID := acctest.SkipIfEnvVarNotSet(t, "CLOUD_HSM_CLUSTER_ID")
+	trustAnchorCertificate := acctest.SkipIfEnvVarNotSet(t, "TRUST_ANCHOR_CERTIFICATE")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_custom_key_store_connection.test"
+	keyStoreResourceName := "aws_kms_custom_key_store.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.KMSEndpointID)
+			testAccCustomKeyStoresPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomKeyStoreConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConnectionConfig_basic(rName, clusterID, trustAnchorCertificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreConnectionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", string(awstypes.ConnectionStateTypeConnected)),
+					resource.TestCheckResourceAttrPair(resourceName, "custom_key_store_id", keyStoreResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCustomKeyStoreConnectionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_kms_custom_key_store_connection" {
+				continue
+			}
+
+			_, err := tfkms.FindConnectedCustomKeyStoreByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("KMS Custom Key Store %s still connected", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCustomKeyStoreConnectionExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
+
+		_, err := tfkms.FindConnectedCustomKeyStoreByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCustomKeyStoreConnectionConfig_basic(rName, clusterId, anchorCertificate string) string {
+	return acctest.ConfigCompose(testAccCustomKeyStoreConfig_basic(rName, clusterId, anchorCertificate), `
+resource "aws_kms_custom_key_store_connection" "test" {
+  custom_key_store_id = aws_kms_custom_key_store.test.id
+}
+`)
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -133,6 +134,90 @@ func testAccCustomKeyStore_disappears(t *testing.T) {
 	})
 }
 
+func testAccCustomKeyStore_externalKeyStore(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	uriEndpoint := acctest.SkipIfEnvVarNotSet(t, "XKS_PROXY_URI_ENDPOINT")
+	accessKeyID := acctest.SkipIfEnvVarNotSet(t, "XKS_PROXY_ACCESS_KEY_ID")
+	secretAccessKey := acctest.SkipIfEnvVarNotSet(t, "XKS_PROXY_SECRET_ACCESS_KEY")
+	var customkeystore awstypes.CustomKeyStoresListEntry
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_custom_key_store.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.KMSEndpointID)
+			testAccCustomKeyStoresPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomKeyStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConfig_externalKeyStore(rName, uriEndpoint, "/example/kms/xks/v1", accessKeyID, secretAccessKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(ctx, resourceName, &customkeystore),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", string(awstypes.ConnectionStateTypeDisconnected)),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_type", string(awstypes.CustomKeyStoreTypeExternalKeyStore)),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_authentication_credential.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_connectivity", string(awstypes.XksProxyConnectivityTypePublicEndpoint)),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_uri_endpoint", uriEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_uri_path", "/example/kms/xks/v1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"xks_proxy_authentication_credential"},
+			},
+			{
+				Config: testAccCustomKeyStoreConfig_externalKeyStore(rName, uriEndpoint, "/example/prefix/kms/xks/v1", accessKeyID, secretAccessKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(ctx, resourceName, &customkeystore),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_uri_path", "/example/prefix/kms/xks/v1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomKeyStore_invalidArguments(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.KMSEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomKeyStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCustomKeyStoreConfig_cloudHSMMissingArguments(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`"trust_anchor_certificate" is required when custom_key_store_type is AWS_CLOUDHSM`),
+			},
+			{
+				Config:      testAccCustomKeyStoreConfig_externalKeyStoreWithCloudHSMArguments(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`"cloud_hsm_cluster_id" must not be set when custom_key_store_type is EXTERNAL_KEY_STORE`),
+			},
+			{
+				Config:      testAccCustomKeyStoreConfig_externalKeyStoreVPCEndpointServiceMissing(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`"xks_proxy_vpc_endpoint_service_name" is required when custom_key_store_type is EXTERNAL_KEY_STORE`),
+			},
+		},
+	})
+}
+
 func testAccCheckCustomKeyStoreDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
@@ -205,3 +290,68 @@ resource "aws_kms_custom_key_store" "test" {
 }
 `, rName, clusterId, anchorCertificate)
 }
+
+func testAccCustomKeyStoreConfig_externalKeyStore(rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  custom_key_store_name = %[1]q
+  custom_key_store_type = "EXTERNAL_KEY_STORE"
+
+  xks_proxy_authentication_credential {
+    access_key_id         = %[4]q
+    raw_secret_access_key = %[5]q
+  }
+
+  xks_proxy_connectivity = "PUBLIC_ENDPOINT"
+  xks_proxy_uri_endpoint = %[2]q
+  xks_proxy_uri_path     = %[3]q
+}
+`, rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey)
+}
+
+func testAccCustomKeyStoreConfig_cloudHSMMissingArguments(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  cloud_hsm_cluster_id  = "cluster-1234567890a"
+  custom_key_store_name = %[1]q
+  key_store_password    = "noplaintextpasswords1"
+}
+`, rName)
+}
+
+func testAccCustomKeyStoreConfig_externalKeyStoreWithCloudHSMArguments(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  cloud_hsm_cluster_id  = "cluster-1234567890a"
+  custom_key_store_name = %[1]q
+  custom_key_store_type = "EXTERNAL_KEY_STORE"
+
+  xks_proxy_authentication_credential {
+    access_key_id         = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+    raw_secret_access_key = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+  }
+
+  xks_proxy_connectivity = "PUBLIC_ENDPOINT"
+  xks_proxy_uri_endpoint = "https://myproxy.xks.example.com"
+  xks_proxy_uri_path     = "/example/kms/xks/v1"
+}
+`, rName)
+}
+
+func testAccCustomKeyStoreConfig_externalKeyStoreVPCEndpointServiceMissing(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  custom_key_store_name = %[1]q
+  custom_key_store_type = "EXTERNAL_KEY_STORE"
+
+  xks_proxy_authentication_credential {
+    access_key_id         = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+    raw_secret_access_key = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+  }
+
+  xks_proxy_connectivity = "VPC_ENDPOINT_SERVICE"
+  xks_proxy_uri_endpoint = "https://myproxy.xks.example.com"
+  xks_proxy_uri_path     = "/example/kms/xks/v1"
+}
+`, rName)
+}
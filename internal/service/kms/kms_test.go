@@ -17,8 +17,13 @@ func TestAccKMS_serial(t *testing.T) {
 			"basic":            testAccCustomKeyStore_basic,
 			"update":           testAccCustomKeyStore_update,
 			"disappears":       testAccCustomKeyStore_disappears,
+			"externalKeyStore": testAccCustomKeyStore_externalKeyStore,
+			"invalidArguments": testAccCustomKeyStore_invalidArguments,
 			"DataSource_basic": testAccCustomKeyStoreDataSource_basic,
 		},
+		"CustomKeyStoreConnection": {
+			"basic": testAccCustomKeyStoreConnection_basic,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
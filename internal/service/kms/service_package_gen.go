@@ -79,6 +79,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_kms_custom_key_store",
 			Name:     "Custom Key Store",
 		},
+		{
+			Factory:  resourceCustomKeyStoreConnection,
+			TypeName: "aws_kms_custom_key_store_connection",
+			Name:     "Custom Key Store Connection",
+		},
 		{
 			Factory:  resourceExternalKey,
 			TypeName: "aws_kms_external_key",
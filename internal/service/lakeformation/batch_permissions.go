@@ -0,0 +1,694 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// See https://docs.aws.amazon.com/lake-formation/latest/APIReference/API_BatchGrantPermissions.html.
+	batchPermissionsMaxEntries = 20
+)
+
+// @SDKResource("aws_lakeformation_batch_permissions", name="Batch Permissions")
+func resourceBatchPermissions() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBatchPermissionsCreate,
+		ReadWithoutTimeout:   resourceBatchPermissionsRead,
+		UpdateWithoutTimeout: resourceBatchPermissionsUpdate,
+		DeleteWithoutTimeout: resourceBatchPermissionsDelete,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrCatalogID: {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"entry": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_resource": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"data_location": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrARN: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									names.AttrCatalogID: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+								},
+							},
+						},
+						names.AttrDatabase: {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCatalogID: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"lf_tag": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCatalogID: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									names.AttrKey: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									names.AttrValues: {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validateLFTagValues(),
+										},
+									},
+								},
+							},
+						},
+						"lf_tag_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCatalogID: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									names.AttrExpression: {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrKey: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												names.AttrValues: {
+													Type:     schema.TypeSet,
+													Required: true,
+													MinItems: 1,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validateLFTagValues(),
+													},
+												},
+											},
+										},
+									},
+									names.AttrResourceType: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.ResourceType](),
+									},
+								},
+							},
+						},
+						names.AttrPermissions: {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[awstypes.Permission](),
+							},
+						},
+						"permissions_with_grant_option": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[awstypes.Permission](),
+							},
+						},
+						names.AttrPrincipal: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validPrincipal,
+						},
+						"table": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCatalogID: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									names.AttrDatabaseName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"wildcard": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"table_with_columns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCatalogID: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"column_names": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.NoZeroValues,
+										},
+									},
+									names.AttrDatabaseName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"excluded_column_names": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.NoZeroValues,
+										},
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"wildcard": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBatchPermissionsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	entries, err := expandBatchPermissionsRequestEntries(d.Get("entry").(*schema.Set).List())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lake Formation Batch Permissions: %s", err)
+	}
+
+	if err := batchGrantPermissions(ctx, conn, d.Get(names.AttrCatalogID).(string), entries); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lake Formation Batch Permissions: %s", err)
+	}
+
+	d.SetId(id.UniqueId())
+
+	return append(diags, resourceBatchPermissionsRead(ctx, d, meta)...)
+}
+
+func resourceBatchPermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	catalogID := d.Get(names.AttrCatalogID).(string)
+	var tfList []interface{}
+
+	// Lake Formation reports permissions per principal and resource, which need not match how they were
+	// granted (see resourcePermissionsRead). Drift is therefore detected per entry: an entry whose
+	// permissions can no longer be found is removed from state and granted again on the next apply.
+	for _, tfMapRaw := range d.Get("entry").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		found, err := findBatchPermissionsEntry(ctx, conn, catalogID, tfMap, d.IsNewResource())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Lake Formation Batch Permissions (%s): %s", d.Id(), err)
+		}
+
+		if found {
+			tfList = append(tfList, tfMap)
+		}
+	}
+
+	if !d.IsNewResource() && len(tfList) == 0 {
+		log.Printf("[WARN] Lake Formation Batch Permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set("entry", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting entry: %s", err)
+	}
+
+	return diags
+}
+
+func resourceBatchPermissionsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	catalogID := d.Get(names.AttrCatalogID).(string)
+	o, n := d.GetChange("entry")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+
+	// Revoke removed entries first so that permissions moved between entries end up granted.
+	if del := os.Difference(ns).List(); len(del) > 0 {
+		entries, err := expandBatchPermissionsRequestEntries(del)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lake Formation Batch Permissions (%s): %s", d.Id(), err)
+		}
+
+		if err := batchRevokePermissions(ctx, conn, catalogID, entries); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lake Formation Batch Permissions (%s): %s", d.Id(), err)
+		}
+	}
+
+	if add := ns.Difference(os).List(); len(add) > 0 {
+		entries, err := expandBatchPermissionsRequestEntries(add)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lake Formation Batch Permissions (%s): %s", d.Id(), err)
+		}
+
+		if err := batchGrantPermissions(ctx, conn, catalogID, entries); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lake Formation Batch Permissions (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceBatchPermissionsRead(ctx, d, meta)...)
+}
+
+func resourceBatchPermissionsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	entries, err := expandBatchPermissionsRequestEntries(d.Get("entry").(*schema.Set).List())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lake Formation Batch Permissions (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting Lake Formation Batch Permissions: %s", d.Id())
+	if err := batchRevokePermissions(ctx, conn, d.Get(names.AttrCatalogID).(string), entries); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lake Formation Batch Permissions (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func batchGrantPermissions(ctx context.Context, conn *lakeformation.Client, catalogID string, entries []awstypes.BatchPermissionsRequestEntry) error {
+	for _, chunk := range tfslices.Chunks(entries, batchPermissionsMaxEntries) {
+		input := &lakeformation.BatchGrantPermissionsInput{
+			Entries: chunk,
+		}
+
+		if catalogID != "" {
+			input.CatalogId = aws.String(catalogID)
+		}
+
+		err := retry.RetryContext(ctx, IAMPropagationTimeout, func() *retry.RetryError {
+			output, err := conn.BatchGrantPermissions(ctx, input)
+
+			if errs.IsA[*awstypes.ConcurrentModificationException](err) {
+				return retry.RetryableError(err)
+			}
+
+			if err != nil {
+				return retry.NonRetryableError(fmt.Errorf("granting permissions: %w", err))
+			}
+
+			if len(output.Failures) == 0 {
+				return nil
+			}
+
+			err = batchPermissionsFailuresError(output.Failures)
+
+			if !tfslices.All(output.Failures, isRetryableBatchPermissionsFailure) {
+				return retry.NonRetryableError(fmt.Errorf("granting permissions: %w", err))
+			}
+
+			// Only retry the entries that failed.
+			input.Entries = tfslices.ApplyToAll(output.Failures, func(v awstypes.BatchPermissionsFailureEntry) awstypes.BatchPermissionsRequestEntry {
+				return *v.RequestEntry
+			})
+
+			return retry.RetryableError(err)
+		})
+
+		if tfresource.TimedOut(err) {
+			var output *lakeformation.BatchGrantPermissionsOutput
+			output, err = conn.BatchGrantPermissions(ctx, input)
+
+			if err == nil && len(output.Failures) > 0 {
+				err = batchPermissionsFailuresError(output.Failures)
+			}
+
+			if err != nil {
+				err = fmt.Errorf("granting permissions: %w", err)
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func batchRevokePermissions(ctx context.Context, conn *lakeformation.Client, catalogID string, entries []awstypes.BatchPermissionsRequestEntry) error {
+	for _, chunk := range tfslices.Chunks(entries, batchPermissionsMaxEntries) {
+		input := &lakeformation.BatchRevokePermissionsInput{
+			Entries: chunk,
+		}
+
+		if catalogID != "" {
+			input.CatalogId = aws.String(catalogID)
+		}
+
+		var failures []awstypes.BatchPermissionsFailureEntry
+		err := retry.RetryContext(ctx, permissionsDeleteRetryTimeout, func() *retry.RetryError {
+			output, err := conn.BatchRevokePermissions(ctx, input)
+
+			if errs.IsA[*awstypes.ConcurrentModificationException](err) {
+				return retry.RetryableError(err)
+			}
+
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			failures = output.Failures
+
+			return nil
+		})
+
+		if tfresource.TimedOut(err) {
+			var output *lakeformation.BatchRevokePermissionsOutput
+			output, err = conn.BatchRevokePermissions(ctx, input)
+
+			if err == nil {
+				failures = output.Failures
+			}
+		}
+
+		if err != nil {
+			return fmt.Errorf("revoking permissions: %w", err)
+		}
+
+		// Permissions that have already been revoked are not an error.
+		failures = tfslices.Filter(failures, func(v awstypes.BatchPermissionsFailureEntry) bool {
+			if v.Error == nil {
+				return true
+			}
+
+			message := aws.ToString(v.Error.ErrorMessage)
+
+			return !strings.Contains(message, "No permissions revoked") && !strings.Contains(message, "non-existent column")
+		})
+
+		if len(failures) > 0 {
+			return fmt.Errorf("revoking permissions: %w", batchPermissionsFailuresError(failures))
+		}
+	}
+
+	return nil
+}
+
+func isRetryableBatchPermissionsFailure(v awstypes.BatchPermissionsFailureEntry) bool {
+	if v.Error == nil {
+		return false
+	}
+
+	if aws.ToString(v.Error.ErrorCode) == "ConcurrentModificationException" {
+		return true
+	}
+
+	message := aws.ToString(v.Error.ErrorMessage)
+
+	for _, s := range []string{
+		"Invalid principal",
+		"Grantee has no permissions",
+		"register the S3 path",
+		"is not authorized to access requested permissions",
+	} {
+		if strings.Contains(message, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func batchPermissionsFailuresError(failures []awstypes.BatchPermissionsFailureEntry) error {
+	var errs []error
+
+	for _, v := range failures {
+		var principal string
+		if v.RequestEntry != nil && v.RequestEntry.Principal != nil {
+			principal = aws.ToString(v.RequestEntry.Principal.DataLakePrincipalIdentifier)
+		}
+
+		if v.Error == nil {
+			errs = append(errs, fmt.Errorf("principal (%s): unknown error", principal))
+			continue
+		}
+
+		errs = append(errs, fmt.Errorf("principal (%s): %s: %s", principal, aws.ToString(v.Error.ErrorCode), aws.ToString(v.Error.ErrorMessage)))
+	}
+
+	return errors.Join(errs...)
+}
+
+// findBatchPermissionsEntry reports whether any of the permissions of the entry are still granted.
+func findBatchPermissionsEntry(ctx context.Context, conn *lakeformation.Client, catalogID string, tfMap map[string]interface{}, isNewResource bool) (bool, error) {
+	input := &lakeformation.ListPermissionsInput{
+		Principal: &awstypes.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(tfMap[names.AttrPrincipal].(string)),
+		},
+		Resource: expandBatchPermissionsResource(tfMap),
+	}
+
+	if catalogID != "" {
+		input.CatalogId = aws.String(catalogID)
+	}
+
+	tableType := ""
+	columnNames := make([]string, 0)
+	excludedColumnNames := make([]string, 0)
+	columnWildcard := false
+
+	if input.Resource.Table != nil {
+		tableType = TableTypeTable
+	}
+
+	if v, ok := tfMap["table_with_columns"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		// can't ListPermissions for TableWithColumns, so use Table instead
+		input.Resource.Table = ExpandTableWithColumnsResourceAsTable(tfMap)
+		input.Resource.TableWithColumns = nil
+		tableType = TableTypeTableWithColumns
+
+		if v, ok := tfMap["column_names"].(*schema.Set); ok && v.Len() > 0 {
+			columnNames = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["excluded_column_names"].(*schema.Set); ok && v.Len() > 0 {
+			excludedColumnNames = flex.ExpandStringValueSet(v)
+		}
+
+		columnWildcard = tfMap["wildcard"].(bool)
+	}
+
+	var permissions []awstypes.PrincipalResourcePermissions
+	var err error
+
+	if isNewResource {
+		permissions, err = waitPermissionsReady(ctx, conn, input, tableType, columnNames, excludedColumnNames, columnWildcard)
+	} else {
+		var outputRaw interface{}
+		outputRaw, _, err = statusPermissions(ctx, conn, input, tableType, columnNames, excludedColumnNames, columnWildcard)()
+		permissions, _ = outputRaw.([]awstypes.PrincipalResourcePermissions)
+	}
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) || errs.IsAErrorMessageContains[*awstypes.AccessDeniedException](err, "Resource does not exist") {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return len(FilterPermissions(input, tableType, columnNames, excludedColumnNames, columnWildcard, permissions)) > 0, nil
+}
+
+func expandBatchPermissionsRequestEntries(tfList []interface{}) ([]awstypes.BatchPermissionsRequestEntry, error) {
+	apiObjects := make([]awstypes.BatchPermissionsRequestEntry, 0, len(tfList))
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		principal := tfMap[names.AttrPrincipal].(string)
+		resource := expandBatchPermissionsResource(tfMap)
+
+		if n := countBatchPermissionsResources(resource); n != 1 {
+			return nil, fmt.Errorf("entry (%s): exactly one of catalog_resource, data_location, database, lf_tag, lf_tag_policy, table or table_with_columns must be specified", principal)
+		}
+
+		apiObject := awstypes.BatchPermissionsRequestEntry{
+			Id:          aws.String(strconv.Itoa(i)),
+			Permissions: flex.ExpandStringyValueSet[awstypes.Permission](tfMap[names.AttrPermissions].(*schema.Set)),
+			Principal: &awstypes.DataLakePrincipal{
+				DataLakePrincipalIdentifier: aws.String(principal),
+			},
+			Resource: resource,
+		}
+
+		if v, ok := tfMap["permissions_with_grant_option"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.PermissionsWithGrantOption = flex.ExpandStringyValueSet[awstypes.Permission](v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+func expandBatchPermissionsResource(tfMap map[string]interface{}) *awstypes.Resource {
+	apiObject := &awstypes.Resource{}
+
+	if v, ok := tfMap["catalog_resource"].(bool); ok && v {
+		apiObject.Catalog = ExpandCatalogResource()
+	}
+
+	if v, ok := tfMap["data_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DataLocation = ExpandDataLocationResource(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap[names.AttrDatabase].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Database = ExpandDatabaseResource(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["lf_tag"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LFTag = ExpandLFTagKeyResource(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["lf_tag_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LFTagPolicy = ExpandLFTagPolicyResource(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["table"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Table = ExpandTableResource(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["table_with_columns"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.TableWithColumns = expandTableColumnsResource(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func countBatchPermissionsResources(apiObject *awstypes.Resource) int {
+	n := 0
+
+	for _, v := range []bool{
+		apiObject.Catalog != nil,
+		apiObject.DataLocation != nil,
+		apiObject.Database != nil,
+		apiObject.LFTag != nil,
+		apiObject.LFTagPolicy != nil,
+		apiObject.Table != nil,
+		apiObject.TableWithColumns != nil,
+	} {
+		if v {
+			n++
+		}
+	}
+
+	return n
+}
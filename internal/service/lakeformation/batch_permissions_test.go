@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccBatchPermissions_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_batch_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.LakeFormation) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBatchPermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchPermissionsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchPermissionsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"database.#":      acctest.CtOne,
+						"database.0.name": rName,
+						"permissions.#":   "2",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "entry.*.permissions.*", string(awstypes.PermissionAlter)),
+					resource.TestCheckTypeSetElemAttr(resourceName, "entry.*.permissions.*", string(awstypes.PermissionDescribe)),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "entry.*.principal", "aws_iam_role.test.0", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "entry.*.principal", "aws_iam_role.test.1", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccBatchPermissions_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_batch_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.LakeFormation) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBatchPermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchPermissionsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchPermissionsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "2"),
+				),
+			},
+			{
+				Config: testAccBatchPermissionsConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchPermissionsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"permissions.#":                   acctest.CtOne,
+						"permissions.0":                   string(awstypes.PermissionCreateTable),
+						"permissions_with_grant_option.#": acctest.CtOne,
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "entry.*.principal", "aws_iam_role.test.2", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBatchPermissionsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lakeformation_batch_permissions" {
+				continue
+			}
+
+			permCount, err := batchPermissionsCountForResource(ctx, conn, rs)
+
+			if err != nil {
+				return fmt.Errorf("acceptance test: error listing Lake Formation permissions (%s): %w", rs.Primary.ID, err)
+			}
+
+			if permCount != 0 {
+				return fmt.Errorf("acceptance test: Lake Formation Batch Permissions (%s) still exist: %d", rs.Primary.ID, permCount)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBatchPermissionsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+
+		permCount, err := batchPermissionsCountForResource(ctx, conn, rs)
+
+		if err != nil {
+			return fmt.Errorf("acceptance test: error listing Lake Formation permissions (%s): %w", rs.Primary.ID, err)
+		}
+
+		if permCount == 0 {
+			return fmt.Errorf("acceptance test: Lake Formation Batch Permissions (%s) do not exist or could not be found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// batchPermissionsCountForResource counts the permissions of the database entries of the resource.
+func batchPermissionsCountForResource(ctx context.Context, conn *lakeformation.Client, rs *terraform.ResourceState) (int, error) {
+	re := regexp.MustCompile(`^entry\.(\d+)\.principal$`)
+	count := 0
+
+	for k, principal := range rs.Primary.Attributes {
+		m := re.FindStringSubmatch(k)
+		if m == nil {
+			continue
+		}
+
+		databaseName := rs.Primary.Attributes[fmt.Sprintf("entry.%s.database.0.name", m[1])]
+		if databaseName == "" {
+			continue
+		}
+
+		input := &lakeformation.ListPermissionsInput{
+			Principal: &awstypes.DataLakePrincipal{
+				DataLakePrincipalIdentifier: aws.String(principal),
+			},
+			Resource: &awstypes.Resource{
+				Database: &awstypes.DatabaseResource{
+					Name: aws.String(databaseName),
+				},
+			},
+		}
+
+		pages := lakeformation.NewListPermissionsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if errs.IsA[*awstypes.EntityNotFoundException](err) {
+				break
+			}
+
+			if err != nil {
+				return 0, err
+			}
+
+			for _, v := range page.PrincipalResourcePermissions {
+				if v.Principal != nil && aws.ToString(v.Principal.DataLakePrincipalIdentifier) == principal {
+					count++
+				}
+			}
+		}
+	}
+
+	return count, nil
+}
+
+func testAccBatchPermissionsConfig_base(rName string, roleCount int) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  count = %[2]d
+
+  name = "%[1]s-${count.index}"
+  path = "/"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+`, rName, roleCount)
+}
+
+func testAccBatchPermissionsConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBatchPermissionsConfig_base(rName, 2), `
+resource "aws_lakeformation_batch_permissions" "test" {
+  dynamic "entry" {
+    for_each = aws_iam_role.test
+
+    content {
+      permissions = ["ALTER", "DESCRIBE"]
+      principal   = entry.value.arn
+
+      database {
+        name = aws_glue_catalog_database.test.name
+      }
+    }
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`)
+}
+
+func testAccBatchPermissionsConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccBatchPermissionsConfig_base(rName, 3), `
+resource "aws_lakeformation_batch_permissions" "test" {
+  dynamic "entry" {
+    for_each = slice(aws_iam_role.test, 0, 2)
+
+    content {
+      permissions = ["ALTER", "DESCRIBE"]
+      principal   = entry.value.arn
+
+      database {
+        name = aws_glue_catalog_database.test.name
+      }
+    }
+  }
+
+  entry {
+    permissions                   = ["CREATE_TABLE"]
+    permissions_with_grant_option = ["CREATE_TABLE"]
+    principal                     = aws_iam_role.test[2].arn
+
+    database {
+      name = aws_glue_catalog_database.test.name
+    }
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`)
+}
@@ -6,8 +6,10 @@ package lakeformation
 // exports used for testing only.
 var (
 	ResourceDataCellsFilter = newResourceDataCellsFilter
+	ResourceOptIn           = resourceOptIn
 	ResourceResourceLFTag   = newResourceResourceLFTag
 
 	FindDataCellsFilterByID = findDataCellsFilterByID
+	FindOptInByTwoPartKey   = findOptInByTwoPartKey
 	FindResourceLFTagByID   = findResourceLFTagByID
 )
@@ -14,6 +14,10 @@ func TestAccLakeFormation_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"BatchPermissions": {
+			"basic":  testAccBatchPermissions_basic,
+			"update": testAccBatchPermissions_update,
+		},
 		"DataLakeSettings": {
 			"basic":            testAccDataLakeSettings_basic,
 			"disappears":       testAccDataLakeSettings_disappears,
@@ -30,6 +34,11 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"basic":          testAccDataLakeSettingsDataSource_basic,
 			"readOnlyAdmins": testAccDataLakeSettingsDataSource_readOnlyAdmins,
 		},
+		"OptIn": {
+			"basic":      testAccOptIn_basic,
+			"disappears": testAccOptIn_disappears,
+			"table":      testAccOptIn_table,
+		},
 		"PermissionsBasic": {
 			"basic":               testAccPermissions_basic,
 			names.AttrDatabase:    testAccPermissions_database,
@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_lakeformation_opt_in", name="Opt In")
+func resourceOptIn() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOptInCreate,
+		ReadWithoutTimeout:   resourceOptInRead,
+		DeleteWithoutTimeout: resourceOptInDelete,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrDatabase: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{names.AttrDatabase, "table"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrCatalogID: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrPrincipal: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validPrincipal,
+			},
+			"table": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{names.AttrDatabase, "table"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrCatalogID: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						names.AttrDatabaseName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							AtLeastOneOf: []string{
+								"table.0.name",
+								"table.0.wildcard",
+							},
+						},
+						"wildcard": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							AtLeastOneOf: []string{
+								"table.0.name",
+								"table.0.wildcard",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceOptInCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	input := &lakeformation.CreateLakeFormationOptInInput{
+		Principal: &awstypes.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get(names.AttrPrincipal).(string)),
+		},
+		Resource: expandOptInResource(d),
+	}
+
+	_, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.InvalidInputException](ctx, IAMPropagationTimeout, func() (interface{}, error) {
+		return conn.CreateLakeFormationOptIn(ctx, input)
+	}, "Invalid principal")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lake Formation Opt In: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", create.StringHashcode(prettify(input))))
+
+	return append(diags, resourceOptInRead(ctx, d, meta)...)
+}
+
+func resourceOptInRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	output, err := findOptInByTwoPartKey(ctx, conn, d.Get(names.AttrPrincipal).(string), expandOptInResource(d))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lake Formation Opt In (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lake Formation Opt In (%s): %s", d.Id(), err)
+	}
+
+	if output.LastModified != nil {
+		d.Set("last_modified", aws.ToTime(output.LastModified).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified", nil)
+	}
+	d.Set("last_updated_by", output.LastUpdatedBy)
+
+	return diags
+}
+
+func resourceOptInDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationClient(ctx)
+
+	log.Printf("[INFO] Deleting Lake Formation Opt In: %s", d.Id())
+	_, err := conn.DeleteLakeFormationOptIn(ctx, &lakeformation.DeleteLakeFormationOptInInput{
+		Principal: &awstypes.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get(names.AttrPrincipal).(string)),
+		},
+		Resource: expandOptInResource(d),
+	})
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lake Formation Opt In (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findOptInByTwoPartKey(ctx context.Context, conn *lakeformation.Client, principal string, resource *awstypes.Resource) (*awstypes.LakeFormationOptInsInfo, error) {
+	input := &lakeformation.ListLakeFormationOptInsInput{
+		Principal: &awstypes.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(principal),
+		},
+		Resource: resource,
+	}
+
+	pages := lakeformation.NewListLakeFormationOptInsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.EntityNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.LakeFormationOptInsInfoList {
+			if v.Principal != nil && aws.ToString(v.Principal.DataLakePrincipalIdentifier) == principal {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func expandOptInResource(d *schema.ResourceData) *awstypes.Resource {
+	apiObject := &awstypes.Resource{}
+
+	if v, ok := d.GetOk(names.AttrDatabase); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Database = ExpandDatabaseResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Table = ExpandTableResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
@@ -50,6 +50,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceBatchPermissions,
+			TypeName: "aws_lakeformation_batch_permissions",
+			Name:     "Batch Permissions",
+		},
 		{
 			Factory:  ResourceDataLakeSettings,
 			TypeName: "aws_lakeformation_data_lake_settings",
@@ -58,6 +63,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceLFTag,
 			TypeName: "aws_lakeformation_lf_tag",
 		},
+		{
+			Factory:  resourceOptIn,
+			TypeName: "aws_lakeformation_opt_in",
+			Name:     "Opt In",
+		},
 		{
 			Factory:  ResourcePermissions,
 			TypeName: "aws_lakeformation_permissions",
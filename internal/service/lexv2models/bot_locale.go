@@ -6,6 +6,7 @@ package lexv2models
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -28,6 +29,7 @@ import (
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -57,6 +59,38 @@ func (r *resourceBotLocale) Metadata(_ context.Context, req resource.MetadataReq
 }
 
 func (r *resourceBotLocale) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	bedrockModelSpecificationLNB := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[bedrockModelSpecificationData](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"model_arn": schema.StringAttribute{
+					CustomType: fwtypes.ARNType,
+					Required:   true,
+				},
+			},
+		},
+	}
+
+	generativeAISpecificationLNB := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[generativeAISpecificationData](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrEnabled: schema.BoolAttribute{
+					Required: true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"bedrock_model_specification": bedrockModelSpecificationLNB,
+			},
+		},
+	}
+
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			names.AttrDescription: schema.StringAttribute{
@@ -98,6 +132,82 @@ func (r *resourceBotLocale) Schema(ctx context.Context, req resource.SchemaReque
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"generative_ai_settings": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[generativeAISettingsData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"buildtime_settings": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[buildtimeSettingsData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"descriptive_bot_builder":     generativeAISpecificationLNB,
+									"sample_utterance_generation": generativeAISpecificationLNB,
+								},
+							},
+						},
+						"runtime_settings": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[runtimeSettingsData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"slot_resolution_improvement": generativeAISpecificationLNB,
+								},
+							},
+						},
+					},
+				},
+			},
+			"test_bot_alias_settings": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[botAliasLocaleSettingsData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrEnabled: schema.BoolAttribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"code_hook_specification": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[codeHookSpecificationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"lambda_code_hook": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[lambdaCodeHookData](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"code_hook_interface_version": schema.StringAttribute{
+													Required: true,
+												},
+												"lambda_arn": schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"voice_settings": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -162,6 +272,10 @@ func (r *resourceBotLocale) Create(ctx context.Context, req resource.CreateReque
 		vsInput := expandVoiceSettings(ctx, tfList)
 		in.VoiceSettings = vsInput
 	}
+	resp.Diagnostics.Append(flex.Expand(ctx, plan.GenerativeAISettings, &in.GenerativeAISettings)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	out, err := conn.CreateBotLocale(ctx, in)
 	if err != nil {
@@ -217,6 +331,22 @@ func (r *resourceBotLocale) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	if !plan.TestBotAliasSettings.IsNull() {
+		settings := &awstypes.BotAliasLocaleSettings{}
+		resp.Diagnostics.Append(flex.Expand(ctx, plan.TestBotAliasSettings, settings)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := updateTestBotAliasLocaleSettings(ctx, conn, state.BotID.ValueString(), state.LocaleID.ValueString(), settings); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotLocale, plan.LocaleID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -254,6 +384,32 @@ func (r *resourceBotLocale) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	state.VoiceSettings = vs
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out.GenerativeAISettings, &state.GenerativeAISettings)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The test bot alias locale settings are only managed when configured.
+	if !state.TestBotAliasSettings.IsNull() {
+		settings, err := findTestBotAliasLocaleSettings(ctx, conn, state.BotID.ValueString(), state.LocaleID.ValueString())
+		switch {
+		case tfresource.NotFound(err):
+			state.TestBotAliasSettings = fwtypes.NewListNestedObjectValueOfNull[botAliasLocaleSettingsData](ctx)
+		case err != nil:
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameBotLocale, state.LocaleID.String(), err),
+				err.Error(),
+			)
+			return
+		default:
+			resp.Diagnostics.Append(flex.Flatten(ctx, settings, &state.TestBotAliasSettings)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -273,6 +429,7 @@ func (r *resourceBotLocale) Update(ctx context.Context, req resource.UpdateReque
 		!plan.LocaleID.Equal(state.LocaleID) ||
 		!plan.Name.Equal(state.Name) ||
 		!plan.VoiceSettings.Equal(state.VoiceSettings) ||
+		!plan.GenerativeAISettings.Equal(state.GenerativeAISettings) ||
 		!plan.NluIntentCOnfidenceThreshold.Equal(state.NluIntentCOnfidenceThreshold) {
 		in := &lexmodelsv2.UpdateBotLocaleInput{
 			BotId:                        aws.String(plan.BotID.ValueString()),
@@ -293,6 +450,10 @@ func (r *resourceBotLocale) Update(ctx context.Context, req resource.UpdateReque
 
 			in.VoiceSettings = expandVoiceSettings(ctx, tfList)
 		}
+		resp.Diagnostics.Append(flex.Expand(ctx, plan.GenerativeAISettings, &in.GenerativeAISettings)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
 		_, err := conn.UpdateBotLocale(ctx, in)
 		if err != nil {
@@ -322,6 +483,25 @@ func (r *resourceBotLocale) Update(ctx context.Context, req resource.UpdateReque
 		state.refreshFromOutput(ctx, out)
 	}
 
+	if !plan.TestBotAliasSettings.Equal(state.TestBotAliasSettings) {
+		var settings *awstypes.BotAliasLocaleSettings
+		if !plan.TestBotAliasSettings.IsNull() {
+			settings = &awstypes.BotAliasLocaleSettings{}
+			resp.Diagnostics.Append(flex.Expand(ctx, plan.TestBotAliasSettings, settings)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		if err := updateTestBotAliasLocaleSettings(ctx, conn, plan.BotID.ValueString(), plan.LocaleID.ValueString(), settings); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameBotLocale, plan.LocaleID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -467,6 +647,86 @@ func FindBotLocaleByID(ctx context.Context, conn *lexmodelsv2.Client, id string)
 	return out, nil
 }
 
+// testBotAliasID is the identifier of the alias that Amazon Lex creates to test the draft version of a bot.
+const testBotAliasID = "TSTALIASID"
+
+func findTestBotAlias(ctx context.Context, conn *lexmodelsv2.Client, botID string) (*lexmodelsv2.DescribeBotAliasOutput, error) {
+	in := &lexmodelsv2.DescribeBotAliasInput{
+		BotAliasId: aws.String(testBotAliasID),
+		BotId:      aws.String(botID),
+	}
+
+	out, err := conn.DescribeBotAlias(ctx, in)
+	if err != nil {
+		var nfe *awstypes.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || out.BotAliasId == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func findTestBotAliasLocaleSettings(ctx context.Context, conn *lexmodelsv2.Client, botID, localeID string) (*awstypes.BotAliasLocaleSettings, error) {
+	out, err := findTestBotAlias(ctx, conn, botID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, ok := out.BotAliasLocaleSettings[localeID]
+	if !ok {
+		return nil, &retry.NotFoundError{}
+	}
+
+	return &settings, nil
+}
+
+// updateTestBotAliasLocaleSettings sets the test bot alias settings for a single locale, preserving the settings of the alias's other locales.
+// A nil settings value removes the locale's settings.
+func updateTestBotAliasLocaleSettings(ctx context.Context, conn *lexmodelsv2.Client, botID, localeID string, settings *awstypes.BotAliasLocaleSettings) error {
+	alias, err := findTestBotAlias(ctx, conn, botID)
+	if err != nil {
+		return fmt.Errorf("reading test bot alias: %w", err)
+	}
+
+	localeSettings := make(map[string]awstypes.BotAliasLocaleSettings, len(alias.BotAliasLocaleSettings)+1)
+	for k, v := range alias.BotAliasLocaleSettings {
+		localeSettings[k] = v
+	}
+
+	if settings == nil {
+		delete(localeSettings, localeID)
+	} else {
+		localeSettings[localeID] = *settings
+	}
+
+	in := &lexmodelsv2.UpdateBotAliasInput{
+		BotAliasId:                aws.String(testBotAliasID),
+		BotAliasLocaleSettings:    localeSettings,
+		BotAliasName:              alias.BotAliasName,
+		BotId:                     aws.String(botID),
+		BotVersion:                alias.BotVersion,
+		ConversationLogSettings:   alias.ConversationLogSettings,
+		Description:               alias.Description,
+		SentimentAnalysisSettings: alias.SentimentAnalysisSettings,
+	}
+
+	if _, err := conn.UpdateBotAlias(ctx, in); err != nil {
+		return fmt.Errorf("updating test bot alias: %w", err)
+	}
+
+	return nil
+}
+
 func flattenVoiceSettings(ctx context.Context, apiObject *awstypes.VoiceSettings) (types.List, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	elemType := types.ObjectType{AttrTypes: voiceSettingsAttrTypes}
@@ -501,15 +761,54 @@ func expandVoiceSettings(ctx context.Context, tfList []voiceSettingsData) *awsty
 }
 
 type resourceBotLocaleData struct {
-	BotID                        types.String   `tfsdk:"bot_id"`
-	BotVersion                   types.String   `tfsdk:"bot_version"`
-	LocaleID                     types.String   `tfsdk:"locale_id"`
-	Name                         types.String   `tfsdk:"name"`
-	VoiceSettings                types.List     `tfsdk:"voice_settings"`
-	Description                  types.String   `tfsdk:"description"`
-	NluIntentCOnfidenceThreshold types.Float64  `tfsdk:"n_lu_intent_confidence_threshold"`
-	Id                           types.String   `tfsdk:"id"`
-	Timeouts                     timeouts.Value `tfsdk:"timeouts"`
+	BotID                        types.String                                                `tfsdk:"bot_id"`
+	BotVersion                   types.String                                                `tfsdk:"bot_version"`
+	LocaleID                     types.String                                                `tfsdk:"locale_id"`
+	Name                         types.String                                                `tfsdk:"name"`
+	VoiceSettings                types.List                                                  `tfsdk:"voice_settings"`
+	GenerativeAISettings         fwtypes.ListNestedObjectValueOf[generativeAISettingsData]   `tfsdk:"generative_ai_settings"`
+	TestBotAliasSettings         fwtypes.ListNestedObjectValueOf[botAliasLocaleSettingsData] `tfsdk:"test_bot_alias_settings"`
+	Description                  types.String                                                `tfsdk:"description"`
+	NluIntentCOnfidenceThreshold types.Float64                                               `tfsdk:"n_lu_intent_confidence_threshold"`
+	Id                           types.String                                                `tfsdk:"id"`
+	Timeouts                     timeouts.Value                                              `tfsdk:"timeouts"`
+}
+
+type generativeAISettingsData struct {
+	BuildtimeSettings fwtypes.ListNestedObjectValueOf[buildtimeSettingsData] `tfsdk:"buildtime_settings"`
+	RuntimeSettings   fwtypes.ListNestedObjectValueOf[runtimeSettingsData]   `tfsdk:"runtime_settings"`
+}
+
+type buildtimeSettingsData struct {
+	DescriptiveBotBuilder     fwtypes.ListNestedObjectValueOf[generativeAISpecificationData] `tfsdk:"descriptive_bot_builder"`
+	SampleUtteranceGeneration fwtypes.ListNestedObjectValueOf[generativeAISpecificationData] `tfsdk:"sample_utterance_generation"`
+}
+
+type runtimeSettingsData struct {
+	SlotResolutionImprovement fwtypes.ListNestedObjectValueOf[generativeAISpecificationData] `tfsdk:"slot_resolution_improvement"`
+}
+
+type generativeAISpecificationData struct {
+	BedrockModelSpecification fwtypes.ListNestedObjectValueOf[bedrockModelSpecificationData] `tfsdk:"bedrock_model_specification"`
+	Enabled                   types.Bool                                                     `tfsdk:"enabled"`
+}
+
+type bedrockModelSpecificationData struct {
+	ModelARN fwtypes.ARN `tfsdk:"model_arn"`
+}
+
+type botAliasLocaleSettingsData struct {
+	CodeHookSpecification fwtypes.ListNestedObjectValueOf[codeHookSpecificationData] `tfsdk:"code_hook_specification"`
+	Enabled               types.Bool                                                 `tfsdk:"enabled"`
+}
+
+type codeHookSpecificationData struct {
+	LambdaCodeHook fwtypes.ListNestedObjectValueOf[lambdaCodeHookData] `tfsdk:"lambda_code_hook"`
+}
+
+type lambdaCodeHookData struct {
+	CodeHookInterfaceVersion types.String `tfsdk:"code_hook_interface_version"`
+	LambdaARN                fwtypes.ARN  `tfsdk:"lambda_arn"`
 }
 
 type voiceSettingsData struct {
@@ -126,6 +126,90 @@ func TestAccLexV2ModelsBotLocale_voiceSettings(t *testing.T) {
 	})
 }
 
+func TestAccLexV2ModelsBotLocale_generativeAISettings(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var botlocale lexmodelsv2.DescribeBotLocaleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot_locale.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotLocaleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotLocaleConfig_generativeAISettings(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotLocaleExists(ctx, resourceName, &botlocale),
+					resource.TestCheckResourceAttr(resourceName, "generative_ai_settings.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "generative_ai_settings.0.buildtime_settings.0.descriptive_bot_builder.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "generative_ai_settings.0.buildtime_settings.0.sample_utterance_generation.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "generative_ai_settings.0.runtime_settings.0.slot_resolution_improvement.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBotLocaleConfig_generativeAISettings(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotLocaleExists(ctx, resourceName, &botlocale),
+					resource.TestCheckResourceAttr(resourceName, "generative_ai_settings.0.buildtime_settings.0.descriptive_bot_builder.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "generative_ai_settings.0.buildtime_settings.0.sample_utterance_generation.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "generative_ai_settings.0.runtime_settings.0.slot_resolution_improvement.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsBotLocale_testBotAliasSettings(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var botlocale lexmodelsv2.DescribeBotLocaleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot_locale.test"
+	lambdaResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotLocaleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotLocaleConfig_testBotAliasSettings(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotLocaleExists(ctx, resourceName, &botlocale),
+					resource.TestCheckResourceAttr(resourceName, "test_bot_alias_settings.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "test_bot_alias_settings.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "test_bot_alias_settings.0.code_hook_specification.0.lambda_code_hook.0.code_hook_interface_version", "1.0"),
+					resource.TestCheckResourceAttrPair(resourceName, "test_bot_alias_settings.0.code_hook_specification.0.lambda_code_hook.0.lambda_arn", lambdaResourceName, names.AttrARN),
+				),
+			},
+			{
+				Config: testAccBotLocaleConfig_testBotAliasSettings(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotLocaleExists(ctx, resourceName, &botlocale),
+					resource.TestCheckResourceAttr(resourceName, "test_bot_alias_settings.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBotLocaleDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
@@ -219,3 +303,93 @@ resource "aws_lexv2models_bot_locale" "test" {
 }
 `, voiceID, engine))
 }
+
+func testAccBotLocaleConfig_generativeAISettings(rName string, enabled bool) string {
+	return acctest.ConfigCompose(
+		testAccBotLocaleConfigBase(rName),
+		fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_lexv2models_bot_locale" "test" {
+  locale_id                        = "en_US"
+  bot_id                           = aws_lexv2models_bot.test.id
+  bot_version                      = "DRAFT"
+  n_lu_intent_confidence_threshold = 0.7
+
+  generative_ai_settings {
+    buildtime_settings {
+      descriptive_bot_builder {
+        enabled = %[1]t
+
+        bedrock_model_specification {
+          model_arn = "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}::foundation-model/anthropic.claude-v2"
+        }
+      }
+
+      sample_utterance_generation {
+        enabled = %[1]t
+
+        bedrock_model_specification {
+          model_arn = "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}::foundation-model/anthropic.claude-v2"
+        }
+      }
+    }
+
+    runtime_settings {
+      slot_resolution_improvement {
+        enabled = %[1]t
+
+        bedrock_model_specification {
+          model_arn = "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}::foundation-model/anthropic.claude-v2"
+        }
+      }
+    }
+  }
+}
+`, enabled))
+}
+
+func testAccBotLocaleConfig_testBotAliasSettings(rName string, enabled bool) string {
+	return acctest.ConfigCompose(
+		testAccBotLocaleConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iam_role" "lambda" {
+  name = "%[1]s-lambda"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "lambda.amazonaws.com" }
+    }]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.lambda.arn
+  handler       = "lambdatest.handler"
+  runtime       = "nodejs16.x"
+}
+
+resource "aws_lexv2models_bot_locale" "test" {
+  locale_id                        = "en_US"
+  bot_id                           = aws_lexv2models_bot.test.id
+  bot_version                      = "DRAFT"
+  n_lu_intent_confidence_threshold = 0.7
+
+  test_bot_alias_settings {
+    enabled = %[2]t
+
+    code_hook_specification {
+      lambda_code_hook {
+        code_hook_interface_version = "1.0"
+        lambda_arn                  = aws_lambda_function.test.arn
+      }
+    }
+  }
+}
+`, rName, enabled))
+}
@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Bot Replica")
+func newResourceBotReplica(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceBotReplica{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameBotReplica = "Bot Replica"
+)
+
+type resourceBotReplica struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[resourceBotReplicaData]
+	framework.WithTimeouts
+}
+
+func (r *resourceBotReplica) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_lexv2models_bot_replica"
+}
+
+func (r *resourceBotReplica) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bot_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"replica_region": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_region": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+const (
+	botReplicaIDPartCount = 2
+)
+
+func (r *resourceBotReplica) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var plan resourceBotReplicaData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lexmodelsv2.CreateBotReplicaInput{
+		BotId:         aws.String(plan.BotID.ValueString()),
+		ReplicaRegion: aws.String(plan.ReplicaRegion.ValueString()),
+	}
+
+	out, err := conn.CreateBotReplica(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotReplica, plan.BotID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil || out.ReplicaRegion == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotReplica, plan.BotID.ValueString(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	idParts := []string{
+		aws.ToString(out.BotId),
+		aws.ToString(out.ReplicaRegion),
+	}
+	id, err := fwflex.FlattenResourceId(idParts, botReplicaIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotReplica, plan.BotID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state := plan
+	state.Id = types.StringValue(id)
+	state.SourceRegion = flex.StringToFramework(ctx, out.SourceRegion)
+
+	createTimeout := r.CreateTimeout(ctx, state.Timeouts)
+	_, err = waitBotReplicaCreated(ctx, conn, id, createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForCreation, ResNameBotReplica, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceBotReplica) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceBotReplicaData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findBotReplicaByID(ctx, conn, state.Id.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameBotReplica, state.Id.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.BotID = flex.StringToFramework(ctx, out.BotId)
+	state.ReplicaRegion = flex.StringToFramework(ctx, out.ReplicaRegion)
+	state.SourceRegion = flex.StringToFramework(ctx, out.SourceRegion)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceBotReplica) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceBotReplicaData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lexmodelsv2.DeleteBotReplicaInput{
+		BotId:         aws.String(state.BotID.ValueString()),
+		ReplicaRegion: aws.String(state.ReplicaRegion.ValueString()),
+	}
+
+	_, err := conn.DeleteBotReplica(ctx, in)
+	if err != nil {
+		var nfe *awstypes.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionDeleting, ResNameBotReplica, state.Id.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitBotReplicaDeleted(ctx, conn, state.Id.ValueString(), deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForDeletion, ResNameBotReplica, state.Id.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceBotReplica) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+}
+
+func waitBotReplicaCreated(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotReplicaOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.BotReplicaStatusEnabling),
+		Target:                    enum.Slice(awstypes.BotReplicaStatusEnabled),
+		Refresh:                   statusBotReplica(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotReplicaOutput); ok {
+		if out.BotReplicaStatus == awstypes.BotReplicaStatusFailed {
+			tfresource.SetLastError(err, errors.New(strings.Join(out.FailureReasons, "; ")))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitBotReplicaDeleted(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotReplicaOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BotReplicaStatusDeleting, awstypes.BotReplicaStatusEnabled),
+		Target:  []string{},
+		Refresh: statusBotReplica(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotReplicaOutput); ok {
+		if out.BotReplicaStatus == awstypes.BotReplicaStatusFailed {
+			tfresource.SetLastError(err, errors.New(strings.Join(out.FailureReasons, "; ")))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusBotReplica(ctx context.Context, conn *lexmodelsv2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findBotReplicaByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.BotReplicaStatus), nil
+	}
+}
+
+func findBotReplicaByID(ctx context.Context, conn *lexmodelsv2.Client, id string) (*lexmodelsv2.DescribeBotReplicaOutput, error) {
+	parts, err := fwflex.ExpandResourceId(id, botReplicaIDPartCount, false)
+	if err != nil {
+		return nil, err
+	}
+	in := &lexmodelsv2.DescribeBotReplicaInput{
+		BotId:         aws.String(parts[0]),
+		ReplicaRegion: aws.String(parts[1]),
+	}
+
+	out, err := conn.DescribeBotReplica(ctx, in)
+	if err != nil {
+		var nfe *awstypes.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || out.ReplicaRegion == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type resourceBotReplicaData struct {
+	BotID         types.String   `tfsdk:"bot_id"`
+	Id            types.String   `tfsdk:"id"`
+	ReplicaRegion types.String   `tfsdk:"replica_region"`
+	SourceRegion  types.String   `tfsdk:"source_region"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
@@ -7,10 +7,12 @@ package lexv2models
 var (
 	ResourceBot        = newResourceBot
 	ResourceBotLocale  = newResourceBotLocale
+	ResourceBotReplica = newResourceBotReplica
 	ResourceBotVersion = newResourceBotVersion
 	ResourceIntent     = newResourceIntent
 	ResourceSlot       = newResourceSlot
 	ResourceSlotType   = newResourceSlotType
 
-	FindSlotByID = findSlotByID
+	FindBotReplicaByID = findBotReplicaByID
+	FindSlotByID       = findSlotByID
 )
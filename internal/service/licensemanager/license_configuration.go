@@ -46,6 +46,11 @@ func ResourceLicenseConfiguration() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"disassociate_when_not_found": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			"license_count": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -78,6 +83,42 @@ func ResourceLicenseConfiguration() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"product_information": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"product_information_filter": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"comparator": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(productInformationFilterComparator_Values(), false),
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									names.AttrValue: {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						names.AttrResourceType: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(productInformationResourceType_Values(), false),
+						},
+					},
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -102,6 +143,10 @@ func resourceLicenseConfigurationCreate(ctx context.Context, d *schema.ResourceD
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("disassociate_when_not_found"); ok {
+		input.DisassociateWhenNotFound = aws.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk("license_count"); ok {
 		input.LicenseCount = aws.Int64(int64(v.(int)))
 	}
@@ -114,6 +159,10 @@ func resourceLicenseConfigurationCreate(ctx context.Context, d *schema.ResourceD
 		input.LicenseRules = flex.ExpandStringList(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("product_information"); ok && len(v.([]interface{})) > 0 {
+		input.ProductInformationList = expandProductInformations(v.([]interface{}))
+	}
+
 	output, err := conn.CreateLicenseConfigurationWithContext(ctx, input)
 
 	if err != nil {
@@ -144,12 +193,16 @@ func resourceLicenseConfigurationRead(ctx context.Context, d *schema.ResourceDat
 
 	d.Set(names.AttrARN, output.LicenseConfigurationArn)
 	d.Set(names.AttrDescription, output.Description)
+	d.Set("disassociate_when_not_found", output.DisassociateWhenNotFound)
 	d.Set("license_count", output.LicenseCount)
 	d.Set("license_count_hard_limit", output.LicenseCountHardLimit)
 	d.Set("license_counting_type", output.LicenseCountingType)
 	d.Set("license_rules", aws.StringValueSlice(output.LicenseRules))
 	d.Set(names.AttrName, output.Name)
 	d.Set("owner_account_id", output.OwnerAccountId)
+	if err := d.Set("product_information", flattenProductInformations(output.ProductInformationList)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting product_information: %s", err)
+	}
 
 	setTagsOut(ctx, output.Tags)
 
@@ -169,10 +222,22 @@ func resourceLicenseConfigurationUpdate(ctx context.Context, d *schema.ResourceD
 			Name:                    aws.String(d.Get(names.AttrName).(string)),
 		}
 
+		if d.HasChange("disassociate_when_not_found") {
+			input.DisassociateWhenNotFound = aws.Bool(d.Get("disassociate_when_not_found").(bool))
+		}
+
 		if v, ok := d.GetOk("license_count"); ok {
 			input.LicenseCount = aws.Int64(int64(v.(int)))
 		}
 
+		if d.HasChange("product_information") {
+			// An empty list removes all automated discovery rules.
+			input.ProductInformationList = expandProductInformations(d.Get("product_information").([]interface{}))
+			if input.ProductInformationList == nil {
+				input.ProductInformationList = []*licensemanager.ProductInformation{}
+			}
+		}
+
 		_, err := conn.UpdateLicenseConfigurationWithContext(ctx, input)
 
 		if err != nil {
@@ -228,3 +293,109 @@ func FindLicenseConfigurationByARN(ctx context.Context, conn *licensemanager.Lic
 
 	return output, nil
 }
+
+// Automated discovery resource types and filter comparators aren't modeled as enums in the API.
+// See https://docs.aws.amazon.com/license-manager/latest/APIReference/API_ProductInformation.html.
+func productInformationResourceType_Values() []string {
+	return []string{
+		"SSM_MANAGED",
+		"RDS",
+	}
+}
+
+func productInformationFilterComparator_Values() []string {
+	return []string{
+		"EQUALS",
+		"NOT_EQUALS",
+	}
+}
+
+func expandProductInformations(tfList []interface{}) []*licensemanager.ProductInformation {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*licensemanager.ProductInformation
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &licensemanager.ProductInformation{
+			ResourceType: aws.String(tfMap[names.AttrResourceType].(string)),
+		}
+
+		if v, ok := tfMap["product_information_filter"].([]interface{}); ok {
+			apiObject.ProductInformationFilterList = expandProductInformationFilters(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandProductInformationFilters(tfList []interface{}) []*licensemanager.ProductInformationFilter {
+	var apiObjects []*licensemanager.ProductInformationFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &licensemanager.ProductInformationFilter{
+			ProductInformationFilterComparator: aws.String(tfMap["comparator"].(string)),
+			ProductInformationFilterName:       aws.String(tfMap[names.AttrName].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrValue].([]interface{}); ok && len(v) > 0 {
+			apiObject.ProductInformationFilterValue = flex.ExpandStringList(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenProductInformations(apiObjects []*licensemanager.ProductInformation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"product_information_filter": flattenProductInformationFilters(apiObject.ProductInformationFilterList),
+			names.AttrResourceType:       aws.StringValue(apiObject.ResourceType),
+		})
+	}
+
+	return tfList
+}
+
+func flattenProductInformationFilters(apiObjects []*licensemanager.ProductInformationFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"comparator":    aws.StringValue(apiObject.ProductInformationFilterComparator),
+			names.AttrName:  aws.StringValue(apiObject.ProductInformationFilterName),
+			names.AttrValue: aws.StringValueSlice(apiObject.ProductInformationFilterValue),
+		})
+	}
+
+	return tfList
+}
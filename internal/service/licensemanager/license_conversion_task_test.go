@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package licensemanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tflicensemanager "github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	conversionInstanceARNKey      = "TF_AWS_LICENSE_MANAGER_CONVERSION_INSTANCE_ARN"
+	envVarConversionInstanceError = "ARN of a Windows EC2 instance launched with a License Included AMI and managed by Systems Manager."
+)
+
+func TestAccLicenseManagerLicenseConversionTask_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	instanceARN := envvar.SkipIfEmpty(t, conversionInstanceARNKey, envVarConversionInstanceError)
+	resourceName := "aws_licensemanager_license_conversion_task.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LicenseManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLicenseConversionTaskConfig_basic(instanceARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLicenseConversionTaskExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_license_context.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "destination_license_context.0.usage_operation", "RunInstances:0800"),
+					resource.TestCheckResourceAttrSet(resourceName, "license_conversion_time"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceARN, instanceARN),
+					resource.TestCheckResourceAttr(resourceName, "source_license_context.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "source_license_context.0.usage_operation", "RunInstances:0002"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStartTime),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "SUCCEEDED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLicenseConversionTaskExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No License Manager License Conversion Task ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LicenseManagerConn(ctx)
+
+		_, err := tflicensemanager.FindLicenseConversionTaskByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccLicenseConversionTaskConfig_basic(instanceARN string) string {
+	return fmt.Sprintf(`
+resource "aws_licensemanager_license_conversion_task" "test" {
+  resource_arn = %[1]q
+
+  source_license_context {
+    usage_operation = "RunInstances:0002"
+  }
+
+  destination_license_context {
+    usage_operation = "RunInstances:0800"
+  }
+}
+`, instanceARN)
+}
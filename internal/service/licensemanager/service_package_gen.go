@@ -62,6 +62,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  ResourceLicenseConversionTask,
+			TypeName: "aws_licensemanager_license_conversion_task",
+			Name:     "License Conversion Task",
+		},
 	}
 }
 
@@ -52,6 +52,10 @@ func ResourceBucketAccessKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"last_used_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"secret_access_key": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -118,6 +122,11 @@ func resourceBucketAccessKeyRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set("access_key_id", out.AccessKeyId)
 	d.Set(names.AttrBucketName, d.Get(names.AttrBucketName).(string))
 	d.Set(names.AttrCreatedAt, out.CreatedAt.Format(time.RFC3339))
+	if v := out.LastUsed; v != nil && v.LastUsedDate != nil {
+		d.Set("last_used_date", aws.ToTime(v.LastUsedDate).Format(time.RFC3339))
+	} else {
+		d.Set("last_used_date", nil)
+	}
 	d.Set(names.AttrStatus, out.Status)
 
 	return diags
@@ -269,7 +269,8 @@ func expandSubjectAlternativeNames(sans interface{}) []string {
 
 func FindCertificateById(ctx context.Context, conn *lightsail.Client, name string) (*types.Certificate, error) {
 	in := &lightsail.GetCertificatesInput{
-		CertificateName: aws.String(name),
+		CertificateName:           aws.String(name),
+		IncludeCertificateDetails: true,
 	}
 
 	out, err := conn.GetCertificates(ctx, in)
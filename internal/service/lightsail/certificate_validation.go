@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lightsail
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_lightsail_certificate_validation")
+func ResourceCertificateValidation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCertificateValidationCreate,
+		ReadWithoutTimeout:   resourceCertificateValidationRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(75 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceCertificateValidationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LightsailClient(ctx)
+
+	name := d.Get("certificate_name").(string)
+
+	if _, err := waitCertificateIssued(ctx, conn, name, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionWaitingForCreation, ResCertificateValidation, name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceCertificateValidationRead(ctx, d, meta)...)
+}
+
+func resourceCertificateValidationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LightsailClient(ctx)
+
+	certificate, err := FindCertificateById(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.Lightsail, create.ErrActionReading, ResCertificateValidation, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionReading, ResCertificateValidation, d.Id(), err)
+	}
+
+	if status := certificate.Status; status != types.CertificateStatusIssued {
+		create.LogNotFoundRemoveState(names.Lightsail, create.ErrActionReading, ResCertificateValidation, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("certificate_name", certificate.Name)
+
+	return diags
+}
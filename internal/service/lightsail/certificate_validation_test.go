@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lightsail_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLightsailCertificateValidation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lightsail_certificate_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(lightsail.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateValidationConfig_basic(rName, rootDomain, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCertificateValidationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_name", "aws_lightsail_certificate.test", names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCertificateValidationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailClient(ctx)
+
+		certificate, err := tflightsail.FindCertificateById(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if status := certificate.Status; status != types.CertificateStatusIssued {
+			return fmt.Errorf("Lightsail Certificate (%s) status is %s", rs.Primary.ID, status)
+		}
+
+		return nil
+	}
+}
+
+func testAccCertificateValidationConfig_basic(rName, rootZoneDomain, domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_certificate" "test" {
+  name        = %[1]q
+  domain_name = %[3]q
+}
+
+data "aws_route53_zone" "test" {
+  name         = %[2]q
+  private_zone = false
+}
+
+resource "aws_route53_record" "test" {
+  allow_overwrite = true
+  name            = tolist(aws_lightsail_certificate.test.domain_validation_options)[0].resource_record_name
+  records         = [tolist(aws_lightsail_certificate.test.domain_validation_options)[0].resource_record_value]
+  ttl             = 60
+  type            = tolist(aws_lightsail_certificate.test.domain_validation_options)[0].resource_record_type
+  zone_id         = data.aws_route53_zone.test.zone_id
+}
+
+resource "aws_lightsail_certificate_validation" "test" {
+  certificate_name = aws_lightsail_certificate.test.name
+
+  depends_on = [aws_route53_record.test]
+}
+`, rName, rootZoneDomain, domainName)
+}
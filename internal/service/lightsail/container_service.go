@@ -208,6 +208,12 @@ func resourceContainerServiceCreate(ctx context.Context, d *schema.ResourceData,
 			IsDisabled:  aws.Bool(true),
 		}
 
+		if d.HasChange("private_registry_access") {
+			if v, ok := d.GetOk("private_registry_access"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.PrivateRegistryAccess = expandPrivateRegistryAccess(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		_, err := conn.UpdateContainerService(ctx, input)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "disabling Lightsail Container Service (%s): %s", d.Id(), err)
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -237,7 +238,7 @@ func TestAccLightsailContainerService_privateRegistryAccess(t *testing.T) {
 		CheckDestroy:             testAccCheckContainerServiceDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccContainerServiceConfig_privateRegistryAccess(rName),
+				Config: testAccContainerServiceConfig_privateRegistryAccess(rName, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckContainerServiceExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "private_registry_access.#", acctest.CtOne),
@@ -246,6 +247,30 @@ func TestAccLightsailContainerService_privateRegistryAccess(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "private_registry_access.0.ecr_image_puller_role.0.principal_arn"),
 				),
 			},
+			{
+				Config: testAccContainerServiceConfig_privateRegistryAccess(rName, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerServiceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "private_registry_access.0.ecr_image_puller_role.0.is_active", "false"),
+				),
+			},
+			{
+				Config: testAccContainerServiceConfig_privateRegistryAccess(rName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerServiceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "private_registry_access.0.ecr_image_puller_role.0.is_active", "true"),
+				),
+			},
 		},
 	})
 }
@@ -425,20 +450,20 @@ resource "aws_lightsail_container_service" "test" {
 `, rName)
 }
 
-func testAccContainerServiceConfig_privateRegistryAccess(rName string) string {
+func testAccContainerServiceConfig_privateRegistryAccess(rName string, isActive bool) string {
 	return fmt.Sprintf(`
 resource "aws_lightsail_container_service" "test" {
-  name  = %q
+  name  = %[1]q
   power = "micro"
   scale = 1
 
   private_registry_access {
     ecr_image_puller_role {
-      is_active = true
+      is_active = %[2]t
     }
   }
 }
-`, rName)
+`, rName, isActive)
 }
 
 func testAccContainerServiceConfig_scale(rName string) string {
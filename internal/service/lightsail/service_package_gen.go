@@ -50,6 +50,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  ResourceCertificateValidation,
+			TypeName: "aws_lightsail_certificate_validation",
+		},
 		{
 			Factory:  ResourceContainerService,
 			TypeName: "aws_lightsail_container_service",
@@ -47,6 +47,22 @@ func statusContainerServiceDeploymentVersion(ctx context.Context, conn *lightsai
 	}
 }
 
+func statusCertificate(ctx context.Context, conn *lightsail.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		certificate, err := FindCertificateById(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return certificate, string(certificate.Status), nil
+	}
+}
+
 // statusOperation is a method to check the status of a Lightsail Operation
 func statusOperation(ctx context.Context, conn *lightsail.Client, oid *string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
@@ -119,6 +119,28 @@ func waitDatabasePubliclyAccessibleModified(ctx context.Context, conn *lightsail
 	return err
 }
 
+func waitCertificateIssued(ctx context.Context, conn *lightsail.Client, name string, timeout time.Duration) (*types.Certificate, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.CertificateStatusPendingValidation),
+		Target:     enum.Slice(types.CertificateStatusIssued),
+		Refresh:    statusCertificate(ctx, conn, name),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Certificate); ok {
+		if reason := output.RequestFailureReason; reason != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(reason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitContainerServiceCreated(ctx context.Context, conn *lightsail.Client, serviceName string, timeout time.Duration) error {
 	stateConf := &retry.StateChangeConf{
 		Pending:    enum.Slice(types.ContainerServiceStatePending),
@@ -0,0 +1,327 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package location
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_location_api_key", name="API Key")
+// @Tags(identifierAttribute="key_arn")
+func ResourceAPIKey() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAPIKeyCreate,
+		ReadWithoutTimeout:   resourceAPIKeyRead,
+		UpdateWithoutTimeout: resourceAPIKeyUpdate,
+		DeleteWithoutTimeout: resourceAPIKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrCreateTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"expire_time": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  verify.ValidUTCTimestamp,
+				ConflictsWith: []string{"no_expiry"},
+			},
+			"force_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"force_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			names.AttrKey: {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"no_expiry": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"expire_time"},
+			},
+			"restrictions": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_actions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 7,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allow_referers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 5,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allow_resources": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 5,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameAPIKey = "API Key"
+)
+
+func resourceAPIKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	name := d.Get("key_name").(string)
+	in := &locationservice.CreateKeyInput{
+		KeyName:      aws.String(name),
+		Restrictions: expandAPIKeyRestrictions(d.Get("restrictions").([]interface{})),
+		Tags:         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("expire_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		in.ExpireTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("no_expiry"); ok {
+		in.NoExpiry = aws.Bool(v.(bool))
+	}
+
+	out, err := conn.CreateKeyWithContext(ctx, in)
+	if err != nil {
+		return create.AppendDiagError(diags, names.Location, create.ErrActionCreating, ResNameAPIKey, name, err)
+	}
+
+	if out == nil {
+		return create.AppendDiagError(diags, names.Location, create.ErrActionCreating, ResNameAPIKey, name, errors.New("empty output"))
+	}
+
+	d.SetId(aws.StringValue(out.KeyName))
+
+	return append(diags, resourceAPIKeyRead(ctx, d, meta)...)
+}
+
+func resourceAPIKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	out, err := findAPIKeyByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Location API Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Location, create.ErrActionReading, ResNameAPIKey, d.Id(), err)
+	}
+
+	d.Set(names.AttrCreateTime, aws.TimeValue(out.CreateTime).Format(time.RFC3339))
+	d.Set(names.AttrDescription, out.Description)
+	if out.ExpireTime != nil {
+		d.Set("expire_time", aws.TimeValue(out.ExpireTime).Format(time.RFC3339))
+	} else {
+		d.Set("expire_time", nil)
+	}
+	d.Set(names.AttrKey, out.Key)
+	d.Set("key_arn", out.KeyArn)
+	d.Set("key_name", out.KeyName)
+	d.Set("update_time", aws.TimeValue(out.UpdateTime).Format(time.RFC3339))
+
+	if err := d.Set("restrictions", flattenAPIKeyRestrictions(out.Restrictions)); err != nil {
+		return create.AppendDiagError(diags, names.Location, create.ErrActionSetting, ResNameAPIKey, d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	if d.HasChanges(names.AttrDescription, "expire_time", "no_expiry", "restrictions") {
+		in := &locationservice.UpdateKeyInput{
+			ForceUpdate: aws.Bool(d.Get("force_update").(bool)),
+			KeyName:     aws.String(d.Id()),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			in.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChanges("expire_time", "no_expiry") {
+			if d.Get("no_expiry").(bool) {
+				in.NoExpiry = aws.Bool(true)
+			} else if v, ok := d.GetOk("expire_time"); ok {
+				v, _ := time.Parse(time.RFC3339, v.(string))
+				in.ExpireTime = aws.Time(v)
+			}
+		}
+
+		if d.HasChange("restrictions") {
+			in.Restrictions = expandAPIKeyRestrictions(d.Get("restrictions").([]interface{}))
+		}
+
+		_, err := conn.UpdateKeyWithContext(ctx, in)
+		if err != nil {
+			return create.AppendDiagError(diags, names.Location, create.ErrActionUpdating, ResNameAPIKey, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAPIKeyRead(ctx, d, meta)...)
+}
+
+func resourceAPIKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	log.Printf("[INFO] Deleting Location API Key %s", d.Id())
+
+	_, err := conn.DeleteKeyWithContext(ctx, &locationservice.DeleteKeyInput{
+		ForceDelete: aws.Bool(d.Get("force_delete").(bool)),
+		KeyName:     aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Location, create.ErrActionDeleting, ResNameAPIKey, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findAPIKeyByName(ctx context.Context, conn *locationservice.LocationService, name string) (*locationservice.DescribeKeyOutput, error) {
+	in := &locationservice.DescribeKeyInput{
+		KeyName: aws.String(name),
+	}
+
+	out, err := conn.DescribeKeyWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func expandAPIKeyRestrictions(tfList []interface{}) *locationservice.ApiKeyRestrictions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &locationservice.ApiKeyRestrictions{
+		AllowActions:   flex.ExpandStringSet(tfMap["allow_actions"].(*schema.Set)),
+		AllowResources: flex.ExpandStringSet(tfMap["allow_resources"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["allow_referers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowReferers = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenAPIKeyRestrictions(apiObject *locationservice.ApiKeyRestrictions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allow_actions":   aws.StringValueSlice(apiObject.AllowActions),
+		"allow_referers":  aws.StringValueSlice(apiObject.AllowReferers),
+		"allow_resources": aws.StringValueSlice(apiObject.AllowResources),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package location_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflocation "github.com/hashicorp/terraform-provider-aws/internal/service/location"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLocationAPIKey_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_api_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LocationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAPIKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAPIKeyExists(ctx, resourceName),
+					acctest.CheckResourceAttrRFC3339(resourceName, names.AttrCreateTime),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrKey),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "key_arn", "geo", fmt.Sprintf("api-key/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "key_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_actions.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttr(resourceName, "restrictions.0.allow_actions.*", "geo:GetMap*"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_referers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_resources.#", acctest.CtOne),
+					acctest.CheckResourceAttrRFC3339(resourceName, "update_time"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete", "force_update", "no_expiry"},
+			},
+		},
+	})
+}
+
+func TestAccLocationAPIKey_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_api_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LocationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAPIKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAPIKeyExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflocation.ResourceAPIKey(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLocationAPIKey_restrictions(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_api_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LocationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAPIKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAPIKeyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_actions.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_referers.#", "0"),
+				),
+			},
+			{
+				Config: testAccAPIKeyConfig_restrictions(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAPIKeyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_actions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "restrictions.0.allow_actions.*", "geo:GetMap*"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "restrictions.0.allow_actions.*", "geo:SearchPlaceIndexForText"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_referers.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttr(resourceName, "restrictions.0.allow_referers.*", "https://example.com/*"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_resources.#", "2"),
+				),
+			},
+			{
+				Config: testAccAPIKeyConfig_basic(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAPIKeyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_actions.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_referers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.allow_resources.#", acctest.CtOne),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLocationAPIKey_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_api_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LocationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAPIKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIKeyConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAPIKeyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAPIKeyConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAPIKeyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAPIKeyConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAPIKeyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAPIKeyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_location_api_key" {
+				continue
+			}
+
+			_, err := tflocation.FindAPIKeyByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.Location, create.ErrActionCheckingDestroyed, tflocation.ResNameAPIKey, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAPIKeyExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameAPIKey, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameAPIKey, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn(ctx)
+
+		_, err := tflocation.FindAPIKeyByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameAPIKey, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAPIKeyConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_location_map" "test" {
+  map_name = %[1]q
+
+  configuration {
+    style = "VectorHereBerlin"
+  }
+}
+
+resource "aws_location_place_index" "test" {
+  index_name  = %[1]q
+  data_source = "Here"
+}
+`, rName)
+}
+
+func testAccAPIKeyConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAPIKeyConfig_base(rName), fmt.Sprintf(`
+resource "aws_location_api_key" "test" {
+  key_name     = %[1]q
+  no_expiry    = true
+  force_delete = true
+
+  restrictions {
+    allow_actions   = ["geo:GetMap*"]
+    allow_resources = [aws_location_map.test.map_arn]
+  }
+}
+`, rName))
+}
+
+func testAccAPIKeyConfig_restrictions(rName string) string {
+	return acctest.ConfigCompose(testAccAPIKeyConfig_base(rName), fmt.Sprintf(`
+resource "aws_location_api_key" "test" {
+  key_name     = %[1]q
+  description  = "updated"
+  no_expiry    = true
+  force_delete = true
+  force_update = true
+
+  restrictions {
+    allow_actions   = ["geo:GetMap*", "geo:SearchPlaceIndexForText"]
+    allow_referers  = ["https://example.com/*"]
+    allow_resources = [aws_location_map.test.map_arn, aws_location_place_index.test.index_arn]
+  }
+}
+`, rName))
+}
+
+func testAccAPIKeyConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccAPIKeyConfig_base(rName), fmt.Sprintf(`
+resource "aws_location_api_key" "test" {
+  key_name     = %[1]q
+  no_expiry    = true
+  force_delete = true
+
+  restrictions {
+    allow_actions   = ["geo:GetMap*"]
+    allow_resources = [aws_location_map.test.map_arn]
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAPIKeyConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccAPIKeyConfig_base(rName), fmt.Sprintf(`
+resource "aws_location_api_key" "test" {
+  key_name     = %[1]q
+  no_expiry    = true
+  force_delete = true
+
+  restrictions {
+    allow_actions   = ["geo:GetMap*"]
+    allow_resources = [aws_location_map.test.map_arn]
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,10 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package location
+
+// Exports for use in tests only.
+var (
+	FindAPIKeyByName                = findAPIKeyByName
+	FindGeofenceIDsByCollectionName = findGeofenceIDsByCollectionName
+)
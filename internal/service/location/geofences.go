@@ -0,0 +1,384 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package location
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// BatchPutGeofence and BatchDeleteGeofence accept at most 10 entries per call.
+	geofencesBatchSize = 10
+)
+
+// @SDKResource("aws_location_geofences", name="Geofences")
+func ResourceGeofences() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGeofencesCreate,
+		ReadWithoutTimeout:   resourceGeofencesRead,
+		UpdateWithoutTimeout: resourceGeofencesUpdate,
+		DeleteWithoutTimeout: resourceGeofencesDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"collection_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"geofence_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"geojson": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+			},
+		},
+
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+			if !diff.NewValueKnown("geojson") {
+				return diff.SetNewComputed("geofence_ids")
+			}
+
+			entries, err := expandGeofencesFromGeoJSON(diff.Get("geojson").(string))
+			if err != nil {
+				return err
+			}
+
+			// Geofences that were deleted outside of Terraform are put again.
+			want := geofenceIDs(entries)
+			if got := flex.ExpandStringValueSet(diff.Get("geofence_ids").(*schema.Set)); !sameStringSet(want, got) {
+				return diff.SetNew("geofence_ids", want)
+			}
+
+			return nil
+		},
+	}
+}
+
+const (
+	ResNameGeofences = "Geofences"
+)
+
+func resourceGeofencesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	name := d.Get("collection_name").(string)
+
+	entries, err := expandGeofencesFromGeoJSON(d.Get("geojson").(string))
+	if err != nil {
+		return create.AppendDiagError(diags, names.Location, create.ErrActionCreating, ResNameGeofences, name, err)
+	}
+
+	if err := putGeofences(ctx, conn, name, entries); err != nil {
+		return create.AppendDiagError(diags, names.Location, create.ErrActionCreating, ResNameGeofences, name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceGeofencesRead(ctx, d, meta)...)
+}
+
+func resourceGeofencesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	ids, err := findGeofenceIDsByCollectionName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Location Geofence Collection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Location, create.ErrActionReading, ResNameGeofences, d.Id(), err)
+	}
+
+	// Only geofences loaded by this resource are tracked; others in the collection are left alone.
+	managed := flex.ExpandStringValueSet(d.Get("geofence_ids").(*schema.Set))
+	if v, ok := d.GetOk("geojson"); ok {
+		if entries, err := expandGeofencesFromGeoJSON(v.(string)); err == nil {
+			managed = append(managed, geofenceIDs(entries)...)
+		}
+	}
+
+	d.Set("collection_name", d.Id())
+	d.Set("geofence_ids", slices.DeleteFunc(ids, func(id string) bool {
+		return !slices.Contains(managed, id)
+	}))
+
+	return diags
+}
+
+func resourceGeofencesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	entries, err := expandGeofencesFromGeoJSON(d.Get("geojson").(string))
+	if err != nil {
+		return create.AppendDiagError(diags, names.Location, create.ErrActionUpdating, ResNameGeofences, d.Id(), err)
+	}
+
+	if err := putGeofences(ctx, conn, d.Id(), entries); err != nil {
+		return create.AppendDiagError(diags, names.Location, create.ErrActionUpdating, ResNameGeofences, d.Id(), err)
+	}
+
+	o, _ := d.GetChange("geofence_ids")
+	want := geofenceIDs(entries)
+	var del []string
+	for _, id := range flex.ExpandStringValueSet(o.(*schema.Set)) {
+		if !slices.Contains(want, id) {
+			del = append(del, id)
+		}
+	}
+
+	if err := deleteGeofences(ctx, conn, d.Id(), del); err != nil {
+		return create.AppendDiagError(diags, names.Location, create.ErrActionUpdating, ResNameGeofences, d.Id(), err)
+	}
+
+	return append(diags, resourceGeofencesRead(ctx, d, meta)...)
+}
+
+func resourceGeofencesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LocationConn(ctx)
+
+	log.Printf("[INFO] Deleting Location Geofences from collection %s", d.Id())
+
+	err := deleteGeofences(ctx, conn, d.Id(), flex.ExpandStringValueSet(d.Get("geofence_ids").(*schema.Set)))
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Location, create.ErrActionDeleting, ResNameGeofences, d.Id(), err)
+	}
+
+	return diags
+}
+
+func putGeofences(ctx context.Context, conn *locationservice.LocationService, collectionName string, entries []*locationservice.BatchPutGeofenceRequestEntry) error {
+	var errs []error
+
+	for _, chunk := range tfslices.Chunks(entries, geofencesBatchSize) {
+		out, err := conn.BatchPutGeofenceWithContext(ctx, &locationservice.BatchPutGeofenceInput{
+			CollectionName: aws.String(collectionName),
+			Entries:        chunk,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range out.Errors {
+			errs = append(errs, fmt.Errorf("putting geofence (%s): %s: %s", aws.StringValue(v.GeofenceId), aws.StringValue(v.Error.Code), aws.StringValue(v.Error.Message)))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func deleteGeofences(ctx context.Context, conn *locationservice.LocationService, collectionName string, ids []string) error {
+	var errs []error
+
+	for _, chunk := range tfslices.Chunks(ids, geofencesBatchSize) {
+		out, err := conn.BatchDeleteGeofenceWithContext(ctx, &locationservice.BatchDeleteGeofenceInput{
+			CollectionName: aws.String(collectionName),
+			GeofenceIds:    aws.StringSlice(chunk),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range out.Errors {
+			if aws.StringValue(v.Error.Code) == locationservice.BatchItemErrorCodeResourceNotFoundError {
+				continue
+			}
+
+			errs = append(errs, fmt.Errorf("deleting geofence (%s): %s: %s", aws.StringValue(v.GeofenceId), aws.StringValue(v.Error.Code), aws.StringValue(v.Error.Message)))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func findGeofenceIDsByCollectionName(ctx context.Context, conn *locationservice.LocationService, name string) ([]string, error) {
+	// Fail with a NotFoundError if the collection itself is gone.
+	if _, err := findGeofenceCollectionByName(ctx, conn, name); err != nil {
+		return nil, err
+	}
+
+	in := &locationservice.ListGeofencesInput{
+		CollectionName: aws.String(name),
+	}
+	var ids []string
+
+	err := conn.ListGeofencesPagesWithContext(ctx, in, func(page *locationservice.ListGeofencesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Entries {
+			if status := aws.StringValue(v.Status); status == "DELETED" || status == "DELETING" {
+				continue
+			}
+
+			ids = append(ids, aws.StringValue(v.GeofenceId))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+type geoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []geoJSONFeature `json:"features"`
+}
+
+type geoJSONFeature struct {
+	ID         any             `json:"id"`
+	Geometry   geoJSONGeometry `json:"geometry"`
+	Properties map[string]any  `json:"properties"`
+}
+
+type geoJSONGeometry struct {
+	Type        string         `json:"type"`
+	Coordinates [][][]*float64 `json:"coordinates"`
+}
+
+// expandGeofencesFromGeoJSON converts a GeoJSON FeatureCollection of Polygon features into geofence entries.
+// Each feature's "id" is used as the geofence ID and its properties become the geofence properties.
+func expandGeofencesFromGeoJSON(s string) ([]*locationservice.BatchPutGeofenceRequestEntry, error) {
+	var fc geoJSONFeatureCollection
+
+	if err := json.Unmarshal([]byte(s), &fc); err != nil {
+		return nil, fmt.Errorf("parsing GeoJSON: %w", err)
+	}
+
+	if fc.Type != "FeatureCollection" {
+		return nil, fmt.Errorf("GeoJSON type must be FeatureCollection, got %q", fc.Type)
+	}
+
+	entries := make([]*locationservice.BatchPutGeofenceRequestEntry, 0, len(fc.Features))
+	seen := make(map[string]bool, len(fc.Features))
+
+	for i, feature := range fc.Features {
+		var id string
+		switch v := feature.ID.(type) {
+		case string:
+			id = v
+		case float64:
+			id = fmt.Sprintf("%v", v)
+		}
+
+		if id == "" {
+			return nil, fmt.Errorf("GeoJSON feature %d: id is required", i)
+		}
+
+		if seen[id] {
+			return nil, fmt.Errorf("GeoJSON feature %d: duplicate id %q", i, id)
+		}
+		seen[id] = true
+
+		if feature.Geometry.Type != "Polygon" {
+			return nil, fmt.Errorf("GeoJSON feature (%s): geometry type must be Polygon, got %q", id, feature.Geometry.Type)
+		}
+
+		entry := &locationservice.BatchPutGeofenceRequestEntry{
+			GeofenceId: aws.String(id),
+			Geometry: &locationservice.GeofenceGeometry{
+				Polygon: feature.Geometry.Coordinates,
+			},
+		}
+
+		if len(feature.Properties) > 0 {
+			entry.GeofenceProperties = make(map[string]*string, len(feature.Properties))
+
+			for k, v := range feature.Properties {
+				if v, ok := v.(string); ok {
+					entry.GeofenceProperties[k] = aws.String(v)
+					continue
+				}
+
+				b, err := json.Marshal(v)
+				if err != nil {
+					return nil, fmt.Errorf("GeoJSON feature (%s): property %q: %w", id, k, err)
+				}
+				entry.GeofenceProperties[k] = aws.String(string(b))
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func geofenceIDs(entries []*locationservice.BatchPutGeofenceRequestEntry) []string {
+	ids := make([]string, 0, len(entries))
+
+	for _, v := range entries {
+		ids = append(ids, aws.StringValue(v.GeofenceId))
+	}
+
+	return ids
+}
+
+func sameStringSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for _, v := range a {
+		if !slices.Contains(b, v) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package location_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflocation "github.com/hashicorp/terraform-provider-aws/internal/service/location"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLocationGeofences_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_geofences.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LocationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGeofencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGeofencesConfig_basic(rName, "one", "two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeofencesExists(ctx, resourceName, "one", "two"),
+					resource.TestCheckResourceAttrPair(resourceName, "collection_name", "aws_location_geofence_collection.test", "collection_name"),
+					resource.TestCheckResourceAttr(resourceName, "geofence_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "geofence_ids.*", "one"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "geofence_ids.*", "two"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLocationGeofences_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_geofences.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LocationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGeofencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGeofencesConfig_basic(rName, "one", "two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeofencesExists(ctx, resourceName, "one", "two"),
+					resource.TestCheckResourceAttr(resourceName, "geofence_ids.#", "2"),
+				),
+			},
+			{
+				Config: testAccGeofencesConfig_basic(rName, "two", "three"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeofencesExists(ctx, resourceName, "three", "two"),
+					resource.TestCheckResourceAttr(resourceName, "geofence_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "geofence_ids.*", "two"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "geofence_ids.*", "three"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGeofencesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_location_geofences" {
+				continue
+			}
+
+			ids, err := tflocation.FindGeofenceIDsByCollectionName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(ids) > 0 {
+				return create.Error(names.Location, create.ErrActionCheckingDestroyed, tflocation.ResNameGeofences, rs.Primary.ID, errors.New("not destroyed"))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGeofencesExists(ctx context.Context, name string, want ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameGeofences, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameGeofences, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn(ctx)
+
+		ids, err := tflocation.FindGeofenceIDsByCollectionName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameGeofences, rs.Primary.ID, err)
+		}
+
+		slices.Sort(ids)
+		if !slices.Equal(ids, want) {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameGeofences, rs.Primary.ID, fmt.Errorf("geofences = %v, want %v", ids, want))
+		}
+
+		return nil
+	}
+}
+
+func testAccGeofencesConfig_basic(rName string, ids ...string) string {
+	features := make([]string, 0, len(ids))
+	for _, id := range ids {
+		features = append(features, fmt.Sprintf(`
+      {
+        type = "Feature"
+        id   = %[1]q
+        properties = {
+          name = %[1]q
+        }
+        geometry = {
+          type = "Polygon"
+          coordinates = [[
+            [-5.716667, -15.933333],
+            [-14.416667, -7.933333],
+            [-12.316667, -37.066667],
+            [-5.716667, -15.933333],
+          ]]
+        }
+      },`, id))
+	}
+
+	return fmt.Sprintf(`
+resource "aws_location_geofence_collection" "test" {
+  collection_name = %[1]q
+}
+
+resource "aws_location_geofences" "test" {
+  collection_name = aws_location_geofence_collection.test.collection_name
+
+  geojson = jsonencode({
+    type = "FeatureCollection"
+    features = [%[2]s
+    ]
+  })
+}
+`, rName, strings.Join(features, ""))
+}
@@ -58,6 +58,14 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceAPIKey,
+			TypeName: "aws_location_api_key",
+			Name:     "API Key",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "key_arn",
+			},
+		},
 		{
 			Factory:  ResourceGeofenceCollection,
 			TypeName: "aws_location_geofence_collection",
@@ -66,6 +74,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "collection_arn",
 			},
 		},
+		{
+			Factory:  ResourceGeofences,
+			TypeName: "aws_location_geofences",
+			Name:     "Geofences",
+		},
 		{
 			Factory:  ResourceMap,
 			TypeName: "aws_location_map",
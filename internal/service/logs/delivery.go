@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudwatch_log_delivery", name="Delivery")
+// @Tags(identifierAttribute="arn")
+func resourceDelivery() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeliveryCreate,
+		ReadWithoutTimeout:   resourceDeliveryRead,
+		UpdateWithoutTimeout: resourceDeliveryUpdate,
+		DeleteWithoutTimeout: resourceDeliveryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delivery_destination_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"delivery_destination_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delivery_source_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+			"field_delimiter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 5),
+			},
+			"record_fields": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 128,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"s3_delivery_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_hive_compatible_path": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"suffix_path": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDeliveryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	sourceName := d.Get("delivery_source_name").(string)
+	input := &cloudwatchlogs.CreateDeliveryInput{
+		DeliveryDestinationArn: aws.String(d.Get("delivery_destination_arn").(string)),
+		DeliverySourceName:     aws.String(sourceName),
+		Tags:                   getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("field_delimiter"); ok {
+		input.FieldDelimiter = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("record_fields"); ok && len(v.([]interface{})) > 0 {
+		input.RecordFields = flex.ExpandStringValueList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("s3_delivery_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.S3DeliveryConfiguration = expandS3DeliveryConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateDelivery(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudWatch Logs Delivery (%s): %s", sourceName, err)
+	}
+
+	d.SetId(aws.ToString(output.Delivery.Id))
+
+	return append(diags, resourceDeliveryRead(ctx, d, meta)...)
+}
+
+func resourceDeliveryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	delivery, err := findDeliveryByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Logs Delivery (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Logs Delivery (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, delivery.Arn)
+	d.Set("delivery_destination_arn", delivery.DeliveryDestinationArn)
+	d.Set("delivery_destination_type", delivery.DeliveryDestinationType)
+	d.Set("delivery_source_name", delivery.DeliverySourceName)
+	d.Set("field_delimiter", delivery.FieldDelimiter)
+	d.Set("record_fields", delivery.RecordFields)
+	if delivery.S3DeliveryConfiguration != nil {
+		if err := d.Set("s3_delivery_configuration", []interface{}{flattenS3DeliveryConfiguration(delivery.S3DeliveryConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting s3_delivery_configuration: %s", err)
+		}
+	} else {
+		d.Set("s3_delivery_configuration", nil)
+	}
+
+	return diags
+}
+
+func resourceDeliveryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &cloudwatchlogs.UpdateDeliveryConfigurationInput{
+			Id: aws.String(d.Id()),
+		}
+
+		if d.HasChange("field_delimiter") {
+			input.FieldDelimiter = aws.String(d.Get("field_delimiter").(string))
+		}
+
+		if d.HasChange("record_fields") {
+			input.RecordFields = flex.ExpandStringValueList(d.Get("record_fields").([]interface{}))
+		}
+
+		if d.HasChange("s3_delivery_configuration") {
+			if v, ok := d.GetOk("s3_delivery_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.S3DeliveryConfiguration = expandS3DeliveryConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		_, err := conn.UpdateDeliveryConfiguration(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudWatch Logs Delivery (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDeliveryRead(ctx, d, meta)...)
+}
+
+func resourceDeliveryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	log.Printf("[INFO] Deleting CloudWatch Logs Delivery: %s", d.Id())
+	_, err := conn.DeleteDelivery(ctx, &cloudwatchlogs.DeleteDeliveryInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudWatch Logs Delivery (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDeliveryByID(ctx context.Context, conn *cloudwatchlogs.Client, id string) (*types.Delivery, error) {
+	input := &cloudwatchlogs.GetDeliveryInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetDelivery(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Delivery == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Delivery, nil
+}
+
+func expandS3DeliveryConfiguration(tfMap map[string]interface{}) *types.S3DeliveryConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3DeliveryConfiguration{}
+
+	if v, ok := tfMap["enable_hive_compatible_path"].(bool); ok {
+		apiObject.EnableHiveCompatiblePath = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["suffix_path"].(string); ok && v != "" {
+		apiObject.SuffixPath = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3DeliveryConfiguration(apiObject *types.S3DeliveryConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enable_hive_compatible_path": aws.ToBool(apiObject.EnableHiveCompatiblePath),
+		"suffix_path":                 aws.ToString(apiObject.SuffixPath),
+	}
+
+	return tfMap
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_cloudwatch_log_delivery_destination_policy", name="Delivery Destination Policy")
+func resourceDeliveryDestinationPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeliveryDestinationPolicyPut,
+		ReadWithoutTimeout:   resourceDeliveryDestinationPolicyRead,
+		UpdateWithoutTimeout: resourceDeliveryDestinationPolicyPut,
+		DeleteWithoutTimeout: resourceDeliveryDestinationPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"delivery_destination_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+			"delivery_destination_policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceDeliveryDestinationPolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	policy, err := structure.NormalizeJsonString(d.Get("delivery_destination_policy").(string))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	name := d.Get("delivery_destination_name").(string)
+	input := &cloudwatchlogs.PutDeliveryDestinationPolicyInput{
+		DeliveryDestinationName:   aws.String(name),
+		DeliveryDestinationPolicy: aws.String(policy),
+	}
+
+	_, err = conn.PutDeliveryDestinationPolicy(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting CloudWatch Logs Delivery Destination Policy (%s): %s", name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(name)
+	}
+
+	return append(diags, resourceDeliveryDestinationPolicyRead(ctx, d, meta)...)
+}
+
+func resourceDeliveryDestinationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	policy, err := findDeliveryDestinationPolicyByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Logs Delivery Destination Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Logs Delivery Destination Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("delivery_destination_name", d.Id())
+
+	policyToSet, err := verify.PolicyToSet(d.Get("delivery_destination_policy").(string), aws.ToString(policy.DeliveryDestinationPolicy))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.Set("delivery_destination_policy", policyToSet)
+
+	return diags
+}
+
+func resourceDeliveryDestinationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	log.Printf("[INFO] Deleting CloudWatch Logs Delivery Destination Policy: %s", d.Id())
+	_, err := conn.DeleteDeliveryDestinationPolicy(ctx, &cloudwatchlogs.DeleteDeliveryDestinationPolicyInput{
+		DeliveryDestinationName: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudWatch Logs Delivery Destination Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDeliveryDestinationPolicyByName(ctx context.Context, conn *cloudwatchlogs.Client, name string) (*types.Policy, error) {
+	input := &cloudwatchlogs.GetDeliveryDestinationPolicyInput{
+		DeliveryDestinationName: aws.String(name),
+	}
+
+	output, err := conn.GetDeliveryDestinationPolicy(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Policy == nil || output.Policy.DeliveryDestinationPolicy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Policy, nil
+}
@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLogsDelivery_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.Delivery
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_delivery.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, names.USEast1RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeliveryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeliveryExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "logs", regexache.MustCompile(`delivery:.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "delivery_destination_arn", "aws_cloudwatch_log_delivery_destination.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "delivery_destination_type", "CWL"),
+					resource.TestCheckResourceAttrPair(resourceName, "delivery_source_name", "aws_cloudwatch_log_delivery_source.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLogsDelivery_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.Delivery
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_delivery.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, names.USEast1RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeliveryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeliveryExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflogs.ResourceDelivery(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLogsDelivery_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.Delivery
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_delivery.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, names.USEast1RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeliveryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryConfig_s3(rName, `["date", "time", "x-edge-location"]`, ",", false, "{DistributionId}/{yyyy}/{MM}/{dd}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeliveryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "delivery_destination_type", "S3"),
+					resource.TestCheckResourceAttr(resourceName, "field_delimiter", ","),
+					resource.TestCheckResourceAttr(resourceName, "record_fields.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "record_fields.0", "date"),
+					resource.TestCheckResourceAttr(resourceName, "record_fields.1", "time"),
+					resource.TestCheckResourceAttr(resourceName, "record_fields.2", "x-edge-location"),
+					resource.TestCheckResourceAttr(resourceName, "s3_delivery_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "s3_delivery_configuration.0.enable_hive_compatible_path", "false"),
+					resource.TestCheckResourceAttr(resourceName, "s3_delivery_configuration.0.suffix_path", "{DistributionId}/{yyyy}/{MM}/{dd}"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDeliveryConfig_s3(rName, `["date", "time"]`, "\t", true, "{DistributionId}/{yyyy}/{MM}/{dd}/{HH}"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeliveryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "field_delimiter", "\t"),
+					resource.TestCheckResourceAttr(resourceName, "record_fields.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "record_fields.0", "date"),
+					resource.TestCheckResourceAttr(resourceName, "record_fields.1", "time"),
+					resource.TestCheckResourceAttr(resourceName, "s3_delivery_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "s3_delivery_configuration.0.enable_hive_compatible_path", "true"),
+					resource.TestCheckResourceAttr(resourceName, "s3_delivery_configuration.0.suffix_path", "{DistributionId}/{yyyy}/{MM}/{dd}/{HH}"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDeliveryDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudwatch_log_delivery" {
+				continue
+			}
+
+			_, err := tflogs.FindDeliveryByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudWatch Logs Delivery still exists: %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDeliveryExists(ctx context.Context, n string, v *types.Delivery) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
+
+		output, err := tflogs.FindDeliveryByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDeliveryConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDeliverySourceConfig_basic(rName), testAccDeliveryDestinationConfig_basic(rName), `
+resource "aws_cloudwatch_log_delivery" "test" {
+  delivery_source_name     = aws_cloudwatch_log_delivery_source.test.name
+  delivery_destination_arn = aws_cloudwatch_log_delivery_destination.test.arn
+}
+`)
+}
+
+func testAccDeliveryConfig_s3(rName, recordFields, fieldDelimiter string, enableHiveCompatiblePath bool, suffixPath string) string {
+	return acctest.ConfigCompose(testAccDeliverySourceConfig_basic(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "delivery.logs.amazonaws.com"
+      }
+      Action   = "s3:PutObject"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+      Condition = {
+        StringEquals = {
+          "s3:x-amz-acl"      = "bucket-owner-full-control"
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+        ArnLike = {
+          "aws:SourceArn" = aws_cloudwatch_log_delivery_source.test.arn
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_cloudwatch_log_delivery_destination" "test" {
+  name          = %[1]q
+  output_format = "w3c"
+
+  delivery_destination_configuration {
+    destination_resource_arn = aws_s3_bucket.test.arn
+  }
+}
+
+resource "aws_cloudwatch_log_delivery" "test" {
+  delivery_source_name     = aws_cloudwatch_log_delivery_source.test.name
+  delivery_destination_arn = aws_cloudwatch_log_delivery_destination.test.arn
+
+  field_delimiter = %[3]q
+  record_fields   = %[2]s
+
+  s3_delivery_configuration {
+    enable_hive_compatible_path = %[4]t
+    suffix_path                 = %[5]q
+  }
+
+  depends_on = [aws_s3_bucket_policy.test]
+}
+`, rName, recordFields, fieldDelimiter, enableHiveCompatiblePath, suffixPath))
+}
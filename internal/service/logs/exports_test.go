@@ -5,21 +5,29 @@ package logs
 
 // Exports for use in tests only.
 var (
-	ResourceDataProtectionPolicy = resourceDataProtectionPolicy
-	ResourceDestination          = resourceDestination
-	ResourceDestinationPolicy    = resourceDestinationPolicy
-	ResourceGroup                = resourceGroup
-	ResourceMetricFilter         = resourceMetricFilter
-	ResourceQueryDefinition      = resourceQueryDefinition
-	ResourceResourcePolicy       = resourceResourcePolicy
-	ResourceStream               = resourceStream
-	ResourceSubscriptionFilter   = resourceSubscriptionFilter
+	ResourceDataProtectionPolicy      = resourceDataProtectionPolicy
+	ResourceDelivery                  = resourceDelivery
+	ResourceDeliveryDestination       = resourceDeliveryDestination
+	ResourceDeliveryDestinationPolicy = resourceDeliveryDestinationPolicy
+	ResourceDeliverySource            = resourceDeliverySource
+	ResourceDestination               = resourceDestination
+	ResourceDestinationPolicy         = resourceDestinationPolicy
+	ResourceGroup                     = resourceGroup
+	ResourceMetricFilter              = resourceMetricFilter
+	ResourceQueryDefinition           = resourceQueryDefinition
+	ResourceResourcePolicy            = resourceResourcePolicy
+	ResourceStream                    = resourceStream
+	ResourceSubscriptionFilter        = resourceSubscriptionFilter
 
-	FindDestinationByName              = findDestinationByName
-	FindLogGroupByName                 = findLogGroupByName
-	FindLogStreamByTwoPartKey          = findLogStreamByTwoPartKey // nosemgrep:ci.logs-in-var-name
-	FindMetricFilterByTwoPartKey       = findMetricFilterByTwoPartKey
-	FindQueryDefinitionByTwoPartKey    = findQueryDefinitionByTwoPartKey
-	FindResourcePolicyByName           = findResourcePolicyByName
-	FindSubscriptionFilterByTwoPartKey = findSubscriptionFilterByTwoPartKey
+	FindDeliveryByID                    = findDeliveryByID
+	FindDeliveryDestinationByName       = findDeliveryDestinationByName
+	FindDeliveryDestinationPolicyByName = findDeliveryDestinationPolicyByName
+	FindDeliverySourceByName            = findDeliverySourceByName
+	FindDestinationByName               = findDestinationByName
+	FindLogGroupByName                  = findLogGroupByName
+	FindLogStreamByTwoPartKey           = findLogStreamByTwoPartKey // nosemgrep:ci.logs-in-var-name
+	FindMetricFilterByTwoPartKey        = findMetricFilterByTwoPartKey
+	FindQueryDefinitionByTwoPartKey     = findQueryDefinitionByTwoPartKey
+	FindResourcePolicyByName            = findResourcePolicyByName
+	FindSubscriptionFilterByTwoPartKey  = findSubscriptionFilterByTwoPartKey
 )
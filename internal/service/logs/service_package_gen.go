@@ -45,6 +45,35 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceDataProtectionPolicy,
 			TypeName: "aws_cloudwatch_log_data_protection_policy",
 		},
+		{
+			Factory:  resourceDelivery,
+			TypeName: "aws_cloudwatch_log_delivery",
+			Name:     "Delivery",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  resourceDeliveryDestination,
+			TypeName: "aws_cloudwatch_log_delivery_destination",
+			Name:     "Delivery Destination",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  resourceDeliveryDestinationPolicy,
+			TypeName: "aws_cloudwatch_log_delivery_destination_policy",
+			Name:     "Delivery Destination Policy",
+		},
+		{
+			Factory:  resourceDeliverySource,
+			TypeName: "aws_cloudwatch_log_delivery_source",
+			Name:     "Delivery Source",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  resourceDestination,
 			TypeName: "aws_cloudwatch_log_destination",
@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedblockchain
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/managedblockchain"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/managedblockchain/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_managedblockchain_accessor", name="Accessor")
+// @Tags(identifierAttribute="arn")
+func resourceAccessor() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccessorCreate,
+		ReadWithoutTimeout:   resourceAccessorRead,
+		UpdateWithoutTimeout: resourceAccessorUpdate,
+		DeleteWithoutTimeout: resourceAccessorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"accessor_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          awstypes.AccessorTypeBillingToken,
+				ValidateDiagFunc: enum.Validate[awstypes.AccessorType](),
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"billing_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// ETHEREUM_MAINNET_AND_GOERLI is only applied by default and can't be requested.
+			"network_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice(enum.Slice(
+					awstypes.AccessorNetworkTypeEthereumGoerli,
+					awstypes.AccessorNetworkTypeEthereumMainnet,
+					awstypes.AccessorNetworkTypePolygonMainnet,
+					awstypes.AccessorNetworkTypePolygonMumbai,
+				), false),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAccessorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainClient(ctx)
+
+	input := &managedblockchain.CreateAccessorInput{
+		AccessorType:       awstypes.AccessorType(d.Get("accessor_type").(string)),
+		ClientRequestToken: aws.String(id.UniqueId()),
+		Tags:               getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("network_type"); ok {
+		input.NetworkType = awstypes.AccessorNetworkType(v.(string))
+	}
+
+	output, err := conn.CreateAccessor(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Managed Blockchain Accessor: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.AccessorId))
+
+	if _, err := waitAccessorCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Managed Blockchain Accessor (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAccessorRead(ctx, d, meta)...)
+}
+
+func resourceAccessorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainClient(ctx)
+
+	accessor, err := findAccessorByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Managed Blockchain Accessor (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Managed Blockchain Accessor (%s): %s", d.Id(), err)
+	}
+
+	d.Set("accessor_type", accessor.Type)
+	d.Set(names.AttrARN, accessor.Arn)
+	d.Set("billing_token", accessor.BillingToken)
+	d.Set(names.AttrCreationDate, aws.ToTime(accessor.CreationDate).Format(time.RFC3339))
+	d.Set("network_type", accessor.NetworkType)
+	d.Set(names.AttrStatus, accessor.Status)
+
+	setTagsOut(ctx, accessor.Tags)
+
+	return diags
+}
+
+func resourceAccessorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceAccessorRead(ctx, d, meta)
+}
+
+func resourceAccessorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Managed Blockchain Accessor: %s", d.Id())
+	_, err := conn.DeleteAccessor(ctx, &managedblockchain.DeleteAccessorInput{
+		AccessorId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Managed Blockchain Accessor (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAccessorDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Managed Blockchain Accessor (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findAccessorByID(ctx context.Context, conn *managedblockchain.Client, id string) (*awstypes.Accessor, error) {
+	input := &managedblockchain.GetAccessorInput{
+		AccessorId: aws.String(id),
+	}
+
+	output, err := conn.GetAccessor(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Accessor == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// An accessor pending deletion can no longer be used.
+	switch status := output.Accessor.Status; status {
+	case awstypes.AccessorStatusPendingDeletion, awstypes.AccessorStatusDeleted:
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.Accessor, nil
+}
+
+func statusAccessor(ctx context.Context, conn *managedblockchain.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAccessorByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitAccessorCreated(ctx context.Context, conn *managedblockchain.Client, id string, timeout time.Duration) (*awstypes.Accessor, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{},
+		Target:                    enum.Slice(awstypes.AccessorStatusAvailable),
+		Refresh:                   statusAccessor(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Accessor); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAccessorDeleted(ctx context.Context, conn *managedblockchain.Client, id string, timeout time.Duration) (*awstypes.Accessor, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AccessorStatusAvailable),
+		Target:  []string{},
+		Refresh: statusAccessor(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Accessor); ok {
+		return output, err
+	}
+
+	return nil, err
+}
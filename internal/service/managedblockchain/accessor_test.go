@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedblockchain_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/managedblockchain/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmanagedblockchain "github.com/hashicorp/terraform-provider-aws/internal/service/managedblockchain"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccManagedBlockchainAccessor_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Accessor
+	resourceName := "aws_managedblockchain_accessor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ManagedBlockchainServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessorConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "accessor_type", string(awstypes.AccessorTypeBillingToken)),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "managedblockchain", regexache.MustCompile(`accessors/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "billing_token"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationDate),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.AccessorStatusAvailable)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccManagedBlockchainAccessor_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Accessor
+	resourceName := "aws_managedblockchain_accessor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ManagedBlockchainServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessorConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessorExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmanagedblockchain.ResourceAccessor(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccManagedBlockchainAccessor_networkType(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Accessor
+	resourceName := "aws_managedblockchain_accessor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ManagedBlockchainServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessorConfig_networkType(string(awstypes.AccessorNetworkTypePolygonMainnet)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "network_type", string(awstypes.AccessorNetworkTypePolygonMainnet)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccManagedBlockchainAccessor_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Accessor
+	resourceName := "aws_managedblockchain_accessor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ManagedBlockchainServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessorConfig_tags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccessorConfig_tags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAccessorConfig_tags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccessorDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ManagedBlockchainClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_managedblockchain_accessor" {
+				continue
+			}
+
+			_, err := tfmanagedblockchain.FindAccessorByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Managed Blockchain Accessor %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccessorExists(ctx context.Context, n string, v *awstypes.Accessor) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ManagedBlockchainClient(ctx)
+
+		output, err := tfmanagedblockchain.FindAccessorByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAccessorConfig_basic() string {
+	return `
+resource "aws_managedblockchain_accessor" "test" {}
+`
+}
+
+func testAccAccessorConfig_networkType(networkType string) string {
+	return fmt.Sprintf(`
+resource "aws_managedblockchain_accessor" "test" {
+  network_type = %[1]q
+}
+`, networkType)
+}
+
+func testAccAccessorConfig_tags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_managedblockchain_accessor" "test" {
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAccessorConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_managedblockchain_accessor" "test" {
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedblockchain
+
+// Exports for use in tests only.
+var (
+	ResourceAccessor = resourceAccessor
+	ResourceNode     = resourceNode
+
+	FindAccessorByID        = findAccessorByID
+	FindNodeByThreePartKey  = findNodeByThreePartKey
+	NodeResourceIDPartCount = nodeResourceIDPartCount
+)
@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedblockchain
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/managedblockchain"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/managedblockchain/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	nodeResourceIDPartCount = 3
+)
+
+// @SDKResource("aws_managedblockchain_node", name="Node")
+// @Tags(identifierAttribute="arn")
+func resourceNode() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNodeCreate,
+		ReadWithoutTimeout:   resourceNodeRead,
+		UpdateWithoutTimeout: resourceNodeUpdate,
+		DeleteWithoutTimeout: resourceNodeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrAvailabilityZone: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ethereum_attributes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"http_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"web_socket_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"fabric_attributes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"peer_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"peer_event_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrInstanceType: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrKMSKeyARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"member_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_db": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.StateDBType](),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceNodeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainClient(ctx)
+
+	networkID := d.Get("network_id").(string)
+	input := &managedblockchain.CreateNodeInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		NetworkId:          aws.String(networkID),
+		NodeConfiguration: &awstypes.NodeConfiguration{
+			InstanceType: aws.String(d.Get(names.AttrInstanceType).(string)),
+		},
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrAvailabilityZone); ok {
+		input.NodeConfiguration.AvailabilityZone = aws.String(v.(string))
+	}
+
+	memberID := d.Get("member_id").(string)
+	if memberID != "" {
+		input.MemberId = aws.String(memberID)
+	}
+
+	if v, ok := d.GetOk("state_db"); ok {
+		input.NodeConfiguration.StateDB = awstypes.StateDBType(v.(string))
+	}
+
+	output, err := conn.CreateNode(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Managed Blockchain Node (%s): %s", networkID, err)
+	}
+
+	nodeID := aws.ToString(output.NodeId)
+	d.SetId(errs.Must(flex.FlattenResourceId([]string{networkID, memberID, nodeID}, nodeResourceIDPartCount, true)))
+
+	if _, err := waitNodeCreated(ctx, conn, networkID, memberID, nodeID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Managed Blockchain Node (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceNodeRead(ctx, d, meta)...)
+}
+
+func resourceNodeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), nodeResourceIDPartCount, true)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	networkID, memberID, nodeID := parts[0], parts[1], parts[2]
+	node, err := findNodeByThreePartKey(ctx, conn, networkID, memberID, nodeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Managed Blockchain Node (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Managed Blockchain Node (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, node.Arn)
+	d.Set(names.AttrAvailabilityZone, node.AvailabilityZone)
+	d.Set(names.AttrCreationDate, aws.ToTime(node.CreationDate).Format(time.RFC3339))
+	if err := d.Set("ethereum_attributes", flattenNodeEthereumAttributes(node.FrameworkAttributes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ethereum_attributes: %s", err)
+	}
+	if err := d.Set("fabric_attributes", flattenNodeFabricAttributes(node.FrameworkAttributes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting fabric_attributes: %s", err)
+	}
+	d.Set(names.AttrInstanceType, node.InstanceType)
+	d.Set(names.AttrKMSKeyARN, node.KmsKeyArn)
+	d.Set("member_id", node.MemberId)
+	d.Set("network_id", node.NetworkId)
+	d.Set("node_id", node.Id)
+	d.Set("state_db", node.StateDB)
+	d.Set(names.AttrStatus, node.Status)
+
+	setTagsOut(ctx, node.Tags)
+
+	return diags
+}
+
+func resourceNodeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceNodeRead(ctx, d, meta)
+}
+
+func resourceNodeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), nodeResourceIDPartCount, true)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	networkID, memberID, nodeID := parts[0], parts[1], parts[2]
+	input := &managedblockchain.DeleteNodeInput{
+		NetworkId: aws.String(networkID),
+		NodeId:    aws.String(nodeID),
+	}
+
+	if memberID != "" {
+		input.MemberId = aws.String(memberID)
+	}
+
+	log.Printf("[DEBUG] Deleting Managed Blockchain Node: %s", d.Id())
+	_, err = conn.DeleteNode(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Managed Blockchain Node (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitNodeDeleted(ctx, conn, networkID, memberID, nodeID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Managed Blockchain Node (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findNodeByThreePartKey(ctx context.Context, conn *managedblockchain.Client, networkID, memberID, nodeID string) (*awstypes.Node, error) {
+	input := &managedblockchain.GetNodeInput{
+		NetworkId: aws.String(networkID),
+		NodeId:    aws.String(nodeID),
+	}
+
+	if memberID != "" {
+		input.MemberId = aws.String(memberID)
+	}
+
+	output, err := conn.GetNode(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Node == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.Node.Status; status == awstypes.NodeStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.Node, nil
+}
+
+func statusNode(ctx context.Context, conn *managedblockchain.Client, networkID, memberID, nodeID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findNodeByThreePartKey(ctx, conn, networkID, memberID, nodeID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitNodeCreated(ctx context.Context, conn *managedblockchain.Client, networkID, memberID, nodeID string, timeout time.Duration) (*awstypes.Node, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.NodeStatusCreating),
+		Target:     enum.Slice(awstypes.NodeStatusAvailable),
+		Refresh:    statusNode(ctx, conn, networkID, memberID, nodeID),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Node); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitNodeDeleted(ctx context.Context, conn *managedblockchain.Client, networkID, memberID, nodeID string, timeout time.Duration) (*awstypes.Node, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.NodeStatusAvailable, awstypes.NodeStatusCreateFailed, awstypes.NodeStatusDeleting, awstypes.NodeStatusFailed, awstypes.NodeStatusUnhealthy),
+		Target:     []string{},
+		Refresh:    statusNode(ctx, conn, networkID, memberID, nodeID),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Node); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flattenNodeEthereumAttributes(apiObject *awstypes.NodeFrameworkAttributes) []interface{} {
+	if apiObject == nil || apiObject.Ethereum == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"http_endpoint":       aws.ToString(apiObject.Ethereum.HttpEndpoint),
+		"web_socket_endpoint": aws.ToString(apiObject.Ethereum.WebSocketEndpoint),
+	}}
+}
+
+func flattenNodeFabricAttributes(apiObject *awstypes.NodeFrameworkAttributes) []interface{} {
+	if apiObject == nil || apiObject.Fabric == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"peer_endpoint":       aws.ToString(apiObject.Fabric.PeerEndpoint),
+		"peer_event_endpoint": aws.ToString(apiObject.Fabric.PeerEventEndpoint),
+	}}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedblockchain_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/managedblockchain/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfmanagedblockchain "github.com/hashicorp/terraform-provider-aws/internal/service/managedblockchain"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccManagedBlockchainNode_ethereum(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Node
+	resourceName := "aws_managedblockchain_node.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ManagedBlockchainServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNodeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNodeConfig_ethereum("bc.t3.large"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNodeExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "managedblockchain", regexache.MustCompile(`nodes/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrAvailabilityZone, "data.aws_availability_zones.available", "names.0"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationDate),
+					resource.TestCheckResourceAttr(resourceName, "ethereum_attributes.#", acctest.CtOne),
+					resource.TestCheckResourceAttrSet(resourceName, "ethereum_attributes.0.http_endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "ethereum_attributes.0.web_socket_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "fabric_attributes.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "bc.t3.large"),
+					resource.TestCheckResourceAttr(resourceName, "member_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "network_id", "n-ethereum-mainnet"),
+					resource.TestCheckResourceAttrSet(resourceName, "node_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.NodeStatusAvailable)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNodeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ManagedBlockchainClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_managedblockchain_node" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, tfmanagedblockchain.NodeResourceIDPartCount, true)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfmanagedblockchain.FindNodeByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Managed Blockchain Node %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNodeExists(ctx context.Context, n string, v *awstypes.Node) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, tfmanagedblockchain.NodeResourceIDPartCount, true)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ManagedBlockchainClient(ctx)
+
+		output, err := tfmanagedblockchain.FindNodeByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccNodeConfig_ethereum(instanceType string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_managedblockchain_node" "test" {
+  network_id        = "n-ethereum-mainnet"
+  availability_zone = data.aws_availability_zones.available.names[0]
+  instance_type     = %[1]q
+
+  tags = {
+    Name = "test"
+  }
+}
+`, instanceType))
+}
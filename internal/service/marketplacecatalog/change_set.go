@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package marketplacecatalog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/marketplacecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	catalogAWSMarketplace = "AWSMarketplace"
+)
+
+const (
+	// Only one change set can be in progress for an entity at a time.
+	changeSetInUseTimeout = 10 * time.Minute
+)
+
+// startChangeSet starts a change set with a single change and waits for it to succeed.
+func startChangeSet(ctx context.Context, conn *marketplacecatalog.MarketplaceCatalog, catalog, changeType string, entity *marketplacecatalog.Entity, details any, timeout time.Duration) (*marketplacecatalog.DescribeChangeSetOutput, error) {
+	detailsJSON, err := json.Marshal(details)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &marketplacecatalog.StartChangeSetInput{
+		Catalog: aws.String(catalog),
+		ChangeSet: []*marketplacecatalog.Change{{
+			ChangeType: aws.String(changeType),
+			Details:    aws.String(string(detailsJSON)),
+			Entity:     entity,
+		}},
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, changeSetInUseTimeout, func() (interface{}, error) {
+		return conn.StartChangeSetWithContext(ctx, input)
+	}, marketplacecatalog.ErrCodeResourceInUseException)
+
+	if err != nil {
+		return nil, fmt.Errorf("starting %s change set: %w", changeType, err)
+	}
+
+	id := aws.StringValue(outputRaw.(*marketplacecatalog.StartChangeSetOutput).ChangeSetId)
+
+	output, err := waitChangeSetSucceeded(ctx, conn, catalog, id, timeout)
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for %s change set (%s) to succeed: %w", changeType, id, err)
+	}
+
+	return output, nil
+}
+
+func findChangeSetByTwoPartKey(ctx context.Context, conn *marketplacecatalog.MarketplaceCatalog, catalog, id string) (*marketplacecatalog.DescribeChangeSetOutput, error) {
+	input := &marketplacecatalog.DescribeChangeSetInput{
+		Catalog:     aws.String(catalog),
+		ChangeSetId: aws.String(id),
+	}
+
+	output, err := conn.DescribeChangeSetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, marketplacecatalog.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusChangeSet(ctx context.Context, conn *marketplacecatalog.MarketplaceCatalog, catalog, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findChangeSetByTwoPartKey(ctx, conn, catalog, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitChangeSetSucceeded(ctx context.Context, conn *marketplacecatalog.MarketplaceCatalog, catalog, id string, timeout time.Duration) (*marketplacecatalog.DescribeChangeSetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{marketplacecatalog.ChangeStatusPreparing, marketplacecatalog.ChangeStatusApplying},
+		Target:  []string{marketplacecatalog.ChangeStatusSucceeded},
+		Refresh: statusChangeSet(ctx, conn, catalog, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*marketplacecatalog.DescribeChangeSetOutput); ok {
+		if status := aws.StringValue(output.Status); status == marketplacecatalog.ChangeStatusFailed || status == marketplacecatalog.ChangeStatusCancelled {
+			var errs []error
+
+			if v := aws.StringValue(output.FailureDescription); v != "" {
+				errs = append(errs, fmt.Errorf("%s: %s", aws.StringValue(output.FailureCode), v))
+			}
+
+			for _, change := range output.ChangeSet {
+				if change == nil {
+					continue
+				}
+
+				for _, v := range change.ErrorDetailList {
+					if v == nil {
+						continue
+					}
+
+					errs = append(errs, fmt.Errorf("%s: %s", aws.StringValue(v.ErrorCode), aws.StringValue(v.ErrorMessage)))
+				}
+			}
+
+			tfresource.SetLastError(err, errors.Join(errs...))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
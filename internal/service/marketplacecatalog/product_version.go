@@ -0,0 +1,604 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package marketplacecatalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/marketplacecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	productTypeAMI       = "AmiProduct"
+	productTypeContainer = "ContainerProduct"
+)
+
+func productType_Values() []string {
+	return []string{
+		productTypeAMI,
+		productTypeContainer,
+	}
+}
+
+const (
+	deliveryOptionVisibilityRestricted = "Restricted"
+)
+
+const (
+	productVersionResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_marketplacecatalog_product_version", name="Product Version")
+func resourceProductVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProductVersionCreate,
+		ReadWithoutTimeout:   resourceProductVersionRead,
+		DeleteWithoutTimeout: resourceProductVersionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ami_delivery_option": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"ami_delivery_option", "container_delivery_option"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"ami_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"operating_system_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"operating_system_version": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"recommended_instance_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"scanning_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      22,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"security_group": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"from_port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+									"ip_protocol": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice([]string{"tcp", "udp"}, false),
+									},
+									"ip_ranges": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidCIDRNetworkAddress,
+										},
+									},
+									"to_port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+								},
+							},
+						},
+						"usage_instructions": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"user_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"catalog": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  catalogAWSMarketplace,
+			},
+			"container_delivery_option": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"ami_delivery_option", "container_delivery_option"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compatible_services": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"container_images": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"deployment_resource": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									names.AttrURL: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"usage_instructions": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"delivery_option_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"product_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(productType_Values(), false),
+			},
+			"release_notes": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version_title": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceProductVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MarketplaceCatalogConn(ctx)
+
+	catalog := d.Get("catalog").(string)
+	productID := d.Get("product_id").(string)
+	title := d.Get("version_title").(string)
+	details := addDeliveryOptionsDetails{
+		Version: versionInformation{
+			ReleaseNotes: d.Get("release_notes").(string),
+			VersionTitle: title,
+		},
+	}
+
+	if v, ok := d.GetOk("ami_delivery_option"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		details.DeliveryOptions = []deliveryOption{{
+			Details: deliveryOptionDetails{
+				AmiDeliveryOptionDetails: expandAMIDeliveryOptionDetails(v.([]interface{})[0].(map[string]interface{})),
+			},
+		}}
+	}
+
+	if v, ok := d.GetOk("container_delivery_option"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		details.DeliveryOptions = []deliveryOption{{
+			DeliveryOptionTitle: tfMap["title"].(string),
+			Details: deliveryOptionDetails{
+				EcrDeliveryOptionDetails: expandECRDeliveryOptionDetails(tfMap),
+			},
+		}}
+	}
+
+	entity := &marketplacecatalog.Entity{
+		Identifier: aws.String(productID),
+		Type:       aws.String(productEntityType(d.Get("product_type").(string))),
+	}
+
+	if _, err := startChangeSet(ctx, conn, catalog, "AddDeliveryOptions", entity, details, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Marketplace Catalog Product Version (%s/%s): %s", productID, title, err)
+	}
+
+	version, err := findProductVersion(ctx, conn, catalog, productID, func(v *productVersion) bool {
+		return v.VersionTitle == title
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Marketplace Catalog Product Version (%s/%s): %s", productID, title, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{productID, version.ID}, productVersionResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceProductVersionRead(ctx, d, meta)...)
+}
+
+func resourceProductVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MarketplaceCatalogConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), productVersionResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	productID, versionID := parts[0], parts[1]
+	version, err := findProductVersionByThreePartKey(ctx, conn, d.Get("catalog").(string), productID, versionID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Marketplace Catalog Product Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Marketplace Catalog Product Version (%s): %s", d.Id(), err)
+	}
+
+	var deliveryOptionIDs []string
+	for _, v := range version.DeliveryOptions {
+		deliveryOptionIDs = append(deliveryOptionIDs, v.ID)
+	}
+	d.Set("delivery_option_ids", deliveryOptionIDs)
+	d.Set("product_id", productID)
+	d.Set("release_notes", version.ReleaseNotes)
+	d.Set("version_id", version.ID)
+	d.Set("version_title", version.VersionTitle)
+
+	return diags
+}
+
+func resourceProductVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MarketplaceCatalogConn(ctx)
+
+	// Product versions cannot be deleted, so restrict the version's delivery options instead.
+	deliveryOptionIDs := flex.ExpandStringValueList(d.Get("delivery_option_ids").([]interface{}))
+
+	if len(deliveryOptionIDs) == 0 {
+		return diags
+	}
+
+	productID := d.Get("product_id").(string)
+	entity := &marketplacecatalog.Entity{
+		Identifier: aws.String(productID),
+		Type:       aws.String(productEntityType(d.Get("product_type").(string))),
+	}
+	details := restrictDeliveryOptionsDetails{
+		DeliveryOptionIDs: deliveryOptionIDs,
+	}
+
+	log.Printf("[INFO] Deleting Marketplace Catalog Product Version: %s", d.Id())
+	_, err := startChangeSet(ctx, conn, d.Get("catalog").(string), "RestrictDeliveryOptions", entity, details, d.Timeout(schema.TimeoutDelete))
+
+	if tfawserr.ErrCodeEquals(err, marketplacecatalog.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Marketplace Catalog Product Version (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func productEntityType(productType string) string {
+	return productType + "@1.0"
+}
+
+func findProductEntityDetails(ctx context.Context, conn *marketplacecatalog.MarketplaceCatalog, catalog, productID string) (*productEntityDetails, error) {
+	input := &marketplacecatalog.DescribeEntityInput{
+		Catalog:  aws.String(catalog),
+		EntityId: aws.String(productID),
+	}
+
+	output, err := conn.DescribeEntityWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, marketplacecatalog.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Details == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	var details productEntityDetails
+	if err := json.Unmarshal([]byte(aws.StringValue(output.Details)), &details); err != nil {
+		return nil, fmt.Errorf("decoding entity details: %w", err)
+	}
+
+	return &details, nil
+}
+
+func findProductVersion(ctx context.Context, conn *marketplacecatalog.MarketplaceCatalog, catalog, productID string, filter func(*productVersion) bool) (*productVersion, error) {
+	details, err := findProductEntityDetails(ctx, conn, catalog, productID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var output []*productVersion
+	for i := range details.Versions {
+		if v := &details.Versions[i]; filter(v) {
+			output = append(output, v)
+		}
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findProductVersionByThreePartKey(ctx context.Context, conn *marketplacecatalog.MarketplaceCatalog, catalog, productID, versionID string) (*productVersion, error) {
+	output, err := findProductVersion(ctx, conn, catalog, productID, func(v *productVersion) bool {
+		return v.ID == versionID
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// A version whose delivery options are all restricted is no longer available to buyers.
+	restricted := true
+	for _, v := range output.DeliveryOptions {
+		if v.Visibility != deliveryOptionVisibilityRestricted {
+			restricted = false
+			break
+		}
+	}
+
+	if restricted {
+		return nil, &retry.NotFoundError{
+			Message: deliveryOptionVisibilityRestricted,
+		}
+	}
+
+	return output, nil
+}
+
+func expandAMIDeliveryOptionDetails(tfMap map[string]interface{}) *amiDeliveryOptionDetails {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &amiDeliveryOptionDetails{
+		AMISource: amiSource{
+			AccessRoleARN:          tfMap["access_role_arn"].(string),
+			AMIID:                  tfMap["ami_id"].(string),
+			OperatingSystemName:    tfMap["operating_system_name"].(string),
+			OperatingSystemVersion: tfMap["operating_system_version"].(string),
+			ScanningPort:           tfMap["scanning_port"].(int),
+			UserName:               tfMap["user_name"].(string),
+		},
+		RecommendedInstanceType: tfMap["recommended_instance_type"].(string),
+		UsageInstructions:       tfMap["usage_instructions"].(string),
+	}
+
+	for _, tfMapRaw := range tfMap["security_group"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.SecurityGroups = append(apiObject.SecurityGroups, securityGroup{
+			FromPort:   tfMap["from_port"].(int),
+			IPProtocol: tfMap["ip_protocol"].(string),
+			IPRanges:   flex.ExpandStringValueSet(tfMap["ip_ranges"].(*schema.Set)),
+			ToPort:     tfMap["to_port"].(int),
+		})
+	}
+
+	return apiObject
+}
+
+func expandECRDeliveryOptionDetails(tfMap map[string]interface{}) *ecrDeliveryOptionDetails {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecrDeliveryOptionDetails{
+		CompatibleServices: flex.ExpandStringValueSet(tfMap["compatible_services"].(*schema.Set)),
+		ContainerImages:    flex.ExpandStringValueSet(tfMap["container_images"].(*schema.Set)),
+		Description:        tfMap[names.AttrDescription].(string),
+		UsageInstructions:  tfMap["usage_instructions"].(string),
+	}
+
+	for _, tfMapRaw := range tfMap["deployment_resource"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.DeploymentResources = append(apiObject.DeploymentResources, deploymentResource{
+			Name: tfMap[names.AttrName].(string),
+			URL:  tfMap[names.AttrURL].(string),
+		})
+	}
+
+	return apiObject
+}
+
+// Change set details are JSON documents whose shape depends on the change type.
+// See https://docs.aws.amazon.com/marketplace-catalog/latest/api-reference/ami-products.html
+// and https://docs.aws.amazon.com/marketplace-catalog/latest/api-reference/container-products.html.
+
+type addDeliveryOptionsDetails struct {
+	DeliveryOptions []deliveryOption   `json:"DeliveryOptions"`
+	Version         versionInformation `json:"Version"`
+}
+
+type versionInformation struct {
+	ReleaseNotes string `json:"ReleaseNotes"`
+	VersionTitle string `json:"VersionTitle"`
+}
+
+type deliveryOption struct {
+	DeliveryOptionTitle string                `json:"DeliveryOptionTitle,omitempty"`
+	Details             deliveryOptionDetails `json:"Details"`
+}
+
+type deliveryOptionDetails struct {
+	AmiDeliveryOptionDetails *amiDeliveryOptionDetails `json:"AmiDeliveryOptionDetails,omitempty"`
+	EcrDeliveryOptionDetails *ecrDeliveryOptionDetails `json:"EcrDeliveryOptionDetails,omitempty"`
+}
+
+type amiDeliveryOptionDetails struct {
+	AMISource               amiSource       `json:"AmiSource"`
+	RecommendedInstanceType string          `json:"RecommendedInstanceType"`
+	SecurityGroups          []securityGroup `json:"SecurityGroups"`
+	UsageInstructions       string          `json:"UsageInstructions"`
+}
+
+type amiSource struct {
+	AccessRoleARN          string `json:"AccessRoleArn"`
+	AMIID                  string `json:"AmiId"`
+	OperatingSystemName    string `json:"OperatingSystemName"`
+	OperatingSystemVersion string `json:"OperatingSystemVersion"`
+	ScanningPort           int    `json:"ScanningPort"`
+	UserName               string `json:"UserName"`
+}
+
+type securityGroup struct {
+	FromPort   int      `json:"FromPort"`
+	IPProtocol string   `json:"IpProtocol"`
+	IPRanges   []string `json:"IpRanges"`
+	ToPort     int      `json:"ToPort"`
+}
+
+type ecrDeliveryOptionDetails struct {
+	CompatibleServices  []string             `json:"CompatibleServices"`
+	ContainerImages     []string             `json:"ContainerImages"`
+	DeploymentResources []deploymentResource `json:"DeploymentResources,omitempty"`
+	Description         string               `json:"Description"`
+	UsageInstructions   string               `json:"UsageInstructions"`
+}
+
+type deploymentResource struct {
+	Name string `json:"Name"`
+	URL  string `json:"Url"`
+}
+
+type restrictDeliveryOptionsDetails struct {
+	DeliveryOptionIDs []string `json:"DeliveryOptionIds"`
+}
+
+type productEntityDetails struct {
+	Versions []productVersion `json:"Versions"`
+}
+
+type productVersion struct {
+	DeliveryOptions []productDeliveryOption `json:"DeliveryOptions"`
+	ID              string                  `json:"Id"`
+	ReleaseNotes    string                  `json:"ReleaseNotes"`
+	VersionTitle    string                  `json:"VersionTitle"`
+}
+
+type productDeliveryOption struct {
+	ID         string `json:"Id"`
+	Visibility string `json:"Visibility"`
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package marketplacecatalog_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/marketplacecatalog"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmarketplacecatalog "github.com/hashicorp/terraform-provider-aws/internal/service/marketplacecatalog"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Product versions can only be added to an existing, seller-owned container product.
+// Set MARKETPLACE_CATALOG_CONTAINER_PRODUCT_ID and MARKETPLACE_CATALOG_CONTAINER_IMAGE to run these tests.
+
+func TestAccMarketplaceCatalogProductVersion_container(t *testing.T) {
+	ctx := acctest.Context(t)
+	productID := acctest.SkipIfEnvVarNotSet(t, "MARKETPLACE_CATALOG_CONTAINER_PRODUCT_ID")
+	image := acctest.SkipIfEnvVarNotSet(t, "MARKETPLACE_CATALOG_CONTAINER_IMAGE")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_marketplacecatalog_product_version.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, marketplacecatalog.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MarketplaceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProductVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProductVersionConfig_container(rName, productID, image),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProductVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "catalog", "AWSMarketplace"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_option_ids.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "product_id", productID),
+					resource.TestCheckResourceAttr(resourceName, "product_type", "ContainerProduct"),
+					resource.TestCheckResourceAttrSet(resourceName, "version_id"),
+					resource.TestCheckResourceAttr(resourceName, "version_title", rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMarketplaceCatalogProductVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	productID := acctest.SkipIfEnvVarNotSet(t, "MARKETPLACE_CATALOG_CONTAINER_PRODUCT_ID")
+	image := acctest.SkipIfEnvVarNotSet(t, "MARKETPLACE_CATALOG_CONTAINER_IMAGE")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_marketplacecatalog_product_version.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, marketplacecatalog.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MarketplaceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProductVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProductVersionConfig_container(rName, productID, image),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProductVersionExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmarketplacecatalog.ResourceProductVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProductVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MarketplaceCatalogConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_marketplacecatalog_product_version" {
+				continue
+			}
+
+			_, err := tfmarketplacecatalog.FindProductVersionByThreePartKey(ctx, conn, rs.Primary.Attributes["catalog"], rs.Primary.Attributes["product_id"], rs.Primary.Attributes["version_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Marketplace Catalog Product Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProductVersionExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MarketplaceCatalogConn(ctx)
+
+		_, err := tfmarketplacecatalog.FindProductVersionByThreePartKey(ctx, conn, rs.Primary.Attributes["catalog"], rs.Primary.Attributes["product_id"], rs.Primary.Attributes["version_id"])
+
+		return err
+	}
+}
+
+func testAccProductVersionConfig_container(rName, productID, image string) string {
+	return fmt.Sprintf(`
+resource "aws_marketplacecatalog_product_version" "test" {
+  product_id    = %[2]q
+  product_type  = "ContainerProduct"
+  version_title = %[1]q
+  release_notes = "Terraform acceptance test"
+
+  container_delivery_option {
+    title               = %[1]q
+    container_images    = [%[3]q]
+    compatible_services = ["ECS", "EKS"]
+    description         = "Terraform acceptance test"
+    usage_instructions  = "Run the container image."
+  }
+}
+`, rName, productID, image)
+}
@@ -0,0 +1,939 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_media_convert_job_template", name="Job Template")
+// @Tags(identifierAttribute="arn")
+func resourceJobTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobTemplateCreate,
+		ReadWithoutTimeout:   resourceJobTemplateRead,
+		UpdateWithoutTimeout: resourceJobTemplateUpdate,
+		DeleteWithoutTimeout: resourceJobTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"acceleration_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMode: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.AccelerationMode](),
+						},
+					},
+				},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"hop_destination": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrPriority: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(-50, 50),
+						},
+						"queue": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"wait_minutes": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrPriority: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(-50, 50),
+			},
+			"queue": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ad_avail_offset": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(-1000, 1000),
+						},
+						"input": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"audio_selector": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"default_selection": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													Computed:         true,
+													ValidateDiagFunc: enum.Validate[types.AudioDefaultSelection](),
+												},
+												names.AttrName: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"selector_type": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													Computed:         true,
+													ValidateDiagFunc: enum.Validate[types.AudioSelectorType](),
+												},
+												"tracks": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeInt},
+												},
+											},
+										},
+									},
+									"timecode_source": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ValidateDiagFunc: enum.Validate[types.InputTimecodeSource](),
+									},
+								},
+							},
+						},
+						"output_group": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"custom_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"output": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"extension": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"name_modifier": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"preset": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"output_group_settings": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cmaf_group_settings": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															names.AttrDestination: {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+															"fragment_length": {
+																Type:     schema.TypeInt,
+																Optional: true,
+																Computed: true,
+															},
+															"segment_length": {
+																Type:     schema.TypeInt,
+																Optional: true,
+																Computed: true,
+															},
+														},
+													},
+												},
+												"dash_iso_group_settings": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															names.AttrDestination: {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+															"fragment_length": {
+																Type:     schema.TypeInt,
+																Optional: true,
+																Computed: true,
+															},
+															"segment_length": {
+																Type:     schema.TypeInt,
+																Optional: true,
+																Computed: true,
+															},
+														},
+													},
+												},
+												"file_group_settings": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															names.AttrDestination: {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+												"hls_group_settings": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															names.AttrDestination: {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+															"min_segment_length": {
+																Type:     schema.TypeInt,
+																Optional: true,
+																Computed: true,
+															},
+															"segment_length": {
+																Type:     schema.TypeInt,
+																Optional: true,
+																Computed: true,
+															},
+														},
+													},
+												},
+												names.AttrType: {
+													Type:             schema.TypeString,
+													Required:         true,
+													ValidateDiagFunc: enum.Validate[types.OutputGroupType](),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"timecode_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"anchor": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									names.AttrSource: {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ValidateDiagFunc: enum.Validate[types.TimecodeSource](),
+									},
+									"start": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"timestamp_offset": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status_update_interval": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.StatusUpdateInterval](),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceJobTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConvertClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &mediaconvert.CreateJobTemplateInput{
+		Name:     aws.String(name),
+		Settings: expandJobTemplateSettings(d.Get("settings").([]interface{})),
+		Tags:     getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("acceleration_settings"); ok {
+		input.AccelerationSettings = expandAccelerationSettings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("category"); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("hop_destination"); ok {
+		input.HopDestinations = expandHopDestinations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrPriority); ok {
+		input.Priority = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("queue"); ok {
+		input.Queue = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("status_update_interval"); ok {
+		input.StatusUpdateInterval = types.StatusUpdateInterval(v.(string))
+	}
+
+	output, err := conn.CreateJobTemplate(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Media Convert Job Template (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.JobTemplate.Name))
+
+	return append(diags, resourceJobTemplateRead(ctx, d, meta)...)
+}
+
+func resourceJobTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConvertClient(ctx)
+
+	jobTemplate, err := findJobTemplateByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Media Convert Job Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Media Convert Job Template (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("acceleration_settings", flattenAccelerationSettings(jobTemplate.AccelerationSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting acceleration_settings: %s", err)
+	}
+	d.Set(names.AttrARN, jobTemplate.Arn)
+	d.Set("category", jobTemplate.Category)
+	d.Set(names.AttrDescription, jobTemplate.Description)
+	if err := d.Set("hop_destination", flattenHopDestinations(jobTemplate.HopDestinations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting hop_destination: %s", err)
+	}
+	d.Set(names.AttrName, jobTemplate.Name)
+	d.Set(names.AttrPriority, jobTemplate.Priority)
+	d.Set("queue", jobTemplate.Queue)
+	if err := d.Set("settings", flattenJobTemplateSettings(jobTemplate.Settings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting settings: %s", err)
+	}
+	d.Set("status_update_interval", jobTemplate.StatusUpdateInterval)
+
+	return diags
+}
+
+func resourceJobTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConvertClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &mediaconvert.UpdateJobTemplateInput{
+			Category:             aws.String(d.Get("category").(string)),
+			Description:          aws.String(d.Get(names.AttrDescription).(string)),
+			HopDestinations:      expandHopDestinations(d.Get("hop_destination").([]interface{})),
+			Name:                 aws.String(d.Id()),
+			Priority:             aws.Int32(int32(d.Get(names.AttrPriority).(int))),
+			Settings:             expandJobTemplateSettings(d.Get("settings").([]interface{})),
+			StatusUpdateInterval: types.StatusUpdateInterval(d.Get("status_update_interval").(string)),
+		}
+
+		if v, ok := d.GetOk("acceleration_settings"); ok {
+			input.AccelerationSettings = expandAccelerationSettings(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("queue"); ok {
+			input.Queue = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateJobTemplate(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Media Convert Job Template (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceJobTemplateRead(ctx, d, meta)...)
+}
+
+func resourceJobTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConvertClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Media Convert Job Template: %s", d.Id())
+	_, err := conn.DeleteJobTemplate(ctx, &mediaconvert.DeleteJobTemplateInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Media Convert Job Template (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findJobTemplateByName(ctx context.Context, conn *mediaconvert.Client, name string) (*types.JobTemplate, error) {
+	input := &mediaconvert.GetJobTemplateInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetJobTemplate(ctx, input)
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JobTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.JobTemplate, nil
+}
+
+func expandAccelerationSettings(tfList []interface{}) *types.AccelerationSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &types.AccelerationSettings{
+		Mode: types.AccelerationMode(tfMap[names.AttrMode].(string)),
+	}
+}
+
+func expandHopDestinations(tfList []interface{}) []types.HopDestination {
+	apiObjects := make([]types.HopDestination, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.HopDestination{}
+
+		if v, ok := tfMap[names.AttrPriority].(int); ok {
+			apiObject.Priority = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["queue"].(string); ok && v != "" {
+			apiObject.Queue = aws.String(v)
+		}
+
+		if v, ok := tfMap["wait_minutes"].(int); ok && v != 0 {
+			apiObject.WaitMinutes = aws.Int32(int32(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandJobTemplateSettings(tfList []interface{}) *types.JobTemplateSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.JobTemplateSettings{}
+
+	if v, ok := tfMap["ad_avail_offset"].(int); ok && v != 0 {
+		apiObject.AdAvailOffset = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["input"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Inputs = expandInputTemplates(v)
+	}
+
+	if v, ok := tfMap["output_group"].([]interface{}); ok && len(v) > 0 {
+		apiObject.OutputGroups = expandOutputGroups(v)
+	}
+
+	if v, ok := tfMap["timecode_config"].([]interface{}); ok && len(v) > 0 {
+		apiObject.TimecodeConfig = expandTimecodeConfig(v)
+	}
+
+	return apiObject
+}
+
+func expandInputTemplates(tfList []interface{}) []types.InputTemplate {
+	apiObjects := make([]types.InputTemplate, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		apiObject := types.InputTemplate{}
+
+		// An empty input block is valid and uses the service defaults.
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			if v, ok := tfMap["audio_selector"].(*schema.Set); ok && v.Len() > 0 {
+				apiObject.AudioSelectors = expandAudioSelectors(v.List())
+			}
+
+			if v, ok := tfMap["timecode_source"].(string); ok && v != "" {
+				apiObject.TimecodeSource = types.InputTimecodeSource(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAudioSelectors(tfList []interface{}) map[string]types.AudioSelector {
+	apiObjects := make(map[string]types.AudioSelector)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.AudioSelector{}
+
+		if v, ok := tfMap["default_selection"].(string); ok && v != "" {
+			apiObject.DefaultSelection = types.AudioDefaultSelection(v)
+		}
+
+		if v, ok := tfMap["selector_type"].(string); ok && v != "" {
+			apiObject.SelectorType = types.AudioSelectorType(v)
+		}
+
+		if v, ok := tfMap["tracks"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Tracks = flex.ExpandInt32ValueList(v)
+		}
+
+		apiObjects[tfMap[names.AttrName].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandOutputGroups(tfList []interface{}) []types.OutputGroup {
+	apiObjects := make([]types.OutputGroup, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.OutputGroup{}
+
+		if v, ok := tfMap["custom_name"].(string); ok && v != "" {
+			apiObject.CustomName = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["output"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Outputs = expandOutputs(v)
+		}
+
+		if v, ok := tfMap["output_group_settings"].([]interface{}); ok && len(v) > 0 {
+			apiObject.OutputGroupSettings = expandOutputGroupSettings(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandOutputs(tfList []interface{}) []types.Output {
+	apiObjects := make([]types.Output, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.Output{}
+
+		if v, ok := tfMap["extension"].(string); ok && v != "" {
+			apiObject.Extension = aws.String(v)
+		}
+
+		if v, ok := tfMap["name_modifier"].(string); ok && v != "" {
+			apiObject.NameModifier = aws.String(v)
+		}
+
+		if v, ok := tfMap["preset"].(string); ok && v != "" {
+			apiObject.Preset = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandOutputGroupSettings(tfList []interface{}) *types.OutputGroupSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.OutputGroupSettings{
+		Type: types.OutputGroupType(tfMap[names.AttrType].(string)),
+	}
+
+	if v, ok := tfMap["cmaf_group_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.CmafGroupSettings = &types.CmafGroupSettings{}
+
+		if v, ok := tfMap[names.AttrDestination].(string); ok && v != "" {
+			apiObject.CmafGroupSettings.Destination = aws.String(v)
+		}
+
+		if v, ok := tfMap["fragment_length"].(int); ok && v != 0 {
+			apiObject.CmafGroupSettings.FragmentLength = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["segment_length"].(int); ok && v != 0 {
+			apiObject.CmafGroupSettings.SegmentLength = aws.Int32(int32(v))
+		}
+	}
+
+	if v, ok := tfMap["dash_iso_group_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.DashIsoGroupSettings = &types.DashIsoGroupSettings{}
+
+		if v, ok := tfMap[names.AttrDestination].(string); ok && v != "" {
+			apiObject.DashIsoGroupSettings.Destination = aws.String(v)
+		}
+
+		if v, ok := tfMap["fragment_length"].(int); ok && v != 0 {
+			apiObject.DashIsoGroupSettings.FragmentLength = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["segment_length"].(int); ok && v != 0 {
+			apiObject.DashIsoGroupSettings.SegmentLength = aws.Int32(int32(v))
+		}
+	}
+
+	if v, ok := tfMap["file_group_settings"].([]interface{}); ok && len(v) > 0 {
+		apiObject.FileGroupSettings = &types.FileGroupSettings{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap[names.AttrDestination].(string); ok && v != "" {
+				apiObject.FileGroupSettings.Destination = aws.String(v)
+			}
+		}
+	}
+
+	if v, ok := tfMap["hls_group_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.HlsGroupSettings = &types.HlsGroupSettings{}
+
+		if v, ok := tfMap[names.AttrDestination].(string); ok && v != "" {
+			apiObject.HlsGroupSettings.Destination = aws.String(v)
+		}
+
+		if v, ok := tfMap["min_segment_length"].(int); ok && v != 0 {
+			apiObject.HlsGroupSettings.MinSegmentLength = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["segment_length"].(int); ok && v != 0 {
+			apiObject.HlsGroupSettings.SegmentLength = aws.Int32(int32(v))
+		}
+	}
+
+	return apiObject
+}
+
+func expandTimecodeConfig(tfList []interface{}) *types.TimecodeConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.TimecodeConfig{}
+
+	if v, ok := tfMap["anchor"].(string); ok && v != "" {
+		apiObject.Anchor = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrSource].(string); ok && v != "" {
+		apiObject.Source = types.TimecodeSource(v)
+	}
+
+	if v, ok := tfMap["start"].(string); ok && v != "" {
+		apiObject.Start = aws.String(v)
+	}
+
+	if v, ok := tfMap["timestamp_offset"].(string); ok && v != "" {
+		apiObject.TimestampOffset = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAccelerationSettings(apiObject *types.AccelerationSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrMode: apiObject.Mode,
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenHopDestinations(apiObjects []types.HopDestination) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrPriority: aws.ToInt32(apiObject.Priority),
+			"queue":            aws.ToString(apiObject.Queue),
+			"wait_minutes":     aws.ToInt32(apiObject.WaitMinutes),
+		})
+	}
+
+	return tfList
+}
+
+func flattenJobTemplateSettings(apiObject *types.JobTemplateSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"ad_avail_offset": aws.ToInt32(apiObject.AdAvailOffset),
+		"input":           flattenInputTemplates(apiObject.Inputs),
+		"output_group":    flattenOutputGroups(apiObject.OutputGroups),
+		"timecode_config": flattenTimecodeConfig(apiObject.TimecodeConfig),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInputTemplates(apiObjects []types.InputTemplate) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"audio_selector":  flattenAudioSelectors(apiObject.AudioSelectors),
+			"timecode_source": apiObject.TimecodeSource,
+		})
+	}
+
+	return tfList
+}
+
+func flattenAudioSelectors(apiObjects map[string]types.AudioSelector) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for name, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"default_selection": apiObject.DefaultSelection,
+			names.AttrName:      name,
+			"selector_type":     apiObject.SelectorType,
+			"tracks":            flex.FlattenInt32ValueList(apiObject.Tracks),
+		})
+	}
+
+	return tfList
+}
+
+func flattenOutputGroups(apiObjects []types.OutputGroup) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"custom_name":           aws.ToString(apiObject.CustomName),
+			names.AttrName:          aws.ToString(apiObject.Name),
+			"output":                flattenOutputs(apiObject.Outputs),
+			"output_group_settings": flattenOutputGroupSettings(apiObject.OutputGroupSettings),
+		})
+	}
+
+	return tfList
+}
+
+func flattenOutputs(apiObjects []types.Output) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"extension":     aws.ToString(apiObject.Extension),
+			"name_modifier": aws.ToString(apiObject.NameModifier),
+			"preset":        aws.ToString(apiObject.Preset),
+		})
+	}
+
+	return tfList
+}
+
+func flattenOutputGroupSettings(apiObject *types.OutputGroupSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrType: apiObject.Type,
+	}
+
+	if v := apiObject.CmafGroupSettings; v != nil {
+		tfMap["cmaf_group_settings"] = []interface{}{map[string]interface{}{
+			names.AttrDestination: aws.ToString(v.Destination),
+			"fragment_length":     aws.ToInt32(v.FragmentLength),
+			"segment_length":      aws.ToInt32(v.SegmentLength),
+		}}
+	}
+
+	if v := apiObject.DashIsoGroupSettings; v != nil {
+		tfMap["dash_iso_group_settings"] = []interface{}{map[string]interface{}{
+			names.AttrDestination: aws.ToString(v.Destination),
+			"fragment_length":     aws.ToInt32(v.FragmentLength),
+			"segment_length":      aws.ToInt32(v.SegmentLength),
+		}}
+	}
+
+	if v := apiObject.FileGroupSettings; v != nil {
+		tfMap["file_group_settings"] = []interface{}{map[string]interface{}{
+			names.AttrDestination: aws.ToString(v.Destination),
+		}}
+	}
+
+	if v := apiObject.HlsGroupSettings; v != nil {
+		tfMap["hls_group_settings"] = []interface{}{map[string]interface{}{
+			names.AttrDestination: aws.ToString(v.Destination),
+			"min_segment_length":  aws.ToInt32(v.MinSegmentLength),
+			"segment_length":      aws.ToInt32(v.SegmentLength),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTimecodeConfig(apiObject *types.TimecodeConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"anchor":           aws.ToString(apiObject.Anchor),
+		names.AttrSource:   apiObject.Source,
+		"start":            aws.ToString(apiObject.Start),
+		"timestamp_offset": aws.ToString(apiObject.TimestampOffset),
+	}
+
+	return []interface{}{tfMap}
+}
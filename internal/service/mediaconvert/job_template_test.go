@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconvert_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconvert/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconvert "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaConvertJobTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobTemplate types.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "mediaconvert", regexache.MustCompile(`jobTemplates/.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "settings.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.output_group.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.output_group.0.output.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.output_group.0.output.0.preset", "System-Generic_Hd_Mp4_Avc_Aac_16x9_1920x1080p_24Hz_6Mbps"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.output_group.0.output_group_settings.0.type", string(types.OutputGroupTypeFileGroupSettings)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertJobTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobTemplate types.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediaconvert.ResourceJobTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaConvertJobTemplate_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobTemplate types.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConvertServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.output_group.0.output_group_settings.0.type", string(types.OutputGroupTypeFileGroupSettings)),
+				),
+			},
+			{
+				Config: testAccJobTemplateConfig_hls(rName, "updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobTemplateExists(ctx, resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, "10"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.input.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.input.0.audio_selector.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.output_group.0.output_group_settings.0.type", string(types.OutputGroupTypeHlsGroupSettings)),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.output_group.0.output_group_settings.0.hls_group_settings.0.segment_length", "6"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckJobTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_media_convert_job_template" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConvertClient(ctx)
+
+			_, err := tfmediaconvert.FindJobTemplateByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Media Convert Job Template %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckJobTemplateExists(ctx context.Context, n string, v *types.JobTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConvertClient(ctx)
+
+		output, err := tfmediaconvert.FindJobTemplateByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccJobTemplateConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_job_template" "test" {
+  name = %[1]q
+
+  settings {
+    output_group {
+      output {
+        preset = "System-Generic_Hd_Mp4_Avc_Aac_16x9_1920x1080p_24Hz_6Mbps"
+      }
+
+      output_group_settings {
+        type = "FILE_GROUP_SETTINGS"
+
+        file_group_settings {}
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccJobTemplateConfig_hls(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_job_template" "test" {
+  name        = %[1]q
+  description = %[2]q
+  priority    = 10
+
+  settings {
+    input {
+      timecode_source = "ZEROBASED"
+
+      audio_selector {
+        name              = "Audio Selector 1"
+        default_selection = "DEFAULT"
+      }
+    }
+
+    output_group {
+      name = "Apple HLS"
+
+      output {
+        name_modifier = "_720p"
+        preset        = "System-Avc_16x9_720p_29_97fps_3500kbps"
+      }
+
+      output_group_settings {
+        type = "HLS_GROUP_SETTINGS"
+
+        hls_group_settings {
+          min_segment_length = 0
+          segment_length     = 6
+        }
+      }
+    }
+  }
+}
+`, rName, description)
+}
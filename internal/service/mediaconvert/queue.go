@@ -5,14 +5,18 @@ package mediaconvert
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/mediaconvert"
 	"github.com/aws/aws-sdk-go-v2/service/mediaconvert/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -69,14 +73,27 @@ func resourceQueue() *schema.Resource {
 							Required:         true,
 							ValidateDiagFunc: enum.Validate[types.Commitment](),
 						},
+						"expires_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"purchased_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"renewal_type": {
 							Type:             schema.TypeString,
 							Required:         true,
 							ValidateDiagFunc: enum.Validate[types.RenewalType](),
 						},
 						"reserved_slots": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
 						},
 					},
 				},
@@ -91,7 +108,10 @@ func resourceQueue() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceQueueCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -172,8 +192,11 @@ func resourceQueueUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.Description = aws.String(v.(string))
 		}
 
-		if v, ok := d.Get("reservation_plan_settings").([]interface{}); ok && len(v) > 0 && v[0] != nil {
-			input.ReservationPlanSettings = expandReservationPlanSettings(v[0].(map[string]interface{}))
+		// Resending unchanged reservation settings would start a new 12-month commitment.
+		if d.HasChange("reservation_plan_settings") {
+			if v, ok := d.Get("reservation_plan_settings").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				input.ReservationPlanSettings = expandReservationPlanSettings(v[0].(map[string]interface{}))
+			}
 		}
 
 		_, err := conn.UpdateQueue(ctx, input)
@@ -206,6 +229,30 @@ func resourceQueueDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
+func resourceQueueCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	// A reserved queue can't be deleted before its commitment term ends, so the
+	// commitment can't be changed by replacing the queue either.
+	if d.HasChange("reservation_plan_settings.0.commitment") {
+		if o, n := d.GetChange("reservation_plan_settings.0.commitment"); o.(string) != "" {
+			return fmt.Errorf("reservation_plan_settings.0.commitment cannot be changed (%s to %s)", o, n)
+		}
+	}
+
+	// Reserved transcoding slots can be added to a commitment but never given back.
+	if d.HasChange("reservation_plan_settings.0.reserved_slots") {
+		o, n := d.GetChange("reservation_plan_settings.0.reserved_slots")
+		if o, n := o.(int), n.(int); o > 0 && n > 0 && n < o {
+			return fmt.Errorf("reservation_plan_settings.0.reserved_slots cannot be decreased (%d to %d)", o, n)
+		}
+	}
+
+	return nil
+}
+
 func findQueueByName(ctx context.Context, conn *mediaconvert.Client, name string) (*types.Queue, error) {
 	input := &mediaconvert.GetQueueInput{
 		Name: aws.String(name),
@@ -262,6 +309,15 @@ func flattenReservationPlan(apiObject *types.ReservationPlan) map[string]interfa
 		"commitment":     apiObject.Commitment,
 		"renewal_type":   apiObject.RenewalType,
 		"reserved_slots": aws.ToInt32(apiObject.ReservedSlots),
+		names.AttrStatus: apiObject.Status,
+	}
+
+	if v := apiObject.ExpiresAt; v != nil {
+		tfMap["expires_at"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.PurchasedAt; v != nil {
+		tfMap["purchased_at"] = aws.ToTime(v).Format(time.RFC3339)
 	}
 
 	return tfMap
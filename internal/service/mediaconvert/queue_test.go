@@ -143,8 +143,11 @@ func TestAccMediaConvertQueue_reservationPlanSettings(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "pricing_plan", string(types.PricingPlanReserved)),
 					resource.TestCheckResourceAttr(resourceName, "reservation_plan_settings.#", acctest.CtOne),
 					resource.TestCheckResourceAttr(resourceName, "reservation_plan_settings.0.commitment", string(types.CommitmentOneYear)),
+					resource.TestCheckResourceAttrSet(resourceName, "reservation_plan_settings.0.expires_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "reservation_plan_settings.0.purchased_at"),
 					resource.TestCheckResourceAttr(resourceName, "reservation_plan_settings.0.renewal_type", string(types.RenewalTypeAutoRenew)),
 					resource.TestCheckResourceAttr(resourceName, "reservation_plan_settings.0.reserved_slots", acctest.CtOne),
+					resource.TestCheckResourceAttrSet(resourceName, "reservation_plan_settings.0.status"),
 				),
 			},
 			{
@@ -71,7 +71,6 @@ func ResourceChannel() *schema.Resource {
 				"channel_class": {
 					Type:             schema.TypeString,
 					Required:         true,
-					ForceNew:         true,
 					ValidateDiagFunc: enum.Validate[types.ChannelClass](),
 				},
 				"channel_id": {
@@ -908,13 +907,32 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			}
 		}
 
-		out, err := conn.UpdateChannel(ctx, in)
-		if err != nil {
-			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Id(), err)
+		// Changing the pipeline redundancy has its own API, which also takes the
+		// destinations since the number of URLs per destination depends on the class.
+		if d.HasChange("channel_class") {
+			out, err := conn.UpdateChannelClass(ctx, &medialive.UpdateChannelClassInput{
+				ChannelClass: types.ChannelClass(d.Get("channel_class").(string)),
+				ChannelId:    aws.String(d.Id()),
+				Destinations: expandChannelDestinations(d.Get("destinations").(*schema.Set).List()),
+			})
+			if err != nil {
+				return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Id(), err)
+			}
+
+			if _, err := waitChannelUpdated(ctx, conn, aws.ToString(out.Channel.Id), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return create.AppendDiagError(diags, names.MediaLive, create.ErrActionWaitingForUpdate, ResNameChannel, d.Id(), err)
+			}
 		}
 
-		if _, err := waitChannelUpdated(ctx, conn, aws.ToString(out.Channel.Id), d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionWaitingForUpdate, ResNameChannel, d.Id(), err)
+		if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "start_channel", "channel_class") {
+			out, err := conn.UpdateChannel(ctx, in)
+			if err != nil {
+				return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Id(), err)
+			}
+
+			if _, err := waitChannelUpdated(ctx, conn, aws.ToString(out.Channel.Id), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return create.AppendDiagError(diags, names.MediaLive, create.ErrActionWaitingForUpdate, ResNameChannel, d.Id(), err)
+			}
 		}
 	}
 
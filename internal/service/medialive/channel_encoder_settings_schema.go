@@ -2376,6 +2376,21 @@ func channelEncoderSettingsSchema() *schema.Schema {
 						},
 					},
 				},
+				"thumbnail_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrState: {
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateDiagFunc: enum.Validate[types.ThumbnailState](),
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -3100,6 +3115,9 @@ func expandChannelEncoderSettings(tfList []interface{}) *types.EncoderSettings {
 	if v, ok := m["nielsen_configuration"].([]interface{}); ok && len(v) > 0 {
 		settings.NielsenConfiguration = expandChannelEncoderSettingsNielsenConfiguration(v)
 	}
+	if v, ok := m["thumbnail_configuration"].([]interface{}); ok && len(v) > 0 {
+		settings.ThumbnailConfiguration = expandChannelEncoderSettingsThumbnailConfiguration(v)
+	}
 
 	return &settings
 }
@@ -5338,6 +5356,21 @@ func expandChannelEncoderSettingsNielsenConfiguration(tfList []interface{}) *typ
 	return &out
 }
 
+func expandChannelEncoderSettingsThumbnailConfiguration(tfList []interface{}) *types.ThumbnailConfiguration {
+	if tfList == nil {
+		return nil
+	}
+
+	m := tfList[0].(map[string]interface{})
+
+	var out types.ThumbnailConfiguration
+	if v, ok := m[names.AttrState].(string); ok && v != "" {
+		out.State = types.ThumbnailState(v)
+	}
+
+	return &out
+}
+
 func expandChannelEncoderSettingsVideoDescriptionsCodecSettings(tfList []interface{}) *types.VideoCodecSettings {
 	if tfList == nil {
 		return nil
@@ -5800,6 +5833,7 @@ func flattenChannelEncoderSettings(apiObject *types.EncoderSettings) []interface
 		"global_configuration":          flattenGlobalConfiguration(apiObject.GlobalConfiguration),
 		"motion_graphics_configuration": flattenMotionGraphicsConfiguration(apiObject.MotionGraphicsConfiguration),
 		"nielsen_configuration":         flattenNielsenConfiguration(apiObject.NielsenConfiguration),
+		"thumbnail_configuration":       flattenThumbnailConfiguration(apiObject.ThumbnailConfiguration),
 	}
 
 	return []interface{}{m}
@@ -6894,6 +6928,18 @@ func flattenNielsenConfiguration(apiObject *types.NielsenConfiguration) []interf
 	return []interface{}{m}
 }
 
+func flattenThumbnailConfiguration(apiObject *types.ThumbnailConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		names.AttrState: string(apiObject.State),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenVideoDescriptionsCodecSettings(in *types.VideoCodecSettings) []interface{} {
 	if in == nil {
 		return nil
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/medialive"
 	"github.com/aws/aws-sdk-go-v2/service/medialive/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -807,6 +808,47 @@ func TestAccMediaLiveChannel_update(t *testing.T) {
 	})
 }
 
+func TestAccMediaLiveChannel_channelClass(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var channel1, channel2 medialive.DescribeChannelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_medialive_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+			testAccChannelsPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_channelClass(rName, "STANDARD", "AUTO"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &channel1),
+					resource.TestCheckResourceAttr(resourceName, "channel_class", "STANDARD"),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.0.thumbnail_configuration.0.state", "AUTO"),
+				),
+			},
+			{
+				Config: testAccChannelConfig_channelClass(rName, "SINGLE_PIPELINE", "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &channel2),
+					testAccCheckChannelNotRecreated(&channel1, &channel2),
+					resource.TestCheckResourceAttr(resourceName, "channel_class", "SINGLE_PIPELINE"),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.0.thumbnail_configuration.0.state", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccMediaLiveChannel_updateTags(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -964,6 +1006,16 @@ func testAccCheckChannelStatus(ctx context.Context, name string, state types.Cha
 	}
 }
 
+func testAccCheckChannelNotRecreated(before, after *medialive.DescribeChannelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.Id), aws.ToString(after.Id); before != after {
+			return create.Error(names.MediaLive, create.ErrActionCheckingNotRecreated, tfmedialive.ResNameChannel, before, errors.New("recreated"))
+		}
+
+		return nil
+	}
+}
+
 func testAccChannelsPreCheck(ctx context.Context, t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveClient(ctx)
 
@@ -2193,6 +2245,91 @@ resource "aws_medialive_channel" "test" {
 `, rName, rNameUpdated, codec, inputResolution))
 }
 
+func testAccChannelConfig_channelClass(rName, channelClass, thumbnailState string) string {
+	return acctest.ConfigCompose(
+		testAccChannelConfig_base(rName),
+		testAccChannelConfig_baseS3(rName),
+		testAccChannelConfig_baseMultiplex(rName),
+		fmt.Sprintf(`
+resource "aws_medialive_channel" "test" {
+  name          = %[1]q
+  channel_class = %[2]q
+  role_arn      = aws_iam_role.test.arn
+
+  input_specification {
+    codec            = "AVC"
+    input_resolution = "HD"
+    maximum_bitrate  = "MAX_20_MBPS"
+  }
+
+  input_attachments {
+    input_attachment_name = "example-input1"
+    input_id              = aws_medialive_input.test.id
+  }
+
+  destinations {
+    id = %[1]q
+
+    dynamic "settings" {
+      for_each = %[2]q == "STANDARD" ? [aws_s3_bucket.test1.id, aws_s3_bucket.test2.id] : [aws_s3_bucket.test1.id]
+
+      content {
+        url = "s3://${settings.value}/test"
+      }
+    }
+  }
+
+  encoder_settings {
+    timecode_config {
+      source = "EMBEDDED"
+    }
+
+    audio_descriptions {
+      audio_selector_name = %[1]q
+      name                = %[1]q
+    }
+
+    video_descriptions {
+      name = "test-video-name"
+    }
+
+    thumbnail_configuration {
+      state = %[3]q
+    }
+
+    output_groups {
+      output_group_settings {
+        archive_group_settings {
+          destination {
+            destination_ref_id = %[1]q
+          }
+        }
+      }
+
+      outputs {
+        output_name             = "test-output-name"
+        video_description_name  = "test-video-name"
+        audio_description_names = [%[1]q]
+        output_settings {
+          archive_output_settings {
+            name_modifier = "_1"
+            extension     = "m2ts"
+            container_settings {
+              m2ts_settings {
+                audio_buffer_model = "ATSC"
+                buffer_model       = "MULTIPLEX"
+                rate_mode          = "CBR"
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName, channelClass, thumbnailState))
+}
+
 func testAccChannelConfig_tags1(rName, key1, value1 string) string {
 	return acctest.ConfigCompose(
 		testAccChannelConfig_base(rName),
@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	"github.com/aws/aws-sdk-go-v2/service/medialive/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_medialive_cloudwatch_alarm_template", name="CloudWatch Alarm Template")
+// @Tags(identifierAttribute="arn")
+func ResourceCloudWatchAlarmTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCloudWatchAlarmTemplateCreate,
+		ReadWithoutTimeout:   resourceCloudWatchAlarmTemplateRead,
+		UpdateWithoutTimeout: resourceCloudWatchAlarmTemplateUpdate,
+		DeleteWithoutTimeout: resourceCloudWatchAlarmTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"comparison_operator": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.CloudWatchAlarmTemplateComparisonOperator](),
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datapoints_to_alarm": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"evaluation_periods": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"group_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrMetricName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+			},
+			"modified_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(10, 86400),
+			},
+			"statistic": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.CloudWatchAlarmTemplateStatistic](),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"target_resource_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.CloudWatchAlarmTemplateTargetResourceType](),
+			},
+			"threshold": {
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+			"treat_missing_data": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.CloudWatchAlarmTemplateTreatMissingData](),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameCloudWatchAlarmTemplate = "CloudWatch Alarm Template"
+)
+
+func resourceCloudWatchAlarmTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	in := &medialive.CreateCloudWatchAlarmTemplateInput{
+		ComparisonOperator: types.CloudWatchAlarmTemplateComparisonOperator(d.Get("comparison_operator").(string)),
+		EvaluationPeriods:  aws.Int32(int32(d.Get("evaluation_periods").(int))),
+		GroupIdentifier:    aws.String(d.Get("group_identifier").(string)),
+		MetricName:         aws.String(d.Get(names.AttrMetricName).(string)),
+		Name:               aws.String(d.Get(names.AttrName).(string)),
+		Period:             aws.Int32(int32(d.Get("period").(int))),
+		Statistic:          types.CloudWatchAlarmTemplateStatistic(d.Get("statistic").(string)),
+		Tags:               getTagsIn(ctx),
+		TargetResourceType: types.CloudWatchAlarmTemplateTargetResourceType(d.Get("target_resource_type").(string)),
+		Threshold:          aws.Float64(d.Get("threshold").(float64)),
+		TreatMissingData:   types.CloudWatchAlarmTemplateTreatMissingData(d.Get("treat_missing_data").(string)),
+	}
+
+	if v, ok := d.GetOk("datapoints_to_alarm"); ok {
+		in.DatapointsToAlarm = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	out, err := conn.CreateCloudWatchAlarmTemplate(ctx, in)
+	if err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionCreating, ResNameCloudWatchAlarmTemplate, d.Get(names.AttrName).(string), err)
+	}
+
+	if out == nil || out.Id == nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionCreating, ResNameCloudWatchAlarmTemplate, d.Get(names.AttrName).(string), errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.Id))
+
+	return append(diags, resourceCloudWatchAlarmTemplateRead(ctx, d, meta)...)
+}
+
+func resourceCloudWatchAlarmTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	out, err := FindCloudWatchAlarmTemplateByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaLive CloudWatch Alarm Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionReading, ResNameCloudWatchAlarmTemplate, d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, out.Arn)
+	d.Set("comparison_operator", out.ComparisonOperator)
+	d.Set("created_at", aws.ToTime(out.CreatedAt).Format(time.RFC3339))
+	d.Set("datapoints_to_alarm", out.DatapointsToAlarm)
+	d.Set(names.AttrDescription, out.Description)
+	d.Set("evaluation_periods", out.EvaluationPeriods)
+	d.Set("group_id", out.GroupId)
+	// The group can be referenced by either ID or name.
+	if _, ok := d.GetOk("group_identifier"); !ok {
+		d.Set("group_identifier", out.GroupId)
+	}
+	d.Set(names.AttrMetricName, out.MetricName)
+	if out.ModifiedAt != nil {
+		d.Set("modified_at", aws.ToTime(out.ModifiedAt).Format(time.RFC3339))
+	} else {
+		d.Set("modified_at", nil)
+	}
+	d.Set(names.AttrName, out.Name)
+	d.Set("period", out.Period)
+	d.Set("statistic", out.Statistic)
+	d.Set("target_resource_type", out.TargetResourceType)
+	d.Set("threshold", out.Threshold)
+	d.Set("treat_missing_data", out.TreatMissingData)
+
+	return diags
+}
+
+func resourceCloudWatchAlarmTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		in := &medialive.UpdateCloudWatchAlarmTemplateInput{
+			Identifier: aws.String(d.Id()),
+		}
+
+		if d.HasChange("comparison_operator") {
+			in.ComparisonOperator = types.CloudWatchAlarmTemplateComparisonOperator(d.Get("comparison_operator").(string))
+		}
+		if d.HasChange("datapoints_to_alarm") {
+			in.DatapointsToAlarm = aws.Int32(int32(d.Get("datapoints_to_alarm").(int)))
+		}
+		if d.HasChange(names.AttrDescription) {
+			in.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+		if d.HasChange("evaluation_periods") {
+			in.EvaluationPeriods = aws.Int32(int32(d.Get("evaluation_periods").(int)))
+		}
+		if d.HasChange("group_identifier") {
+			in.GroupIdentifier = aws.String(d.Get("group_identifier").(string))
+		}
+		if d.HasChange(names.AttrMetricName) {
+			in.MetricName = aws.String(d.Get(names.AttrMetricName).(string))
+		}
+		if d.HasChange(names.AttrName) {
+			in.Name = aws.String(d.Get(names.AttrName).(string))
+		}
+		if d.HasChange("period") {
+			in.Period = aws.Int32(int32(d.Get("period").(int)))
+		}
+		if d.HasChange("statistic") {
+			in.Statistic = types.CloudWatchAlarmTemplateStatistic(d.Get("statistic").(string))
+		}
+		if d.HasChange("target_resource_type") {
+			in.TargetResourceType = types.CloudWatchAlarmTemplateTargetResourceType(d.Get("target_resource_type").(string))
+		}
+		if d.HasChange("threshold") {
+			in.Threshold = aws.Float64(d.Get("threshold").(float64))
+		}
+		if d.HasChange("treat_missing_data") {
+			in.TreatMissingData = types.CloudWatchAlarmTemplateTreatMissingData(d.Get("treat_missing_data").(string))
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive CloudWatch Alarm Template (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateCloudWatchAlarmTemplate(ctx, in)
+		if err != nil {
+			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameCloudWatchAlarmTemplate, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCloudWatchAlarmTemplateRead(ctx, d, meta)...)
+}
+
+func resourceCloudWatchAlarmTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	log.Printf("[INFO] Deleting MediaLive CloudWatch Alarm Template %s", d.Id())
+
+	_, err := conn.DeleteCloudWatchAlarmTemplate(ctx, &medialive.DeleteCloudWatchAlarmTemplateInput{
+		Identifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionDeleting, ResNameCloudWatchAlarmTemplate, d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindCloudWatchAlarmTemplateByID(ctx context.Context, conn *medialive.Client, id string) (*medialive.GetCloudWatchAlarmTemplateOutput, error) {
+	in := &medialive.GetCloudWatchAlarmTemplateInput{
+		Identifier: aws.String(id),
+	}
+	out, err := conn.GetCloudWatchAlarmTemplate(ctx, in)
+	if err != nil {
+		if errs.IsA[*types.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
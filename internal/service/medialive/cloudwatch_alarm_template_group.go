@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	"github.com/aws/aws-sdk-go-v2/service/medialive/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_medialive_cloudwatch_alarm_template_group", name="CloudWatch Alarm Template Group")
+// @Tags(identifierAttribute="arn")
+func ResourceCloudWatchAlarmTemplateGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCloudWatchAlarmTemplateGroupCreate,
+		ReadWithoutTimeout:   resourceCloudWatchAlarmTemplateGroupRead,
+		UpdateWithoutTimeout: resourceCloudWatchAlarmTemplateGroupUpdate,
+		DeleteWithoutTimeout: resourceCloudWatchAlarmTemplateGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"modified_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameCloudWatchAlarmTemplateGroup = "CloudWatch Alarm Template Group"
+)
+
+func resourceCloudWatchAlarmTemplateGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	in := &medialive.CreateCloudWatchAlarmTemplateGroupInput{
+		Name: aws.String(d.Get(names.AttrName).(string)),
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	out, err := conn.CreateCloudWatchAlarmTemplateGroup(ctx, in)
+	if err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionCreating, ResNameCloudWatchAlarmTemplateGroup, d.Get(names.AttrName).(string), err)
+	}
+
+	if out == nil || out.Id == nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionCreating, ResNameCloudWatchAlarmTemplateGroup, d.Get(names.AttrName).(string), errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.Id))
+
+	return append(diags, resourceCloudWatchAlarmTemplateGroupRead(ctx, d, meta)...)
+}
+
+func resourceCloudWatchAlarmTemplateGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	out, err := FindCloudWatchAlarmTemplateGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaLive CloudWatch Alarm Template Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionReading, ResNameCloudWatchAlarmTemplateGroup, d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, out.Arn)
+	d.Set("created_at", aws.ToTime(out.CreatedAt).Format(time.RFC3339))
+	d.Set(names.AttrDescription, out.Description)
+	if out.ModifiedAt != nil {
+		d.Set("modified_at", aws.ToTime(out.ModifiedAt).Format(time.RFC3339))
+	} else {
+		d.Set("modified_at", nil)
+	}
+	d.Set(names.AttrName, out.Name)
+
+	return diags
+}
+
+func resourceCloudWatchAlarmTemplateGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		in := &medialive.UpdateCloudWatchAlarmTemplateGroupInput{
+			Identifier:  aws.String(d.Id()),
+			Description: aws.String(d.Get(names.AttrDescription).(string)),
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive CloudWatch Alarm Template Group (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateCloudWatchAlarmTemplateGroup(ctx, in)
+		if err != nil {
+			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameCloudWatchAlarmTemplateGroup, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCloudWatchAlarmTemplateGroupRead(ctx, d, meta)...)
+}
+
+func resourceCloudWatchAlarmTemplateGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).MediaLiveClient(ctx)
+
+	log.Printf("[INFO] Deleting MediaLive CloudWatch Alarm Template Group %s", d.Id())
+
+	_, err := conn.DeleteCloudWatchAlarmTemplateGroup(ctx, &medialive.DeleteCloudWatchAlarmTemplateGroupInput{
+		Identifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionDeleting, ResNameCloudWatchAlarmTemplateGroup, d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindCloudWatchAlarmTemplateGroupByID(ctx context.Context, conn *medialive.Client, id string) (*medialive.GetCloudWatchAlarmTemplateGroupOutput, error) {
+	in := &medialive.GetCloudWatchAlarmTemplateGroupInput{
+		Identifier: aws.String(id),
+	}
+	out, err := conn.GetCloudWatchAlarmTemplateGroup(ctx, in)
+	if err != nil {
+		if errs.IsA[*types.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
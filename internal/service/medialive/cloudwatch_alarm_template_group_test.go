@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfmedialive "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaLiveCloudWatchAlarmTemplateGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group medialive.GetCloudWatchAlarmTemplateGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_medialive_cloudwatch_alarm_template_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCloudWatchAlarmTemplateGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudWatchAlarmTemplateGroupConfig_basic(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchAlarmTemplateGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCloudWatchAlarmTemplateGroupConfig_basic(rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchAlarmTemplateGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveCloudWatchAlarmTemplateGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group medialive.GetCloudWatchAlarmTemplateGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_medialive_cloudwatch_alarm_template_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCloudWatchAlarmTemplateGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudWatchAlarmTemplateGroupConfig_basic(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchAlarmTemplateGroupExists(ctx, resourceName, &group),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmedialive.ResourceCloudWatchAlarmTemplateGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudWatchAlarmTemplateGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_medialive_cloudwatch_alarm_template_group" {
+				continue
+			}
+
+			_, err := tfmedialive.FindCloudWatchAlarmTemplateGroupByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.MediaLive, create.ErrActionCheckingDestroyed, tfmedialive.ResNameCloudWatchAlarmTemplateGroup, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.MediaLive, create.ErrActionCheckingDestroyed, tfmedialive.ResNameCloudWatchAlarmTemplateGroup, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCloudWatchAlarmTemplateGroupExists(ctx context.Context, name string, group *medialive.GetCloudWatchAlarmTemplateGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameCloudWatchAlarmTemplateGroup, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameCloudWatchAlarmTemplateGroup, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveClient(ctx)
+
+		resp, err := tfmedialive.FindCloudWatchAlarmTemplateGroupByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameCloudWatchAlarmTemplateGroup, rs.Primary.ID, err)
+		}
+
+		*group = *resp
+
+		return nil
+	}
+}
+
+func testAccCloudWatchAlarmTemplateGroupConfig_basic(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_cloudwatch_alarm_template_group" "test" {
+  name        = %[1]q
+  description = %[2]q
+}
+`, rName, description)
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package medialive_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/medialive"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfmedialive "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaLiveCloudWatchAlarmTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template medialive.GetCloudWatchAlarmTemplateOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_medialive_cloudwatch_alarm_template.test"
+	groupResourceName := "aws_medialive_cloudwatch_alarm_template_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCloudWatchAlarmTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudWatchAlarmTemplateConfig_basic(rName, 1000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchAlarmTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "comparison_operator", "GreaterThanOrEqualToThreshold"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_periods", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "group_id", groupResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrMetricName, "ActiveAlerts"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "period", "300"),
+					resource.TestCheckResourceAttr(resourceName, "statistic", "Average"),
+					resource.TestCheckResourceAttr(resourceName, "target_resource_type", "MEDIALIVE_CHANNEL"),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "treat_missing_data", "notBreaching"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCloudWatchAlarmTemplateConfig_basic(rName, 2000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchAlarmTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "2000"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveCloudWatchAlarmTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template medialive.GetCloudWatchAlarmTemplateOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_medialive_cloudwatch_alarm_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MediaLiveEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaLiveServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCloudWatchAlarmTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudWatchAlarmTemplateConfig_basic(rName, 1000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchAlarmTemplateExists(ctx, resourceName, &template),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmedialive.ResourceCloudWatchAlarmTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudWatchAlarmTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_medialive_cloudwatch_alarm_template" {
+				continue
+			}
+
+			_, err := tfmedialive.FindCloudWatchAlarmTemplateByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.MediaLive, create.ErrActionCheckingDestroyed, tfmedialive.ResNameCloudWatchAlarmTemplate, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.MediaLive, create.ErrActionCheckingDestroyed, tfmedialive.ResNameCloudWatchAlarmTemplate, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCloudWatchAlarmTemplateExists(ctx context.Context, name string, template *medialive.GetCloudWatchAlarmTemplateOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameCloudWatchAlarmTemplate, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameCloudWatchAlarmTemplate, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveClient(ctx)
+
+		resp, err := tfmedialive.FindCloudWatchAlarmTemplateByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.MediaLive, create.ErrActionCheckingExistence, tfmedialive.ResNameCloudWatchAlarmTemplate, rs.Primary.ID, err)
+		}
+
+		*template = *resp
+
+		return nil
+	}
+}
+
+func testAccCloudWatchAlarmTemplateConfig_basic(rName string, threshold int) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_cloudwatch_alarm_template_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_medialive_cloudwatch_alarm_template" "test" {
+  group_identifier     = aws_medialive_cloudwatch_alarm_template_group.test.id
+  name                 = %[1]q
+  comparison_operator  = "GreaterThanOrEqualToThreshold"
+  evaluation_periods   = 2
+  metric_name          = "ActiveAlerts"
+  period               = 300
+  statistic            = "Average"
+  target_resource_type = "MEDIALIVE_CHANNEL"
+  threshold            = %[2]d
+  treat_missing_data   = "notBreaching"
+}
+`, rName, threshold)
+}
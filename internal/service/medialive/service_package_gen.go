@@ -45,6 +45,22 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceCloudWatchAlarmTemplate,
+			TypeName: "aws_medialive_cloudwatch_alarm_template",
+			Name:     "CloudWatch Alarm Template",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  ResourceCloudWatchAlarmTemplateGroup,
+			TypeName: "aws_medialive_cloudwatch_alarm_template_group",
+			Name:     "CloudWatch Alarm Template Group",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceInput,
 			TypeName: "aws_medialive_input",
@@ -0,0 +1,677 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkmonitor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkmonitor"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkmonitor/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_networkmonitor_monitor", name="Monitor")
+// @Tags(identifierAttribute="arn")
+func resourceMonitor() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMonitorCreate,
+		ReadWithoutTimeout:   resourceMonitorRead,
+		UpdateWithoutTimeout: resourceMonitorUpdate,
+		DeleteWithoutTimeout: resourceMonitorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aggregation_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice([]int{30, 60}),
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"monitor_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 200),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"probe": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address_family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDestination: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsIPAddress,
+						},
+						"destination_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"packet_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      56,
+							ValidateFunc: validation.IntBetween(56, 8500),
+						},
+						"probe_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrProtocol: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.Protocol](),
+						},
+						"source_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						names.AttrState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrVPCID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				// Only the configurable arguments identify a probe. Any change to them replaces the probe.
+				Set: func(v interface{}) int {
+					var buf bytes.Buffer
+					tfMap := v.(map[string]interface{})
+					buf.WriteString(fmt.Sprintf("%s-", tfMap[names.AttrDestination].(string)))
+					buf.WriteString(fmt.Sprintf("%d-", tfMap["destination_port"].(int)))
+					buf.WriteString(fmt.Sprintf("%d-", tfMap["packet_size"].(int)))
+					buf.WriteString(fmt.Sprintf("%s-", tfMap[names.AttrProtocol].(string)))
+					buf.WriteString(fmt.Sprintf("%s-", tfMap["source_arn"].(string)))
+					return create.StringHashcode(buf.String())
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			probesPlanTimeValidate,
+		),
+	}
+}
+
+func resourceMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NetworkMonitorClient(ctx)
+
+	name := d.Get("monitor_name").(string)
+	input := &networkmonitor.CreateMonitorInput{
+		ClientToken: aws.String(id.UniqueId()),
+		MonitorName: aws.String(name),
+		Tags:        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("aggregation_period"); ok {
+		input.AggregationPeriod = aws.Int64(int64(v.(int)))
+	}
+
+	// All configured probes are created with the monitor in a single request.
+	if v, ok := d.GetOk("probe"); ok && v.(*schema.Set).Len() > 0 {
+		for _, tfMapRaw := range v.(*schema.Set).List() {
+			input.Probes = append(input.Probes, expandCreateMonitorProbeInput(tfMapRaw.(map[string]interface{})))
+		}
+	}
+
+	_, err := conn.CreateMonitor(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudWatch Network Monitor Monitor (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitMonitorReady(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudWatch Network Monitor Monitor (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceMonitorRead(ctx, d, meta)...)
+}
+
+func resourceMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NetworkMonitorClient(ctx)
+
+	monitor, err := findMonitorByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Network Monitor Monitor (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Network Monitor Monitor (%s): %s", d.Id(), err)
+	}
+
+	d.Set("aggregation_period", monitor.AggregationPeriod)
+	d.Set(names.AttrARN, monitor.MonitorArn)
+	d.Set("monitor_name", monitor.MonitorName)
+	if err := d.Set("probe", flattenProbes(monitor.Probes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting probe: %s", err)
+	}
+
+	setTagsOut(ctx, monitor.Tags)
+
+	return diags
+}
+
+func resourceMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NetworkMonitorClient(ctx)
+
+	if d.HasChange("aggregation_period") {
+		input := &networkmonitor.UpdateMonitorInput{
+			AggregationPeriod: aws.Int64(int64(d.Get("aggregation_period").(int))),
+			MonitorName:       aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateMonitor(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudWatch Network Monitor Monitor (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitMonitorReady(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for CloudWatch Network Monitor Monitor (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("probe") {
+		o, n := d.GetChange("probe")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// Remove probes before adding their replacements so that a changed probe never
+		// exists twice at the same time.
+		var probeIDs []string
+		for _, tfMapRaw := range os.Difference(ns).List() {
+			if v := tfMapRaw.(map[string]interface{})["probe_id"].(string); v != "" {
+				probeIDs = append(probeIDs, v)
+			}
+		}
+
+		if err := deleteProbes(ctx, conn, d.Id(), probeIDs, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		var add []*awstypes.ProbeInput
+		for _, tfMapRaw := range ns.Difference(os).List() {
+			add = append(add, expandProbeInput(tfMapRaw.(map[string]interface{})))
+		}
+
+		if err := createProbes(ctx, conn, d.Id(), add, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceMonitorRead(ctx, d, meta)...)
+}
+
+func resourceMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NetworkMonitorClient(ctx)
+
+	var probeIDs []string
+	for _, tfMapRaw := range d.Get("probe").(*schema.Set).List() {
+		if v := tfMapRaw.(map[string]interface{})["probe_id"].(string); v != "" {
+			probeIDs = append(probeIDs, v)
+		}
+	}
+
+	if err := deleteProbes(ctx, conn, d.Id(), probeIDs, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting CloudWatch Network Monitor Monitor: %s", d.Id())
+	_, err := conn.DeleteMonitor(ctx, &networkmonitor.DeleteMonitorInput{
+		MonitorName: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudWatch Network Monitor Monitor (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitMonitorDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudWatch Network Monitor Monitor (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// probesPlanTimeValidate checks that each configured probe's destination port is consistent with its protocol.
+func probesPlanTimeValidate(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	var diags diag.Diagnostics
+
+	configRaw := d.GetRawConfig()
+	if !configRaw.IsKnown() || configRaw.IsNull() {
+		return nil
+	}
+
+	probesPath := cty.GetAttrPath("probe")
+	probes := configRaw.GetAttr("probe")
+	if !probes.IsKnown() || probes.IsNull() {
+		return nil
+	}
+
+	for it := probes.ElementIterator(); it.Next(); {
+		i, probe := it.Element()
+		probePath := probesPath.Index(i)
+
+		protocol, port := probe.GetAttr(names.AttrProtocol), probe.GetAttr("destination_port")
+		if !protocol.IsKnown() || protocol.IsNull() || !port.IsKnown() {
+			continue
+		}
+
+		switch awstypes.Protocol(protocol.AsString()) {
+		case awstypes.ProtocolTcp:
+			if port.IsNull() {
+				diags = append(diags, errs.NewAttributeErrorDiagnostic(probePath,
+					"Invalid Attribute Combination",
+					fmt.Sprintf("%q must be specified when %q is %q.",
+						errs.PathString(probePath.GetAttr("destination_port")),
+						errs.PathString(probePath.GetAttr(names.AttrProtocol)),
+						awstypes.ProtocolTcp,
+					),
+				))
+			}
+
+		case awstypes.ProtocolIcmp:
+			if !port.IsNull() {
+				diags = append(diags, errs.NewAttributeErrorDiagnostic(probePath,
+					"Invalid Attribute Combination",
+					fmt.Sprintf("%q must not be specified when %q is %q.",
+						errs.PathString(probePath.GetAttr("destination_port")),
+						errs.PathString(probePath.GetAttr(names.AttrProtocol)),
+						awstypes.ProtocolIcmp,
+					),
+				))
+			}
+		}
+	}
+
+	return sdkdiag.DiagnosticsError(diags)
+}
+
+// createProbes adds probes to a monitor and waits for all of them to become ready.
+func createProbes(ctx context.Context, conn *networkmonitor.Client, monitorName string, probes []*awstypes.ProbeInput, timeout time.Duration) error {
+	var probeIDs []string
+
+	for _, probe := range probes {
+		input := &networkmonitor.CreateProbeInput{
+			ClientToken: aws.String(id.UniqueId()),
+			MonitorName: aws.String(monitorName),
+			Probe:       probe,
+		}
+
+		output, err := conn.CreateProbe(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("creating CloudWatch Network Monitor Monitor (%s) probe (%s): %w", monitorName, aws.ToString(probe.Destination), err)
+		}
+
+		probeIDs = append(probeIDs, aws.ToString(output.ProbeId))
+	}
+
+	for _, probeID := range probeIDs {
+		if _, err := waitProbeReady(ctx, conn, monitorName, probeID, timeout); err != nil {
+			return fmt.Errorf("waiting for CloudWatch Network Monitor Monitor (%s) probe (%s) create: %w", monitorName, probeID, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteProbes removes probes from a monitor.
+// Active probes must be deactivated before they can be deleted, so all probes are deactivated first
+// and then deleted together.
+func deleteProbes(ctx context.Context, conn *networkmonitor.Client, monitorName string, probeIDs []string, timeout time.Duration) error {
+	for _, probeID := range probeIDs {
+		input := &networkmonitor.UpdateProbeInput{
+			MonitorName: aws.String(monitorName),
+			ProbeId:     aws.String(probeID),
+			State:       awstypes.ProbeStateInactive,
+		}
+
+		_, err := conn.UpdateProbe(ctx, input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deactivating CloudWatch Network Monitor Monitor (%s) probe (%s): %w", monitorName, probeID, err)
+		}
+	}
+
+	for _, probeID := range probeIDs {
+		if _, err := waitProbeReady(ctx, conn, monitorName, probeID, timeout); err != nil {
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			return fmt.Errorf("waiting for CloudWatch Network Monitor Monitor (%s) probe (%s) deactivate: %w", monitorName, probeID, err)
+		}
+
+		log.Printf("[DEBUG] Deleting CloudWatch Network Monitor Monitor (%s) probe: %s", monitorName, probeID)
+		_, err := conn.DeleteProbe(ctx, &networkmonitor.DeleteProbeInput{
+			MonitorName: aws.String(monitorName),
+			ProbeId:     aws.String(probeID),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting CloudWatch Network Monitor Monitor (%s) probe (%s): %w", monitorName, probeID, err)
+		}
+	}
+
+	for _, probeID := range probeIDs {
+		if _, err := waitProbeDeleted(ctx, conn, monitorName, probeID, timeout); err != nil {
+			return fmt.Errorf("waiting for CloudWatch Network Monitor Monitor (%s) probe (%s) delete: %w", monitorName, probeID, err)
+		}
+	}
+
+	return nil
+}
+
+func findMonitorByName(ctx context.Context, conn *networkmonitor.Client, name string) (*networkmonitor.GetMonitorOutput, error) {
+	input := &networkmonitor.GetMonitorInput{
+		MonitorName: aws.String(name),
+	}
+
+	output, err := conn.GetMonitor(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findProbeByTwoPartKey(ctx context.Context, conn *networkmonitor.Client, monitorName, probeID string) (*networkmonitor.GetProbeOutput, error) {
+	input := &networkmonitor.GetProbeInput{
+		MonitorName: aws.String(monitorName),
+		ProbeId:     aws.String(probeID),
+	}
+
+	output, err := conn.GetProbe(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := output.State; state == awstypes.ProbeStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func statusMonitor(ctx context.Context, conn *networkmonitor.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findMonitorByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+func statusProbe(ctx context.Context, conn *networkmonitor.Client, monitorName, probeID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findProbeByTwoPartKey(ctx, conn, monitorName, probeID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+func waitMonitorReady(ctx context.Context, conn *networkmonitor.Client, name string, timeout time.Duration) (*networkmonitor.GetMonitorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.MonitorStatePending),
+		Target:  enum.Slice(awstypes.MonitorStateActive, awstypes.MonitorStateInactive),
+		Refresh: statusMonitor(ctx, conn, name),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmonitor.GetMonitorOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMonitorDeleted(ctx context.Context, conn *networkmonitor.Client, name string, timeout time.Duration) (*networkmonitor.GetMonitorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.MonitorStateDeleting, awstypes.MonitorStateActive, awstypes.MonitorStateInactive),
+		Target:  []string{},
+		Refresh: statusMonitor(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmonitor.GetMonitorOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProbeReady(ctx context.Context, conn *networkmonitor.Client, monitorName, probeID string, timeout time.Duration) (*networkmonitor.GetProbeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ProbeStatePending),
+		Target:  enum.Slice(awstypes.ProbeStateActive, awstypes.ProbeStateInactive),
+		Refresh: statusProbe(ctx, conn, monitorName, probeID),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmonitor.GetProbeOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProbeDeleted(ctx context.Context, conn *networkmonitor.Client, monitorName, probeID string, timeout time.Duration) (*networkmonitor.GetProbeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ProbeStateActive, awstypes.ProbeStateInactive, awstypes.ProbeStateDeleting),
+		Target:  []string{},
+		Refresh: statusProbe(ctx, conn, monitorName, probeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmonitor.GetProbeOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandCreateMonitorProbeInput(tfMap map[string]interface{}) awstypes.CreateMonitorProbeInput {
+	apiObject := awstypes.CreateMonitorProbeInput{}
+
+	if v, ok := tfMap[names.AttrDestination].(string); ok && v != "" {
+		apiObject.Destination = aws.String(v)
+	}
+
+	if v, ok := tfMap["destination_port"].(int); ok && v != 0 {
+		apiObject.DestinationPort = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["packet_size"].(int); ok && v != 0 {
+		apiObject.PacketSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap[names.AttrProtocol].(string); ok && v != "" {
+		apiObject.Protocol = awstypes.Protocol(v)
+	}
+
+	if v, ok := tfMap["source_arn"].(string); ok && v != "" {
+		apiObject.SourceArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandProbeInput(tfMap map[string]interface{}) *awstypes.ProbeInput {
+	apiObject := &awstypes.ProbeInput{}
+
+	if v, ok := tfMap[names.AttrDestination].(string); ok && v != "" {
+		apiObject.Destination = aws.String(v)
+	}
+
+	if v, ok := tfMap["destination_port"].(int); ok && v != 0 {
+		apiObject.DestinationPort = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["packet_size"].(int); ok && v != 0 {
+		apiObject.PacketSize = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap[names.AttrProtocol].(string); ok && v != "" {
+		apiObject.Protocol = awstypes.Protocol(v)
+	}
+
+	if v, ok := tfMap["source_arn"].(string); ok && v != "" {
+		apiObject.SourceArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenProbes(apiObjects []awstypes.Probe) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		// Probes that are being removed are no longer part of the monitor.
+		if state := apiObject.State; state == awstypes.ProbeStateDeleting || state == awstypes.ProbeStateDeleted {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"address_family":      string(apiObject.AddressFamily),
+			names.AttrARN:         aws.ToString(apiObject.ProbeArn),
+			names.AttrDestination: aws.ToString(apiObject.Destination),
+			"destination_port":    int(aws.ToInt32(apiObject.DestinationPort)),
+			"packet_size":         int(aws.ToInt32(apiObject.PacketSize)),
+			"probe_id":            aws.ToString(apiObject.ProbeId),
+			names.AttrProtocol:    string(apiObject.Protocol),
+			"source_arn":          aws.ToString(apiObject.SourceArn),
+			names.AttrState:       string(apiObject.State),
+			names.AttrVPCID:       aws.ToString(apiObject.VpcId),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
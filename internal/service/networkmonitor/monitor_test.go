@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkmonitor_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfnetworkmonitor "github.com/hashicorp/terraform-provider-aws/internal/service/networkmonitor"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNetworkMonitorMonitor_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkmonitor_monitor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkMonitorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMonitorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_period", "60"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "networkmonitor", regexache.MustCompile(`monitor/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "monitor_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "probe.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMonitorConfig_aggregationPeriod(rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_period", "30"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkMonitorMonitor_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkmonitor_monitor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkMonitorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitorExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfnetworkmonitor.ResourceMonitor(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkMonitorMonitor_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkmonitor_monitor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkMonitorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMonitorConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccMonitorConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkMonitorMonitor_probes(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkmonitor_monitor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkMonitorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitorConfig_probes2(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMonitorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "probe.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "probe.*", map[string]string{
+						"address_family":      "IPV4",
+						names.AttrDestination: "10.0.0.1",
+						"destination_port":    "8080",
+						"packet_size":         "256",
+						names.AttrProtocol:    "TCP",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "probe.*", map[string]string{
+						"address_family":      "IPV4",
+						names.AttrDestination: "10.0.0.2",
+						"destination_port":    "0",
+						"packet_size":         "56",
+						names.AttrProtocol:    "ICMP",
+					}),
+					resource.TestMatchTypeSetElemNestedAttrs(resourceName, "probe.*", map[string]*regexp.Regexp{
+						names.AttrARN: regexache.MustCompile(`probe/.+$`),
+						"probe_id":    regexache.MustCompile(`^probe-.+$`),
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "probe.*.source_arn", "aws_subnet.test.0", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "probe.*.vpc_id", "aws_vpc.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMonitorConfig_probes1(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMonitorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "probe.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "probe.*", map[string]string{
+						names.AttrDestination: "10.0.0.3",
+						"destination_port":    "443",
+						names.AttrProtocol:    "TCP",
+					}),
+				),
+			},
+			{
+				Config: testAccMonitorConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "probe.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkMonitorMonitor_probeValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkMonitorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMonitorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMonitorConfig_probe(rName, "TCP", "null"),
+				ExpectError: regexache.MustCompile(`must be specified when "probe\[\d+\]\.protocol" is "TCP"`),
+			},
+			{
+				Config:      testAccMonitorConfig_probe(rName, "ICMP", "80"),
+				ExpectError: regexache.MustCompile(`must not be specified when "probe\[\d+\]\.protocol" is "ICMP"`),
+			},
+		},
+	})
+}
+
+func testAccCheckMonitorDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkMonitorClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_networkmonitor_monitor" {
+				continue
+			}
+
+			_, err := tfnetworkmonitor.FindMonitorByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudWatch Network Monitor Monitor %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMonitorExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkMonitorClient(ctx)
+
+		_, err := tfnetworkmonitor.FindMonitorByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccMonitorConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmonitor_monitor" "test" {
+  monitor_name = %[1]q
+}
+`, rName)
+}
+
+func testAccMonitorConfig_aggregationPeriod(rName string, period int) string {
+	return fmt.Sprintf(`
+resource "aws_networkmonitor_monitor" "test" {
+  monitor_name       = %[1]q
+  aggregation_period = %[2]d
+}
+`, rName, period)
+}
+
+func testAccMonitorConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmonitor_monitor" "test" {
+  monitor_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccMonitorConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmonitor_monitor" "test" {
+  monitor_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccMonitorConfig_probes2(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_networkmonitor_monitor" "test" {
+  monitor_name = %[1]q
+
+  probe {
+    destination      = "10.0.0.1"
+    destination_port = 8080
+    packet_size      = 256
+    protocol         = "TCP"
+    source_arn       = aws_subnet.test[0].arn
+  }
+
+  probe {
+    destination = "10.0.0.2"
+    protocol    = "ICMP"
+    source_arn  = aws_subnet.test[0].arn
+  }
+}
+`, rName))
+}
+
+func testAccMonitorConfig_probes1(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_networkmonitor_monitor" "test" {
+  monitor_name = %[1]q
+
+  probe {
+    destination      = "10.0.0.3"
+    destination_port = 443
+    protocol         = "TCP"
+    source_arn       = aws_subnet.test[0].arn
+  }
+}
+`, rName))
+}
+
+func testAccMonitorConfig_probe(rName, protocol, port string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_networkmonitor_monitor" "test" {
+  monitor_name = %[1]q
+
+  probe {
+    destination      = "10.0.0.1"
+    destination_port = %[3]s
+    protocol         = %[2]q
+    source_arn       = aws_subnet.test[0].arn
+  }
+}
+`, rName, protocol, port))
+}
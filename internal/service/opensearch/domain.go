@@ -229,6 +229,76 @@ func ResourceDomain() *schema.Resource {
 					},
 				},
 			},
+			"change_progress": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"change_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"completed_properties": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"config_change_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"initiated_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_updated_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pending_properties": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"stage": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrDescription: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"last_updated": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrStatus: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						names.AttrStartTime: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total_number_of_stages": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"cluster_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -936,6 +1006,22 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interf
 		d.Set("off_peak_window_options", nil)
 	}
 
+	progress, err := findDomainChangeProgressByName(ctx, conn, d.Get(names.AttrDomainName).(string))
+
+	switch {
+	case tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeAccessDeniedException):
+		log.Printf("[WARN] reading OpenSearch Domain (%s) change progress: %s", d.Id(), err)
+		d.Set("change_progress", nil)
+	case tfresource.NotFound(err):
+		d.Set("change_progress", nil)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Domain (%s) change progress: %s", d.Id(), err)
+	default:
+		if err := d.Set("change_progress", flattenChangeProgressStatusDetails(progress)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting change_progress: %s", err)
+		}
+	}
+
 	return diags
 }
 
@@ -1150,6 +1236,32 @@ func FindDomainByName(ctx context.Context, conn *opensearchservice.OpenSearchSer
 	return output.DomainStatus, nil
 }
 
+// findDomainChangeProgressByName returns the progress of the domain's most recent configuration change.
+func findDomainChangeProgressByName(ctx context.Context, conn *opensearchservice.OpenSearchService, name string) (*opensearchservice.ChangeProgressStatusDetails, error) {
+	input := &opensearchservice.DescribeDomainChangeProgressInput{
+		DomainName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDomainChangeProgressWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ChangeProgressStatus == nil || output.ChangeProgressStatus.ChangeId == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ChangeProgressStatus, nil
+}
+
 // inPlaceEncryptionEnableVersion returns true if, based on version, encryption
 // can be enabled in place (without ForceNew)
 func inPlaceEncryptionEnableVersion(version string) bool {
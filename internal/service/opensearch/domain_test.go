@@ -133,6 +133,77 @@ func TestParseEngineVersion(t *testing.T) {
 	}
 }
 
+func TestDomainChangeProgressError(t *testing.T) {
+	t.Parallel()
+
+	stages := []*opensearchservice.ChangeProgressStage{
+		{Name: aws.String("Validation"), Status: aws.String("COMPLETED")},
+		{Name: aws.String("Creating a new environment"), Status: aws.String("IN_PROGRESS"), Description: aws.String("Creating new nodes")},
+		{Name: aws.String("Deleting older resources"), Status: aws.String("PENDING")},
+	}
+
+	testCases := []struct {
+		TestName      string
+		Progress      *opensearchservice.ChangeProgressStatusDetails
+		ExpectedError string
+	}{
+		{
+			TestName: "processing",
+			Progress: &opensearchservice.ChangeProgressStatusDetails{
+				ChangeId:             aws.String("change"),
+				ChangeProgressStages: stages,
+				ConfigChangeStatus:   aws.String(opensearchservice.ConfigChangeStatusApplyingChanges),
+				Status:               aws.String(opensearchservice.OverallChangeStatusProcessing),
+			},
+		},
+		{
+			TestName: "failed",
+			Progress: &opensearchservice.ChangeProgressStatusDetails{
+				ChangeId:             aws.String("change"),
+				ChangeProgressStages: stages,
+				ConfigChangeStatus:   aws.String(opensearchservice.ConfigChangeStatusApplyingChanges),
+				Status:               aws.String(opensearchservice.OverallChangeStatusFailed),
+			},
+			ExpectedError: "configuration change (change) failed: stage 2 of 3, Creating a new environment: IN_PROGRESS (Creating new nodes)",
+		},
+		{
+			TestName: "pending user input",
+			Progress: &opensearchservice.ChangeProgressStatusDetails{
+				ChangeId:             aws.String("change"),
+				ChangeProgressStages: stages,
+				ConfigChangeStatus:   aws.String(opensearchservice.ConfigChangeStatusPendingUserInput),
+				Status:               aws.String(opensearchservice.OverallChangeStatusProcessing),
+			},
+			ExpectedError: "configuration change (change) is PendingUserInput: stage 2 of 3, Creating a new environment: IN_PROGRESS (Creating new nodes)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfopensearch.DomainChangeProgressError(testCase.Progress)
+
+			if testCase.ExpectedError == "" {
+				if err != nil {
+					t.Fatalf("got unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got no error")
+			}
+
+			if got := err.Error(); got != testCase.ExpectedError {
+				t.Errorf("got error %q, expected %q", got, testCase.ExpectedError)
+			}
+		})
+	}
+}
+
 func TestAccOpenSearchDomain_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -5,6 +5,7 @@ package opensearch
 
 // Exports for use in tests only.
 var (
-	FindVPCEndpointByID = findVPCEndpointByID
-	VPCEndpointsError   = vpcEndpointsError
+	DomainChangeProgressError = domainChangeProgressError
+	FindVPCEndpointByID       = findVPCEndpointByID
+	VPCEndpointsError         = vpcEndpointsError
 )
@@ -4,6 +4,8 @@
 package opensearch
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -274,3 +276,51 @@ func flattenVPCDerivedInfo(apiObject *opensearchservice.VPCDerivedInfo) map[stri
 
 	return tfMap
 }
+
+func flattenChangeProgressStatusDetails(apiObject *opensearchservice.ChangeProgressStatusDetails) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"change_id":              aws.StringValue(apiObject.ChangeId),
+		"completed_properties":   aws.StringValueSlice(apiObject.CompletedProperties),
+		"config_change_status":   aws.StringValue(apiObject.ConfigChangeStatus),
+		"initiated_by":           aws.StringValue(apiObject.InitiatedBy),
+		"pending_properties":     aws.StringValueSlice(apiObject.PendingProperties),
+		names.AttrStatus:         aws.StringValue(apiObject.Status),
+		"total_number_of_stages": aws.Int64Value(apiObject.TotalNumberOfStages),
+	}
+
+	if v := apiObject.LastUpdatedTime; v != nil {
+		tfMap["last_updated_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.StartTime; v != nil {
+		tfMap[names.AttrStartTime] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	var stages []interface{}
+
+	for _, v := range apiObject.ChangeProgressStages {
+		if v == nil {
+			continue
+		}
+
+		stage := map[string]interface{}{
+			names.AttrDescription: aws.StringValue(v.Description),
+			names.AttrName:        aws.StringValue(v.Name),
+			names.AttrStatus:      aws.StringValue(v.Status),
+		}
+
+		if v := v.LastUpdated; v != nil {
+			stage["last_updated"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		stages = append(stages, stage)
+	}
+
+	tfMap["stage"] = stages
+
+	return []interface{}{tfMap}
+}
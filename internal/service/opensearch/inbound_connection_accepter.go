@@ -45,6 +45,10 @@ func ResourceInboundConnectionAccepter() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"connection_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"connection_status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -92,6 +96,7 @@ func resourceInboundConnectionRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	d.Set("connection_id", connection.ConnectionId)
+	d.Set("connection_mode", connection.ConnectionMode)
 	d.Set("connection_status", connection.ConnectionStatus.StatusCode)
 	return nil
 }
@@ -80,6 +80,7 @@ func ResourceOutboundConnection() *schema.Resource {
 			"connection_mode": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(opensearchservice.ConnectionMode_Values(), false),
 			},
@@ -98,9 +99,11 @@ func ResourceOutboundConnection() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"skip_unavailable": {
-										Type:     schema.TypeString,
-										Optional: true,
-										ForceNew: true,
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(opensearchservice.SkipUnavailableStatus_Values(), false),
 									},
 								},
 							},
@@ -128,12 +131,15 @@ func resourceOutboundConnectionCreate(ctx context.Context, d *schema.ResourceDat
 	connectionAlias := d.Get("connection_alias").(string)
 	input := &opensearchservice.CreateOutboundConnectionInput{
 		ConnectionAlias:      aws.String(connectionAlias),
-		ConnectionMode:       aws.String(d.Get("connection_mode").(string)),
 		ConnectionProperties: expandOutboundConnectionConnectionProperties(d.Get("connection_properties").([]interface{})),
 		LocalDomainInfo:      expandOutboundConnectionDomainInfo(d.Get("local_domain_info").([]interface{})),
 		RemoteDomainInfo:     expandOutboundConnectionDomainInfo(d.Get("remote_domain_info").([]interface{})),
 	}
 
+	if v, ok := d.GetOk("connection_mode"); ok {
+		input.ConnectionMode = aws.String(v.(string))
+	}
+
 	output, err := conn.CreateOutboundConnectionWithContext(ctx, input)
 
 	if err != nil {
@@ -396,11 +402,15 @@ func expandOutboundConnectionCrossClusterSearchConnectionProperties(cProperties
 		return nil
 	}
 
-	mOptions := cProperties[0].(map[string]interface{})
+	apiObject := &opensearchservice.CrossClusterSearchConnectionProperties{}
 
-	return &opensearchservice.CrossClusterSearchConnectionProperties{
-		SkipUnavailable: aws.String(mOptions["skip_unavailable"].(string)),
+	if mOptions, ok := cProperties[0].(map[string]interface{}); ok {
+		if v, ok := mOptions["skip_unavailable"].(string); ok && v != "" {
+			apiObject.SkipUnavailable = aws.String(v)
+		}
 	}
+
+	return apiObject
 }
 
 func flattenOutboundConnectionCrossClusterSearchConnectionProperties(cProperties *opensearchservice.CrossClusterSearchConnectionProperties) []interface{} {
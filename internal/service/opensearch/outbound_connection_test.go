@@ -33,6 +33,10 @@ func TestAccOpenSearchOutboundConnection_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDomainExists(ctx, "aws_opensearch_domain.domain_1", &domain),
 					testAccCheckDomainExists(ctx, "aws_opensearch_domain.domain_2", &domain),
+					resource.TestCheckResourceAttr(resourceName, "connection_mode", "DIRECT"),
+					resource.TestCheckResourceAttr(resourceName, "connection_properties.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "connection_properties.0.cross_cluster_search.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "connection_properties.0.cross_cluster_search.0.skip_unavailable", "ENABLED"),
 					resource.TestCheckResourceAttr(resourceName, "connection_status", "ACTIVE"),
 				),
 			},
@@ -46,6 +50,40 @@ func TestAccOpenSearchOutboundConnection_basic(t *testing.T) {
 	})
 }
 
+func TestAccOpenSearchOutboundConnection_skipUnavailable(t *testing.T) {
+	ctx := acctest.Context(t)
+	var domain opensearchservice.DomainStatus
+	ri := sdkacctest.RandString(10)
+	name := fmt.Sprintf("tf-test-%s", ri)
+	resourceName := "aws_opensearch_outbound_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutboundConnectionConfig_skipUnavailable(name, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, "aws_opensearch_domain.domain_1", &domain),
+					resource.TestCheckResourceAttr(resourceName, "connection_mode", "DIRECT"),
+					resource.TestCheckResourceAttr(resourceName, "connection_properties.0.cross_cluster_search.0.skip_unavailable", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "connection_status", "ACTIVE"),
+				),
+			},
+			{
+				Config: testAccOutboundConnectionConfig_skipUnavailable(name, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, "aws_opensearch_domain.domain_1", &domain),
+					resource.TestCheckResourceAttr(resourceName, "connection_properties.0.cross_cluster_search.0.skip_unavailable", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "connection_status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccOpenSearchOutboundConnection_vpc(t *testing.T) {
 	ctx := acctest.Context(t)
 	var domain opensearchservice.DomainStatus
@@ -105,6 +143,10 @@ func TestAccOpenSearchOutboundConnection_disappears(t *testing.T) {
 }
 
 func testAccOutboundConnectionConfig(name string) string {
+	return testAccOutboundConnectionConfig_skipUnavailable(name, "ENABLED")
+}
+
+func testAccOutboundConnectionConfig_skipUnavailable(name, skipUnavailable string) string {
 	// Satisfy the pw requirements
 	pw := fmt.Sprintf("Aa1-%s", sdkacctest.RandString(10))
 	return fmt.Sprintf(`
@@ -190,7 +232,7 @@ resource "aws_opensearch_outbound_connection" "test" {
 
   connection_properties {
     cross_cluster_search {
-      skip_unavailable = "ENABLED"
+      skip_unavailable = %q
     }
   }
 
@@ -206,7 +248,7 @@ resource "aws_opensearch_outbound_connection" "test" {
     domain_name = aws_opensearch_domain.domain_2.domain_name
   }
 }
-`, name, pw, name, pw, name)
+`, name, pw, name, pw, name, skipUnavailable)
 }
 
 func testAccOutboundConnectionConfig_vpcEndpoint(name string) string {
@@ -9,16 +9,21 @@ import (
 	"log"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -27,6 +32,7 @@ func ResourcePackageAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePackageAssociationCreate,
 		ReadWithoutTimeout:   resourcePackageAssociationRead,
+		UpdateWithoutTimeout: resourcePackageAssociationUpdate,
 		DeleteWithoutTimeout: resourcePackageAssociationDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -35,10 +41,43 @@ func ResourcePackageAssociation() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
+			"association_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_store_access_option": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key_access_role_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"key_store_access_enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			names.AttrDomainName: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -49,6 +88,17 @@ func ResourcePackageAssociation() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"package_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"prerequisite_package_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"reference_path": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -59,17 +109,20 @@ func ResourcePackageAssociation() *schema.Resource {
 
 func resourcePackageAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+	conn := meta.(*conns.AWSClient).OpenSearchClient(ctx)
 
 	domainName := d.Get(names.AttrDomainName).(string)
 	packageID := d.Get("package_id").(string)
 	id := fmt.Sprintf("%s-%s", domainName, packageID)
-	input := &opensearchservice.AssociatePackageInput{
-		DomainName: aws.String(domainName),
-		PackageID:  aws.String(packageID),
+	if v, ok := d.GetOk("package_version"); ok {
+		if err := checkPackageVersionAvailable(ctx, meta.(*conns.AWSClient).OpenSearchConn(ctx), packageID, v.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating OpenSearch Package Association (%s): %s", id, err)
+		}
 	}
 
-	_, err := conn.AssociatePackageWithContext(ctx, input)
+	input := expandAssociatePackageInput(d)
+
+	_, err := conn.AssociatePackage(ctx, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating OpenSearch Package Association (%s): %s", id, err)
@@ -86,7 +139,7 @@ func resourcePackageAssociationCreate(ctx context.Context, d *schema.ResourceDat
 
 func resourcePackageAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+	conn := meta.(*conns.AWSClient).OpenSearchClient(ctx)
 
 	domainName := d.Get(names.AttrDomainName).(string)
 	packageID := d.Get("package_id").(string)
@@ -102,26 +155,59 @@ func resourcePackageAssociationRead(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Package Association (%s): %s", d.Id(), err)
 	}
 
+	if err := d.Set("association_configuration", flattenPackageAssociationConfiguration(pkgAssociation.AssociationConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting association_configuration: %s", err)
+	}
 	d.Set(names.AttrDomainName, pkgAssociation.DomainName)
 	d.Set("package_id", pkgAssociation.PackageID)
+	d.Set("package_version", pkgAssociation.PackageVersion)
+	d.Set("prerequisite_package_ids", pkgAssociation.PrerequisitePackageIDList)
 	d.Set("reference_path", pkgAssociation.ReferencePath)
 
 	return diags
 }
 
+func resourcePackageAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchClient(ctx)
+
+	if d.HasChange("package_version") {
+		domainName := d.Get(names.AttrDomainName).(string)
+		packageID := d.Get("package_id").(string)
+		packageVersion := d.Get("package_version").(string)
+
+		if err := checkPackageVersionAvailable(ctx, meta.(*conns.AWSClient).OpenSearchConn(ctx), packageID, packageVersion); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Package Association (%s): %s", d.Id(), err)
+		}
+
+		// Associating a package again applies its latest version to the domain.
+		_, err := conn.AssociatePackage(ctx, expandAssociatePackageInput(d))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Package Association (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitPackageAssociationUpdated(ctx, conn, domainName, packageID, packageVersion, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Package Association (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePackageAssociationRead(ctx, d, meta)...)
+}
+
 func resourcePackageAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+	conn := meta.(*conns.AWSClient).OpenSearchClient(ctx)
 
 	log.Printf("[DEBUG] Deleting OpenSearch Package Association: %s", d.Id())
 	domainName := d.Get(names.AttrDomainName).(string)
 	packageID := d.Get("package_id").(string)
-	_, err := conn.DissociatePackageWithContext(ctx, &opensearchservice.DissociatePackageInput{
+	_, err := conn.DissociatePackage(ctx, &opensearch.DissociatePackageInput{
 		DomainName: aws.String(domainName),
 		PackageID:  aws.String(packageID),
 	})
 
-	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return diags
 	}
 
@@ -136,59 +222,72 @@ func resourcePackageAssociationDelete(ctx context.Context, d *schema.ResourceDat
 	return diags
 }
 
-func FindPackageAssociationByTwoPartKey(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, packageID string) (*opensearchservice.DomainPackageDetails, error) {
-	input := &opensearchservice.ListPackagesForDomainInput{
+// checkPackageVersionAvailable returns an error if the requested version isn't the package's
+// available version. AssociatePackage always associates the available version.
+func checkPackageVersionAvailable(ctx context.Context, conn *opensearchservice.OpenSearchService, packageID, packageVersion string) error {
+	pkg, err := FindPackageByID(ctx, conn, packageID)
+
+	if err != nil {
+		return fmt.Errorf("reading OpenSearch Package (%s): %w", packageID, err)
+	}
+
+	if available := aws.ToString(pkg.AvailablePackageVersion); available != packageVersion {
+		return fmt.Errorf("package version %q is not the available version (%q) of OpenSearch Package (%s)", packageVersion, available, packageID)
+	}
+
+	return nil
+}
+
+func FindPackageAssociationByTwoPartKey(ctx context.Context, conn *opensearch.Client, domainName, packageID string) (*awstypes.DomainPackageDetails, error) {
+	input := &opensearch.ListPackagesForDomainInput{
 		DomainName: aws.String(domainName),
 	}
-	filter := func(v *opensearchservice.DomainPackageDetails) bool {
-		return aws.StringValue(v.PackageID) == packageID
+	filter := func(v *awstypes.DomainPackageDetails) bool {
+		return aws.ToString(v.PackageID) == packageID
 	}
 
 	return findPackageAssociation(ctx, conn, input, filter)
 }
 
-func findPackageAssociation(ctx context.Context, conn *opensearchservice.OpenSearchService, input *opensearchservice.ListPackagesForDomainInput, filter tfslices.Predicate[*opensearchservice.DomainPackageDetails]) (*opensearchservice.DomainPackageDetails, error) {
+func findPackageAssociation(ctx context.Context, conn *opensearch.Client, input *opensearch.ListPackagesForDomainInput, filter tfslices.Predicate[*awstypes.DomainPackageDetails]) (*awstypes.DomainPackageDetails, error) {
 	output, err := findPackageAssociations(ctx, conn, input, filter)
 
 	if err != nil {
 		return nil, err
 	}
 
-	return tfresource.AssertSinglePtrResult(output)
+	return tfresource.AssertSingleValueResult(output)
 }
 
-func findPackageAssociations(ctx context.Context, conn *opensearchservice.OpenSearchService, input *opensearchservice.ListPackagesForDomainInput, filter tfslices.Predicate[*opensearchservice.DomainPackageDetails]) ([]*opensearchservice.DomainPackageDetails, error) {
-	var output []*opensearchservice.DomainPackageDetails
+func findPackageAssociations(ctx context.Context, conn *opensearch.Client, input *opensearch.ListPackagesForDomainInput, filter tfslices.Predicate[*awstypes.DomainPackageDetails]) ([]awstypes.DomainPackageDetails, error) {
+	var output []awstypes.DomainPackageDetails
 
-	err := conn.ListPackagesForDomainPagesWithContext(ctx, input, func(page *opensearchservice.ListPackagesForDomainOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
-		}
+	pages := opensearch.NewListPackagesForDomainPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
 
-		for _, v := range page.DomainPackageDetailsList {
-			if v != nil && filter(v) {
-				output = append(output, v)
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
 			}
 		}
 
-		return !lastPage
-	})
-
-	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
-		return nil, &retry.NotFoundError{
-			LastError:   err,
-			LastRequest: input,
+		if err != nil {
+			return nil, err
 		}
-	}
 
-	if err != nil {
-		return nil, err
+		for _, v := range page.DomainPackageDetailsList {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
 	}
 
 	return output, nil
 }
 
-func statusPackageAssociation(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, packageID string) retry.StateRefreshFunc {
+func statusPackageAssociation(ctx context.Context, conn *opensearch.Client, domainName, packageID string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindPackageAssociationByTwoPartKey(ctx, conn, domainName, packageID)
 
@@ -200,14 +299,14 @@ func statusPackageAssociation(ctx context.Context, conn *opensearchservice.OpenS
 			return nil, "", err
 		}
 
-		return output, aws.StringValue(output.DomainPackageStatus), nil
+		return output, string(output.DomainPackageStatus), nil
 	}
 }
 
-func waitPackageAssociationCreated(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, packageID string, timeout time.Duration) (*opensearchservice.DomainPackageDetails, error) {
+func waitPackageAssociationCreated(ctx context.Context, conn *opensearch.Client, domainName, packageID string, timeout time.Duration) (*awstypes.DomainPackageDetails, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{opensearchservice.DomainPackageStatusAssociating},
-		Target:  []string{opensearchservice.DomainPackageStatusActive},
+		Pending: enum.Slice(awstypes.DomainPackageStatusAssociating),
+		Target:  enum.Slice(awstypes.DomainPackageStatusActive),
 		Refresh: statusPackageAssociation(ctx, conn, domainName, packageID),
 		Timeout: timeout,
 		Delay:   30 * time.Second,
@@ -215,9 +314,9 @@ func waitPackageAssociationCreated(ctx context.Context, conn *opensearchservice.
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*opensearchservice.DomainPackageDetails); ok {
-		if status, details := aws.StringValue(output.DomainPackageStatus), output.ErrorDetails; status == opensearchservice.DomainPackageStatusAssociationFailed && details != nil {
-			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(details.ErrorType), aws.StringValue(details.ErrorMessage)))
+	if output, ok := outputRaw.(*awstypes.DomainPackageDetails); ok {
+		if status, details := output.DomainPackageStatus, output.ErrorDetails; status == awstypes.DomainPackageStatusAssociationFailed && details != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(details.ErrorType), aws.ToString(details.ErrorMessage)))
 		}
 
 		return output, err
@@ -226,9 +325,41 @@ func waitPackageAssociationCreated(ctx context.Context, conn *opensearchservice.
 	return nil, err
 }
 
-func waitPackageAssociationDeleted(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, packageID string, timeout time.Duration) (*opensearchservice.DomainPackageDetails, error) {
+func waitPackageAssociationUpdated(ctx context.Context, conn *opensearch.Client, domainName, packageID, packageVersion string, timeout time.Duration) (*awstypes.DomainPackageDetails, error) {
+	refresh := statusPackageAssociation(ctx, conn, domainName, packageID)
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{opensearchservice.DomainPackageStatusDissociating},
+		Pending: enum.Slice(awstypes.DomainPackageStatusAssociating),
+		Target:  enum.Slice(awstypes.DomainPackageStatusActive),
+		Refresh: func() (interface{}, string, error) {
+			output, status, err := refresh()
+
+			// The association stays ACTIVE on the previous version until the update starts.
+			if v, ok := output.(*awstypes.DomainPackageDetails); ok && status == string(awstypes.DomainPackageStatusActive) && aws.ToString(v.PackageVersion) != packageVersion {
+				return output, string(awstypes.DomainPackageStatusAssociating), nil
+			}
+
+			return output, status, err
+		},
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DomainPackageDetails); ok {
+		if status, details := output.DomainPackageStatus, output.ErrorDetails; status == awstypes.DomainPackageStatusAssociationFailed && details != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(details.ErrorType), aws.ToString(details.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPackageAssociationDeleted(ctx context.Context, conn *opensearch.Client, domainName, packageID string, timeout time.Duration) (*awstypes.DomainPackageDetails, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DomainPackageStatusDissociating),
 		Target:  []string{},
 		Refresh: statusPackageAssociation(ctx, conn, domainName, packageID),
 		Timeout: timeout,
@@ -237,9 +368,9 @@ func waitPackageAssociationDeleted(ctx context.Context, conn *opensearchservice.
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*opensearchservice.DomainPackageDetails); ok {
-		if status, details := aws.StringValue(output.DomainPackageStatus), output.ErrorDetails; status == opensearchservice.DomainPackageStatusDissociationFailed && details != nil {
-			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(details.ErrorType), aws.StringValue(details.ErrorMessage)))
+	if output, ok := outputRaw.(*awstypes.DomainPackageDetails); ok {
+		if status, details := output.DomainPackageStatus, output.ErrorDetails; status == awstypes.DomainPackageStatusDissociationFailed && details != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(details.ErrorType), aws.ToString(details.ErrorMessage)))
 		}
 
 		return output, err
@@ -247,3 +378,77 @@ func waitPackageAssociationDeleted(ctx context.Context, conn *opensearchservice.
 
 	return nil, err
 }
+
+func expandAssociatePackageInput(d *schema.ResourceData) *opensearch.AssociatePackageInput {
+	input := &opensearch.AssociatePackageInput{
+		DomainName: aws.String(d.Get(names.AttrDomainName).(string)),
+		PackageID:  aws.String(d.Get("package_id").(string)),
+	}
+
+	if v, ok := d.GetOk("association_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AssociationConfiguration = expandPackageAssociationConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("prerequisite_package_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.PrerequisitePackageIDList = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	return input
+}
+
+func expandPackageAssociationConfiguration(tfMap map[string]interface{}) *awstypes.PackageAssociationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.PackageAssociationConfiguration{}
+
+	if v, ok := tfMap["key_store_access_option"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KeyStoreAccessOption = expandKeyStoreAccessOption(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandKeyStoreAccessOption(tfMap map[string]interface{}) *awstypes.KeyStoreAccessOption {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.KeyStoreAccessOption{}
+
+	if v, ok := tfMap["key_access_role_arn"].(string); ok && v != "" {
+		apiObject.KeyAccessRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_store_access_enabled"].(bool); ok {
+		apiObject.KeyStoreAccessEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenPackageAssociationConfiguration(apiObject *awstypes.PackageAssociationConfiguration) []interface{} {
+	if apiObject == nil || apiObject.KeyStoreAccessOption == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"key_store_access_option": []interface{}{flattenKeyStoreAccessOption(apiObject.KeyStoreAccessOption)},
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenKeyStoreAccessOption(apiObject *awstypes.KeyStoreAccessOption) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"key_access_role_arn":      aws.ToString(apiObject.KeyAccessRoleArn),
+		"key_store_access_enabled": aws.ToBool(apiObject.KeyStoreAccessEnabled),
+	}
+
+	return tfMap
+}
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -37,6 +39,68 @@ func TestAccOpenSearchPackageAssociation_basic(t *testing.T) {
 					testAccCheckPackageAssociationExists(ctx, resourceName),
 					resource.TestCheckResourceAttrPair(resourceName, names.AttrDomainName, domainResourceName, names.AttrDomainName),
 					resource.TestCheckResourceAttrPair(resourceName, "package_id", packageResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "package_version", packageResourceName, "available_package_version"),
+					resource.TestCheckResourceAttr(resourceName, "prerequisite_package_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchPackageAssociation_packageVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainName := testAccRandomDomainName()
+	pkgName := testAccRandomDomainName()
+	resourceName := "aws_opensearch_package_association.test"
+	packageResourceName := "aws_opensearch_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageAssociationConfig_packageVersion(pkgName, domainName, "aws_opensearch_package.test.available_package_version"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "package_version", packageResourceName, "available_package_version"),
+				),
+			},
+			{
+				Config:      testAccPackageAssociationConfig_packageVersion(pkgName, domainName, `"v0"`),
+				ExpectError: regexache.MustCompile(`is not the available version`),
+			},
+		},
+	})
+}
+
+// Plugin packages with prerequisites and key store access can't be created from
+// a test fixture, so the test uses an existing domain and packages.
+func TestAccOpenSearchPackageAssociation_prerequisites(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainName := acctest.SkipIfEnvVarNotSet(t, "OPENSEARCH_DOMAIN_NAME")
+	packageID := acctest.SkipIfEnvVarNotSet(t, "OPENSEARCH_PLUGIN_PACKAGE_ID")
+	prerequisitePackageID := acctest.SkipIfEnvVarNotSet(t, "OPENSEARCH_PREREQUISITE_PACKAGE_ID")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opensearch_package_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageAssociationConfig_prerequisites(rName, domainName, packageID, prerequisitePackageID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "association_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "association_configuration.0.key_store_access_option.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(resourceName, "association_configuration.0.key_store_access_option.0.key_access_role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "association_configuration.0.key_store_access_option.0.key_store_access_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "prerequisite_package_ids.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttr(resourceName, "prerequisite_package_ids.*", prerequisitePackageID),
 				),
 			},
 		},
@@ -74,7 +138,7 @@ func testAccCheckPackageAssociationExists(ctx context.Context, n string) resourc
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn(ctx)
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchClient(ctx)
 
 		_, err := tfopensearch.FindPackageAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrDomainName], rs.Primary.Attributes["package_id"])
 
@@ -89,7 +153,7 @@ func testAccCheckPackageAssociationDestroy(ctx context.Context) resource.TestChe
 				continue
 			}
 
-			conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn(ctx)
+			conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchClient(ctx)
 
 			_, err := tfopensearch.FindPackageAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrDomainName], rs.Primary.Attributes["package_id"])
 
@@ -108,7 +172,7 @@ func testAccCheckPackageAssociationDestroy(ctx context.Context) resource.TestChe
 	}
 }
 
-func testAccPackageAssociationConfig_basic(pkgName, domainName string) string {
+func testAccPackageAssociationConfig_base(pkgName, domainName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
@@ -142,10 +206,63 @@ resource "aws_opensearch_domain" "test" {
     volume_size = 10
   }
 }
+`, pkgName, domainName)
+}
 
+func testAccPackageAssociationConfig_basic(pkgName, domainName string) string {
+	return acctest.ConfigCompose(testAccPackageAssociationConfig_base(pkgName, domainName), `
 resource "aws_opensearch_package_association" "test" {
   package_id  = aws_opensearch_package.test.id
   domain_name = aws_opensearch_domain.test.domain_name
 }
-`, pkgName, domainName)
+`)
+}
+
+func testAccPackageAssociationConfig_packageVersion(pkgName, domainName, packageVersion string) string {
+	return acctest.ConfigCompose(testAccPackageAssociationConfig_base(pkgName, domainName), fmt.Sprintf(`
+resource "aws_opensearch_package_association" "test" {
+  package_id      = aws_opensearch_package.test.id
+  domain_name     = aws_opensearch_domain.test.domain_name
+  package_version = %[1]s
+}
+`, packageVersion))
+}
+
+func testAccPackageAssociationConfig_prerequisites(rName, domainName, packageID, prerequisitePackageID string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "opensearchservice.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_opensearch_package_association" "prerequisite" {
+  package_id  = %[4]q
+  domain_name = %[2]q
+}
+
+resource "aws_opensearch_package_association" "test" {
+  package_id               = %[3]q
+  domain_name              = %[2]q
+  prerequisite_package_ids = [aws_opensearch_package_association.prerequisite.package_id]
+
+  association_configuration {
+    key_store_access_option {
+      key_access_role_arn      = aws_iam_role.test.arn
+      key_store_access_enabled = true
+    }
+  }
+}
+`, rName, domainName, packageID, prerequisitePackageID)
 }
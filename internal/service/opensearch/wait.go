@@ -6,6 +6,8 @@ package opensearch
 import (
 	"context"
 	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -87,6 +89,19 @@ func waitForDomainUpdate(ctx context.Context, conn *opensearchservice.OpenSearch
 			return nil
 		}
 
+		// Report the progress of the change, e.g. the stages of a blue/green deployment,
+		// and stop waiting if it can't complete without intervention.
+		if progress, err := findDomainChangeProgressByName(ctx, conn, domainName); err == nil {
+			if err := domainChangeProgressError(progress); err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			log.Printf("[DEBUG] OpenSearch Domain (%s) change progress: %s", domainName, domainChangeProgressSummary(progress))
+
+			return retry.RetryableError(
+				fmt.Errorf("%q: Timeout while waiting for changes to be processed (%s)", domainName, domainChangeProgressSummary(progress)))
+		}
+
 		return retry.RetryableError(
 			fmt.Errorf("%q: Timeout while waiting for changes to be processed", domainName))
 	}, tfresource.WithDelay(1*time.Minute), tfresource.WithPollInterval(10*time.Second))
@@ -159,3 +174,38 @@ func waitForDomainDelete(ctx context.Context, conn *opensearchservice.OpenSearch
 
 	return err
 }
+
+// domainChangeProgressError returns an error if a configuration change has failed or is blocked.
+func domainChangeProgressError(progress *opensearchservice.ChangeProgressStatusDetails) error {
+	switch status := aws.StringValue(progress.ConfigChangeStatus); status {
+	case opensearchservice.ConfigChangeStatusValidationFailed, opensearchservice.ConfigChangeStatusCancelled, opensearchservice.ConfigChangeStatusPendingUserInput:
+		return fmt.Errorf("configuration change (%s) is %s: %s", aws.StringValue(progress.ChangeId), status, domainChangeProgressSummary(progress))
+	}
+
+	if aws.StringValue(progress.Status) == opensearchservice.OverallChangeStatusFailed {
+		return fmt.Errorf("configuration change (%s) failed: %s", aws.StringValue(progress.ChangeId), domainChangeProgressSummary(progress))
+	}
+
+	return nil
+}
+
+// domainChangeProgressSummary describes the current stage of a configuration change.
+func domainChangeProgressSummary(progress *opensearchservice.ChangeProgressStatusDetails) string {
+	stages := progress.ChangeProgressStages
+
+	for i, stage := range stages {
+		if stage == nil || strings.EqualFold(aws.StringValue(stage.Status), opensearchservice.OverallChangeStatusCompleted) {
+			continue
+		}
+
+		summary := fmt.Sprintf("stage %d of %d, %s: %s", i+1, len(stages), aws.StringValue(stage.Name), aws.StringValue(stage.Status))
+
+		if v := aws.StringValue(stage.Description); v != "" {
+			summary += fmt.Sprintf(" (%s)", v)
+		}
+
+		return summary
+	}
+
+	return fmt.Sprintf("%s, %d of %d stages completed", aws.StringValue(progress.Status), len(stages), len(stages))
+}
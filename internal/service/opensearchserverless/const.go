@@ -4,3 +4,8 @@
 package opensearchserverless
 
 const idSeparator = "/"
+
+const (
+	// errCodeUnauthorizedOperation is returned by EC2 when the caller lacks an IAM permission.
+	errCodeUnauthorizedOperation = "UnauthorizedOperation"
+)
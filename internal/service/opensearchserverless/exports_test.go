@@ -7,6 +7,7 @@ package opensearchserverless
 var (
 	ResourceAccessPolicy    = newResourceAccessPolicy
 	ResourceCollection      = newResourceCollection
+	ResourceIndex           = newResourceIndex
 	ResourceLifecyclePolicy = newResourceLifecyclePolicy
 	ResourceSecurityConfig  = newResourceSecurityConfig
 	ResourceSecurityPolicy  = newResourceSecurityPolicy
@@ -14,6 +15,7 @@ var (
 
 	FindAccessPolicyByNameAndType    = findAccessPolicyByNameAndType
 	FindCollectionByID               = findCollectionByID
+	FindIndexByTwoPartKey            = findIndexByTwoPartKey
 	FindLifecyclePolicyByNameAndType = findLifecyclePolicyByNameAndType
 	FindSecurityConfigByID           = findSecurityConfigByID
 	FindSecurityPolicyByNameAndType  = findSecurityPolicyByNameAndType
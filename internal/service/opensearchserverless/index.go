@@ -0,0 +1,652 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearchserverless
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Index")
+func newResourceIndex(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceIndex{}, nil
+}
+
+const (
+	ResNameIndex = "Index"
+
+	// Data access policies can take a few minutes to apply to a new collection or principal.
+	indexPropagationTimeout = 5 * time.Minute
+)
+
+type resourceIndex struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceIndex) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_opensearchserverless_index"
+}
+
+func (r *resourceIndex) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"collection_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"mappings": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(indexMappingsRemovedReplaceIf,
+						"Removing the mappings of an index requires replacement",
+						"Removing the mappings of an index requires replacement",
+					),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9a-z][0-9a-z_.-]*$`), "must start with a lowercase letter or number and contain only lowercase letters, numbers, underscores, hyphens and periods"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"settings": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceIndex) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceIndexData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collectionID, name := plan.CollectionID.ValueString(), plan.Name.ValueString()
+	id := collectionID + idSeparator + name
+
+	client, err := newIndexClient(ctx, r.Meta(), collectionID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionCreating, ResNameIndex, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	body := map[string]json.RawMessage{}
+	if !plan.Mappings.IsNull() {
+		body["mappings"] = json.RawMessage(plan.Mappings.ValueString())
+	}
+	if !plan.Settings.IsNull() {
+		body["settings"] = json.RawMessage(plan.Settings.ValueString())
+	}
+
+	_, err = tfresource.RetryWhen(ctx, indexPropagationTimeout,
+		func() (interface{}, error) {
+			return client.do(ctx, http.MethodPut, name, body)
+		},
+		func(err error) (bool, error) {
+			if isIndexAPIErrorStatus(err, http.StatusForbidden) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionCreating, ResNameIndex, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceIndex) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceIndexData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findIndexByTwoPartKey(ctx, r.Meta(), state.CollectionID.ValueString(), state.Name.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionReading, ResNameIndex, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Mappings grow as documents are indexed with dynamic mapping and the returned
+	// settings include service-generated values, so only the configured parts are refreshed.
+	if !state.Mappings.IsNull() {
+		mappings, err := normalizeIndexMappings(state.Mappings.ValueString(), output.Mappings)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionReading, ResNameIndex, state.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+		state.Mappings = jsontypes.NewNormalizedValue(mappings)
+	}
+
+	if !state.Settings.IsNull() {
+		settings, err := normalizeIndexSettings(state.Settings.ValueString(), output.Settings)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionReading, ResNameIndex, state.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+		state.Settings = jsontypes.NewNormalizedValue(settings)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceIndex) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourceIndexData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Removing the mappings requires replacement.
+	if !plan.Mappings.Equal(state.Mappings) && !plan.Mappings.IsNull() {
+		client, err := newIndexClient(ctx, r.Meta(), plan.CollectionID.ValueString())
+
+		if err == nil {
+			// Mappings can only be extended. Existing fields can't be changed or removed.
+			_, err = client.do(ctx, http.MethodPut, plan.Name.ValueString()+"/_mapping", json.RawMessage(plan.Mappings.ValueString()))
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionUpdating, ResNameIndex, plan.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceIndex) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state resourceIndexData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := newIndexClient(ctx, r.Meta(), state.CollectionID.ValueString())
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err == nil {
+		_, err = client.do(ctx, http.MethodDelete, state.Name.ValueString(), nil)
+	}
+
+	if isIndexAPIErrorStatus(err, http.StatusNotFound) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionDeleting, ResNameIndex, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceIndex) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, idSeparator)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		err := fmt.Errorf("unexpected format for ID (%[1]s), expected collection-id%[2]sindex-name", req.ID, idSeparator)
+		resp.Diagnostics.AddError(fmt.Sprintf("importing %s (%s)", ResNameIndex, req.ID), err.Error())
+		return
+	}
+
+	output, err := findIndexByTwoPartKey(ctx, r.Meta(), parts[0], parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("importing %s (%s)", ResNameIndex, req.ID), err.Error())
+		return
+	}
+
+	mappings, err := json.Marshal(output.Mappings)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("importing %s (%s)", ResNameIndex, req.ID), err.Error())
+		return
+	}
+
+	settings, err := json.Marshal(importedIndexSettings(output.Settings))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("importing %s (%s)", ResNameIndex, req.ID), err.Error())
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("collection_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mappings"), jsontypes.NewNormalizedValue(string(mappings)))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrName), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("settings"), jsontypes.NewNormalizedValue(string(settings)))...)
+}
+
+// indexMappingsRemovedReplaceIf requires replacement when mappings are removed from the configuration.
+// The fields of an index's mappings can't be removed.
+func indexMappingsRemovedReplaceIf(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = req.ConfigValue.IsNull() && !req.StateValue.IsNull()
+}
+
+func findIndexByTwoPartKey(ctx context.Context, meta *conns.AWSClient, collectionID, name string) (*indexOutput, error) {
+	client, err := newIndexClient(ctx, meta, collectionID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return client.findIndex(ctx, name)
+}
+
+// newIndexClient returns a client for the OpenSearch API of the specified collection.
+func newIndexClient(ctx context.Context, meta *conns.AWSClient, collectionID string) (*indexClient, error) {
+	collection, err := findCollectionByID(ctx, meta.OpenSearchServerlessClient(ctx), collectionID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := aws.ToString(collection.CollectionEndpoint)
+	if endpoint == "" {
+		return nil, fmt.Errorf("OpenSearch Serverless Collection (%s) has no endpoint", collectionID)
+	}
+
+	return &indexClient{
+		credentials: meta.CredentialsProvider(ctx),
+		endpoint:    endpoint,
+		httpClient:  meta.HTTPClient(ctx),
+		region:      meta.Region,
+	}, nil
+}
+
+// indexClient sends SigV4-signed requests to a collection's OpenSearch API.
+type indexClient struct {
+	credentials aws.CredentialsProvider
+	endpoint    string
+	httpClient  *http.Client
+	region      string
+}
+
+type indexAPIError struct {
+	method     string
+	path       string
+	statusCode int
+	body       string
+}
+
+func (e *indexAPIError) Error() string {
+	return fmt.Sprintf("%s %s: %d %s: %s", e.method, e.path, e.statusCode, http.StatusText(e.statusCode), e.body)
+}
+
+func isIndexAPIErrorStatus(err error, statusCode int) bool {
+	var apiErr *indexAPIError
+
+	return errors.As(err, &apiErr) && apiErr.statusCode == statusCode
+}
+
+// indexOutput holds an index's mappings and settings as returned by the OpenSearch API.
+type indexOutput struct {
+	Mappings map[string]any
+	Settings map[string]any
+}
+
+func (c *indexClient) findIndex(ctx context.Context, name string) (*indexOutput, error) {
+	mappings, err := c.getIndexObject(ctx, name, "_mapping", "mappings")
+
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := c.getIndexObject(ctx, name, "_settings", "settings")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &indexOutput{
+		Mappings: mappings,
+		Settings: settings,
+	}, nil
+}
+
+// getIndexObject calls GET /{name}/{api} and returns the response's key object for the index.
+func (c *indexClient) getIndexObject(ctx context.Context, name, api, key string) (map[string]any, error) {
+	output, err := c.do(ctx, http.MethodGet, name+"/"+api, nil)
+
+	if isIndexAPIErrorStatus(err, http.StatusNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var v map[string]map[string]map[string]any
+	if err := json.Unmarshal(output, &v); err != nil {
+		return nil, fmt.Errorf("reading %s %s response: %w", name, api, err)
+	}
+
+	index, ok := v[name]
+	if !ok {
+		return nil, tfresource.NewEmptyResultError(name)
+	}
+
+	if index[key] == nil {
+		return map[string]any{}, nil
+	}
+
+	return index[key], nil
+}
+
+func (c *indexClient) do(ctx context.Context, method, path string, body any) ([]byte, error) {
+	var payload []byte
+
+	if body != nil {
+		var err error
+
+		if payload, err = json.Marshal(body); err != nil {
+			return nil, err
+		}
+	}
+
+	u, err := url.JoinPath(c.endpoint, path)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(payload)
+	payloadHash := hex.EncodeToString(hash[:])
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	credentials, err := c.credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving AWS credentials: %w", err)
+	}
+
+	if err := v4.NewSigner().SignHTTP(ctx, credentials, req, payloadHash, "aoss", c.region, time.Now()); err != nil {
+		return nil, fmt.Errorf("signing request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	output, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, &indexAPIError{
+			method:     method,
+			path:       path,
+			statusCode: resp.StatusCode,
+			body:       string(output),
+		}
+	}
+
+	return output, nil
+}
+
+// normalizeIndexMappings returns the parts of the index's mappings that are present in the prior mappings.
+// Fields added by dynamic mapping are ignored. A configured field missing from the index, or mapped
+// differently, shows as a difference.
+func normalizeIndexMappings(prior string, mappings map[string]any) (string, error) {
+	var v any
+	if err := json.Unmarshal([]byte(prior), &v); err != nil {
+		return "", err
+	}
+
+	output, err := json.Marshal(indexJSONSubset(v, mappings))
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
+
+// indexJSONSubset returns the parts of actual whose keys are present in prior.
+// Scalars equal to the prior value when formatted as strings keep the prior value, as the OpenSearch API
+// returns some values, e.g. all setting values, as strings.
+func indexJSONSubset(prior, actual any) any {
+	if p, ok := prior.(map[string]any); ok {
+		a, ok := actual.(map[string]any)
+		if !ok {
+			return actual
+		}
+
+		subset := make(map[string]any)
+		for k, pv := range p {
+			if av, ok := a[k]; ok {
+				subset[k] = indexJSONSubset(pv, av)
+			}
+		}
+
+		return subset
+	}
+
+	if _, ok := actual.(string); ok && prior != nil && fmt.Sprint(prior) == actual {
+		return prior
+	}
+
+	return actual
+}
+
+// normalizeIndexSettings returns the index's values for the settings in the prior settings.
+// Settings may be configured nested or with dotted names, and with or without the "index." prefix.
+// If every configured setting has its configured value the prior settings are returned unchanged,
+// otherwise the index's values of the configured settings are returned using dotted names.
+func normalizeIndexSettings(prior string, settings map[string]any) (string, error) {
+	var v map[string]any
+	if err := json.Unmarshal([]byte(prior), &v); err != nil {
+		return "", err
+	}
+
+	configured, actual := flattenIndexSettings(v), flattenIndexSettings(settings)
+	current := make(map[string]any)
+	drift := false
+
+	for k, pv := range configured {
+		name := k
+		if !strings.HasPrefix(name, "index.") {
+			name = "index." + name
+		}
+
+		av, ok := actual[name]
+		if !ok {
+			drift = true
+			continue
+		}
+
+		current[name] = av
+		if fmt.Sprint(pv) != fmt.Sprint(av) {
+			drift = true
+		}
+	}
+
+	if !drift {
+		return prior, nil
+	}
+
+	output, err := json.Marshal(current)
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
+
+// flattenIndexSettings returns the settings keyed by their dotted names.
+func flattenIndexSettings(settings map[string]any) map[string]any {
+	flattened := make(map[string]any)
+
+	var flatten func(string, map[string]any)
+	flatten = func(prefix string, m map[string]any) {
+		for k, v := range m {
+			if v, ok := v.(map[string]any); ok {
+				flatten(prefix+k+".", v)
+				continue
+			}
+
+			flattened[prefix+k] = v
+		}
+	}
+	flatten("", settings)
+
+	return flattened
+}
+
+// indexGeneratedSettings are the settings that the service sets on every index.
+var indexGeneratedSettings = []string{
+	"creation_date",
+	"number_of_replicas",
+	"number_of_shards",
+	"provided_name",
+	"uuid",
+	"version",
+}
+
+// importedIndexSettings returns the index's settings without the service-generated ones.
+// Boolean and numeric values, which the OpenSearch API returns as strings, are converted.
+func importedIndexSettings(settings map[string]any) map[string]any {
+	imported := make(map[string]any)
+
+	for k, v := range settings {
+		if v, ok := v.(map[string]any); ok && k == "index" {
+			index := make(map[string]any)
+
+			for k, v := range v {
+				if !slices.Contains(indexGeneratedSettings, k) {
+					index[k] = convertIndexSettingValue(v)
+				}
+			}
+
+			if len(index) > 0 {
+				imported[k] = index
+			}
+
+			continue
+		}
+
+		imported[k] = convertIndexSettingValue(v)
+	}
+
+	return imported
+}
+
+func convertIndexSettingValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		converted := make(map[string]any, len(v))
+		for k, v := range v {
+			converted[k] = convertIndexSettingValue(v)
+		}
+
+		return converted
+	case string:
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return n
+		}
+	}
+
+	return v
+}
+
+type resourceIndexData struct {
+	CollectionID types.String         `tfsdk:"collection_id"`
+	ID           types.String         `tfsdk:"id"`
+	Mappings     jsontypes.Normalized `tfsdk:"mappings"`
+	Name         types.String         `tfsdk:"name"`
+	Settings     jsontypes.Normalized `tfsdk:"settings"`
+}
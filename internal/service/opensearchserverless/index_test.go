@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearchserverless_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfopensearchserverless "github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccOpenSearchServerlessIndex_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opensearchserverless_index.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
+			testAccPreCheckCollection(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIndexDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexConfig_basic(rName, `{ title = { type = "text" } }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIndexExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "collection_id", "aws_opensearchserverless_collection.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, "books"),
+					resource.TestCheckResourceAttr(resourceName, "mappings", `{"properties":{"title":{"type":"text"}}}`),
+					resource.TestCheckResourceAttr(resourceName, "settings", `{"index":{"knn":true}}`),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings"},
+			},
+			{
+				Config: testAccIndexConfig_basic(rName, `{ title = { type = "text" }, year = { type = "integer" } }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIndexExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "mappings", `{"properties":{"title":{"type":"text"},"year":{"type":"integer"}}}`),
+				),
+			},
+			{
+				Config: testAccIndexConfig_noMappings(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIndexExists(ctx, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "mappings"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchServerlessIndex_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opensearchserverless_index.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
+			testAccPreCheckCollection(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIndexDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexConfig_basic(rName, `{ title = { type = "text" } }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIndexExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfopensearchserverless.ResourceIndex, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIndexDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		meta := acctest.Provider.Meta().(*conns.AWSClient)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_opensearchserverless_index" {
+				continue
+			}
+
+			_, err := tfopensearchserverless.FindIndexByTwoPartKey(ctx, meta, rs.Primary.Attributes["collection_id"], rs.Primary.Attributes[names.AttrName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.OpenSearchServerless, create.ErrActionCheckingDestroyed, tfopensearchserverless.ResNameIndex, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIndexExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.OpenSearchServerless, create.ErrActionCheckingExistence, tfopensearchserverless.ResNameIndex, name, errors.New("not found"))
+		}
+
+		meta := acctest.Provider.Meta().(*conns.AWSClient)
+
+		_, err := tfopensearchserverless.FindIndexByTwoPartKey(ctx, meta, rs.Primary.Attributes["collection_id"], rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return create.Error(names.OpenSearchServerless, create.ErrActionCheckingExistence, tfopensearchserverless.ResNameIndex, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccIndexConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		testAccCollectionBaseConfig(rName),
+		fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_opensearchserverless_security_policy" "network" {
+  name = "%[1]s-net"
+  type = "network"
+  policy = jsonencode([
+    {
+      Rules = [
+        {
+          ResourceType = "collection"
+          Resource     = ["collection/%[1]s"]
+        }
+      ]
+      AllowFromPublic = true
+    }
+  ])
+}
+
+resource "aws_opensearchserverless_access_policy" "test" {
+  name = %[1]q
+  type = "data"
+  policy = jsonencode([
+    {
+      Rules = [
+        {
+          ResourceType = "index"
+          Resource     = ["index/%[1]s/*"]
+          Permission   = ["aoss:*"]
+        }
+      ]
+      Principal = [data.aws_iam_session_context.current.issuer_arn]
+    }
+  ])
+}
+
+resource "aws_opensearchserverless_collection" "test" {
+  name = %[1]q
+  type = "VECTORSEARCH"
+
+  depends_on = [aws_opensearchserverless_security_policy.test, aws_opensearchserverless_security_policy.network]
+}
+`, rName))
+}
+
+func testAccIndexConfig_basic(rName, properties string) string {
+	return acctest.ConfigCompose(
+		testAccIndexConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_opensearchserverless_index" "test" {
+  collection_id = aws_opensearchserverless_collection.test.id
+  name          = "books"
+
+  mappings = jsonencode({
+    properties = %[1]s
+  })
+
+  settings = jsonencode({
+    index = {
+      knn = true
+    }
+  })
+
+  depends_on = [aws_opensearchserverless_access_policy.test]
+}
+`, properties))
+}
+
+func testAccIndexConfig_noMappings(rName string) string {
+	return acctest.ConfigCompose(
+		testAccIndexConfig_base(rName),
+		`
+resource "aws_opensearchserverless_index" "test" {
+  collection_id = aws_opensearchserverless_collection.test.id
+  name          = "books"
+
+  settings = jsonencode({
+    index = {
+      knn = true
+    }
+  })
+
+  depends_on = [aws_opensearchserverless_access_policy.test]
+}
+`)
+}
@@ -47,6 +47,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory: newResourceIndex,
+			Name:    "Index",
+		},
 		{
 			Factory: newResourceLifecyclePolicy,
 			Name:    "Lifecycle Policy",
@@ -11,8 +11,11 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -21,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -30,6 +34,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -45,12 +50,13 @@ func newResourceVPCEndpoint(_ context.Context) (resource.ResourceWithConfigure,
 }
 
 type resourceVpcEndpointData struct {
-	ID               types.String   `tfsdk:"id"`
-	Name             types.String   `tfsdk:"name"`
-	SecurityGroupIds types.Set      `tfsdk:"security_group_ids"`
-	SubnetIds        types.Set      `tfsdk:"subnet_ids"`
-	Timeouts         timeouts.Value `tfsdk:"timeouts"`
-	VpcId            types.String   `tfsdk:"vpc_id"`
+	ID                  types.String   `tfsdk:"id"`
+	Name                types.String   `tfsdk:"name"`
+	NetworkInterfaceIds types.Set      `tfsdk:"network_interface_ids"`
+	SecurityGroupIds    types.Set      `tfsdk:"security_group_ids"`
+	SubnetIds           types.Set      `tfsdk:"subnet_ids"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+	VpcId               types.String   `tfsdk:"vpc_id"`
 }
 
 const (
@@ -75,6 +81,16 @@ func (r *resourceVpcEndpoint) Schema(ctx context.Context, req resource.SchemaReq
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(3, 32),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"network_interface_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
 			},
 			names.AttrSecurityGroupIDs: schema.SetAttribute{
 				ElementType: types.StringType,
@@ -99,6 +115,9 @@ func (r *resourceVpcEndpoint) Schema(ctx context.Context, req resource.SchemaReq
 				Validators: []validator.String{
 					stringvalidator.LengthBetween(1, 255),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 		},
 		Blocks: map[string]schema.Block{
@@ -165,6 +184,16 @@ func (r *resourceVpcEndpoint) Create(ctx context.Context, req resource.CreateReq
 
 	state := plan
 	state.refreshFromOutput(ctx, vpcEndpoint)
+
+	state.NetworkInterfaceIds, err = readNetworkInterfaceIDs(ctx, r.Meta().EC2Client(ctx), vpcEndpoint, plan.NetworkInterfaceIds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionChecking, ResNameVPCEndpoint, plan.Name.String(), nil),
+			err.Error(),
+		)
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -184,7 +213,25 @@ func (r *resourceVpcEndpoint) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionReading, ResNameVPCEndpoint, state.ID.String(), nil),
+			err.Error(),
+		)
+		return
+	}
+
 	state.refreshFromOutput(ctx, out)
+
+	state.NetworkInterfaceIds, err = readNetworkInterfaceIDs(ctx, r.Meta().EC2Client(ctx), out, state.NetworkInterfaceIds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionReading, ResNameVPCEndpoint, state.ID.String(), nil),
+			err.Error(),
+		)
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -236,6 +283,9 @@ func (r *resourceVpcEndpoint) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	if !update {
+		// Only the timeouts changed, keep the computed attributes from state.
+		plan.NetworkInterfaceIds = state.NetworkInterfaceIds
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 		return
 	}
 
@@ -268,6 +318,18 @@ func (r *resourceVpcEndpoint) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	plan.refreshFromOutput(ctx, vpcEndpoint)
+
+	// Adding or removing subnets creates or deletes the endpoint's network
+	// interfaces.
+	plan.NetworkInterfaceIds, err = readNetworkInterfaceIDs(ctx, r.Meta().EC2Client(ctx), vpcEndpoint, plan.NetworkInterfaceIds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionChecking, ResNameVPCEndpoint, plan.Name.String(), nil),
+			err.Error(),
+		)
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -306,6 +368,25 @@ func (r *resourceVpcEndpoint) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+func (r *resourceVpcEndpoint) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state resourceVpcEndpointData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Adding or removing subnets creates or deletes the endpoint's network
+	// interfaces, so the prior network_interface_ids can't be kept.
+	if !plan.SubnetIds.Equal(state.SubnetIds) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("network_interface_ids"), types.SetUnknown(types.StringType))...)
+	}
+}
+
 func (r *resourceVpcEndpoint) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
 }
@@ -323,6 +404,54 @@ func (rd *resourceVpcEndpointData) refreshFromOutput(ctx context.Context, out *a
 	rd.VpcId = flex.StringToFramework(ctx, out.VpcId)
 }
 
+// readNetworkInterfaceIDs returns the IDs of the VPC endpoint's network interfaces.
+// Listing them needs the ec2:DescribeNetworkInterfaces permission. Without it the
+// prior value is kept, or the attribute is left null for a new endpoint.
+func readNetworkInterfaceIDs(ctx context.Context, conn *ec2.Client, vpcEndpoint *awstypes.VpcEndpointDetail, prior types.Set) (types.Set, error) {
+	networkInterfaceIDs, err := findNetworkInterfaceIDsByVPCEndpoint(ctx, conn, aws.ToString(vpcEndpoint.VpcId), aws.ToString(vpcEndpoint.Id))
+
+	if tfawserr.ErrCodeEquals(err, errCodeUnauthorizedOperation) {
+		log.Printf("[WARN] Unable to read network interfaces of OpenSearchServerless VPC Endpoint (%s): %s", aws.ToString(vpcEndpoint.Id), err)
+
+		if prior.IsUnknown() {
+			return types.SetNull(types.StringType), nil
+		}
+
+		return prior, nil
+	}
+
+	if err != nil {
+		return types.SetNull(types.StringType), err
+	}
+
+	return flex.FlattenFrameworkStringValueSet(ctx, networkInterfaceIDs), nil
+}
+
+// findNetworkInterfaceIDsByVPCEndpoint returns the IDs of the network interfaces
+// that the OpenSearch Serverless VPC endpoint created in the VPC's subnets.
+func findNetworkInterfaceIDsByVPCEndpoint(ctx context.Context, conn *ec2.Client, vpcID, vpcEndpointID string) ([]string, error) {
+	input := &ec2.DescribeNetworkInterfacesInput{
+		Filters: []ec2types.Filter{
+			tfec2.NewFilterV2("description", []string{"VPC Endpoint Interface " + vpcEndpointID}),
+			tfec2.NewFilterV2("vpc-id", []string{vpcID}),
+		},
+	}
+
+	networkInterfaces, err := tfec2.FindNetworkInterfacesV2(ctx, conn, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading ENIs for VPC Endpoint (%s): %w", vpcEndpointID, err)
+	}
+
+	var output []string
+
+	for _, v := range networkInterfaces {
+		output = append(output, aws.ToString(v.NetworkInterfaceId))
+	}
+
+	return output, nil
+}
+
 func waitVPCEndpointCreated(ctx context.Context, conn *opensearchserverless.Client, id string, timeout time.Duration) (*awstypes.VpcEndpointDetail, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.VpcEndpointStatusPending),
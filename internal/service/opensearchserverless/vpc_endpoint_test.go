@@ -14,7 +14,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -46,6 +49,7 @@ func TestAccOpenSearchServerlessVPCEndpoint_basic(t *testing.T) {
 				Config: testAccVPCEndpointConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVPCEndpointExists(ctx, resourceName, &vpcendpoint),
+					resource.TestCheckResourceAttr(resourceName, "network_interface_ids.#", acctest.CtOne),
 					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", acctest.CtOne),
 					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", acctest.CtOne),
 				),
@@ -87,6 +91,11 @@ func TestAccOpenSearchServerlessVPCEndpoint_securityGroups(t *testing.T) {
 			},
 			{
 				Config: testAccVPCEndpointConfig_multiple_securityGroups(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("network_interface_ids"), knownvalue.SetSizeExact(1)),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVPCEndpointExists(ctx, resourceName, &vpcendpoint2),
 					testAccCheckVPCEndpointNotRecreated(&vpcendpoint1, &vpcendpoint2),
@@ -134,9 +143,15 @@ func TestAccOpenSearchServerlessVPCEndpoint_update(t *testing.T) {
 			},
 			{
 				Config: testAccVPCEndpointConfig_multiple_subnets(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("network_interface_ids")),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVPCEndpointExists(ctx, resourceName, &vpcendpoint2),
 					testAccCheckVPCEndpointNotRecreated(&vpcendpoint1, &vpcendpoint2),
+					resource.TestCheckResourceAttr(resourceName, "network_interface_ids.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", acctest.CtOne),
 				),
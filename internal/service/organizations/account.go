@@ -6,14 +6,18 @@ package organizations
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -43,9 +47,10 @@ func ResourceAccount() *schema.Resource {
 				Computed: true,
 			},
 			"close_on_deletion": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Default:          false,
+				ValidateDiagFunc: validateCloseOnDeletion,
 			},
 			"create_govcloud": {
 				Type:     schema.TypeBool,
@@ -105,7 +110,10 @@ func ResourceAccount() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffAccountEmailDomain,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -225,6 +233,48 @@ func resourceAccountUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	return append(diags, resourceAccountRead(ctx, d, meta)...)
 }
 
+func validateCloseOnDeletion(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.(bool) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "AWS Organizations Account will be closed on deletion",
+			Detail:        "With close_on_deletion enabled, destroying or replacing this resource permanently closes the AWS account instead of removing it from the organization.",
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+// customizeDiffAccountEmailDomain validates the account's root email address against the provider's organizations_allowed_email_domains.
+func customizeDiffAccountEmailDomain(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange(names.AttrEmail) {
+		return nil
+	}
+
+	allowed := meta.(*conns.AWSClient).OrganizationsAllowedEmailDomains(ctx)
+	if len(allowed) == 0 {
+		return nil
+	}
+
+	email := diff.Get(names.AttrEmail).(string)
+	if email == "" {
+		// Unknown at plan time.
+		return nil
+	}
+
+	_, domain, _ := strings.Cut(email, "@")
+	for _, v := range allowed {
+		if strings.EqualFold(domain, v) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%q domain %q is not one of the provider's organizations_allowed_email_domains: %s", names.AttrEmail, domain, strings.Join(allowed, ", "))
+}
+
 func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OrganizationsConn(ctx)
@@ -9,6 +9,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/service/organizations"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -112,6 +113,26 @@ func testAccAccount_CloseOnDeletion(t *testing.T) {
 	})
 }
 
+func testAccAccount_EmailDomainNotAllowed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rInt := sdkacctest.RandInt()
+	name := fmt.Sprintf("tf_acctest_%d", rInt)
+	email := fmt.Sprintf("tf-acctest+%d@example.com", rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationsEnabled(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAccountConfig_allowedEmailDomains(name, email, "example.org"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`"email" domain "example.com" is not one of the provider's organizations_allowed_email_domains`),
+			},
+		},
+	})
+}
+
 func testAccAccount_ParentID(t *testing.T) {
 	ctx := acctest.Context(t)
 	key := "TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN"
@@ -305,6 +326,19 @@ resource "aws_organizations_account" "test" {
 `, name, email)
 }
 
+func testAccAccountConfig_allowedEmailDomains(name, email, domain string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  organizations_allowed_email_domains = [%[3]q]
+}
+
+resource "aws_organizations_account" "test" {
+  name  = %[1]q
+  email = %[2]q
+}
+`, name, email, domain)
+}
+
 func testAccAccountConfig_parentId1(name, email string) string {
 	return fmt.Sprintf(`
 data "aws_organizations_organization" "test" {}
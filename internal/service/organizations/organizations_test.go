@@ -38,11 +38,12 @@ func TestAccOrganizations_serial(t *testing.T) {
 			"DataSource_delegatedAdministrator": testAccOrganizationDataSource_delegatedAdministrator,
 		},
 		"Account": {
-			"basic":           testAccAccount_basic,
-			"CloseOnDeletion": testAccAccount_CloseOnDeletion,
-			"ParentId":        testAccAccount_ParentID,
-			"Tags":            testAccAccount_Tags,
-			"GovCloud":        testAccAccount_govCloud,
+			"basic":                 testAccAccount_basic,
+			"CloseOnDeletion":       testAccAccount_CloseOnDeletion,
+			"EmailDomainNotAllowed": testAccAccount_EmailDomainNotAllowed,
+			"ParentId":              testAccAccount_ParentID,
+			"Tags":                  testAccAccount_Tags,
+			"GovCloud":              testAccAccount_govCloud,
 		},
 		"OrganizationalUnit": {
 			"basic":                              testAccOrganizationalUnit_basic,
@@ -0,0 +1,362 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package outposts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	capacityTaskResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_outposts_capacity_task", name="Capacity Task")
+func ResourceCapacityTask() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCapacityTaskCreate,
+		ReadWithoutTimeout:   resourceCapacityTaskRead,
+		DeleteWithoutTimeout: resourceCapacityTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"capacity_task_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity_task_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"completion_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dry_run": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"failure": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"instance_pool": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						names.AttrInstanceType: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"order_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"outpost_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceCapacityTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OutpostsConn(ctx)
+
+	outpostID := d.Get("outpost_identifier").(string)
+	input := &outposts.StartCapacityTaskInput{
+		DryRun:            aws.Bool(d.Get("dry_run").(bool)),
+		InstancePools:     expandInstanceTypeCapacities(d.Get("instance_pool").([]interface{})),
+		OrderId:           aws.String(d.Get("order_id").(string)),
+		OutpostIdentifier: aws.String(outpostID),
+	}
+
+	output, err := conn.StartCapacityTaskWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Outposts Capacity Task (%s): %s", outpostID, err)
+	}
+
+	d.SetId(errs.Must(flex.FlattenResourceId([]string{outpostID, aws.StringValue(output.CapacityTaskId)}, capacityTaskResourceIDPartCount, false)))
+
+	if _, err := waitCapacityTaskCompleted(ctx, conn, outpostID, aws.StringValue(output.CapacityTaskId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Outposts Capacity Task (%s) complete: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCapacityTaskRead(ctx, d, meta)...)
+}
+
+func resourceCapacityTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OutpostsConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), capacityTaskResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	outpostID, taskID := parts[0], parts[1]
+	output, err := findCapacityTaskByTwoPartKey(ctx, conn, outpostID, taskID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Outposts Capacity Task (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Outposts Capacity Task (%s): %s", d.Id(), err)
+	}
+
+	d.Set("capacity_task_id", output.CapacityTaskId)
+	d.Set("capacity_task_status", output.CapacityTaskStatus)
+	if output.CompletionDate != nil {
+		d.Set("completion_date", aws.TimeValue(output.CompletionDate).Format(time.RFC3339))
+	} else {
+		d.Set("completion_date", nil)
+	}
+	if output.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(output.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set("creation_date", nil)
+	}
+	d.Set("dry_run", output.DryRun)
+	if err := d.Set("failure", flattenCapacityTaskFailure(output.Failed)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting failure: %s", err)
+	}
+	if err := d.Set("instance_pool", flattenInstanceTypeCapacities(output.RequestedInstancePools)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting instance_pool: %s", err)
+	}
+	d.Set("order_id", output.OrderId)
+	d.Set("outpost_identifier", outpostID)
+
+	return diags
+}
+
+func resourceCapacityTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OutpostsConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), capacityTaskResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	outpostID, taskID := parts[0], parts[1]
+
+	// Only tasks that haven't finished can be cancelled. Finished tasks are removed from state.
+	if status := d.Get("capacity_task_status").(string); status != outposts.CapacityTaskStatusRequested && status != outposts.CapacityTaskStatusInProgress {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Cancelling Outposts Capacity Task: %s", d.Id())
+	_, err = conn.CancelCapacityTaskWithContext(ctx, &outposts.CancelCapacityTaskInput{
+		CapacityTaskId:    aws.String(taskID),
+		OutpostIdentifier: aws.String(outpostID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, outposts.ErrCodeNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling Outposts Capacity Task (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitCapacityTaskCancelled(ctx, conn, outpostID, taskID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Outposts Capacity Task (%s) cancel: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findCapacityTaskByTwoPartKey(ctx context.Context, conn *outposts.Outposts, outpostID, taskID string) (*outposts.GetCapacityTaskOutput, error) {
+	input := &outposts.GetCapacityTaskInput{
+		CapacityTaskId:    aws.String(taskID),
+		OutpostIdentifier: aws.String(outpostID),
+	}
+
+	output, err := conn.GetCapacityTaskWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, outposts.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusCapacityTask(ctx context.Context, conn *outposts.Outposts, outpostID, taskID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findCapacityTaskByTwoPartKey(ctx, conn, outpostID, taskID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.CapacityTaskStatus), nil
+	}
+}
+
+func waitCapacityTaskCompleted(ctx context.Context, conn *outposts.Outposts, outpostID, taskID string, timeout time.Duration) (*outposts.GetCapacityTaskOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{outposts.CapacityTaskStatusRequested, outposts.CapacityTaskStatusInProgress},
+		Target:  []string{outposts.CapacityTaskStatusCompleted},
+		Refresh: statusCapacityTask(ctx, conn, outpostID, taskID),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*outposts.GetCapacityTaskOutput); ok {
+		if v := output.Failed; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(v.Type), aws.StringValue(v.Reason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCapacityTaskCancelled(ctx context.Context, conn *outposts.Outposts, outpostID, taskID string, timeout time.Duration) (*outposts.GetCapacityTaskOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{outposts.CapacityTaskStatusRequested, outposts.CapacityTaskStatusInProgress},
+		Target:  []string{outposts.CapacityTaskStatusCancelled},
+		Refresh: statusCapacityTask(ctx, conn, outpostID, taskID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*outposts.GetCapacityTaskOutput); ok {
+		if v := output.Failed; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Reason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandInstanceTypeCapacities(tfList []interface{}) []*outposts.InstanceTypeCapacity {
+	var apiObjects []*outposts.InstanceTypeCapacity
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &outposts.InstanceTypeCapacity{
+			Count:        aws.Int64(int64(tfMap["count"].(int))),
+			InstanceType: aws.String(tfMap[names.AttrInstanceType].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenInstanceTypeCapacities(apiObjects []*outposts.InstanceTypeCapacity) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"count":                aws.Int64Value(apiObject.Count),
+			names.AttrInstanceType: aws.StringValue(apiObject.InstanceType),
+		})
+	}
+
+	return tfList
+}
+
+func flattenCapacityTaskFailure(apiObject *outposts.CapacityTaskFailure) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"reason":       aws.StringValue(apiObject.Reason),
+		names.AttrType: aws.StringValue(apiObject.Type),
+	}}
+}
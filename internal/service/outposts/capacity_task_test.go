@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package outposts_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfoutposts "github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	orderIDKey            = "TF_AWS_OUTPOSTS_ORDER_ID"
+	envVarOrderIDKeyError = "ID of an Outposts order for the first Outpost in the current account."
+)
+
+func TestAccOutpostsCapacityTask_dryRun(t *testing.T) {
+	ctx := acctest.Context(t)
+	orderID := envvar.SkipIfEmpty(t, orderIDKey, envVarOrderIDKeyError)
+	resourceName := "aws_outposts_capacity_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOutpostsOutposts(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OutpostsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityTaskConfig_dryRun(orderID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCapacityTaskExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "capacity_task_id"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_task_status", "COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "dry_run", "true"),
+					resource.TestCheckResourceAttr(resourceName, "instance_pool.#", acctest.CtOne),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_pool.0.instance_type"),
+					resource.TestCheckResourceAttr(resourceName, "order_id", orderID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCapacityTaskExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OutpostsConn(ctx)
+
+		_, err = tfoutposts.FindCapacityTaskByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		return err
+	}
+}
+
+func testAccCapacityTaskConfig_dryRun(orderID string) string {
+	return fmt.Sprintf(`
+data "aws_outposts_outposts" "test" {}
+
+data "aws_outposts_outpost_instance_types" "test" {
+  arn = tolist(data.aws_outposts_outposts.test.arns)[0]
+}
+
+resource "aws_outposts_capacity_task" "test" {
+  outpost_identifier = tolist(data.aws_outposts_outposts.test.ids)[0]
+  order_id           = %[1]q
+  dry_run            = true
+
+  instance_pool {
+    instance_type = tolist(data.aws_outposts_outpost_instance_types.test.instance_types)[0]
+    count         = 1
+  }
+}
+`, orderID)
+}
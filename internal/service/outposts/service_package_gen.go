@@ -61,7 +61,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceCapacityTask,
+			TypeName: "aws_outposts_capacity_task",
+			Name:     "Capacity Task",
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package polly_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/polly"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfpolly "github.com/hashicorp/terraform-provider-aws/internal/service/polly"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPollyLexicon_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	resourceName := "aws_polly_lexicon.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PollyEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PollyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLexiconDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLexiconConfig_basic(rName, "W3C", "World Wide Web Consortium"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alphabet", "ipa"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "polly", fmt.Sprintf("lexicon/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "content_sha256"),
+					resource.TestCheckResourceAttr(resourceName, "language_code", "en-US"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified"),
+					resource.TestCheckResourceAttr(resourceName, "lexemes_count", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrContent},
+			},
+		},
+	})
+}
+
+func TestAccPollyLexicon_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	resourceName := "aws_polly_lexicon.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PollyEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PollyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLexiconDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLexiconConfig_basic(rName, "W3C", "World Wide Web Consortium"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpolly.ResourceLexicon, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPollyLexicon_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	resourceName := "aws_polly_lexicon.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PollyEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PollyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLexiconDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLexiconConfig_basic(rName, "W3C", "World Wide Web Consortium"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lexemes_count", acctest.CtOne),
+				),
+			},
+			{
+				Config: testAccLexiconConfig_basic(rName, "AWS", "Amazon Web Services"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lexemes_count", acctest.CtOne),
+				),
+			},
+		},
+	})
+}
+
+// Content changed outside of Terraform must be detected and reverted.
+func TestAccPollyLexicon_contentDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	resourceName := "aws_polly_lexicon.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PollyEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PollyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLexiconDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLexiconConfig_basic(rName, "W3C", "World Wide Web Consortium"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName),
+					testAccCheckLexiconPutContent(ctx, rName, testAccLexiconContent("AWS", "Amazon Web Services")),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccLexiconConfig_basic(rName, "W3C", "World Wide Web Consortium"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLexiconDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PollyClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_polly_lexicon" {
+				continue
+			}
+
+			_, err := tfpolly.FindLexiconByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.Polly, create.ErrActionCheckingDestroyed, tfpolly.ResNameLexicon, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.Polly, create.ErrActionCheckingDestroyed, tfpolly.ResNameLexicon, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLexiconExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Polly, create.ErrActionCheckingExistence, tfpolly.ResNameLexicon, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Polly, create.ErrActionCheckingExistence, tfpolly.ResNameLexicon, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PollyClient(ctx)
+
+		_, err := tfpolly.FindLexiconByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.Polly, create.ErrActionCheckingExistence, tfpolly.ResNameLexicon, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLexiconPutContent(ctx context.Context, name, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PollyClient(ctx)
+
+		_, err := conn.PutLexicon(ctx, &polly.PutLexiconInput{
+			Content: aws.String(content),
+			Name:    aws.String(name),
+		})
+
+		return err
+	}
+}
+
+func testAccLexiconContent(grapheme, alias string) string {
+	return fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<lexicon version="1.0"
+      xmlns="http://www.w3.org/2005/01/pronunciation-lexicon"
+      xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
+      xsi:schemaLocation="http://www.w3.org/2005/01/pronunciation-lexicon
+        http://www.w3.org/TR/2007/CR-pronunciation-lexicon-20071212/pls.xsd"
+      alphabet="ipa"
+      xml:lang="en-US">
+  <lexeme>
+    <grapheme>%[1]s</grapheme>
+    <alias>%[2]s</alias>
+  </lexeme>
+</lexicon>
+`, grapheme, alias)
+}
+
+func testAccLexiconConfig_basic(rName, grapheme, alias string) string {
+	return fmt.Sprintf(`
+resource "aws_polly_lexicon" "test" {
+  name    = %[1]q
+  content = %[2]q
+}
+`, rName, testAccLexiconContent(grapheme, alias))
+}
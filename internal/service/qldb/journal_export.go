@@ -0,0 +1,359 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qldb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qldb"
+	"github.com/aws/aws-sdk-go-v2/service/qldb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_qldb_journal_export", name="Journal Export")
+func resourceJournalExport() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJournalExportCreate,
+		ReadWithoutTimeout:   resourceJournalExportRead,
+		DeleteWithoutTimeout: resourceJournalExportDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"exclusive_end_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"export_creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inclusive_start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"ledger_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"output_format": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          types.OutputFormatIonText,
+				ValidateDiagFunc: enum.Validate[types.OutputFormat](),
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"s3_export_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrBucket: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 255),
+						},
+						names.AttrEncryptionConfiguration: {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"kms_key_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"object_encryption_type": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.S3ObjectEncryptionType](),
+									},
+								},
+							},
+						},
+						names.AttrPrefix: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceJournalExportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QLDBClient(ctx)
+
+	ledgerName := d.Get("ledger_name").(string)
+	input := &qldb.ExportJournalToS3Input{
+		Name:         aws.String(ledgerName),
+		OutputFormat: types.OutputFormat(d.Get("output_format").(string)),
+		RoleArn:      aws.String(d.Get(names.AttrRoleARN).(string)),
+	}
+
+	if v, ok := d.GetOk("exclusive_end_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.ExclusiveEndTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("inclusive_start_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.InclusiveStartTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("s3_export_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.S3ExportConfiguration = expandS3ExportConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.ExportJournalToS3(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating QLDB Journal Export (%s): %s", ledgerName, err)
+	}
+
+	d.SetId(aws.ToString(output.ExportId))
+
+	if _, err := waitJournalExportCompleted(ctx, conn, ledgerName, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for QLDB Journal Export (%s) complete: %s", d.Id(), err)
+	}
+
+	return resourceJournalExportRead(ctx, d, meta)
+}
+
+func resourceJournalExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QLDBClient(ctx)
+
+	export, err := findJournalExportByTwoPartKey(ctx, conn, d.Get("ledger_name").(string), d.Id())
+
+	// Export descriptions expire after 7 days but the exported objects remain in S3,
+	// so keep the last known state rather than exporting the journal again.
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QLDB Journal Export %s not found, keeping last known state", d.Id())
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading QLDB Journal Export (%s): %s", d.Id(), err)
+	}
+
+	if err := setJournalExportAttributes(d, export); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceJournalExportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Journal exports can't be deleted. The exported objects remain in the S3 bucket.
+	log.Printf("[WARN] QLDB Journal Export (%s) can't be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func setJournalExportAttributes(d *schema.ResourceData, export *types.JournalS3ExportDescription) error {
+	d.Set("exclusive_end_time", aws.ToTime(export.ExclusiveEndTime).Format(time.RFC3339))
+	d.Set("export_creation_time", aws.ToTime(export.ExportCreationTime).Format(time.RFC3339))
+	d.Set("export_id", export.ExportId)
+	d.Set("inclusive_start_time", aws.ToTime(export.InclusiveStartTime).Format(time.RFC3339))
+	d.Set("ledger_name", export.LedgerName)
+	d.Set("output_format", export.OutputFormat)
+	d.Set(names.AttrRoleARN, export.RoleArn)
+	if export.S3ExportConfiguration != nil {
+		if err := d.Set("s3_export_configuration", []interface{}{flattenS3ExportConfiguration(export.S3ExportConfiguration)}); err != nil {
+			return fmt.Errorf("setting s3_export_configuration: %w", err)
+		}
+	} else {
+		d.Set("s3_export_configuration", nil)
+	}
+	d.Set(names.AttrStatus, export.Status)
+
+	return nil
+}
+
+func findJournalExportByTwoPartKey(ctx context.Context, conn *qldb.Client, ledgerName, exportID string) (*types.JournalS3ExportDescription, error) {
+	input := &qldb.DescribeJournalS3ExportInput{
+		ExportId: aws.String(exportID),
+		Name:     aws.String(ledgerName),
+	}
+
+	output, err := conn.DescribeJournalS3Export(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ExportDescription == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ExportDescription, nil
+}
+
+func statusJournalExport(ctx context.Context, conn *qldb.Client, ledgerName, exportID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findJournalExportByTwoPartKey(ctx, conn, ledgerName, exportID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitJournalExportCompleted(ctx context.Context, conn *qldb.Client, ledgerName, exportID string, timeout time.Duration) (*types.JournalS3ExportDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.ExportStatusInProgress),
+		Target:     enum.Slice(types.ExportStatusCompleted),
+		Refresh:    statusJournalExport(ctx, conn, ledgerName, exportID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.JournalS3ExportDescription); ok {
+		if output.Status == types.ExportStatusCancelled {
+			tfresource.SetLastError(err, errors.New("journal export was canceled"))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandS3ExportConfiguration(tfMap map[string]interface{}) *types.S3ExportConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3ExportConfiguration{}
+
+	if v, ok := tfMap[names.AttrBucket].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrEncryptionConfiguration].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EncryptionConfiguration = expandS3EncryptionConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap[names.AttrPrefix].(string); ok {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3EncryptionConfiguration(tfMap map[string]interface{}) *types.S3EncryptionConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.S3EncryptionConfiguration{}
+
+	if v, ok := tfMap["kms_key_arn"].(string); ok && v != "" {
+		apiObject.KmsKeyArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_encryption_type"].(string); ok && v != "" {
+		apiObject.ObjectEncryptionType = types.S3ObjectEncryptionType(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3ExportConfiguration(apiObject *types.S3ExportConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Bucket; v != nil {
+		tfMap[names.AttrBucket] = aws.ToString(v)
+	}
+
+	if v := apiObject.EncryptionConfiguration; v != nil {
+		tfMap[names.AttrEncryptionConfiguration] = []interface{}{flattenS3EncryptionConfiguration(v)}
+	}
+
+	if v := apiObject.Prefix; v != nil {
+		tfMap[names.AttrPrefix] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenS3EncryptionConfiguration(apiObject *types.S3EncryptionConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"object_encryption_type": apiObject.ObjectEncryptionType,
+	}
+
+	if v := apiObject.KmsKeyArn; v != nil {
+		tfMap["kms_key_arn"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qldb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_qldb_journal_export", name="Journal Export")
+func dataSourceJournalExport() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceJournalExportRead,
+
+		Schema: map[string]*schema.Schema{
+			"exclusive_end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"inclusive_start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ledger_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"output_format": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrRoleARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_export_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrBucket: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrEncryptionConfiguration: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"kms_key_arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"object_encryption_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						names.AttrPrefix: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceJournalExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QLDBClient(ctx)
+
+	exportID := d.Get("export_id").(string)
+	export, err := findJournalExportByTwoPartKey(ctx, conn, d.Get("ledger_name").(string), exportID)
+
+	if err != nil {
+		return diag.Errorf("reading QLDB Journal Export (%s): %s", exportID, err)
+	}
+
+	d.SetId(aws.ToString(export.ExportId))
+	if err := setJournalExportAttributes(d, export); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
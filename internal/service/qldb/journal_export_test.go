@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qldb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/qldb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfqldb "github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQLDBJournalExport_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.JournalS3ExportDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qldb_journal_export.test"
+	dataSourceName := "data.aws_qldb_journal_export.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.QLDBEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QLDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			// The exported range must end after the ledger is created.
+			{
+				Config: testAccJournalExportConfig_base(rName),
+			},
+			{
+				Config: testAccJournalExportConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJournalExportExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "exclusive_end_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "export_creation_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "export_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "ledger_name", "aws_qldb_ledger.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "output_format", string(types.OutputFormatJson)),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "s3_export_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_export_configuration.0.bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "s3_export_configuration.0.encryption_configuration.0.object_encryption_type", string(types.S3ObjectEncryptionTypeSseS3)),
+					resource.TestCheckResourceAttr(resourceName, "s3_export_configuration.0.prefix", "exports/"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.ExportStatusCompleted)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "exclusive_end_time", resourceName, "exclusive_end_time"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "export_id", resourceName, "export_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "inclusive_start_time", resourceName, "inclusive_start_time"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "output_format", resourceName, "output_format"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "s3_export_configuration.0.bucket", resourceName, "s3_export_configuration.0.bucket"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrStatus, resourceName, names.AttrStatus),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJournalExportExists(ctx context.Context, n string, v *types.JournalS3ExportDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No QLDB Journal Export ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QLDBClient(ctx)
+
+		output, err := tfqldb.FindJournalExportByTwoPartKey(ctx, conn, rs.Primary.Attributes["ledger_name"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccJournalExportConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_qldb_ledger" "test" {
+  name                = %[1]q
+  permissions_mode    = "STANDARD"
+  deletion_protection = false
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "qldb.amazonaws.com"
+      }
+    }]
+  })
+
+  inline_policy {
+    name = "test-qldb-policy"
+    policy = jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Action = [
+          "s3:PutObject",
+          "s3:PutObjectAcl",
+        ]
+        Effect   = "Allow"
+        Resource = "${aws_s3_bucket.test.arn}/*"
+      }]
+    })
+  }
+}
+`, rName)
+}
+
+func testAccJournalExportConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccJournalExportConfig_base(rName), `
+resource "aws_qldb_journal_export" "test" {
+  ledger_name          = aws_qldb_ledger.test.name
+  inclusive_start_time = "2021-01-01T00:00:00Z"
+  exclusive_end_time   = plantimestamp()
+  output_format        = "JSON"
+  role_arn             = aws_iam_role.test.arn
+
+  s3_export_configuration {
+    bucket = aws_s3_bucket.test.bucket
+    prefix = "exports/"
+
+    encryption_configuration {
+      object_encryption_type = "SSE_S3"
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [exclusive_end_time]
+  }
+}
+
+data "aws_qldb_journal_export" "test" {
+  ledger_name = aws_qldb_journal_export.test.ledger_name
+  export_id   = aws_qldb_journal_export.test.export_id
+}
+`)
+}
@@ -24,6 +24,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceJournalExport,
+			TypeName: "aws_qldb_journal_export",
+			Name:     "Journal Export",
+		},
 		{
 			Factory:  dataSourceLedger,
 			TypeName: "aws_qldb_ledger",
@@ -33,6 +38,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceJournalExport,
+			TypeName: "aws_qldb_journal_export",
+			Name:     "Journal Export",
+		},
 		{
 			Factory:  resourceLedger,
 			TypeName: "aws_qldb_ledger",
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -76,7 +77,8 @@ func ResourceAnalysis() *schema.Resource {
 					Required: true,
 					ForceNew: true,
 				},
-				"definition": quicksightschema.AnalysisDefinitionSchema(),
+				"definition":      quicksightschema.AnalysisDefinitionSchema(),
+				"definition_json": quicksightschema.AnalysisDefinitionJSONSchema(),
 				"last_updated_time": {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -136,7 +138,10 @@ func ResourceAnalysis() *schema.Resource {
 			}
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			quicksightschema.DefinitionJSONCustomizeDiff,
+		),
 	}
 }
 
@@ -170,6 +175,15 @@ func resourceAnalysisCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Definition = quicksightschema.ExpandAnalysisDefinition(d.Get("definition").([]interface{}))
 	}
 
+	if quicksightschema.DefinitionJSONConfigured(d) {
+		definition, err := quicksightschema.ExpandAnalysisDefinitionJSON(d.Get("definition_json").(string))
+		if err != nil {
+			return create.DiagError(names.QuickSight, create.ErrActionCreating, ResNameAnalysis, d.Get(names.AttrName).(string), err)
+		}
+
+		input.Definition = definition
+	}
+
 	if v, ok := d.GetOk(names.AttrParameters); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.Parameters = quicksightschema.ExpandParameters(d.Get(names.AttrParameters).([]interface{}))
 	}
@@ -238,6 +252,13 @@ func resourceAnalysisRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("setting definition: %s", err)
 	}
 
+	definitionJSON, err := quicksightschema.FlattenDefinitionJSON(descResp.Definition)
+	if err != nil {
+		return diag.Errorf("flattening QuickSight Analysis (%s) definition JSON: %s", d.Id(), err)
+	}
+
+	d.Set("definition_json", definitionJSON)
+
 	permsResp, err := conn.DescribeAnalysisPermissionsWithContext(ctx, &quicksight.DescribeAnalysisPermissionsInput{
 		AwsAccountId: aws.String(awsAccountId),
 		AnalysisId:   aws.String(analysisId),
@@ -272,6 +293,13 @@ func resourceAnalysisUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		_, createdFromEntity := d.GetOk("source_entity")
 		if createdFromEntity {
 			in.SourceEntity = quicksightschema.ExpandAnalysisSourceEntity(d.Get("source_entity").([]interface{}))
+		} else if quicksightschema.DefinitionJSONConfigured(d) {
+			definition, err := quicksightschema.ExpandAnalysisDefinitionJSON(d.Get("definition_json").(string))
+			if err != nil {
+				return create.DiagError(names.QuickSight, create.ErrActionUpdating, ResNameAnalysis, d.Id(), err)
+			}
+
+			in.Definition = definition
 		} else {
 			in.Definition = quicksightschema.ExpandAnalysisDefinition(d.Get("definition").([]interface{}))
 		}
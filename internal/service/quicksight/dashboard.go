@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -70,6 +71,7 @@ func ResourceDashboard() *schema.Resource {
 				},
 				"dashboard_publish_options": quicksightschema.DashboardPublishOptionsSchema(),
 				"definition":                quicksightschema.DashboardDefinitionSchema(),
+				"definition_json":           quicksightschema.DashboardDefinitionJSONSchema(),
 				"last_updated_time": {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -133,7 +135,10 @@ func ResourceDashboard() *schema.Resource {
 			}
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			quicksightschema.DefinitionJSONCustomizeDiff,
+		),
 	}
 }
 
@@ -172,6 +177,15 @@ func resourceDashboardCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.Definition = quicksightschema.ExpandDashboardDefinition(d.Get("definition").([]interface{}))
 	}
 
+	if quicksightschema.DefinitionJSONConfigured(d) {
+		definition, err := quicksightschema.ExpandDashboardDefinitionJSON(d.Get("definition_json").(string))
+		if err != nil {
+			return create.DiagError(names.QuickSight, create.ErrActionCreating, ResNameDashboard, d.Get(names.AttrName).(string), err)
+		}
+
+		input.Definition = definition
+	}
+
 	if v, ok := d.GetOk("dashboard_publish_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.DashboardPublishOptions = quicksightschema.ExpandDashboardPublishOptions(d.Get("dashboard_publish_options").([]interface{}))
 	}
@@ -241,6 +255,13 @@ func resourceDashboardRead(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.Errorf("setting definition: %s", err)
 	}
 
+	definitionJSON, err := quicksightschema.FlattenDefinitionJSON(descResp.Definition)
+	if err != nil {
+		return diag.Errorf("flattening QuickSight Dashboard (%s) definition JSON: %s", d.Id(), err)
+	}
+
+	d.Set("definition_json", definitionJSON)
+
 	if err := d.Set("dashboard_publish_options", quicksightschema.FlattenDashboardPublishOptions(descResp.DashboardPublishOptions)); err != nil {
 		return diag.Errorf("setting dashboard_publish_options: %s", err)
 	}
@@ -280,6 +301,13 @@ func resourceDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		_, createdFromEntity := d.GetOk("source_entity")
 		if createdFromEntity {
 			in.SourceEntity = quicksightschema.ExpandDashboardSourceEntity(d.Get("source_entity").([]interface{}))
+		} else if quicksightschema.DefinitionJSONConfigured(d) {
+			definition, err := quicksightschema.ExpandDashboardDefinitionJSON(d.Get("definition_json").(string))
+			if err != nil {
+				return create.DiagError(names.QuickSight, create.ErrActionUpdating, ResNameDashboard, d.Id(), err)
+			}
+
+			in.Definition = definition
 		} else {
 			in.Definition = quicksightschema.ExpandDashboardDefinition(d.Get("definition").([]interface{}))
 		}
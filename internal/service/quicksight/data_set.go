@@ -172,6 +172,7 @@ func ResourceDataSet() *schema.Resource {
 					Computed: true,
 					MaxItems: 64,
 					Elem:     logicalTableMapSchema(),
+					Set:      logicalTableMapHash,
 				},
 				names.AttrName: {
 					Type:         schema.TypeString,
@@ -225,6 +226,7 @@ func ResourceDataSet() *schema.Resource {
 					Optional: true,
 					MaxItems: 32,
 					Elem:     physicalTableMapSchema(),
+					Set:      physicalTableMapHash,
 				},
 				"row_level_permission_data_set": {
 					Type:     schema.TypeList,
@@ -963,7 +965,7 @@ func resourceDataSetRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.Errorf("setting field_folders: %s", err)
 	}
 
-	if err := d.Set("logical_table_map", flattenLogicalTableMap(dataSet.LogicalTableMap, logicalTableMapSchema())); err != nil {
+	if err := d.Set("logical_table_map", flattenLogicalTableMap(dataSet.LogicalTableMap)); err != nil {
 		return diag.Errorf("setting logical_table_map: %s", err)
 	}
 
@@ -971,7 +973,7 @@ func resourceDataSetRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.Errorf("setting output_columns: %s", err)
 	}
 
-	if err := d.Set("physical_table_map", flattenPhysicalTableMap(dataSet.PhysicalTableMap, physicalTableMapSchema())); err != nil {
+	if err := d.Set("physical_table_map", flattenPhysicalTableMap(dataSet.PhysicalTableMap)); err != nil {
 		return diag.Errorf("setting physical_table_map: %s", err)
 	}
 
@@ -2127,7 +2129,7 @@ func fieldFoldersHash(v interface{}) int {
 	return create.StringHashcode(buf.String())
 }
 
-func flattenLogicalTableMap(apiObject map[string]*quicksight.LogicalTable, resourceSchema *schema.Resource) *schema.Set {
+func flattenLogicalTableMap(apiObject map[string]*quicksight.LogicalTable) *schema.Set {
 	if len(apiObject) == 0 {
 		return nil
 	}
@@ -2153,7 +2155,14 @@ func flattenLogicalTableMap(apiObject map[string]*quicksight.LogicalTable, resou
 		tfList = append(tfList, tfMap)
 	}
 
-	return schema.NewSet(schema.HashResource(resourceSchema), tfList)
+	return schema.NewSet(logicalTableMapHash, tfList)
+}
+
+// logicalTableMapHash hashes a logical table on its map key only. The API models
+// logical tables as a map, so keying the set on the map ID keeps each table at a
+// stable address and avoids hashing the full nested table on every diff.
+func logicalTableMapHash(v interface{}) int {
+	return create.StringHashcode(v.(map[string]interface{})["logical_table_map_id"].(string))
 }
 
 func flattenDataTransforms(apiObject []*quicksight.TransformOperation) []interface{} {
@@ -2427,7 +2436,7 @@ func flattenJoinKeyProperties(apiObject *quicksight.JoinKeyProperties) map[strin
 	return tfMap
 }
 
-func flattenPhysicalTableMap(apiObject map[string]*quicksight.PhysicalTable, resourceSchema *schema.Resource) *schema.Set {
+func flattenPhysicalTableMap(apiObject map[string]*quicksight.PhysicalTable) *schema.Set {
 	var tfList []interface{}
 	for k, v := range apiObject {
 		if v == nil {
@@ -2449,7 +2458,12 @@ func flattenPhysicalTableMap(apiObject map[string]*quicksight.PhysicalTable, res
 		tfList = append(tfList, tfMap)
 	}
 
-	return schema.NewSet(schema.HashResource(resourceSchema), tfList)
+	return schema.NewSet(physicalTableMapHash, tfList)
+}
+
+// physicalTableMapHash hashes a physical table on its map key only.
+func physicalTableMapHash(v interface{}) int {
+	return create.StringHashcode(v.(map[string]interface{})["physical_table_map_id"].(string))
 }
 
 func flattenCustomSQL(apiObject *quicksight.CustomSql) []interface{} {
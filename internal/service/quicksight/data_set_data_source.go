@@ -132,6 +132,7 @@ func DataSourceDataSet() *schema.Resource {
 					Type:     schema.TypeSet,
 					Computed: true,
 					Elem:     logicalTableMapDataSourceSchema(),
+					Set:      logicalTableMapHash,
 				},
 				names.AttrName: {
 					Type:     schema.TypeString,
@@ -158,6 +159,7 @@ func DataSourceDataSet() *schema.Resource {
 					Type:     schema.TypeSet,
 					Computed: true,
 					Elem:     physicalTableMapDataSourceSchema(),
+					Set:      physicalTableMapHash,
 				},
 				"row_level_permission_data_set": {
 					Type:     schema.TypeList,
@@ -661,11 +663,11 @@ func dataSourceDataSetRead(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.Errorf("setting field_folders: %s", err)
 	}
 
-	if err := d.Set("logical_table_map", flattenLogicalTableMap(dataSet.LogicalTableMap, logicalTableMapDataSourceSchema())); err != nil {
+	if err := d.Set("logical_table_map", flattenLogicalTableMap(dataSet.LogicalTableMap)); err != nil {
 		return diag.Errorf("setting logical_table_map: %s", err)
 	}
 
-	if err := d.Set("physical_table_map", flattenPhysicalTableMap(dataSet.PhysicalTableMap, physicalTableMapDataSourceSchema())); err != nil {
+	if err := d.Set("physical_table_map", flattenPhysicalTableMap(dataSet.PhysicalTableMap)); err != nil {
 		return diag.Errorf("setting physical_table_map: %s", err)
 	}
 
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -133,9 +134,11 @@ func TestAccQuickSightDataSet_columnLevelPermissionRules(t *testing.T) {
 		CheckDestroy:             testAccCheckDataSetDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDataSetConfigColumnLevelPermissionRules(rId, rName),
+				Config: testAccDataSetConfigColumnLevelPermissionRules(rId, rName, "Column1"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDataSetExists(ctx, resourceName, &dataSet),
+					resource.TestCheckResourceAttr(resourceName, "column_level_permission_rules.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "column_level_permission_rules.0.column_names.0", "Column1"),
 				),
 			},
 			{
@@ -143,6 +146,19 @@ func TestAccQuickSightDataSet_columnLevelPermissionRules(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccDataSetConfigColumnLevelPermissionRules(rId, rName, "Column2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSetExists(ctx, resourceName, &dataSet),
+					resource.TestCheckResourceAttr(resourceName, "column_level_permission_rules.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "column_level_permission_rules.0.column_names.0", "Column2"),
+				),
+			},
 		},
 	})
 }
@@ -262,6 +278,53 @@ func TestAccQuickSightDataSet_logicalTableMap(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightDataSet_logicalTableMapDataTransformsUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataSet quicksight.DataSet
+	resourceName := "aws_quicksight_data_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSetConfigLogicalTableMapCreateColumnsOperation(rId, rName, "Column1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSetExists(ctx, resourceName, &dataSet),
+					testAccCheckDataSetCreateColumnsOperationExpression(&dataSet, rId, "Column1"),
+					resource.TestCheckResourceAttr(resourceName, "logical_table_map.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "logical_table_map.0.data_transforms.0.create_columns_operation.0.columns.0.expression", "Column1"),
+				),
+			},
+			{
+				// Only a nested transform changes. The logical table keeps its ID.
+				Config: testAccDataSetConfigLogicalTableMapCreateColumnsOperation(rId, rName, "toUpper(Column1)"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSetExists(ctx, resourceName, &dataSet),
+					testAccCheckDataSetCreateColumnsOperationExpression(&dataSet, rId, "toUpper(Column1)"),
+					resource.TestCheckResourceAttr(resourceName, "logical_table_map.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "logical_table_map.0.logical_table_map_id", rId),
+					resource.TestCheckResourceAttr(resourceName, "logical_table_map.0.data_transforms.0.create_columns_operation.0.columns.0.expression", "toUpper(Column1)"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccQuickSightDataSet_permissions(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dataSet quicksight.DataSet
@@ -568,6 +631,31 @@ func testAccCheckDataSetExists(ctx context.Context, resourceName string, dataSet
 	}
 }
 
+func testAccCheckDataSetCreateColumnsOperationExpression(dataSet *quicksight.DataSet, logicalTableMapID, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		logicalTable, ok := dataSet.LogicalTableMap[logicalTableMapID]
+		if !ok || logicalTable == nil {
+			return fmt.Errorf("QuickSight Data Set (%s) logical table %s not found", aws.StringValue(dataSet.DataSetId), logicalTableMapID)
+		}
+
+		for _, v := range logicalTable.DataTransforms {
+			if v == nil || v.CreateColumnsOperation == nil {
+				continue
+			}
+
+			for _, v := range v.CreateColumnsOperation.Columns {
+				if got := aws.StringValue(v.Expression); got != want {
+					return fmt.Errorf("QuickSight Data Set (%s) logical table %s column %s expression = %q, want %q", aws.StringValue(dataSet.DataSetId), logicalTableMapID, aws.StringValue(v.ColumnName), got, want)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("QuickSight Data Set (%s) logical table %s has no create columns operation", aws.StringValue(dataSet.DataSetId), logicalTableMapID)
+	}
+}
+
 func testAccCheckDataSetDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn(ctx)
@@ -696,7 +784,7 @@ resource "aws_quicksight_data_set" "test" {
 `, rId, rName))
 }
 
-func testAccDataSetConfigColumnLevelPermissionRules(rId, rName string) string {
+func testAccDataSetConfigColumnLevelPermissionRules(rId, rName, columnName string) string {
 	return acctest.ConfigCompose(
 		testAccDataSetConfigBase(rId, rName),
 		testAccDataSource_UserConfig(rName),
@@ -714,17 +802,21 @@ resource "aws_quicksight_data_set" "test" {
         name = "Column1"
         type = "STRING"
       }
+      input_columns {
+        name = "Column2"
+        type = "STRING"
+      }
       upload_settings {
         format = "JSON"
       }
     }
   }
   column_level_permission_rules {
-    column_names = ["Column1"]
+    column_names = [%[3]q]
     principals   = [aws_quicksight_user.test.arn]
   }
 }
-`, rId, rName))
+`, rId, rName, columnName))
 }
 
 func testAccDataSetConfigDataSetUsageConfiguration(rId, rName string) string {
@@ -859,6 +951,46 @@ resource "aws_quicksight_data_set" "test" {
 `, rId, rName))
 }
 
+func testAccDataSetConfigLogicalTableMapCreateColumnsOperation(rId, rName, expression string) string {
+	return acctest.ConfigCompose(
+		testAccDataSetConfigBase(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_data_set" "test" {
+  data_set_id = %[1]q
+  name        = %[2]q
+  import_mode = "SPICE"
+
+  physical_table_map {
+    physical_table_map_id = %[1]q
+    s3_source {
+      data_source_arn = aws_quicksight_data_source.test.arn
+      input_columns {
+        name = "Column1"
+        type = "STRING"
+      }
+      upload_settings {}
+    }
+  }
+  logical_table_map {
+    logical_table_map_id = %[1]q
+    alias                = "Group1"
+    source {
+      physical_table_id = %[1]q
+    }
+    data_transforms {
+      create_columns_operation {
+        columns {
+          column_id   = "Column2"
+          column_name = "Column2"
+          expression  = %[3]q
+        }
+      }
+    }
+  }
+}
+`, rId, rName, expression))
+}
+
 func testAccDataSetConfigPermissions(rId, rName string) string {
 	return acctest.ConfigCompose(
 		testAccDataSetConfigBase(rId, rName),
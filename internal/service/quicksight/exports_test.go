@@ -7,9 +7,15 @@ package quicksight
 var (
 	ResourceFolderMembership    = newResourceFolderMembership
 	ResourceIAMPolicyAssignment = newResourceIAMPolicyAssignment
+	ResourceIPRestriction       = newResourceIPRestriction
 	ResourceIngestion           = newResourceIngestion
+	ResourceKeyRegistration     = newResourceKeyRegistration
 	ResourceNamespace           = newResourceNamespace
 	ResourceRefreshSchedule     = newResourceRefreshSchedule
 	ResourceTemplateAlias       = newResourceTemplateAlias
 	ResourceVPCConnection       = newResourceVPCConnection
+
+	ExpiredTemplateVersionNumbers = expiredTemplateVersionNumbers
+	FindTemplateVersions          = findTemplateVersions
+	TemplateVersionNumberFromARN  = templateVersionNumberFromARN
 )
@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="IP Restriction")
+func newResourceIPRestriction(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceIPRestriction{}, nil
+}
+
+const (
+	ResNameIPRestriction = "IP Restriction"
+)
+
+type resourceIPRestriction struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceIPRestriction) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_quicksight_ip_restriction"
+}
+
+func (r *resourceIPRestriction) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"aws_account_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrEnabled: schema.BoolAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"ip_restriction_rule_map": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"vpc_endpoint_id_restriction_rule_map": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"vpc_id_restriction_rule_map": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *resourceIPRestriction) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().QuickSightConn(ctx)
+
+	var plan resourceIPRestrictionData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.AWSAccountID.IsUnknown() || plan.AWSAccountID.IsNull() {
+		plan.AWSAccountID = types.StringValue(r.Meta().AccountID)
+	}
+	plan.ID = plan.AWSAccountID
+
+	_, err := conn.UpdateIpRestrictionWithContext(ctx, expandIPRestriction(ctx, plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionCreating, ResNameIPRestriction, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceIPRestriction) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().QuickSightConn(ctx)
+
+	var state resourceIPRestrictionData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := FindIPRestrictionByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionSetting, ResNameIPRestriction, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.AWSAccountID = flex.StringToFramework(ctx, out.AwsAccountId)
+	state.Enabled = flex.BoolToFramework(ctx, out.Enabled)
+	state.IPRestrictionRuleMap = flex.FlattenFrameworkStringMap(ctx, out.IpRestrictionRuleMap)
+	state.VPCEndpointIDRestrictionRuleMap = flex.FlattenFrameworkStringMap(ctx, out.VpcEndpointIdRestrictionRuleMap)
+	state.VPCIDRestrictionRuleMap = flex.FlattenFrameworkStringMap(ctx, out.VpcIdRestrictionRuleMap)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceIPRestriction) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().QuickSightConn(ctx)
+
+	var plan resourceIPRestrictionData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.UpdateIpRestrictionWithContext(ctx, expandIPRestriction(ctx, plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionUpdating, ResNameIPRestriction, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceIPRestriction) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().QuickSightConn(ctx)
+
+	var state resourceIPRestrictionData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// IP restrictions cannot be deleted, so disable them and remove all rules.
+	_, err := conn.UpdateIpRestrictionWithContext(ctx, &quicksight.UpdateIpRestrictionInput{
+		AwsAccountId:                    aws.String(state.AWSAccountID.ValueString()),
+		Enabled:                         aws.Bool(false),
+		IpRestrictionRuleMap:            map[string]*string{},
+		VpcEndpointIdRestrictionRuleMap: map[string]*string{},
+		VpcIdRestrictionRuleMap:         map[string]*string{},
+	})
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionDeleting, ResNameIPRestriction, state.ID.String(), err),
+			err.Error(),
+		)
+	}
+}
+
+func (r *resourceIPRestriction) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+}
+
+func FindIPRestrictionByID(ctx context.Context, conn *quicksight.QuickSight, id string) (*quicksight.DescribeIpRestrictionOutput, error) {
+	in := &quicksight.DescribeIpRestrictionInput{
+		AwsAccountId: aws.String(id),
+	}
+
+	out, err := conn.DescribeIpRestrictionWithContext(ctx, in)
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+// expandIPRestriction returns the complete set of rules, as omitted rule maps would otherwise be left unchanged.
+func expandIPRestriction(ctx context.Context, data resourceIPRestrictionData) *quicksight.UpdateIpRestrictionInput {
+	in := &quicksight.UpdateIpRestrictionInput{
+		AwsAccountId:                    aws.String(data.AWSAccountID.ValueString()),
+		Enabled:                         aws.Bool(data.Enabled.ValueBool()),
+		IpRestrictionRuleMap:            map[string]*string{},
+		VpcEndpointIdRestrictionRuleMap: map[string]*string{},
+		VpcIdRestrictionRuleMap:         map[string]*string{},
+	}
+
+	if !data.IPRestrictionRuleMap.IsNull() {
+		in.IpRestrictionRuleMap = flex.ExpandFrameworkStringMap(ctx, data.IPRestrictionRuleMap)
+	}
+	if !data.VPCEndpointIDRestrictionRuleMap.IsNull() {
+		in.VpcEndpointIdRestrictionRuleMap = flex.ExpandFrameworkStringMap(ctx, data.VPCEndpointIDRestrictionRuleMap)
+	}
+	if !data.VPCIDRestrictionRuleMap.IsNull() {
+		in.VpcIdRestrictionRuleMap = flex.ExpandFrameworkStringMap(ctx, data.VPCIDRestrictionRuleMap)
+	}
+
+	return in
+}
+
+type resourceIPRestrictionData struct {
+	AWSAccountID                    types.String `tfsdk:"aws_account_id"`
+	Enabled                         types.Bool   `tfsdk:"enabled"`
+	ID                              types.String `tfsdk:"id"`
+	IPRestrictionRuleMap            types.Map    `tfsdk:"ip_restriction_rule_map"`
+	VPCEndpointIDRestrictionRuleMap types.Map    `tfsdk:"vpc_endpoint_id_restriction_rule_map"`
+	VPCIDRestrictionRuleMap         types.Map    `tfsdk:"vpc_id_restriction_rule_map"`
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccIPRestriction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_quicksight_ip_restriction.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, quicksight.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPRestrictionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPRestrictionConfig_basic("10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPRestrictionExists(ctx, resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "aws_account_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, "false"),
+					resource.TestCheckResourceAttr(resourceName, "ip_restriction_rule_map.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "ip_restriction_rule_map.10.0.0.0/16", "test"),
+					resource.TestCheckNoResourceAttr(resourceName, "vpc_id_restriction_rule_map"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIPRestrictionConfig_vpc("10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPRestrictionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ip_restriction_rule_map.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "ip_restriction_rule_map.10.1.0.0/16", "test"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_id_restriction_rule_map.%", acctest.CtOne),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIPRestrictionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_quicksight_ip_restriction" {
+				continue
+			}
+
+			output, err := tfquicksight.FindIPRestrictionByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if aws.BoolValue(output.Enabled) || len(output.IpRestrictionRuleMap) > 0 || len(output.VpcEndpointIdRestrictionRuleMap) > 0 || len(output.VpcIdRestrictionRuleMap) > 0 {
+				return fmt.Errorf("QuickSight IP Restriction %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIPRestrictionExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn(ctx)
+
+		_, err := tfquicksight.FindIPRestrictionByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccIPRestrictionConfig_basic(cidr string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_ip_restriction" "test" {
+  enabled = false
+
+  ip_restriction_rule_map = {
+    %[1]q = "test"
+  }
+}
+`, cidr)
+}
+
+func testAccIPRestrictionConfig_vpc(cidr string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets("test", 1), fmt.Sprintf(`
+resource "aws_quicksight_ip_restriction" "test" {
+  enabled = false
+
+  ip_restriction_rule_map = {
+    %[1]q = "test"
+  }
+
+  vpc_id_restriction_rule_map = {
+    (aws_vpc.test.id) = "test"
+  }
+}
+`, cidr))
+}
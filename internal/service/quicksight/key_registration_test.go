@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccKeyRegistration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_quicksight_key_registration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, quicksight.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyRegistrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyRegistrationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyRegistrationExists(ctx, resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "aws_account_id"),
+					resource.TestCheckResourceAttr(resourceName, "key_registration.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "key_registration.*", map[string]string{
+						"default_key": "true",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "key_registration.*.key_arn", "aws_kms_key.test.0", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKeyRegistrationConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyRegistrationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_registration.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "key_registration.*.key_arn", "aws_kms_key.test.0", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "key_registration.*.key_arn", "aws_kms_key.test.1", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyRegistrationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_quicksight_key_registration" {
+				continue
+			}
+
+			_, err := tfquicksight.FindKeyRegistrationByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("QuickSight Key Registration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeyRegistrationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+
+		_, err := tfquicksight.FindKeyRegistrationByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccKeyRegistrationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  count = 2
+
+  description             = "%[1]s-${count.index}"
+  deletion_window_in_days = 7
+}
+`, rName)
+}
+
+func testAccKeyRegistrationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccKeyRegistrationConfig_base(rName), `
+resource "aws_quicksight_key_registration" "test" {
+  key_registration {
+    key_arn     = aws_kms_key.test[0].arn
+    default_key = true
+  }
+}
+`)
+}
+
+func testAccKeyRegistrationConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccKeyRegistrationConfig_base(rName), `
+resource "aws_quicksight_key_registration" "test" {
+  key_registration {
+    key_arn     = aws_kms_key.test[0].arn
+    default_key = true
+  }
+
+  key_registration {
+    key_arn = aws_kms_key.test[1].arn
+  }
+}
+`)
+}
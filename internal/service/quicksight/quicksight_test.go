@@ -17,6 +17,12 @@ func TestAccQuickSight_serial(t *testing.T) {
 			"basic":      testAccAccountSubscription_basic,
 			"disappears": testAccAccountSubscription_disappears,
 		},
+		"IPRestriction": {
+			"basic": testAccIPRestriction_basic,
+		},
+		"KeyRegistration": {
+			"basic": testAccKeyRegistration_basic,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
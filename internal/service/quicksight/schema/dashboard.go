@@ -20,6 +20,7 @@ func DashboardDefinitionSchema() *schema.Schema {
 		Computed: true,
 		ExactlyOneOf: []string{
 			"definition",
+			"definition_json",
 			"source_entity",
 		},
 		Elem: &schema.Resource{
@@ -273,6 +274,7 @@ func DashboardSourceEntitySchema() *schema.Schema {
 		Optional: true,
 		ExactlyOneOf: []string{
 			"definition",
+			"definition_json",
 			"source_entity",
 		},
 		Elem: &schema.Resource{
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// definitionJSON is implemented by the QuickSight definition API objects that
+// can be supplied as raw JSON via the definition_json attribute.
+type definitionJSON interface {
+	Validate() error
+}
+
+func AnalysisDefinitionJSONSchema() *schema.Schema {
+	return definitionJSONSchema(func() definitionJSON { return &quicksight.AnalysisDefinition{} })
+}
+
+func DashboardDefinitionJSONSchema() *schema.Schema {
+	return definitionJSONSchema(func() definitionJSON { return &quicksight.DashboardVersionDefinition{} })
+}
+
+func TemplateDefinitionJSONSchema() *schema.Schema {
+	return definitionJSONSchema(func() definitionJSON { return &quicksight.TemplateVersionDefinition{} })
+}
+
+func definitionJSONSchema(newDefinition func() definitionJSON) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ExactlyOneOf: []string{
+			"definition",
+			"definition_json",
+			"source_entity",
+		},
+		ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+			apiObject := newDefinition()
+
+			if err := unmarshalDefinitionJSON(v.(string), apiObject); err != nil {
+				errors = append(errors, fmt.Errorf("%q contains an invalid QuickSight definition: %w", k, err))
+				return
+			}
+
+			if err := apiObject.Validate(); err != nil {
+				errors = append(errors, fmt.Errorf("%q contains an invalid QuickSight definition: %w", k, err))
+			}
+
+			return
+		},
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			equal, err := definitionJSONEquivalent(old, new, newDefinition)
+
+			if err != nil {
+				log.Printf("[WARN] Unable to compare QuickSight definition JSON: %s", err)
+				return false
+			}
+
+			return equal
+		},
+	}
+}
+
+func unmarshalDefinitionJSON(s string, apiObject interface{}) error {
+	return jsonutil.UnmarshalJSON(apiObject, strings.NewReader(s))
+}
+
+// definitionJSONEquivalent compares two definitions after round-tripping them
+// through the SDK types, so that formatting and key order are ignored.
+func definitionJSONEquivalent(s1, s2 string, newDefinition func() definitionJSON) (bool, error) {
+	if s1 == "" || s2 == "" {
+		return s1 == s2, nil
+	}
+
+	d1, d2 := newDefinition(), newDefinition()
+
+	if err := unmarshalDefinitionJSON(s1, d1); err != nil {
+		return false, err
+	}
+
+	canonicalJSON1, err := jsonutil.BuildJSON(d1)
+
+	if err != nil {
+		return false, err
+	}
+
+	if err := unmarshalDefinitionJSON(s2, d2); err != nil {
+		return false, err
+	}
+
+	canonicalJSON2, err := jsonutil.BuildJSON(d2)
+
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(canonicalJSON1, canonicalJSON2), nil
+}
+
+func ExpandAnalysisDefinitionJSON(s string) (*quicksight.AnalysisDefinition, error) {
+	apiObject := &quicksight.AnalysisDefinition{}
+
+	if err := unmarshalDefinitionJSON(s, apiObject); err != nil {
+		return nil, err
+	}
+
+	return apiObject, nil
+}
+
+func ExpandDashboardDefinitionJSON(s string) (*quicksight.DashboardVersionDefinition, error) {
+	apiObject := &quicksight.DashboardVersionDefinition{}
+
+	if err := unmarshalDefinitionJSON(s, apiObject); err != nil {
+		return nil, err
+	}
+
+	return apiObject, nil
+}
+
+func ExpandTemplateDefinitionJSON(s string) (*quicksight.TemplateVersionDefinition, error) {
+	apiObject := &quicksight.TemplateVersionDefinition{}
+
+	if err := unmarshalDefinitionJSON(s, apiObject); err != nil {
+		return nil, err
+	}
+
+	return apiObject, nil
+}
+
+func FlattenDefinitionJSON(apiObject definitionJSON) (string, error) {
+	if apiObject == nil {
+		return "", nil
+	}
+
+	b, err := jsonutil.BuildJSON(apiObject)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// DefinitionJSONCustomizeDiff marks the definition attribute that isn't
+// configured as computed when the configured one changes, as both are
+// refreshed from the same DescribeXDefinition response.
+func DefinitionJSONCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange("definition_json") && !definitionBlockConfigured(d) {
+		return d.SetNewComputed("definition")
+	}
+
+	if d.HasChange("definition") && definitionBlockConfigured(d) {
+		return d.SetNewComputed("definition_json")
+	}
+
+	return nil
+}
+
+func definitionBlockConfigured(d *schema.ResourceDiff) bool {
+	v := d.GetRawConfig().GetAttr("definition")
+
+	return !v.IsKnown() || (!v.IsNull() && v.LengthInt() > 0)
+}
+
+// DefinitionJSONConfigured returns whether the definition_json attribute is set
+// in configuration, as opposed to being populated from a previous read.
+func DefinitionJSONConfigured(d *schema.ResourceData) bool {
+	v := d.GetRawConfig().GetAttr("definition_json")
+
+	return !v.IsKnown() || !v.IsNull()
+}
@@ -21,6 +21,7 @@ func TemplateDefinitionSchema() *schema.Schema {
 		Computed: true,
 		ExactlyOneOf: []string{
 			"definition",
+			"definition_json",
 			"source_entity",
 		},
 		Elem: &schema.Resource{
@@ -336,6 +337,7 @@ func TemplateSourceEntitySchema() *schema.Schema {
 		Optional: true,
 		ExactlyOneOf: []string{
 			"definition",
+			"definition_json",
 			"source_entity",
 		},
 		Elem: &schema.Resource{
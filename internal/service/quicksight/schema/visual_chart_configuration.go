@@ -436,6 +436,30 @@ func smallMultiplesOptionsSchema() *schema.Schema {
 	}
 }
 
+func singleAxisOptionsSchema() *schema.Schema {
+	return &schema.Schema{ // https://docs.aws.amazon.com/quicksight/latest/APIReference/API_SingleAxisOptions.html
+		Type:     schema.TypeList,
+		Optional: true,
+		MinItems: 1,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"y_axis_options": { // https://docs.aws.amazon.com/quicksight/latest/APIReference/API_YAxisOptions.html
+					Type:     schema.TypeList,
+					Optional: true,
+					MinItems: 1,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"y_axis": stringSchema(true, validation.StringInSlice(quicksight.SingleYAxisOption_Values(), false)),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func expandAxisDisplayOptions(tfList []interface{}) *quicksight.AxisDisplayOptions {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -1197,6 +1221,44 @@ func expandItemsLimitConfiguration(tfList []interface{}) *quicksight.ItemsLimitC
 	return config
 }
 
+func expandSingleAxisOptions(tfList []interface{}) *quicksight.SingleAxisOptions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	config := &quicksight.SingleAxisOptions{}
+
+	if v, ok := tfMap["y_axis_options"].([]interface{}); ok && len(v) > 0 {
+		config.YAxisOptions = expandYAxisOptions(v)
+	}
+
+	return config
+}
+
+func expandYAxisOptions(tfList []interface{}) *quicksight.YAxisOptions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	config := &quicksight.YAxisOptions{}
+
+	if v, ok := tfMap["y_axis"].(string); ok && v != "" {
+		config.YAxis = aws.String(v)
+	}
+
+	return config
+}
+
 func flattenAxisDisplayOptions(apiObject *quicksight.AxisDisplayOptions) []interface{} {
 	if apiObject == nil {
 		return nil
@@ -1751,3 +1813,29 @@ func flattenPanelTitleOptions(apiObject *quicksight.PanelTitleOptions) []interfa
 
 	return []interface{}{tfMap}
 }
+
+func flattenSingleAxisOptions(apiObject *quicksight.SingleAxisOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+	if apiObject.YAxisOptions != nil {
+		tfMap["y_axis_options"] = flattenYAxisOptions(apiObject.YAxisOptions)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenYAxisOptions(apiObject *quicksight.YAxisOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+	if apiObject.YAxis != nil {
+		tfMap["y_axis"] = aws.StringValue(apiObject.YAxis)
+	}
+
+	return []interface{}{tfMap}
+}
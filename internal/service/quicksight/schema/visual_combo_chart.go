@@ -64,6 +64,7 @@ func comboChartVisualSchema() *schema.Schema {
 							"reference_lines":                  referenceLineSchema(referenceLinesMaxItems), // https://docs.aws.amazon.com/quicksight/latest/APIReference/API_ReferenceLine.html
 							"secondary_y_axis_display_options": axisDisplayOptionsSchema(),                  // https://docs.aws.amazon.com/quicksight/latest/APIReference/API_AxisDisplayOptions.html
 							"secondary_y_axis_label_options":   chartAxisLabelOptionsSchema(),               // https://docs.aws.amazon.com/quicksight/latest/APIReference/API_ChartAxisLabelOptions.html
+							"single_axis_options":              singleAxisOptionsSchema(),                   // https://docs.aws.amazon.com/quicksight/latest/APIReference/API_SingleAxisOptions.html
 							"sort_configuration": { // https://docs.aws.amazon.com/quicksight/latest/APIReference/API_ComboChartSortConfiguration.html
 								Type:             schema.TypeList,
 								Optional:         true,
@@ -177,6 +178,9 @@ func expandComboChartConfiguration(tfList []interface{}) *quicksight.ComboChartC
 	if v, ok := tfMap["secondary_y_axis_label_options"].([]interface{}); ok && len(v) > 0 {
 		config.SecondaryYAxisLabelOptions = expandChartAxisLabelOptions(v)
 	}
+	if v, ok := tfMap["single_axis_options"].([]interface{}); ok && len(v) > 0 {
+		config.SingleAxisOptions = expandSingleAxisOptions(v)
+	}
 	if v, ok := tfMap["sort_configuration"].([]interface{}); ok && len(v) > 0 {
 		config.SortConfiguration = expandComboChartSortConfiguration(v)
 	}
@@ -337,6 +341,9 @@ func flattenComboChartConfiguration(apiObject *quicksight.ComboChartConfiguratio
 	if apiObject.SecondaryYAxisLabelOptions != nil {
 		tfMap["secondary_y_axis_label_options"] = flattenChartAxisLabelOptions(apiObject.SecondaryYAxisLabelOptions)
 	}
+	if apiObject.SingleAxisOptions != nil {
+		tfMap["single_axis_options"] = flattenSingleAxisOptions(apiObject.SingleAxisOptions)
+	}
 	if apiObject.SortConfiguration != nil {
 		tfMap["sort_configuration"] = flattenComboChartSortConfiguration(apiObject.SortConfiguration)
 	}
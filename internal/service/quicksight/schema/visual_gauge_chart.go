@@ -4,6 +4,7 @@
 package schema
 
 import (
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,6 +29,18 @@ func gaugeChartVisualSchema() *schema.Schema {
 					MaxItems: 1,
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
+							"color_configuration": { // https://docs.aws.amazon.com/quicksight/latest/APIReference/API_GaugeChartColorConfiguration.html
+								Type:     schema.TypeList,
+								Optional: true,
+								MinItems: 1,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"background_color": stringSchema(false, validation.StringMatch(regexache.MustCompile(`^#[0-9A-F]{6}$`), "")),
+										"foreground_color": stringSchema(false, validation.StringMatch(regexache.MustCompile(`^#[0-9A-F]{6}$`), "")),
+									},
+								},
+							},
 							"data_labels": dataLabelOptionsSchema(), // https://docs.aws.amazon.com/quicksight/latest/APIReference/API_DataLabelOptions.html
 							"field_wells": { // https://docs.aws.amazon.com/quicksight/latest/APIReference/API_GaugeChartFieldWells.html
 								Type:     schema.TypeList,
@@ -202,6 +215,9 @@ func expandGaugeChartConfiguration(tfList []interface{}) *quicksight.GaugeChartC
 
 	config := &quicksight.GaugeChartConfiguration{}
 
+	if v, ok := tfMap["color_configuration"].([]interface{}); ok && len(v) > 0 {
+		config.ColorConfiguration = expandGaugeChartColorConfiguration(v)
+	}
 	if v, ok := tfMap["data_labels"].([]interface{}); ok && len(v) > 0 {
 		config.DataLabels = expandDataLabelOptions(v)
 	}
@@ -221,6 +237,28 @@ func expandGaugeChartConfiguration(tfList []interface{}) *quicksight.GaugeChartC
 	return config
 }
 
+func expandGaugeChartColorConfiguration(tfList []interface{}) *quicksight.GaugeChartColorConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	config := &quicksight.GaugeChartColorConfiguration{}
+
+	if v, ok := tfMap["background_color"].(string); ok && v != "" {
+		config.BackgroundColor = aws.String(v)
+	}
+	if v, ok := tfMap["foreground_color"].(string); ok && v != "" {
+		config.ForegroundColor = aws.String(v)
+	}
+
+	return config
+}
+
 func expandGaugeChartFieldWells(tfList []interface{}) *quicksight.GaugeChartFieldWells {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -473,6 +511,9 @@ func flattenGaugeChartConfiguration(apiObject *quicksight.GaugeChartConfiguratio
 	}
 
 	tfMap := map[string]interface{}{}
+	if apiObject.ColorConfiguration != nil {
+		tfMap["color_configuration"] = flattenGaugeChartColorConfiguration(apiObject.ColorConfiguration)
+	}
 	if apiObject.DataLabels != nil {
 		tfMap["data_labels"] = flattenDataLabelOptions(apiObject.DataLabels)
 	}
@@ -492,6 +533,22 @@ func flattenGaugeChartConfiguration(apiObject *quicksight.GaugeChartConfiguratio
 	return []interface{}{tfMap}
 }
 
+func flattenGaugeChartColorConfiguration(apiObject *quicksight.GaugeChartColorConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+	if apiObject.BackgroundColor != nil {
+		tfMap["background_color"] = aws.StringValue(apiObject.BackgroundColor)
+	}
+	if apiObject.ForegroundColor != nil {
+		tfMap["foreground_color"] = aws.StringValue(apiObject.ForegroundColor)
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenGaugeChartFieldWells(apiObject *quicksight.GaugeChartFieldWells) []interface{} {
 	if apiObject == nil {
 		return nil
@@ -254,6 +254,7 @@ func lineChartVisualSchema() *schema.Schema {
 									},
 								},
 							},
+							"single_axis_options":     singleAxisOptionsSchema(),     // https://docs.aws.amazon.com/quicksight/latest/APIReference/API_SingleAxisOptions.html
 							"small_multiples_options": smallMultiplesOptionsSchema(), // https://docs.aws.amazon.com/quicksight/latest/APIReference/API_SmallMultiplesOptions.html
 							"sort_configuration": { // https://docs.aws.amazon.com/quicksight/latest/APIReference/API_LineChartSortConfiguration.html
 								Type:             schema.TypeList,
@@ -409,6 +410,9 @@ func expandLineChartConfiguration(tfList []interface{}) *quicksight.LineChartCon
 	if v, ok := tfMap["secondary_y_axis_label_options"].([]interface{}); ok && len(v) > 0 {
 		config.SecondaryYAxisLabelOptions = expandChartAxisLabelOptions(v)
 	}
+	if v, ok := tfMap["single_axis_options"].([]interface{}); ok && len(v) > 0 {
+		config.SingleAxisOptions = expandSingleAxisOptions(v)
+	}
 	if v, ok := tfMap["series"].([]interface{}); ok && len(v) > 0 {
 		config.Series = expandSeriesItems(v)
 	}
@@ -980,6 +984,9 @@ func flattenLineChartConfiguration(apiObject *quicksight.LineChartConfiguration)
 	if apiObject.SecondaryYAxisLabelOptions != nil {
 		tfMap["secondary_y_axis_label_options"] = flattenChartAxisLabelOptions(apiObject.SecondaryYAxisLabelOptions)
 	}
+	if apiObject.SingleAxisOptions != nil {
+		tfMap["single_axis_options"] = flattenSingleAxisOptions(apiObject.SingleAxisOptions)
+	}
 	if apiObject.Series != nil {
 		tfMap["series"] = flattenSeriesItem(apiObject.Series)
 	}
@@ -33,6 +33,7 @@ func radarChartVisualSchema() *schema.Schema {
 							"alternate_band_colors_visibility": stringSchema(false, validation.StringInSlice(quicksight.Visibility_Values(), false)),
 							"alternate_band_even_color":        stringSchema(false, validation.StringMatch(regexache.MustCompile(`^#[0-9A-F]{6}$`), "")),
 							"alternate_band_odd_color":         stringSchema(false, validation.StringMatch(regexache.MustCompile(`^#[0-9A-F]{6}$`), "")),
+							"axes_range_scale":                 stringSchema(false, validation.StringInSlice(quicksight.RadarChartAxesRangeScale_Values(), false)),
 							"base_series_settings": { // https://docs.aws.amazon.com/quicksight/latest/APIReference/API_RadarChartSeriesSettings.html
 								Type:     schema.TypeList,
 								Optional: true,
@@ -166,6 +167,9 @@ func expandRadarChartConfiguration(tfList []interface{}) *quicksight.RadarChartC
 	if v, ok := tfMap["alternate_band_odd_color"].(string); ok && v != "" {
 		config.AlternateBandOddColor = aws.String(v)
 	}
+	if v, ok := tfMap["axes_range_scale"].(string); ok && v != "" {
+		config.AxesRangeScale = aws.String(v)
+	}
 	if v, ok := tfMap["shape"].(string); ok && v != "" {
 		config.Shape = aws.String(v)
 	}
@@ -237,7 +241,7 @@ func expandRadarChartAggregatedFieldWells(tfList []interface{}) *quicksight.Rada
 	if v, ok := tfMap["category"].([]interface{}); ok && len(v) > 0 {
 		config.Category = expandDimensionFields(v)
 	}
-	if v, ok := tfMap["colors"].([]interface{}); ok && len(v) > 0 {
+	if v, ok := tfMap["color"].([]interface{}); ok && len(v) > 0 {
 		config.Color = expandDimensionFields(v)
 	}
 	if v, ok := tfMap[names.AttrValues].([]interface{}); ok && len(v) > 0 {
@@ -355,6 +359,9 @@ func flattenRadarChartConfiguration(apiObject *quicksight.RadarChartConfiguratio
 	if apiObject.AlternateBandOddColor != nil {
 		tfMap["alternate_band_odd_color"] = aws.StringValue(apiObject.AlternateBandOddColor)
 	}
+	if apiObject.AxesRangeScale != nil {
+		tfMap["axes_range_scale"] = aws.StringValue(apiObject.AxesRangeScale)
+	}
 	if apiObject.BaseSeriesSettings != nil {
 		tfMap["base_series_settings"] = flattenRadarChartSeriesSettings(apiObject.BaseSeriesSettings)
 	}
@@ -4,9 +4,11 @@
 package schema
 
 import (
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -30,7 +32,30 @@ func waterfallVisualSchema() *schema.Schema {
 						Schema: map[string]*schema.Schema{
 							"category_axis_display_options": axisDisplayOptionsSchema(),    // https://docs.aws.amazon.com/quicksight/latest/APIReference/API_AxisDisplayOptions.html
 							"category_axis_label_options":   chartAxisLabelOptionsSchema(), // https://docs.aws.amazon.com/quicksight/latest/APIReference/API_ChartAxisLabelOptions.html
-							"data_labels":                   dataLabelOptionsSchema(),      // https://docs.aws.amazon.com/quicksight/latest/APIReference/API_DataLabelOptions.html
+							"color_configuration": { // https://docs.aws.amazon.com/quicksight/latest/APIReference/API_WaterfallChartColorConfiguration.html
+								Type:     schema.TypeList,
+								Optional: true,
+								MinItems: 1,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"group_color_configuration": { // https://docs.aws.amazon.com/quicksight/latest/APIReference/API_WaterfallChartGroupColorConfiguration.html
+											Type:     schema.TypeList,
+											Optional: true,
+											MinItems: 1,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"negative_bar_color": stringSchema(false, validation.StringMatch(regexache.MustCompile(`^#[0-9A-F]{6}$`), "")),
+													"positive_bar_color": stringSchema(false, validation.StringMatch(regexache.MustCompile(`^#[0-9A-F]{6}$`), "")),
+													"total_bar_color":    stringSchema(false, validation.StringMatch(regexache.MustCompile(`^#[0-9A-F]{6}$`), "")),
+												},
+											},
+										},
+									},
+								},
+							},
+							"data_labels": dataLabelOptionsSchema(), // https://docs.aws.amazon.com/quicksight/latest/APIReference/API_DataLabelOptions.html
 							"field_wells": { // https://docs.aws.amazon.com/quicksight/latest/APIReference/API_WaterfallChartFieldWells.html
 								Type:     schema.TypeList,
 								Optional: true,
@@ -148,6 +173,9 @@ func expandWaterfallChartConfiguration(tfList []interface{}) *quicksight.Waterfa
 	if v, ok := tfMap["category_axis_label_options"].([]interface{}); ok && len(v) > 0 {
 		config.CategoryAxisLabelOptions = expandChartAxisLabelOptions(v)
 	}
+	if v, ok := tfMap["color_configuration"].([]interface{}); ok && len(v) > 0 {
+		config.ColorConfiguration = expandWaterfallChartColorConfiguration(v)
+	}
 	if v, ok := tfMap["data_labels"].([]interface{}); ok && len(v) > 0 {
 		config.DataLabels = expandDataLabelOptions(v)
 	}
@@ -176,6 +204,50 @@ func expandWaterfallChartConfiguration(tfList []interface{}) *quicksight.Waterfa
 	return config
 }
 
+func expandWaterfallChartColorConfiguration(tfList []interface{}) *quicksight.WaterfallChartColorConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	config := &quicksight.WaterfallChartColorConfiguration{}
+
+	if v, ok := tfMap["group_color_configuration"].([]interface{}); ok && len(v) > 0 {
+		config.GroupColorConfiguration = expandWaterfallChartGroupColorConfiguration(v)
+	}
+
+	return config
+}
+
+func expandWaterfallChartGroupColorConfiguration(tfList []interface{}) *quicksight.WaterfallChartGroupColorConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	config := &quicksight.WaterfallChartGroupColorConfiguration{}
+
+	if v, ok := tfMap["negative_bar_color"].(string); ok && v != "" {
+		config.NegativeBarColor = aws.String(v)
+	}
+	if v, ok := tfMap["positive_bar_color"].(string); ok && v != "" {
+		config.PositiveBarColor = aws.String(v)
+	}
+	if v, ok := tfMap["total_bar_color"].(string); ok && v != "" {
+		config.TotalBarColor = aws.String(v)
+	}
+
+	return config
+}
+
 func expandWaterfallChartFieldWells(tfList []interface{}) *quicksight.WaterfallChartFieldWells {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -300,6 +372,9 @@ func flattenWaterfallChartConfiguration(apiObject *quicksight.WaterfallChartConf
 	if apiObject.CategoryAxisLabelOptions != nil {
 		tfMap["category_axis_label_options"] = flattenChartAxisLabelOptions(apiObject.CategoryAxisLabelOptions)
 	}
+	if apiObject.ColorConfiguration != nil {
+		tfMap["color_configuration"] = flattenWaterfallChartColorConfiguration(apiObject.ColorConfiguration)
+	}
 	if apiObject.DataLabels != nil {
 		tfMap["data_labels"] = flattenDataLabelOptions(apiObject.DataLabels)
 	}
@@ -328,6 +403,38 @@ func flattenWaterfallChartConfiguration(apiObject *quicksight.WaterfallChartConf
 	return []interface{}{tfMap}
 }
 
+func flattenWaterfallChartColorConfiguration(apiObject *quicksight.WaterfallChartColorConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+	if apiObject.GroupColorConfiguration != nil {
+		tfMap["group_color_configuration"] = flattenWaterfallChartGroupColorConfiguration(apiObject.GroupColorConfiguration)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenWaterfallChartGroupColorConfiguration(apiObject *quicksight.WaterfallChartGroupColorConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+	if apiObject.NegativeBarColor != nil {
+		tfMap["negative_bar_color"] = aws.StringValue(apiObject.NegativeBarColor)
+	}
+	if apiObject.PositiveBarColor != nil {
+		tfMap["positive_bar_color"] = aws.StringValue(apiObject.PositiveBarColor)
+	}
+	if apiObject.TotalBarColor != nil {
+		tfMap["total_bar_color"] = aws.StringValue(apiObject.TotalBarColor)
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenWaterfallChartFieldWells(apiObject *quicksight.WaterfallChartFieldWells) []interface{} {
 	if apiObject == nil {
 		return nil
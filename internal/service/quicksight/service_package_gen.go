@@ -5,6 +5,8 @@ package quicksight
 import (
 	"context"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	quicksight_sdkv2 "github.com/aws/aws-sdk-go-v2/service/quicksight"
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	quicksight_sdkv1 "github.com/aws/aws-sdk-go/service/quicksight"
@@ -29,10 +31,18 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceIAMPolicyAssignment,
 			Name:    "IAM Policy Assignment",
 		},
+		{
+			Factory: newResourceIPRestriction,
+			Name:    "IP Restriction",
+		},
 		{
 			Factory: newResourceIngestion,
 			Name:    "Ingestion",
 		},
+		{
+			Factory: newResourceKeyRegistration,
+			Name:    "Key Registration",
+		},
 		{
 			Factory: newResourceNamespace,
 			Name:    "Namespace",
@@ -156,6 +166,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceTopic,
+			TypeName: "aws_quicksight_topic",
+			Name:     "Topic",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceUser,
 			TypeName: "aws_quicksight_user",
@@ -175,6 +193,17 @@ func (p *servicePackage) NewConn(ctx context.Context, config map[string]any) (*q
 	return quicksight_sdkv1.New(sess.Copy(&aws_sdkv1.Config{Endpoint: aws_sdkv1.String(config[names.AttrEndpoint].(string))})), nil
 }
 
+// NewClient returns a new AWS SDK for Go v2 client for this service package's AWS API.
+func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (*quicksight_sdkv2.Client, error) {
+	cfg := *(config["aws_sdkv2_config"].(*aws_sdkv2.Config))
+
+	return quicksight_sdkv2.NewFromConfig(cfg, func(o *quicksight_sdkv2.Options) {
+		if endpoint := config[names.AttrEndpoint].(string); endpoint != "" {
+			o.BaseEndpoint = aws_sdkv2.String(endpoint)
+		}
+	}), nil
+}
+
 func ServicePackage(ctx context.Context) conns.ServicePackage {
 	return &servicePackage{}
 }
@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -62,7 +65,8 @@ func ResourceTemplate() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
-				"definition": quicksightschema.TemplateDefinitionSchema(),
+				"definition":      quicksightschema.TemplateDefinitionSchema(),
+				"definition_json": quicksightschema.TemplateDefinitionJSONSchema(),
 				"last_updated_time": {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -119,10 +123,18 @@ func ResourceTemplate() *schema.Resource {
 					Type:     schema.TypeInt,
 					Computed: true,
 				},
+				"version_retention": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
 			}
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			quicksightschema.DefinitionJSONCustomizeDiff,
+		),
 	}
 }
 
@@ -160,6 +172,15 @@ func resourceTemplateCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Definition = quicksightschema.ExpandTemplateDefinition(d.Get("definition").([]interface{}))
 	}
 
+	if quicksightschema.DefinitionJSONConfigured(d) {
+		definition, err := quicksightschema.ExpandTemplateDefinitionJSON(d.Get("definition_json").(string))
+		if err != nil {
+			return create.DiagError(names.QuickSight, create.ErrActionCreating, ResNameTemplate, d.Get(names.AttrName).(string), err)
+		}
+
+		input.Definition = definition
+	}
+
 	if v, ok := d.Get(names.AttrPermissions).(*schema.Set); ok && v.Len() > 0 {
 		input.Permissions = expandResourcePermissions(v.List())
 	}
@@ -221,6 +242,13 @@ func resourceTemplateRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("setting definition: %s", err)
 	}
 
+	definitionJSON, err := quicksightschema.FlattenDefinitionJSON(descResp.Definition)
+	if err != nil {
+		return diag.Errorf("flattening QuickSight Template (%s) definition JSON: %s", d.Id(), err)
+	}
+
+	d.Set("definition_json", definitionJSON)
+
 	permsResp, err := conn.DescribeTemplatePermissionsWithContext(ctx, &quicksight.DescribeTemplatePermissionsInput{
 		AwsAccountId: aws.String(awsAccountId),
 		TemplateId:   aws.String(templateId),
@@ -245,7 +273,10 @@ func resourceTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.FromErr(err)
 	}
 
-	if d.HasChangesExcept(names.AttrPermissions, names.AttrTags, names.AttrTagsAll) {
+	// The version created by this update, if any, is never deleted by version_retention.
+	var currentVersionNumber int64
+
+	if d.HasChangesExcept(names.AttrPermissions, names.AttrTags, names.AttrTagsAll, "version_retention") {
 		in := &quicksight.UpdateTemplateInput{
 			AwsAccountId:       aws.String(awsAccountId),
 			TemplateId:         aws.String(templateId),
@@ -253,15 +284,27 @@ func resourceTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			VersionDescription: aws.String(d.Get("version_description").(string)),
 		}
 
-		// One of source_entity or definition is required for update
+		// One of source_entity, definition or definition_json is required for update
 		if _, ok := d.GetOk("source_entity"); ok {
 			in.SourceEntity = quicksightschema.ExpandTemplateSourceEntity(d.Get("source_entity").([]interface{}))
+		} else if quicksightschema.DefinitionJSONConfigured(d) {
+			definition, err := quicksightschema.ExpandTemplateDefinitionJSON(d.Get("definition_json").(string))
+			if err != nil {
+				return create.DiagError(names.QuickSight, create.ErrActionUpdating, ResNameTemplate, d.Id(), err)
+			}
+
+			in.Definition = definition
 		} else {
 			in.Definition = quicksightschema.ExpandTemplateDefinition(d.Get("definition").([]interface{}))
 		}
 
 		log.Printf("[DEBUG] Updating QuickSight Template (%s): %#v", d.Id(), in)
-		_, err := conn.UpdateTemplateWithContext(ctx, in)
+		out, err := conn.UpdateTemplateWithContext(ctx, in)
+		if err != nil {
+			return create.DiagError(names.QuickSight, create.ErrActionUpdating, ResNameTemplate, d.Id(), err)
+		}
+
+		currentVersionNumber, err = templateVersionNumberFromARN(aws.StringValue(out.VersionArn))
 		if err != nil {
 			return create.DiagError(names.QuickSight, create.ErrActionUpdating, ResNameTemplate, d.Id(), err)
 		}
@@ -298,6 +341,12 @@ func resourceTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if v, ok := d.GetOk("version_retention"); ok {
+		if err := deleteExpiredTemplateVersions(ctx, conn, awsAccountId, templateId, currentVersionNumber, v.(int)); err != nil {
+			return diag.Errorf("deleting QuickSight Template (%s) versions: %s", d.Id(), err)
+		}
+	}
+
 	return resourceTemplateRead(ctx, d, meta)
 }
 
@@ -357,6 +406,137 @@ func FindTemplateByID(ctx context.Context, conn *quicksight.QuickSight, id strin
 	return out.Template, nil
 }
 
+// deleteExpiredTemplateVersions deletes all but the latest retain versions of a template.
+// Versions that a template alias points to and the current version are never deleted.
+func deleteExpiredTemplateVersions(ctx context.Context, conn *quicksight.QuickSight, awsAccountId, templateId string, currentVersionNumber int64, retain int) error {
+	versions, err := findTemplateVersions(ctx, conn, &quicksight.ListTemplateVersionsInput{
+		AwsAccountId: aws.String(awsAccountId),
+		TemplateId:   aws.String(templateId),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	aliases, err := findTemplateAliases(ctx, conn, &quicksight.ListTemplateAliasesInput{
+		AwsAccountId: aws.String(awsAccountId),
+		TemplateId:   aws.String(templateId),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	versionNumbers := make([]int64, 0, len(versions))
+	for _, v := range versions {
+		versionNumbers = append(versionNumbers, aws.Int64Value(v.VersionNumber))
+	}
+
+	keep := []int64{currentVersionNumber}
+	for _, v := range aliases {
+		keep = append(keep, aws.Int64Value(v.TemplateVersionNumber))
+	}
+
+	for _, versionNumber := range expiredTemplateVersionNumbers(versionNumbers, currentVersionNumber, keep, retain) {
+		log.Printf("[INFO] Deleting QuickSight Template (%s) version: %d", templateId, versionNumber)
+		_, err := conn.DeleteTemplateWithContext(ctx, &quicksight.DeleteTemplateInput{
+			AwsAccountId:  aws.String(awsAccountId),
+			TemplateId:    aws.String(templateId),
+			VersionNumber: aws.Int64(versionNumber),
+		})
+
+		if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("version %d: %w", versionNumber, err)
+		}
+	}
+
+	return nil
+}
+
+// expiredTemplateVersionNumbers returns the version numbers that fall outside the latest retain versions.
+// The current version counts towards retain even if it isn't listed yet. Versions in keep are never returned.
+func expiredTemplateVersionNumbers(versionNumbers []int64, currentVersionNumber int64, keep []int64, retain int) []int64 {
+	versionNumbers = slices.Clone(versionNumbers)
+	if currentVersionNumber > 0 && !slices.Contains(versionNumbers, currentVersionNumber) {
+		versionNumbers = append(versionNumbers, currentVersionNumber)
+	}
+	slices.Sort(versionNumbers)
+
+	var output []int64
+
+	for i := 0; i < len(versionNumbers)-retain; i++ {
+		if versionNumber := versionNumbers[i]; !slices.Contains(keep, versionNumber) {
+			output = append(output, versionNumber)
+		}
+	}
+
+	return output
+}
+
+// templateVersionNumberFromARN returns the version number from a template version ARN,
+// e.g. arn:aws:quicksight:us-west-2:123456789012:template/example/version/3.
+func templateVersionNumberFromARN(s string) (int64, error) {
+	parts := strings.Split(s, "/")
+
+	if len(parts) < 2 || parts[len(parts)-2] != "version" {
+		return 0, fmt.Errorf("unexpected format for template version ARN (%s)", s)
+	}
+
+	return strconv.ParseInt(parts[len(parts)-1], 10, 64)
+}
+
+func findTemplateVersions(ctx context.Context, conn *quicksight.QuickSight, input *quicksight.ListTemplateVersionsInput) ([]*quicksight.TemplateVersionSummary, error) {
+	var output []*quicksight.TemplateVersionSummary
+
+	err := conn.ListTemplateVersionsPagesWithContext(ctx, input, func(page *quicksight.ListTemplateVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TemplateVersionSummaryList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findTemplateAliases(ctx context.Context, conn *quicksight.QuickSight, input *quicksight.ListTemplateAliasesInput) ([]*quicksight.TemplateAlias, error) {
+	var output []*quicksight.TemplateAlias
+
+	err := conn.ListTemplateAliasesPagesWithContext(ctx, input, func(page *quicksight.ListTemplateAliasesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TemplateAliasList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func ParseTemplateId(id string) (string, string, error) {
 	parts := strings.SplitN(id, ",", 2)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -22,6 +23,72 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestExpiredTemplateVersionNumbers(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		versionNumbers       []int64
+		currentVersionNumber int64
+		keep                 []int64
+		retain               int
+		expected             []int64
+	}{
+		"fewer than retain": {
+			versionNumbers: []int64{1, 2},
+			retain:         3,
+		},
+		"unordered": {
+			versionNumbers: []int64{4, 1, 3, 2, 5},
+			retain:         2,
+			expected:       []int64{1, 2, 3},
+		},
+		"aliased": {
+			versionNumbers: []int64{1, 2, 3, 4},
+			keep:           []int64{2},
+			retain:         1,
+			expected:       []int64{1, 3},
+		},
+		"current version not listed": {
+			versionNumbers:       []int64{1, 2, 3},
+			currentVersionNumber: 4,
+			keep:                 []int64{4},
+			retain:               2,
+			expected:             []int64{1, 2},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfquicksight.ExpiredTemplateVersionNumbers(testCase.versionNumbers, testCase.currentVersionNumber, testCase.keep, testCase.retain)
+
+			if !slices.Equal(got, testCase.expected) {
+				t.Errorf("got %v, expected %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestTemplateVersionNumberFromARN(t *testing.T) {
+	t.Parallel()
+
+	got, err := tfquicksight.TemplateVersionNumberFromARN("arn:aws:quicksight:us-west-2:123456789012:template/example/version/12")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != 12 {
+		t.Errorf("got %d, expected 12", got)
+	}
+
+	if _, err := tfquicksight.TemplateVersionNumberFromARN("arn:aws:quicksight:us-west-2:123456789012:template/example"); err == nil {
+		t.Error("expected error")
+	}
+}
+
 func TestAccQuickSightTemplate_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -315,6 +382,52 @@ func TestAccQuickSightTemplate_update(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightTemplate_versionRetention(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var template quicksight.Template
+	resourceName := "aws_quicksight_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_versionRetention(rId, rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "version_retention", acctest.CtOne),
+					testAccCheckTemplateVersionNumbers(ctx, resourceName, 1),
+				),
+			},
+			{
+				Config: testAccTemplateConfig_versionRetention(rId, rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+					// Version 1 is kept as the alias points to it.
+					testAccCheckTemplateVersionNumbers(ctx, resourceName, 1, 2),
+				),
+			},
+			{
+				Config: testAccTemplateConfig_versionRetention(rId, rName, "third"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "3"),
+					testAccCheckTemplateVersionNumbers(ctx, resourceName, 1, 3),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn(ctx)
@@ -375,6 +488,38 @@ func testAccCheckTemplateNotRecreated(before, after *quicksight.Template) resour
 	}
 }
 
+func testAccCheckTemplateVersionNumbers(ctx context.Context, n string, expected ...int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn(ctx)
+
+		versions, err := tfquicksight.FindTemplateVersions(ctx, conn, &quicksight.ListTemplateVersionsInput{
+			AwsAccountId: aws.String(rs.Primary.Attributes["aws_account_id"]),
+			TemplateId:   aws.String(rs.Primary.Attributes["template_id"]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		var got []int64
+		for _, v := range versions {
+			got = append(got, aws.Int64Value(v.VersionNumber))
+		}
+		slices.Sort(got)
+
+		if !slices.Equal(got, expected) {
+			return fmt.Errorf("QuickSight Template (%s) versions: got %v, expected %v", rs.Primary.ID, got, expected)
+		}
+
+		return nil
+	}
+}
+
 func testAccTemplateConfigBase(rId string, rName string) string {
 	return acctest.ConfigCompose(
 		testAccDataSetConfigBase(rId, rName),
@@ -795,3 +940,53 @@ resource "aws_quicksight_template" "test" {
 }
 `, rId, rName, key1, value1, key2, value2))
 }
+
+func testAccTemplateConfig_versionRetention(rId, rName, versionDescription string) string {
+	return acctest.ConfigCompose(
+		testAccTemplateConfigBase(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_template" "test" {
+  template_id         = %[1]q
+  name                = %[2]q
+  version_description = %[3]q
+  version_retention   = 1
+
+  definition {
+    data_set_configuration {
+      data_set_schema {
+        column_schema_list {
+          name      = "Column1"
+          data_type = "STRING"
+        }
+        column_schema_list {
+          name      = "Column2"
+          data_type = "INTEGER"
+        }
+      }
+      placeholder = "1"
+    }
+    sheets {
+      title    = "Test"
+      sheet_id = "Test1"
+      visuals {
+        custom_content_visual {
+          data_set_identifier = "1"
+          title {
+            format_text {
+              plain_text = "Test"
+            }
+          }
+          visual_id = "Test1"
+        }
+      }
+    }
+  }
+}
+
+resource "aws_quicksight_template_alias" "test" {
+  alias_name              = %[2]q
+  template_id             = aws_quicksight_template.test.template_id
+  template_version_number = 1
+}
+`, rId, rName, versionDescription))
+}
@@ -0,0 +1,1848 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_quicksight_topic", name="Topic")
+// @Tags(identifierAttribute="arn")
+func ResourceTopic() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTopicCreate,
+		ReadWithoutTimeout:   resourceTopicRead,
+		UpdateWithoutTimeout: resourceTopicUpdate,
+		DeleteWithoutTimeout: resourceTopicDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				names.AttrARN: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"aws_account_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ForceNew:     true,
+					ValidateFunc: verify.ValidAccountID,
+				},
+				"data_sets": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"calculated_fields": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: topicCalculatedFieldSchema(),
+								},
+							},
+							"columns": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: topicColumnSchema(),
+								},
+							},
+							"data_aggregation": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"dataset_row_date_granularity": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice(quicksight.TopicTimeGranularity_Values(), false),
+										},
+										"default_date_column_name": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+									},
+								},
+							},
+							"dataset_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+							"dataset_description": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"dataset_name": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"filters": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: topicFilterSchema(),
+								},
+							},
+							"named_entities": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: topicNamedEntitySchema(),
+								},
+							},
+						},
+					},
+				},
+				names.AttrDescription: {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				names.AttrName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+				names.AttrPermissions: {
+					Type:     schema.TypeSet,
+					Optional: true,
+					MinItems: 1,
+					MaxItems: 64,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"actions": {
+								Type:     schema.TypeSet,
+								Required: true,
+								MinItems: 1,
+								MaxItems: 16,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							names.AttrPrincipal: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+						},
+					},
+				},
+				names.AttrTags:    tftags.TagsSchema(),
+				names.AttrTagsAll: tftags.TagsSchemaComputed(),
+				"topic_id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				"user_experience_version": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(quicksight.TopicUserExperienceVersion_Values(), false),
+				},
+			}
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+// topicFieldSchema returns the attributes shared by topic columns and
+// calculated fields.
+func topicFieldSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"aggregation": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(quicksight.DefaultAggregation_Values(), false),
+		},
+		"allowed_aggregations": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(quicksight.AuthorSpecifiedAggregation_Values(), false),
+			},
+		},
+		"cell_value_synonyms": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"cell_value": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"synonyms": topicSynonymsSchema(),
+				},
+			},
+		},
+		"column_data_role": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(quicksight.ColumnDataRole_Values(), false),
+		},
+		"comparative_order": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"specified_order": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"treat_undefined_specified_values": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(quicksight.UndefinedSpecifiedValueType_Values(), false),
+					},
+					"use_ordering": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(quicksight.ColumnOrderingType_Values(), false),
+					},
+				},
+			},
+		},
+		"default_formatting": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"display_format": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(quicksight.DisplayFormat_Values(), false),
+					},
+					"display_format_options": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"blank_cell_format": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"currency_symbol": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"date_format": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"decimal_separator": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice(quicksight.TopicNumericSeparatorSymbol_Values(), false),
+								},
+								"fraction_digits": {
+									Type:     schema.TypeInt,
+									Optional: true,
+								},
+								"grouping_separator": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"negative_format": {
+									Type:     schema.TypeList,
+									Optional: true,
+									MaxItems: 1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											names.AttrPrefix: {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+											"suffix": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+										},
+									},
+								},
+								names.AttrPrefix: {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"suffix": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"unit_scaler": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice(quicksight.NumberScale_Values(), false),
+								},
+								"use_blank_cell_format": {
+									Type:     schema.TypeBool,
+									Optional: true,
+								},
+								"use_grouping": {
+									Type:     schema.TypeBool,
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"disable_indexing": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"is_included_in_topic": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"never_aggregate_in_filter": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"non_additive": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"not_allowed_aggregations": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(quicksight.AuthorSpecifiedAggregation_Values(), false),
+			},
+		},
+		"semantic_type": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"falsey_cell_value": {
+						Type:      schema.TypeString,
+						Optional:  true,
+						Sensitive: true,
+					},
+					"falsey_cell_value_synonyms": topicSynonymsSchema(),
+					"sub_type_name": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"truthy_cell_value": {
+						Type:      schema.TypeString,
+						Optional:  true,
+						Sensitive: true,
+					},
+					"truthy_cell_value_synonyms": topicSynonymsSchema(),
+					"type_name": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"type_parameters": {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		"time_granularity": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(quicksight.TopicTimeGranularity_Values(), false),
+		},
+	}
+}
+
+func topicCalculatedFieldSchema() map[string]*schema.Schema {
+	s := topicFieldSchema()
+
+	s["calculated_field_description"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	s["calculated_field_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	s["calculated_field_synonyms"] = topicSynonymsSchema()
+	s[names.AttrExpression] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Sensitive:    true,
+		ValidateFunc: validation.StringLenBetween(1, 4096),
+	}
+
+	return s
+}
+
+func topicColumnSchema() map[string]*schema.Schema {
+	s := topicFieldSchema()
+
+	s["column_description"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	s["column_friendly_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	s["column_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	s["column_synonyms"] = topicSynonymsSchema()
+
+	return s
+}
+
+func topicFilterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"category_filter": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"category_filter_function": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(quicksight.CategoryFilterFunction_Values(), false),
+					},
+					"category_filter_type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(quicksight.CategoryFilterType_Values(), false),
+					},
+					"constant": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"collective_constant": {
+									Type:     schema.TypeList,
+									Optional: true,
+									MaxItems: 1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"value_list": {
+												Type:     schema.TypeList,
+												Optional: true,
+												Elem:     &schema.Schema{Type: schema.TypeString},
+											},
+										},
+									},
+								},
+								"constant_type": topicConstantTypeSchema(),
+								"singular_constant": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+							},
+						},
+					},
+					"inverse": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"date_range_filter": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"constant": topicRangeFilterConstantSchema(),
+					"inclusive": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"filter_class": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(quicksight.FilterClass_Values(), false),
+		},
+		"filter_description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"filter_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"filter_synonyms": topicSynonymsSchema(),
+		"filter_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(quicksight.NamedFilterType_Values(), false),
+		},
+		"numeric_equality_filter": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aggregation": topicNamedFilterAggTypeSchema(),
+					"constant":    topicSingularFilterConstantSchema(),
+				},
+			},
+		},
+		"numeric_range_filter": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aggregation": topicNamedFilterAggTypeSchema(),
+					"constant":    topicRangeFilterConstantSchema(),
+					"inclusive": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"operand_field_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"relative_date_filter": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"constant": topicSingularFilterConstantSchema(),
+					"relative_date_filter_function": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(quicksight.TopicRelativeDateFilterFunction_Values(), false),
+					},
+					"time_granularity": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(quicksight.TopicTimeGranularity_Values(), false),
+					},
+				},
+			},
+		},
+	}
+}
+
+func topicNamedEntitySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"definition": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"field_name": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"metric": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"aggregation": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice(quicksight.NamedEntityAggType_Values(), false),
+								},
+								"aggregation_function_parameters": {
+									Type:     schema.TypeMap,
+									Optional: true,
+									Elem:     &schema.Schema{Type: schema.TypeString},
+								},
+							},
+						},
+					},
+					"property_name": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"property_role": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(quicksight.PropertyRole_Values(), false),
+					},
+					"property_usage": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(quicksight.PropertyUsage_Values(), false),
+					},
+				},
+			},
+		},
+		"entity_description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"entity_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"entity_synonyms": topicSynonymsSchema(),
+		"semantic_entity_type": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"sub_type_name": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"type_name": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"type_parameters": {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+	}
+}
+
+func topicSynonymsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+func topicConstantTypeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(quicksight.ConstantType_Values(), false),
+	}
+}
+
+func topicNamedFilterAggTypeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(quicksight.NamedFilterAggType_Values(), false),
+	}
+}
+
+func topicRangeFilterConstantSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"constant_type": topicConstantTypeSchema(),
+				"range_constant": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"maximum": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"minimum": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func topicSingularFilterConstantSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"constant_type": topicConstantTypeSchema(),
+				"singular_constant": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+const (
+	ResNameTopic = "Topic"
+)
+
+func resourceTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn(ctx)
+
+	awsAccountId := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountId = v.(string)
+	}
+	topicId := d.Get("topic_id").(string)
+	id := createTopicId(awsAccountId, topicId)
+
+	input := &quicksight.CreateTopicInput{
+		AwsAccountId: aws.String(awsAccountId),
+		Tags:         getTagsIn(ctx),
+		Topic:        expandTopicDetails(d),
+		TopicId:      aws.String(topicId),
+	}
+
+	_, err := conn.CreateTopicWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.QuickSight, create.ErrActionCreating, ResNameTopic, d.Get(names.AttrName).(string), err)
+	}
+
+	d.SetId(id)
+
+	if v, ok := d.Get(names.AttrPermissions).(*schema.Set); ok && v.Len() > 0 {
+		_, err := conn.UpdateTopicPermissionsWithContext(ctx, &quicksight.UpdateTopicPermissionsInput{
+			AwsAccountId:     aws.String(awsAccountId),
+			GrantPermissions: expandResourcePermissions(v.List()),
+			TopicId:          aws.String(topicId),
+		})
+
+		if err != nil {
+			return diag.Errorf("setting QuickSight Topic (%s) permissions: %s", d.Id(), err)
+		}
+	}
+
+	return resourceTopicRead(ctx, d, meta)
+}
+
+func resourceTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn(ctx)
+
+	awsAccountId, topicId, err := ParseTopicId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	out, err := FindTopicByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Topic (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.QuickSight, create.ErrActionReading, ResNameTopic, d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, out.Arn)
+	d.Set("aws_account_id", awsAccountId)
+	if err := d.Set("data_sets", flattenTopicDatasetMetadata(out.Topic.DataSets)); err != nil {
+		return diag.Errorf("setting data_sets: %s", err)
+	}
+	d.Set(names.AttrDescription, out.Topic.Description)
+	d.Set(names.AttrName, out.Topic.Name)
+	d.Set("topic_id", topicId)
+	d.Set("user_experience_version", out.Topic.UserExperienceVersion)
+
+	permsResp, err := conn.DescribeTopicPermissionsWithContext(ctx, &quicksight.DescribeTopicPermissionsInput{
+		AwsAccountId: aws.String(awsAccountId),
+		TopicId:      aws.String(topicId),
+	})
+
+	if err != nil {
+		return diag.Errorf("describing QuickSight Topic (%s) Permissions: %s", d.Id(), err)
+	}
+
+	if err := d.Set(names.AttrPermissions, flattenPermissions(permsResp.Permissions)); err != nil {
+		return diag.Errorf("setting permissions: %s", err)
+	}
+
+	return nil
+}
+
+func resourceTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn(ctx)
+
+	awsAccountId, topicId, err := ParseTopicId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChangesExcept(names.AttrPermissions, names.AttrTags, names.AttrTagsAll) {
+		input := &quicksight.UpdateTopicInput{
+			AwsAccountId: aws.String(awsAccountId),
+			Topic:        expandTopicDetails(d),
+			TopicId:      aws.String(topicId),
+		}
+
+		log.Printf("[DEBUG] Updating QuickSight Topic (%s): %#v", d.Id(), input)
+		_, err := conn.UpdateTopicWithContext(ctx, input)
+
+		if err != nil {
+			return create.DiagError(names.QuickSight, create.ErrActionUpdating, ResNameTopic, d.Id(), err)
+		}
+	}
+
+	if d.HasChange(names.AttrPermissions) {
+		oraw, nraw := d.GetChange(names.AttrPermissions)
+		o := oraw.(*schema.Set)
+		n := nraw.(*schema.Set)
+
+		toGrant, toRevoke := DiffPermissions(o.List(), n.List())
+
+		params := &quicksight.UpdateTopicPermissionsInput{
+			AwsAccountId: aws.String(awsAccountId),
+			TopicId:      aws.String(topicId),
+		}
+
+		if len(toGrant) > 0 {
+			params.GrantPermissions = toGrant
+		}
+
+		if len(toRevoke) > 0 {
+			params.RevokePermissions = toRevoke
+		}
+
+		_, err = conn.UpdateTopicPermissionsWithContext(ctx, params)
+
+		if err != nil {
+			return diag.Errorf("updating QuickSight Topic (%s) permissions: %s", topicId, err)
+		}
+	}
+
+	return resourceTopicRead(ctx, d, meta)
+}
+
+func resourceTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn(ctx)
+
+	awsAccountId, topicId, err := ParseTopicId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting QuickSight Topic %s", d.Id())
+	_, err = conn.DeleteTopicWithContext(ctx, &quicksight.DeleteTopicInput{
+		AwsAccountId: aws.String(awsAccountId),
+		TopicId:      aws.String(topicId),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.QuickSight, create.ErrActionDeleting, ResNameTopic, d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindTopicByID(ctx context.Context, conn *quicksight.QuickSight, id string) (*quicksight.DescribeTopicOutput, error) {
+	awsAccountId, topicId, err := ParseTopicId(id)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &quicksight.DescribeTopicInput{
+		AwsAccountId: aws.String(awsAccountId),
+		TopicId:      aws.String(topicId),
+	}
+
+	output, err := conn.DescribeTopicWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Topic == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func ParseTopicId(id string) (string, string, error) {
+	parts := strings.SplitN(id, ",", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID,TOPIC_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func createTopicId(awsAccountID, topicId string) string {
+	return fmt.Sprintf("%s,%s", awsAccountID, topicId)
+}
+
+func expandTopicDetails(d *schema.ResourceData) *quicksight.TopicDetails {
+	apiObject := &quicksight.TopicDetails{
+		Name: aws.String(d.Get(names.AttrName).(string)),
+	}
+
+	if v, ok := d.GetOk("data_sets"); ok && len(v.([]interface{})) > 0 {
+		apiObject.DataSets = expandTopicDatasetMetadata(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		apiObject.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("user_experience_version"); ok {
+		apiObject.UserExperienceVersion = aws.String(v.(string))
+	}
+
+	return apiObject
+}
+
+func expandTopicDatasetMetadata(tfList []interface{}) []*quicksight.DatasetMetadata {
+	var apiObjects []*quicksight.DatasetMetadata
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.DatasetMetadata{
+			DatasetArn: aws.String(tfMap["dataset_arn"].(string)),
+		}
+
+		if v, ok := tfMap["calculated_fields"].([]interface{}); ok && len(v) > 0 {
+			apiObject.CalculatedFields = expandTopicCalculatedFields(v)
+		}
+		if v, ok := tfMap["columns"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Columns = expandTopicColumns(v)
+		}
+		if v, ok := tfMap["data_aggregation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.DataAggregation = expandTopicDataAggregation(v[0].(map[string]interface{}))
+		}
+		if v, ok := tfMap["dataset_description"].(string); ok && v != "" {
+			apiObject.DatasetDescription = aws.String(v)
+		}
+		if v, ok := tfMap["dataset_name"].(string); ok && v != "" {
+			apiObject.DatasetName = aws.String(v)
+		}
+		if v, ok := tfMap["filters"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Filters = expandTopicFilters(v)
+		}
+		if v, ok := tfMap["named_entities"].([]interface{}); ok && len(v) > 0 {
+			apiObject.NamedEntities = expandTopicNamedEntities(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTopicDataAggregation(tfMap map[string]interface{}) *quicksight.DataAggregation {
+	apiObject := &quicksight.DataAggregation{}
+
+	if v, ok := tfMap["dataset_row_date_granularity"].(string); ok && v != "" {
+		apiObject.DatasetRowDateGranularity = aws.String(v)
+	}
+	if v, ok := tfMap["default_date_column_name"].(string); ok && v != "" {
+		apiObject.DefaultDateColumnName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTopicCalculatedFields(tfList []interface{}) []*quicksight.TopicCalculatedField {
+	var apiObjects []*quicksight.TopicCalculatedField
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.TopicCalculatedField{
+			CalculatedFieldName: aws.String(tfMap["calculated_field_name"].(string)),
+			Expression:          aws.String(tfMap[names.AttrExpression].(string)),
+		}
+
+		if v, ok := tfMap["aggregation"].(string); ok && v != "" {
+			apiObject.Aggregation = aws.String(v)
+		}
+		if v, ok := tfMap["allowed_aggregations"].([]interface{}); ok && len(v) > 0 {
+			apiObject.AllowedAggregations = flex.ExpandStringList(v)
+		}
+		if v, ok := tfMap["calculated_field_description"].(string); ok && v != "" {
+			apiObject.CalculatedFieldDescription = aws.String(v)
+		}
+		if v, ok := tfMap["calculated_field_synonyms"].([]interface{}); ok && len(v) > 0 {
+			apiObject.CalculatedFieldSynonyms = flex.ExpandStringList(v)
+		}
+		if v, ok := tfMap["cell_value_synonyms"].([]interface{}); ok && len(v) > 0 {
+			apiObject.CellValueSynonyms = expandTopicCellValueSynonyms(v)
+		}
+		if v, ok := tfMap["column_data_role"].(string); ok && v != "" {
+			apiObject.ColumnDataRole = aws.String(v)
+		}
+		if v, ok := tfMap["comparative_order"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ComparativeOrder = expandTopicComparativeOrder(v[0].(map[string]interface{}))
+		}
+		if v, ok := tfMap["default_formatting"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.DefaultFormatting = expandTopicDefaultFormatting(v[0].(map[string]interface{}))
+		}
+		if v, ok := tfMap["disable_indexing"].(bool); ok {
+			apiObject.DisableIndexing = aws.Bool(v)
+		}
+		if v, ok := tfMap["is_included_in_topic"].(bool); ok {
+			apiObject.IsIncludedInTopic = aws.Bool(v)
+		}
+		if v, ok := tfMap["never_aggregate_in_filter"].(bool); ok {
+			apiObject.NeverAggregateInFilter = aws.Bool(v)
+		}
+		if v, ok := tfMap["non_additive"].(bool); ok {
+			apiObject.NonAdditive = aws.Bool(v)
+		}
+		if v, ok := tfMap["not_allowed_aggregations"].([]interface{}); ok && len(v) > 0 {
+			apiObject.NotAllowedAggregations = flex.ExpandStringList(v)
+		}
+		if v, ok := tfMap["semantic_type"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.SemanticType = expandTopicSemanticType(v[0].(map[string]interface{}))
+		}
+		if v, ok := tfMap["time_granularity"].(string); ok && v != "" {
+			apiObject.TimeGranularity = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTopicColumns(tfList []interface{}) []*quicksight.TopicColumn {
+	var apiObjects []*quicksight.TopicColumn
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.TopicColumn{
+			ColumnName: aws.String(tfMap["column_name"].(string)),
+		}
+
+		if v, ok := tfMap["aggregation"].(string); ok && v != "" {
+			apiObject.Aggregation = aws.String(v)
+		}
+		if v, ok := tfMap["allowed_aggregations"].([]interface{}); ok && len(v) > 0 {
+			apiObject.AllowedAggregations = flex.ExpandStringList(v)
+		}
+		if v, ok := tfMap["cell_value_synonyms"].([]interface{}); ok && len(v) > 0 {
+			apiObject.CellValueSynonyms = expandTopicCellValueSynonyms(v)
+		}
+		if v, ok := tfMap["column_data_role"].(string); ok && v != "" {
+			apiObject.ColumnDataRole = aws.String(v)
+		}
+		if v, ok := tfMap["column_description"].(string); ok && v != "" {
+			apiObject.ColumnDescription = aws.String(v)
+		}
+		if v, ok := tfMap["column_friendly_name"].(string); ok && v != "" {
+			apiObject.ColumnFriendlyName = aws.String(v)
+		}
+		if v, ok := tfMap["column_synonyms"].([]interface{}); ok && len(v) > 0 {
+			apiObject.ColumnSynonyms = flex.ExpandStringList(v)
+		}
+		if v, ok := tfMap["comparative_order"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ComparativeOrder = expandTopicComparativeOrder(v[0].(map[string]interface{}))
+		}
+		if v, ok := tfMap["default_formatting"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.DefaultFormatting = expandTopicDefaultFormatting(v[0].(map[string]interface{}))
+		}
+		if v, ok := tfMap["disable_indexing"].(bool); ok {
+			apiObject.DisableIndexing = aws.Bool(v)
+		}
+		if v, ok := tfMap["is_included_in_topic"].(bool); ok {
+			apiObject.IsIncludedInTopic = aws.Bool(v)
+		}
+		if v, ok := tfMap["never_aggregate_in_filter"].(bool); ok {
+			apiObject.NeverAggregateInFilter = aws.Bool(v)
+		}
+		if v, ok := tfMap["non_additive"].(bool); ok {
+			apiObject.NonAdditive = aws.Bool(v)
+		}
+		if v, ok := tfMap["not_allowed_aggregations"].([]interface{}); ok && len(v) > 0 {
+			apiObject.NotAllowedAggregations = flex.ExpandStringList(v)
+		}
+		if v, ok := tfMap["semantic_type"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.SemanticType = expandTopicSemanticType(v[0].(map[string]interface{}))
+		}
+		if v, ok := tfMap["time_granularity"].(string); ok && v != "" {
+			apiObject.TimeGranularity = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTopicCellValueSynonyms(tfList []interface{}) []*quicksight.CellValueSynonym {
+	var apiObjects []*quicksight.CellValueSynonym
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.CellValueSynonym{}
+
+		if v, ok := tfMap["cell_value"].(string); ok && v != "" {
+			apiObject.CellValue = aws.String(v)
+		}
+		if v, ok := tfMap["synonyms"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Synonyms = flex.ExpandStringList(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTopicComparativeOrder(tfMap map[string]interface{}) *quicksight.ComparativeOrder {
+	apiObject := &quicksight.ComparativeOrder{}
+
+	if v, ok := tfMap["specified_order"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SpecifedOrder = flex.ExpandStringList(v)
+	}
+	if v, ok := tfMap["treat_undefined_specified_values"].(string); ok && v != "" {
+		apiObject.TreatUndefinedSpecifiedValues = aws.String(v)
+	}
+	if v, ok := tfMap["use_ordering"].(string); ok && v != "" {
+		apiObject.UseOrdering = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTopicDefaultFormatting(tfMap map[string]interface{}) *quicksight.DefaultFormatting {
+	apiObject := &quicksight.DefaultFormatting{}
+
+	if v, ok := tfMap["display_format"].(string); ok && v != "" {
+		apiObject.DisplayFormat = aws.String(v)
+	}
+	if v, ok := tfMap["display_format_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DisplayFormatOptions = expandTopicDisplayFormatOptions(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandTopicDisplayFormatOptions(tfMap map[string]interface{}) *quicksight.DisplayFormatOptions {
+	apiObject := &quicksight.DisplayFormatOptions{}
+
+	if v, ok := tfMap["blank_cell_format"].(string); ok && v != "" {
+		apiObject.BlankCellFormat = aws.String(v)
+	}
+	if v, ok := tfMap["currency_symbol"].(string); ok && v != "" {
+		apiObject.CurrencySymbol = aws.String(v)
+	}
+	if v, ok := tfMap["date_format"].(string); ok && v != "" {
+		apiObject.DateFormat = aws.String(v)
+	}
+	if v, ok := tfMap["decimal_separator"].(string); ok && v != "" {
+		apiObject.DecimalSeparator = aws.String(v)
+	}
+	if v, ok := tfMap["fraction_digits"].(int); ok && v != 0 {
+		apiObject.FractionDigits = aws.Int64(int64(v))
+	}
+	if v, ok := tfMap["grouping_separator"].(string); ok && v != "" {
+		apiObject.GroupingSeparator = aws.String(v)
+	}
+	if v, ok := tfMap["negative_format"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.NegativeFormat = &quicksight.NegativeFormat{}
+
+		if v, ok := tfMap[names.AttrPrefix].(string); ok && v != "" {
+			apiObject.NegativeFormat.Prefix = aws.String(v)
+		}
+		if v, ok := tfMap["suffix"].(string); ok && v != "" {
+			apiObject.NegativeFormat.Suffix = aws.String(v)
+		}
+	}
+	if v, ok := tfMap[names.AttrPrefix].(string); ok && v != "" {
+		apiObject.Prefix = aws.String(v)
+	}
+	if v, ok := tfMap["suffix"].(string); ok && v != "" {
+		apiObject.Suffix = aws.String(v)
+	}
+	if v, ok := tfMap["unit_scaler"].(string); ok && v != "" {
+		apiObject.UnitScaler = aws.String(v)
+	}
+	if v, ok := tfMap["use_blank_cell_format"].(bool); ok {
+		apiObject.UseBlankCellFormat = aws.Bool(v)
+	}
+	if v, ok := tfMap["use_grouping"].(bool); ok {
+		apiObject.UseGrouping = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandTopicSemanticType(tfMap map[string]interface{}) *quicksight.SemanticType {
+	apiObject := &quicksight.SemanticType{}
+
+	if v, ok := tfMap["falsey_cell_value"].(string); ok && v != "" {
+		apiObject.FalseyCellValue = aws.String(v)
+	}
+	if v, ok := tfMap["falsey_cell_value_synonyms"].([]interface{}); ok && len(v) > 0 {
+		apiObject.FalseyCellValueSynonyms = flex.ExpandStringList(v)
+	}
+	if v, ok := tfMap["sub_type_name"].(string); ok && v != "" {
+		apiObject.SubTypeName = aws.String(v)
+	}
+	if v, ok := tfMap["truthy_cell_value"].(string); ok && v != "" {
+		apiObject.TruthyCellValue = aws.String(v)
+	}
+	if v, ok := tfMap["truthy_cell_value_synonyms"].([]interface{}); ok && len(v) > 0 {
+		apiObject.TruthyCellValueSynonyms = flex.ExpandStringList(v)
+	}
+	if v, ok := tfMap["type_name"].(string); ok && v != "" {
+		apiObject.TypeName = aws.String(v)
+	}
+	if v, ok := tfMap["type_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.TypeParameters = flex.ExpandStringMap(v)
+	}
+
+	return apiObject
+}
+
+func expandTopicFilters(tfList []interface{}) []*quicksight.TopicFilter {
+	var apiObjects []*quicksight.TopicFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.TopicFilter{
+			FilterName:       aws.String(tfMap["filter_name"].(string)),
+			OperandFieldName: aws.String(tfMap["operand_field_name"].(string)),
+		}
+
+		if v, ok := tfMap["category_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.CategoryFilter = expandTopicCategoryFilter(v[0].(map[string]interface{}))
+		}
+		if v, ok := tfMap["date_range_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.DateRangeFilter = &quicksight.TopicDateRangeFilter{}
+
+			if v, ok := tfMap["constant"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				apiObject.DateRangeFilter.Constant = expandTopicRangeFilterConstant(v[0].(map[string]interface{}))
+			}
+			if v, ok := tfMap["inclusive"].(bool); ok {
+				apiObject.DateRangeFilter.Inclusive = aws.Bool(v)
+			}
+		}
+		if v, ok := tfMap["filter_class"].(string); ok && v != "" {
+			apiObject.FilterClass = aws.String(v)
+		}
+		if v, ok := tfMap["filter_description"].(string); ok && v != "" {
+			apiObject.FilterDescription = aws.String(v)
+		}
+		if v, ok := tfMap["filter_synonyms"].([]interface{}); ok && len(v) > 0 {
+			apiObject.FilterSynonyms = flex.ExpandStringList(v)
+		}
+		if v, ok := tfMap["filter_type"].(string); ok && v != "" {
+			apiObject.FilterType = aws.String(v)
+		}
+		if v, ok := tfMap["numeric_equality_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.NumericEqualityFilter = &quicksight.TopicNumericEqualityFilter{}
+
+			if v, ok := tfMap["aggregation"].(string); ok && v != "" {
+				apiObject.NumericEqualityFilter.Aggregation = aws.String(v)
+			}
+			if v, ok := tfMap["constant"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				apiObject.NumericEqualityFilter.Constant = expandTopicSingularFilterConstant(v[0].(map[string]interface{}))
+			}
+		}
+		if v, ok := tfMap["numeric_range_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.NumericRangeFilter = &quicksight.TopicNumericRangeFilter{}
+
+			if v, ok := tfMap["aggregation"].(string); ok && v != "" {
+				apiObject.NumericRangeFilter.Aggregation = aws.String(v)
+			}
+			if v, ok := tfMap["constant"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				apiObject.NumericRangeFilter.Constant = expandTopicRangeFilterConstant(v[0].(map[string]interface{}))
+			}
+			if v, ok := tfMap["inclusive"].(bool); ok {
+				apiObject.NumericRangeFilter.Inclusive = aws.Bool(v)
+			}
+		}
+		if v, ok := tfMap["relative_date_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.RelativeDateFilter = &quicksight.TopicRelativeDateFilter{}
+
+			if v, ok := tfMap["constant"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				apiObject.RelativeDateFilter.Constant = expandTopicSingularFilterConstant(v[0].(map[string]interface{}))
+			}
+			if v, ok := tfMap["relative_date_filter_function"].(string); ok && v != "" {
+				apiObject.RelativeDateFilter.RelativeDateFilterFunction = aws.String(v)
+			}
+			if v, ok := tfMap["time_granularity"].(string); ok && v != "" {
+				apiObject.RelativeDateFilter.TimeGranularity = aws.String(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTopicCategoryFilter(tfMap map[string]interface{}) *quicksight.TopicCategoryFilter {
+	apiObject := &quicksight.TopicCategoryFilter{}
+
+	if v, ok := tfMap["category_filter_function"].(string); ok && v != "" {
+		apiObject.CategoryFilterFunction = aws.String(v)
+	}
+	if v, ok := tfMap["category_filter_type"].(string); ok && v != "" {
+		apiObject.CategoryFilterType = aws.String(v)
+	}
+	if v, ok := tfMap["constant"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Constant = &quicksight.TopicCategoryFilterConstant{}
+
+		if v, ok := tfMap["collective_constant"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.Constant.CollectiveConstant = &quicksight.CollectiveConstant{}
+
+			if v, ok := tfMap["value_list"].([]interface{}); ok && len(v) > 0 {
+				apiObject.Constant.CollectiveConstant.ValueList = flex.ExpandStringList(v)
+			}
+		}
+		if v, ok := tfMap["constant_type"].(string); ok && v != "" {
+			apiObject.Constant.ConstantType = aws.String(v)
+		}
+		if v, ok := tfMap["singular_constant"].(string); ok && v != "" {
+			apiObject.Constant.SingularConstant = aws.String(v)
+		}
+	}
+	if v, ok := tfMap["inverse"].(bool); ok {
+		apiObject.Inverse = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandTopicRangeFilterConstant(tfMap map[string]interface{}) *quicksight.TopicRangeFilterConstant {
+	apiObject := &quicksight.TopicRangeFilterConstant{}
+
+	if v, ok := tfMap["constant_type"].(string); ok && v != "" {
+		apiObject.ConstantType = aws.String(v)
+	}
+	if v, ok := tfMap["range_constant"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.RangeConstant = &quicksight.RangeConstant{}
+
+		if v, ok := tfMap["maximum"].(string); ok && v != "" {
+			apiObject.RangeConstant.Maximum = aws.String(v)
+		}
+		if v, ok := tfMap["minimum"].(string); ok && v != "" {
+			apiObject.RangeConstant.Minimum = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandTopicSingularFilterConstant(tfMap map[string]interface{}) *quicksight.TopicSingularFilterConstant {
+	apiObject := &quicksight.TopicSingularFilterConstant{}
+
+	if v, ok := tfMap["constant_type"].(string); ok && v != "" {
+		apiObject.ConstantType = aws.String(v)
+	}
+	if v, ok := tfMap["singular_constant"].(string); ok && v != "" {
+		apiObject.SingularConstant = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTopicNamedEntities(tfList []interface{}) []*quicksight.TopicNamedEntity {
+	var apiObjects []*quicksight.TopicNamedEntity
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.TopicNamedEntity{
+			EntityName: aws.String(tfMap["entity_name"].(string)),
+		}
+
+		if v, ok := tfMap["definition"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Definition = expandTopicNamedEntityDefinitions(v)
+		}
+		if v, ok := tfMap["entity_description"].(string); ok && v != "" {
+			apiObject.EntityDescription = aws.String(v)
+		}
+		if v, ok := tfMap["entity_synonyms"].([]interface{}); ok && len(v) > 0 {
+			apiObject.EntitySynonyms = flex.ExpandStringList(v)
+		}
+		if v, ok := tfMap["semantic_entity_type"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.SemanticEntityType = &quicksight.SemanticEntityType{}
+
+			if v, ok := tfMap["sub_type_name"].(string); ok && v != "" {
+				apiObject.SemanticEntityType.SubTypeName = aws.String(v)
+			}
+			if v, ok := tfMap["type_name"].(string); ok && v != "" {
+				apiObject.SemanticEntityType.TypeName = aws.String(v)
+			}
+			if v, ok := tfMap["type_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+				apiObject.SemanticEntityType.TypeParameters = flex.ExpandStringMap(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTopicNamedEntityDefinitions(tfList []interface{}) []*quicksight.NamedEntityDefinition {
+	var apiObjects []*quicksight.NamedEntityDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.NamedEntityDefinition{}
+
+		if v, ok := tfMap["field_name"].(string); ok && v != "" {
+			apiObject.FieldName = aws.String(v)
+		}
+		if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.Metric = &quicksight.NamedEntityDefinitionMetric{}
+
+			if v, ok := tfMap["aggregation"].(string); ok && v != "" {
+				apiObject.Metric.Aggregation = aws.String(v)
+			}
+			if v, ok := tfMap["aggregation_function_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+				apiObject.Metric.AggregationFunctionParameters = flex.ExpandStringMap(v)
+			}
+		}
+		if v, ok := tfMap["property_name"].(string); ok && v != "" {
+			apiObject.PropertyName = aws.String(v)
+		}
+		if v, ok := tfMap["property_role"].(string); ok && v != "" {
+			apiObject.PropertyRole = aws.String(v)
+		}
+		if v, ok := tfMap["property_usage"].(string); ok && v != "" {
+			apiObject.PropertyUsage = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTopicDatasetMetadata(apiObjects []*quicksight.DatasetMetadata) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"calculated_fields":   flattenTopicCalculatedFields(apiObject.CalculatedFields),
+			"columns":             flattenTopicColumns(apiObject.Columns),
+			"dataset_arn":         aws.StringValue(apiObject.DatasetArn),
+			"dataset_description": aws.StringValue(apiObject.DatasetDescription),
+			"dataset_name":        aws.StringValue(apiObject.DatasetName),
+			"filters":             flattenTopicFilters(apiObject.Filters),
+			"named_entities":      flattenTopicNamedEntities(apiObject.NamedEntities),
+		}
+
+		if v := apiObject.DataAggregation; v != nil {
+			tfMap["data_aggregation"] = []interface{}{map[string]interface{}{
+				"dataset_row_date_granularity": aws.StringValue(v.DatasetRowDateGranularity),
+				"default_date_column_name":     aws.StringValue(v.DefaultDateColumnName),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenTopicCalculatedFields(apiObjects []*quicksight.TopicCalculatedField) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"aggregation":                  aws.StringValue(apiObject.Aggregation),
+			"allowed_aggregations":         flex.FlattenStringList(apiObject.AllowedAggregations),
+			"calculated_field_description": aws.StringValue(apiObject.CalculatedFieldDescription),
+			"calculated_field_name":        aws.StringValue(apiObject.CalculatedFieldName),
+			"calculated_field_synonyms":    flex.FlattenStringList(apiObject.CalculatedFieldSynonyms),
+			"cell_value_synonyms":          flattenTopicCellValueSynonyms(apiObject.CellValueSynonyms),
+			"column_data_role":             aws.StringValue(apiObject.ColumnDataRole),
+			"comparative_order":            flattenTopicComparativeOrder(apiObject.ComparativeOrder),
+			"default_formatting":           flattenTopicDefaultFormatting(apiObject.DefaultFormatting),
+			"disable_indexing":             aws.BoolValue(apiObject.DisableIndexing),
+			names.AttrExpression:           aws.StringValue(apiObject.Expression),
+			"is_included_in_topic":         aws.BoolValue(apiObject.IsIncludedInTopic),
+			"never_aggregate_in_filter":    aws.BoolValue(apiObject.NeverAggregateInFilter),
+			"non_additive":                 aws.BoolValue(apiObject.NonAdditive),
+			"not_allowed_aggregations":     flex.FlattenStringList(apiObject.NotAllowedAggregations),
+			"semantic_type":                flattenTopicSemanticType(apiObject.SemanticType),
+			"time_granularity":             aws.StringValue(apiObject.TimeGranularity),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenTopicColumns(apiObjects []*quicksight.TopicColumn) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"aggregation":               aws.StringValue(apiObject.Aggregation),
+			"allowed_aggregations":      flex.FlattenStringList(apiObject.AllowedAggregations),
+			"cell_value_synonyms":       flattenTopicCellValueSynonyms(apiObject.CellValueSynonyms),
+			"column_data_role":          aws.StringValue(apiObject.ColumnDataRole),
+			"column_description":        aws.StringValue(apiObject.ColumnDescription),
+			"column_friendly_name":      aws.StringValue(apiObject.ColumnFriendlyName),
+			"column_name":               aws.StringValue(apiObject.ColumnName),
+			"column_synonyms":           flex.FlattenStringList(apiObject.ColumnSynonyms),
+			"comparative_order":         flattenTopicComparativeOrder(apiObject.ComparativeOrder),
+			"default_formatting":        flattenTopicDefaultFormatting(apiObject.DefaultFormatting),
+			"disable_indexing":          aws.BoolValue(apiObject.DisableIndexing),
+			"is_included_in_topic":      aws.BoolValue(apiObject.IsIncludedInTopic),
+			"never_aggregate_in_filter": aws.BoolValue(apiObject.NeverAggregateInFilter),
+			"non_additive":              aws.BoolValue(apiObject.NonAdditive),
+			"not_allowed_aggregations":  flex.FlattenStringList(apiObject.NotAllowedAggregations),
+			"semantic_type":             flattenTopicSemanticType(apiObject.SemanticType),
+			"time_granularity":          aws.StringValue(apiObject.TimeGranularity),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenTopicCellValueSynonyms(apiObjects []*quicksight.CellValueSynonym) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"cell_value": aws.StringValue(apiObject.CellValue),
+			"synonyms":   flex.FlattenStringList(apiObject.Synonyms),
+		})
+	}
+
+	return tfList
+}
+
+func flattenTopicComparativeOrder(apiObject *quicksight.ComparativeOrder) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"specified_order":                  flex.FlattenStringList(apiObject.SpecifedOrder),
+		"treat_undefined_specified_values": aws.StringValue(apiObject.TreatUndefinedSpecifiedValues),
+		"use_ordering":                     aws.StringValue(apiObject.UseOrdering),
+	}}
+}
+
+func flattenTopicDefaultFormatting(apiObject *quicksight.DefaultFormatting) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"display_format": aws.StringValue(apiObject.DisplayFormat),
+	}
+
+	if v := apiObject.DisplayFormatOptions; v != nil {
+		tfOptions := map[string]interface{}{
+			"blank_cell_format":     aws.StringValue(v.BlankCellFormat),
+			"currency_symbol":       aws.StringValue(v.CurrencySymbol),
+			"date_format":           aws.StringValue(v.DateFormat),
+			"decimal_separator":     aws.StringValue(v.DecimalSeparator),
+			"fraction_digits":       aws.Int64Value(v.FractionDigits),
+			"grouping_separator":    aws.StringValue(v.GroupingSeparator),
+			names.AttrPrefix:        aws.StringValue(v.Prefix),
+			"suffix":                aws.StringValue(v.Suffix),
+			"unit_scaler":           aws.StringValue(v.UnitScaler),
+			"use_blank_cell_format": aws.BoolValue(v.UseBlankCellFormat),
+			"use_grouping":          aws.BoolValue(v.UseGrouping),
+		}
+
+		if v := v.NegativeFormat; v != nil {
+			tfOptions["negative_format"] = []interface{}{map[string]interface{}{
+				names.AttrPrefix: aws.StringValue(v.Prefix),
+				"suffix":         aws.StringValue(v.Suffix),
+			}}
+		}
+
+		tfMap["display_format_options"] = []interface{}{tfOptions}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTopicSemanticType(apiObject *quicksight.SemanticType) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"falsey_cell_value":          aws.StringValue(apiObject.FalseyCellValue),
+		"falsey_cell_value_synonyms": flex.FlattenStringList(apiObject.FalseyCellValueSynonyms),
+		"sub_type_name":              aws.StringValue(apiObject.SubTypeName),
+		"truthy_cell_value":          aws.StringValue(apiObject.TruthyCellValue),
+		"truthy_cell_value_synonyms": flex.FlattenStringList(apiObject.TruthyCellValueSynonyms),
+		"type_name":                  aws.StringValue(apiObject.TypeName),
+		"type_parameters":            flex.FlattenStringMap(apiObject.TypeParameters),
+	}}
+}
+
+func flattenTopicFilters(apiObjects []*quicksight.TopicFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"filter_class":       aws.StringValue(apiObject.FilterClass),
+			"filter_description": aws.StringValue(apiObject.FilterDescription),
+			"filter_name":        aws.StringValue(apiObject.FilterName),
+			"filter_synonyms":    flex.FlattenStringList(apiObject.FilterSynonyms),
+			"filter_type":        aws.StringValue(apiObject.FilterType),
+			"operand_field_name": aws.StringValue(apiObject.OperandFieldName),
+		}
+
+		if v := apiObject.CategoryFilter; v != nil {
+			tfFilter := map[string]interface{}{
+				"category_filter_function": aws.StringValue(v.CategoryFilterFunction),
+				"category_filter_type":     aws.StringValue(v.CategoryFilterType),
+				"inverse":                  aws.BoolValue(v.Inverse),
+			}
+
+			if v := v.Constant; v != nil {
+				tfConstant := map[string]interface{}{
+					"constant_type":     aws.StringValue(v.ConstantType),
+					"singular_constant": aws.StringValue(v.SingularConstant),
+				}
+
+				if v := v.CollectiveConstant; v != nil {
+					tfConstant["collective_constant"] = []interface{}{map[string]interface{}{
+						"value_list": flex.FlattenStringList(v.ValueList),
+					}}
+				}
+
+				tfFilter["constant"] = []interface{}{tfConstant}
+			}
+
+			tfMap["category_filter"] = []interface{}{tfFilter}
+		}
+
+		if v := apiObject.DateRangeFilter; v != nil {
+			tfMap["date_range_filter"] = []interface{}{map[string]interface{}{
+				"constant":  flattenTopicRangeFilterConstant(v.Constant),
+				"inclusive": aws.BoolValue(v.Inclusive),
+			}}
+		}
+
+		if v := apiObject.NumericEqualityFilter; v != nil {
+			tfMap["numeric_equality_filter"] = []interface{}{map[string]interface{}{
+				"aggregation": aws.StringValue(v.Aggregation),
+				"constant":    flattenTopicSingularFilterConstant(v.Constant),
+			}}
+		}
+
+		if v := apiObject.NumericRangeFilter; v != nil {
+			tfMap["numeric_range_filter"] = []interface{}{map[string]interface{}{
+				"aggregation": aws.StringValue(v.Aggregation),
+				"constant":    flattenTopicRangeFilterConstant(v.Constant),
+				"inclusive":   aws.BoolValue(v.Inclusive),
+			}}
+		}
+
+		if v := apiObject.RelativeDateFilter; v != nil {
+			tfMap["relative_date_filter"] = []interface{}{map[string]interface{}{
+				"constant":                      flattenTopicSingularFilterConstant(v.Constant),
+				"relative_date_filter_function": aws.StringValue(v.RelativeDateFilterFunction),
+				"time_granularity":              aws.StringValue(v.TimeGranularity),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenTopicRangeFilterConstant(apiObject *quicksight.TopicRangeFilterConstant) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"constant_type": aws.StringValue(apiObject.ConstantType),
+	}
+
+	if v := apiObject.RangeConstant; v != nil {
+		tfMap["range_constant"] = []interface{}{map[string]interface{}{
+			"maximum": aws.StringValue(v.Maximum),
+			"minimum": aws.StringValue(v.Minimum),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTopicSingularFilterConstant(apiObject *quicksight.TopicSingularFilterConstant) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"constant_type":     aws.StringValue(apiObject.ConstantType),
+		"singular_constant": aws.StringValue(apiObject.SingularConstant),
+	}}
+}
+
+func flattenTopicNamedEntities(apiObjects []*quicksight.TopicNamedEntity) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"entity_description": aws.StringValue(apiObject.EntityDescription),
+			"entity_name":        aws.StringValue(apiObject.EntityName),
+			"entity_synonyms":    flex.FlattenStringList(apiObject.EntitySynonyms),
+		}
+
+		var tfDefinitions []interface{}
+
+		for _, v := range apiObject.Definition {
+			if v == nil {
+				continue
+			}
+
+			tfDefinition := map[string]interface{}{
+				"field_name":     aws.StringValue(v.FieldName),
+				"property_name":  aws.StringValue(v.PropertyName),
+				"property_role":  aws.StringValue(v.PropertyRole),
+				"property_usage": aws.StringValue(v.PropertyUsage),
+			}
+
+			if v := v.Metric; v != nil {
+				tfDefinition["metric"] = []interface{}{map[string]interface{}{
+					"aggregation":                     aws.StringValue(v.Aggregation),
+					"aggregation_function_parameters": flex.FlattenStringMap(v.AggregationFunctionParameters),
+				}}
+			}
+
+			tfDefinitions = append(tfDefinitions, tfDefinition)
+		}
+
+		tfMap["definition"] = tfDefinitions
+
+		if v := apiObject.SemanticEntityType; v != nil {
+			tfMap["semantic_entity_type"] = []interface{}{map[string]interface{}{
+				"sub_type_name":   aws.StringValue(v.SubTypeName),
+				"type_name":       aws.StringValue(v.TypeName),
+				"type_parameters": flex.FlattenStringMap(v.TypeParameters),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQuickSightTopic_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var topic quicksight.DescribeTopicOutput
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_quicksight_topic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, quicksight.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicConfig_basic(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(ctx, resourceName, &topic),
+					resource.TestCheckResourceAttr(resourceName, "topic_id", rId),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "data_sets.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(resourceName, "data_sets.0.dataset_arn", "aws_quicksight_data_set.test", names.AttrARN),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "quicksight", fmt.Sprintf("topic/%s", rId)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightTopic_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var topic quicksight.DescribeTopicOutput
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_quicksight_topic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, quicksight.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicConfig_basic(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(ctx, resourceName, &topic),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfquicksight.ResourceTopic(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightTopic_dataSetMetadata(t *testing.T) {
+	ctx := acctest.Context(t)
+	var topic quicksight.DescribeTopicOutput
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_quicksight_topic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, quicksight.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicConfig_basic(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(ctx, resourceName, &topic),
+					resource.TestCheckResourceAttr(resourceName, "data_sets.0.columns.#", "0"),
+				),
+			},
+			{
+				Config: testAccTopicConfig_dataSetMetadata(rId, rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(ctx, resourceName, &topic),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Sales questions"),
+					resource.TestCheckResourceAttr(resourceName, "data_sets.0.columns.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "data_sets.0.columns.0.column_name", "Column1"),
+					resource.TestCheckResourceAttr(resourceName, "data_sets.0.columns.0.column_synonyms.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "data_sets.0.calculated_fields.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "data_sets.0.calculated_fields.0.calculated_field_name", "Column1Upper"),
+					resource.TestCheckResourceAttr(resourceName, "data_sets.0.filters.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "data_sets.0.filters.0.filter_name", "OnlyTest"),
+					resource.TestCheckResourceAttr(resourceName, "data_sets.0.filters.0.category_filter.0.constant.0.singular_constant", "test"),
+					resource.TestCheckResourceAttr(resourceName, "data_sets.0.named_entities.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "data_sets.0.named_entities.0.entity_name", "Item"),
+					resource.TestCheckResourceAttr(resourceName, "data_sets.0.named_entities.0.definition.#", acctest.CtOne),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightTopic_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var topic quicksight.DescribeTopicOutput
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_quicksight_topic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, quicksight.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicConfig_tags1(rId, rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(ctx, resourceName, &topic),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTopicConfig_tags2(rId, rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(ctx, resourceName, &topic),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccTopicConfig_tags1(rId, rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(ctx, resourceName, &topic),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTopicDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_quicksight_topic" {
+				continue
+			}
+
+			_, err := tfquicksight.FindTopicByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("QuickSight Topic (%s) still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTopicExists(ctx context.Context, name string, topic *quicksight.DescribeTopicOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.QuickSight, create.ErrActionCheckingExistence, tfquicksight.ResNameTopic, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.QuickSight, create.ErrActionCheckingExistence, tfquicksight.ResNameTopic, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn(ctx)
+		output, err := tfquicksight.FindTopicByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.QuickSight, create.ErrActionCheckingExistence, tfquicksight.ResNameTopic, rs.Primary.ID, err)
+		}
+
+		*topic = *output
+
+		return nil
+	}
+}
+
+func testAccTopicConfig_basic(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccDataSetConfigBasic(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_topic" "test" {
+  topic_id = %[1]q
+  name     = %[2]q
+
+  data_sets {
+    dataset_arn  = aws_quicksight_data_set.test.arn
+    dataset_name = %[2]q
+  }
+}
+`, rId, rName))
+}
+
+func testAccTopicConfig_dataSetMetadata(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccDataSetConfigBasic(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_topic" "test" {
+  topic_id    = %[1]q
+  name        = %[2]q
+  description = "Sales questions"
+
+  data_sets {
+    dataset_arn  = aws_quicksight_data_set.test.arn
+    dataset_name = %[2]q
+
+    columns {
+      column_name          = "Column1"
+      column_friendly_name = "Item"
+      column_synonyms      = ["product", "sku"]
+      column_data_role     = "DIMENSION"
+      is_included_in_topic = true
+    }
+
+    calculated_fields {
+      calculated_field_name = "Column1Upper"
+      expression            = "toUpper({Column1})"
+      column_data_role      = "DIMENSION"
+    }
+
+    filters {
+      filter_name        = "OnlyTest"
+      operand_field_name = "Column1"
+      filter_class       = "ENFORCED_VALUE_FILTER"
+      filter_type        = "CATEGORY_FILTER"
+
+      category_filter {
+        category_filter_function = "EXACT"
+        category_filter_type     = "FILTER_LIST"
+
+        constant {
+          constant_type     = "SINGULAR"
+          singular_constant = "test"
+        }
+      }
+    }
+
+    named_entities {
+      entity_name     = "Item"
+      entity_synonyms = ["product"]
+
+      definition {
+        field_name     = "Column1"
+        property_name  = "Column1"
+        property_role  = "ID"
+        property_usage = "INHERIT"
+      }
+    }
+  }
+}
+`, rId, rName))
+}
+
+func testAccTopicConfig_tags1(rId, rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(
+		testAccDataSetConfigBasic(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_topic" "test" {
+  topic_id = %[1]q
+  name     = %[2]q
+
+  data_sets {
+    dataset_arn = aws_quicksight_data_set.test.arn
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rId, rName, tagKey1, tagValue1))
+}
+
+func testAccTopicConfig_tags2(rId, rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(
+		testAccDataSetConfigBasic(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_topic" "test" {
+  topic_id = %[1]q
+  name     = %[2]q
+
+  data_sets {
+    dataset_arn = aws_quicksight_data_set.test.arn
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rId, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
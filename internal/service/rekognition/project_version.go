@@ -0,0 +1,646 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rekognition
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rekognition"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rekognition/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Project Version")
+// @Tags(identifierAttribute="arn")
+func newResourceProjectVersion(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceProjectVersion{}
+
+	r.SetDefaultCreateTimeout(4 * time.Hour)
+	r.SetDefaultUpdateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type resourceProjectVersion struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+const (
+	ResNameProjectVersion = "Project Version"
+
+	projectVersionResourceIDPartCount = 2
+)
+
+func (r *resourceProjectVersion) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_rekognition_project_version"
+}
+
+func (r *resourceProjectVersion) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	assetBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[asset](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"ground_truth_manifest": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[groundTruthManifest](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+						listvalidator.IsRequired(),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"s3_object": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[s3Object](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+									listvalidator.IsRequired(),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrBucket: schema.StringAttribute{
+											Required: true,
+										},
+										names.AttrName: schema.StringAttribute{
+											Required: true,
+										},
+										names.AttrVersion: schema.StringAttribute{
+											Optional: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_inference_units": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AlsoRequires(path.MatchRoot("min_inference_units")),
+				},
+			},
+			"min_inference_units": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"project_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ProjectVersionStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"version_description": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"output_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[outputConfig](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"s3_bucket": schema.StringAttribute{
+							Required: true,
+						},
+						"s3_key_prefix": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"testing_data": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[testingData](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"auto_create": schema.BoolAttribute{
+							Optional: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"assets": assetBlock,
+					},
+				},
+			},
+			"training_data": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[trainingData](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"assets": assetBlock,
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceProjectVersion) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().RekognitionClient(ctx)
+
+	var plan resourceProjectVersionData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &rekognition.CreateProjectVersionInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in.Tags = getTagsIn(ctx)
+
+	out, err := conn.CreateProjectVersion(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionCreating, ResNameProjectVersion, plan.VersionName.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if out == nil || out.ProjectVersionArn == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionCreating, ResNameProjectVersion, plan.VersionName.ValueString(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	plan.setID()
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	version, err := waitProjectVersionTrainingCompleted(ctx, conn, plan.ProjectARN.ValueString(), plan.VersionName.ValueString(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionWaitingForCreation, ResNameProjectVersion, plan.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if !plan.MinInferenceUnits.IsNull() {
+		version, err = startProjectVersion(ctx, conn, &plan, createTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Rekognition, create.ErrActionCreating, ResNameProjectVersion, plan.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(plan.refreshFromOutput(ctx, version)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceProjectVersion) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().RekognitionClient(ctx)
+
+	var state resourceProjectVersionData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := state.InitFromID(); err != nil {
+		resp.Diagnostics.AddError("parsing resource ID", err.Error())
+		return
+	}
+
+	out, err := findProjectVersionByTwoPartKey(ctx, conn, state.ProjectARN.ValueString(), state.VersionName.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionReading, ResNameProjectVersion, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.refreshFromOutput(ctx, out)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceProjectVersion) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().RekognitionClient(ctx)
+
+	var plan, state resourceProjectVersionData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
+
+	if !plan.MinInferenceUnits.Equal(state.MinInferenceUnits) || !plan.MaxInferenceUnits.Equal(state.MaxInferenceUnits) {
+		// Inference units can't be changed on a running model, so it is stopped and started again.
+		if !state.MinInferenceUnits.IsNull() {
+			if _, err := stopProjectVersion(ctx, conn, &state, updateTimeout); err != nil {
+				resp.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.Rekognition, create.ErrActionUpdating, ResNameProjectVersion, plan.ID.ValueString(), err),
+					err.Error(),
+				)
+				return
+			}
+		}
+
+		if !plan.MinInferenceUnits.IsNull() {
+			if _, err := startProjectVersion(ctx, conn, &plan, updateTimeout); err != nil {
+				resp.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.Rekognition, create.ErrActionUpdating, ResNameProjectVersion, plan.ID.ValueString(), err),
+					err.Error(),
+				)
+				return
+			}
+		}
+	}
+
+	out, err := findProjectVersionByTwoPartKey(ctx, conn, plan.ProjectARN.ValueString(), plan.VersionName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionUpdating, ResNameProjectVersion, plan.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.refreshFromOutput(ctx, out)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceProjectVersion) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().RekognitionClient(ctx)
+
+	var state resourceProjectVersionData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+
+	// A running model must be stopped before it can be deleted.
+	if out, err := findProjectVersionByTwoPartKey(ctx, conn, state.ProjectARN.ValueString(), state.VersionName.ValueString()); err == nil {
+		switch out.Status {
+		case awstypes.ProjectVersionStatusRunning, awstypes.ProjectVersionStatusStarting:
+			if _, err := stopProjectVersion(ctx, conn, &state, deleteTimeout); err != nil {
+				resp.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.Rekognition, create.ErrActionDeleting, ResNameProjectVersion, state.ID.ValueString(), err),
+					err.Error(),
+				)
+				return
+			}
+		}
+	}
+
+	_, err := conn.DeleteProjectVersion(ctx, &rekognition.DeleteProjectVersionInput{
+		ProjectVersionArn: state.ARN.ValueStringPointer(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionDeleting, ResNameProjectVersion, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := waitProjectVersionDeleted(ctx, conn, state.ProjectARN.ValueString(), state.VersionName.ValueString(), deleteTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionWaitingForDeletion, ResNameProjectVersion, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceProjectVersion) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, req, resp)
+}
+
+func startProjectVersion(ctx context.Context, conn *rekognition.Client, data *resourceProjectVersionData, timeout time.Duration) (*awstypes.ProjectVersionDescription, error) {
+	in := &rekognition.StartProjectVersionInput{
+		MaxInferenceUnits: flex.Int32FromFramework(ctx, data.MaxInferenceUnits),
+		MinInferenceUnits: flex.Int32FromFramework(ctx, data.MinInferenceUnits),
+		ProjectVersionArn: data.ARN.ValueStringPointer(),
+	}
+
+	if _, err := conn.StartProjectVersion(ctx, in); err != nil {
+		return nil, err
+	}
+
+	return waitProjectVersionRunning(ctx, conn, data.ProjectARN.ValueString(), data.VersionName.ValueString(), timeout)
+}
+
+func stopProjectVersion(ctx context.Context, conn *rekognition.Client, data *resourceProjectVersionData, timeout time.Duration) (*awstypes.ProjectVersionDescription, error) {
+	in := &rekognition.StopProjectVersionInput{
+		ProjectVersionArn: data.ARN.ValueStringPointer(),
+	}
+
+	if _, err := conn.StopProjectVersion(ctx, in); err != nil {
+		return nil, err
+	}
+
+	return waitProjectVersionStopped(ctx, conn, data.ProjectARN.ValueString(), data.VersionName.ValueString(), timeout)
+}
+
+func waitProjectVersionTrainingCompleted(ctx context.Context, conn *rekognition.Client, projectARN, versionName string, timeout time.Duration) (*awstypes.ProjectVersionDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:        enum.Slice(awstypes.ProjectVersionStatusTrainingInProgress),
+		Target:         enum.Slice(awstypes.ProjectVersionStatusTrainingCompleted),
+		Refresh:        statusProjectVersion(ctx, conn, projectARN, versionName),
+		Timeout:        timeout,
+		NotFoundChecks: 20,
+		Delay:          1 * time.Minute,
+		PollInterval:   1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.ProjectVersionDescription); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusMessage)))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitProjectVersionRunning(ctx context.Context, conn *rekognition.Client, projectARN, versionName string, timeout time.Duration) (*awstypes.ProjectVersionDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ProjectVersionStatusStarting),
+		Target:  enum.Slice(awstypes.ProjectVersionStatusRunning),
+		Refresh: statusProjectVersion(ctx, conn, projectARN, versionName),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.ProjectVersionDescription); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusMessage)))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitProjectVersionStopped(ctx context.Context, conn *rekognition.Client, projectARN, versionName string, timeout time.Duration) (*awstypes.ProjectVersionDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ProjectVersionStatusRunning, awstypes.ProjectVersionStatusStarting, awstypes.ProjectVersionStatusStopping),
+		Target:  enum.Slice(awstypes.ProjectVersionStatusStopped),
+		Refresh: statusProjectVersion(ctx, conn, projectARN, versionName),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.ProjectVersionDescription); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.StatusMessage)))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitProjectVersionDeleted(ctx context.Context, conn *rekognition.Client, projectARN, versionName string, timeout time.Duration) (*awstypes.ProjectVersionDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ProjectVersionStatusDeleting),
+		Target:  []string{},
+		Refresh: statusProjectVersion(ctx, conn, projectARN, versionName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*awstypes.ProjectVersionDescription); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusProjectVersion(ctx context.Context, conn *rekognition.Client, projectARN, versionName string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findProjectVersionByTwoPartKey(ctx, conn, projectARN, versionName)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func findProjectVersionByTwoPartKey(ctx context.Context, conn *rekognition.Client, projectARN, versionName string) (*awstypes.ProjectVersionDescription, error) {
+	in := &rekognition.DescribeProjectVersionsInput{
+		ProjectArn:   aws.String(projectARN),
+		VersionNames: []string{versionName},
+	}
+
+	out, err := conn.DescribeProjectVersions(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || len(out.ProjectVersionDescriptions) == 0 {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return &out.ProjectVersionDescriptions[0], nil
+}
+
+type resourceProjectVersionData struct {
+	ARN                types.String                                      `tfsdk:"arn"`
+	ID                 types.String                                      `tfsdk:"id"`
+	KMSKeyID           types.String                                      `tfsdk:"kms_key_id"`
+	MaxInferenceUnits  types.Int64                                       `tfsdk:"max_inference_units"`
+	MinInferenceUnits  types.Int64                                       `tfsdk:"min_inference_units"`
+	OutputConfig       fwtypes.ListNestedObjectValueOf[outputConfig]     `tfsdk:"output_config"`
+	ProjectARN         fwtypes.ARN                                       `tfsdk:"project_arn"`
+	Status             fwtypes.StringEnum[awstypes.ProjectVersionStatus] `tfsdk:"status"`
+	Tags               types.Map                                         `tfsdk:"tags"`
+	TagsAll            types.Map                                         `tfsdk:"tags_all"`
+	TestingData        fwtypes.ListNestedObjectValueOf[testingData]      `tfsdk:"testing_data"`
+	Timeouts           timeouts.Value                                    `tfsdk:"timeouts"`
+	TrainingData       fwtypes.ListNestedObjectValueOf[trainingData]     `tfsdk:"training_data"`
+	VersionDescription types.String                                      `tfsdk:"version_description"`
+	VersionName        types.String                                      `tfsdk:"version_name"`
+}
+
+func (data *resourceProjectVersionData) InitFromID() error {
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), projectVersionResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	data.ProjectARN = fwtypes.ARNValue(parts[0])
+	data.VersionName = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *resourceProjectVersionData) setID() {
+	data.ID = types.StringValue(errs.Must(intflex.FlattenResourceId([]string{data.ProjectARN.ValueString(), data.VersionName.ValueString()}, projectVersionResourceIDPartCount, false)))
+}
+
+func (data *resourceProjectVersionData) refreshFromOutput(ctx context.Context, out *awstypes.ProjectVersionDescription) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The API echoes the inference units of the last start, so they are only meaningful while the model is running.
+	maxConfigured := !data.MaxInferenceUnits.IsNull()
+
+	diags.Append(flex.Flatten(ctx, out, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.ARN = flex.StringToFramework(ctx, out.ProjectVersionArn)
+	switch out.Status {
+	case awstypes.ProjectVersionStatusRunning, awstypes.ProjectVersionStatusStarting:
+		if !maxConfigured {
+			data.MaxInferenceUnits = types.Int64Null()
+		}
+	default:
+		data.MaxInferenceUnits = types.Int64Null()
+		data.MinInferenceUnits = types.Int64Null()
+	}
+
+	return diags
+}
+
+type outputConfig struct {
+	S3Bucket    types.String `tfsdk:"s3_bucket"`
+	S3KeyPrefix types.String `tfsdk:"s3_key_prefix"`
+}
+
+type trainingData struct {
+	Assets fwtypes.ListNestedObjectValueOf[asset] `tfsdk:"assets"`
+}
+
+type testingData struct {
+	Assets     fwtypes.ListNestedObjectValueOf[asset] `tfsdk:"assets"`
+	AutoCreate types.Bool                             `tfsdk:"auto_create"`
+}
+
+type asset struct {
+	GroundTruthManifest fwtypes.ListNestedObjectValueOf[groundTruthManifest] `tfsdk:"ground_truth_manifest"`
+}
+
+type groundTruthManifest struct {
+	S3Object fwtypes.ListNestedObjectValueOf[s3Object] `tfsdk:"s3_object"`
+}
+
+type s3Object struct {
+	Bucket  types.String `tfsdk:"bucket"`
+	Name    types.String `tfsdk:"name"`
+	Version types.String `tfsdk:"version"`
+}
@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rekognition_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rekognition/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfrekognition "github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Training a Custom Labels model needs a labelled dataset, supplied as a
+// SageMaker Ground Truth manifest in an existing S3 bucket.
+const (
+	envVarProjectVersionManifestBucket = "REKOGNITION_MANIFEST_BUCKET"
+	envVarProjectVersionManifestKey    = "REKOGNITION_MANIFEST_KEY"
+)
+
+func TestAccRekognitionProjectVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	bucket := acctest.SkipIfEnvVarNotSet(t, envVarProjectVersionManifestBucket)
+	key := acctest.SkipIfEnvVarNotSet(t, envVarProjectVersionManifestKey)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_project_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RekognitionEndpointID)
+			testAccProjectPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RekognitionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVersionConfig_basic(rName, bucket, key),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProjectVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckNoResourceAttr(resourceName, "min_inference_units"),
+					resource.TestCheckResourceAttrPair(resourceName, "project_arn", "aws_rekognition_project.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ProjectVersionStatusTrainingCompleted)),
+					resource.TestCheckResourceAttr(resourceName, "version_name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"testing_data", "training_data", names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func TestAccRekognitionProjectVersion_inferenceUnits(t *testing.T) {
+	ctx := acctest.Context(t)
+	bucket := acctest.SkipIfEnvVarNotSet(t, envVarProjectVersionManifestBucket)
+	key := acctest.SkipIfEnvVarNotSet(t, envVarProjectVersionManifestKey)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_project_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RekognitionEndpointID)
+			testAccProjectPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RekognitionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVersionConfig_inferenceUnits(rName, bucket, key, 1, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProjectVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_inference_units", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "min_inference_units", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ProjectVersionStatusRunning)),
+				),
+			},
+			{
+				Config: testAccProjectVersionConfig_inferenceUnits(rName, bucket, key, 1, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProjectVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "max_inference_units", "2"),
+					resource.TestCheckResourceAttr(resourceName, "min_inference_units", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ProjectVersionStatusRunning)),
+				),
+			},
+			{
+				Config: testAccProjectVersionConfig_basic(rName, bucket, key),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProjectVersionExists(ctx, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "max_inference_units"),
+					resource.TestCheckNoResourceAttr(resourceName, "min_inference_units"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ProjectVersionStatusStopped)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProjectVersionExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Rekognition, create.ErrActionCheckingExistence, tfrekognition.ResNameProjectVersion, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Rekognition, create.ErrActionCheckingExistence, tfrekognition.ResNameProjectVersion, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionClient(ctx)
+		_, err := tfrekognition.FindProjectVersionByTwoPartKey(ctx, conn, rs.Primary.Attributes["project_arn"], rs.Primary.Attributes["version_name"])
+
+		if err != nil {
+			return create.Error(names.Rekognition, create.ErrActionCheckingExistence, tfrekognition.ResNameProjectVersion, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProjectVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rekognition_project_version" {
+				continue
+			}
+
+			_, err := tfrekognition.FindProjectVersionByTwoPartKey(ctx, conn, rs.Primary.Attributes["project_arn"], rs.Primary.Attributes["version_name"])
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.Rekognition, create.ErrActionCheckingDestroyed, tfrekognition.ResNameProjectVersion, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccProjectVersionConfig_base(rName, bucket string) string {
+	return fmt.Sprintf(`
+resource "aws_rekognition_project" "test" {
+  name    = %[1]q
+  feature = "CUSTOM_LABELS"
+}
+
+locals {
+  manifest_bucket = %[2]q
+}
+`, rName, bucket)
+}
+
+func testAccProjectVersionConfig_basic(rName, bucket, key string) string {
+	return acctest.ConfigCompose(testAccProjectVersionConfig_base(rName, bucket), fmt.Sprintf(`
+resource "aws_rekognition_project_version" "test" {
+  project_arn  = aws_rekognition_project.test.arn
+  version_name = %[1]q
+
+  output_config {
+    s3_bucket     = local.manifest_bucket
+    s3_key_prefix = "output/"
+  }
+
+  training_data {
+    assets {
+      ground_truth_manifest {
+        s3_object {
+          bucket = local.manifest_bucket
+          name   = %[2]q
+        }
+      }
+    }
+  }
+
+  testing_data {
+    auto_create = true
+  }
+}
+`, rName, key))
+}
+
+func testAccProjectVersionConfig_inferenceUnits(rName, bucket, key string, minInferenceUnits, maxInferenceUnits int) string {
+	return acctest.ConfigCompose(testAccProjectVersionConfig_base(rName, bucket), fmt.Sprintf(`
+resource "aws_rekognition_project_version" "test" {
+  project_arn  = aws_rekognition_project.test.arn
+  version_name = %[1]q
+
+  min_inference_units = %[3]d
+  max_inference_units = %[4]d
+
+  output_config {
+    s3_bucket     = local.manifest_bucket
+    s3_key_prefix = "output/"
+  }
+
+  training_data {
+    assets {
+      ground_truth_manifest {
+        s3_object {
+          bucket = local.manifest_bucket
+          name   = %[2]q
+        }
+      }
+    }
+  }
+
+  testing_data {
+    auto_create = true
+  }
+}
+`, rName, key, minInferenceUnits, maxInferenceUnits))
+}
@@ -31,6 +31,20 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceProject,
 			Name:    "Project",
 		},
+		{
+			Factory: newResourceProjectVersion,
+			Name:    "Project Version",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newResourceStreamProcessor,
+			Name:    "Stream Processor",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 	}
 }
 
@@ -0,0 +1,735 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rekognition
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rekognition"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rekognition/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Stream Processor")
+// @Tags(identifierAttribute="arn")
+func newResourceStreamProcessor(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceStreamProcessor{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type resourceStreamProcessor struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+const (
+	ResNameStreamProcessor = "Stream Processor"
+)
+
+func (r *resourceStreamProcessor) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_rekognition_stream_processor"
+}
+
+func (r *resourceStreamProcessor) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"data_sharing_preference": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataSharingPreference](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"opt_in": schema.BoolAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"input": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[streamProcessorInput](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"kinesis_video_stream": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[kinesisStream](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.IsRequired(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"notification_channel": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[notificationChannel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrSNSTopicARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
+			"output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[streamProcessorOutput](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"kinesis_data_stream": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[kinesisStream](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+						"s3_destination": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3Destination](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrBucket: schema.StringAttribute{
+										Required: true,
+									},
+									"key_prefix": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"regions_of_interest": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[regionOfInterest](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(10),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"bounding_box": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[boundingBox](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"height": ratioAttribute(),
+									"left":   ratioAttribute(),
+									"top":    ratioAttribute(),
+									"width":  ratioAttribute(),
+								},
+							},
+						},
+						"polygon": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[point](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeBetween(3, 10),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"x": ratioAttribute(),
+									"y": ratioAttribute(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"settings": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[streamProcessorSettings](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"connected_home": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[connectedHome](ctx),
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplaceIf(requiresReplaceIfListAddedOrRemoved, "", ""),
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"labels": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+									"min_confidence": schema.Float64Attribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Float64{
+											float64planmodifier.UseStateForUnknown(),
+										},
+										Validators: []validator.Float64{
+											float64validator.Between(0, 100),
+										},
+									},
+								},
+							},
+						},
+						"face_search": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[faceSearch](ctx),
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"collection_id": schema.StringAttribute{
+										Required: true,
+									},
+									"face_match_threshold": schema.Float64Attribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Float64{
+											float64planmodifier.UseStateForUnknown(),
+										},
+										Validators: []validator.Float64{
+											float64validator.Between(0, 100),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func ratioAttribute() schema.Float64Attribute {
+	return schema.Float64Attribute{
+		Required: true,
+		Validators: []validator.Float64{
+			float64validator.Between(0, 1),
+		},
+	}
+}
+
+// requiresReplaceIfListAddedOrRemoved forces replacement when a nested block
+// is added or removed, while still allowing its contents to change in place.
+func requiresReplaceIfListAddedOrRemoved(_ context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = len(req.StateValue.Elements()) != len(req.PlanValue.Elements())
+}
+
+func (r *resourceStreamProcessor) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().RekognitionClient(ctx)
+
+	var plan resourceStreamProcessorData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &rekognition.CreateStreamProcessorInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in.Tags = getTagsIn(ctx)
+
+	out, err := conn.CreateStreamProcessor(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionCreating, ResNameStreamProcessor, plan.Name.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if out == nil || out.StreamProcessorArn == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionCreating, ResNameStreamProcessor, plan.Name.ValueString(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	plan.ARN = flex.StringToFramework(ctx, out.StreamProcessorArn)
+	plan.ID = plan.Name
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	created, err := waitStreamProcessorCreated(ctx, conn, plan.ID.ValueString(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionWaitingForCreation, ResNameStreamProcessor, plan.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.refreshFromOutput(ctx, created)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceStreamProcessor) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().RekognitionClient(ctx)
+
+	var state resourceStreamProcessorData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findStreamProcessorByName(ctx, conn, state.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionReading, ResNameStreamProcessor, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.refreshFromOutput(ctx, out)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceStreamProcessor) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().RekognitionClient(ctx)
+
+	var plan, state resourceStreamProcessorData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.DataSharingPreference.Equal(state.DataSharingPreference) ||
+		!plan.RegionsOfInterest.Equal(state.RegionsOfInterest) ||
+		!plan.Settings.Equal(state.Settings) {
+		in := &rekognition.UpdateStreamProcessorInput{
+			Name: plan.ID.ValueStringPointer(),
+		}
+
+		if !plan.DataSharingPreference.Equal(state.DataSharingPreference) {
+			// Removing the block opts out of data sharing.
+			in.DataSharingPreferenceForUpdate = &awstypes.StreamProcessorDataSharingPreference{}
+			resp.Diagnostics.Append(flex.Expand(ctx, plan.DataSharingPreference, in.DataSharingPreferenceForUpdate)...)
+		}
+
+		if !plan.RegionsOfInterest.Equal(state.RegionsOfInterest) {
+			if len(plan.RegionsOfInterest.Elements()) == 0 {
+				in.ParametersToDelete = append(in.ParametersToDelete, awstypes.StreamProcessorParameterToDeleteRegionsOfInterest)
+			} else {
+				resp.Diagnostics.Append(flex.Expand(ctx, plan.RegionsOfInterest, &in.RegionsOfInterestForUpdate)...)
+			}
+		}
+
+		if !plan.Settings.Equal(state.Settings) {
+			settings, d := plan.Settings.ToPtr(ctx)
+			resp.Diagnostics.Append(d...)
+
+			if settings != nil && len(settings.ConnectedHome.Elements()) > 0 {
+				in.SettingsForUpdate = &awstypes.StreamProcessorSettingsForUpdate{
+					ConnectedHomeForUpdate: &awstypes.ConnectedHomeSettingsForUpdate{},
+				}
+				resp.Diagnostics.Append(flex.Expand(ctx, settings.ConnectedHome, in.SettingsForUpdate.ConnectedHomeForUpdate)...)
+			}
+		}
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateStreamProcessor(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Rekognition, create.ErrActionUpdating, ResNameStreamProcessor, plan.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
+		updated, err := waitStreamProcessorUpdated(ctx, conn, plan.ID.ValueString(), updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Rekognition, create.ErrActionWaitingForUpdate, ResNameStreamProcessor, plan.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(plan.refreshFromOutput(ctx, updated)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceStreamProcessor) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().RekognitionClient(ctx)
+
+	var state resourceStreamProcessorData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+
+	// A running stream processor must be stopped before it can be deleted.
+	if out, err := findStreamProcessorByName(ctx, conn, state.ID.ValueString()); err == nil && out.Status == awstypes.StreamProcessorStatusRunning {
+		_, err := conn.StopStreamProcessor(ctx, &rekognition.StopStreamProcessorInput{
+			Name: state.ID.ValueStringPointer(),
+		})
+
+		if err != nil && !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Rekognition, create.ErrActionDeleting, ResNameStreamProcessor, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		if _, err := waitStreamProcessorStopped(ctx, conn, state.ID.ValueString(), deleteTimeout); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Rekognition, create.ErrActionWaitingForDeletion, ResNameStreamProcessor, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	_, err := conn.DeleteStreamProcessor(ctx, &rekognition.DeleteStreamProcessorInput{
+		Name: state.ID.ValueStringPointer(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionDeleting, ResNameStreamProcessor, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := waitStreamProcessorDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Rekognition, create.ErrActionWaitingForDeletion, ResNameStreamProcessor, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceStreamProcessor) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, req, resp)
+}
+
+func waitStreamProcessorCreated(ctx context.Context, conn *rekognition.Client, name string, timeout time.Duration) (*rekognition.DescribeStreamProcessorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{},
+		Target:                    enum.Slice(awstypes.StreamProcessorStatusStopped),
+		Refresh:                   statusStreamProcessor(ctx, conn, name),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*rekognition.DescribeStreamProcessorOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitStreamProcessorUpdated(ctx context.Context, conn *rekognition.Client, name string, timeout time.Duration) (*rekognition.DescribeStreamProcessorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.StreamProcessorStatusUpdating),
+		Target:                    enum.Slice(awstypes.StreamProcessorStatusStopped, awstypes.StreamProcessorStatusRunning),
+		Refresh:                   statusStreamProcessor(ctx, conn, name),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*rekognition.DescribeStreamProcessorOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitStreamProcessorStopped(ctx context.Context, conn *rekognition.Client, name string, timeout time.Duration) (*rekognition.DescribeStreamProcessorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StreamProcessorStatusRunning, awstypes.StreamProcessorStatusStopping),
+		Target:  enum.Slice(awstypes.StreamProcessorStatusStopped),
+		Refresh: statusStreamProcessor(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*rekognition.DescribeStreamProcessorOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitStreamProcessorDeleted(ctx context.Context, conn *rekognition.Client, name string, timeout time.Duration) (*rekognition.DescribeStreamProcessorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StreamProcessorStatusStopped, awstypes.StreamProcessorStatusStopping, awstypes.StreamProcessorStatusFailed, awstypes.StreamProcessorStatusUpdating),
+		Target:  []string{},
+		Refresh: statusStreamProcessor(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*rekognition.DescribeStreamProcessorOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusStreamProcessor(ctx context.Context, conn *rekognition.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findStreamProcessorByName(ctx, conn, name)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func findStreamProcessorByName(ctx context.Context, conn *rekognition.Client, name string) (*rekognition.DescribeStreamProcessorOutput, error) {
+	in := &rekognition.DescribeStreamProcessorInput{
+		Name: aws.String(name),
+	}
+
+	out, err := conn.DescribeStreamProcessor(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.StreamProcessorArn == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type resourceStreamProcessorData struct {
+	ARN                   types.String                                             `tfsdk:"arn"`
+	DataSharingPreference fwtypes.ListNestedObjectValueOf[dataSharingPreference]   `tfsdk:"data_sharing_preference"`
+	ID                    types.String                                             `tfsdk:"id"`
+	Input                 fwtypes.ListNestedObjectValueOf[streamProcessorInput]    `tfsdk:"input"`
+	KMSKeyID              types.String                                             `tfsdk:"kms_key_id"`
+	Name                  types.String                                             `tfsdk:"name"`
+	NotificationChannel   fwtypes.ListNestedObjectValueOf[notificationChannel]     `tfsdk:"notification_channel"`
+	Output                fwtypes.ListNestedObjectValueOf[streamProcessorOutput]   `tfsdk:"output"`
+	RegionsOfInterest     fwtypes.ListNestedObjectValueOf[regionOfInterest]        `tfsdk:"regions_of_interest"`
+	RoleARN               fwtypes.ARN                                              `tfsdk:"role_arn"`
+	Settings              fwtypes.ListNestedObjectValueOf[streamProcessorSettings] `tfsdk:"settings"`
+	Tags                  types.Map                                                `tfsdk:"tags"`
+	TagsAll               types.Map                                                `tfsdk:"tags_all"`
+	Timeouts              timeouts.Value                                           `tfsdk:"timeouts"`
+}
+
+func (data *resourceStreamProcessorData) refreshFromOutput(ctx context.Context, out *rekognition.DescribeStreamProcessorOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The API always reports a data sharing preference; only keep it in state when configured or opted in.
+	keepDataSharingPreference := len(data.DataSharingPreference.Elements()) > 0 || (out.DataSharingPreference != nil && out.DataSharingPreference.OptIn)
+
+	diags.Append(flex.Flatten(ctx, out, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.ARN = flex.StringToFramework(ctx, out.StreamProcessorArn)
+	data.ID = flex.StringToFramework(ctx, out.Name)
+	if !keepDataSharingPreference {
+		data.DataSharingPreference = fwtypes.NewListNestedObjectValueOfNull[dataSharingPreference](ctx)
+	}
+
+	return diags
+}
+
+type dataSharingPreference struct {
+	OptIn types.Bool `tfsdk:"opt_in"`
+}
+
+type streamProcessorInput struct {
+	KinesisVideoStream fwtypes.ListNestedObjectValueOf[kinesisStream] `tfsdk:"kinesis_video_stream"`
+}
+
+type streamProcessorOutput struct {
+	KinesisDataStream fwtypes.ListNestedObjectValueOf[kinesisStream] `tfsdk:"kinesis_data_stream"`
+	S3Destination     fwtypes.ListNestedObjectValueOf[s3Destination] `tfsdk:"s3_destination"`
+}
+
+type kinesisStream struct {
+	ARN fwtypes.ARN `tfsdk:"arn"`
+}
+
+type s3Destination struct {
+	Bucket    types.String `tfsdk:"bucket"`
+	KeyPrefix types.String `tfsdk:"key_prefix"`
+}
+
+type notificationChannel struct {
+	SNSTopicARN fwtypes.ARN `tfsdk:"sns_topic_arn"`
+}
+
+type regionOfInterest struct {
+	BoundingBox fwtypes.ListNestedObjectValueOf[boundingBox] `tfsdk:"bounding_box"`
+	Polygon     fwtypes.ListNestedObjectValueOf[point]       `tfsdk:"polygon"`
+}
+
+type boundingBox struct {
+	Height types.Float64 `tfsdk:"height"`
+	Left   types.Float64 `tfsdk:"left"`
+	Top    types.Float64 `tfsdk:"top"`
+	Width  types.Float64 `tfsdk:"width"`
+}
+
+type point struct {
+	X types.Float64 `tfsdk:"x"`
+	Y types.Float64 `tfsdk:"y"`
+}
+
+type streamProcessorSettings struct {
+	ConnectedHome fwtypes.ListNestedObjectValueOf[connectedHome] `tfsdk:"connected_home"`
+	FaceSearch    fwtypes.ListNestedObjectValueOf[faceSearch]    `tfsdk:"face_search"`
+}
+
+type connectedHome struct {
+	Labels        fwtypes.ListValueOf[types.String] `tfsdk:"labels"`
+	MinConfidence types.Float64                     `tfsdk:"min_confidence"`
+}
+
+type faceSearch struct {
+	CollectionID       types.String  `tfsdk:"collection_id"`
+	FaceMatchThreshold types.Float64 `tfsdk:"face_match_threshold"`
+}
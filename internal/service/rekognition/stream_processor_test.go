@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rekognition_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/rekognition"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfrekognition "github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRekognitionStreamProcessor_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var streamProcessor rekognition.DescribeStreamProcessorOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_stream_processor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RekognitionEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RekognitionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStreamProcessorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamProcessorConfig_faceSearch(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStreamProcessorExists(ctx, resourceName, &streamProcessor),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrID, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "data_sharing_preference.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "input.0.kinesis_video_stream.0.arn", "aws_kinesis_video_stream.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "output.0.kinesis_data_stream.0.arn", "aws_kinesis_stream.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "settings.0.face_search.0.collection_id", "aws_rekognition_collection.test", "collection_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "settings.0.face_search.0.face_match_threshold"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func TestAccRekognitionStreamProcessor_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var streamProcessor rekognition.DescribeStreamProcessorOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_stream_processor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RekognitionEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RekognitionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStreamProcessorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamProcessorConfig_faceSearch(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamProcessorExists(ctx, resourceName, &streamProcessor),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfrekognition.ResourceStreamProcessor, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRekognitionStreamProcessor_connectedHome(t *testing.T) {
+	ctx := acctest.Context(t)
+	var streamProcessor rekognition.DescribeStreamProcessorOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_stream_processor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RekognitionEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RekognitionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStreamProcessorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamProcessorConfig_connectedHome(rName, `"PERSON"`, 80, testAccStreamProcessorConfig_boundingBox),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStreamProcessorExists(ctx, resourceName, &streamProcessor),
+					resource.TestCheckResourceAttrPair(resourceName, "notification_channel.0.sns_topic_arn", "aws_sns_topic.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "output.0.s3_destination.0.bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "regions_of_interest.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "regions_of_interest.0.bounding_box.0.height", "0.5"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.connected_home.0.labels.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.connected_home.0.min_confidence", "80"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+			{
+				Config: testAccStreamProcessorConfig_connectedHome(rName, `"PERSON", "PET"`, 60, testAccStreamProcessorConfig_polygon),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStreamProcessorExists(ctx, resourceName, &streamProcessor),
+					resource.TestCheckResourceAttr(resourceName, "regions_of_interest.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "regions_of_interest.0.bounding_box.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "regions_of_interest.0.polygon.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.connected_home.0.labels.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.connected_home.0.min_confidence", "60"),
+				),
+			},
+			{
+				Config: testAccStreamProcessorConfig_connectedHome(rName, `"PERSON", "PET"`, 60, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStreamProcessorExists(ctx, resourceName, &streamProcessor),
+					resource.TestCheckResourceAttr(resourceName, "regions_of_interest.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStreamProcessorExists(ctx context.Context, name string, v *rekognition.DescribeStreamProcessorOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Rekognition, create.ErrActionCheckingExistence, tfrekognition.ResNameStreamProcessor, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Rekognition, create.ErrActionCheckingExistence, tfrekognition.ResNameStreamProcessor, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionClient(ctx)
+		output, err := tfrekognition.FindStreamProcessorByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.Rekognition, create.ErrActionCheckingExistence, tfrekognition.ResNameStreamProcessor, rs.Primary.ID, err)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckStreamProcessorDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rekognition_stream_processor" {
+				continue
+			}
+
+			_, err := tfrekognition.FindStreamProcessorByName(ctx, conn, rs.Primary.ID)
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.Rekognition, create.ErrActionCheckingDestroyed, tfrekognition.ResNameStreamProcessor, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+const testAccStreamProcessorConfig_boundingBox = `
+  regions_of_interest {
+    bounding_box {
+      height = 0.5
+      left   = 0.25
+      top    = 0.25
+      width  = 0.5
+    }
+  }
+`
+
+const testAccStreamProcessorConfig_polygon = `
+  regions_of_interest {
+    polygon {
+      x = 0.1
+      y = 0.1
+    }
+    polygon {
+      x = 0.9
+      y = 0.1
+    }
+    polygon {
+      x = 0.5
+      y = 0.9
+    }
+  }
+`
+
+func testAccStreamProcessorConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_kinesis_video_stream" "test" {
+  name                    = %[1]q
+  data_retention_in_hours = 1
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "rekognition.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccStreamProcessorConfig_faceSearch(rName string) string {
+	return acctest.ConfigCompose(testAccStreamProcessorConfig_base(rName), fmt.Sprintf(`
+resource "aws_kinesis_stream" "test" {
+  name        = %[1]q
+  shard_count = 1
+}
+
+resource "aws_rekognition_collection" "test" {
+  collection_id = %[1]q
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["kinesis:PutRecord", "kinesis:PutRecords"]
+      Effect   = "Allow"
+      Resource = aws_kinesis_stream.test.arn
+      }, {
+      Action   = ["kinesisvideo:GetDataEndpoint", "kinesisvideo:GetMedia"]
+      Effect   = "Allow"
+      Resource = aws_kinesis_video_stream.test.arn
+    }]
+  })
+}
+
+resource "aws_rekognition_stream_processor" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  input {
+    kinesis_video_stream {
+      arn = aws_kinesis_video_stream.test.arn
+    }
+  }
+
+  output {
+    kinesis_data_stream {
+      arn = aws_kinesis_stream.test.arn
+    }
+  }
+
+  settings {
+    face_search {
+      collection_id = aws_rekognition_collection.test.collection_id
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccStreamProcessorConfig_connectedHome(rName, labels string, minConfidence int, regionsOfInterest string) string {
+	return acctest.ConfigCompose(testAccStreamProcessorConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "s3:PutObject"
+      Effect   = "Allow"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+      }, {
+      Action   = "sns:Publish"
+      Effect   = "Allow"
+      Resource = aws_sns_topic.test.arn
+      }, {
+      Action   = ["kinesisvideo:GetDataEndpoint", "kinesisvideo:GetMedia"]
+      Effect   = "Allow"
+      Resource = aws_kinesis_video_stream.test.arn
+    }]
+  })
+}
+
+resource "aws_rekognition_stream_processor" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  input {
+    kinesis_video_stream {
+      arn = aws_kinesis_video_stream.test.arn
+    }
+  }
+
+  output {
+    s3_destination {
+      bucket = aws_s3_bucket.test.bucket
+    }
+  }
+
+  notification_channel {
+    sns_topic_arn = aws_sns_topic.test.arn
+  }
+
+  settings {
+    connected_home {
+      labels         = [%[2]s]
+      min_confidence = %[3]d
+    }
+  }
+%[4]s
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, labels, minConfidence, regionsOfInterest))
+}
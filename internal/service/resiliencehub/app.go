@@ -0,0 +1,488 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resiliencehub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resiliencehub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resiliencehub/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// The unpublished version of an application, which resource imports are made into.
+	appVersionDraft = "draft"
+)
+
+// @SDKResource("aws_resiliencehub_app", name="App")
+// @Tags(identifierAttribute="arn")
+func resourceApp() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAppCreate,
+		ReadWithoutTimeout:   resourceAppRead,
+		UpdateWithoutTimeout: resourceAppUpdate,
+		DeleteWithoutTimeout: resourceAppDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assessment_schedule": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.AppAssessmentScheduleTypeDisabled,
+				ValidateDiagFunc: enum.Validate[awstypes.AppAssessmentScheduleType](),
+			},
+			"compliance_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenAtMost(500),
+			},
+			"drift_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"eks_source": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"eks_cluster_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"namespaces": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 60),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z][0-9A-Za-z_-]+$`), "must start with an alphanumeric character and contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"resiliency_policy_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resiliency_score": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"source_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"terraform_source_s3_state_file_urls": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexache.MustCompile(`^https://`), "must be an HTTPS URL"),
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &resiliencehub.CreateAppInput{
+		AssessmentSchedule: awstypes.AppAssessmentScheduleType(d.Get("assessment_schedule").(string)),
+		ClientToken:        aws.String(id.UniqueId()),
+		Name:               aws.String(name),
+		Tags:               getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resiliency_policy_arn"); ok {
+		input.PolicyArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateApp(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Resilience Hub App (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.App.AppArn))
+
+	if hasAppInputSources(d) {
+		if err := importAndPublishAppResources(ctx, conn, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceAppRead(ctx, d, meta)...)
+}
+
+func resourceAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubClient(ctx)
+
+	app, err := findAppByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resilience Hub App (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resilience Hub App (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, app.AppArn)
+	d.Set("assessment_schedule", app.AssessmentSchedule)
+	d.Set("compliance_status", app.ComplianceStatus)
+	d.Set(names.AttrDescription, app.Description)
+	d.Set("drift_status", app.DriftStatus)
+	d.Set(names.AttrName, app.Name)
+	d.Set("resiliency_policy_arn", app.PolicyArn)
+	d.Set("resiliency_score", app.ResiliencyScore)
+	d.Set(names.AttrStatus, app.Status)
+
+	sources, err := findAppInputSourcesByTwoPartKey(ctx, conn, d.Id(), appVersionDraft)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resilience Hub App (%s) input sources: %s", d.Id(), err)
+	}
+
+	var sourceARNs, stateFileURLs []string
+	eksSources := make(map[string][]string)
+	for _, v := range sources {
+		switch {
+		case v.EksSourceClusterNamespace != nil:
+			clusterARN := aws.ToString(v.EksSourceClusterNamespace.EksClusterArn)
+			eksSources[clusterARN] = append(eksSources[clusterARN], aws.ToString(v.EksSourceClusterNamespace.Namespace))
+		case v.TerraformSource != nil:
+			stateFileURLs = append(stateFileURLs, aws.ToString(v.TerraformSource.S3StateFileUrl))
+		case v.SourceArn != nil:
+			sourceARNs = append(sourceARNs, aws.ToString(v.SourceArn))
+		}
+	}
+
+	var tfEKSSources []interface{}
+	for k, v := range eksSources {
+		tfEKSSources = append(tfEKSSources, map[string]interface{}{
+			"eks_cluster_arn": k,
+			"namespaces":      v,
+		})
+	}
+
+	if err := d.Set("eks_source", tfEKSSources); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting eks_source: %s", err)
+	}
+	d.Set("source_arns", sourceARNs)
+	d.Set("terraform_source_s3_state_file_urls", stateFileURLs)
+
+	setTagsOut(ctx, app.Tags)
+
+	return diags
+}
+
+func resourceAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubClient(ctx)
+
+	if d.HasChanges("assessment_schedule", names.AttrDescription, "resiliency_policy_arn") {
+		input := &resiliencehub.UpdateAppInput{
+			AppArn:             aws.String(d.Id()),
+			AssessmentSchedule: awstypes.AppAssessmentScheduleType(d.Get("assessment_schedule").(string)),
+			Description:        aws.String(d.Get(names.AttrDescription).(string)),
+		}
+
+		if v, ok := d.GetOk("resiliency_policy_arn"); ok {
+			input.PolicyArn = aws.String(v.(string))
+		} else {
+			input.ClearResiliencyPolicyArn = aws.Bool(true)
+		}
+
+		_, err := conn.UpdateApp(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Resilience Hub App (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("eks_source", "source_arns", "terraform_source_s3_state_file_urls") {
+		if err := importAndPublishAppResources(ctx, conn, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceAppRead(ctx, d, meta)...)
+}
+
+func resourceAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Resilience Hub App: %s", d.Id())
+	_, err := conn.DeleteApp(ctx, &resiliencehub.DeleteAppInput{
+		AppArn:      aws.String(d.Id()),
+		ClientToken: aws.String(id.UniqueId()),
+		ForceDelete: aws.Bool(true),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Resilience Hub App (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAppDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Resilience Hub App (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func hasAppInputSources(d *schema.ResourceData) bool {
+	return d.Get("eks_source").(*schema.Set).Len() > 0 || d.Get("source_arns").(*schema.Set).Len() > 0 || d.Get("terraform_source_s3_state_file_urls").(*schema.Set).Len() > 0
+}
+
+// importAndPublishAppResources replaces the resources of the draft application version
+// with those discovered from the configured sources and publishes a new application version.
+func importAndPublishAppResources(ctx context.Context, conn *resiliencehub.Client, d *schema.ResourceData, timeout time.Duration) error {
+	input := &resiliencehub.ImportResourcesToDraftAppVersionInput{
+		AppArn:         aws.String(d.Id()),
+		EksSources:     expandEKSSources(d.Get("eks_source").(*schema.Set).List()),
+		ImportStrategy: awstypes.ResourceImportStrategyTypeReplaceAll,
+		SourceArns:     flex.ExpandStringValueSet(d.Get("source_arns").(*schema.Set)),
+	}
+
+	for _, v := range flex.ExpandStringValueSet(d.Get("terraform_source_s3_state_file_urls").(*schema.Set)) {
+		input.TerraformSources = append(input.TerraformSources, awstypes.TerraformSource{
+			S3StateFileUrl: aws.String(v),
+		})
+	}
+
+	_, err := conn.ImportResourcesToDraftAppVersion(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("importing Resilience Hub App (%s) resources: %w", d.Id(), err)
+	}
+
+	if _, err := waitDraftAppVersionResourcesImported(ctx, conn, d.Id(), timeout); err != nil {
+		return fmt.Errorf("waiting for Resilience Hub App (%s) resource import: %w", d.Id(), err)
+	}
+
+	_, err = conn.PublishAppVersion(ctx, &resiliencehub.PublishAppVersionInput{
+		AppArn: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("publishing Resilience Hub App (%s) version: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func findAppByARN(ctx context.Context, conn *resiliencehub.Client, arn string) (*awstypes.App, error) {
+	input := &resiliencehub.DescribeAppInput{
+		AppArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeApp(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.App == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.App, nil
+}
+
+func findAppInputSourcesByTwoPartKey(ctx context.Context, conn *resiliencehub.Client, appARN, appVersion string) ([]awstypes.AppInputSource, error) {
+	input := &resiliencehub.ListAppInputSourcesInput{
+		AppArn:     aws.String(appARN),
+		AppVersion: aws.String(appVersion),
+	}
+	var output []awstypes.AppInputSource
+
+	pages := resiliencehub.NewListAppInputSourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AppInputSources...)
+	}
+
+	return output, nil
+}
+
+func statusApp(ctx context.Context, conn *resiliencehub.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAppByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func statusDraftAppVersionResourcesImport(ctx context.Context, conn *resiliencehub.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeDraftAppVersionResourcesImportStatus(ctx, &resiliencehub.DescribeDraftAppVersionResourcesImportStatusInput{
+			AppArn: aws.String(arn),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitAppDeleted(ctx context.Context, conn *resiliencehub.Client, arn string, timeout time.Duration) (*awstypes.App, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AppStatusTypeActive, awstypes.AppStatusTypeDeleting),
+		Target:  []string{},
+		Refresh: statusApp(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.App); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDraftAppVersionResourcesImported(ctx context.Context, conn *resiliencehub.Client, arn string, timeout time.Duration) (*resiliencehub.DescribeDraftAppVersionResourcesImportStatusOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ResourceImportStatusTypePending, awstypes.ResourceImportStatusTypeInProgress),
+		Target:  enum.Slice(awstypes.ResourceImportStatusTypeSuccess),
+		Refresh: statusDraftAppVersionResourcesImport(ctx, conn, arn),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*resiliencehub.DescribeDraftAppVersionResourcesImportStatusOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandEKSSources(tfList []interface{}) []awstypes.EksSource {
+	var apiObjects []awstypes.EksSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.EksSource{
+			EksClusterArn: aws.String(tfMap["eks_cluster_arn"].(string)),
+			Namespaces:    flex.ExpandStringValueSet(tfMap["namespaces"].(*schema.Set)),
+		})
+	}
+
+	return apiObjects
+}
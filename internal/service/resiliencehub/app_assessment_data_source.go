@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resiliencehub
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resiliencehub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resiliencehub/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_resiliencehub_app_assessment", name="App Assessment")
+func dataSourceAppAssessment() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAppAssessmentRead,
+
+		Schema: map[string]*schema.Schema{
+			"app_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"app_arn", "assessment_arn"},
+			},
+			"app_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"assessment_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"app_arn", "assessment_arn"},
+			},
+			"assessment_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compliance": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"achievable_rpo_in_secs": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"achievable_rto_in_secs": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"compliance_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"current_rpo_in_secs": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"current_rto_in_secs": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disruption_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"compliance_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"invoker": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resiliency_score": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAppAssessmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubClient(ctx)
+
+	assessmentARN := d.Get("assessment_arn").(string)
+	if assessmentARN == "" {
+		appARN := d.Get("app_arn").(string)
+		summary, err := findLatestSuccessfulAppAssessmentByAppARN(ctx, conn, appARN)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("Resilience Hub App Assessment", err))
+		}
+
+		assessmentARN = aws.ToString(summary.AssessmentArn)
+	}
+
+	assessment, err := findAppAssessmentByARN(ctx, conn, assessmentARN)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resilience Hub App Assessment (%s): %s", assessmentARN, err)
+	}
+
+	d.SetId(aws.ToString(assessment.AssessmentArn))
+	d.Set("app_arn", assessment.AppArn)
+	d.Set("app_version", assessment.AppVersion)
+	d.Set("assessment_arn", assessment.AssessmentArn)
+	d.Set("assessment_name", assessment.AssessmentName)
+	if err := d.Set("compliance", flattenDisruptionCompliances(assessment.Compliance)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting compliance: %s", err)
+	}
+	d.Set("compliance_status", assessment.ComplianceStatus)
+	if assessment.EndTime != nil {
+		d.Set("end_time", aws.ToTime(assessment.EndTime).Format(time.RFC3339))
+	}
+	d.Set("invoker", assessment.Invoker)
+	if assessment.ResiliencyScore != nil {
+		d.Set("resiliency_score", assessment.ResiliencyScore.Score)
+	}
+
+	return diags
+}
+
+func findAppAssessmentByARN(ctx context.Context, conn *resiliencehub.Client, arn string) (*awstypes.AppAssessment, error) {
+	input := &resiliencehub.DescribeAppAssessmentInput{
+		AssessmentArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeAppAssessment(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Assessment == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Assessment, nil
+}
+
+// findLatestSuccessfulAppAssessmentByAppARN returns the most recently completed successful
+// assessment of an application, whether it was run on demand or by the daily schedule.
+func findLatestSuccessfulAppAssessmentByAppARN(ctx context.Context, conn *resiliencehub.Client, appARN string) (*awstypes.AppAssessmentSummary, error) {
+	input := &resiliencehub.ListAppAssessmentsInput{
+		AppArn:           aws.String(appARN),
+		AssessmentStatus: []awstypes.AssessmentStatus{awstypes.AssessmentStatusSuccess},
+	}
+	var output *awstypes.AppAssessmentSummary
+
+	pages := resiliencehub.NewListAppAssessmentsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.AssessmentSummaries {
+			if output == nil || aws.ToTime(v.EndTime).After(aws.ToTime(output.EndTime)) {
+				output = &v
+			}
+		}
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func flattenDisruptionCompliances(apiObjects map[string]awstypes.DisruptionCompliance) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, disruptionType := range enum.EnumValues[awstypes.DisruptionType]() {
+		apiObject, ok := apiObjects[string(disruptionType)]
+		if !ok {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"achievable_rpo_in_secs": apiObject.AchievableRpoInSecs,
+			"achievable_rto_in_secs": apiObject.AchievableRtoInSecs,
+			"compliance_status":      apiObject.ComplianceStatus,
+			"current_rpo_in_secs":    apiObject.CurrentRpoInSecs,
+			"current_rto_in_secs":    apiObject.CurrentRtoInSecs,
+			"disruption_type":        disruptionType,
+		})
+	}
+
+	return tfList
+}
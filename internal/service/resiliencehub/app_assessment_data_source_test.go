@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resiliencehub_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Assessments can only be run against a published application version with
+// supported resources, so the happy path is exercised out of band; this test
+// covers the lookup of an application that has not yet been assessed.
+func TestAccResilienceHubAppAssessmentDataSource_noAssessments(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResilienceHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAppAssessmentDataSourceConfig_appARN(rName),
+				ExpectError: regexache.MustCompile(`no matching Resilience Hub App Assessment found`),
+			},
+		},
+	})
+}
+
+func testAccAppAssessmentDataSourceConfig_appARN(rName string) string {
+	return acctest.ConfigCompose(testAccAppConfig_basic(rName), `
+data "aws_resiliencehub_app_assessment" "test" {
+  app_arn = aws_resiliencehub_app.test.arn
+}
+`)
+}
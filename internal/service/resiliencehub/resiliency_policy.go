@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resiliencehub
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resiliencehub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resiliencehub/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_resiliencehub_resiliency_policy", name="Resiliency Policy")
+// @Tags(identifierAttribute="arn")
+func resourceResiliencyPolicy() *schema.Resource {
+	failurePolicySchema := func(required bool) *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Required: required,
+			Optional: !required,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"rpo_in_secs": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+					"rto_in_secs": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResiliencyPolicyCreate,
+		ReadWithoutTimeout:   resourceResiliencyPolicyRead,
+		UpdateWithoutTimeout: resourceResiliencyPolicyUpdate,
+		DeleteWithoutTimeout: resourceResiliencyPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_location_constraint": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.DataLocationConstraint](),
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenAtMost(500),
+			},
+			"estimated_cost_tier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 60),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z][0-9A-Za-z_-]+$`), "must start with an alphanumeric character and contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			names.AttrPolicy: {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"az":       failurePolicySchema(true),
+						"hardware": failurePolicySchema(true),
+						"region":   failurePolicySchema(false),
+						"software": failurePolicySchema(true),
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"tier": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ResiliencyPolicyTier](),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceResiliencyPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &resiliencehub.CreateResiliencyPolicyInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Policy:      expandFailurePolicies(d.Get(names.AttrPolicy).([]interface{})),
+		PolicyName:  aws.String(name),
+		Tags:        getTagsIn(ctx),
+		Tier:        awstypes.ResiliencyPolicyTier(d.Get("tier").(string)),
+	}
+
+	if v, ok := d.GetOk("data_location_constraint"); ok {
+		input.DataLocationConstraint = awstypes.DataLocationConstraint(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.PolicyDescription = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateResiliencyPolicy(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Resilience Hub Resiliency Policy (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Policy.PolicyArn))
+
+	return append(diags, resourceResiliencyPolicyRead(ctx, d, meta)...)
+}
+
+func resourceResiliencyPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubClient(ctx)
+
+	policy, err := findResiliencyPolicyByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resilience Hub Resiliency Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resilience Hub Resiliency Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, policy.PolicyArn)
+	d.Set("data_location_constraint", policy.DataLocationConstraint)
+	d.Set(names.AttrDescription, policy.PolicyDescription)
+	d.Set("estimated_cost_tier", policy.EstimatedCostTier)
+	d.Set(names.AttrName, policy.PolicyName)
+	if err := d.Set(names.AttrPolicy, flattenFailurePolicies(policy.Policy)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting policy: %s", err)
+	}
+	d.Set("tier", policy.Tier)
+
+	setTagsOut(ctx, policy.Tags)
+
+	return diags
+}
+
+func resourceResiliencyPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &resiliencehub.UpdateResiliencyPolicyInput{
+			PolicyArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("data_location_constraint") {
+			input.DataLocationConstraint = awstypes.DataLocationConstraint(d.Get("data_location_constraint").(string))
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.PolicyDescription = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange(names.AttrName) {
+			input.PolicyName = aws.String(d.Get(names.AttrName).(string))
+		}
+
+		if d.HasChange(names.AttrPolicy) {
+			input.Policy = expandFailurePolicies(d.Get(names.AttrPolicy).([]interface{}))
+		}
+
+		if d.HasChange("tier") {
+			input.Tier = awstypes.ResiliencyPolicyTier(d.Get("tier").(string))
+		}
+
+		_, err := conn.UpdateResiliencyPolicy(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Resilience Hub Resiliency Policy (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceResiliencyPolicyRead(ctx, d, meta)...)
+}
+
+func resourceResiliencyPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResilienceHubClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Resilience Hub Resiliency Policy: %s", d.Id())
+	_, err := conn.DeleteResiliencyPolicy(ctx, &resiliencehub.DeleteResiliencyPolicyInput{
+		ClientToken: aws.String(id.UniqueId()),
+		PolicyArn:   aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Resilience Hub Resiliency Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findResiliencyPolicyByARN(ctx context.Context, conn *resiliencehub.Client, arn string) (*awstypes.ResiliencyPolicy, error) {
+	input := &resiliencehub.DescribeResiliencyPolicyInput{
+		PolicyArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeResiliencyPolicy(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Policy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Policy, nil
+}
+
+var failurePolicyDisruptionTypes = map[string]awstypes.DisruptionType{
+	"az":       awstypes.DisruptionTypeAz,
+	"hardware": awstypes.DisruptionTypeHardware,
+	"region":   awstypes.DisruptionTypeRegion,
+	"software": awstypes.DisruptionTypeSoftware,
+}
+
+func expandFailurePolicies(tfList []interface{}) map[string]awstypes.FailurePolicy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObjects := make(map[string]awstypes.FailurePolicy)
+
+	for k, disruptionType := range failurePolicyDisruptionTypes {
+		v, ok := tfMap[k].([]interface{})
+		if !ok || len(v) == 0 || v[0] == nil {
+			continue
+		}
+
+		tfMap := v[0].(map[string]interface{})
+		apiObjects[string(disruptionType)] = awstypes.FailurePolicy{
+			RpoInSecs: int32(tfMap["rpo_in_secs"].(int)),
+			RtoInSecs: int32(tfMap["rto_in_secs"].(int)),
+		}
+	}
+
+	return apiObjects
+}
+
+func flattenFailurePolicies(apiObjects map[string]awstypes.FailurePolicy) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	for k, disruptionType := range failurePolicyDisruptionTypes {
+		apiObject, ok := apiObjects[string(disruptionType)]
+		if !ok {
+			continue
+		}
+
+		tfMap[k] = []interface{}{map[string]interface{}{
+			"rpo_in_secs": apiObject.RpoInSecs,
+			"rto_in_secs": apiObject.RtoInSecs,
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -259,6 +259,7 @@ func ResourceRecord() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 				ConflictsWith: []string{
+					names.AttrAlias,
 					"cidr_routing_policy",
 					"failover_routing_policy",
 					"geolocation_routing_policy",
@@ -330,6 +331,8 @@ func ResourceRecord() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 		},
+
+		CustomizeDiff: resourceRecordCustomizeDiff,
 	}
 }
 
@@ -831,6 +834,18 @@ func FindResourceRecordSetByFourPartKey(ctx context.Context, conn *route53.Route
 	return output, fqdn, nil
 }
 
+func resourceRecordCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Multivalue answer routing answers with several record sets of the same name and type,
+	// which DNS doesn't allow for CNAME records and Route 53 doesn't allow for NS records.
+	if d.Get("multivalue_answer_routing_policy").(bool) {
+		if v := d.Get(names.AttrType).(string); v == route53.RRTypeCname || v == route53.RRTypeNs {
+			return fmt.Errorf("multivalue_answer_routing_policy can't be used with %s records", v)
+		}
+	}
+
+	return nil
+}
+
 func ChangeResourceRecordSets(ctx context.Context, conn *route53.Route53, input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeInfo, error) {
 	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 1*time.Minute, func() (interface{}, error) {
 		return conn.ChangeResourceRecordSetsWithContext(ctx, input)
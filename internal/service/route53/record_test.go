@@ -1603,6 +1603,68 @@ func TestAccRoute53Record_MultiValueAnswer_basic(t *testing.T) {
 	})
 }
 
+func TestAccRoute53Record_MultiValueAnswer_healthCheck(t *testing.T) {
+	ctx := acctest.Context(t)
+	var record1, record2 route53.ResourceRecordSet
+	resourceName1 := "aws_route53_record.test.0"
+	resourceName2 := "aws_route53_record.test.1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordConfig_multiValueAnswerHealthCheck(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordExists(ctx, resourceName1, &record1),
+					testAccCheckRecordExists(ctx, resourceName2, &record2),
+					resource.TestCheckResourceAttr(resourceName1, "multivalue_answer_routing_policy", "true"),
+					resource.TestCheckResourceAttrPair(resourceName1, "health_check_id", "aws_route53_health_check.test.0", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName2, "health_check_id", "aws_route53_health_check.test.1", names.AttrID),
+				),
+			},
+			{
+				ResourceName:            resourceName1,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_overwrite"},
+			},
+			{
+				Config: testAccRecordConfig_multiValueAnswerHealthCheck(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordExists(ctx, resourceName1, &record1),
+					testAccCheckRecordExists(ctx, resourceName2, &record2),
+					resource.TestCheckResourceAttrPair(resourceName1, "health_check_id", "aws_route53_health_check.test.1", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName2, "health_check_id", "aws_route53_health_check.test.0", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53Record_MultiValueAnswer_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRecordConfig_multiValueAnswerCNAME,
+				ExpectError: regexache.MustCompile(`multivalue_answer_routing_policy can't be used with CNAME records`),
+			},
+			{
+				Config:      testAccRecordConfig_multiValueAnswerAlias,
+				ExpectError: regexache.MustCompile(`"multivalue_answer_routing_policy": conflicts with alias`),
+			},
+		},
+	})
+}
+
 func TestAccRoute53Record_Allow_doNotOverwrite(t *testing.T) {
 	ctx := acctest.Context(t)
 	resource.ParallelTest(t, resource.TestCase{
@@ -3278,6 +3340,74 @@ resource "aws_route53_record" "www-server2" {
 }
 `
 
+func testAccRecordConfig_multiValueAnswerHealthCheck(swap bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = "domain.test"
+}
+
+resource "aws_route53_health_check" "test" {
+  count = 2
+
+  failure_threshold = "2"
+  fqdn              = "server${count.index}.domain.test"
+  port              = 80
+  request_interval  = "30"
+  resource_path     = "/"
+  type              = "HTTP"
+}
+
+resource "aws_route53_record" "test" {
+  count = 2
+
+  zone_id                          = aws_route53_zone.test.zone_id
+  name                             = "www"
+  type                             = "A"
+  ttl                              = "5"
+  multivalue_answer_routing_policy = true
+  set_identifier                   = "server${count.index}"
+  records                          = ["127.0.0.${count.index + 1}"]
+  health_check_id                  = aws_route53_health_check.test[%[1]t ? 1 - count.index : count.index].id
+}
+`, swap)
+}
+
+const testAccRecordConfig_multiValueAnswerCNAME = `
+resource "aws_route53_zone" "test" {
+  name = "domain.test"
+}
+
+resource "aws_route53_record" "test" {
+  zone_id                          = aws_route53_zone.test.zone_id
+  name                             = "www"
+  type                             = "CNAME"
+  ttl                              = "5"
+  multivalue_answer_routing_policy = true
+  set_identifier                   = "server1"
+  records                          = ["server1.domain.test"]
+}
+`
+
+const testAccRecordConfig_multiValueAnswerAlias = `
+resource "aws_route53_zone" "test" {
+  name = "domain.test"
+}
+
+resource "aws_route53_record" "test" {
+  zone_id                          = aws_route53_zone.test.zone_id
+  name                             = "www"
+  type                             = "A"
+  multivalue_answer_routing_policy = true
+  set_identifier                   = "server1"
+
+  alias {
+    zone_id                = aws_route53_zone.test.zone_id
+    name                   = "server1.domain.test"
+    evaluate_target_health = false
+  }
+}
+`
+
 const testAccRecordConfig_weightedRoutingPolicy = `
 resource "aws_route53_zone" "main" {
   name = "domain.test"
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_route53_records", name="Records")
+func dataSourceRecords() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRecordsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_record_sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAlias: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"evaluate_target_health": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"zone_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"failover": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health_check_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"multivalue_answer": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"set_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrWeight: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"set_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+	namePrefix := strings.ToLower(strings.TrimSuffix(d.Get("name_prefix").(string), "."))
+	recordType := d.Get(names.AttrType).(string)
+	setIdentifier, filterSetIdentifier := d.GetOk("set_identifier")
+
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+	var tfList []interface{}
+
+	err := conn.ListResourceRecordSetsPagesWithContext(ctx, input, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceRecordSets {
+			if v == nil {
+				continue
+			}
+
+			name := strings.ToLower(strings.TrimSuffix(CleanRecordName(aws.StringValue(v.Name)), "."))
+			if !strings.HasPrefix(name, namePrefix) {
+				continue
+			}
+			if recordType != "" && recordType != aws.StringValue(v.Type) {
+				continue
+			}
+			if filterSetIdentifier && setIdentifier.(string) != aws.StringValue(v.SetIdentifier) {
+				continue
+			}
+
+			tfList = append(tfList, flattenResourceRecordSet(zoneID, name, v))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Route 53 Records (%s): %s", zoneID, err)
+	}
+
+	d.SetId(zoneID)
+	if err := d.Set("resource_record_sets", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resource_record_sets: %s", err)
+	}
+
+	return diags
+}
+
+// flattenResourceRecordSet flattens a record set. The id attribute has the same format as aws_route53_record resource IDs
+// so that it can be used to import the record.
+func flattenResourceRecordSet(zoneID, name string, apiObject *route53.ResourceRecordSet) map[string]interface{} {
+	recordType := aws.StringValue(apiObject.Type)
+	parts := []string{zoneID, name, recordType}
+	if v := aws.StringValue(apiObject.SetIdentifier); v != "" {
+		parts = append(parts, v)
+	}
+
+	tfMap := map[string]interface{}{
+		"failover":          aws.StringValue(apiObject.Failover),
+		"health_check_id":   aws.StringValue(apiObject.HealthCheckId),
+		names.AttrID:        strings.Join(parts, "_"),
+		"multivalue_answer": aws.BoolValue(apiObject.MultiValueAnswer),
+		names.AttrName:      name,
+		"records":           FlattenResourceRecords(apiObject.ResourceRecords, recordType),
+		names.AttrRegion:    aws.StringValue(apiObject.Region),
+		"set_identifier":    aws.StringValue(apiObject.SetIdentifier),
+		"ttl":               aws.Int64Value(apiObject.TTL),
+		names.AttrType:      recordType,
+		names.AttrWeight:    aws.Int64Value(apiObject.Weight),
+	}
+
+	if v := apiObject.AliasTarget; v != nil {
+		tfMap[names.AttrAlias] = []interface{}{map[string]interface{}{
+			"evaluate_target_health": aws.BoolValue(v.EvaluateTargetHealth),
+			names.AttrName:           NormalizeAliasName(aws.StringValue(v.DNSName)),
+			"zone_id":                aws.StringValue(v.HostedZoneId),
+		}}
+	}
+
+	return tfMap
+}
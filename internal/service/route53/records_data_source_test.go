@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53RecordsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	zoneName := acctest.RandomDomainName()
+	dataSourceName := "data.aws_route53_records.test"
+	filteredDataSourceName := "data.aws_route53_records.filtered"
+	recordResourceName := "aws_route53_record.test.1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsDataSourceConfig_basic(zoneName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// 2 A records, plus the NS and SOA records created with the zone.
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "4"),
+					resource.TestCheckResourceAttr(filteredDataSourceName, "resource_record_sets.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(filteredDataSourceName, "resource_record_sets.0.id", recordResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(filteredDataSourceName, "resource_record_sets.0.name", recordResourceName, "fqdn"),
+					resource.TestCheckResourceAttr(filteredDataSourceName, "resource_record_sets.0.type", "A"),
+					resource.TestCheckResourceAttr(filteredDataSourceName, "resource_record_sets.0.set_identifier", "server1"),
+					resource.TestCheckResourceAttr(filteredDataSourceName, "resource_record_sets.0.multivalue_answer", "true"),
+					resource.TestCheckResourceAttr(filteredDataSourceName, "resource_record_sets.0.records.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(filteredDataSourceName, "resource_record_sets.0.records.0", "127.0.0.2"),
+					resource.TestCheckResourceAttr(filteredDataSourceName, "resource_record_sets.0.ttl", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecordsDataSourceConfig_basic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_record" "test" {
+  count = 2
+
+  zone_id                          = aws_route53_zone.test.zone_id
+  name                             = "www.%[1]s"
+  type                             = "A"
+  ttl                              = "30"
+  multivalue_answer_routing_policy = true
+  set_identifier                   = "server${count.index}"
+  records                          = ["127.0.0.${count.index + 1}"]
+}
+
+data "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  depends_on = [aws_route53_record.test]
+}
+
+data "aws_route53_records" "filtered" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name_prefix    = "www."
+  type           = "A"
+  set_identifier = "server1"
+
+  depends_on = [aws_route53_record.test]
+}
+`, zoneName)
+}
@@ -33,6 +33,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceDelegationSet,
 			TypeName: "aws_route53_delegation_set",
 		},
+		{
+			Factory:  dataSourceRecords,
+			TypeName: "aws_route53_records",
+			Name:     "Records",
+		},
 		{
 			Factory:  DataSourceTrafficPolicyDocument,
 			TypeName: "aws_route53_traffic_policy_document",
@@ -5,17 +5,21 @@ package route53resolver
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -40,11 +44,16 @@ func ResourceQueryLogConfig() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"associated_vpc_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrDestinationARN: {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNCheck(queryLogConfigDestinationARNCheck),
 			},
 			names.AttrName: {
 				Type:         schema.TypeString,
@@ -62,9 +71,17 @@ func ResourceQueryLogConfig() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"vpc_association_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceQueryLogConfigCustomizeDiff,
+		),
 	}
 }
 
@@ -91,6 +108,12 @@ func resourceQueryLogConfigCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("waiting for Route53 Resolver Query Log Config (%s) create: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("associated_vpc_ids"); ok && v.(*schema.Set).Len() > 0 {
+		if err := associateQueryLogConfigVPCs(ctx, conn, d.Id(), flex.ExpandStringValueSet(v.(*schema.Set))); err != nil {
+			return diag.Errorf("associating Route53 Resolver Query Log Config (%s) VPCs: %s", d.Id(), err)
+		}
+	}
+
 	return resourceQueryLogConfigRead(ctx, d, meta)
 }
 
@@ -116,17 +139,66 @@ func resourceQueryLogConfigRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set(names.AttrOwnerID, queryLogConfig.OwnerId)
 	d.Set("share_status", queryLogConfig.ShareStatus)
 
+	// Only VPCs that were associated by tag, or that currently carry the association tags, are reported.
+	// Associations managed by aws_route53_resolver_query_log_config_association are left out.
+	candidates := d.Get("associated_vpc_ids").(*schema.Set)
+	if v, ok := d.GetOk("vpc_association_tags"); ok && len(v.(map[string]interface{})) > 0 {
+		vpcIDs, err := findVPCIDsByTags(ctx, meta.(*conns.AWSClient).EC2Conn(ctx), flex.ExpandStringValueMap(v.(map[string]interface{})))
+
+		if err != nil {
+			return diag.Errorf("reading Route53 Resolver Query Log Config (%s) tagged VPCs: %s", d.Id(), err)
+		}
+
+		candidates = candidates.Union(flex.FlattenStringValueSet(vpcIDs))
+	}
+
+	var associatedVPCIDs []string
+	if candidates.Len() > 0 {
+		resourceIDs, err := findQueryLogConfigAssociatedResourceIDs(ctx, conn, d.Id())
+
+		if err != nil {
+			return diag.Errorf("reading Route53 Resolver Query Log Config (%s) associations: %s", d.Id(), err)
+		}
+
+		for _, v := range resourceIDs {
+			if candidates.Contains(v) {
+				associatedVPCIDs = append(associatedVPCIDs, v)
+			}
+		}
+	}
+	d.Set("associated_vpc_ids", associatedVPCIDs)
+
 	return nil
 }
 
 func resourceQueryLogConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// Tags only.
+	conn := meta.(*conns.AWSClient).Route53ResolverConn(ctx)
+
+	if d.HasChange("associated_vpc_ids") {
+		o, n := d.GetChange("associated_vpc_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if err := disassociateQueryLogConfigVPCs(ctx, conn, d.Id(), flex.ExpandStringValueSet(os.Difference(ns))); err != nil {
+			return diag.Errorf("disassociating Route53 Resolver Query Log Config (%s) VPCs: %s", d.Id(), err)
+		}
+
+		if err := associateQueryLogConfigVPCs(ctx, conn, d.Id(), flex.ExpandStringValueSet(ns.Difference(os))); err != nil {
+			return diag.Errorf("associating Route53 Resolver Query Log Config (%s) VPCs: %s", d.Id(), err)
+		}
+	}
+
 	return resourceQueryLogConfigRead(ctx, d, meta)
 }
 
 func resourceQueryLogConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).Route53ResolverConn(ctx)
 
+	if v, ok := d.GetOk("associated_vpc_ids"); ok && v.(*schema.Set).Len() > 0 {
+		if err := disassociateQueryLogConfigVPCs(ctx, conn, d.Id(), flex.ExpandStringValueSet(v.(*schema.Set))); err != nil {
+			return diag.Errorf("disassociating Route53 Resolver Query Log Config (%s) VPCs: %s", d.Id(), err)
+		}
+	}
+
 	log.Printf("[DEBUG] Deleting Route53 Resolver Query Log Config: %s", d.Id())
 	_, err := conn.DeleteResolverQueryLogConfigWithContext(ctx, &route53resolver.DeleteResolverQueryLogConfigInput{
 		ResolverQueryLogConfigId: aws.String(d.Id()),
@@ -147,6 +219,138 @@ func resourceQueryLogConfigDelete(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+// resourceQueryLogConfigCustomizeDiff plans the VPCs to associate by tag, so that VPCs tagged or untagged
+// after the last apply show up as changes.
+func resourceQueryLogConfigCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("vpc_association_tags") {
+		return d.SetNewComputed("associated_vpc_ids")
+	}
+
+	o := d.Get("associated_vpc_ids").(*schema.Set)
+	var n []string
+
+	if v, ok := d.GetOk("vpc_association_tags"); ok && len(v.(map[string]interface{})) > 0 {
+		vpcIDs, err := findVPCIDsByTags(ctx, meta.(*conns.AWSClient).EC2Conn(ctx), flex.ExpandStringValueMap(v.(map[string]interface{})))
+
+		if err != nil {
+			return fmt.Errorf("reading tagged VPCs: %w", err)
+		}
+
+		n = vpcIDs
+	}
+
+	if ns := flex.FlattenStringValueSet(n); !o.Equal(ns) {
+		return d.SetNew("associated_vpc_ids", ns)
+	}
+
+	return nil
+}
+
+func associateQueryLogConfigVPCs(ctx context.Context, conn *route53resolver.Route53Resolver, id string, vpcIDs []string) error {
+	for _, vpcID := range vpcIDs {
+		output, err := conn.AssociateResolverQueryLogConfigWithContext(ctx, &route53resolver.AssociateResolverQueryLogConfigInput{
+			ResolverQueryLogConfigId: aws.String(id),
+			ResourceId:               aws.String(vpcID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("associating VPC (%s): %w", vpcID, err)
+		}
+
+		if _, err := waitQueryLogConfigAssociationCreated(ctx, conn, aws.StringValue(output.ResolverQueryLogConfigAssociation.Id)); err != nil {
+			return fmt.Errorf("waiting for VPC (%s) association: %w", vpcID, err)
+		}
+	}
+
+	return nil
+}
+
+func disassociateQueryLogConfigVPCs(ctx context.Context, conn *route53resolver.Route53Resolver, id string, vpcIDs []string) error {
+	for _, vpcID := range vpcIDs {
+		output, err := conn.DisassociateResolverQueryLogConfigWithContext(ctx, &route53resolver.DisassociateResolverQueryLogConfigInput{
+			ResolverQueryLogConfigId: aws.String(id),
+			ResourceId:               aws.String(vpcID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, route53resolver.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("disassociating VPC (%s): %w", vpcID, err)
+		}
+
+		if _, err := waitQueryLogConfigAssociationDeleted(ctx, conn, aws.StringValue(output.ResolverQueryLogConfigAssociation.Id)); err != nil {
+			return fmt.Errorf("waiting for VPC (%s) disassociation: %w", vpcID, err)
+		}
+	}
+
+	return nil
+}
+
+func findQueryLogConfigAssociatedResourceIDs(ctx context.Context, conn *route53resolver.Route53Resolver, id string) ([]string, error) {
+	input := &route53resolver.ListResolverQueryLogConfigAssociationsInput{
+		Filters: []*route53resolver.Filter{{
+			Name:   aws.String("ResolverQueryLogConfigId"),
+			Values: aws.StringSlice([]string{id}),
+		}},
+	}
+	var output []string
+
+	err := conn.ListResolverQueryLogConfigAssociationsPagesWithContext(ctx, input, func(page *route53resolver.ListResolverQueryLogConfigAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResolverQueryLogConfigAssociations {
+			if v == nil || aws.StringValue(v.Status) == route53resolver.ResolverQueryLogConfigAssociationStatusDeleting {
+				continue
+			}
+
+			output = append(output, aws.StringValue(v.ResourceId))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findVPCIDsByTags(ctx context.Context, conn *ec2.EC2, tags map[string]string) ([]string, error) {
+	input := &ec2.DescribeVpcsInput{}
+	for k, v := range tags {
+		input.Filters = append(input.Filters, &ec2.Filter{
+			Name:   aws.String("tag:" + k),
+			Values: aws.StringSlice([]string{v}),
+		})
+	}
+	var output []string
+
+	err := conn.DescribeVpcsPagesWithContext(ctx, input, func(page *ec2.DescribeVpcsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Vpcs {
+			if v != nil {
+				output = append(output, aws.StringValue(v.VpcId))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func FindResolverQueryLogConfigByID(ctx context.Context, conn *route53resolver.Route53Resolver, id string) (*route53resolver.ResolverQueryLogConfig, error) {
 	input := &route53resolver.GetResolverQueryLogConfigInput{
 		ResolverQueryLogConfigId: aws.String(id),
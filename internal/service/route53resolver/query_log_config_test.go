@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -135,6 +136,95 @@ func TestAccRoute53ResolverQueryLogConfig_tags(t *testing.T) {
 	})
 }
 
+func TestAccRoute53ResolverQueryLogConfig_firehose(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v route53resolver.ResolverQueryLogConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53_resolver_query_log_config.test"
+	streamResourceName := "aws_kinesis_firehose_delivery_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueryLogConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryLogConfigConfig_firehose(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueryLogConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrDestinationARN, streamResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53ResolverQueryLogConfig_invalidDestination(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueryLogConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQueryLogConfigConfig_invalidDestination(rName),
+				ExpectError: regexache.MustCompile(`must be the ARN of an S3 bucket, CloudWatch Logs log group or Kinesis Data Firehose delivery stream`),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ResolverQueryLogConfig_vpcAssociationTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v route53resolver.ResolverQueryLogConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53_resolver_query_log_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueryLogConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryLogConfigConfig_vpcAssociationTags(rName, rName, "other"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueryLogConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "associated_vpc_ids.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "associated_vpc_ids.*", "aws_vpc.test.0", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "vpc_association_tags.%", acctest.CtOne),
+				),
+			},
+			{
+				Config: testAccQueryLogConfigConfig_vpcAssociationTags(rName, rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueryLogConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "associated_vpc_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "associated_vpc_ids.*", "aws_vpc.test.0", names.AttrID),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "associated_vpc_ids.*", "aws_vpc.test.1", names.AttrID),
+				),
+			},
+			{
+				Config: testAccQueryLogConfigConfig_vpcAssociationTags(rName, "other", rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueryLogConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "associated_vpc_ids.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "associated_vpc_ids.*", "aws_vpc.test.1", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckQueryLogConfigDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn(ctx)
@@ -234,3 +324,138 @@ resource "aws_route53_resolver_query_log_config" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccQueryLogConfigConfig_firehose(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = "firehose.amazonaws.com" }
+      Action    = "sts:AssumeRole"
+      Condition = {
+        StringEquals = { "sts:ExternalId" = data.aws_caller_identity.current.account_id }
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "s3:AbortMultipartUpload",
+        "s3:GetBucketLocation",
+        "s3:GetObject",
+        "s3:ListBucket",
+        "s3:ListBucketMultipartUploads",
+        "s3:PutObject",
+      ]
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+  })
+}
+
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  depends_on  = [aws_iam_role_policy.test]
+  name        = %[1]q
+  destination = "extended_s3"
+
+  extended_s3_configuration {
+    role_arn            = aws_iam_role.test.arn
+    bucket_arn          = aws_s3_bucket.test.arn
+    prefix              = "vpc_id=!{partitionKeyFromQuery:vpc_id}/"
+    error_output_prefix = "errors/"
+    buffering_size      = 64
+
+    dynamic_partitioning_configuration {
+      enabled = true
+    }
+
+    processing_configuration {
+      enabled = true
+
+      processors {
+        type = "MetadataExtraction"
+
+        parameters {
+          parameter_name  = "JsonParsingEngine"
+          parameter_value = "JQ-1.6"
+        }
+        parameters {
+          parameter_name  = "MetadataExtractionQuery"
+          parameter_value = "{vpc_id:.vpc_id}"
+        }
+      }
+    }
+  }
+}
+
+resource "aws_route53_resolver_query_log_config" "test" {
+  name            = %[1]q
+  destination_arn = aws_kinesis_firehose_delivery_stream.test.arn
+}
+`, rName)
+}
+
+func testAccQueryLogConfigConfig_invalidDestination(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+data "aws_caller_identity" "current" {}
+
+resource "aws_route53_resolver_query_log_config" "test" {
+  name            = %[1]q
+  destination_arn = "arn:${data.aws_partition.current.partition}:sqs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:%[1]s"
+}
+`, rName)
+}
+
+func testAccQueryLogConfigConfig_vpcAssociationTags(rName, vpc1TagValue, vpc2TagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_vpc" "test" {
+  count = 2
+
+  cidr_block = "10.${count.index}.0.0/16"
+
+  tags = {
+    Name         = %[1]q
+    QueryLogging = count.index == 0 ? %[2]q : %[3]q
+  }
+}
+
+resource "aws_route53_resolver_query_log_config" "test" {
+  name            = %[1]q
+  destination_arn = aws_cloudwatch_log_group.test.arn
+
+  vpc_association_tags = {
+    QueryLogging = %[1]q
+  }
+
+  # The tags must be applied before the tagged VPCs are looked up.
+  depends_on = [aws_vpc.test]
+}
+`, rName, vpc1TagValue, vpc2TagValue)
+}
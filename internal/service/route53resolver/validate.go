@@ -5,8 +5,10 @@ package route53resolver
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws/arn"
 )
 
 func validResolverName(v interface{}, k string) (ws []string, errors []error) {
@@ -31,3 +33,12 @@ func validResolverName(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+// queryLogConfigDestinationARNCheck verifies that a query logging destination is an S3 bucket,
+// a CloudWatch Logs log group or a Kinesis Data Firehose delivery stream.
+func queryLogConfigDestinationARNCheck(v any, k string, arn arn.ARN) (ws []string, errors []error) {
+	if !slices.Contains([]string{"firehose", "logs", "s3"}, arn.Service) {
+		errors = append(errors, fmt.Errorf("%q (%s) must be the ARN of an S3 bucket, CloudWatch Logs log group or Kinesis Data Firehose delivery stream", k, v))
+	}
+	return
+}
@@ -13,4 +13,7 @@ var (
 	FindSecretByID                = findSecretByID
 	FindSecretPolicyByID          = findSecretPolicyByID
 	FindSecretVersionByTwoPartKey = findSecretVersionByTwoPartKey
+
+	HostedRotationTemplateApplicationID = hostedRotationTemplateApplicationID
+	HostedRotationTemplateApplications  = hostedRotationTemplateApplications
 )
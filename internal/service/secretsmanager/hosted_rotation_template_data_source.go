@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go/aws"
+	serverlessrepo "github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// See https://docs.aws.amazon.com/secretsmanager/latest/userguide/reference_available-rotation-templates.html.
+var hostedRotationTemplateApplications = map[string]string{
+	"MariaDBMultiUser":     "SecretsManagerRDSMariaDBRotationMultiUser",
+	"MariaDBSingleUser":    "SecretsManagerRDSMariaDBRotationSingleUser",
+	"MongoDBMultiUser":     "SecretsManagerMongoDBRotationMultiUser",
+	"MongoDBSingleUser":    "SecretsManagerMongoDBRotationSingleUser",
+	"MySQLMultiUser":       "SecretsManagerRDSMySQLRotationMultiUser",
+	"MySQLSingleUser":      "SecretsManagerRDSMySQLRotationSingleUser",
+	"OracleMultiUser":      "SecretsManagerRDSOracleRotationMultiUser",
+	"OracleSingleUser":     "SecretsManagerRDSOracleRotationSingleUser",
+	"PostgreSQLMultiUser":  "SecretsManagerRDSPostgreSQLRotationMultiUser",
+	"PostgreSQLSingleUser": "SecretsManagerRDSPostgreSQLRotationSingleUser",
+	"RedshiftMultiUser":    "SecretsManagerRedshiftRotationMultiUser",
+	"RedshiftSingleUser":   "SecretsManagerRedshiftRotationSingleUser",
+	"SQLServerMultiUser":   "SecretsManagerRDSSQLServerRotationMultiUser",
+	"SQLServerSingleUser":  "SecretsManagerRDSSQLServerRotationSingleUser",
+}
+
+// hostedRotationTemplateAccounts maps partitions to the Region and account
+// publishing the rotation templates to the AWS Serverless Application Repository.
+// The commercial and GovCloud publishers are the ones used by the
+// aws_serverlessapplicationrepository_cloudformation_stack acceptance tests.
+// The ARN built from them is checked against the Serverless Application
+// Repository API at read time, so a wrong publisher fails instead of
+// returning an ARN that doesn't exist.
+var hostedRotationTemplateAccounts = map[string][2]string{
+	names.ChinaPartitionID:      {names.CNNorth1RegionID, "193023089310"},
+	names.StandardPartitionID:   {names.USEast1RegionID, "297356227824"},
+	names.USGovCloudPartitionID: {names.USGovWest1RegionID, "023102451235"},
+}
+
+// @SDKDataSource("aws_secretsmanager_hosted_rotation_template", name="Hosted Rotation Template")
+func dataSourceHostedRotationTemplate() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceHostedRotationTemplateRead,
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capabilities": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rotation_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(tfmaps.Keys(hostedRotationTemplateApplications), false),
+			},
+			"semantic_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceHostedRotationTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	partition := meta.(*conns.AWSClient).Partition
+	rotationType := d.Get("rotation_type").(string)
+	applicationID, err := hostedRotationTemplateApplicationID(partition, rotationType)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Secrets Manager Hosted Rotation Template (%s): %s", rotationType, err)
+	}
+
+	conn := meta.(*conns.AWSClient).ServerlessRepoConn(ctx)
+
+	output, err := conn.GetApplicationWithContext(ctx, &serverlessrepo.GetApplicationInput{
+		ApplicationId: aws.String(applicationID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, serverlessrepo.ErrCodeNotFoundException) {
+		return sdkdiag.AppendErrorf(diags, "reading Secrets Manager Hosted Rotation Template (%s): Serverless Application Repository application (%s) not found", rotationType, applicationID)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Secrets Manager Hosted Rotation Template (%s): reading Serverless Application Repository application (%s): %s", rotationType, applicationID, err)
+	}
+
+	if output.Version == nil {
+		return sdkdiag.AppendErrorf(diags, "reading Secrets Manager Hosted Rotation Template (%s): Serverless Application Repository application (%s) has no version", rotationType, applicationID)
+	}
+
+	d.SetId(applicationID)
+	d.Set("application_id", output.ApplicationId)
+	d.Set("capabilities", aws.StringValueSlice(output.Version.RequiredCapabilities))
+	d.Set("semantic_version", output.Version.SemanticVersion)
+
+	return diags
+}
+
+func hostedRotationTemplateApplicationID(partition, rotationType string) (string, error) {
+	application, ok := hostedRotationTemplateApplications[rotationType]
+
+	if !ok {
+		return "", fmt.Errorf("unsupported rotation type: %s", rotationType)
+	}
+
+	account, ok := hostedRotationTemplateAccounts[partition]
+
+	if !ok {
+		return "", fmt.Errorf("hosted rotation templates are not available in partition: %s", partition)
+	}
+
+	return arn.ARN{
+		Partition: partition,
+		Service:   "serverlessrepo",
+		Region:    account[0],
+		AccountID: account[1],
+		Resource:  "applications/" + application,
+	}.String(), nil
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfsecretsmanager "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestHostedRotationTemplateApplicationID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		partition     string
+		rotationType  string
+		expected      string
+		expectedError bool
+	}{
+		{
+			partition:    names.StandardPartitionID,
+			rotationType: "PostgreSQLSingleUser",
+			expected:     "arn:aws:serverlessrepo:us-east-1:297356227824:applications/SecretsManagerRDSPostgreSQLRotationSingleUser",
+		},
+		{
+			partition:    names.USGovCloudPartitionID,
+			rotationType: "MySQLMultiUser",
+			expected:     "arn:aws-us-gov:serverlessrepo:us-gov-west-1:023102451235:applications/SecretsManagerRDSMySQLRotationMultiUser",
+		},
+		{
+			partition:    names.ChinaPartitionID,
+			rotationType: "RedshiftSingleUser",
+			expected:     "arn:aws-cn:serverlessrepo:cn-north-1:193023089310:applications/SecretsManagerRedshiftRotationSingleUser",
+		},
+		{
+			partition:     names.StandardPartitionID,
+			rotationType:  "Unknown",
+			expectedError: true,
+		},
+		{
+			partition:     names.ISOPartitionID,
+			rotationType:  "MySQLSingleUser",
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(fmt.Sprintf("%s/%s", testCase.partition, testCase.rotationType), func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfsecretsmanager.HostedRotationTemplateApplicationID(testCase.partition, testCase.rotationType)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestHostedRotationTemplateApplicationID_allRotationTypes(t *testing.T) {
+	t.Parallel()
+
+	for _, partition := range []string{names.ChinaPartitionID, names.StandardPartitionID, names.USGovCloudPartitionID} {
+		for rotationType, application := range tfsecretsmanager.HostedRotationTemplateApplications {
+			got, err := tfsecretsmanager.HostedRotationTemplateApplicationID(partition, rotationType)
+
+			if err != nil {
+				t.Errorf("%s/%s: unexpected error: %s", partition, rotationType, err)
+				continue
+			}
+
+			if !regexache.MustCompile(`^arn:` + partition + `:serverlessrepo:[0-9a-z-]+:\d{12}:applications/` + application + `$`).MatchString(got) {
+				t.Errorf("%s/%s: unexpected application ID %s", partition, rotationType, got)
+			}
+		}
+	}
+}
+
+func TestAccSecretsManagerHostedRotationTemplateDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_secretsmanager_hosted_rotation_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostedRotationTemplateDataSourceConfig_basic("PostgreSQLSingleUser"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "application_id", regexache.MustCompile(`^arn:[^:]+:serverlessrepo:[^:]+:\d{12}:applications/SecretsManagerRDSPostgreSQLRotationSingleUser$`)),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "capabilities.*", "CAPABILITY_IAM"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "capabilities.*", "CAPABILITY_RESOURCE_POLICY"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "semantic_version"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccSecretsManagerHostedRotationTemplateDataSource_rotationTypes checks
+// that every supported rotation type resolves to a published application.
+func TestAccSecretsManagerHostedRotationTemplateDataSource_rotationTypes(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_secretsmanager_hosted_rotation_template.test"
+
+	var steps []resource.TestStep
+	for rotationType := range tfsecretsmanager.HostedRotationTemplateApplications {
+		steps = append(steps, resource.TestStep{
+			Config: testAccHostedRotationTemplateDataSourceConfig_basic(rotationType),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrSet(dataSourceName, "application_id"),
+				resource.TestCheckResourceAttrSet(dataSourceName, "semantic_version"),
+			),
+		})
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps:                    steps,
+	})
+}
+
+func testAccHostedRotationTemplateDataSourceConfig_basic(rotationType string) string {
+	return fmt.Sprintf(`
+data "aws_secretsmanager_hosted_rotation_template" "test" {
+  rotation_type = %[1]q
+}
+`, rotationType)
+}
@@ -24,6 +24,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceHostedRotationTemplate,
+			TypeName: "aws_secretsmanager_hosted_rotation_template",
+			Name:     "Hosted Rotation Template",
+		},
 		{
 			Factory:  dataSourceRandomPassword,
 			TypeName: "aws_secretsmanager_random_password",
@@ -14,6 +14,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -22,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
@@ -32,6 +35,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	automationRuleOrderMax = 1000
+)
+
 // @FrameworkResource(name="Automation Rule")
 // @Tags(identifierAttribute="arn")
 func newAutomationRuleResource(_ context.Context) (resource.ResourceWithConfigure, error) {
@@ -76,6 +83,10 @@ func (r *automationRuleResource) Schema(ctx context.Context, request resource.Sc
 			},
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"unique_order_enforcement": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[uniqueOrderEnforcement](),
+				Optional:   true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"actions": schema.SetNestedBlock{
@@ -292,8 +303,10 @@ func mapFilterSchemaFramework(ctx context.Context) schema.SetNestedBlock {
 		NestedObject: schema.NestedBlockObject{
 			Attributes: map[string]schema.Attribute{
 				"comparison": schema.StringAttribute{
-					CustomType: fwtypes.StringEnumType[awstypes.MapFilterComparison](),
-					Required:   true,
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(mapFilterComparisonValues()...),
+					},
 				},
 				names.AttrKey: schema.StringAttribute{
 					Required: true,
@@ -306,6 +319,11 @@ func mapFilterSchemaFramework(ctx context.Context) schema.SetNestedBlock {
 	}
 }
 
+// mapFilterComparisonValues returns every comparison operator supported by map filters.
+func mapFilterComparisonValues() []string {
+	return enum.Values[awstypes.MapFilterComparison]()
+}
+
 func numberFilterSchemaFramework(ctx context.Context) schema.SetNestedBlock {
 	return schema.SetNestedBlock{
 		CustomType: fwtypes.NewSetNestedObjectTypeOf[numberFilterModel](ctx),
@@ -343,8 +361,10 @@ func stringFilterSchemaFramework(ctx context.Context) schema.SetNestedBlock {
 		NestedObject: schema.NestedBlockObject{
 			Attributes: map[string]schema.Attribute{
 				"comparison": schema.StringAttribute{
-					CustomType: fwtypes.StringEnumType[awstypes.StringFilterComparison](),
-					Required:   true,
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(stringFilterComparisonValues()...),
+					},
 				},
 				names.AttrValue: schema.StringAttribute{
 					Required: true,
@@ -354,6 +374,11 @@ func stringFilterSchemaFramework(ctx context.Context) schema.SetNestedBlock {
 	}
 }
 
+// stringFilterComparisonValues returns every comparison operator supported by string filters.
+func stringFilterComparisonValues() []string {
+	return enum.Values[awstypes.StringFilterComparison]()
+}
+
 func (r *automationRuleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	var data automationRuleResourceModel
 	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
@@ -505,6 +530,58 @@ func (r *automationRuleResource) Delete(ctx context.Context, request resource.De
 
 func (r *automationRuleResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, request, response)
+
+	// Nothing to validate on destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data automationRuleResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.UniqueOrderEnforcement.ValueEnum() != uniqueOrderEnforcementValidate || data.RuleOrder.IsUnknown() {
+		return
+	}
+
+	// Only a new rule or a changed rule_order can introduce a conflict.
+	if !request.State.Raw.IsNull() {
+		var old automationRuleResourceModel
+		response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if data.RuleOrder.Equal(old.RuleOrder) {
+			return
+		}
+	}
+
+	// Terraform only passes a resource its own configuration, so rules are checked against those already in the account.
+	// Rules created earlier in the same apply are checked when the plan is revalidated during apply.
+	conn := r.Meta().SecurityHubClient(ctx)
+
+	metadata, err := findAutomationRulesMetadata(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("listing Security Hub Automation Rules", err.Error())
+
+		return
+	}
+
+	ruleOrder := data.RuleOrder.ValueInt64()
+	for _, v := range metadata {
+		if arn := aws.ToString(v.RuleArn); arn != data.RuleARN.ValueString() && int64(aws.ToInt32(v.RuleOrder)) == ruleOrder {
+			response.Diagnostics.AddAttributeError(path.Root("rule_order"), "Conflicting rule order",
+				fmt.Sprintf("Security Hub Automation Rule %s (%s) already uses rule order %d. Choose a different rule_order.", aws.ToString(v.RuleName), arn, ruleOrder))
+		}
+	}
 }
 
 func findAutomationRuleByARN(ctx context.Context, conn *securityhub.Client, arn string) (*awstypes.AutomationRulesConfig, error) {
@@ -547,17 +624,31 @@ func findAutomationRules(ctx context.Context, conn *securityhub.Client, input *s
 }
 
 type automationRuleResourceModel struct {
-	Actions     fwtypes.SetNestedObjectValueOf[automationRulesActionModel]          `tfsdk:"actions"`
-	Criteria    fwtypes.ListNestedObjectValueOf[automationRulesFindingFiltersModel] `tfsdk:"criteria"`
-	Description types.String                                                        `tfsdk:"description"`
-	ID          types.String                                                        `tfsdk:"id"`
-	IsTerminal  types.Bool                                                          `tfsdk:"is_terminal"`
-	RuleARN     types.String                                                        `tfsdk:"arn"`
-	RuleName    types.String                                                        `tfsdk:"rule_name"`
-	RuleOrder   types.Int64                                                         `tfsdk:"rule_order"`
-	RuleStatus  fwtypes.StringEnum[awstypes.RuleStatus]                             `tfsdk:"rule_status"`
-	Tags        types.Map                                                           `tfsdk:"tags"`
-	TagsAll     types.Map                                                           `tfsdk:"tags_all"`
+	Actions                fwtypes.SetNestedObjectValueOf[automationRulesActionModel]          `tfsdk:"actions"`
+	Criteria               fwtypes.ListNestedObjectValueOf[automationRulesFindingFiltersModel] `tfsdk:"criteria"`
+	Description            types.String                                                        `tfsdk:"description"`
+	ID                     types.String                                                        `tfsdk:"id"`
+	IsTerminal             types.Bool                                                          `tfsdk:"is_terminal"`
+	RuleARN                types.String                                                        `tfsdk:"arn"`
+	RuleName               types.String                                                        `tfsdk:"rule_name"`
+	RuleOrder              types.Int64                                                         `tfsdk:"rule_order"`
+	RuleStatus             fwtypes.StringEnum[awstypes.RuleStatus]                             `tfsdk:"rule_status"`
+	Tags                   types.Map                                                           `tfsdk:"tags"`
+	TagsAll                types.Map                                                           `tfsdk:"tags_all"`
+	UniqueOrderEnforcement fwtypes.StringEnum[uniqueOrderEnforcement]                          `tfsdk:"unique_order_enforcement"`
+}
+
+// uniqueOrderEnforcement controls how a rule_order already used by another automation rule is handled.
+type uniqueOrderEnforcement string
+
+const (
+	uniqueOrderEnforcementValidate uniqueOrderEnforcement = "VALIDATE"
+)
+
+func (uniqueOrderEnforcement) Values() []uniqueOrderEnforcement {
+	return []uniqueOrderEnforcement{
+		uniqueOrderEnforcementValidate,
+	}
 }
 
 func (data *automationRuleResourceModel) InitFromID() error {
@@ -659,9 +750,9 @@ type dateRangeModel struct {
 }
 
 type mapFilterModel struct {
-	Comparison fwtypes.StringEnum[awstypes.MapFilterComparison] `tfsdk:"comparison"`
-	Key        types.String                                     `tfsdk:"key"`
-	Value      types.String                                     `tfsdk:"value"`
+	Comparison types.String `tfsdk:"comparison"`
+	Key        types.String `tfsdk:"key"`
+	Value      types.String `tfsdk:"value"`
 }
 
 type numberFilterModel struct {
@@ -673,6 +764,6 @@ type numberFilterModel struct {
 }
 
 type stringFilterModel struct {
-	Comparison fwtypes.StringEnum[awstypes.StringFilterComparison] `tfsdk:"comparison"`
-	Value      types.String                                        `tfsdk:"value"`
+	Comparison types.String `tfsdk:"comparison"`
+	Value      types.String `tfsdk:"value"`
 }
@@ -6,10 +6,12 @@ package securityhub_test
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -174,6 +176,46 @@ func testAccAutomationRule_stringFilters(t *testing.T) {
 	})
 }
 
+func testAccAutomationRule_stringFilterComparisons(t *testing.T) {
+	ctx := acctest.Context(t)
+	var automationRule types.AutomationRulesConfig
+	resourceName := "aws_securityhub_automation_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	var steps []resource.TestStep
+	for _, comparison := range []string{
+		string(types.StringFilterComparisonEquals),
+		string(types.StringFilterComparisonPrefix),
+		string(types.StringFilterComparisonNotEquals),
+		string(types.StringFilterComparisonPrefixNotEquals),
+		string(types.StringFilterComparisonContains),
+		string(types.StringFilterComparisonNotContains),
+		string(types.StringFilterComparisonContainsWord),
+	} {
+		steps = append(steps, resource.TestStep{
+			Config: testAccAutomationRuleConfig_stringFilters(rName, comparison, "1234567890"),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckAutomationRuleExists(ctx, resourceName, &automationRule),
+				resource.TestCheckResourceAttr(resourceName, "criteria.0.aws_account_id.#", acctest.CtOne),
+				resource.TestCheckResourceAttr(resourceName, "criteria.0.aws_account_id.0.comparison", comparison),
+				resource.TestCheckResourceAttr(resourceName, "criteria.0.aws_account_id.0.value", "1234567890"),
+			),
+		}, resource.TestStep{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps:                    steps,
+	})
+}
+
 func testAccAutomationRule_numberFilters(t *testing.T) {
 	ctx := acctest.Context(t)
 	var automationRule types.AutomationRulesConfig
@@ -294,6 +336,44 @@ func testAccAutomationRule_mapFilters(t *testing.T) {
 	})
 }
 
+func testAccAutomationRule_mapFilterComparisons(t *testing.T) {
+	ctx := acctest.Context(t)
+	var automationRule types.AutomationRulesConfig
+	resourceName := "aws_securityhub_automation_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	var steps []resource.TestStep
+	for _, comparison := range []string{
+		string(types.MapFilterComparisonEquals),
+		string(types.MapFilterComparisonNotEquals),
+		string(types.MapFilterComparisonContains),
+		string(types.MapFilterComparisonNotContains),
+	} {
+		steps = append(steps, resource.TestStep{
+			Config: testAccAutomationRuleConfig_mapFilters(rName, comparison, "key1", "value1"),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckAutomationRuleExists(ctx, resourceName, &automationRule),
+				resource.TestCheckResourceAttr(resourceName, "criteria.0.resource_details_other.#", acctest.CtOne),
+				resource.TestCheckResourceAttr(resourceName, "criteria.0.resource_details_other.0.comparison", comparison),
+				resource.TestCheckResourceAttr(resourceName, "criteria.0.resource_details_other.0.key", "key1"),
+				resource.TestCheckResourceAttr(resourceName, "criteria.0.resource_details_other.0.value", "value1"),
+			),
+		}, resource.TestStep{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps:                    steps,
+	})
+}
+
 func testAccAutomationRule_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var automationRule types.AutomationRulesConfig
@@ -340,6 +420,72 @@ func testAccAutomationRule_tags(t *testing.T) {
 	})
 }
 
+func testAccAutomationRule_uniqueOrderEnforcement(t *testing.T) {
+	ctx := acctest.Context(t)
+	var automationRule1, automationRule2 types.AutomationRulesConfig
+	resourceName1 := "aws_securityhub_automation_rule.test"
+	resourceName2 := "aws_securityhub_automation_rule.test2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAutomationRuleConfig_uniqueOrderEnforcement(rName, 1, "VALIDATE"),
+				ExpectError: regexache.MustCompile(`Conflicting rule order`),
+			},
+			{
+				Config: testAccAutomationRuleConfig_uniqueOrderEnforcement(rName, 2, "VALIDATE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName1, &automationRule1),
+					testAccCheckAutomationRuleExists(ctx, resourceName2, &automationRule2),
+					testAccCheckAutomationRuleOrder(&automationRule1, 1),
+					testAccCheckAutomationRuleOrder(&automationRule2, 2),
+					resource.TestCheckResourceAttr(resourceName2, "unique_order_enforcement", "VALIDATE"),
+				),
+			},
+			{
+				Config:      testAccAutomationRuleConfig_uniqueOrderEnforcement(rName, 1, "VALIDATE"),
+				ExpectError: regexache.MustCompile(`Conflicting rule order`),
+			},
+			{
+				Config: testAccAutomationRuleConfig_uniqueOrderEnforcement(rName, 1, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName1, &automationRule1),
+					testAccCheckAutomationRuleExists(ctx, resourceName2, &automationRule2),
+					testAccCheckAutomationRuleOrder(&automationRule1, 1),
+					testAccCheckAutomationRuleOrder(&automationRule2, 1),
+					resource.TestCheckNoResourceAttr(resourceName2, "unique_order_enforcement"),
+				),
+			},
+			{
+				// An unchanged rule_order is not validated again.
+				Config: testAccAutomationRuleConfig_uniqueOrderEnforcement(rName, 1, "VALIDATE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName1, &automationRule1),
+					testAccCheckAutomationRuleExists(ctx, resourceName2, &automationRule2),
+					testAccCheckAutomationRuleOrder(&automationRule1, 1),
+					testAccCheckAutomationRuleOrder(&automationRule2, 1),
+					resource.TestCheckResourceAttr(resourceName2, "unique_order_enforcement", "VALIDATE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAutomationRuleOrder(v *types.AutomationRulesConfig, want int32) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.ToInt32(v.RuleOrder); got != want {
+			return fmt.Errorf("Security Hub Automation Rule (%s) rule order = %d, want %d", aws.ToString(v.RuleArn), got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAutomationRuleExists(ctx context.Context, n string, v *types.AutomationRulesConfig) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -760,3 +906,63 @@ resource "aws_securityhub_automation_rule" "test" {
 }
 `, rName, key, value, key2, value2)
 }
+
+func testAccAutomationRuleConfig_uniqueOrderEnforcement(rName string, ruleOrder int, mode string) string {
+	uniqueOrderEnforcement := "null"
+	if mode != "" {
+		uniqueOrderEnforcement = strconv.Quote(mode)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rule" "test" {
+  description = "test description"
+  rule_name   = %[1]q
+  rule_order  = 1
+
+  actions {
+    finding_fields_update {
+      severity {
+        label = "LOW"
+      }
+    }
+    type = "FINDING_FIELDS_UPDATE"
+  }
+
+  criteria {
+    aws_account_id {
+      comparison = "EQUALS"
+      value      = "1234567890"
+    }
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+
+resource "aws_securityhub_automation_rule" "test2" {
+  description              = "test description"
+  rule_name                = "%[1]s-2"
+  rule_order               = 1
+  unique_order_enforcement = %[2]q
+
+  actions {
+    finding_fields_update {
+      severity {
+        label = "INFORMATIONAL"
+      }
+    }
+    type = "FINDING_FIELDS_UPDATE"
+  }
+
+  criteria {
+    aws_account_id {
+      comparison = "EQUALS"
+      value      = "1234567890"
+    }
+  }
+
+  depends_on = [aws_securityhub_automation_rule.test]
+}
+`, rName, mode)
+}
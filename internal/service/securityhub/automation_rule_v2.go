@@ -0,0 +1,650 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Automation Rule V2")
+// @Tags(identifierAttribute="arn")
+func newAutomationRuleV2Resource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &automationRuleV2Resource{}, nil
+}
+
+type automationRuleV2Resource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *automationRuleV2Resource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securityhub_automation_rule_v2"
+}
+
+func (r *automationRuleV2Resource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"rule_name": schema.StringAttribute{
+				Required: true,
+			},
+			"rule_order": schema.Float64Attribute{
+				Required: true,
+				Validators: []validator.Float64{
+					float64validator.Between(1, automationRuleOrderMax),
+				},
+			},
+			"rule_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RuleStatusV2](),
+				Computed:   true,
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"actions": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[automationRulesActionV2Model](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.AutomationRulesActionTypeV2](),
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"external_integration_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[externalIntegrationConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"connector_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+						"finding_fields_update": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[automationRulesFindingFieldsUpdateV2Model](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"comment": schema.StringAttribute{
+										Optional: true,
+									},
+									"severity_id": schema.Int64Attribute{
+										Optional: true,
+									},
+									"status_id": schema.Int64Attribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"criteria": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[criteriaModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"ocsf_finding_criteria": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[ocsfFindingFiltersModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"composite_operator": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AllowedOperators](),
+										Optional:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"composite_filters": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[compositeFilterModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"operator": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.AllowedOperators](),
+													Optional:   true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"boolean_filters": ocsfFilterSchemaFramework[ocsfBooleanFilterModel, awstypes.OcsfBooleanField](ctx, booleanFilterBlockFramework(ctx)),
+												"date_filters":    ocsfFilterSchemaFramework[ocsfDateFilterModel, awstypes.OcsfDateField](ctx, dateFilterBlockFramework(ctx)),
+												"map_filters":     ocsfFilterSchemaFramework[ocsfMapFilterModel, awstypes.OcsfMapField](ctx, mapFilterBlockFramework(ctx)),
+												"number_filters":  ocsfFilterSchemaFramework[ocsfNumberFilterModel, awstypes.OcsfNumberField](ctx, numberFilterBlockFramework(ctx)),
+												"string_filters":  ocsfFilterSchemaFramework[ocsfStringFilterModel, awstypes.OcsfStringField](ctx, stringFilterBlockFramework(ctx)),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ocsfFilterSchemaFramework returns the schema for a list of filters on the OCSF fields named by F.
+func ocsfFilterSchemaFramework[T any, F enum.Valueser[F]](ctx context.Context, filter schema.ListNestedBlock) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[T](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"field_name": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[F](),
+					Required:   true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				names.AttrFilter: filter,
+			},
+		},
+	}
+}
+
+func booleanFilterBlockFramework(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[booleanFilterModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrValue: schema.BoolAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func dateFilterBlockFramework(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[dateFilterModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"end": schema.StringAttribute{
+					CustomType: timetypes.RFC3339Type{},
+					Optional:   true,
+				},
+				"start": schema.StringAttribute{
+					CustomType: timetypes.RFC3339Type{},
+					Optional:   true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"date_range": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[dateRangeModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrUnit: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.DateRangeUnit](),
+								Required:   true,
+							},
+							names.AttrValue: schema.Int64Attribute{
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func mapFilterBlockFramework(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[mapFilterModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"comparison": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(mapFilterComparisonValues()...),
+					},
+				},
+				names.AttrKey: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrValue: schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func numberFilterBlockFramework(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[numberFilterModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"eq": schema.Float64Attribute{
+					Optional: true,
+				},
+				"gt": schema.Float64Attribute{
+					Optional: true,
+				},
+				"gte": schema.Float64Attribute{
+					Optional: true,
+				},
+				"lt": schema.Float64Attribute{
+					Optional: true,
+				},
+				"lte": schema.Float64Attribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func stringFilterBlockFramework(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[stringFilterModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"comparison": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(stringFilterComparisonValues()...),
+					},
+				},
+				names.AttrValue: schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func (r *automationRuleV2Resource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data automationRuleV2ResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityHubClient(ctx)
+
+	input := &securityhub.CreateAutomationRuleV2Input{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// AutoFlEx doesn't yet handle union types.
+	criteria, diags := expandCriteria(ctx, data.Criteria)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Criteria = criteria
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateAutomationRuleV2(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Hub Automation Rule V2 (%s)", aws.ToString(input.RuleName)), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.RuleId)
+	data.RuleARN = fwflex.StringToFramework(ctx, output.RuleArn)
+
+	rule, err := findAutomationRuleV2ByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Hub Automation Rule V2 (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.RuleStatus = fwtypes.StringEnumValue(rule.RuleStatus)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *automationRuleV2Resource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data automationRuleV2ResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityHubClient(ctx)
+
+	output, err := findAutomationRuleV2ByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Hub Automation Rule V2 (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	criteria, diags := flattenCriteria(ctx, output.Criteria)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Criteria = criteria
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *automationRuleV2Resource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new automationRuleV2ResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityHubClient(ctx)
+
+	if !new.Actions.Equal(old.Actions) ||
+		!new.Criteria.Equal(old.Criteria) ||
+		!new.Description.Equal(old.Description) ||
+		!new.RuleName.Equal(old.RuleName) ||
+		!new.RuleOrder.Equal(old.RuleOrder) ||
+		!new.RuleStatus.Equal(old.RuleStatus) {
+		input := &securityhub.UpdateAutomationRuleV2Input{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		criteria, diags := expandCriteria(ctx, new.Criteria)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.Criteria = criteria
+		input.Identifier = fwflex.StringFromFramework(ctx, new.ID)
+
+		_, err := conn.UpdateAutomationRuleV2(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Hub Automation Rule V2 (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *automationRuleV2Resource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data automationRuleV2ResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityHubClient(ctx)
+
+	_, err := conn.DeleteAutomationRuleV2(ctx, &securityhub.DeleteAutomationRuleV2Input{
+		Identifier: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Hub Automation Rule V2 (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *automationRuleV2Resource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findAutomationRuleV2ByID(ctx context.Context, conn *securityhub.Client, id string) (*securityhub.GetAutomationRuleV2Output, error) {
+	input := &securityhub.GetAutomationRuleV2Input{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetAutomationRuleV2(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandCriteria(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[criteriaModel]) (awstypes.Criteria, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	criteriaData, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || criteriaData == nil {
+		return nil, diags
+	}
+
+	ocsfData, d := criteriaData.OCSFFindingCriteria.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || ocsfData == nil {
+		return nil, diags
+	}
+
+	var filters awstypes.OcsfFindingFilters
+	diags.Append(fwflex.Expand(ctx, ocsfData, &filters)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &awstypes.CriteriaMemberOcsfFindingCriteria{
+		Value: filters,
+	}, diags
+}
+
+func flattenCriteria(ctx context.Context, apiObject awstypes.Criteria) (fwtypes.ListNestedObjectValueOf[criteriaModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch v := apiObject.(type) {
+	case *awstypes.CriteriaMemberOcsfFindingCriteria:
+		var ocsfData ocsfFindingFiltersModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &ocsfData)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[criteriaModel](ctx), diags
+		}
+
+		return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &criteriaModel{
+			OCSFFindingCriteria: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &ocsfData),
+		}), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfNull[criteriaModel](ctx), diags
+}
+
+type automationRuleV2ResourceModel struct {
+	Actions     fwtypes.ListNestedObjectValueOf[automationRulesActionV2Model] `tfsdk:"actions"`
+	Criteria    fwtypes.ListNestedObjectValueOf[criteriaModel]                `tfsdk:"criteria"`
+	Description types.String                                                  `tfsdk:"description"`
+	ID          types.String                                                  `tfsdk:"id"`
+	RuleARN     types.String                                                  `tfsdk:"arn"`
+	RuleName    types.String                                                  `tfsdk:"rule_name"`
+	RuleOrder   types.Float64                                                 `tfsdk:"rule_order"`
+	RuleStatus  fwtypes.StringEnum[awstypes.RuleStatusV2]                     `tfsdk:"rule_status"`
+	Tags        types.Map                                                     `tfsdk:"tags"`
+	TagsAll     types.Map                                                     `tfsdk:"tags_all"`
+}
+
+type automationRulesActionV2Model struct {
+	ExternalIntegrationConfiguration fwtypes.ListNestedObjectValueOf[externalIntegrationConfigurationModel]     `tfsdk:"external_integration_configuration"`
+	FindingFieldsUpdate              fwtypes.ListNestedObjectValueOf[automationRulesFindingFieldsUpdateV2Model] `tfsdk:"finding_fields_update"`
+	Type                             fwtypes.StringEnum[awstypes.AutomationRulesActionTypeV2]                   `tfsdk:"type"`
+}
+
+type externalIntegrationConfigurationModel struct {
+	ConnectorARN fwtypes.ARN `tfsdk:"connector_arn"`
+}
+
+type automationRulesFindingFieldsUpdateV2Model struct {
+	Comment    types.String `tfsdk:"comment"`
+	SeverityID types.Int64  `tfsdk:"severity_id"`
+	StatusID   types.Int64  `tfsdk:"status_id"`
+}
+
+type criteriaModel struct {
+	OCSFFindingCriteria fwtypes.ListNestedObjectValueOf[ocsfFindingFiltersModel] `tfsdk:"ocsf_finding_criteria"`
+}
+
+type ocsfFindingFiltersModel struct {
+	CompositeFilters  fwtypes.ListNestedObjectValueOf[compositeFilterModel] `tfsdk:"composite_filters"`
+	CompositeOperator fwtypes.StringEnum[awstypes.AllowedOperators]         `tfsdk:"composite_operator"`
+}
+
+type compositeFilterModel struct {
+	BooleanFilters fwtypes.ListNestedObjectValueOf[ocsfBooleanFilterModel] `tfsdk:"boolean_filters"`
+	DateFilters    fwtypes.ListNestedObjectValueOf[ocsfDateFilterModel]    `tfsdk:"date_filters"`
+	MapFilters     fwtypes.ListNestedObjectValueOf[ocsfMapFilterModel]     `tfsdk:"map_filters"`
+	NumberFilters  fwtypes.ListNestedObjectValueOf[ocsfNumberFilterModel]  `tfsdk:"number_filters"`
+	Operator       fwtypes.StringEnum[awstypes.AllowedOperators]           `tfsdk:"operator"`
+	StringFilters  fwtypes.ListNestedObjectValueOf[ocsfStringFilterModel]  `tfsdk:"string_filters"`
+}
+
+type ocsfBooleanFilterModel struct {
+	FieldName fwtypes.StringEnum[awstypes.OcsfBooleanField]       `tfsdk:"field_name"`
+	Filter    fwtypes.ListNestedObjectValueOf[booleanFilterModel] `tfsdk:"filter"`
+}
+
+type booleanFilterModel struct {
+	Value types.Bool `tfsdk:"value"`
+}
+
+type ocsfDateFilterModel struct {
+	FieldName fwtypes.StringEnum[awstypes.OcsfDateField]       `tfsdk:"field_name"`
+	Filter    fwtypes.ListNestedObjectValueOf[dateFilterModel] `tfsdk:"filter"`
+}
+
+type ocsfMapFilterModel struct {
+	FieldName fwtypes.StringEnum[awstypes.OcsfMapField]       `tfsdk:"field_name"`
+	Filter    fwtypes.ListNestedObjectValueOf[mapFilterModel] `tfsdk:"filter"`
+}
+
+type ocsfNumberFilterModel struct {
+	FieldName fwtypes.StringEnum[awstypes.OcsfNumberField]       `tfsdk:"field_name"`
+	Filter    fwtypes.ListNestedObjectValueOf[numberFilterModel] `tfsdk:"filter"`
+}
+
+type ocsfStringFilterModel struct {
+	FieldName fwtypes.StringEnum[awstypes.OcsfStringField]       `tfsdk:"field_name"`
+	Filter    fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"filter"`
+}
@@ -0,0 +1,517 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAutomationRuleV2_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var automationRule securityhub.GetAutomationRuleV2Output
+	resourceName := "aws_securityhub_automation_rule_v2.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckAutomationRuleV2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleV2Destroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleV2Config_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAutomationRuleV2Exists(ctx, resourceName, &automationRule),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.finding_fields_update.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.finding_fields_update.0.comment", "Reviewed by automation"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.finding_fields_update.0.severity_id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.finding_fields_update.0.status_id", "3"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.type", "FINDING_FIELDS_UPDATE"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "securityhub", regexache.MustCompile(`automation-rulev2/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.0.string_filters.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.0.string_filters.0.field_name", "cloud.account.uid"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.0.string_filters.0.filter.0.comparison", "EQUALS"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.0.string_filters.0.filter.0.value", "123456789012"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test description"),
+					resource.TestCheckResourceAttr(resourceName, "rule_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule_order", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAutomationRuleV2_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var automationRule securityhub.GetAutomationRuleV2Output
+	resourceName := "aws_securityhub_automation_rule_v2.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckAutomationRuleV2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleV2Destroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleV2Config_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleV2Exists(ctx, resourceName, &automationRule),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfsecurityhub.ResourceAutomationRuleV2, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAutomationRuleV2_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var automationRule securityhub.GetAutomationRuleV2Output
+	resourceName := "aws_securityhub_automation_rule_v2.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckAutomationRuleV2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleV2Destroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleV2Config_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleV2Exists(ctx, resourceName, &automationRule),
+				),
+			},
+			{
+				Config: testAccAutomationRuleV2Config_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAutomationRuleV2Exists(ctx, resourceName, &automationRule),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.finding_fields_update.0.comment", "Resolved by automation"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.finding_fields_update.0.severity_id", "2"),
+					resource.TestCheckResourceAttr(resourceName, "actions.0.finding_fields_update.0.status_id", "4"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.0.string_filters.0.filter.0.comparison", "PREFIX"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.0.string_filters.0.filter.0.value", "1234"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated description"),
+					resource.TestCheckResourceAttr(resourceName, "rule_name", rName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "rule_order", "2.5"),
+					resource.TestCheckResourceAttr(resourceName, "rule_status", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAutomationRuleV2_filters(t *testing.T) {
+	ctx := acctest.Context(t)
+	var automationRule securityhub.GetAutomationRuleV2Output
+	resourceName := "aws_securityhub_automation_rule_v2.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckAutomationRuleV2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleV2Destroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleV2Config_filters(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAutomationRuleV2Exists(ctx, resourceName, &automationRule),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_operator", "OR"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.0.operator", "AND"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.0.boolean_filters.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.0.boolean_filters.0.field_name", "vulnerabilities.is_fix_available"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.0.boolean_filters.0.filter.0.value", "true"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.0.number_filters.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.0.number_filters.0.field_name", "severity_id"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.0.number_filters.0.filter.0.gte", "4"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.1.date_filters.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.1.date_filters.0.field_name", "finding_info.created_time_dt"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.1.date_filters.0.filter.0.date_range.0.unit", "DAYS"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.1.date_filters.0.filter.0.date_range.0.value", "7"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.1.map_filters.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.1.map_filters.0.field_name", "resources.tags"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.1.map_filters.0.filter.0.comparison", "EQUALS"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.1.map_filters.0.filter.0.key", "Environment"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.ocsf_finding_criteria.0.composite_filters.1.map_filters.0.filter.0.value", "production"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAutomationRuleV2_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var automationRule securityhub.GetAutomationRuleV2Output
+	resourceName := "aws_securityhub_automation_rule_v2.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckAutomationRuleV2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleV2Destroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleV2Config_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleV2Exists(ctx, resourceName, &automationRule),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAutomationRuleV2Config_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleV2Exists(ctx, resourceName, &automationRule),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAutomationRuleV2Config_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleV2Exists(ctx, resourceName, &automationRule),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAutomationRuleV2Exists(ctx context.Context, n string, v *securityhub.GetAutomationRuleV2Output) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubClient(ctx)
+
+		output, err := tfsecurityhub.FindAutomationRuleV2ByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAutomationRuleV2Destroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_securityhub_automation_rule_v2" {
+				continue
+			}
+
+			_, err := tfsecurityhub.FindAutomationRuleV2ByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Security Hub Automation Rule V2 %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testAccPreCheckAutomationRuleV2 skips the test unless Security Hub V2 is enabled in the account.
+func testAccPreCheckAutomationRuleV2(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubClient(ctx)
+
+	input := &securityhub.ListAutomationRulesV2Input{}
+	_, err := conn.ListAutomationRulesV2(ctx, input)
+
+	if acctest.PreCheckSkipError(err) || tfawserr.ErrCodeEquals(err, "InvalidAccessException") {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccAutomationRuleV2Config_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_automation_rule_v2" "test" {
+  description = "test description"
+  rule_name   = %[1]q
+  rule_order  = 1
+
+  actions {
+    type = "FINDING_FIELDS_UPDATE"
+
+    finding_fields_update {
+      comment     = "Reviewed by automation"
+      severity_id = 1
+      status_id   = 3
+    }
+  }
+
+  criteria {
+    ocsf_finding_criteria {
+      composite_filters {
+        string_filters {
+          field_name = "cloud.account.uid"
+
+          filter {
+            comparison = "EQUALS"
+            value      = "123456789012"
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAutomationRuleV2Config_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_automation_rule_v2" "test" {
+  description = "updated description"
+  rule_name   = "%[1]s-updated"
+  rule_order  = 2.5
+  rule_status = "DISABLED"
+
+  actions {
+    type = "FINDING_FIELDS_UPDATE"
+
+    finding_fields_update {
+      comment     = "Resolved by automation"
+      severity_id = 2
+      status_id   = 4
+    }
+  }
+
+  criteria {
+    ocsf_finding_criteria {
+      composite_filters {
+        string_filters {
+          field_name = "cloud.account.uid"
+
+          filter {
+            comparison = "PREFIX"
+            value      = "1234"
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAutomationRuleV2Config_filters(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_automation_rule_v2" "test" {
+  description = "test description"
+  rule_name   = %[1]q
+  rule_order  = 1
+
+  actions {
+    type = "FINDING_FIELDS_UPDATE"
+
+    finding_fields_update {
+      status_id = 3
+    }
+  }
+
+  criteria {
+    ocsf_finding_criteria {
+      composite_operator = "OR"
+
+      composite_filters {
+        operator = "AND"
+
+        boolean_filters {
+          field_name = "vulnerabilities.is_fix_available"
+
+          filter {
+            value = true
+          }
+        }
+
+        number_filters {
+          field_name = "severity_id"
+
+          filter {
+            gte = 4
+          }
+        }
+      }
+
+      composite_filters {
+        operator = "AND"
+
+        date_filters {
+          field_name = "finding_info.created_time_dt"
+
+          filter {
+            date_range {
+              unit  = "DAYS"
+              value = 7
+            }
+          }
+        }
+
+        map_filters {
+          field_name = "resources.tags"
+
+          filter {
+            comparison = "EQUALS"
+            key        = "Environment"
+            value      = "production"
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAutomationRuleV2Config_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_automation_rule_v2" "test" {
+  description = "test description"
+  rule_name   = %[1]q
+  rule_order  = 1
+
+  actions {
+    type = "FINDING_FIELDS_UPDATE"
+
+    finding_fields_update {
+      status_id = 3
+    }
+  }
+
+  criteria {
+    ocsf_finding_criteria {
+      composite_filters {
+        string_filters {
+          field_name = "cloud.account.uid"
+
+          filter {
+            comparison = "EQUALS"
+            value      = "123456789012"
+          }
+        }
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAutomationRuleV2Config_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_automation_rule_v2" "test" {
+  description = "test description"
+  rule_name   = %[1]q
+  rule_order  = 1
+
+  actions {
+    type = "FINDING_FIELDS_UPDATE"
+
+    finding_fields_update {
+      status_id = 3
+    }
+  }
+
+  criteria {
+    ocsf_finding_criteria {
+      composite_filters {
+        string_filters {
+          field_name = "cloud.account.uid"
+
+          filter {
+            comparison = "EQUALS"
+            value      = "123456789012"
+          }
+        }
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// BatchGetAutomationRules accepts at most 100 rule ARNs.
+	automationRulesBatchGetMaxItems = 100
+)
+
+// @FrameworkDataSource(name="Automation Rules")
+func newAutomationRulesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &automationRulesDataSource{}, nil
+}
+
+type automationRulesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *automationRulesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_securityhub_automation_rules"
+}
+
+func (d *automationRulesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arns": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"rules": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[automationRuleSummaryModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[automationRuleSummaryModel](ctx),
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *automationRulesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data automationRulesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SecurityHubClient(ctx)
+
+	rules, err := findAutomationRulesConfigs(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Security Hub Automation Rules", err.Error())
+
+		return
+	}
+
+	// Rules are evaluated in ascending rule order.
+	slices.SortStableFunc(rules, func(a, b awstypes.AutomationRulesConfig) int {
+		return cmp.Compare(aws.ToInt32(a.RuleOrder), aws.ToInt32(b.RuleOrder))
+	})
+
+	data.ID = fwflex.StringValueToFramework(ctx, d.Meta().Region)
+	data.ARNs = fwflex.FlattenFrameworkStringValueListOfString(ctx, tfslices.ApplyToAll(rules, func(v awstypes.AutomationRulesConfig) string {
+		return aws.ToString(v.RuleArn)
+	}))
+	response.Diagnostics.Append(fwflex.Flatten(ctx, rules, &data.Rules)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// findAutomationRulesConfigs returns the full configuration of every automation rule in the Region.
+func findAutomationRulesConfigs(ctx context.Context, conn *securityhub.Client) ([]awstypes.AutomationRulesConfig, error) {
+	metadata, err := findAutomationRulesMetadata(ctx, conn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	arns := tfslices.ApplyToAll(metadata, func(v awstypes.AutomationRulesMetadata) string {
+		return aws.ToString(v.RuleArn)
+	})
+
+	var output []awstypes.AutomationRulesConfig
+
+	for _, chunk := range tfslices.Chunks(arns, automationRulesBatchGetMaxItems) {
+		rules, err := findAutomationRules(ctx, conn, &securityhub.BatchGetAutomationRulesInput{
+			AutomationRulesArns: chunk,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, rules...)
+	}
+
+	return output, nil
+}
+
+// findAutomationRulesMetadata returns the summary of every automation rule in the Region.
+func findAutomationRulesMetadata(ctx context.Context, conn *securityhub.Client) ([]awstypes.AutomationRulesMetadata, error) {
+	input := &securityhub.ListAutomationRulesInput{}
+	var output []awstypes.AutomationRulesMetadata
+
+	for {
+		page, err := conn.ListAutomationRules(ctx, input)
+
+		if tfawserr.ErrMessageContains(err, errCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AutomationRulesMetadata...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+type automationRulesDataSourceModel struct {
+	ARNs  fwtypes.ListValueOf[types.String]                           `tfsdk:"arns"`
+	ID    types.String                                                `tfsdk:"id"`
+	Rules fwtypes.ListNestedObjectValueOf[automationRuleSummaryModel] `tfsdk:"rules"`
+}
+
+type automationRuleSummaryModel struct {
+	CreatedAt   timetypes.RFC3339                                                   `tfsdk:"created_at"`
+	CreatedBy   types.String                                                        `tfsdk:"created_by"`
+	Criteria    fwtypes.ListNestedObjectValueOf[automationRulesFindingFiltersModel] `tfsdk:"criteria"`
+	Description types.String                                                        `tfsdk:"description"`
+	IsTerminal  types.Bool                                                          `tfsdk:"is_terminal"`
+	RuleARN     types.String                                                        `tfsdk:"arn"`
+	RuleName    types.String                                                        `tfsdk:"rule_name"`
+	RuleOrder   types.Int64                                                         `tfsdk:"rule_order"`
+	RuleStatus  fwtypes.StringEnum[awstypes.RuleStatus]                             `tfsdk:"rule_status"`
+	UpdatedAt   timetypes.RFC3339                                                   `tfsdk:"updated_at"`
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAutomationRulesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_securityhub_automation_rules.test"
+	resource1Name := "aws_securityhub_automation_rule.test1"
+	resource2Name := "aws_securityhub_automation_rule.test2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRulesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resource2Name, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.1", resource1Name, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.arn", resource2Name, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.0.rule_name", resource2Name, "rule_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.rule_order", "5"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.rule_status", "DISABLED"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.criteria.0.severity_label.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.criteria.0.severity_label.0.value", "LOW"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "rules.1.arn", resource1Name, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.1.rule_order", "10"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.1.rule_status", "ENABLED"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.1.criteria.0.aws_account_id.0.value", "1234567890"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "rules.1.created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAutomationRulesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rule" "test1" {
+  description = "test description"
+  rule_name   = "%[1]s-1"
+  rule_order  = 10
+
+  actions {
+    finding_fields_update {
+      workflow {
+        status = "SUPPRESSED"
+      }
+    }
+    type = "FINDING_FIELDS_UPDATE"
+  }
+
+  criteria {
+    aws_account_id {
+      comparison = "EQUALS"
+      value      = "1234567890"
+    }
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+
+resource "aws_securityhub_automation_rule" "test2" {
+  description = "test description"
+  rule_name   = "%[1]s-2"
+  rule_order  = 5
+  rule_status = "DISABLED"
+
+  actions {
+    finding_fields_update {
+      criticality = 10
+    }
+    type = "FINDING_FIELDS_UPDATE"
+  }
+
+  criteria {
+    severity_label {
+      comparison = "EQUALS"
+      value      = "LOW"
+    }
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+
+data "aws_securityhub_automation_rules" "test" {
+  depends_on = [aws_securityhub_automation_rule.test1, aws_securityhub_automation_rule.test2]
+}
+`, rName)
+}
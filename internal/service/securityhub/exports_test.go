@@ -8,10 +8,11 @@ var (
 	ResourceAccount                        = resourceAccount
 	ResourceActionTarget                   = resourceActionTarget
 	ResourceAutomationRule                 = newAutomationRuleResource
+	ResourceAutomationRuleV2               = newAutomationRuleV2Resource
 	ResourceConfigurationPolicy            = resourceConfigurationPolicy
 	ResourceConfigurationPolicyAssociation = resourceConfigurationPolicyAssociation
 	ResourceFindingAggregator              = resourceFindingAggregator
-	ResourceInsight                        = resourceInsight
+	ResourceInsight                        = newInsightResource
 	ResourceInviteAccepter                 = resourceInviteAccepter
 	ResourceMember                         = resourceMember
 	ResourceOrganizationAdminAccount       = resourceOrganizationAdminAccount
@@ -24,6 +25,7 @@ var (
 	FindActionTargetByARN                         = findActionTargetByARN
 	FindAdminAccountByID                          = findAdminAccountByID
 	FindAutomationRuleByARN                       = findAutomationRuleByARN
+	FindAutomationRuleV2ByID                      = findAutomationRuleV2ByID
 	FindConfigurationPolicyAssociationByID        = findConfigurationPolicyAssociationByID
 	FindConfigurationPolicyByID                   = findConfigurationPolicyByID
 	FindFindingAggregatorByARN                    = findFindingAggregatorByARN
@@ -36,4 +38,5 @@ var (
 	FindStandardsControlByTwoPartKey              = findStandardsControlByTwoPartKey
 	FindStandardsSubscriptionByARN                = findStandardsSubscriptionByARN
 	StandardsControlARNToStandardsSubscriptionARN = standardsControlARNToStandardsSubscriptionARN
+	WaitOrganizationConfigurationEnabled          = waitOrganizationConfigurationEnabled
 )
@@ -0,0 +1,392 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// GetFindings returns at most 100 findings per page.
+	getFindingsMaxResultsPerPage = 100
+)
+
+// @SDKDataSource("aws_securityhub_findings", name="Findings")
+func dataSourceFindings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFindingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     securityFindingFiltersSchema(),
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"company_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compliance_security_control_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compliance_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"confidence": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrCreatedAt: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"criticality": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"first_observed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"generator_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_observed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product_fields": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"product_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"recommendation_text": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"recommendation_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"record_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResources: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrID: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"partition": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrRegion: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrTags: {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									names.AttrType: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"schema_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity_label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity_normalized": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"severity_original": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"types": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_defined_fields": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"verification_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"workflow_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      getFindingsMaxResultsPerPage,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"sort_criterion": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"sort_order": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          string(types.SortOrderAscending),
+							ValidateDiagFunc: enum.Validate[types.SortOrder](),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFindingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	input := &securityhub.GetFindingsInput{}
+
+	if v, ok := d.GetOk("filters"); ok {
+		input.Filters = expandSecurityFindingFilters(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("sort_criterion"); ok {
+		input.SortCriteria = expandSortCriteria(v.([]interface{}))
+	}
+
+	findings, err := findFindings(ctx, conn, input, d.Get("max_results").(int))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Hub Findings: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("findings", flattenSecurityFindings(findings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting findings: %s", err)
+	}
+	d.Set("ids", tfslices.ApplyToAll(findings, func(v types.AwsSecurityFinding) string {
+		return aws.ToString(v.Id)
+	}))
+
+	return diags
+}
+
+// findFindings returns up to maxResults findings matching the input's filters.
+func findFindings(ctx context.Context, conn *securityhub.Client, input *securityhub.GetFindingsInput, maxResults int) ([]types.AwsSecurityFinding, error) {
+	var output []types.AwsSecurityFinding
+
+	for len(output) < maxResults {
+		input.MaxResults = aws.Int32(int32(min(maxResults-len(output), getFindingsMaxResultsPerPage)))
+
+		page, err := conn.GetFindings(ctx, input)
+
+		if tfawserr.ErrMessageContains(err, errCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Findings...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+func expandSortCriteria(tfList []interface{}) []types.SortCriterion {
+	var apiObjects []types.SortCriterion
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.SortCriterion{}
+
+		if v, ok := tfMap["field_name"].(string); ok && v != "" {
+			apiObject.Field = aws.String(v)
+		}
+
+		if v, ok := tfMap["sort_order"].(string); ok && v != "" {
+			apiObject.SortOrder = types.SortOrder(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenSecurityFindings(apiObjects []types.AwsSecurityFinding) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"aws_account_id":      aws.ToString(apiObject.AwsAccountId),
+			"company_name":        aws.ToString(apiObject.CompanyName),
+			"confidence":          aws.ToInt32(apiObject.Confidence),
+			names.AttrCreatedAt:   aws.ToString(apiObject.CreatedAt),
+			"criticality":         aws.ToInt32(apiObject.Criticality),
+			names.AttrDescription: aws.ToString(apiObject.Description),
+			"first_observed_at":   aws.ToString(apiObject.FirstObservedAt),
+			"generator_id":        aws.ToString(apiObject.GeneratorId),
+			names.AttrID:          aws.ToString(apiObject.Id),
+			"last_observed_at":    aws.ToString(apiObject.LastObservedAt),
+			"product_arn":         aws.ToString(apiObject.ProductArn),
+			"product_fields":      apiObject.ProductFields,
+			"product_name":        aws.ToString(apiObject.ProductName),
+			"record_state":        string(apiObject.RecordState),
+			names.AttrRegion:      aws.ToString(apiObject.Region),
+			names.AttrResources:   flattenFindingResources(apiObject.Resources),
+			"schema_version":      aws.ToString(apiObject.SchemaVersion),
+			"source_url":          aws.ToString(apiObject.SourceUrl),
+			"title":               aws.ToString(apiObject.Title),
+			"types":               apiObject.Types,
+			"updated_at":          aws.ToString(apiObject.UpdatedAt),
+			"user_defined_fields": apiObject.UserDefinedFields,
+			"verification_state":  string(apiObject.VerificationState),
+		}
+
+		if v := apiObject.Compliance; v != nil {
+			tfMap["compliance_security_control_id"] = aws.ToString(v.SecurityControlId)
+			tfMap["compliance_status"] = string(v.Status)
+		}
+
+		if v := apiObject.Remediation; v != nil && v.Recommendation != nil {
+			tfMap["recommendation_text"] = aws.ToString(v.Recommendation.Text)
+			tfMap["recommendation_url"] = aws.ToString(v.Recommendation.Url)
+		}
+
+		if v := apiObject.Severity; v != nil {
+			tfMap["severity_label"] = string(v.Label)
+			tfMap["severity_normalized"] = aws.ToInt32(v.Normalized)
+			tfMap["severity_original"] = aws.ToString(v.Original)
+		}
+
+		if v := apiObject.Workflow; v != nil {
+			tfMap["workflow_status"] = string(v.Status)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenFindingResources(apiObjects []types.Resource) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrID:     aws.ToString(apiObject.Id),
+			"partition":      string(apiObject.Partition),
+			names.AttrRegion: aws.ToString(apiObject.Region),
+			names.AttrTags:   apiObject.Tags,
+			names.AttrType:   aws.ToString(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccFindingsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_securityhub_findings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFindingsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrID, acctest.Region()),
+					resource.TestMatchResourceAttr(dataSourceName, "findings.#", regexache.MustCompile(`^[0-5]$`)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "findings.#", dataSourceName, "ids.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "max_results", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFindingsDataSource_filters(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_securityhub_findings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFindingsDataSourceConfig_filters,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccFindingsDataSourceConfig_basic = `
+resource "aws_securityhub_account" "test" {}
+
+data "aws_securityhub_findings" "test" {
+  max_results = 5
+
+  sort_criterion {
+    field_name = "UpdatedAt"
+    sort_order = "desc"
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`
+
+const testAccFindingsDataSourceConfig_filters = `
+data "aws_caller_identity" "current" {}
+
+resource "aws_securityhub_account" "test" {}
+
+data "aws_securityhub_findings" "test" {
+  filters {
+    aws_account_id {
+      comparison = "EQUALS"
+      value      = data.aws_caller_identity.current.account_id
+    }
+
+    generator_id {
+      comparison = "EQUALS"
+      value      = "tf-acc-test-no-such-generator"
+    }
+
+    severity_label {
+      comparison = "EQUALS"
+      value      = "CRITICAL"
+    }
+
+    updated_at {
+      date_range {
+        unit  = "DAYS"
+        value = 1
+      }
+    }
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`
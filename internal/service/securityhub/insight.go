@@ -5,376 +5,402 @@ package securityhub
 
 import (
 	"context"
-	"log"
+	"encoding/json"
+	"fmt"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/securityhub"
-	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securityhub/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
-	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-// @SDKResource("aws_securityhub_insight", name="Insight")
-func resourceInsight() *schema.Resource {
-	return &schema.Resource{
-		CreateWithoutTimeout: resourceInsightCreate,
-		ReadWithoutTimeout:   resourceInsightRead,
-		UpdateWithoutTimeout: resourceInsightUpdate,
-		DeleteWithoutTimeout: resourceInsightDelete,
+// @FrameworkResource(name="Insight")
+func newInsightResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &insightResource{}, nil
+}
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type insightResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
 
-		Schema: map[string]*schema.Schema{
-			names.AttrARN: {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"filters": {
-				Type:     schema.TypeList,
+func (*insightResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securityhub_insight"
+}
+
+func (r *insightResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"group_by_attribute": schema.StringAttribute{
 				Required: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"aws_account_id":                              stringFilterSchema(),
-						"company_name":                                stringFilterSchema(),
-						"compliance_status":                           stringFilterSchema(),
-						"confidence":                                  numberFilterSchema(),
-						names.AttrCreatedAt:                           dateFilterSchema(),
-						"criticality":                                 numberFilterSchema(),
-						names.AttrDescription:                         stringFilterSchema(),
-						"finding_provider_fields_confidence":          numberFilterSchema(),
-						"finding_provider_fields_criticality":         numberFilterSchema(),
-						"finding_provider_fields_related_findings_id": stringFilterSchema(),
-						"finding_provider_fields_related_findings_product_arn": stringFilterSchema(),
-						"finding_provider_fields_severity_label":               stringFilterSchema(),
-						"finding_provider_fields_severity_original":            stringFilterSchema(),
-						"finding_provider_fields_types":                        stringFilterSchema(),
-						"first_observed_at":                                    dateFilterSchema(),
-						"generator_id":                                         stringFilterSchema(),
-						names.AttrID:                                           stringFilterSchema(),
-						"keyword":                                              keywordFilterSchema(),
-						"last_observed_at":                                     dateFilterSchema(),
-						"malware_name":                                         stringFilterSchema(),
-						"malware_path":                                         stringFilterSchema(),
-						"malware_state":                                        stringFilterSchema(),
-						"malware_type":                                         stringFilterSchema(),
-						"network_destination_domain":                           stringFilterSchema(),
-						"network_destination_ipv4":                             ipFilterSchema(),
-						"network_destination_ipv6":                             ipFilterSchema(),
-						"network_destination_port":                             numberFilterSchema(),
-						"network_direction":                                    stringFilterSchema(),
-						"network_protocol":                                     stringFilterSchema(),
-						"network_source_domain":                                stringFilterSchema(),
-						"network_source_ipv4":                                  ipFilterSchema(),
-						"network_source_ipv6":                                  ipFilterSchema(),
-						"network_source_mac":                                   stringFilterSchema(),
-						"network_source_port":                                  numberFilterSchema(),
-						"note_text":                                            stringFilterSchema(),
-						"note_updated_at":                                      dateFilterSchema(),
-						"note_updated_by":                                      stringFilterSchema(),
-						"process_launched_at":                                  dateFilterSchema(),
-						"process_name":                                         stringFilterSchema(),
-						"process_parent_pid":                                   numberFilterSchema(),
-						"process_path":                                         stringFilterSchema(),
-						"process_pid":                                          numberFilterSchema(),
-						"process_terminated_at":                                dateFilterSchema(),
-						"product_arn":                                          stringFilterSchema(),
-						"product_fields":                                       mapFilterSchema(),
-						"product_name":                                         stringFilterSchema(),
-						"recommendation_text":                                  stringFilterSchema(),
-						"record_state":                                         stringFilterSchema(),
-						"related_findings_id":                                  stringFilterSchema(),
-						"related_findings_product_arn":                         stringFilterSchema(),
-						"resource_aws_ec2_instance_iam_instance_profile_arn": stringFilterSchema(),
-						"resource_aws_ec2_instance_image_id":                 stringFilterSchema(),
-						"resource_aws_ec2_instance_ipv4_addresses":           ipFilterSchema(),
-						"resource_aws_ec2_instance_ipv6_addresses":           ipFilterSchema(),
-						"resource_aws_ec2_instance_key_name":                 stringFilterSchema(),
-						"resource_aws_ec2_instance_launched_at":              dateFilterSchema(),
-						"resource_aws_ec2_instance_subnet_id":                stringFilterSchema(),
-						"resource_aws_ec2_instance_type":                     stringFilterSchema(),
-						"resource_aws_ec2_instance_vpc_id":                   stringFilterSchema(),
-						"resource_aws_iam_access_key_created_at":             dateFilterSchema(),
-						"resource_aws_iam_access_key_status":                 stringFilterSchema(),
-						"resource_aws_iam_access_key_user_name":              stringFilterSchema(),
-						"resource_aws_s3_bucket_owner_id":                    stringFilterSchema(),
-						"resource_aws_s3_bucket_owner_name":                  stringFilterSchema(),
-						"resource_container_image_id":                        stringFilterSchema(),
-						"resource_container_image_name":                      stringFilterSchema(),
-						"resource_container_launched_at":                     dateFilterSchema(),
-						"resource_container_name":                            stringFilterSchema(),
-						"resource_details_other":                             mapFilterSchema(),
-						names.AttrResourceID:                                 stringFilterSchema(),
-						"resource_partition":                                 stringFilterSchema(),
-						"resource_region":                                    stringFilterSchema(),
-						"resource_tags":                                      mapFilterSchema(),
-						names.AttrResourceType:                               stringFilterSchema(),
-						"severity_label":                                     stringFilterSchema(),
-						"source_url":                                         stringFilterSchema(),
-						"threat_intel_indicator_category":                    stringFilterSchema(),
-						"threat_intel_indicator_last_observed_at":            dateFilterSchema(),
-						"threat_intel_indicator_source":                      stringFilterSchema(),
-						"threat_intel_indicator_source_url":                  stringFilterSchema(),
-						"threat_intel_indicator_type":                        stringFilterSchema(),
-						"threat_intel_indicator_value":                       stringFilterSchema(),
-						"title":                                              stringFilterSchema(),
-						names.AttrType:                                       stringFilterSchema(),
-						"updated_at":                                         dateFilterSchema(),
-						"user_defined_values":                                mapFilterSchema(),
-						"verification_state":                                 stringFilterSchema(),
-						"workflow_status":                                    workflowStatusSchema(),
-					},
-				},
 			},
-			"group_by_attribute": {
-				Type:     schema.TypeString,
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
 				Required: true,
 			},
-			names.AttrName: {
-				Type:     schema.TypeString,
-				Required: true,
+		},
+		Blocks: map[string]schema.Block{
+			"filters": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[insightFiltersModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"aws_account_id":                              stringFilterSchemaFramework(ctx),
+						"company_name":                                stringFilterSchemaFramework(ctx),
+						"compliance_status":                           stringFilterSchemaFramework(ctx),
+						"confidence":                                  numberFilterSchemaFramework(ctx),
+						names.AttrCreatedAt:                           dateFilterSchemaFramework(ctx),
+						"criticality":                                 numberFilterSchemaFramework(ctx),
+						names.AttrDescription:                         stringFilterSchemaFramework(ctx),
+						"finding_provider_fields_confidence":          numberFilterSchemaFramework(ctx),
+						"finding_provider_fields_criticality":         numberFilterSchemaFramework(ctx),
+						"finding_provider_fields_related_findings_id": stringFilterSchemaFramework(ctx),
+						"finding_provider_fields_related_findings_product_arn": stringFilterSchemaFramework(ctx),
+						"finding_provider_fields_severity_label":               stringFilterSchemaFramework(ctx),
+						"finding_provider_fields_severity_original":            stringFilterSchemaFramework(ctx),
+						"finding_provider_fields_types":                        stringFilterSchemaFramework(ctx),
+						"first_observed_at":                                    dateFilterSchemaFramework(ctx),
+						"generator_id":                                         stringFilterSchemaFramework(ctx),
+						names.AttrID:                                           stringFilterSchemaFramework(ctx),
+						"keyword":                                              keywordFilterSchemaFramework(ctx),
+						"last_observed_at":                                     dateFilterSchemaFramework(ctx),
+						"malware_name":                                         stringFilterSchemaFramework(ctx),
+						"malware_path":                                         stringFilterSchemaFramework(ctx),
+						"malware_state":                                        stringFilterSchemaFramework(ctx),
+						"malware_type":                                         stringFilterSchemaFramework(ctx),
+						"network_destination_domain":                           stringFilterSchemaFramework(ctx),
+						"network_destination_ipv4":                             ipFilterSchemaFramework(ctx),
+						"network_destination_ipv6":                             ipFilterSchemaFramework(ctx),
+						"network_destination_port":                             numberFilterSchemaFramework(ctx),
+						"network_direction":                                    stringFilterSchemaFramework(ctx),
+						"network_protocol":                                     stringFilterSchemaFramework(ctx),
+						"network_source_domain":                                stringFilterSchemaFramework(ctx),
+						"network_source_ipv4":                                  ipFilterSchemaFramework(ctx),
+						"network_source_ipv6":                                  ipFilterSchemaFramework(ctx),
+						"network_source_mac":                                   stringFilterSchemaFramework(ctx),
+						"network_source_port":                                  numberFilterSchemaFramework(ctx),
+						"note_text":                                            stringFilterSchemaFramework(ctx),
+						"note_updated_at":                                      dateFilterSchemaFramework(ctx),
+						"note_updated_by":                                      stringFilterSchemaFramework(ctx),
+						"process_launched_at":                                  dateFilterSchemaFramework(ctx),
+						"process_name":                                         stringFilterSchemaFramework(ctx),
+						"process_parent_pid":                                   numberFilterSchemaFramework(ctx),
+						"process_path":                                         stringFilterSchemaFramework(ctx),
+						"process_pid":                                          numberFilterSchemaFramework(ctx),
+						"process_terminated_at":                                dateFilterSchemaFramework(ctx),
+						"product_arn":                                          stringFilterSchemaFramework(ctx),
+						"product_fields":                                       mapFilterSchemaFramework(ctx),
+						"product_name":                                         stringFilterSchemaFramework(ctx),
+						"recommendation_text":                                  stringFilterSchemaFramework(ctx),
+						"record_state":                                         stringFilterSchemaFramework(ctx),
+						"related_findings_id":                                  stringFilterSchemaFramework(ctx),
+						"related_findings_product_arn":                         stringFilterSchemaFramework(ctx),
+						"resource_aws_ec2_instance_iam_instance_profile_arn": stringFilterSchemaFramework(ctx),
+						"resource_aws_ec2_instance_image_id":                 stringFilterSchemaFramework(ctx),
+						"resource_aws_ec2_instance_ipv4_addresses":           ipFilterSchemaFramework(ctx),
+						"resource_aws_ec2_instance_ipv6_addresses":           ipFilterSchemaFramework(ctx),
+						"resource_aws_ec2_instance_key_name":                 stringFilterSchemaFramework(ctx),
+						"resource_aws_ec2_instance_launched_at":              dateFilterSchemaFramework(ctx),
+						"resource_aws_ec2_instance_subnet_id":                stringFilterSchemaFramework(ctx),
+						"resource_aws_ec2_instance_type":                     stringFilterSchemaFramework(ctx),
+						"resource_aws_ec2_instance_vpc_id":                   stringFilterSchemaFramework(ctx),
+						"resource_aws_iam_access_key_created_at":             dateFilterSchemaFramework(ctx),
+						"resource_aws_iam_access_key_status":                 stringFilterSchemaFramework(ctx),
+						"resource_aws_iam_access_key_user_name":              stringFilterSchemaFramework(ctx),
+						"resource_aws_s3_bucket_owner_id":                    stringFilterSchemaFramework(ctx),
+						"resource_aws_s3_bucket_owner_name":                  stringFilterSchemaFramework(ctx),
+						"resource_container_image_id":                        stringFilterSchemaFramework(ctx),
+						"resource_container_image_name":                      stringFilterSchemaFramework(ctx),
+						"resource_container_launched_at":                     dateFilterSchemaFramework(ctx),
+						"resource_container_name":                            stringFilterSchemaFramework(ctx),
+						"resource_details_other":                             mapFilterSchemaFramework(ctx),
+						names.AttrResourceID:                                 stringFilterSchemaFramework(ctx),
+						"resource_partition":                                 stringFilterSchemaFramework(ctx),
+						"resource_region":                                    stringFilterSchemaFramework(ctx),
+						"resource_tags":                                      mapFilterSchemaFramework(ctx),
+						names.AttrResourceType:                               stringFilterSchemaFramework(ctx),
+						"severity_label":                                     stringFilterSchemaFramework(ctx),
+						"source_url":                                         stringFilterSchemaFramework(ctx),
+						"threat_intel_indicator_category":                    stringFilterSchemaFramework(ctx),
+						"threat_intel_indicator_last_observed_at":            dateFilterSchemaFramework(ctx),
+						"threat_intel_indicator_source":                      stringFilterSchemaFramework(ctx),
+						"threat_intel_indicator_source_url":                  stringFilterSchemaFramework(ctx),
+						"threat_intel_indicator_type":                        stringFilterSchemaFramework(ctx),
+						"threat_intel_indicator_value":                       stringFilterSchemaFramework(ctx),
+						"title":                                              stringFilterSchemaFramework(ctx),
+						names.AttrType:                                       stringFilterSchemaFramework(ctx),
+						"updated_at":                                         dateFilterSchemaFramework(ctx),
+						"user_defined_values":                                mapFilterSchemaFramework(ctx),
+						"verification_state":                                 stringFilterSchemaFramework(ctx),
+						"workflow_status":                                    workflowStatusFilterSchemaFramework(ctx),
+					},
+				},
 			},
 		},
 	}
 }
 
-func resourceInsightCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
-
-	name := d.Get(names.AttrName).(string)
-	input := &securityhub.CreateInsightInput{
-		GroupByAttribute: aws.String(d.Get("group_by_attribute").(string)),
-		Name:             aws.String(name),
+func (r *insightResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data insightResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	if v, ok := d.GetOk("filters"); ok {
-		input.Filters = expandSecurityFindingFilters(v.([]interface{}))
+	conn := r.Meta().SecurityHubClient(ctx)
+
+	input := &securityhub.CreateInsightInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
 	output, err := conn.CreateInsight(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("creating Security Hub Insight (%s): %s", name, err)
+		response.Diagnostics.AddError(fmt.Sprintf("creating Security Hub Insight (%s)", data.Name.ValueString()), err.Error())
+
+		return
 	}
 
-	d.SetId(aws.ToString(output.InsightArn))
+	// Set values for unknowns.
+	data.InsightARN = fwflex.StringToFramework(ctx, output.InsightArn)
+	data.setID()
 
-	return resourceInsightRead(ctx, d, meta)
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
 
-func resourceInsightRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+func (r *insightResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data insightResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().SecurityHubClient(ctx)
+
+	insight, err := findInsightByARN(ctx, conn, data.ID.ValueString())
 
-	insight, err := findInsightByARN(ctx, conn, d.Id())
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
 
-	if !d.IsNewResource() && tfresource.NotFound(err) {
-		log.Printf("[WARN] Security Hub Insight (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
+		return
 	}
 
 	if err != nil {
-		return diag.Errorf("reading Security Hub Insight (%s): %s", d.Id(), err)
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Hub Insight (%s)", data.ID.ValueString()), err.Error())
+
+		return
 	}
 
-	d.Set(names.AttrARN, insight.InsightArn)
-	if err := d.Set("filters", flattenSecurityFindingFilters(insight.Filters)); err != nil {
-		return diag.Errorf("setting filters: %s", err)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, insight, &data)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
-	d.Set("group_by_attribute", insight.GroupByAttribute)
-	d.Set(names.AttrName, insight.Name)
 
-	return nil
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
-func resourceInsightUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
-
-	input := &securityhub.UpdateInsightInput{
-		InsightArn: aws.String(d.Id()),
+func (r *insightResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new insightResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
-
-	if d.HasChange("filters") {
-		input.Filters = expandSecurityFindingFilters(d.Get("filters").([]interface{}))
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	if d.HasChange("group_by_attribute") {
-		input.GroupByAttribute = aws.String(d.Get("group_by_attribute").(string))
-	}
+	conn := r.Meta().SecurityHubClient(ctx)
 
-	if v, ok := d.GetOk(names.AttrName); ok {
-		input.Name = aws.String(v.(string))
-	}
+	if !new.Filters.Equal(old.Filters) ||
+		!new.GroupByAttribute.Equal(old.GroupByAttribute) ||
+		!new.Name.Equal(old.Name) {
+		input := &securityhub.UpdateInsightInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
 
-	_, err := conn.UpdateInsight(ctx, input)
+		_, err := conn.UpdateInsight(ctx, input)
 
-	if err != nil {
-		return diag.Errorf("updating Security Hub Insight (%s): %s", d.Id(), err)
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Hub Insight (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
 	}
 
-	return resourceInsightRead(ctx, d, meta)
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
 }
 
-func resourceInsightDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+func (r *insightResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data insightResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityHubClient(ctx)
 
-	log.Printf("[DEBUG] Deleting Security Hub Insight: %s", d.Id())
 	_, err := conn.DeleteInsight(ctx, &securityhub.DeleteInsightInput{
-		InsightArn: aws.String(d.Id()),
+		InsightArn: aws.String(data.ID.ValueString()),
 	})
 
 	if tfawserr.ErrCodeEquals(err, errCodeResourceNotFoundException) {
-		return nil
+		return
 	}
 
 	if err != nil {
-		return diag.Errorf("deleting Security Hub Insight (%s): %s", d.Id(), err)
-	}
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Hub Insight (%s)", data.ID.ValueString()), err.Error())
 
-	return nil
+		return
+	}
 }
 
-func findInsightByARN(ctx context.Context, conn *securityhub.Client, arn string) (*types.Insight, error) {
-	input := &securityhub.GetInsightsInput{
-		InsightArns: []string{arn},
+func (r *insightResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: upgradeInsightResourceStateV0toV1,
+		},
 	}
-
-	return findInsight(ctx, conn, input)
 }
 
-func findInsight(ctx context.Context, conn *securityhub.Client, input *securityhub.GetInsightsInput) (*types.Insight, error) {
-	output, err := findInsights(ctx, conn, input)
+// upgradeInsightResourceStateV0toV1 upgrades state written by the Plugin SDK implementation of the resource.
+// The SDK stored number filter values as strings and unset date filter bounds as empty strings.
+// The prior state is rewritten as raw JSON so that the filter schema is not duplicated.
+func upgradeInsightResourceStateV0toV1(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+	if request.RawState == nil || request.RawState.JSON == nil {
+		response.Diagnostics.AddError("upgrading Security Hub Insight state", "missing raw state")
 
-	if err != nil {
-		return nil, err
+		return
 	}
 
-	return tfresource.AssertSingleValueResult(output)
-}
+	var state map[string]any
+	if err := json.Unmarshal(request.RawState.JSON, &state); err != nil {
+		response.Diagnostics.AddError("upgrading Security Hub Insight state", err.Error())
 
-func findInsights(ctx context.Context, conn *securityhub.Client, input *securityhub.GetInsightsInput) ([]types.Insight, error) {
-	var output []types.Insight
+		return
+	}
 
-	pages := securityhub.NewGetInsightsPaginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
+	filters, _ := state["filters"].([]any)
+	for _, v := range filters {
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
 
-		if tfawserr.ErrCodeEquals(err, errCodeResourceNotFoundException) || tfawserr.ErrMessageContains(err, errCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
-			return nil, &retry.NotFoundError{
-				LastError:   err,
-				LastRequest: input,
+		for _, v := range tfMap {
+			tfList, ok := v.([]any)
+			if !ok {
+				continue
 			}
-		}
 
-		if err != nil {
-			return nil, err
+			for _, v := range tfList {
+				filter, ok := v.(map[string]any)
+				if !ok {
+					continue
+				}
+
+				for _, k := range []string{"eq", "gte", "lte"} {
+					s, ok := filter[k].(string)
+					if !ok {
+						continue
+					}
+
+					if s == "" {
+						filter[k] = nil
+						continue
+					}
+
+					f, err := strconv.ParseFloat(s, 64)
+					if err != nil {
+						response.Diagnostics.AddError("upgrading Security Hub Insight state", err.Error())
+
+						return
+					}
+
+					filter[k] = f
+				}
+
+				for _, k := range []string{"end", "start"} {
+					if s, ok := filter[k].(string); ok && s == "" {
+						filter[k] = nil
+					}
+				}
+			}
 		}
-
-		output = append(output, page.Insights...)
 	}
 
-	return output, nil
-}
+	b, err := json.Marshal(state)
+	if err != nil {
+		response.Diagnostics.AddError("upgrading Security Hub Insight state", err.Error())
 
-func dateFilterSchema() *schema.Schema {
-	return &schema.Schema{
-		Type:     schema.TypeSet,
-		Optional: true,
-		MaxItems: 20,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"date_range": {
-					Type:     schema.TypeList,
-					Optional: true,
-					MaxItems: 1,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							names.AttrUnit: {
-								Type:             schema.TypeString,
-								Required:         true,
-								ValidateDiagFunc: enum.Validate[types.DateRangeUnit](),
-							},
-							names.AttrValue: {
-								Type:     schema.TypeInt,
-								Required: true,
-							},
-						},
-					},
-				},
-				"end": {
-					Type:     schema.TypeString,
-					Optional: true,
-				},
-				"start": {
-					Type:     schema.TypeString,
-					Optional: true,
-				},
-			},
-		},
+		return
 	}
-}
 
-func ipFilterSchema() *schema.Schema {
-	return &schema.Schema{
-		Type:     schema.TypeSet,
-		Optional: true,
-		MaxItems: 20,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"cidr": {
-					Type:         schema.TypeString,
-					Required:     true,
-					ValidateFunc: verify.ValidCIDRNetworkAddress,
-				},
-			},
-		},
+	response.DynamicValue = &tfprotov6.DynamicValue{
+		JSON: b,
 	}
 }
 
-func keywordFilterSchema() *schema.Schema {
-	return &schema.Schema{
-		Type:     schema.TypeSet,
-		Optional: true,
-		MaxItems: 20,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				names.AttrValue: {
-					Type:     schema.TypeString,
-					Required: true,
+func ipFilterSchemaFramework(ctx context.Context) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[ipFilterModel](ctx),
+		Validators: []validator.Set{
+			setvalidator.SizeAtMost(20),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"cidr": schema.StringAttribute{
+					CustomType: fwtypes.CIDRBlockType,
+					Required:   true,
 				},
 			},
 		},
 	}
 }
 
-func mapFilterSchema() *schema.Schema {
-	return &schema.Schema{
-		Type:     schema.TypeSet,
-		Optional: true,
-		MaxItems: 20,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"comparison": {
-					Type:             schema.TypeString,
-					Required:         true,
-					ValidateDiagFunc: enum.Validate[types.MapFilterComparison](),
-				},
-				names.AttrKey: {
-					Type:     schema.TypeString,
-					Required: true,
-				},
-				names.AttrValue: {
-					Type:     schema.TypeString,
+func keywordFilterSchemaFramework(ctx context.Context) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[keywordFilterModel](ctx),
+		Validators: []validator.Set{
+			setvalidator.SizeAtMost(20),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrValue: schema.StringAttribute{
 					Required: true,
 				},
 			},
@@ -382,870 +408,186 @@ func mapFilterSchema() *schema.Schema {
 	}
 }
 
-func numberFilterSchema() *schema.Schema {
-	return &schema.Schema{
-		Type:     schema.TypeSet,
-		Optional: true,
-		MaxItems: 20,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"eq": {
-					Type:         schema.TypeString,
-					Optional:     true,
-					ValidateFunc: verify.ValidTypeStringNullableFloat,
-				},
-				"gte": {
-					Type:         schema.TypeString,
-					Optional:     true,
-					ValidateFunc: verify.ValidTypeStringNullableFloat,
-				},
-				"lte": {
-					Type:         schema.TypeString,
-					Optional:     true,
-					ValidateFunc: verify.ValidTypeStringNullableFloat,
-				},
-			},
+func workflowStatusFilterSchemaFramework(ctx context.Context) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[stringFilterModel](ctx),
+		Validators: []validator.Set{
+			setvalidator.SizeAtMost(20),
 		},
-	}
-}
-
-func stringFilterSchema() *schema.Schema {
-	return &schema.Schema{
-		Type:     schema.TypeSet,
-		Optional: true,
-		MaxItems: 20,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"comparison": {
-					Type:             schema.TypeString,
-					Required:         true,
-					ValidateDiagFunc: enum.Validate[types.StringFilterComparison](),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"comparison": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(stringFilterComparisonValues()...),
+					},
 				},
-				names.AttrValue: {
-					Type:     schema.TypeString,
+				names.AttrValue: schema.StringAttribute{
 					Required: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(enum.Values[awstypes.WorkflowStatus]()...),
+					},
 				},
 			},
 		},
 	}
 }
 
-func workflowStatusSchema() *schema.Schema {
-	s := stringFilterSchema()
-
-	s.Elem.(*schema.Resource).Schema[names.AttrValue].ValidateDiagFunc = enum.Validate[types.WorkflowStatus]()
-
-	return s
-}
-
-func expandDateFilterDateRange(l []interface{}) *types.DateRange {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	tfMap, ok := l[0].(map[string]interface{})
-	if !ok {
-		return nil
-	}
-
-	dr := &types.DateRange{}
-
-	if v, ok := tfMap[names.AttrUnit].(string); ok && v != "" {
-		dr.Unit = types.DateRangeUnit(v)
-	}
-
-	if v, ok := tfMap[names.AttrValue].(int); ok {
-		dr.Value = aws.Int32(int32(v))
-	}
-
-	return dr
-}
-
-func expandDateFilters(l []interface{}) []types.DateFilter {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	var dateFilters []types.DateFilter
-
-	for _, item := range l {
-		tfMap, ok := item.(map[string]interface{})
-		if !ok {
-			continue
-		}
-
-		df := types.DateFilter{}
-
-		if v, ok := tfMap["date_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
-			df.DateRange = expandDateFilterDateRange(v)
-		}
-
-		if v, ok := tfMap["end"].(string); ok && v != "" {
-			df.End = aws.String(v)
-		}
-
-		if v, ok := tfMap["start"].(string); ok && v != "" {
-			df.Start = aws.String(v)
-		}
-
-		dateFilters = append(dateFilters, df)
-	}
-
-	return dateFilters
-}
-
-func expandSecurityFindingFilters(l []interface{}) *types.AwsSecurityFindingFilters {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	tfMap, ok := l[0].(map[string]interface{})
-	if !ok {
-		return nil
-	}
-
-	filters := &types.AwsSecurityFindingFilters{}
-
-	if v, ok := tfMap["aws_account_id"].(*schema.Set); ok && v.Len() > 0 {
-		filters.AwsAccountId = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["company_name"].(*schema.Set); ok && v.Len() > 0 {
-		filters.CompanyName = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["compliance_status"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ComplianceStatus = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["confidence"].(*schema.Set); ok && v.Len() > 0 {
-		filters.Confidence = expandNumberFilters(v.List())
-	}
-
-	if v, ok := tfMap[names.AttrCreatedAt].(*schema.Set); ok && v.Len() > 0 {
-		filters.CreatedAt = expandDateFilters(v.List())
-	}
-
-	if v, ok := tfMap["criticality"].(*schema.Set); ok && v.Len() > 0 {
-		filters.Criticality = expandNumberFilters(v.List())
-	}
-
-	if v, ok := tfMap[names.AttrDescription].(*schema.Set); ok && v.Len() > 0 {
-		filters.Description = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["finding_provider_fields_confidence"].(*schema.Set); ok && v.Len() > 0 {
-		filters.FindingProviderFieldsConfidence = expandNumberFilters(v.List())
-	}
-
-	if v, ok := tfMap["finding_provider_fields_criticality"].(*schema.Set); ok && v.Len() > 0 {
-		filters.FindingProviderFieldsCriticality = expandNumberFilters(v.List())
-	}
-
-	if v, ok := tfMap["finding_provider_fields_related_findings_id"].(*schema.Set); ok && v.Len() > 0 {
-		filters.FindingProviderFieldsRelatedFindingsId = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["finding_provider_fields_related_findings_product_arn"].(*schema.Set); ok && v.Len() > 0 {
-		filters.FindingProviderFieldsRelatedFindingsProductArn = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["finding_provider_fields_severity_label"].(*schema.Set); ok && v.Len() > 0 {
-		filters.FindingProviderFieldsSeverityLabel = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["finding_provider_fields_severity_original"].(*schema.Set); ok && v.Len() > 0 {
-		filters.FindingProviderFieldsSeverityOriginal = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["finding_provider_fields_types"].(*schema.Set); ok && v.Len() > 0 {
-		filters.FindingProviderFieldsTypes = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["first_observed_at"].(*schema.Set); ok && v.Len() > 0 {
-		filters.FirstObservedAt = expandDateFilters(v.List())
-	}
-
-	if v, ok := tfMap["generator_id"].(*schema.Set); ok && v.Len() > 0 {
-		filters.GeneratorId = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap[names.AttrID].(*schema.Set); ok && v.Len() > 0 {
-		filters.Id = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["keyword"].(*schema.Set); ok && v.Len() > 0 {
-		filters.Keyword = expandKeywordFilters(v.List())
-	}
-
-	if v, ok := tfMap["last_observed_at"].(*schema.Set); ok && v.Len() > 0 {
-		filters.LastObservedAt = expandDateFilters(v.List())
-	}
-
-	if v, ok := tfMap["malware_name"].(*schema.Set); ok && v.Len() > 0 {
-		filters.MalwareName = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["malware_path"].(*schema.Set); ok && v.Len() > 0 {
-		filters.MalwarePath = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["malware_state"].(*schema.Set); ok && v.Len() > 0 {
-		filters.MalwareState = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["malware_type"].(*schema.Set); ok && v.Len() > 0 {
-		filters.MalwareType = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["network_destination_domain"].(*schema.Set); ok && v.Len() > 0 {
-		filters.NetworkDestinationDomain = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["network_destination_ipv4"].(*schema.Set); ok && v.Len() > 0 {
-		filters.NetworkDestinationIpV4 = expandIPFilters(v.List())
-	}
-
-	if v, ok := tfMap["network_destination_ipv6"].(*schema.Set); ok && v.Len() > 0 {
-		filters.NetworkDestinationIpV6 = expandIPFilters(v.List())
-	}
-
-	if v, ok := tfMap["network_destination_port"].(*schema.Set); ok && v.Len() > 0 {
-		filters.NetworkDestinationPort = expandNumberFilters(v.List())
-	}
-
-	if v, ok := tfMap["network_direction"].(*schema.Set); ok && v.Len() > 0 {
-		filters.NetworkDirection = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["network_protocol"].(*schema.Set); ok && v.Len() > 0 {
-		filters.NetworkProtocol = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["network_source_domain"].(*schema.Set); ok && v.Len() > 0 {
-		filters.NetworkSourceDomain = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["network_source_ipv4"].(*schema.Set); ok && v.Len() > 0 {
-		filters.NetworkSourceIpV4 = expandIPFilters(v.List())
-	}
-
-	if v, ok := tfMap["network_source_ipv6"].(*schema.Set); ok && v.Len() > 0 {
-		filters.NetworkSourceIpV6 = expandIPFilters(v.List())
-	}
-
-	if v, ok := tfMap["network_source_mac"].(*schema.Set); ok && v.Len() > 0 {
-		filters.NetworkSourceMac = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["network_source_port"].(*schema.Set); ok && v.Len() > 0 {
-		filters.NetworkSourcePort = expandNumberFilters(v.List())
-	}
-
-	if v, ok := tfMap["note_text"].(*schema.Set); ok && v.Len() > 0 {
-		filters.NoteText = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["note_updated_at"].(*schema.Set); ok && v.Len() > 0 {
-		filters.NoteUpdatedAt = expandDateFilters(v.List())
-	}
-
-	if v, ok := tfMap["note_updated_by"].(*schema.Set); ok && v.Len() > 0 {
-		filters.NoteUpdatedBy = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["process_launched_at"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ProcessLaunchedAt = expandDateFilters(v.List())
-	}
-
-	if v, ok := tfMap["process_name"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ProcessName = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["process_parent_pid"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ProcessParentPid = expandNumberFilters(v.List())
-	}
-
-	if v, ok := tfMap["process_path"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ProcessPath = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["process_pid"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ProcessPid = expandNumberFilters(v.List())
-	}
-
-	if v, ok := tfMap["process_terminated_at"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ProcessTerminatedAt = expandDateFilters(v.List())
-	}
-
-	if v, ok := tfMap["product_arn"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ProductArn = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["product_fields"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ProductFields = expandMapFilters(v.List())
-	}
-
-	if v, ok := tfMap["product_name"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ProductName = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["recommendation_text"].(*schema.Set); ok && v.Len() > 0 {
-		filters.RecommendationText = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["record_state"].(*schema.Set); ok && v.Len() > 0 {
-		filters.RecordState = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["related_findings_id"].(*schema.Set); ok && v.Len() > 0 {
-		filters.RelatedFindingsId = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["related_findings_product_arn"].(*schema.Set); ok && v.Len() > 0 {
-		filters.RelatedFindingsProductArn = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_aws_ec2_instance_iam_instance_profile_arn"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceAwsEc2InstanceIamInstanceProfileArn = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_aws_ec2_instance_image_id"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceAwsEc2InstanceImageId = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_aws_ec2_instance_ipv4_addresses"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceAwsEc2InstanceIpV4Addresses = expandIPFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_aws_ec2_instance_ipv6_addresses"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceAwsEc2InstanceIpV6Addresses = expandIPFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_aws_ec2_instance_key_name"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceAwsEc2InstanceKeyName = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_aws_ec2_instance_launched_at"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceAwsEc2InstanceLaunchedAt = expandDateFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_aws_ec2_instance_subnet_id"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceAwsEc2InstanceSubnetId = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_aws_ec2_instance_type"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceAwsEc2InstanceType = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_aws_ec2_instance_vpc_id"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceAwsEc2InstanceVpcId = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_aws_iam_access_key_created_at"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceAwsIamAccessKeyCreatedAt = expandDateFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_aws_iam_access_key_status"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceAwsIamAccessKeyStatus = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_aws_iam_access_key_user_name"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceAwsIamAccessKeyUserName = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_aws_s3_bucket_owner_id"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceAwsS3BucketOwnerId = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_aws_s3_bucket_owner_name"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceAwsS3BucketOwnerName = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_container_image_id"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceContainerImageId = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_container_image_name"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceContainerImageName = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_container_launched_at"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceContainerLaunchedAt = expandDateFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_container_name"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceContainerName = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_details_other"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceDetailsOther = expandMapFilters(v.List())
-	}
-
-	if v, ok := tfMap[names.AttrResourceID].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceId = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_partition"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourcePartition = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_region"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceRegion = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["resource_tags"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceTags = expandMapFilters(v.List())
-	}
-
-	if v, ok := tfMap[names.AttrResourceType].(*schema.Set); ok && v.Len() > 0 {
-		filters.ResourceType = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["severity_label"].(*schema.Set); ok && v.Len() > 0 {
-		filters.SeverityLabel = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["source_url"].(*schema.Set); ok && v.Len() > 0 {
-		filters.SourceUrl = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["threat_intel_indicator_category"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ThreatIntelIndicatorCategory = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["threat_intel_indicator_last_observed_at"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ThreatIntelIndicatorLastObservedAt = expandDateFilters(v.List())
-	}
-
-	if v, ok := tfMap["threat_intel_indicator_source"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ThreatIntelIndicatorSource = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["threat_intel_indicator_source_url"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ThreatIntelIndicatorSourceUrl = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["threat_intel_indicator_type"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ThreatIntelIndicatorType = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["threat_intel_indicator_value"].(*schema.Set); ok && v.Len() > 0 {
-		filters.ThreatIntelIndicatorValue = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["title"].(*schema.Set); ok && v.Len() > 0 {
-		filters.Title = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap[names.AttrType].(*schema.Set); ok && v.Len() > 0 {
-		filters.Type = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["updated_at"].(*schema.Set); ok && v.Len() > 0 {
-		filters.UpdatedAt = expandDateFilters(v.List())
-	}
-
-	if v, ok := tfMap["user_defined_values"].(*schema.Set); ok && v.Len() > 0 {
-		filters.UserDefinedFields = expandMapFilters(v.List())
-	}
-
-	if v, ok := tfMap["verification_state"].(*schema.Set); ok && v.Len() > 0 {
-		filters.VerificationState = expandStringFilters(v.List())
-	}
-
-	if v, ok := tfMap["workflow_status"].(*schema.Set); ok && v.Len() > 0 {
-		filters.WorkflowStatus = expandStringFilters(v.List())
-	}
-
-	return filters
-}
-
-func expandIPFilters(l []interface{}) []types.IpFilter {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	var ipFilters []types.IpFilter
-
-	for _, item := range l {
-		tfMap, ok := item.(map[string]interface{})
-		if !ok {
-			continue
-		}
-
-		ipFilter := types.IpFilter{}
-
-		if v, ok := tfMap["cidr"].(string); ok && v != "" {
-			ipFilter.Cidr = aws.String(v)
-		}
-
-		ipFilters = append(ipFilters, ipFilter)
-	}
-
-	return ipFilters
-}
-
-func expandKeywordFilters(l []interface{}) []types.KeywordFilter {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	var keywordFilters []types.KeywordFilter
-
-	for _, item := range l {
-		tfMap, ok := item.(map[string]interface{})
-		if !ok {
-			continue
-		}
-
-		kf := types.KeywordFilter{}
-
-		if v, ok := tfMap[names.AttrValue].(string); ok && v != "" {
-			kf.Value = aws.String(v)
-		}
-
-		keywordFilters = append(keywordFilters, kf)
+func findInsightByARN(ctx context.Context, conn *securityhub.Client, arn string) (*awstypes.Insight, error) {
+	input := &securityhub.GetInsightsInput{
+		InsightArns: []string{arn},
 	}
 
-	return keywordFilters
+	return findInsight(ctx, conn, input)
 }
 
-func expandMapFilters(l []interface{}) []types.MapFilter {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	var mapFilters []types.MapFilter
-
-	for _, item := range l {
-		tfMap, ok := item.(map[string]interface{})
-		if !ok {
-			continue
-		}
-
-		mf := types.MapFilter{}
-
-		if v, ok := tfMap["comparison"].(string); ok && v != "" {
-			mf.Comparison = types.MapFilterComparison(v)
-		}
-
-		if v, ok := tfMap[names.AttrKey].(string); ok && v != "" {
-			mf.Key = aws.String(v)
-		}
-
-		if v, ok := tfMap[names.AttrValue].(string); ok && v != "" {
-			mf.Value = aws.String(v)
-		}
+func findInsight(ctx context.Context, conn *securityhub.Client, input *securityhub.GetInsightsInput) (*awstypes.Insight, error) {
+	output, err := findInsights(ctx, conn, input)
 
-		mapFilters = append(mapFilters, mf)
+	if err != nil {
+		return nil, err
 	}
 
-	return mapFilters
+	return tfresource.AssertSingleValueResult(output)
 }
 
-func expandNumberFilters(l []interface{}) []types.NumberFilter {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	var numFilters []types.NumberFilter
-
-	for _, item := range l {
-		tfMap, ok := item.(map[string]interface{})
-		if !ok {
-			continue
-		}
-
-		nf := types.NumberFilter{}
+func findInsights(ctx context.Context, conn *securityhub.Client, input *securityhub.GetInsightsInput) ([]awstypes.Insight, error) {
+	var output []awstypes.Insight
 
-		if v, ok := tfMap["eq"].(string); ok && v != "" {
-			val, err := strconv.ParseFloat(v, 64)
-			if err == nil {
-				nf.Eq = aws.Float64(val)
-			}
-		}
-
-		if v, ok := tfMap["gte"].(string); ok && v != "" {
-			val, err := strconv.ParseFloat(v, 64)
-			if err == nil {
-				nf.Gte = aws.Float64(val)
-			}
-		}
+	pages := securityhub.NewGetInsightsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
 
-		if v, ok := tfMap["lte"].(string); ok && v != "" {
-			val, err := strconv.ParseFloat(v, 64)
-			if err == nil {
-				nf.Lte = aws.Float64(val)
+		if tfawserr.ErrCodeEquals(err, errCodeResourceNotFoundException) || tfawserr.ErrMessageContains(err, errCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
 			}
 		}
 
-		numFilters = append(numFilters, nf)
-	}
-
-	return numFilters
-}
-
-func expandStringFilters(l []interface{}) []types.StringFilter {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-
-	var stringFilters []types.StringFilter
-
-	for _, item := range l {
-		tfMap, ok := item.(map[string]interface{})
-		if !ok {
-			continue
-		}
-
-		sf := types.StringFilter{}
-
-		if v, ok := tfMap["comparison"].(string); ok && v != "" {
-			sf.Comparison = types.StringFilterComparison(v)
-		}
-
-		if v, ok := tfMap[names.AttrValue].(string); ok && v != "" {
-			sf.Value = aws.String(v)
-		}
-
-		stringFilters = append(stringFilters, sf)
-	}
-
-	return stringFilters
-}
-
-func flattenDateFilterDateRange(dateRange *types.DateRange) []interface{} {
-	if dateRange == nil {
-		return nil
-	}
-
-	m := map[string]interface{}{
-		names.AttrUnit:  string(dateRange.Unit),
-		names.AttrValue: aws.ToInt32((dateRange.Value)),
-	}
-
-	return []interface{}{m}
-}
-
-func flattenDateFilters(filters []types.DateFilter) []interface{} {
-	if len(filters) == 0 {
-		return nil
-	}
-
-	var dateFilters []interface{}
-
-	for _, filter := range filters {
-		m := map[string]interface{}{
-			"date_range": flattenDateFilterDateRange(filter.DateRange),
-			"end":        aws.ToString(filter.End),
-			"start":      aws.ToString(filter.Start),
+		if err != nil {
+			return nil, err
 		}
 
-		dateFilters = append(dateFilters, m)
+		output = append(output, page.Insights...)
 	}
 
-	return dateFilters
+	return output, nil
 }
 
-func flattenIPFilters(filters []types.IpFilter) []interface{} {
-	if len(filters) == 0 {
-		return nil
-	}
-
-	var ipFilters []interface{}
-
-	for _, filter := range filters {
-		m := map[string]interface{}{
-			"cidr": aws.ToString(filter.Cidr),
-		}
-
-		ipFilters = append(ipFilters, m)
-	}
-
-	return ipFilters
+type insightResourceModel struct {
+	Filters          fwtypes.ListNestedObjectValueOf[insightFiltersModel] `tfsdk:"filters"`
+	GroupByAttribute types.String                                         `tfsdk:"group_by_attribute"`
+	ID               types.String                                         `tfsdk:"id"`
+	InsightARN       types.String                                         `tfsdk:"arn"`
+	Name             types.String                                         `tfsdk:"name"`
 }
 
-func flattenKeywordFilters(filters []types.KeywordFilter) []interface{} {
-	if len(filters) == 0 {
-		return nil
-	}
-
-	var keywordFilters []interface{}
+func (data *insightResourceModel) InitFromID() error {
+	data.InsightARN = data.ID
 
-	for _, filter := range filters {
-		m := map[string]interface{}{
-			names.AttrValue: aws.ToString(filter.Value),
-		}
-
-		keywordFilters = append(keywordFilters, m)
-	}
-
-	return keywordFilters
+	return nil
 }
 
-func flattenMapFilters(filters []types.MapFilter) []interface{} {
-	if len(filters) == 0 {
-		return nil
-	}
-
-	var mapFilters []interface{}
-
-	for _, filter := range filters {
-		m := map[string]interface{}{
-			"comparison":    string(filter.Comparison),
-			names.AttrKey:   aws.ToString(filter.Key),
-			names.AttrValue: aws.ToString(filter.Value),
-		}
-
-		mapFilters = append(mapFilters, m)
-	}
-
-	return mapFilters
+func (data *insightResourceModel) setID() {
+	data.ID = data.InsightARN
 }
 
-func flattenNumberFilters(filters []types.NumberFilter) []interface{} {
-	if len(filters) == 0 {
-		return nil
-	}
-
-	var numFilters []interface{}
-
-	for _, filter := range filters {
-		m := map[string]interface{}{}
-
-		if filter.Eq != nil {
-			m["eq"] = strconv.FormatFloat(aws.ToFloat64(filter.Eq), 'f', -1, 64)
-		}
-
-		if filter.Gte != nil {
-			m["gte"] = strconv.FormatFloat(aws.ToFloat64(filter.Gte), 'f', -1, 64)
-		}
-
-		if filter.Lte != nil {
-			m["lte"] = strconv.FormatFloat(aws.ToFloat64(filter.Lte), 'f', -1, 64)
-		}
-
-		numFilters = append(numFilters, m)
-	}
-
-	return numFilters
+type insightFiltersModel struct {
+	AWSAccountID                                   fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"aws_account_id"`
+	CompanyName                                    fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"company_name"`
+	ComplianceStatus                               fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"compliance_status"`
+	Confidence                                     fwtypes.SetNestedObjectValueOf[numberFilterModel]  `tfsdk:"confidence"`
+	CreatedAt                                      fwtypes.SetNestedObjectValueOf[dateFilterModel]    `tfsdk:"created_at"`
+	Criticality                                    fwtypes.SetNestedObjectValueOf[numberFilterModel]  `tfsdk:"criticality"`
+	Description                                    fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"description"`
+	FindingProviderFieldsConfidence                fwtypes.SetNestedObjectValueOf[numberFilterModel]  `tfsdk:"finding_provider_fields_confidence"`
+	FindingProviderFieldsCriticality               fwtypes.SetNestedObjectValueOf[numberFilterModel]  `tfsdk:"finding_provider_fields_criticality"`
+	FindingProviderFieldsRelatedFindingsID         fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"finding_provider_fields_related_findings_id"`
+	FindingProviderFieldsRelatedFindingsProductARN fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"finding_provider_fields_related_findings_product_arn"`
+	FindingProviderFieldsSeverityLabel             fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"finding_provider_fields_severity_label"`
+	FindingProviderFieldsSeverityOriginal          fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"finding_provider_fields_severity_original"`
+	FindingProviderFieldsTypes                     fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"finding_provider_fields_types"`
+	FirstObservedAt                                fwtypes.SetNestedObjectValueOf[dateFilterModel]    `tfsdk:"first_observed_at"`
+	GeneratorID                                    fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"generator_id"`
+	ID                                             fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"id"`
+	Keyword                                        fwtypes.SetNestedObjectValueOf[keywordFilterModel] `tfsdk:"keyword"`
+	LastObservedAt                                 fwtypes.SetNestedObjectValueOf[dateFilterModel]    `tfsdk:"last_observed_at"`
+	MalwareName                                    fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"malware_name"`
+	MalwarePath                                    fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"malware_path"`
+	MalwareState                                   fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"malware_state"`
+	MalwareType                                    fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"malware_type"`
+	NetworkDestinationDomain                       fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"network_destination_domain"`
+	NetworkDestinationIPv4                         fwtypes.SetNestedObjectValueOf[ipFilterModel]      `tfsdk:"network_destination_ipv4"`
+	NetworkDestinationIPv6                         fwtypes.SetNestedObjectValueOf[ipFilterModel]      `tfsdk:"network_destination_ipv6"`
+	NetworkDestinationPort                         fwtypes.SetNestedObjectValueOf[numberFilterModel]  `tfsdk:"network_destination_port"`
+	NetworkDirection                               fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"network_direction"`
+	NetworkProtocol                                fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"network_protocol"`
+	NetworkSourceDomain                            fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"network_source_domain"`
+	NetworkSourceIPv4                              fwtypes.SetNestedObjectValueOf[ipFilterModel]      `tfsdk:"network_source_ipv4"`
+	NetworkSourceIPv6                              fwtypes.SetNestedObjectValueOf[ipFilterModel]      `tfsdk:"network_source_ipv6"`
+	NetworkSourceMAC                               fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"network_source_mac"`
+	NetworkSourcePort                              fwtypes.SetNestedObjectValueOf[numberFilterModel]  `tfsdk:"network_source_port"`
+	NoteText                                       fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"note_text"`
+	NoteUpdatedAt                                  fwtypes.SetNestedObjectValueOf[dateFilterModel]    `tfsdk:"note_updated_at"`
+	NoteUpdatedBy                                  fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"note_updated_by"`
+	ProcessLaunchedAt                              fwtypes.SetNestedObjectValueOf[dateFilterModel]    `tfsdk:"process_launched_at"`
+	ProcessName                                    fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"process_name"`
+	ProcessPID                                     fwtypes.SetNestedObjectValueOf[numberFilterModel]  `tfsdk:"process_pid"`
+	ProcessParentPID                               fwtypes.SetNestedObjectValueOf[numberFilterModel]  `tfsdk:"process_parent_pid"`
+	ProcessPath                                    fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"process_path"`
+	ProcessTerminatedAt                            fwtypes.SetNestedObjectValueOf[dateFilterModel]    `tfsdk:"process_terminated_at"`
+	ProductARN                                     fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"product_arn"`
+	ProductFields                                  fwtypes.SetNestedObjectValueOf[mapFilterModel]     `tfsdk:"product_fields"`
+	ProductName                                    fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"product_name"`
+	RecommendationText                             fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"recommendation_text"`
+	RecordState                                    fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"record_state"`
+	RelatedFindingsID                              fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"related_findings_id"`
+	RelatedFindingsProductARN                      fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"related_findings_product_arn"`
+	ResourceAWSEC2InstanceIAMInstanceProfileARN    fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"resource_aws_ec2_instance_iam_instance_profile_arn"`
+	ResourceAWSEC2InstanceIPv4Addresses            fwtypes.SetNestedObjectValueOf[ipFilterModel]      `tfsdk:"resource_aws_ec2_instance_ipv4_addresses"`
+	ResourceAWSEC2InstanceIPv6Addresses            fwtypes.SetNestedObjectValueOf[ipFilterModel]      `tfsdk:"resource_aws_ec2_instance_ipv6_addresses"`
+	ResourceAWSEC2InstanceImageID                  fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"resource_aws_ec2_instance_image_id"`
+	ResourceAWSEC2InstanceKeyName                  fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"resource_aws_ec2_instance_key_name"`
+	ResourceAWSEC2InstanceLaunchedAt               fwtypes.SetNestedObjectValueOf[dateFilterModel]    `tfsdk:"resource_aws_ec2_instance_launched_at"`
+	ResourceAWSEC2InstanceSubnetID                 fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"resource_aws_ec2_instance_subnet_id"`
+	ResourceAWSEC2InstanceType                     fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"resource_aws_ec2_instance_type"`
+	ResourceAWSEC2InstanceVpcID                    fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"resource_aws_ec2_instance_vpc_id"`
+	ResourceAWSIAMAccessKeyCreatedAt               fwtypes.SetNestedObjectValueOf[dateFilterModel]    `tfsdk:"resource_aws_iam_access_key_created_at"`
+	ResourceAWSIAMAccessKeyStatus                  fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"resource_aws_iam_access_key_status"`
+	ResourceAWSIAMAccessKeyUserName                fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"resource_aws_iam_access_key_user_name"`
+	ResourceAWSS3BucketOwnerID                     fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"resource_aws_s3_bucket_owner_id"`
+	ResourceAWSS3BucketOwnerName                   fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"resource_aws_s3_bucket_owner_name"`
+	ResourceContainerImageID                       fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"resource_container_image_id"`
+	ResourceContainerImageName                     fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"resource_container_image_name"`
+	ResourceContainerLaunchedAt                    fwtypes.SetNestedObjectValueOf[dateFilterModel]    `tfsdk:"resource_container_launched_at"`
+	ResourceContainerName                          fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"resource_container_name"`
+	ResourceDetailsOther                           fwtypes.SetNestedObjectValueOf[mapFilterModel]     `tfsdk:"resource_details_other"`
+	ResourceID                                     fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"resource_id"`
+	ResourcePartition                              fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"resource_partition"`
+	ResourceRegion                                 fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"resource_region"`
+	ResourceTags                                   fwtypes.SetNestedObjectValueOf[mapFilterModel]     `tfsdk:"resource_tags"`
+	ResourceType                                   fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"resource_type"`
+	SeverityLabel                                  fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"severity_label"`
+	SourceURL                                      fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"source_url"`
+	ThreatIntelIndicatorCategory                   fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"threat_intel_indicator_category"`
+	ThreatIntelIndicatorLastObservedAt             fwtypes.SetNestedObjectValueOf[dateFilterModel]    `tfsdk:"threat_intel_indicator_last_observed_at"`
+	ThreatIntelIndicatorSource                     fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"threat_intel_indicator_source"`
+	ThreatIntelIndicatorSourceURL                  fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"threat_intel_indicator_source_url"`
+	ThreatIntelIndicatorType                       fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"threat_intel_indicator_type"`
+	ThreatIntelIndicatorValue                      fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"threat_intel_indicator_value"`
+	Title                                          fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"title"`
+	Type                                           fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"type"`
+	UpdatedAt                                      fwtypes.SetNestedObjectValueOf[dateFilterModel]    `tfsdk:"updated_at"`
+	UserDefinedFields                              fwtypes.SetNestedObjectValueOf[mapFilterModel]     `tfsdk:"user_defined_values"`
+	VerificationState                              fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"verification_state"`
+	WorkflowStatus                                 fwtypes.SetNestedObjectValueOf[stringFilterModel]  `tfsdk:"workflow_status"`
 }
 
-func flattenSecurityFindingFilters(filters *types.AwsSecurityFindingFilters) []interface{} {
-	if filters == nil {
-		return nil
-	}
-
-	m := map[string]interface{}{
-		"aws_account_id":                              flattenStringFilters(filters.AwsAccountId),
-		"company_name":                                flattenStringFilters(filters.CompanyName),
-		"compliance_status":                           flattenStringFilters(filters.ComplianceStatus),
-		"confidence":                                  flattenNumberFilters(filters.Confidence),
-		names.AttrCreatedAt:                           flattenDateFilters(filters.CreatedAt),
-		"criticality":                                 flattenNumberFilters(filters.Criticality),
-		names.AttrDescription:                         flattenStringFilters(filters.Description),
-		"finding_provider_fields_confidence":          flattenNumberFilters(filters.FindingProviderFieldsConfidence),
-		"finding_provider_fields_criticality":         flattenNumberFilters(filters.FindingProviderFieldsCriticality),
-		"finding_provider_fields_related_findings_id": flattenStringFilters(filters.FindingProviderFieldsRelatedFindingsId),
-		"finding_provider_fields_related_findings_product_arn": flattenStringFilters(filters.FindingProviderFieldsRelatedFindingsProductArn),
-		"finding_provider_fields_severity_label":               flattenStringFilters(filters.FindingProviderFieldsSeverityLabel),
-		"finding_provider_fields_severity_original":            flattenStringFilters(filters.FindingProviderFieldsSeverityOriginal),
-		"finding_provider_fields_types":                        flattenStringFilters(filters.FindingProviderFieldsTypes),
-		"first_observed_at":                                    flattenDateFilters(filters.FirstObservedAt),
-		"generator_id":                                         flattenStringFilters(filters.GeneratorId),
-		names.AttrID:                                           flattenStringFilters(filters.Id),
-		"keyword":                                              flattenKeywordFilters(filters.Keyword),
-		"last_observed_at":                                     flattenDateFilters(filters.LastObservedAt),
-		"malware_name":                                         flattenStringFilters(filters.MalwareName),
-		"malware_path":                                         flattenStringFilters(filters.MalwarePath),
-		"malware_state":                                        flattenStringFilters(filters.MalwareState),
-		"malware_type":                                         flattenStringFilters(filters.MalwareType),
-		"network_destination_domain":                           flattenStringFilters(filters.NetworkDestinationDomain),
-		"network_destination_ipv4":                             flattenIPFilters(filters.NetworkDestinationIpV4),
-		"network_destination_ipv6":                             flattenIPFilters(filters.NetworkDestinationIpV6),
-		"network_destination_port":                             flattenNumberFilters(filters.NetworkDestinationPort),
-		"network_direction":                                    flattenStringFilters(filters.NetworkDirection),
-		"network_protocol":                                     flattenStringFilters(filters.NetworkProtocol),
-		"network_source_domain":                                flattenStringFilters(filters.NetworkSourceDomain),
-		"network_source_ipv4":                                  flattenIPFilters(filters.NetworkSourceIpV4),
-		"network_source_ipv6":                                  flattenIPFilters(filters.NetworkSourceIpV6),
-		"network_source_mac":                                   flattenStringFilters(filters.NetworkSourceMac),
-		"network_source_port":                                  flattenNumberFilters(filters.NetworkSourcePort),
-		"note_text":                                            flattenStringFilters(filters.NoteText),
-		"note_updated_at":                                      flattenDateFilters(filters.NoteUpdatedAt),
-		"note_updated_by":                                      flattenStringFilters(filters.NoteUpdatedBy),
-		"process_launched_at":                                  flattenDateFilters(filters.ProcessLaunchedAt),
-		"process_name":                                         flattenStringFilters(filters.ProcessName),
-		"process_parent_pid":                                   flattenNumberFilters(filters.ProcessParentPid),
-		"process_path":                                         flattenStringFilters(filters.ProcessPath),
-		"process_pid":                                          flattenNumberFilters(filters.ProcessPid),
-		"process_terminated_at":                                flattenDateFilters(filters.ProcessTerminatedAt),
-		"product_arn":                                          flattenStringFilters(filters.ProductArn),
-		"product_fields":                                       flattenMapFilters(filters.ProductFields),
-		"product_name":                                         flattenStringFilters(filters.ProductName),
-		"recommendation_text":                                  flattenStringFilters(filters.RecommendationText),
-		"record_state":                                         flattenStringFilters(filters.RecordState),
-		"related_findings_id":                                  flattenStringFilters(filters.RelatedFindingsId),
-		"related_findings_product_arn":                         flattenStringFilters(filters.RelatedFindingsProductArn),
-		"resource_aws_ec2_instance_iam_instance_profile_arn": flattenStringFilters(filters.ResourceAwsEc2InstanceIamInstanceProfileArn),
-		"resource_aws_ec2_instance_image_id":                 flattenStringFilters(filters.ResourceAwsEc2InstanceImageId),
-		"resource_aws_ec2_instance_ipv4_addresses":           flattenIPFilters(filters.ResourceAwsEc2InstanceIpV4Addresses),
-		"resource_aws_ec2_instance_ipv6_addresses":           flattenIPFilters(filters.ResourceAwsEc2InstanceIpV6Addresses),
-		"resource_aws_ec2_instance_key_name":                 flattenStringFilters(filters.ResourceAwsEc2InstanceKeyName),
-		"resource_aws_ec2_instance_launched_at":              flattenDateFilters(filters.ResourceAwsEc2InstanceLaunchedAt),
-		"resource_aws_ec2_instance_subnet_id":                flattenStringFilters(filters.ResourceAwsEc2InstanceSubnetId),
-		"resource_aws_ec2_instance_type":                     flattenStringFilters(filters.ResourceAwsEc2InstanceType),
-		"resource_aws_ec2_instance_vpc_id":                   flattenStringFilters(filters.ResourceAwsEc2InstanceVpcId),
-		"resource_aws_iam_access_key_created_at":             flattenDateFilters(filters.ResourceAwsIamAccessKeyCreatedAt),
-		"resource_aws_iam_access_key_status":                 flattenStringFilters(filters.ResourceAwsIamAccessKeyStatus),
-		"resource_aws_iam_access_key_user_name":              flattenStringFilters(filters.ResourceAwsIamAccessKeyUserName),
-		"resource_aws_s3_bucket_owner_id":                    flattenStringFilters(filters.ResourceAwsS3BucketOwnerId),
-		"resource_aws_s3_bucket_owner_name":                  flattenStringFilters(filters.ResourceAwsS3BucketOwnerName),
-		"resource_container_image_id":                        flattenStringFilters(filters.ResourceContainerImageId),
-		"resource_container_image_name":                      flattenStringFilters(filters.ResourceContainerImageName),
-		"resource_container_launched_at":                     flattenDateFilters(filters.ResourceContainerLaunchedAt),
-		"resource_container_name":                            flattenStringFilters(filters.ResourceContainerName),
-		"resource_details_other":                             flattenMapFilters(filters.ResourceDetailsOther),
-		names.AttrResourceID:                                 flattenStringFilters(filters.ResourceId),
-		"resource_partition":                                 flattenStringFilters(filters.ResourcePartition),
-		"resource_region":                                    flattenStringFilters(filters.ResourceRegion),
-		"resource_tags":                                      flattenMapFilters(filters.ResourceTags),
-		names.AttrResourceType:                               flattenStringFilters(filters.ResourceType),
-		"severity_label":                                     flattenStringFilters(filters.SeverityLabel),
-		"source_url":                                         flattenStringFilters(filters.ThreatIntelIndicatorSourceUrl),
-		"threat_intel_indicator_category":                    flattenStringFilters(filters.ThreatIntelIndicatorCategory),
-		"threat_intel_indicator_last_observed_at":            flattenDateFilters(filters.ThreatIntelIndicatorLastObservedAt),
-		"threat_intel_indicator_source":                      flattenStringFilters(filters.ThreatIntelIndicatorSource),
-		"threat_intel_indicator_source_url":                  flattenStringFilters(filters.ThreatIntelIndicatorSourceUrl),
-		"threat_intel_indicator_type":                        flattenStringFilters(filters.ThreatIntelIndicatorType),
-		"threat_intel_indicator_value":                       flattenStringFilters(filters.ThreatIntelIndicatorValue),
-		"title":                                              flattenStringFilters(filters.Title),
-		names.AttrType:                                       flattenStringFilters(filters.Type),
-		"updated_at":                                         flattenDateFilters(filters.UpdatedAt),
-		"user_defined_values":                                flattenMapFilters(filters.UserDefinedFields),
-		"verification_state":                                 flattenStringFilters(filters.VerificationState),
-		"workflow_status":                                    flattenStringFilters(filters.WorkflowStatus),
-	}
-
-	return []interface{}{m}
+type ipFilterModel struct {
+	CIDR fwtypes.CIDRBlock `tfsdk:"cidr"`
 }
 
-func flattenStringFilters(filters []types.StringFilter) []interface{} {
-	if len(filters) == 0 {
-		return nil
-	}
-
-	var stringFilters []interface{}
-
-	for _, filter := range filters {
-		m := map[string]interface{}{
-			"comparison":    string(filter.Comparison),
-			names.AttrValue: aws.ToString(filter.Value),
-		}
-
-		stringFilters = append(stringFilters, m)
-	}
-
-	return stringFilters
+type keywordFilterModel struct {
+	Value types.String `tfsdk:"value"`
 }
@@ -71,7 +71,7 @@ func testAccInsight_disappears(t *testing.T) {
 				Config: testAccInsightConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckInsightExists(ctx, resourceName),
-					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsecurityhub.ResourceInsight(), resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfsecurityhub.ResourceInsight, resourceName),
 				),
 				ExpectNonEmptyPlan: true,
 			},
@@ -418,6 +418,54 @@ func testAccInsight_GroupByAttribute(t *testing.T) {
 	})
 }
 
+func testAccInsight_MigrateFromPluginSDK(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_insight.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		CheckDestroy: testAccCheckInsightDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"aws": {
+						Source:            "hashicorp/aws",
+						VersionConstraint: "5.49.0",
+					},
+				},
+				Config: testAccInsightConfig_multipleFilters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInsightExists(ctx, resourceName),
+				),
+			},
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				Config:                   testAccInsightConfig_multipleFilters(rName),
+				PlanOnly:                 true,
+			},
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"aws": {
+						Source:            "hashicorp/aws",
+						VersionConstraint: "5.49.0",
+					},
+				},
+				Config: testAccInsightConfig_numberFilters(rName, "eq = 50.5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInsightExists(ctx, resourceName),
+				),
+			},
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				Config:                   testAccInsightConfig_numberFilters(rName, "eq = 50.5"),
+				PlanOnly:                 true,
+			},
+		},
+	})
+}
+
 func testAccInsight_WorkflowStatus(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
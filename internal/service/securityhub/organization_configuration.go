@@ -6,6 +6,7 @@ package securityhub
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -36,6 +38,7 @@ func resourceOrganizationConfiguration() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(180 * time.Second),
+			Read:   schema.DefaultTimeout(180 * time.Second),
 			Update: schema.DefaultTimeout(180 * time.Second),
 			Delete: schema.DefaultTimeout(180 * time.Second),
 		},
@@ -68,7 +71,48 @@ func resourceOrganizationConfiguration() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			resourceOrganizationConfigurationCustomizeDiff,
+		),
+	}
+}
+
+func resourceOrganizationConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	// An omitted organization_configuration block means LOCAL configuration.
+	// Planning the default detects a switch to CENTRAL configuration made outside of Terraform.
+	if v := d.GetRawConfig().GetAttr("organization_configuration"); v.IsKnown() && (v.IsNull() || v.LengthInt() == 0) {
+		if d.Id() != "" && d.Get("organization_configuration.0.configuration_type").(string) != string(types.OrganizationConfigurationConfigurationTypeLocal) {
+			if err := d.SetNew("organization_configuration", []interface{}{
+				map[string]interface{}{
+					"configuration_type": string(types.OrganizationConfigurationConfigurationTypeLocal),
+				},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.Get("organization_configuration.0.configuration_type").(string) != string(types.OrganizationConfigurationConfigurationTypeCentral) {
+		return nil
+	}
+
+	// With CENTRAL configuration, new accounts are configured by configuration policies.
+	if d.Get("auto_enable").(bool) {
+		return fmt.Errorf(`auto_enable must be false when organization_configuration.configuration_type is %q`, types.OrganizationConfigurationConfigurationTypeCentral)
+	}
+
+	if v := d.GetRawConfig().GetAttr("auto_enable_standards"); !v.IsKnown() {
+		return nil
+	} else if !v.IsNull() {
+		if v.AsString() != string(types.AutoEnableStandardsNone) {
+			return fmt.Errorf(`auto_enable_standards must be %q when organization_configuration.configuration_type is %q`, types.AutoEnableStandardsNone, types.OrganizationConfigurationConfigurationTypeCentral)
+		}
+	} else if d.Get("auto_enable_standards").(string) != string(types.AutoEnableStandardsNone) {
+		return d.SetNew("auto_enable_standards", string(types.AutoEnableStandardsNone))
 	}
+
+	return nil
 }
 
 func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -105,12 +149,8 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 		d.SetId(meta.(*conns.AWSClient).AccountID)
 	}
 
-	configurationType := types.OrganizationConfigurationConfigurationTypeLocal
-	if input.OrganizationConfiguration != nil {
-		configurationType = input.OrganizationConfiguration.ConfigurationType
-	}
-
-	if configurationType == types.OrganizationConfigurationConfigurationTypeCentral {
+	// Switching between LOCAL and CENTRAL configuration is asynchronous.
+	if d.IsNewResource() || d.HasChange("organization_configuration") {
 		if _, err := waitOrganizationConfigurationEnabled(ctx, conn, timeout); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Organization Configuration (%s) enable: %s", d.Id(), err)
 		}
@@ -135,6 +175,22 @@ func resourceOrganizationConfigurationRead(ctx context.Context, d *schema.Resour
 		return sdkdiag.AppendErrorf(diags, "reading Security Hub Organization Configuration (%s): %s", d.Id(), err)
 	}
 
+	// A configuration change made outside of Terraform may still be in progress.
+	if output.OrganizationConfiguration.Status == types.OrganizationConfigurationStatusPending {
+		v, err := waitOrganizationConfigurationEnabled(ctx, conn, d.Timeout(schema.TimeoutRead))
+
+		// A failed change is reported below rather than failing the refresh.
+		if err != nil && (v == nil || v.OrganizationConfiguration.Status != types.OrganizationConfigurationStatusFailed) {
+			return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Organization Configuration (%s) enable: %s", d.Id(), err)
+		}
+
+		output = v
+	}
+
+	if status := output.OrganizationConfiguration.Status; status == types.OrganizationConfigurationStatusFailed {
+		diags = sdkdiag.AppendWarningf(diags, "Security Hub Organization Configuration (%s) status is %s: %s", d.Id(), status, aws.ToString(output.OrganizationConfiguration.StatusMessage))
+	}
+
 	d.Set("auto_enable", output.AutoEnable)
 	d.Set("auto_enable_standards", output.AutoEnableStandards)
 	if err := d.Set("organization_configuration", []interface{}{flattenOrganizationConfiguration(output.OrganizationConfiguration)}); err != nil {
@@ -7,7 +7,11 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
 	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -137,10 +141,73 @@ func testAccOrganizationConfiguration_centralConfiguration(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "organization_configuration.0.configuration_type", "LOCAL"),
 				),
 			},
+			{
+				// Switch to CENTRAL configuration outside of Terraform.
+				PreConfig: func() {
+					testAccOrganizationConfigurationUpdateCentral(ctx, t)
+				},
+				Config:             testAccOrganizationConfigurationConfig_centralConfiguration(true, "DEFAULT", "LOCAL"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccOrganizationConfigurationConfig_centralConfiguration(true, "DEFAULT", "LOCAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_standards", "DEFAULT"),
+					resource.TestCheckResourceAttr(resourceName, "organization_configuration.0.configuration_type", "LOCAL"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationConfiguration_centralConfigurationInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOrganizationConfigurationConfig_centralConfigurationOnly(true, "NONE"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`auto_enable must be false`),
+			},
+			{
+				Config:      testAccOrganizationConfigurationConfig_centralConfigurationOnly(false, "DEFAULT"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`auto_enable_standards must be "NONE"`),
+			},
 		},
 	})
 }
 
+func testAccOrganizationConfigurationUpdateCentral(ctx context.Context, t *testing.T) {
+	t.Helper()
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubClient(ctx)
+
+	input := &securityhub.UpdateOrganizationConfigurationInput{
+		AutoEnable:          aws.Bool(false),
+		AutoEnableStandards: types.AutoEnableStandardsNone,
+		OrganizationConfiguration: &types.OrganizationConfiguration{
+			ConfigurationType: types.OrganizationConfigurationConfigurationTypeCentral,
+		},
+	}
+
+	if _, err := conn.UpdateOrganizationConfiguration(ctx, input); err != nil {
+		t.Fatalf("updating Security Hub Organization Configuration: %s", err)
+	}
+
+	if _, err := tfsecurityhub.WaitOrganizationConfigurationEnabled(ctx, conn, 3*time.Minute); err != nil {
+		t.Fatalf("waiting for Security Hub Organization Configuration enable: %s", err)
+	}
+}
+
 func testAccCheckOrganizationConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, ok := s.RootModule().Resources[n]
@@ -263,3 +330,16 @@ resource "aws_securityhub_organization_configuration" "test" {
 }
 `, autoEnable, autoEnableStandards, configType))
 }
+
+func testAccOrganizationConfigurationConfig_centralConfigurationOnly(autoEnable bool, autoEnableStandards string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_organization_configuration" "test" {
+  auto_enable           = %[1]t
+  auto_enable_standards = %[2]q
+
+  organization_configuration {
+    configuration_type = "CENTRAL"
+  }
+}
+`, autoEnable, autoEnableStandards)
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_securityhub_products", name="Products")
+func dataSourceProducts() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceProductsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"company_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"product_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"product_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"products": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"activation_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"categories": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"company_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"integration_types": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"marketplace_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceProductsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	input := &securityhub.DescribeProductsInput{}
+
+	if v, ok := d.GetOk("product_arn"); ok {
+		input.ProductArn = aws.String(v.(string))
+	}
+
+	companyName, productName := d.Get("company_name").(string), d.Get("product_name").(string)
+	products, err := findProducts(ctx, conn, input, func(v *types.Product) bool {
+		if companyName != "" && aws.ToString(v.CompanyName) != companyName {
+			return false
+		}
+
+		if productName != "" && aws.ToString(v.ProductName) != productName {
+			return false
+		}
+
+		return true
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Hub Products: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", tfslices.ApplyToAll(products, func(v types.Product) string {
+		return aws.ToString(v.ProductArn)
+	}))
+	if err := d.Set("products", flattenProducts(products)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting products: %s", err)
+	}
+
+	return diags
+}
+
+func findProducts(ctx context.Context, conn *securityhub.Client, input *securityhub.DescribeProductsInput, filter tfslices.Predicate[*types.Product]) ([]types.Product, error) {
+	var output []types.Product
+
+	pages := securityhub.NewDescribeProductsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrMessageContains(err, errCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Products {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func flattenProducts(apiObjects []types.Product) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"activation_url":      aws.ToString(apiObject.ActivationUrl),
+			"categories":          apiObject.Categories,
+			"company_name":        aws.ToString(apiObject.CompanyName),
+			names.AttrDescription: aws.ToString(apiObject.Description),
+			"integration_types":   apiObject.IntegrationTypes,
+			"marketplace_url":     aws.ToString(apiObject.MarketplaceUrl),
+			"product_arn":         aws.ToString(apiObject.ProductArn),
+			"product_name":        aws.ToString(apiObject.ProductName),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccProductsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_securityhub_products.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProductsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", acctest.CtOne),
+					acctest.CheckResourceAttrRegionalARNNoAccount(dataSourceName, "arns.0", "securityhub", "product/aws/guardduty"),
+					resource.TestCheckResourceAttr(dataSourceName, "products.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(dataSourceName, "products.0.company_name", "Amazon"),
+					resource.TestCheckResourceAttr(dataSourceName, "products.0.product_name", "GuardDuty"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "products.0.product_arn", dataSourceName, "arns.0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccProductsDataSourceConfig_basic = `
+resource "aws_securityhub_account" "test" {}
+
+data "aws_securityhub_products" "test" {
+  company_name = "Amazon"
+  product_name = "GuardDuty"
+
+  depends_on = [aws_securityhub_account.test]
+}
+`
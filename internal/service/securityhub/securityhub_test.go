@@ -23,14 +23,27 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			"RemoveControlFindingGeneratorDefaultValue": testAccAccount_removeControlFindingGeneratorDefaultValue,
 		},
 		"AutomationRule": {
-			"basic":         testAccAutomationRule_basic,
-			"full":          testAccAutomationRule_full,
-			"disappears":    testAccAutomationRule_disappears,
-			"stringFilters": testAccAutomationRule_stringFilters,
-			"numberFilters": testAccAutomationRule_numberFilters,
-			"dateFilters":   testAccAutomationRule_dateFilters,
-			"mapFilters":    testAccAutomationRule_mapFilters,
-			names.AttrTags:  testAccAutomationRule_tags,
+			"basic":                   testAccAutomationRule_basic,
+			"full":                    testAccAutomationRule_full,
+			"disappears":              testAccAutomationRule_disappears,
+			"stringFilters":           testAccAutomationRule_stringFilters,
+			"stringFilterComparisons": testAccAutomationRule_stringFilterComparisons,
+			"numberFilters":           testAccAutomationRule_numberFilters,
+			"dateFilters":             testAccAutomationRule_dateFilters,
+			"mapFilters":              testAccAutomationRule_mapFilters,
+			"mapFilterComparisons":    testAccAutomationRule_mapFilterComparisons,
+			names.AttrTags:            testAccAutomationRule_tags,
+			"uniqueOrderEnforcement":  testAccAutomationRule_uniqueOrderEnforcement,
+		},
+		"AutomationRuleV2": {
+			"basic":        testAccAutomationRuleV2_basic,
+			"disappears":   testAccAutomationRuleV2_disappears,
+			"update":       testAccAutomationRuleV2_update,
+			"filters":      testAccAutomationRuleV2_filters,
+			names.AttrTags: testAccAutomationRuleV2_tags,
+		},
+		"AutomationRulesDataSource": {
+			"basic": testAccAutomationRulesDataSource_basic,
 		},
 		"ActionTarget": {
 			"basic":       testAccActionTarget_basic,
@@ -52,18 +65,23 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			"basic":      testAccFindingAggregator_basic,
 			"disappears": testAccFindingAggregator_disappears,
 		},
+		"FindingsDataSource": {
+			"basic":   testAccFindingsDataSource_basic,
+			"filters": testAccFindingsDataSource_filters,
+		},
 		"Insight": {
-			"basic":            testAccInsight_basic,
-			"disappears":       testAccInsight_disappears,
-			"DateFilters":      testAccInsight_DateFilters,
-			"GroupByAttribute": testAccInsight_GroupByAttribute,
-			"IpFilters":        testAccInsight_IPFilters,
-			"KeywordFilters":   testAccInsight_KeywordFilters,
-			"MapFilters":       testAccInsight_MapFilters,
-			"MultipleFilters":  testAccInsight_MultipleFilters,
-			"Name":             testAccInsight_Name,
-			"NumberFilters":    testAccInsight_NumberFilters,
-			"WorkflowStatus":   testAccInsight_WorkflowStatus,
+			"basic":                testAccInsight_basic,
+			"disappears":           testAccInsight_disappears,
+			"DateFilters":          testAccInsight_DateFilters,
+			"GroupByAttribute":     testAccInsight_GroupByAttribute,
+			"IpFilters":            testAccInsight_IPFilters,
+			"KeywordFilters":       testAccInsight_KeywordFilters,
+			"MapFilters":           testAccInsight_MapFilters,
+			"MigrateFromPluginSDK": testAccInsight_MigrateFromPluginSDK,
+			"MultipleFilters":      testAccInsight_MultipleFilters,
+			"Name":                 testAccInsight_Name,
+			"NumberFilters":        testAccInsight_NumberFilters,
+			"WorkflowStatus":       testAccInsight_WorkflowStatus,
 		},
 		"InviteAccepter": {
 			"basic": testAccInviteAccepter_basic,
@@ -78,9 +96,13 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			"MultiRegion": testAccOrganizationAdminAccount_MultiRegion,
 		},
 		"OrganizationConfiguration": {
-			"basic":                testAccOrganizationConfiguration_basic,
-			"AutoEnableStandards":  testAccOrganizationConfiguration_autoEnableStandards,
-			"CentralConfiguration": testAccOrganizationConfiguration_centralConfiguration,
+			"basic":                       testAccOrganizationConfiguration_basic,
+			"AutoEnableStandards":         testAccOrganizationConfiguration_autoEnableStandards,
+			"CentralConfiguration":        testAccOrganizationConfiguration_centralConfiguration,
+			"CentralConfigurationInvalid": testAccOrganizationConfiguration_centralConfigurationInvalid,
+		},
+		"ProductsDataSource": {
+			"basic": testAccProductsDataSource_basic,
 		},
 		"ProductSubscription": {
 			"basic": testAccProductSubscription_basic,
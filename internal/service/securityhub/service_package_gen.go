@@ -15,7 +15,12 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newAutomationRulesDataSource,
+			Name:    "Automation Rules",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -27,11 +32,33 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory: newAutomationRuleV2Resource,
+			Name:    "Automation Rule V2",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newInsightResource,
+			Name:    "Insight",
+		},
 	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceFindings,
+			TypeName: "aws_securityhub_findings",
+			Name:     "Findings",
+		},
+		{
+			Factory:  dataSourceProducts,
+			TypeName: "aws_securityhub_products",
+			Name:     "Products",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
@@ -61,11 +88,6 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_securityhub_finding_aggregator",
 			Name:     "Finding Aggregator",
 		},
-		{
-			Factory:  resourceInsight,
-			TypeName: "aws_securityhub_insight",
-			Name:     "Insight",
-		},
 		{
 			Factory:  resourceInviteAccepter,
 			TypeName: "aws_securityhub_invite_accepter",
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/securitylake"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -31,6 +32,48 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// ocsfEventClassValues returns the Open Cybersecurity Schema Framework (OCSF) event classes
+// that a custom log source can map its data to.
+func ocsfEventClassValues() []string {
+	return []string{
+		// System Activity.
+		"ACCESS_ACTIVITY",
+		"FILE_ACTIVITY",
+		"KERNEL_ACTIVITY",
+		"KERNEL_EXTENSION",
+		"MEMORY_ACTIVITY",
+		"MODULE_ACTIVITY",
+		"PROCESS_ACTIVITY",
+		"REGISTRY_KEY_ACTIVITY",
+		"REGISTRY_VALUE_ACTIVITY",
+		"RESOURCE_ACTIVITY",
+		"SCHEDULED_JOB_ACTIVITY",
+		// Findings.
+		"SECURITY_FINDING",
+		// Identity and Access Management.
+		"ACCOUNT_CHANGE",
+		"AUTHENTICATION",
+		"AUTHORIZATION",
+		"ENTITY_MANAGEMENT_AUDIT",
+		// Network Activity.
+		"DHCP_ACTIVITY",
+		"NETWORK_ACTIVITY",
+		"DNS_ACTIVITY",
+		"FTP_ACTIVITY",
+		"HTTP_ACTIVITY",
+		"RDP_ACTIVITY",
+		"SMB_ACTIVITY",
+		"SSH_ACTIVITY",
+		// Discovery.
+		"CONFIG_STATE",
+		"INVENTORY_INFO",
+		// Application Activity.
+		"EMAIL_ACTIVITY",
+		"API_ACTIVITY",
+		"CLOUD_API",
+	}
+}
+
 // @FrameworkResource(name="Custom Log Source")
 func newCustomLogSourceResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &customLogSourceResource{}
@@ -72,6 +115,9 @@ func (r *customLogSourceResource) Schema(ctx context.Context, request resource.S
 				PlanModifiers: []planmodifier.Set{
 					setplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(ocsfEventClassValues()...)),
+				},
 			},
 			names.AttrID: framework.IDAttribute(),
 			"provider_details": schema.ListAttribute{
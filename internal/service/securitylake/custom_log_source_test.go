@@ -170,6 +170,10 @@ func testAccCustomLogSource_eventClasses(t *testing.T) {
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		CheckDestroy:             testAccCheckCustomLogSourceDestroy(ctx),
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccCustomLogSourceConfig_eventClasses(rName, "NOT_AN_EVENT_CLASS"),
+				ExpectError: regexache.MustCompile(`value must be one of`),
+			},
 			{
 				Config: testAccCustomLogSourceConfig_eventClasses(rName, "FILE_ACTIVITY"),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -51,14 +51,15 @@ func TestAccSecurityLake_serial(t *testing.T) {
 			"replication":     testAccDataLake_replication,
 		},
 		"Subscriber": {
-			"accessType":      testAccSubscriber_accessType,
-			"basic":           testAccSubscriber_basic,
-			"customLogs":      testAccSubscriber_customLogSource,
-			"disappears":      testAccSubscriber_disappears,
-			"multipleSources": testAccSubscriber_multipleSources,
-			names.AttrTags:    testAccSubscriber_tags,
-			"updated":         testAccSubscriber_update,
-			"migrateSource":   testAccSubscriber_migrate_source,
+			"accessType":                testAccSubscriber_accessType,
+			"basic":                     testAccSubscriber_basic,
+			"customLogs":                testAccSubscriber_customLogSource,
+			"disappears":                testAccSubscriber_disappears,
+			"multipleSources":           testAccSubscriber_multipleSources,
+			"notificationConfiguration": testAccSubscriber_notificationConfiguration,
+			names.AttrTags:              testAccSubscriber_tags,
+			"updated":                   testAccSubscriber_update,
+			"migrateSource":             testAccSubscriber_migrate_source,
 		},
 		"SubscriberNotification": {
 			"disappears":     testAccSubscriberNotification_disappears,
@@ -171,6 +171,13 @@ func (r *subscriberResource) Schema(ctx context.Context, request resource.Schema
 					},
 				},
 			},
+			"notification_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[subscriberNotificationResourceConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: notificationConfigurationNestedBlockObject(ctx),
+			},
 			"subscriber_identity": schema.ListNestedBlock{
 				CustomType: fwtypes.NewListNestedObjectTypeOf[subscriberIdentityModel](ctx),
 				Validators: []validator.List{
@@ -255,6 +262,18 @@ func (r *subscriberResource) Create(ctx context.Context, request resource.Create
 		return
 	}
 
+	if !data.NotificationConfiguration.IsNull() && len(data.NotificationConfiguration.Elements()) > 0 {
+		subscriber, err = createSubscriberNotification(ctx, conn, data.ID.ValueString(), data.NotificationConfiguration, &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("creating Security Lake Subscriber (%s) notification", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
 	response.Diagnostics.Append(data.refreshFromOutput(ctx, subscriberIdentity, subscriber)...)
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
@@ -295,6 +314,17 @@ func (r *subscriberResource) Read(ctx context.Context, request resource.ReadRequ
 	}
 
 	response.Diagnostics.Append(data.refreshFromOutput(ctx, subscriberIdentity, output)...)
+
+	// The notification is only tracked here when it is configured on this resource,
+	// so that it can still be managed by aws_securitylake_subscriber_notification instead.
+	if !data.NotificationConfiguration.IsNull() {
+		if aws.ToString(output.SubscriberEndpoint) == "" {
+			data.NotificationConfiguration = fwtypes.NewListNestedObjectValueOfNull[subscriberNotificationResourceConfigurationModel](ctx)
+		} else {
+			data.NotificationConfiguration = refreshConfiguration(ctx, data.NotificationConfiguration, output, &response.Diagnostics)
+		}
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
@@ -365,9 +395,96 @@ func (r *subscriberResource) Update(ctx context.Context, request resource.Update
 		response.Diagnostics.Append(new.refreshFromOutput(ctx, subscriberIdentity, subscriber)...)
 	}
 
+	if !new.NotificationConfiguration.Equal(old.NotificationConfiguration) {
+		id := new.ID.ValueString()
+		oldSet := !old.NotificationConfiguration.IsNull() && len(old.NotificationConfiguration.Elements()) > 0
+		newSet := !new.NotificationConfiguration.IsNull() && len(new.NotificationConfiguration.Elements()) > 0
+
+		var subscriber *awstypes.SubscriberResource
+		var err error
+
+		switch {
+		case !oldSet && newSet:
+			subscriber, err = createSubscriberNotification(ctx, conn, id, new.NotificationConfiguration, &response.Diagnostics)
+		case oldSet && newSet:
+			subscriber, err = updateSubscriberNotification(ctx, conn, id, new.NotificationConfiguration, &response.Diagnostics)
+		default:
+			_, err = conn.DeleteSubscriberNotification(ctx, &securitylake.DeleteSubscriberNotificationInput{
+				SubscriberId: aws.String(id),
+			})
+			if err == nil {
+				subscriber, err = findSubscriberByID(ctx, conn, id)
+			}
+		}
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Lake Subscriber (%s) notification", id), err.Error())
+
+			return
+		}
+
+		new.SubscriberEndpoint = fwflex.StringToFramework(ctx, subscriber.SubscriberEndpoint)
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
 }
 
+// createSubscriberNotification creates a subscriber's notification and returns the updated subscriber.
+func createSubscriberNotification(ctx context.Context, conn *securitylake.Client, id string, config fwtypes.ListNestedObjectValueOf[subscriberNotificationResourceConfigurationModel], diags *diag.Diagnostics) (*awstypes.SubscriberResource, error) {
+	var configurationData []subscriberNotificationResourceConfigurationModel
+	diags.Append(config.ElementsAs(ctx, &configurationData, false)...)
+	if diags.HasError() {
+		return nil, nil
+	}
+
+	configuration, d := expandSubscriberNotificationResourceConfiguration(ctx, configurationData)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, nil
+	}
+
+	input := &securitylake.CreateSubscriberNotificationInput{
+		Configuration: configuration,
+		SubscriberId:  aws.String(id),
+	}
+
+	if _, err := conn.CreateSubscriberNotification(ctx, input); err != nil {
+		return nil, err
+	}
+
+	return findSubscriberByID(ctx, conn, id)
+}
+
+// updateSubscriberNotification updates a subscriber's notification and returns the updated subscriber.
+func updateSubscriberNotification(ctx context.Context, conn *securitylake.Client, id string, config fwtypes.ListNestedObjectValueOf[subscriberNotificationResourceConfigurationModel], diags *diag.Diagnostics) (*awstypes.SubscriberResource, error) {
+	var configurationData []subscriberNotificationResourceConfigurationModel
+	diags.Append(config.ElementsAs(ctx, &configurationData, false)...)
+	if diags.HasError() {
+		return nil, nil
+	}
+
+	configuration, d := expandSubscriberNotificationResourceConfiguration(ctx, configurationData)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, nil
+	}
+
+	input := &securitylake.UpdateSubscriberNotificationInput{
+		Configuration: configuration,
+		SubscriberId:  aws.String(id),
+	}
+
+	if _, err := conn.UpdateSubscriberNotification(ctx, input); err != nil {
+		return nil, err
+	}
+
+	return findSubscriberByID(ctx, conn, id)
+}
+
 func (r *subscriberResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	conn := r.Meta().SecurityLakeClient(ctx)
 
@@ -734,22 +851,23 @@ var (
 )
 
 type subscriberResourceModel struct {
-	AccessTypes           types.String                                             `tfsdk:"access_type"`
-	SubscriberArn         types.String                                             `tfsdk:"arn"`
-	ID                    types.String                                             `tfsdk:"id"`
-	Sources               types.Set                                                `tfsdk:"source"`
-	SubscriberDescription types.String                                             `tfsdk:"subscriber_description"`
-	SubscriberIdentity    fwtypes.ListNestedObjectValueOf[subscriberIdentityModel] `tfsdk:"subscriber_identity"`
-	SubscriberName        types.String                                             `tfsdk:"subscriber_name"`
-	ResourceShareArn      types.String                                             `tfsdk:"resource_share_arn"`
-	ResourceShareName     types.String                                             `tfsdk:"resource_share_name"`
-	RoleArn               types.String                                             `tfsdk:"role_arn"`
-	S3BucketArn           types.String                                             `tfsdk:"s3_bucket_arn"`
-	SubscriberEndpoint    types.String                                             `tfsdk:"subscriber_endpoint"`
-	SubscriberStatus      types.String                                             `tfsdk:"subscriber_status"`
-	Tags                  types.Map                                                `tfsdk:"tags"`
-	TagsAll               types.Map                                                `tfsdk:"tags_all"`
-	Timeouts              timeouts.Value                                           `tfsdk:"timeouts"`
+	AccessTypes               types.String                                                                      `tfsdk:"access_type"`
+	SubscriberArn             types.String                                                                      `tfsdk:"arn"`
+	ID                        types.String                                                                      `tfsdk:"id"`
+	NotificationConfiguration fwtypes.ListNestedObjectValueOf[subscriberNotificationResourceConfigurationModel] `tfsdk:"notification_configuration"`
+	Sources                   types.Set                                                                         `tfsdk:"source"`
+	SubscriberDescription     types.String                                                                      `tfsdk:"subscriber_description"`
+	SubscriberIdentity        fwtypes.ListNestedObjectValueOf[subscriberIdentityModel]                          `tfsdk:"subscriber_identity"`
+	SubscriberName            types.String                                                                      `tfsdk:"subscriber_name"`
+	ResourceShareArn          types.String                                                                      `tfsdk:"resource_share_arn"`
+	ResourceShareName         types.String                                                                      `tfsdk:"resource_share_name"`
+	RoleArn                   types.String                                                                      `tfsdk:"role_arn"`
+	S3BucketArn               types.String                                                                      `tfsdk:"s3_bucket_arn"`
+	SubscriberEndpoint        types.String                                                                      `tfsdk:"subscriber_endpoint"`
+	SubscriberStatus          types.String                                                                      `tfsdk:"subscriber_status"`
+	Tags                      types.Map                                                                         `tfsdk:"tags"`
+	TagsAll                   types.Map                                                                         `tfsdk:"tags_all"`
+	Timeouts                  timeouts.Value                                                                    `tfsdk:"timeouts"`
 }
 
 type subscriberSourcesModel struct {
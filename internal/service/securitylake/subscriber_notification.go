@@ -76,45 +76,50 @@ func (r *subscriberNotificationResource) Schema(ctx context.Context, req resourc
 					listvalidator.IsRequired(),
 					listvalidator.SizeAtMost(1),
 				},
+				NestedObject: notificationConfigurationNestedBlockObject(ctx),
+			},
+		},
+	}
+}
+
+// notificationConfigurationNestedBlockObject returns the schema of a subscriber notification configuration.
+func notificationConfigurationNestedBlockObject(ctx context.Context) schema.NestedBlockObject {
+	return schema.NestedBlockObject{
+		Blocks: map[string]schema.Block{
+			"https_notification_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[httpsNotificationConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
 				NestedObject: schema.NestedBlockObject{
-					Blocks: map[string]schema.Block{
-						"https_notification_configuration": schema.ListNestedBlock{
-							CustomType: fwtypes.NewListNestedObjectTypeOf[httpsNotificationConfigurationModel](ctx),
-							Validators: []validator.List{
-								listvalidator.SizeAtMost(1),
-							},
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									"authorization_api_key_name": schema.StringAttribute{
-										Optional: true,
-									},
-									"authorization_api_key_value": schema.StringAttribute{
-										Optional:  true,
-										Sensitive: true,
-									},
-									names.AttrEndpoint: schema.StringAttribute{
-										Required: true,
-									},
-									"http_method": schema.StringAttribute{
-										CustomType: fwtypes.StringEnumType[awstypes.HttpMethod](),
-										Optional:   true,
-									},
-									"target_role_arn": schema.StringAttribute{
-										CustomType: fwtypes.ARNType,
-										Required:   true,
-									},
-								},
-							},
+					Attributes: map[string]schema.Attribute{
+						"authorization_api_key_name": schema.StringAttribute{
+							Optional: true,
+						},
+						"authorization_api_key_value": schema.StringAttribute{
+							Optional:  true,
+							Sensitive: true,
+						},
+						names.AttrEndpoint: schema.StringAttribute{
+							Required: true,
+						},
+						"http_method": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.HttpMethod](),
+							Optional:   true,
 						},
-						"sqs_notification_configuration": schema.ListNestedBlock{
-							CustomType: fwtypes.NewListNestedObjectTypeOf[sqsNotificationConfigurationModel](ctx),
-							Validators: []validator.List{
-								listvalidator.SizeAtMost(1),
-							},
+						"target_role_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
 						},
 					},
 				},
 			},
+			"sqs_notification_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sqsNotificationConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
 		},
 	}
 }
@@ -469,6 +469,60 @@ func testAccSubscriber_migrate_source(t *testing.T) {
 	})
 }
 
+func testAccSubscriber_notificationConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_securitylake_subscriber.test"
+	var subscriber types.SubscriberResource
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriberDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriberConfig_notificationSQS(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSubscriberExists(ctx, resourceName, &subscriber),
+					resource.TestCheckResourceAttr(resourceName, "notification_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "notification_configuration.0.https_notification_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "notification_configuration.0.sqs_notification_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttrSet(resourceName, "subscriber_endpoint"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"notification_configuration"},
+			},
+			{
+				Config: testAccSubscriberConfig_notificationHTTPS(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSubscriberExists(ctx, resourceName, &subscriber),
+					resource.TestCheckResourceAttr(resourceName, "notification_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "notification_configuration.0.https_notification_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(resourceName, "notification_configuration.0.https_notification_configuration.0.endpoint", "aws_apigatewayv2_api.test", "api_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "notification_configuration.0.sqs_notification_configuration.#", "0"),
+				),
+			},
+			{
+				Config: testAccSubscriberConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSubscriberExists(ctx, resourceName, &subscriber),
+					resource.TestCheckResourceAttr(resourceName, "notification_configuration.#", "0"),
+					resource.TestCheckNoResourceAttr(resourceName, "subscriber_endpoint"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckSubscriberDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeClient(ctx)
@@ -877,3 +931,121 @@ resource "aws_securitylake_aws_log_source" "test" {
 data "aws_region" "current" {}
 `, rName))
 }
+
+func testAccSubscriberConfig_notificationSQS(rName string) string {
+	return acctest.ConfigCompose(
+		testAccDataLakeConfig_basic(), fmt.Sprintf(`
+resource "aws_securitylake_subscriber" "test" {
+  subscriber_name = %[1]q
+  source {
+    aws_log_source_resource {
+      source_name = "ROUTE53"
+    }
+  }
+  subscriber_identity {
+    external_id = "example"
+    principal   = data.aws_caller_identity.current.account_id
+  }
+
+  notification_configuration {
+    sqs_notification_configuration {}
+  }
+
+  depends_on = [aws_securitylake_aws_log_source.test]
+}
+
+resource "aws_securitylake_aws_log_source" "test" {
+  source {
+    accounts    = [data.aws_caller_identity.current.account_id]
+    regions     = [data.aws_region.current.name]
+    source_name = "ROUTE53"
+  }
+  depends_on = [aws_securitylake_data_lake.test]
+}
+
+data "aws_region" "current" {}
+`, rName))
+}
+
+func testAccSubscriberConfig_notificationHTTPS(rName string) string {
+	return acctest.ConfigCompose(
+		testAccDataLakeConfig_basic(), fmt.Sprintf(`
+resource "aws_securitylake_subscriber" "test" {
+  subscriber_name = %[1]q
+  source {
+    aws_log_source_resource {
+      source_name = "ROUTE53"
+    }
+  }
+  subscriber_identity {
+    external_id = "example"
+    principal   = data.aws_caller_identity.current.account_id
+  }
+
+  notification_configuration {
+    https_notification_configuration {
+      endpoint        = aws_apigatewayv2_api.test.api_endpoint
+      target_role_arn = aws_iam_role.event_bridge.arn
+    }
+  }
+
+  depends_on = [aws_securitylake_aws_log_source.test, aws_iam_role_policy.event_bridge]
+}
+
+resource "aws_securitylake_aws_log_source" "test" {
+  source {
+    accounts    = [data.aws_caller_identity.current.account_id]
+    regions     = [data.aws_region.current.name]
+    source_name = "ROUTE53"
+  }
+  depends_on = [aws_securitylake_data_lake.test]
+}
+
+resource "aws_apigatewayv2_api" "test" {
+  name          = %[1]q
+  protocol_type = "HTTP"
+}
+
+resource "aws_iam_role" "event_bridge" {
+  name = "AmazonSecurityLakeSubscriberEventBridge"
+  path = "/service-role/"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "events.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "event_bridge" {
+  name = "AmazonSecurityLakeSubscriberEventBridgePolicy"
+  role = aws_iam_role.event_bridge.name
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "events:InvokeApiDestination"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+POLICY
+}
+
+data "aws_region" "current" {}
+`, rName))
+}
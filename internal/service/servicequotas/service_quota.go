@@ -30,7 +30,11 @@ func ResourceServiceQuota() *schema.Resource {
 		UpdateWithoutTimeout: resourceServiceQuotaUpdate,
 		DeleteWithoutTimeout: schema.NoopContext,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("support_case_allowed", true)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -82,6 +86,11 @@ func ResourceServiceQuota() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"support_case_allowed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"usage_metric": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -162,9 +171,10 @@ func resourceServiceQuotaCreate(ctx context.Context, d *schema.ResourceData, met
 
 	if value > quotaValue {
 		input := &servicequotas.RequestServiceQuotaIncreaseInput{
-			DesiredValue: aws.Float64(value),
-			QuotaCode:    aws.String(quotaCode),
-			ServiceCode:  aws.String(serviceCode),
+			DesiredValue:       aws.Float64(value),
+			QuotaCode:          aws.String(quotaCode),
+			ServiceCode:        aws.String(serviceCode),
+			SupportCaseAllowed: aws.Bool(d.Get("support_case_allowed").(bool)),
 		}
 
 		output, err := conn.RequestServiceQuotaIncrease(ctx, input)
@@ -271,23 +281,26 @@ func resourceServiceQuotaUpdate(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "deleting Service Quota (%s): %s", d.Id(), err)
 	}
 
-	input := &servicequotas.RequestServiceQuotaIncreaseInput{
-		DesiredValue: aws.Float64(value),
-		QuotaCode:    aws.String(quotaCode),
-		ServiceCode:  aws.String(serviceCode),
-	}
+	if d.HasChange(names.AttrValue) {
+		input := &servicequotas.RequestServiceQuotaIncreaseInput{
+			DesiredValue:       aws.Float64(value),
+			QuotaCode:          aws.String(quotaCode),
+			ServiceCode:        aws.String(serviceCode),
+			SupportCaseAllowed: aws.Bool(d.Get("support_case_allowed").(bool)),
+		}
 
-	output, err := conn.RequestServiceQuotaIncrease(ctx, input)
+		output, err := conn.RequestServiceQuotaIncrease(ctx, input)
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "requesting Service Quota (%s) increase: %s", d.Id(), err)
-	}
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "requesting Service Quota (%s) increase: %s", d.Id(), err)
+		}
 
-	if output == nil || output.RequestedQuota == nil {
-		return sdkdiag.AppendErrorf(diags, "requesting Service Quota (%s) increase: empty result", d.Id())
-	}
+		if output == nil || output.RequestedQuota == nil {
+			return sdkdiag.AppendErrorf(diags, "requesting Service Quota (%s) increase: empty result", d.Id())
+		}
 
-	d.Set("request_id", output.RequestedQuota.Id)
+		d.Set("request_id", output.RequestedQuota.Id)
+	}
 
 	return append(diags, resourceServiceQuotaRead(ctx, d, meta)...)
 }
@@ -43,6 +43,7 @@ func TestAccServiceQuotasServiceQuota_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "quota_name", dataSourceName, "quota_name"),
 					resource.TestCheckResourceAttrPair(resourceName, "service_code", dataSourceName, "service_code"),
 					resource.TestCheckResourceAttrPair(resourceName, names.AttrServiceName, dataSourceName, names.AttrServiceName),
+					resource.TestCheckResourceAttr(resourceName, "support_case_allowed", "true"),
 					resource.TestCheckResourceAttrPair(resourceName, "usage_metric", dataSourceName, "usage_metric"),
 					resource.TestCheckNoResourceAttr(resourceName, "usage_metric.0.metric_name"),
 					resource.TestCheckResourceAttrPair(resourceName, names.AttrValue, dataSourceName, names.AttrValue),
@@ -183,6 +184,50 @@ func TestAccServiceQuotasServiceQuota_Value_increaseOnCreate(t *testing.T) {
 	})
 }
 
+func TestAccServiceQuotasServiceQuota_Value_supportCaseNotAllowed(t *testing.T) {
+	ctx := acctest.Context(t)
+	quotaCode := os.Getenv("SERVICEQUOTAS_INCREASE_ON_CREATE_QUOTA_CODE")
+	if quotaCode == "" {
+		t.Skip(
+			"Environment variable SERVICEQUOTAS_INCREASE_ON_CREATE_QUOTA_CODE is not set. " +
+				"WARNING: This test will submit a real service quota increase!")
+	}
+
+	serviceCode := os.Getenv("SERVICEQUOTAS_INCREASE_ON_CREATE_SERVICE_CODE")
+	if serviceCode == "" {
+		t.Skip(
+			"Environment variable SERVICEQUOTAS_INCREASE_ON_CREATE_SERVICE_CODE is not set. " +
+				"WARNING: This test will submit a real service quota increase!")
+	}
+
+	value := os.Getenv("SERVICEQUOTAS_INCREASE_ON_CREATE_VALUE")
+	if value == "" {
+		t.Skip(
+			"Environment variable SERVICEQUOTAS_INCREASE_ON_CREATE_VALUE is not set. " +
+				"WARNING: This test will submit a real service quota increase!")
+	}
+
+	resourceName := "aws_servicequotas_service_quota.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceQuotasServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceQuotaConfig_supportCaseAllowed(serviceCode, quotaCode, value, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "quota_code", quotaCode),
+					resource.TestCheckResourceAttr(resourceName, "service_code", serviceCode),
+					resource.TestCheckResourceAttr(resourceName, "support_case_allowed", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "request_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccServiceQuotasServiceQuota_Value_increaseOnUpdate(t *testing.T) {
 	ctx := acctest.Context(t)
 	quotaCode := os.Getenv("SERVICEQUOTAS_INCREASE_ON_UPDATE_QUOTA_CODE")
@@ -279,6 +324,17 @@ resource "aws_servicequotas_service_quota" "test" {
 `, quotaCode, serviceCode, value)
 }
 
+func testAccServiceQuotaConfig_supportCaseAllowed(serviceCode, quotaCode, value string, supportCaseAllowed bool) string {
+	return fmt.Sprintf(`
+resource "aws_servicequotas_service_quota" "test" {
+  quota_code           = %[1]q
+  service_code         = %[2]q
+  value                = %[3]s
+  support_case_allowed = %[4]t
+}
+`, quotaCode, serviceCode, value, supportCaseAllowed)
+}
+
 func testAccServiceQuotaConfig_permissionError(serviceCode, quotaCode string) string {
 	policy := `{
   "Version": "2012-10-17",
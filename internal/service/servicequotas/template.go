@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -118,6 +119,7 @@ func (r *resourceTemplate) Create(ctx context.Context, req resource.CreateReques
 			create.ProblemStandardMessage(names.ServiceQuotas, create.ErrActionCreating, ResNameTemplate, id, err),
 			err.Error(),
 		)
+		return
 	}
 	plan.ID = fwflex.StringValueToFramework(ctx, id)
 
@@ -164,6 +166,7 @@ func (r *resourceTemplate) Read(ctx context.Context, req resource.ReadRequest, r
 
 	out, err := FindTemplateByID(ctx, conn, state.ID.ValueString())
 	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
 		resp.State.RemoveResource(ctx)
 		return
 	}
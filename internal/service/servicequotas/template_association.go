@@ -5,6 +5,7 @@ package servicequotas
 
 import (
 	"context"
+	"errors"
 
 	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -94,10 +96,6 @@ func (r *resourceTemplateAssociation) Read(ctx context.Context, req resource.Rea
 	}
 
 	out, err := conn.GetAssociationForServiceQuotaTemplate(ctx, &servicequotas.GetAssociationForServiceQuotaTemplateInput{})
-	if out == nil || out.ServiceQuotaTemplateAssociationStatus == awstypes.ServiceQuotaTemplateAssociationStatusDisassociated {
-		resp.State.RemoveResource(ctx)
-		return
-	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			create.ProblemStandardMessage(names.ServiceQuotas, create.ErrActionSetting, ResNameTemplateAssociation, state.ID.String(), err),
@@ -105,6 +103,11 @@ func (r *resourceTemplateAssociation) Read(ctx context.Context, req resource.Rea
 		)
 		return
 	}
+	if out == nil || out.ServiceQuotaTemplateAssociationStatus == awstypes.ServiceQuotaTemplateAssociationStatusDisassociated {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(errors.New("Service Quotas template is not associated with the organization")))
+		resp.State.RemoveResource(ctx)
+		return
+	}
 
 	state.Status = flex.StringValueToFramework(ctx, string(out.ServiceQuotaTemplateAssociationStatus))
 
@@ -0,0 +1,328 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// See https://docs.aws.amazon.com/sns/latest/dg/subscription-filter-policy-constraints.html.
+	filterPolicyMaxKeys             = 5
+	filterPolicyMaxCombinationCount = 150
+)
+
+// @SDKDataSource("aws_sns_filter_policy_document", name="Filter Policy Document")
+func dataSourceFilterPolicyDocument() *schema.Resource {
+	validNumber := func(v interface{}, k string) (ws []string, es []error) {
+		if _, err := strconv.ParseFloat(v.(string), 64); err != nil {
+			es = append(es, fmt.Errorf("%q (%s) must be a number", k, v))
+		}
+		return
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFilterPolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter_policy_scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      subscriptionFilterPolicyScopeMessageAttributes,
+				ValidateFunc: validation.StringInSlice(subscriptionFilterPolicyScope_Values(), false),
+			},
+			names.AttrJSON: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrKey: {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: filterPolicyMaxKeys,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"anything_but": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"anything_but_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"cidr": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsCIDR,
+							},
+						},
+						"equals_ignore_case": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"exists": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"numeric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"equal": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validNumber,
+									},
+									"greater_than": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validNumber,
+									},
+									"greater_than_or_equal": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validNumber,
+									},
+									"less_than": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validNumber,
+									},
+									"less_than_or_equal": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validNumber,
+									},
+								},
+							},
+						},
+						names.AttrPrefix: {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"suffix": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrValues: {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFilterPolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	policy, err := expandFilterPolicy(d.Get(names.AttrKey).([]interface{}), d.Get("filter_policy_scope").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "building SNS filter policy: %s", err)
+	}
+
+	// Keep the numeric operators readable, e.g. ">=" instead of "\u003e=".
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(policy); err != nil {
+		return sdkdiag.AppendErrorf(diags, "building SNS filter policy: %s", err)
+	}
+
+	jsonString := strings.TrimSuffix(buf.String(), "\n")
+
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	d.Set(names.AttrJSON, jsonString)
+
+	return diags
+}
+
+// expandFilterPolicy builds a filter policy from the key blocks.
+// Dot-separated key names denote nested properties of a MessageBody scoped policy.
+func expandFilterPolicy(tfList []interface{}, scope string) (map[string]interface{}, error) {
+	policy := make(map[string]interface{})
+	combinations := 1
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+		conditions, err := expandFilterPolicyConditions(tfMap)
+
+		if err != nil {
+			return nil, fmt.Errorf("key (%s): %w", name, err)
+		}
+
+		if len(conditions) == 0 {
+			return nil, fmt.Errorf("key (%s): at least one condition must be specified", name)
+		}
+
+		combinations *= len(conditions)
+
+		path := []string{name}
+		if scope == subscriptionFilterPolicyScopeMessageBody {
+			path = strings.Split(name, ".")
+		}
+
+		parent := policy
+		for i, v := range path {
+			if i == len(path)-1 {
+				if _, ok := parent[v]; ok {
+					return nil, fmt.Errorf("key (%s): duplicate key", name)
+				}
+
+				parent[v] = conditions
+				break
+			}
+
+			switch child := parent[v].(type) {
+			case nil:
+				m := make(map[string]interface{})
+				parent[v] = m
+				parent = m
+			case map[string]interface{}:
+				parent = child
+			default:
+				return nil, fmt.Errorf("key (%s): conflicts with key (%s)", name, strings.Join(path[:i+1], "."))
+			}
+		}
+	}
+
+	if combinations > filterPolicyMaxCombinationCount {
+		return nil, fmt.Errorf("total combination of values (%d) exceeds the maximum of %d", combinations, filterPolicyMaxCombinationCount)
+	}
+
+	return policy, nil
+}
+
+func expandFilterPolicyConditions(tfMap map[string]interface{}) ([]interface{}, error) {
+	var conditions []interface{}
+
+	for _, v := range tfMap[names.AttrValues].([]interface{}) {
+		conditions = append(conditions, v.(string))
+	}
+
+	if v := tfMap["anything_but"].([]interface{}); len(v) > 0 {
+		conditions = append(conditions, map[string]interface{}{"anything-but": expandFilterPolicyStrings(v)})
+	}
+
+	if v := tfMap["anything_but_prefix"].(string); v != "" {
+		conditions = append(conditions, map[string]interface{}{"anything-but": map[string]interface{}{"prefix": v}})
+	}
+
+	for _, v := range tfMap["cidr"].([]interface{}) {
+		conditions = append(conditions, map[string]interface{}{"cidr": v.(string)})
+	}
+
+	for _, v := range tfMap["equals_ignore_case"].([]interface{}) {
+		conditions = append(conditions, map[string]interface{}{"equals-ignore-case": v.(string)})
+	}
+
+	if v := tfMap["exists"].(string); v != "" {
+		conditions = append(conditions, map[string]interface{}{"exists": v == "true"})
+	}
+
+	for _, tfMapRaw := range tfMap["numeric"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("numeric: at least one operator must be specified")
+		}
+
+		numeric, err := expandFilterPolicyNumeric(tfMap)
+
+		if err != nil {
+			return nil, fmt.Errorf("numeric: %w", err)
+		}
+
+		conditions = append(conditions, map[string]interface{}{"numeric": numeric})
+	}
+
+	for _, v := range tfMap[names.AttrPrefix].([]interface{}) {
+		conditions = append(conditions, map[string]interface{}{"prefix": v.(string)})
+	}
+
+	for _, v := range tfMap["suffix"].([]interface{}) {
+		conditions = append(conditions, map[string]interface{}{"suffix": v.(string)})
+	}
+
+	return conditions, nil
+}
+
+func expandFilterPolicyNumeric(tfMap map[string]interface{}) ([]interface{}, error) {
+	var numeric []interface{}
+
+	if v := tfMap["equal"].(string); v != "" {
+		for _, k := range []string{"greater_than", "greater_than_or_equal", "less_than", "less_than_or_equal"} {
+			if tfMap[k].(string) != "" {
+				return nil, fmt.Errorf(`"equal" conflicts with %q`, k)
+			}
+		}
+
+		return []interface{}{"=", json.Number(v)}, nil
+	}
+
+	for _, bound := range [][2]string{{"greater_than", ">"}, {"greater_than_or_equal", ">="}, {"less_than", "<"}, {"less_than_or_equal", "<="}} {
+		if v := tfMap[bound[0]].(string); v != "" {
+			numeric = append(numeric, bound[1], json.Number(v))
+		}
+	}
+
+	switch len(numeric) {
+	case 0:
+		return nil, fmt.Errorf("at least one operator must be specified")
+	case 4:
+		if tfMap["greater_than"].(string) != "" && tfMap["greater_than_or_equal"].(string) != "" {
+			return nil, fmt.Errorf(`"greater_than" conflicts with "greater_than_or_equal"`)
+		}
+		if tfMap["less_than"].(string) != "" && tfMap["less_than_or_equal"].(string) != "" {
+			return nil, fmt.Errorf(`"less_than" conflicts with "less_than_or_equal"`)
+		}
+	case 6, 8:
+		return nil, fmt.Errorf("at most one lower and one upper bound can be specified")
+	}
+
+	return numeric, nil
+}
+
+func expandFilterPolicyStrings(tfList []interface{}) []string {
+	apiObjects := make([]string, 0, len(tfList))
+
+	for _, v := range tfList {
+		apiObjects = append(apiObjects, v.(string))
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSNSFilterPolicyDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sns_filter_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterPolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "filter_policy_scope", "MessageAttributes"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrJSON, `{"customer_interests":["rugby","football",{"prefix":"bas"}],"price_usd":[{"numeric":[">=",100,"<",150]}],"store":[{"exists":false}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSNSFilterPolicyDocumentDataSource_messageBody(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sns_filter_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterPolicyDocumentDataSourceConfig_messageBody,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "filter_policy_scope", "MessageBody"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrJSON, `{"customer":{"address":{"country":[{"anything-but":["US","CA"]}]},"source_ip":[{"cidr":"10.0.0.0/24"}]},"event":[{"equals-ignore-case":"order_placed"},{"suffix":"_created"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSNSFilterPolicyDocumentDataSource_errors(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFilterPolicyDocumentDataSourceConfig_noCondition,
+				ExpectError: regexache.MustCompile(`at least one condition must be specified`),
+			},
+			{
+				Config:      testAccFilterPolicyDocumentDataSourceConfig_conflictingKeys,
+				ExpectError: regexache.MustCompile(`conflicts with key \(customer\)`),
+			},
+			{
+				Config:      testAccFilterPolicyDocumentDataSourceConfig_numericConflict,
+				ExpectError: regexache.MustCompile(`"equal" conflicts with "less_than"`),
+			},
+		},
+	})
+}
+
+const testAccFilterPolicyDocumentDataSourceConfig_basic = `
+data "aws_sns_filter_policy_document" "test" {
+  key {
+    name   = "customer_interests"
+    values = ["rugby", "football"]
+    prefix = ["bas"]
+  }
+
+  key {
+    name = "price_usd"
+
+    numeric {
+      greater_than_or_equal = 100
+      less_than             = 150
+    }
+  }
+
+  key {
+    name   = "store"
+    exists = false
+  }
+}
+`
+
+const testAccFilterPolicyDocumentDataSourceConfig_messageBody = `
+data "aws_sns_filter_policy_document" "test" {
+  filter_policy_scope = "MessageBody"
+
+  key {
+    name         = "customer.address.country"
+    anything_but = ["US", "CA"]
+  }
+
+  key {
+    name = "customer.source_ip"
+    cidr = ["10.0.0.0/24"]
+  }
+
+  key {
+    name               = "event"
+    equals_ignore_case = ["order_placed"]
+    suffix             = ["_created"]
+  }
+}
+`
+
+const testAccFilterPolicyDocumentDataSourceConfig_noCondition = `
+data "aws_sns_filter_policy_document" "test" {
+  key {
+    name = "store"
+  }
+}
+`
+
+const testAccFilterPolicyDocumentDataSourceConfig_conflictingKeys = `
+data "aws_sns_filter_policy_document" "test" {
+  filter_policy_scope = "MessageBody"
+
+  key {
+    name   = "customer"
+    values = ["example"]
+  }
+
+  key {
+    name   = "customer.name"
+    values = ["example"]
+  }
+}
+`
+
+const testAccFilterPolicyDocumentDataSourceConfig_numericConflict = `
+data "aws_sns_filter_policy_document" "test" {
+  key {
+    name = "price_usd"
+
+    numeric {
+      equal     = 100
+      less_than = 150
+    }
+  }
+}
+`
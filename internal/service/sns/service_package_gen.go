@@ -24,6 +24,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceFilterPolicyDocument,
+			TypeName: "aws_sns_filter_policy_document",
+			Name:     "Filter Policy Document",
+		},
 		{
 			Factory:  dataSourceTopic,
 			TypeName: "aws_sns_topic",
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -65,6 +66,11 @@ func ResourceAssociation() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 50),
 			},
+			"calendar_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"compliance_severity": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -137,6 +143,43 @@ func ResourceAssociation() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice(ssm.AssociationSyncCompliance_Values(), false),
 			},
+			"target_location": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accounts": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"execution_role_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"regions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"target_location_max_concurrency": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+						"target_location_max_errors": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+					},
+				},
+			},
 			"targets": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -184,6 +227,10 @@ func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta
 		associationInput.AssociationName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("calendar_names"); ok && v.(*schema.Set).Len() > 0 {
+		associationInput.CalendarNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk(names.AttrInstanceID); ok {
 		associationInput.InstanceId = aws.String(v.(string))
 	}
@@ -204,6 +251,10 @@ func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta
 		associationInput.SyncCompliance = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("target_location"); ok && len(v.([]interface{})) > 0 {
+		associationInput.TargetLocations = expandTargetLocations(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("targets"); ok {
 		associationInput.Targets = expandTargets(v.([]interface{}))
 	}
@@ -276,6 +327,7 @@ func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set(names.AttrARN, arn)
 	d.Set("apply_only_at_cron_interval", association.ApplyOnlyAtCronInterval)
 	d.Set("association_name", association.AssociationName)
+	d.Set("calendar_names", aws.StringValueSlice(association.CalendarNames))
 	d.Set(names.AttrInstanceID, association.InstanceId)
 	d.Set(names.AttrName, association.Name)
 	d.Set("association_id", association.AssociationId)
@@ -291,6 +343,10 @@ func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "reading SSM Association (%s): %s", d.Id(), err)
 	}
 
+	if err := d.Set("target_location", flattenTargetLocations(association.TargetLocations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting target_location error: %s", err)
+	}
+
 	if err := d.Set("targets", flattenTargets(association.Targets)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting targets error: %s", err)
 	}
@@ -321,6 +377,12 @@ func resourceAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta
 		associationInput.AssociationName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("calendar_names"); ok && v.(*schema.Set).Len() > 0 {
+		associationInput.CalendarNames = flex.ExpandStringSet(v.(*schema.Set))
+	} else if d.HasChange("calendar_names") {
+		associationInput.CalendarNames = []*string{}
+	}
+
 	if v, ok := d.GetOk("document_version"); ok {
 		associationInput.DocumentVersion = aws.String(v.(string))
 	}
@@ -329,14 +391,18 @@ func resourceAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta
 		associationInput.ScheduleExpression = aws.String(v.(string))
 	}
 
-	if d.HasChange("sync_compliance") {
-		associationInput.SyncCompliance = aws.String(d.Get("sync_compliance").(string))
+	if v, ok := d.GetOk("sync_compliance"); ok {
+		associationInput.SyncCompliance = aws.String(v.(string))
 	}
 
 	if v, ok := d.GetOk(names.AttrParameters); ok {
 		associationInput.Parameters = expandDocumentParameters(v.(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("target_location"); ok && len(v.([]interface{})) > 0 {
+		associationInput.TargetLocations = expandTargetLocations(v.([]interface{}))
+	}
+
 	if _, ok := d.GetOk("targets"); ok {
 		associationInput.Targets = expandTargets(d.Get("targets").([]interface{}))
 	}
@@ -449,3 +515,71 @@ func flattenAssociationOutputLocation(location *ssm.InstanceAssociationOutputLoc
 
 	return result
 }
+
+func expandTargetLocations(tfList []interface{}) []*ssm.TargetLocation {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ssm.TargetLocation
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssm.TargetLocation{}
+
+		if v, ok := tfMap["accounts"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Accounts = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["execution_role_name"].(string); ok && v != "" {
+			apiObject.ExecutionRoleName = aws.String(v)
+		}
+
+		if v, ok := tfMap["regions"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Regions = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["target_location_max_concurrency"].(string); ok && v != "" {
+			apiObject.TargetLocationMaxConcurrency = aws.String(v)
+		}
+
+		if v, ok := tfMap["target_location_max_errors"].(string); ok && v != "" {
+			apiObject.TargetLocationMaxErrors = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTargetLocations(apiObjects []*ssm.TargetLocation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"accounts":                        aws.StringValueSlice(apiObject.Accounts),
+			"execution_role_name":             aws.StringValue(apiObject.ExecutionRoleName),
+			"regions":                         aws.StringValueSlice(apiObject.Regions),
+			"target_location_max_concurrency": aws.StringValue(apiObject.TargetLocationMaxConcurrency),
+			"target_location_max_errors":      aws.StringValue(apiObject.TargetLocationMaxErrors),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
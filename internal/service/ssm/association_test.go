@@ -640,6 +640,85 @@ func TestAccSSMAssociation_syncCompliance(t *testing.T) {
 	})
 }
 
+func TestAccSSMAssociation_calendarNames(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationConfig_calendarNames(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "calendar_names.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "calendar_names.*", "aws_ssm_document.calendar", names.AttrName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssociationConfig_basicTargets(rName, `
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "calendar_names.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMAssociation_targetLocation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationConfig_targetLocation(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_location.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "target_location.0.accounts.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "target_location.0.regions.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "target_location.0.target_location_max_concurrency", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "target_location.0.target_location_max_errors", acctest.CtOne),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssociationConfig_targetLocation(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_location.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "target_location.0.target_location_max_concurrency", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1646,3 +1725,76 @@ resource "aws_ssm_association" "test" {
 }
 `)
 }
+
+func testAccAssociationConfig_calendarNames(rName string) string {
+	return acctest.ConfigCompose(testAccAssociationConfig_basicTargets(rName, `
+  calendar_names = [aws_ssm_document.calendar.name]
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+`), fmt.Sprintf(`
+resource "aws_ssm_document" "calendar" {
+  name            = "%[1]s-calendar"
+  document_type   = "ChangeCalendar"
+  document_format = "TEXT"
+
+  content = <<DOC
+BEGIN:VCALENDAR
+PRODID:-//AWS//Change Calendar 1.0//EN
+VERSION:2.0
+X-CALENDAR-TYPE:DEFAULT_OPEN
+X-WR-CALDESC:test
+END:VCALENDAR
+DOC
+}
+`, rName))
+}
+
+func testAccAssociationConfig_targetLocation(rName, maxConcurrency string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Command"
+
+  content = <<DOC
+{
+  "schemaVersion": "1.2",
+  "description": "Check ip configuration of a Linux instance.",
+  "parameters": {},
+  "runtimeConfig": {
+    "aws:runShellScript": {
+      "properties": [
+        {
+          "id": "0.aws:runShellScript",
+          "runCommand": ["ifconfig"]
+        }
+      ]
+    }
+  }
+}
+DOC
+}
+
+resource "aws_ssm_association" "test" {
+  name = aws_ssm_document.test.name
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+
+  target_location {
+    accounts                        = [data.aws_caller_identity.current.account_id]
+    regions                         = [data.aws_region.current.name]
+    target_location_max_concurrency = %[2]q
+    target_location_max_errors      = "1"
+  }
+}
+`, rName, maxConcurrency)
+}
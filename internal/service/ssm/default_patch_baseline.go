@@ -47,7 +47,7 @@ func resourceDefaultPatchBaseline() *schema.Resource {
 				if isPatchBaselineID(id) || isPatchBaselineARN(id) {
 					conn := meta.(*conns.AWSClient).SSMClient(ctx)
 
-					patchbaseline, err := findPatchBaselineByIDV2(ctx, conn, id)
+					patchbaseline, err := findPatchBaselineByID(ctx, conn, id)
 					if err != nil {
 						return nil, fmt.Errorf("reading SSM Patch Baseline (%s): %w", id, err)
 					}
@@ -175,7 +175,7 @@ func resourceDefaultPatchBaselineCreate(ctx context.Context, d *schema.ResourceD
 
 	baselineID := d.Get("baseline_id").(string)
 
-	patchBaseline, err := findPatchBaselineByIDV2(ctx, conn, baselineID)
+	patchBaseline, err := findPatchBaselineByID(ctx, conn, baselineID)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading SSM Patch Baseline (%s): %s", baselineID, err)
@@ -303,31 +303,6 @@ func FindDefaultPatchBaseline(ctx context.Context, conn *ssm.Client, os types.Op
 	return out, nil
 }
 
-func findPatchBaselineByIDV2(ctx context.Context, conn *ssm.Client, id string) (*ssm.GetPatchBaselineOutput, error) {
-	input := &ssm.GetPatchBaselineInput{
-		BaselineId: aws.String(id),
-	}
-
-	output, err := conn.GetPatchBaseline(ctx, input)
-
-	if errs.IsA[*types.DoesNotExistException](err) {
-		return nil, &retry.NotFoundError{
-			LastError:   err,
-			LastRequest: input,
-		}
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	if output == nil {
-		return nil, tfresource.NewEmptyResultError(input)
-	}
-
-	return output, nil
-}
-
 func patchBaselinesPaginator(conn *ssm.Client, filters ...types.PatchOrchestratorFilter) *ssm.DescribePatchBaselinesPaginator {
 	return ssm.NewDescribePatchBaselinesPaginator(conn, &ssm.DescribePatchBaselinesInput{
 		Filters: filters,
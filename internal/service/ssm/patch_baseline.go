@@ -11,17 +11,18 @@ import (
 	"strings"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
-	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
@@ -61,10 +62,10 @@ func resourcePatchBaseline() *schema.Resource {
 							ValidateFunc: validation.StringMatch(regexache.MustCompile(`([12]\d{3}-(0[1-9]|1[0-2])-(0[1-9]|[12]\d|3[01]))`), "must be formatted YYYY-MM-DD"),
 						},
 						"compliance_level": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Default:      ssm.PatchComplianceLevelUnspecified,
-							ValidateFunc: validation.StringInSlice(ssm.PatchComplianceLevel_Values(), false),
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          types.PatchComplianceLevelUnspecified,
+							ValidateDiagFunc: enum.Validate[types.PatchComplianceLevel](),
 						},
 						"enable_non_security": {
 							Type:     schema.TypeBool,
@@ -78,9 +79,9 @@ func resourcePatchBaseline() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									names.AttrKey: {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringInSlice(ssm.PatchFilterKey_Values(), false),
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.PatchFilterKey](),
 									},
 									names.AttrValues: {
 										Type:     schema.TypeList,
@@ -108,10 +109,10 @@ func resourcePatchBaseline() *schema.Resource {
 				},
 			},
 			"approved_patches_compliance_level": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      ssm.PatchComplianceLevelUnspecified,
-				ValidateFunc: validation.StringInSlice(ssm.PatchComplianceLevel_Values(), false),
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.PatchComplianceLevelUnspecified,
+				ValidateDiagFunc: enum.Validate[types.PatchComplianceLevel](),
 			},
 			"approved_patches_enable_non_security": {
 				Type:     schema.TypeBool,
@@ -121,6 +122,12 @@ func resourcePatchBaseline() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"available_security_updates_compliance_status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.PatchComplianceStatus](),
+			},
 			names.AttrDescription: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -133,9 +140,9 @@ func resourcePatchBaseline() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrKey: {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice(ssm.PatchFilterKey_Values(), false),
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.PatchFilterKey](),
 						},
 						names.AttrValues: {
 							Type:     schema.TypeList,
@@ -163,11 +170,11 @@ func resourcePatchBaseline() *schema.Resource {
 				),
 			},
 			"operating_system": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Default:      ssm.OperatingSystemWindows,
-				ValidateFunc: validation.StringInSlice(ssm.OperatingSystem_Values(), false),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          types.OperatingSystemWindows,
+				ValidateDiagFunc: enum.Validate[types.OperatingSystem](),
 			},
 			"rejected_patches": {
 				Type:     schema.TypeSet,
@@ -179,10 +186,10 @@ func resourcePatchBaseline() *schema.Resource {
 				},
 			},
 			"rejected_patches_action": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validation.StringInSlice(ssm.PatchAction_Values(), false),
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.PatchAction](),
 			},
 			names.AttrSource: {
 				Type:     schema.TypeList,
@@ -228,14 +235,14 @@ func resourcePatchBaseline() *schema.Resource {
 
 func resourcePatchBaselineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
 
 	name := d.Get(names.AttrName).(string)
 	input := &ssm.CreatePatchBaselineInput{
-		ApprovedPatchesComplianceLevel: aws.String(d.Get("approved_patches_compliance_level").(string)),
+		ApprovedPatchesComplianceLevel: types.PatchComplianceLevel(d.Get("approved_patches_compliance_level").(string)),
 		Name:                           aws.String(name),
-		OperatingSystem:                aws.String(d.Get("operating_system").(string)),
-		Tags:                           getTagsIn(ctx),
+		OperatingSystem:                types.OperatingSystem(d.Get("operating_system").(string)),
+		Tags:                           getTagsInV2(ctx),
 	}
 
 	if _, ok := d.GetOk("approval_rule"); ok {
@@ -243,13 +250,17 @@ func resourcePatchBaselineCreate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if v, ok := d.GetOk("approved_patches"); ok && v.(*schema.Set).Len() > 0 {
-		input.ApprovedPatches = flex.ExpandStringSet(v.(*schema.Set))
+		input.ApprovedPatches = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
 	if v, ok := d.GetOk("approved_patches_enable_non_security"); ok {
 		input.ApprovedPatchesEnableNonSecurity = aws.Bool(v.(bool))
 	}
 
+	if v, ok := d.GetOk("available_security_updates_compliance_status"); ok {
+		input.AvailableSecurityUpdatesComplianceStatus = types.PatchComplianceStatus(v.(string))
+	}
+
 	if v, ok := d.GetOk(names.AttrDescription); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -259,31 +270,31 @@ func resourcePatchBaselineCreate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if v, ok := d.GetOk("rejected_patches"); ok && v.(*schema.Set).Len() > 0 {
-		input.RejectedPatches = flex.ExpandStringSet(v.(*schema.Set))
+		input.RejectedPatches = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
 	if v, ok := d.GetOk("rejected_patches_action"); ok {
-		input.RejectedPatchesAction = aws.String(v.(string))
+		input.RejectedPatchesAction = types.PatchAction(v.(string))
 	}
 
 	if _, ok := d.GetOk(names.AttrSource); ok {
 		input.Sources = expandPatchSource(d)
 	}
 
-	output, err := conn.CreatePatchBaselineWithContext(ctx, input)
+	output, err := conn.CreatePatchBaseline(ctx, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating SSM Patch Baseline (%s): %s", name, err)
 	}
 
-	d.SetId(aws.StringValue(output.BaselineId))
+	d.SetId(aws.ToString(output.BaselineId))
 
 	return append(diags, resourcePatchBaselineRead(ctx, d, meta)...)
 }
 
 func resourcePatchBaselineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
 
 	output, err := findPatchBaselineByID(ctx, conn, d.Id())
 
@@ -314,10 +325,11 @@ func resourcePatchBaselineRead(ctx context.Context, d *schema.ResourceData, meta
 	if err := d.Set("approval_rule", flattenPatchRuleGroup(output.ApprovalRules)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting approval_rule: %s", err)
 	}
-	d.Set("approved_patches", aws.StringValueSlice(output.ApprovedPatches))
+	d.Set("approved_patches", output.ApprovedPatches)
 	d.Set("approved_patches_compliance_level", output.ApprovedPatchesComplianceLevel)
 	d.Set("approved_patches_enable_non_security", output.ApprovedPatchesEnableNonSecurity)
 	d.Set(names.AttrARN, arn)
+	d.Set("available_security_updates_compliance_status", output.AvailableSecurityUpdatesComplianceStatus)
 	d.Set(names.AttrDescription, output.Description)
 	if err := d.Set("global_filter", flattenPatchFilterGroup(output.GlobalFilters)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting global_filter: %s", err)
@@ -325,7 +337,7 @@ func resourcePatchBaselineRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set(names.AttrJSON, jsonString)
 	d.Set(names.AttrName, output.Name)
 	d.Set("operating_system", output.OperatingSystem)
-	d.Set("rejected_patches", aws.StringValueSlice(output.RejectedPatches))
+	d.Set("rejected_patches", output.RejectedPatches)
 	d.Set("rejected_patches_action", output.RejectedPatchesAction)
 	if err := d.Set(names.AttrSource, flattenPatchSource(output.Sources)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting source: %s", err)
@@ -336,7 +348,7 @@ func resourcePatchBaselineRead(ctx context.Context, d *schema.ResourceData, meta
 
 func resourcePatchBaselineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
 
 	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
 		input := &ssm.UpdatePatchBaselineInput{
@@ -348,17 +360,21 @@ func resourcePatchBaselineUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 
 		if d.HasChange("approved_patches") {
-			input.ApprovedPatches = flex.ExpandStringSet(d.Get("approved_patches").(*schema.Set))
+			input.ApprovedPatches = flex.ExpandStringValueSet(d.Get("approved_patches").(*schema.Set))
 		}
 
 		if d.HasChange("approved_patches_compliance_level") {
-			input.ApprovedPatchesComplianceLevel = aws.String(d.Get("approved_patches_compliance_level").(string))
+			input.ApprovedPatchesComplianceLevel = types.PatchComplianceLevel(d.Get("approved_patches_compliance_level").(string))
 		}
 
 		if d.HasChange("approved_patches_enable_non_security") {
 			input.ApprovedPatchesEnableNonSecurity = aws.Bool(d.Get("approved_patches_enable_non_security").(bool))
 		}
 
+		if d.HasChange("available_security_updates_compliance_status") {
+			input.AvailableSecurityUpdatesComplianceStatus = types.PatchComplianceStatus(d.Get("available_security_updates_compliance_status").(string))
+		}
+
 		if d.HasChange(names.AttrDescription) {
 			input.Description = aws.String(d.Get(names.AttrDescription).(string))
 		}
@@ -372,18 +388,18 @@ func resourcePatchBaselineUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 
 		if d.HasChange("rejected_patches") {
-			input.RejectedPatches = flex.ExpandStringSet(d.Get("rejected_patches").(*schema.Set))
+			input.RejectedPatches = flex.ExpandStringValueSet(d.Get("rejected_patches").(*schema.Set))
 		}
 
 		if d.HasChange("rejected_patches_action") {
-			input.RejectedPatchesAction = aws.String(d.Get("rejected_patches_action").(string))
+			input.RejectedPatchesAction = types.PatchAction(d.Get("rejected_patches_action").(string))
 		}
 
 		if d.HasChange(names.AttrSource) {
 			input.Sources = expandPatchSource(d)
 		}
 
-		_, err := conn.UpdatePatchBaselineWithContext(ctx, input)
+		_, err := conn.UpdatePatchBaseline(ctx, input)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating SSM Patch Baseline (%s): %s", d.Id(), err)
@@ -394,23 +410,23 @@ func resourcePatchBaselineUpdate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourcePatchBaselineDelete(ctx context.Context, d *schema.ResourceData, meta any) (diags diag.Diagnostics) {
-	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
 
 	log.Printf("[INFO] Deleting SSM Patch Baseline: %s", d.Id())
 	input := &ssm.DeletePatchBaselineInput{
 		BaselineId: aws.String(d.Id()),
 	}
 
-	_, err := conn.DeletePatchBaselineWithContext(ctx, input)
+	_, err := conn.DeletePatchBaseline(ctx, input)
 
-	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeResourceInUseException) {
+	if errs.IsA[*types.ResourceInUseException](err) {
 		// Reset the default patch baseline before retrying.
-		diags = append(diags, defaultPatchBaselineRestoreOSDefault(ctx, meta.(*conns.AWSClient).SSMClient(ctx), types.OperatingSystem(d.Get("operating_system").(string)))...)
+		diags = append(diags, defaultPatchBaselineRestoreOSDefault(ctx, conn, types.OperatingSystem(d.Get("operating_system").(string)))...)
 		if diags.HasError() {
 			return
 		}
 
-		_, err = conn.DeletePatchBaselineWithContext(ctx, input)
+		_, err = conn.DeletePatchBaseline(ctx, input)
 	}
 
 	if err != nil {
@@ -420,14 +436,14 @@ func resourcePatchBaselineDelete(ctx context.Context, d *schema.ResourceData, me
 	return
 }
 
-func findPatchBaselineByID(ctx context.Context, conn *ssm.SSM, id string) (*ssm.GetPatchBaselineOutput, error) {
+func findPatchBaselineByID(ctx context.Context, conn *ssm.Client, id string) (*ssm.GetPatchBaselineOutput, error) {
 	input := &ssm.GetPatchBaselineInput{
 		BaselineId: aws.String(id),
 	}
 
-	output, err := conn.GetPatchBaselineWithContext(ctx, input)
+	output, err := conn.GetPatchBaseline(ctx, input)
 
-	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeDoesNotExistException) {
+	if errs.IsA[*types.DoesNotExistException](err) {
 		return nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -445,29 +461,29 @@ func findPatchBaselineByID(ctx context.Context, conn *ssm.SSM, id string) (*ssm.
 	return output, nil
 }
 
-func expandPatchFilterGroup(d *schema.ResourceData) *ssm.PatchFilterGroup {
-	var filters []*ssm.PatchFilter
+func expandPatchFilterGroup(d *schema.ResourceData) *types.PatchFilterGroup {
+	var filters []types.PatchFilter
 
 	filterConfig := d.Get("global_filter").([]interface{})
 
 	for _, fConfig := range filterConfig {
 		config := fConfig.(map[string]interface{})
 
-		filter := &ssm.PatchFilter{
-			Key:    aws.String(config[names.AttrKey].(string)),
-			Values: flex.ExpandStringList(config[names.AttrValues].([]interface{})),
+		filter := types.PatchFilter{
+			Key:    types.PatchFilterKey(config[names.AttrKey].(string)),
+			Values: flex.ExpandStringValueList(config[names.AttrValues].([]interface{})),
 		}
 
 		filters = append(filters, filter)
 	}
 
-	return &ssm.PatchFilterGroup{
+	return &types.PatchFilterGroup{
 		PatchFilters: filters,
 	}
 }
 
-func flattenPatchFilterGroup(group *ssm.PatchFilterGroup) []map[string]interface{} {
-	if len(group.PatchFilters) == 0 {
+func flattenPatchFilterGroup(group *types.PatchFilterGroup) []map[string]interface{} {
+	if group == nil || len(group.PatchFilters) == 0 {
 		return nil
 	}
 
@@ -475,8 +491,8 @@ func flattenPatchFilterGroup(group *ssm.PatchFilterGroup) []map[string]interface
 
 	for _, filter := range group.PatchFilters {
 		f := make(map[string]interface{})
-		f[names.AttrKey] = aws.StringValue(filter.Key)
-		f[names.AttrValues] = flex.FlattenStringList(filter.Values)
+		f[names.AttrKey] = filter.Key
+		f[names.AttrValues] = filter.Values
 
 		result = append(result, f)
 	}
@@ -484,54 +500,54 @@ func flattenPatchFilterGroup(group *ssm.PatchFilterGroup) []map[string]interface
 	return result
 }
 
-func expandPatchRuleGroup(d *schema.ResourceData) *ssm.PatchRuleGroup {
-	var rules []*ssm.PatchRule
+func expandPatchRuleGroup(d *schema.ResourceData) *types.PatchRuleGroup {
+	var rules []types.PatchRule
 
 	ruleConfig := d.Get("approval_rule").([]interface{})
 
 	for _, rConfig := range ruleConfig {
 		rCfg := rConfig.(map[string]interface{})
 
-		var filters []*ssm.PatchFilter
+		var filters []types.PatchFilter
 		filterConfig := rCfg["patch_filter"].([]interface{})
 
 		for _, fConfig := range filterConfig {
 			fCfg := fConfig.(map[string]interface{})
 
-			filter := &ssm.PatchFilter{
-				Key:    aws.String(fCfg[names.AttrKey].(string)),
-				Values: flex.ExpandStringList(fCfg[names.AttrValues].([]interface{})),
+			filter := types.PatchFilter{
+				Key:    types.PatchFilterKey(fCfg[names.AttrKey].(string)),
+				Values: flex.ExpandStringValueList(fCfg[names.AttrValues].([]interface{})),
 			}
 
 			filters = append(filters, filter)
 		}
 
-		filterGroup := &ssm.PatchFilterGroup{
+		filterGroup := &types.PatchFilterGroup{
 			PatchFilters: filters,
 		}
 
-		rule := &ssm.PatchRule{
+		rule := types.PatchRule{
 			PatchFilterGroup:  filterGroup,
-			ComplianceLevel:   aws.String(rCfg["compliance_level"].(string)),
+			ComplianceLevel:   types.PatchComplianceLevel(rCfg["compliance_level"].(string)),
 			EnableNonSecurity: aws.Bool(rCfg["enable_non_security"].(bool)),
 		}
 
 		if v, ok := rCfg["approve_until_date"].(string); ok && v != "" {
 			rule.ApproveUntilDate = aws.String(v)
 		} else if v, ok := rCfg["approve_after_days"].(int); ok {
-			rule.ApproveAfterDays = aws.Int64(int64(v))
+			rule.ApproveAfterDays = aws.Int32(int32(v))
 		}
 
 		rules = append(rules, rule)
 	}
 
-	return &ssm.PatchRuleGroup{
+	return &types.PatchRuleGroup{
 		PatchRules: rules,
 	}
 }
 
-func flattenPatchRuleGroup(group *ssm.PatchRuleGroup) []map[string]interface{} {
-	if len(group.PatchRules) == 0 {
+func flattenPatchRuleGroup(group *types.PatchRuleGroup) []map[string]interface{} {
+	if group == nil || len(group.PatchRules) == 0 {
 		return nil
 	}
 
@@ -539,16 +555,16 @@ func flattenPatchRuleGroup(group *ssm.PatchRuleGroup) []map[string]interface{} {
 
 	for _, rule := range group.PatchRules {
 		r := make(map[string]interface{})
-		r["compliance_level"] = aws.StringValue(rule.ComplianceLevel)
-		r["enable_non_security"] = aws.BoolValue(rule.EnableNonSecurity)
+		r["compliance_level"] = rule.ComplianceLevel
+		r["enable_non_security"] = aws.ToBool(rule.EnableNonSecurity)
 		r["patch_filter"] = flattenPatchFilterGroup(rule.PatchFilterGroup)
 
 		if rule.ApproveAfterDays != nil {
-			r["approve_after_days"] = aws.Int64Value(rule.ApproveAfterDays)
+			r["approve_after_days"] = aws.ToInt32(rule.ApproveAfterDays)
 		}
 
 		if rule.ApproveUntilDate != nil {
-			r["approve_until_date"] = aws.StringValue(rule.ApproveUntilDate)
+			r["approve_until_date"] = aws.ToString(rule.ApproveUntilDate)
 		}
 
 		result = append(result, r)
@@ -557,18 +573,18 @@ func flattenPatchRuleGroup(group *ssm.PatchRuleGroup) []map[string]interface{} {
 	return result
 }
 
-func expandPatchSource(d *schema.ResourceData) []*ssm.PatchSource {
-	var sources []*ssm.PatchSource
+func expandPatchSource(d *schema.ResourceData) []types.PatchSource {
+	var sources []types.PatchSource
 
 	sourceConfigs := d.Get(names.AttrSource).([]interface{})
 
 	for _, sConfig := range sourceConfigs {
 		config := sConfig.(map[string]interface{})
 
-		source := &ssm.PatchSource{
+		source := types.PatchSource{
 			Name:          aws.String(config[names.AttrName].(string)),
 			Configuration: aws.String(config[names.AttrConfiguration].(string)),
-			Products:      flex.ExpandStringList(config["products"].([]interface{})),
+			Products:      flex.ExpandStringValueList(config["products"].([]interface{})),
 		}
 
 		sources = append(sources, source)
@@ -577,7 +593,7 @@ func expandPatchSource(d *schema.ResourceData) []*ssm.PatchSource {
 	return sources
 }
 
-func flattenPatchSource(sources []*ssm.PatchSource) []map[string]interface{} {
+func flattenPatchSource(sources []types.PatchSource) []map[string]interface{} {
 	if len(sources) == 0 {
 		return nil
 	}
@@ -586,9 +602,9 @@ func flattenPatchSource(sources []*ssm.PatchSource) []map[string]interface{} {
 
 	for _, source := range sources {
 		s := make(map[string]interface{})
-		s[names.AttrName] = aws.StringValue(source.Name)
-		s[names.AttrConfiguration] = aws.StringValue(source.Configuration)
-		s["products"] = flex.FlattenStringList(source.Products)
+		s[names.AttrName] = aws.ToString(source.Name)
+		s[names.AttrConfiguration] = aws.ToString(source.Configuration)
+		s["products"] = source.Products
 		result = append(result, s)
 	}
 
@@ -614,6 +630,7 @@ func hasObjectContentChanges(d sdkv2.ResourceDiffer) bool {
 		"approved_patches_compliance_level",
 		"rejected_patches_action",
 		"approved_patches_enable_non_security",
+		"available_security_updates_compliance_status",
 		names.AttrSource,
 	} {
 		if d.HasChange(key) {
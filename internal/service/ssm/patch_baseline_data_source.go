@@ -8,12 +8,14 @@ import (
 	"encoding/json"
 	"log"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -36,6 +38,10 @@ func DataSourcePatchBaseline() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"available_security_updates_compliance_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"approval_rule": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -118,7 +124,7 @@ func DataSourcePatchBaseline() *schema.Resource {
 			"operating_system": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.StringInSlice(ssm.OperatingSystem_Values(), false),
+				ValidateFunc: validation.StringInSlice(enum.Values[types.OperatingSystem](), false),
 			},
 			names.AttrOwner: {
 				Type:         schema.TypeString,
@@ -161,22 +167,22 @@ func DataSourcePatchBaseline() *schema.Resource {
 
 func dataPatchBaselineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
 
-	filters := []*ssm.PatchOrchestratorFilter{
+	filters := []types.PatchOrchestratorFilter{
 		{
 			Key: aws.String("OWNER"),
-			Values: []*string{
-				aws.String(d.Get(names.AttrOwner).(string)),
+			Values: []string{
+				d.Get(names.AttrOwner).(string),
 			},
 		},
 	}
 
 	if v, ok := d.GetOk(names.AttrNamePrefix); ok {
-		filters = append(filters, &ssm.PatchOrchestratorFilter{
+		filters = append(filters, types.PatchOrchestratorFilter{
 			Key: aws.String("NAME_PREFIX"),
-			Values: []*string{
-				aws.String(v.(string)),
+			Values: []string{
+				v.(string),
 			},
 		})
 	}
@@ -185,18 +191,18 @@ func dataPatchBaselineRead(ctx context.Context, d *schema.ResourceData, meta int
 		Filters: filters,
 	}
 
-	log.Printf("[DEBUG] Reading DescribePatchBaselines: %s", params)
+	log.Printf("[DEBUG] Reading DescribePatchBaselines: %v", params)
 
-	resp, err := conn.DescribePatchBaselinesWithContext(ctx, params)
+	resp, err := conn.DescribePatchBaselines(ctx, params)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "describing SSM PatchBaselines: %s", err)
 	}
 
-	var filteredBaselines []*ssm.PatchBaselineIdentity
+	var filteredBaselines []types.PatchBaselineIdentity
 	if v, ok := d.GetOk("operating_system"); ok {
 		for _, baseline := range resp.BaselineIdentities {
-			if v.(string) == aws.StringValue(baseline.OperatingSystem) {
+			if v.(string) == string(baseline.OperatingSystem) {
 				filteredBaselines = append(filteredBaselines, baseline)
 			}
 		}
@@ -204,14 +210,14 @@ func dataPatchBaselineRead(ctx context.Context, d *schema.ResourceData, meta int
 
 	if v, ok := d.GetOk("default_baseline"); ok {
 		for _, baseline := range filteredBaselines {
-			if v.(bool) == aws.BoolValue(baseline.DefaultBaseline) {
-				filteredBaselines = []*ssm.PatchBaselineIdentity{baseline}
+			if v.(bool) == baseline.DefaultBaseline {
+				filteredBaselines = []types.PatchBaselineIdentity{baseline}
 				break
 			}
 		}
 	}
 
-	if len(filteredBaselines) < 1 || filteredBaselines[0] == nil {
+	if len(filteredBaselines) < 1 {
 		return sdkdiag.AppendErrorf(diags, "Your query returned no results. Please change your search criteria and try again.")
 	}
 
@@ -225,7 +231,7 @@ func dataPatchBaselineRead(ctx context.Context, d *schema.ResourceData, meta int
 		BaselineId: baseline.BaselineId,
 	}
 
-	output, err := conn.GetPatchBaselineWithContext(ctx, input)
+	output, err := conn.GetPatchBaseline(ctx, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "getting SSM PatchBaseline: %s", err)
@@ -238,18 +244,19 @@ func dataPatchBaselineRead(ctx context.Context, d *schema.ResourceData, meta int
 	}
 	jsonString := string(jsonDoc)
 
-	d.SetId(aws.StringValue(baseline.BaselineId))
-	d.Set("approved_patches", aws.StringValueSlice(output.ApprovedPatches))
+	d.SetId(aws.ToString(baseline.BaselineId))
+	d.Set("approved_patches", output.ApprovedPatches)
 	d.Set("approved_patches_compliance_level", output.ApprovedPatchesComplianceLevel)
 	d.Set("approved_patches_enable_non_security", output.ApprovedPatchesEnableNonSecurity)
 	d.Set("approval_rule", flattenPatchRuleGroup(output.ApprovalRules))
+	d.Set("available_security_updates_compliance_status", output.AvailableSecurityUpdatesComplianceStatus)
 	d.Set("default_baseline", baseline.DefaultBaseline)
 	d.Set(names.AttrDescription, baseline.BaselineDescription)
 	d.Set("global_filter", flattenPatchFilterGroup(output.GlobalFilters))
 	d.Set(names.AttrJSON, jsonString)
 	d.Set(names.AttrName, baseline.BaselineName)
 	d.Set("operating_system", baseline.OperatingSystem)
-	d.Set("rejected_patches", aws.StringValueSlice(output.RejectedPatches))
+	d.Set("rejected_patches", output.RejectedPatches)
 	d.Set("rejected_patches_action", output.RejectedPatchesAction)
 	d.Set(names.AttrSource, flattenPatchSource(output.Sources))
 
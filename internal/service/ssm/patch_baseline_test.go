@@ -9,8 +9,9 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
@@ -48,7 +49,7 @@ func TestAccSSMPatchBaseline_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "approved_patches.#", acctest.CtOne),
 					resource.TestCheckTypeSetElemAttr(resourceName, "approved_patches.*", "KB123456"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, fmt.Sprintf("patch-baseline-%s", name)),
-					resource.TestCheckResourceAttr(resourceName, "approved_patches_compliance_level", ssm.PatchComplianceLevelCritical),
+					resource.TestCheckResourceAttr(resourceName, "approved_patches_compliance_level", string(types.PatchComplianceLevelCritical)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Baseline containing all updates approved for production systems"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 					resource.TestCheckResourceAttr(resourceName, "approved_patches_enable_non_security", "false"),
@@ -80,14 +81,14 @@ func TestAccSSMPatchBaseline_basic(t *testing.T) {
 					resource.TestCheckTypeSetElemAttr(resourceName, "approved_patches.*", "KB123456"),
 					resource.TestCheckTypeSetElemAttr(resourceName, "approved_patches.*", "KB456789"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, fmt.Sprintf("updated-patch-baseline-%s", name)),
-					resource.TestCheckResourceAttr(resourceName, "approved_patches_compliance_level", ssm.PatchComplianceLevelHigh),
+					resource.TestCheckResourceAttr(resourceName, "approved_patches_compliance_level", string(types.PatchComplianceLevelHigh)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Baseline containing all updates approved for production systems - August 2017"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 					acctest.CheckResourceAttrJMESPair(resourceName, names.AttrJSON, "ApprovedPatches[0]", resourceName, "approved_patches.1"),
 					acctest.CheckResourceAttrJMESPair(resourceName, names.AttrJSON, "ApprovedPatches[1]", resourceName, "approved_patches.0"),
 					acctest.CheckResourceAttrJMES(resourceName, names.AttrJSON, "ApprovedPatches|length(@)", "2"),
 					func(*terraform.State) error {
-						if aws.StringValue(before.BaselineId) != aws.StringValue(after.BaselineId) {
+						if aws.ToString(before.BaselineId) != aws.ToString(after.BaselineId) {
 							t.Fatal("Baseline IDs changed unexpectedly")
 						}
 						return nil
@@ -185,7 +186,7 @@ func TestAccSSMPatchBaseline_operatingSystem(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "approval_rule.#", acctest.CtOne),
 					resource.TestCheckResourceAttr(resourceName, "approval_rule.0.approve_after_days", "7"),
 					resource.TestCheckResourceAttr(resourceName, "approval_rule.0.patch_filter.#", "2"),
-					resource.TestCheckResourceAttr(resourceName, "approval_rule.0.compliance_level", ssm.PatchComplianceLevelCritical),
+					resource.TestCheckResourceAttr(resourceName, "approval_rule.0.compliance_level", string(types.PatchComplianceLevelCritical)),
 					resource.TestCheckResourceAttr(resourceName, "approval_rule.0.enable_non_security", "true"),
 					resource.TestCheckResourceAttr(resourceName, "operating_system", "AMAZON_LINUX"),
 				),
@@ -202,8 +203,8 @@ func TestAccSSMPatchBaseline_operatingSystem(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "approval_rule.#", acctest.CtOne),
 					resource.TestCheckResourceAttr(resourceName, "approval_rule.0.approve_after_days", "7"),
 					resource.TestCheckResourceAttr(resourceName, "approval_rule.0.patch_filter.#", "2"),
-					resource.TestCheckResourceAttr(resourceName, "approval_rule.0.compliance_level", ssm.PatchComplianceLevelInformational),
-					resource.TestCheckResourceAttr(resourceName, "operating_system", ssm.OperatingSystemWindows),
+					resource.TestCheckResourceAttr(resourceName, "approval_rule.0.compliance_level", string(types.PatchComplianceLevelInformational)),
+					resource.TestCheckResourceAttr(resourceName, "operating_system", string(types.OperatingSystemWindows)),
 					testAccCheckPatchBaselineRecreated(t, &before, &after),
 				),
 			},
@@ -230,7 +231,7 @@ func TestAccSSMPatchBaseline_approveUntilDateParam(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "approval_rule.#", acctest.CtOne),
 					resource.TestCheckResourceAttr(resourceName, "approval_rule.0.approve_until_date", "2020-01-01"),
 					resource.TestCheckResourceAttr(resourceName, "approval_rule.0.patch_filter.#", "2"),
-					resource.TestCheckResourceAttr(resourceName, "approval_rule.0.compliance_level", ssm.PatchComplianceLevelCritical),
+					resource.TestCheckResourceAttr(resourceName, "approval_rule.0.compliance_level", string(types.PatchComplianceLevelCritical)),
 					resource.TestCheckResourceAttr(resourceName, "approval_rule.0.enable_non_security", "true"),
 					resource.TestCheckResourceAttr(resourceName, "operating_system", "AMAZON_LINUX"),
 				),
@@ -247,10 +248,10 @@ func TestAccSSMPatchBaseline_approveUntilDateParam(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "approval_rule.#", acctest.CtOne),
 					resource.TestCheckResourceAttr(resourceName, "approval_rule.0.approve_until_date", "2020-02-02"),
 					resource.TestCheckResourceAttr(resourceName, "approval_rule.0.patch_filter.#", "2"),
-					resource.TestCheckResourceAttr(resourceName, "approval_rule.0.compliance_level", ssm.PatchComplianceLevelCritical),
+					resource.TestCheckResourceAttr(resourceName, "approval_rule.0.compliance_level", string(types.PatchComplianceLevelCritical)),
 					resource.TestCheckResourceAttr(resourceName, "operating_system", "AMAZON_LINUX"),
 					func(*terraform.State) error {
-						if aws.StringValue(before.BaselineId) != aws.StringValue(after.BaselineId) {
+						if aws.ToString(before.BaselineId) != aws.ToString(after.BaselineId) {
 							t.Fatal("Baseline IDs changed unexpectedly")
 						}
 						return nil
@@ -304,7 +305,7 @@ func TestAccSSMPatchBaseline_sources(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "source.1.products.0", "AmazonLinux2018.03"),
 
 					func(*terraform.State) error {
-						if aws.StringValue(before.BaselineId) != aws.StringValue(after.BaselineId) {
+						if aws.ToString(before.BaselineId) != aws.ToString(after.BaselineId) {
 							t.Fatal("Baseline IDs changed unexpectedly")
 						}
 						return nil
@@ -371,6 +372,48 @@ func TestAccSSMPatchBaseline_rejectPatchesAction(t *testing.T) {
 	})
 }
 
+func TestAccSSMPatchBaseline_availableSecurityUpdatesComplianceStatus(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after ssm.GetPatchBaselineOutput
+	name := sdkacctest.RandString(10)
+	resourceName := "aws_ssm_patch_baseline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPatchBaselineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatchBaselineConfig_availableSecurityUpdatesComplianceStatus(name, string(types.PatchComplianceStatusNonCompliant)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPatchBaselineExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "available_security_updates_compliance_status", string(types.PatchComplianceStatusNonCompliant)),
+					acctest.CheckResourceAttrJMES(resourceName, names.AttrJSON, "AvailableSecurityUpdatesComplianceStatus", string(types.PatchComplianceStatusNonCompliant)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPatchBaselineConfig_availableSecurityUpdatesComplianceStatus(name, string(types.PatchComplianceStatusCompliant)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPatchBaselineExists(ctx, resourceName, &after),
+					resource.TestCheckResourceAttr(resourceName, "available_security_updates_compliance_status", string(types.PatchComplianceStatusCompliant)),
+					func(*terraform.State) error {
+						if aws.ToString(before.BaselineId) != aws.ToString(after.BaselineId) {
+							t.Fatal("Baseline IDs changed unexpectedly")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 // testAccSSMPatchBaseline_deleteDefault needs to be serialized with the other
 // Default Patch Baseline acceptance tests because it sets the default patch baseline
 func testAccSSMPatchBaseline_deleteDefault(t *testing.T) {
@@ -393,13 +436,13 @@ func testAccSSMPatchBaseline_deleteDefault(t *testing.T) {
 			},
 			{
 				PreConfig: func() {
-					conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn(ctx)
+					conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
 
 					input := &ssm.RegisterDefaultPatchBaselineInput{
 						BaselineId: ssmPatch.BaselineId,
 					}
-					if _, err := conn.RegisterDefaultPatchBaselineWithContext(ctx, input); err != nil {
-						t.Fatalf("registering Default Patch Baseline (%s): %s", aws.StringValue(ssmPatch.BaselineId), err)
+					if _, err := conn.RegisterDefaultPatchBaseline(ctx, input); err != nil {
+						t.Fatalf("registering Default Patch Baseline (%s): %s", aws.ToString(ssmPatch.BaselineId), err)
 					}
 				},
 				Config: "# Empty config", // Deletes the patch baseline
@@ -425,7 +468,7 @@ func testAccCheckPatchBaselineExists(ctx context.Context, n string, v *ssm.GetPa
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn(ctx)
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
 
 		output, err := tfssm.FindPatchBaselineByID(ctx, conn, rs.Primary.ID)
 
@@ -441,7 +484,7 @@ func testAccCheckPatchBaselineExists(ctx context.Context, n string, v *ssm.GetPa
 
 func testAccCheckPatchBaselineDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn(ctx)
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_ssm_patch_baseline" {
@@ -703,3 +746,23 @@ resource "aws_ssm_patch_baseline" "test" {
 }
 `, rName)
 }
+
+func testAccPatchBaselineConfig_availableSecurityUpdatesComplianceStatus(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "test" {
+  name             = "patch-baseline-%[1]s"
+  operating_system = "WINDOWS"
+
+  available_security_updates_compliance_status = %[2]q
+
+  approval_rule {
+    approve_after_days = 7
+
+    patch_filter {
+      key    = "CLASSIFICATION"
+      values = ["SecurityUpdates"]
+    }
+  }
+}
+`, rName, status)
+}
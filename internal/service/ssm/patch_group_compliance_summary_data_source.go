@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_ssm_patch_group_compliance_summary", name="Patch Group Compliance Summary")
+func DataSourcePatchGroupComplianceSummary() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePatchGroupComplianceSummaryRead,
+
+		Schema: map[string]*schema.Schema{
+			"instances": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_available_security_updates": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_critical_non_compliant_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_failed_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_installed_other_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_installed_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_installed_pending_reboot_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_installed_rejected_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_missing_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_not_applicable_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_other_non_compliant_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_security_non_compliant_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_unreported_not_applicable_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"patch_group": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+		},
+	}
+}
+
+func dataSourcePatchGroupComplianceSummaryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	patchGroup := d.Get("patch_group").(string)
+	input := &ssm.DescribePatchGroupStateInput{
+		PatchGroup: aws.String(patchGroup),
+	}
+
+	output, err := conn.DescribePatchGroupState(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Patch Group (%s) compliance summary: %s", patchGroup, err)
+	}
+
+	d.SetId(patchGroup)
+	d.Set("instances", output.Instances)
+	d.Set("instances_with_available_security_updates", output.InstancesWithAvailableSecurityUpdates)
+	d.Set("instances_with_critical_non_compliant_patches", output.InstancesWithCriticalNonCompliantPatches)
+	d.Set("instances_with_failed_patches", output.InstancesWithFailedPatches)
+	d.Set("instances_with_installed_other_patches", output.InstancesWithInstalledOtherPatches)
+	d.Set("instances_with_installed_patches", output.InstancesWithInstalledPatches)
+	d.Set("instances_with_installed_pending_reboot_patches", output.InstancesWithInstalledPendingRebootPatches)
+	d.Set("instances_with_installed_rejected_patches", output.InstancesWithInstalledRejectedPatches)
+	d.Set("instances_with_missing_patches", output.InstancesWithMissingPatches)
+	d.Set("instances_with_not_applicable_patches", output.InstancesWithNotApplicablePatches)
+	d.Set("instances_with_other_non_compliant_patches", output.InstancesWithOtherNonCompliantPatches)
+	d.Set("instances_with_security_non_compliant_patches", output.InstancesWithSecurityNonCompliantPatches)
+	d.Set("instances_with_unreported_not_applicable_patches", output.InstancesWithUnreportedNotApplicablePatches)
+
+	return diags
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMPatchGroupComplianceSummaryDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssm_patch_group_compliance_summary.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatchGroupComplianceSummaryDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "patch_group", "aws_ssm_patch_group.test", "patch_group"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances_with_available_security_updates", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances_with_critical_non_compliant_patches", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances_with_failed_patches", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances_with_installed_other_patches", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances_with_installed_patches", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances_with_installed_pending_reboot_patches", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances_with_installed_rejected_patches", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances_with_missing_patches", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances_with_not_applicable_patches", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances_with_other_non_compliant_patches", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances_with_security_non_compliant_patches", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances_with_unreported_not_applicable_patches", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPatchGroupComplianceSummaryDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "test" {
+  name             = %[1]q
+  operating_system = "WINDOWS"
+  approved_patches = ["KB123456"]
+}
+
+resource "aws_ssm_patch_group" "test" {
+  baseline_id = aws_ssm_patch_baseline.test.id
+  patch_group = %[1]q
+}
+
+data "aws_ssm_patch_group_compliance_summary" "test" {
+  patch_group = aws_ssm_patch_group.test.patch_group
+}
+`, rName)
+}
@@ -51,6 +51,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourcePatchBaseline,
 			TypeName: "aws_ssm_patch_baseline",
 		},
+		{
+			Factory:  DataSourcePatchGroupComplianceSummary,
+			TypeName: "aws_ssm_patch_group_compliance_summary",
+			Name:     "Patch Group Compliance Summary",
+		},
 	}
 }
 
@@ -322,8 +322,9 @@ func expandDynamicParameters(parameterMap map[string]interface{}) map[string]typ
 func flattenDynamicParameters(parameterMap map[string]types.DynamicSsmParameterValue) map[string]interface{} {
 	result := make(map[string]interface{})
 	for key, value := range parameterMap {
-		parameterValue := value.(*types.DynamicSsmParameterValueMemberVariable)
-		result[key] = parameterValue.Value
+		if parameterValue, ok := value.(*types.DynamicSsmParameterValueMemberVariable); ok {
+			result[key] = parameterValue.Value
+		}
 	}
 
 	return result
@@ -403,8 +404,8 @@ func flattenPagerDutyIntegration(integrations []types.Integration) []interface{}
 				pagerDutyData[names.AttrName] = v
 			}
 
-			if v := pagerDutyConfiguration.PagerDutyIncidentConfiguration.ServiceId; v != nil {
-				pagerDutyData["service_id"] = v
+			if v := pagerDutyConfiguration.PagerDutyIncidentConfiguration; v != nil && v.ServiceId != nil {
+				pagerDutyData["service_id"] = v.ServiceId
 			}
 
 			if v := pagerDutyConfiguration.SecretId; v != nil {
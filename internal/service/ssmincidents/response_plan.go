@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -81,9 +82,10 @@ func ResourceResponsePlan() *schema.Resource {
 										},
 									},
 									"dynamic_parameters": {
-										Type:     schema.TypeMap,
-										Optional: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
+										Type:             schema.TypeMap,
+										Optional:         true,
+										Elem:             &schema.Schema{Type: schema.TypeString},
+										ValidateDiagFunc: verify.MapValuesAre(enum.Validate[types.VariableType]()),
 									},
 								},
 							},
@@ -98,8 +100,11 @@ func ResourceResponsePlan() *schema.Resource {
 			"chat_channel": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+				Set: schema.HashString,
 			},
 			names.AttrDisplayName: {
 				Type:     schema.TypeString,
@@ -158,6 +163,7 @@ func ResourceResponsePlan() *schema.Resource {
 						"pagerduty": {
 							Type:     schema.TypeList,
 							Optional: true,
+							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									names.AttrName: {
@@ -254,6 +260,11 @@ func resourceResponsePlanUpdate(ctx context.Context, d *schema.ResourceData, met
 
 		if d.HasChanges(names.AttrAction) {
 			input.Actions = expandAction(d.Get(names.AttrAction).([]interface{}))
+
+			// Send an empty list so that removed actions are cleared.
+			if input.Actions == nil {
+				input.Actions = []types.Action{}
+			}
 		}
 
 		if d.HasChanges("chat_channel") {
@@ -276,6 +287,11 @@ func resourceResponsePlanUpdate(ctx context.Context, d *schema.ResourceData, met
 
 		if d.HasChanges("integration") {
 			input.Integrations = expandIntegration(d.Get("integration").([]interface{}))
+
+			// Send an empty list so that removed integrations are cleared.
+			if input.Integrations == nil {
+				input.Integrations = []types.Integration{}
+			}
 		}
 
 		_, err := client.UpdateResponsePlan(ctx, input)
@@ -775,6 +775,13 @@ func testResponsePlan_action(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"replication_set_arn"},
 			},
+			{
+				Config: testAccResponsePlanConfig_basic(rName, rName, acctest.CtOne),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,6 +18,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/synthetics"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/synthetics/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -42,7 +45,12 @@ func ResourceCanary() *schema.Resource {
 		UpdateWithoutTimeout: resourceCanaryUpdate,
 		DeleteWithoutTimeout: resourceCanaryDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("auto_upgrade_runtime_version", false)
+				d.Set("dry_run_before_update", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -85,11 +93,37 @@ func ResourceCanary() *schema.Resource {
 					return strings.TrimPrefix(new, "s3://") == old
 				},
 			},
+			"auto_upgrade_runtime_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"delete_lambda": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			"dry_run_before_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"dry_run_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dry_run_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_dry_run_execution_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"engine_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -153,8 +187,9 @@ func ResourceCanary() *schema.Resource {
 				},
 			},
 			"runtime_version": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressRuntimeVersionInSameLine,
 			},
 			names.AttrS3Bucket: {
 				Type:          schema.TypeString,
@@ -268,7 +303,10 @@ func ResourceCanary() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceCanaryCustomizeDiff,
+		),
 	}
 }
 
@@ -384,6 +422,9 @@ func resourceCanaryRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}.String()
 	d.Set(names.AttrARN, canaryArn)
 	d.Set("artifact_s3_location", canary.ArtifactS3Location)
+	if err := d.Set("dry_run_config", flattenCanaryDryRunConfig(canary.DryRunConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting dry_run_config: %s", err)
+	}
 	d.Set("engine_arn", canary.EngineArn)
 	d.Set(names.AttrExecutionRoleARN, canary.ExecutionRoleArn)
 	d.Set("failure_retention_period", canary.FailureRetentionPeriodInDays)
@@ -428,7 +469,7 @@ func resourceCanaryUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SyntheticsClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "start_canary") {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "auto_upgrade_runtime_version", "delete_lambda", "dry_run_before_update", "start_canary") {
 		input := &synthetics.UpdateCanaryInput{
 			Name: aws.String(d.Id()),
 		}
@@ -480,6 +521,22 @@ func resourceCanaryUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.ExecutionRoleArn = aws.String(n.(string))
 		}
 
+		// Validate the new configuration with a dry run and, once it passes, update the
+		// canary from the dry run rather than from the configuration directly.
+		if d.Get("dry_run_before_update").(bool) && d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, names.AttrSchedule, "auto_upgrade_runtime_version", "delete_lambda", "dry_run_before_update", "start_canary") {
+			dryRunID, err := runCanaryDryRun(ctx, conn, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Synthetics Canary (%s): %s", d.Id(), err)
+			}
+
+			input = &synthetics.UpdateCanaryInput{
+				DryRunId: aws.String(dryRunID),
+				Name:     aws.String(d.Id()),
+				Schedule: input.Schedule,
+			}
+		}
+
 		status := d.Get(names.AttrStatus).(string)
 		if status == string(awstypes.CanaryStateRunning) {
 			if err := stopCanary(ctx, d.Id(), conn); err != nil {
@@ -563,6 +620,138 @@ func resourceCanaryDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	return diags
 }
 
+func resourceCanaryCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("auto_upgrade_runtime_version").(bool) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).SyntheticsClient(ctx)
+
+	current := d.Get("runtime_version").(string)
+	latest, err := findLatestRuntimeVersionInLine(ctx, conn, current)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Synthetics runtime versions: %w", err)
+	}
+
+	if latest != current {
+		return d.SetNew("runtime_version", latest)
+	}
+
+	return nil
+}
+
+// suppressRuntimeVersionInSameLine suppresses differences between a configured runtime
+// version and a newer minor version of the same major line, e.g. "syn-nodejs-puppeteer-9.0"
+// and "syn-nodejs-puppeteer-9.1", when the canary tracks the latest runtime version.
+func suppressRuntimeVersionInSameLine(k, old, new string, d *schema.ResourceData) bool {
+	if !d.Get("auto_upgrade_runtime_version").(bool) {
+		return false
+	}
+
+	oldLine, oldMinor, ok := parseRuntimeVersion(old)
+	if !ok {
+		return false
+	}
+
+	newLine, newMinor, ok := parseRuntimeVersion(new)
+	if !ok {
+		return false
+	}
+
+	return oldLine == newLine && oldMinor >= newMinor
+}
+
+// parseRuntimeVersion splits a runtime version such as "syn-python-selenium-4.1" into its
+// major line ("syn-python-selenium-4") and minor version (1).
+func parseRuntimeVersion(v string) (string, int, bool) {
+	i := strings.LastIndex(v, ".")
+	if i <= 0 {
+		return "", 0, false
+	}
+
+	minor, err := strconv.Atoi(v[i+1:])
+	if err != nil {
+		return "", 0, false
+	}
+
+	return v[:i], minor, true
+}
+
+func findLatestRuntimeVersionInLine(ctx context.Context, conn *synthetics.Client, runtimeVersion string) (string, error) {
+	line, minor, ok := parseRuntimeVersion(runtimeVersion)
+	if !ok {
+		return "", &retry.NotFoundError{}
+	}
+
+	input := &synthetics.DescribeRuntimeVersionsInput{}
+	latest := runtimeVersion
+	now := time.Now()
+
+	pages := synthetics.NewDescribeRuntimeVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return "", err
+		}
+
+		for _, v := range page.RuntimeVersions {
+			if v.DeprecationDate != nil && aws.ToTime(v.DeprecationDate).Before(now) {
+				continue
+			}
+
+			if l, m, ok := parseRuntimeVersion(aws.ToString(v.VersionName)); ok && l == line && m > minor {
+				latest, minor = aws.ToString(v.VersionName), m
+			}
+		}
+	}
+
+	return latest, nil
+}
+
+// runCanaryDryRun starts a dry run of the canary with the updated configuration and
+// waits for it to complete, returning the dry run's ID if it passed.
+func runCanaryDryRun(ctx context.Context, conn *synthetics.Client, update *synthetics.UpdateCanaryInput) (string, error) {
+	name := aws.ToString(update.Name)
+	input := &synthetics.StartCanaryDryRunInput{
+		ArtifactConfig:               update.ArtifactConfig,
+		ArtifactS3Location:           update.ArtifactS3Location,
+		Code:                         update.Code,
+		ExecutionRoleArn:             update.ExecutionRoleArn,
+		FailureRetentionPeriodInDays: update.FailureRetentionPeriodInDays,
+		Name:                         update.Name,
+		RunConfig:                    update.RunConfig,
+		RuntimeVersion:               update.RuntimeVersion,
+		SuccessRetentionPeriodInDays: update.SuccessRetentionPeriodInDays,
+		VpcConfig:                    update.VpcConfig,
+	}
+
+	output, err := conn.StartCanaryDryRun(ctx, input)
+
+	if err != nil {
+		return "", fmt.Errorf("starting dry run: %w", err)
+	}
+
+	dryRunID := aws.ToString(output.DryRunConfig.DryRunId)
+
+	canary, err := waitCanaryDryRunCompleted(ctx, conn, name, dryRunID)
+
+	if err != nil {
+		return "", fmt.Errorf("waiting for dry run (%s) to complete: %w", dryRunID, err)
+	}
+
+	if status := aws.ToString(canary.DryRunConfig.LastDryRunExecutionStatus); status != string(awstypes.CanaryRunStatePassed) {
+		return "", fmt.Errorf("dry run (%s) %s", dryRunID, status)
+	}
+
+	return dryRunID, nil
+}
+
 func expandCanaryCode(d *schema.ResourceData) (*awstypes.CanaryCodeInput, error) {
 	codeConfig := &awstypes.CanaryCodeInput{
 		Handler: aws.String(d.Get("handler").(string)),
@@ -760,6 +949,19 @@ func expandCanaryVPCConfig(l []interface{}) *awstypes.VpcConfigInput {
 	return codeConfig
 }
 
+func flattenCanaryDryRunConfig(apiObject *awstypes.DryRunConfigOutput) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"dry_run_id":                    aws.ToString(apiObject.DryRunId),
+		"last_dry_run_execution_status": aws.ToString(apiObject.LastDryRunExecutionStatus),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenCanaryTimeline(timeline *awstypes.CanaryTimeline) []interface{} {
 	if timeline == nil {
 		return []interface{}{}
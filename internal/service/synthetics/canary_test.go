@@ -172,6 +172,76 @@ func TestAccSyntheticsCanary_runtimeVersion(t *testing.T) {
 	})
 }
 
+func TestAccSyntheticsCanary_autoUpgradeRuntimeVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SyntheticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCanaryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCanaryConfig_autoUpgradeRuntimeVersion(rName, "syn-nodejs-puppeteer-6.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCanaryExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "auto_upgrade_runtime_version", "true"),
+					resource.TestMatchResourceAttr(resourceName, "runtime_version", regexache.MustCompile(`^syn-nodejs-puppeteer-6\.[1-9]\d*$`)),
+				),
+			},
+			{
+				// A configured version older than the tracked one does not produce a diff.
+				Config:   testAccCanaryConfig_autoUpgradeRuntimeVersion(rName, "syn-nodejs-puppeteer-6.0"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_upgrade_runtime_version", "zip_file", "start_canary", "delete_lambda"},
+			},
+		},
+	})
+}
+
+func TestAccSyntheticsCanary_dryRunBeforeUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf1, conf2 awstypes.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SyntheticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCanaryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCanaryConfig_dryRunBeforeUpdate(rName, "syn-nodejs-puppeteer-6.1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCanaryExists(ctx, resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "dry_run_before_update", "true"),
+					resource.TestCheckResourceAttr(resourceName, "runtime_version", "syn-nodejs-puppeteer-6.1"),
+				),
+			},
+			{
+				Config: testAccCanaryConfig_dryRunBeforeUpdate(rName, "syn-nodejs-puppeteer-6.2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCanaryExists(ctx, resourceName, &conf2),
+					testAccCheckCanaryIsUpdated(&conf1, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "dry_run_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttrSet(resourceName, "dry_run_config.0.dry_run_id"),
+					resource.TestCheckResourceAttr(resourceName, "dry_run_config.0.last_dry_run_execution_status", "PASSED"),
+					resource.TestCheckResourceAttr(resourceName, "runtime_version", "syn-nodejs-puppeteer-6.2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSyntheticsCanary_startCanary(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf1, conf2, conf3 awstypes.Canary
@@ -966,6 +1036,48 @@ resource "aws_synthetics_canary" "test" {
 `, rName, version))
 }
 
+func testAccCanaryConfig_autoUpgradeRuntimeVersion(rName, version string) string {
+	return acctest.ConfigCompose(testAccCanaryConfig_base(rName), fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                         = %[1]q
+  artifact_s3_location         = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn           = aws_iam_role.test.arn
+  handler                      = "exports.handler"
+  zip_file                     = "test-fixtures/lambdatest.zip"
+  runtime_version              = %[2]q
+  auto_upgrade_runtime_version = true
+  delete_lambda                = true
+
+  schedule {
+    expression = "rate(0 minute)"
+  }
+
+  depends_on = [aws_iam_role.test, aws_iam_role_policy.test]
+}
+`, rName, version))
+}
+
+func testAccCanaryConfig_dryRunBeforeUpdate(rName, version string) string {
+	return acctest.ConfigCompose(testAccCanaryConfig_base(rName), fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                  = %[1]q
+  artifact_s3_location  = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn    = aws_iam_role.test.arn
+  handler               = "exports.handler"
+  zip_file              = "test-fixtures/lambdatest.zip"
+  runtime_version       = %[2]q
+  dry_run_before_update = true
+  delete_lambda         = true
+
+  schedule {
+    expression = "rate(0 minute)"
+  }
+
+  depends_on = [aws_iam_role.test, aws_iam_role_policy.test]
+}
+`, rName, version))
+}
+
 func testAccCanaryConfig_zipUpdated(rName string) string {
 	return acctest.ConfigCompose(testAccCanaryConfig_base(rName), fmt.Sprintf(`
 resource "aws_synthetics_canary" "test" {
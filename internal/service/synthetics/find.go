@@ -44,11 +44,13 @@ func FindCanaryByName(ctx context.Context, conn *synthetics.Client, name string)
 	return output.Canary, nil
 }
 
-func FindGroupByName(ctx context.Context, conn *synthetics.Client, name string) (*awstypes.Group, error) {
-	input := &synthetics.GetGroupInput{
-		GroupIdentifier: aws.String(name),
+func findCanaryDryRunByTwoPartKey(ctx context.Context, conn *synthetics.Client, name, dryRunID string) (*awstypes.Canary, error) {
+	input := &synthetics.GetCanaryInput{
+		DryRunId: aws.String(dryRunID),
+		Name:     aws.String(name),
 	}
-	output, err := conn.GetGroup(ctx, input)
+
+	output, err := conn.GetCanary(ctx, input)
 
 	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return nil, &retry.NotFoundError{
@@ -61,18 +63,18 @@ func FindGroupByName(ctx context.Context, conn *synthetics.Client, name string)
 		return nil, err
 	}
 
-	if output == nil || output.Group == nil {
+	if output == nil || output.Canary == nil || output.Canary.DryRunConfig == nil {
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	return output.Group, nil
+	return output.Canary, nil
 }
 
-func FindAssociatedGroup(ctx context.Context, conn *synthetics.Client, canaryArn string, groupName string) (*awstypes.GroupSummary, error) {
-	input := &synthetics.ListAssociatedGroupsInput{
-		ResourceArn: aws.String(canaryArn),
+func FindGroupByName(ctx context.Context, conn *synthetics.Client, name string) (*awstypes.Group, error) {
+	input := &synthetics.GetGroupInput{
+		GroupIdentifier: aws.String(name),
 	}
-	out, err := conn.ListAssociatedGroups(ctx, input)
+	output, err := conn.GetGroup(ctx, input)
 
 	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return nil, &retry.NotFoundError{
@@ -85,20 +87,66 @@ func FindAssociatedGroup(ctx context.Context, conn *synthetics.Client, canaryArn
 		return nil, err
 	}
 
-	if out == nil || out.Groups == nil || len(out.Groups) == 0 {
+	if output == nil || output.Group == nil {
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	var group awstypes.GroupSummary
-	for _, groupSummary := range out.Groups {
-		if aws.ToString(groupSummary.Name) == groupName {
-			group = groupSummary
+	return output.Group, nil
+}
+
+func FindAssociatedGroup(ctx context.Context, conn *synthetics.Client, canaryArn string, groupName string) (*awstypes.GroupSummary, error) {
+	input := &synthetics.ListAssociatedGroupsInput{
+		ResourceArn: aws.String(canaryArn),
+	}
+
+	pages := synthetics.NewListAssociatedGroupsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, groupSummary := range page.Groups {
+			if aws.ToString(groupSummary.Name) == groupName {
+				return &groupSummary, nil
+			}
 		}
 	}
 
-	if group == (awstypes.GroupSummary{}) {
-		return nil, tfresource.NewEmptyResultError(input)
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+func findGroupResourcesByName(ctx context.Context, conn *synthetics.Client, name string) ([]string, error) {
+	input := &synthetics.ListGroupResourcesInput{
+		GroupIdentifier: aws.String(name),
+	}
+	var output []string
+
+	pages := synthetics.NewListGroupResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Resources...)
 	}
 
-	return &group, nil
+	return output, nil
 }
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synthetics
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/synthetics"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/synthetics/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_synthetics_group_associations", name="Group Associations")
+func resourceGroupAssociations() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupAssociationsCreate,
+		ReadWithoutTimeout:   resourceGroupAssociationsRead,
+		UpdateWithoutTimeout: resourceGroupAssociationsUpdate,
+		DeleteWithoutTimeout: resourceGroupAssociationsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"canary_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"group_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrGroupName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGroupAssociationsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SyntheticsClient(ctx)
+
+	groupName := d.Get(names.AttrGroupName).(string)
+
+	if err := associateGroupResources(ctx, conn, groupName, flex.ExpandStringValueSet(d.Get("canary_arns").(*schema.Set))); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(groupName)
+
+	return append(diags, resourceGroupAssociationsRead(ctx, d, meta)...)
+}
+
+func resourceGroupAssociationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SyntheticsClient(ctx)
+
+	group, err := FindGroupByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Synthetics Group (%s) not found, removing Group Associations from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Synthetics Group (%s): %s", d.Id(), err)
+	}
+
+	resources, err := findGroupResourcesByName(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Synthetics Group (%s) resources: %s", d.Id(), err)
+	}
+
+	d.Set("canary_arns", resources)
+	d.Set("group_arn", group.Arn)
+	d.Set("group_id", group.Id)
+	d.Set(names.AttrGroupName, group.Name)
+
+	return diags
+}
+
+func resourceGroupAssociationsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SyntheticsClient(ctx)
+
+	o, n := d.GetChange("canary_arns")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+
+	if err := disassociateGroupResources(ctx, conn, d.Id(), flex.ExpandStringValueSet(os.Difference(ns))); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := associateGroupResources(ctx, conn, d.Id(), flex.ExpandStringValueSet(ns.Difference(os))); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return append(diags, resourceGroupAssociationsRead(ctx, d, meta)...)
+}
+
+func resourceGroupAssociationsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SyntheticsClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Synthetics Group Associations: %s", d.Id())
+	if err := disassociateGroupResources(ctx, conn, d.Id(), flex.ExpandStringValueSet(d.Get("canary_arns").(*schema.Set))); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func associateGroupResources(ctx context.Context, conn *synthetics.Client, groupName string, canaryARNs []string) error {
+	for _, canaryARN := range canaryARNs {
+		_, err := conn.AssociateResource(ctx, &synthetics.AssociateResourceInput{
+			GroupIdentifier: aws.String(groupName),
+			ResourceArn:     aws.String(canaryARN),
+		})
+
+		if err != nil {
+			return fmt.Errorf("associating canary (%s) with Synthetics Group (%s): %w", canaryARN, groupName, err)
+		}
+	}
+
+	return nil
+}
+
+func disassociateGroupResources(ctx context.Context, conn *synthetics.Client, groupName string, canaryARNs []string) error {
+	for _, canaryARN := range canaryARNs {
+		_, err := conn.DisassociateResource(ctx, &synthetics.DisassociateResourceInput{
+			GroupIdentifier: aws.String(groupName),
+			ResourceArn:     aws.String(canaryARN),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if errs.IsAErrorMessageContains[*awstypes.ValidationException](err, "does not exist in group") {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("disassociating canary (%s) from Synthetics Group (%s): %w", canaryARN, groupName, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synthetics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsynthetics "github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSyntheticsGroupAssociations_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	resourceName := "aws_synthetics_group_associations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SyntheticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupAssociationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupAssociationsConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupAssociationsExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "canary_arns.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "canary_arns.*", "aws_synthetics_canary.test.0", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "canary_arns.*", "aws_synthetics_canary.test.1", names.AttrARN),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "group_arn", "synthetics", regexache.MustCompile(`group:.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "group_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrGroupName, rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGroupAssociationsConfig_basic(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupAssociationsExists(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "canary_arns.#", "3"),
+				),
+			},
+			{
+				Config: testAccGroupAssociationsConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupAssociationsExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "canary_arns.#", acctest.CtOne),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSyntheticsGroupAssociations_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	resourceName := "aws_synthetics_group_associations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SyntheticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupAssociationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupAssociationsConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupAssociationsExists(ctx, resourceName, 2),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsynthetics.ResourceGroupAssociations(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGroupAssociationsExists(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SyntheticsClient(ctx)
+
+		output, err := tfsynthetics.FindGroupResourcesByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("Synthetics Group (%s) has %d associated canaries, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGroupAssociationsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SyntheticsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_synthetics_group_associations" {
+				continue
+			}
+
+			output, err := tfsynthetics.FindGroupResourcesByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return fmt.Errorf("Synthetics Group (%s) still has associated canaries", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccGroupAssociationsConfig_basic(rName string, count int) string {
+	return acctest.ConfigCompose(testAccCanaryConfig_base(rName), testAccGroupConfig_basic(rName), fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  count = %[2]d
+
+  name                 = "${substr(%[1]q, 12, 8)}-${count.index}"
+  artifact_s3_location = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/lambdatest.zip"
+  runtime_version      = "syn-nodejs-puppeteer-6.1"
+  delete_lambda        = true
+
+  schedule {
+    expression = "rate(0 minute)"
+  }
+
+  depends_on = [aws_iam_role.test, aws_iam_role_policy.test]
+}
+
+resource "aws_synthetics_group_associations" "test" {
+  group_name  = aws_synthetics_group.test.name
+  canary_arns = aws_synthetics_canary.test[*].arn
+}
+`, rName, count))
+}
@@ -49,6 +49,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_synthetics_group_association",
 			Name:     "Group Association",
 		},
+		{
+			Factory:  resourceGroupAssociations,
+			TypeName: "aws_synthetics_group_associations",
+			Name:     "Group Associations",
+		},
 	}
 }
 
@@ -6,7 +6,9 @@ package synthetics
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/synthetics"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/synthetics/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -26,3 +28,24 @@ func statusCanaryState(ctx context.Context, conn *synthetics.Client, name string
 		return output, string(output.Status.State), nil
 	}
 }
+
+func statusCanaryDryRun(ctx context.Context, conn *synthetics.Client, name, dryRunID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findCanaryDryRunByTwoPartKey(ctx, conn, name, dryRunID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.ToString(output.DryRunConfig.LastDryRunExecutionStatus)
+		if status == "" {
+			status = string(awstypes.CanaryRunStateRunning)
+		}
+
+		return output, status, nil
+	}
+}
@@ -21,6 +21,7 @@ const (
 	canaryRunningTimeout = 5 * time.Minute
 	canaryStoppedTimeout = 5 * time.Minute
 	canaryDeletedTimeout = 5 * time.Minute
+	canaryDryRunTimeout  = 20 * time.Minute
 )
 
 func waitCanaryReady(ctx context.Context, conn *synthetics.Client, name string) (*awstypes.Canary, error) { //nolint:unparam
@@ -114,3 +115,20 @@ func waitCanaryDeleted(ctx context.Context, conn *synthetics.Client, name string
 
 	return nil, err
 }
+
+func waitCanaryDryRunCompleted(ctx context.Context, conn *synthetics.Client, name, dryRunID string) (*awstypes.Canary, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.CanaryRunStateRunning),
+		Target:  enum.Slice(awstypes.CanaryRunStatePassed, awstypes.CanaryRunStateFailed),
+		Refresh: statusCanaryDryRun(ctx, conn, name, dryRunID),
+		Timeout: canaryDryRunTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Canary); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreaminfluxdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="DB Parameter Group")
+// @Tags(identifierAttribute="arn")
+func newDBParameterGroupResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &dbParameterGroupResource{}, nil
+}
+
+type dbParameterGroupResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[dbParameterGroupResourceModel]
+	framework.WithImportByID
+}
+
+func (r *dbParameterGroupResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_timestreaminfluxdb_db_parameter_group"
+}
+
+func (r *dbParameterGroupResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 64),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrParameters: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[parametersModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"influxdbv2": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[influxDBv2ParametersModel](ctx),
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"flux_log_enabled": schema.BoolAttribute{
+										Optional: true,
+									},
+									"log_level": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.LogLevel](),
+										Optional:   true,
+									},
+									"metrics_disabled": schema.BoolAttribute{
+										Optional: true,
+									},
+									"no_tasks": schema.BoolAttribute{
+										Optional: true,
+									},
+									"query_concurrency": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
+									},
+									"query_queue_size": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
+									},
+									"tracing_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.TracingType](),
+										Optional:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *dbParameterGroupResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data dbParameterGroupResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TimestreamInfluxDBClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &timestreaminfluxdb.CreateDbParameterGroupInput{
+		Description: fwflex.StringFromFramework(ctx, data.Description),
+		Name:        aws.String(name),
+		Tags:        getTagsIn(ctx),
+	}
+
+	parameters, diags := expandParameters(ctx, data.Parameters)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.Parameters = parameters
+
+	output, err := conn.CreateDbParameterGroup(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Timestream for InfluxDB DB Parameter Group (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.ID = fwflex.StringToFramework(ctx, output.Id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *dbParameterGroupResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data dbParameterGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TimestreamInfluxDBClient(ctx)
+
+	output, err := findDBParameterGroupByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Timestream for InfluxDB DB Parameter Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.Description = fwflex.StringToFramework(ctx, output.Description)
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+
+	parameters, diags := flattenParameters(ctx, output.Parameters)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.Parameters = parameters
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *dbParameterGroupResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data dbParameterGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The Timestream for InfluxDB API has no operation to delete a DB parameter group.
+	response.Diagnostics.AddWarning(
+		fmt.Sprintf("Timestream for InfluxDB DB Parameter Group (%s) not deleted", data.ID.ValueString()),
+		"Timestream for InfluxDB DB parameter groups can't be deleted. The parameter group has been removed from Terraform state only.",
+	)
+}
+
+func (r *dbParameterGroupResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findDBParameterGroupByID(ctx context.Context, conn *timestreaminfluxdb.Client, id string) (*timestreaminfluxdb.GetDbParameterGroupOutput, error) {
+	input := &timestreaminfluxdb.GetDbParameterGroupInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetDbParameterGroup(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandParameters(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[parametersModel]) (awstypes.Parameters, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfObject, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || tfObject == nil {
+		return nil, diags
+	}
+
+	influxDBv2, d := tfObject.InfluxDBv2.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || influxDBv2 == nil {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.ParametersMemberInfluxDBv2{}
+	diags.Append(fwflex.Expand(ctx, influxDBv2, &apiObject.Value)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return apiObject, diags
+}
+
+func flattenParameters(ctx context.Context, apiObject awstypes.Parameters) (fwtypes.ListNestedObjectValueOf[parametersModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v, ok := apiObject.(*awstypes.ParametersMemberInfluxDBv2)
+	if !ok {
+		return fwtypes.NewListNestedObjectValueOfNull[parametersModel](ctx), diags
+	}
+
+	var influxDBv2 influxDBv2ParametersModel
+	diags.Append(fwflex.Flatten(ctx, v.Value, &influxDBv2)...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[parametersModel](ctx), diags
+	}
+
+	tfObject := &parametersModel{
+		InfluxDBv2: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &influxDBv2),
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, tfObject), diags
+}
+
+type dbParameterGroupResourceModel struct {
+	ARN         types.String                                     `tfsdk:"arn"`
+	Description types.String                                     `tfsdk:"description"`
+	ID          types.String                                     `tfsdk:"id"`
+	Name        types.String                                     `tfsdk:"name"`
+	Parameters  fwtypes.ListNestedObjectValueOf[parametersModel] `tfsdk:"parameters"`
+	Tags        types.Map                                        `tfsdk:"tags"`
+	TagsAll     types.Map                                        `tfsdk:"tags_all"`
+}
+
+type parametersModel struct {
+	InfluxDBv2 fwtypes.ListNestedObjectValueOf[influxDBv2ParametersModel] `tfsdk:"influxdbv2"`
+}
+
+type influxDBv2ParametersModel struct {
+	FluxLogEnabled   types.Bool                               `tfsdk:"flux_log_enabled"`
+	LogLevel         fwtypes.StringEnum[awstypes.LogLevel]    `tfsdk:"log_level"`
+	MetricsDisabled  types.Bool                               `tfsdk:"metrics_disabled"`
+	NoTasks          types.Bool                               `tfsdk:"no_tasks"`
+	QueryConcurrency types.Int64                              `tfsdk:"query_concurrency"`
+	QueryQueueSize   types.Int64                              `tfsdk:"query_queue_size"`
+	TracingType      fwtypes.StringEnum[awstypes.TracingType] `tfsdk:"tracing_type"`
+}
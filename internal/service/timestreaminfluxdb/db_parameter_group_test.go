@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreaminfluxdb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftimestreaminfluxdb "github.com/hashicorp/terraform-provider-aws/internal/service/timestreaminfluxdb"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// DB parameter groups can't be deleted, so these tests leave them behind and don't check for destruction.
+
+func TestAccTimestreamInfluxDBDBParameterGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var parameterGroup timestreaminfluxdb.GetDbParameterGroupOutput
+	rName := fmt.Sprintf("%s-%s", acctest.ResourcePrefix, sdkacctest.RandString(10))
+	resourceName := "aws_timestreaminfluxdb_db_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TimestreamInfluxDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBParameterGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBParameterGroupExists(ctx, resourceName, &parameterGroup),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "timestream-influxdb", regexache.MustCompile(`db-parameter-group/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.influxdbv2.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.influxdbv2.0.flux_log_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.influxdbv2.0.log_level", "debug"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.influxdbv2.0.metrics_disabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.influxdbv2.0.no_tasks", "false"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.influxdbv2.0.query_concurrency", "10"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.influxdbv2.0.query_queue_size", "20"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.influxdbv2.0.tracing_type", "log"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTimestreamInfluxDBDBParameterGroup_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var parameterGroup timestreaminfluxdb.GetDbParameterGroupOutput
+	rName := fmt.Sprintf("%s-%s", acctest.ResourcePrefix, sdkacctest.RandString(10))
+	resourceName := "aws_timestreaminfluxdb_db_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TimestreamInfluxDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBParameterGroupConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBParameterGroupExists(ctx, resourceName, &parameterGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDBParameterGroupConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBParameterGroupExists(ctx, resourceName, &parameterGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDBParameterGroupConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBParameterGroupExists(ctx, resourceName, &parameterGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDBParameterGroupExists(ctx context.Context, n string, v *timestreaminfluxdb.GetDbParameterGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TimestreamInfluxDBClient(ctx)
+
+		output, err := tftimestreaminfluxdb.FindDBParameterGroupByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDBParameterGroupConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_timestreaminfluxdb_db_parameter_group" "test" {
+  name        = %[1]q
+  description = "test"
+
+  parameters {
+    influxdbv2 {
+      flux_log_enabled  = true
+      log_level         = "debug"
+      metrics_disabled  = false
+      no_tasks          = false
+      query_concurrency = 10
+      query_queue_size  = 20
+      tracing_type      = "log"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccDBParameterGroupConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_timestreaminfluxdb_db_parameter_group" "test" {
+  name = %[1]q
+
+  parameters {
+    influxdbv2 {
+      log_level = "info"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccDBParameterGroupConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_timestreaminfluxdb_db_parameter_group" "test" {
+  name = %[1]q
+
+  parameters {
+    influxdbv2 {
+      log_level = "info"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
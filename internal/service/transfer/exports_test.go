@@ -5,6 +5,7 @@ package transfer
 
 // Exports for use in tests only.
 var (
-	ResourceServer = resourceServer
-	ResourceTag    = resourceTag
+	ResourceHostKey = resourceHostKey
+	ResourceServer  = resourceServer
+	ResourceTag     = resourceTag
 )
@@ -115,6 +115,32 @@ func FindConnectorByID(ctx context.Context, conn *transfer.Transfer, id string)
 	return output.Connector, nil
 }
 
+func FindHostKeyByTwoPartKey(ctx context.Context, conn *transfer.Transfer, serverID, hostKeyID string) (*transfer.DescribedHostKey, error) {
+	input := &transfer.DescribeHostKeyInput{
+		HostKeyId: aws.String(hostKeyID),
+		ServerId:  aws.String(serverID),
+	}
+
+	output, err := conn.DescribeHostKeyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, transfer.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.HostKey == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.HostKey, nil
+}
+
 func FindProfileByID(ctx context.Context, conn *transfer.Transfer, id string) (*transfer.DescribedProfile, error) {
 	input := &transfer.DescribeProfileInput{
 		ProfileId: aws.String(id),
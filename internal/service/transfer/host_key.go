@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_transfer_host_key", name="Host Key")
+// @Tags(identifierAttribute="arn")
+func resourceHostKey() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceHostKeyCreate,
+		ReadWithoutTimeout:   resourceHostKeyRead,
+		UpdateWithoutTimeout: resourceHostKeyUpdate,
+		DeleteWithoutTimeout: resourceHostKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_imported": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"host_key_body": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(0, 4096),
+			},
+			"host_key_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"host_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"server_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validServerID,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceHostKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferConn(ctx)
+
+	serverID := d.Get("server_id").(string)
+	input := &transfer.ImportHostKeyInput{
+		HostKeyBody: aws.String(d.Get("host_key_body").(string)),
+		ServerId:    aws.String(serverID),
+		Tags:        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.ImportHostKeyWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "importing Transfer Host Key (%s): %s", serverID, err)
+	}
+
+	d.SetId(HostKeyCreateResourceID(serverID, aws.StringValue(output.HostKeyId)))
+
+	return append(diags, resourceHostKeyRead(ctx, d, meta)...)
+}
+
+func resourceHostKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferConn(ctx)
+
+	serverID, hostKeyID, err := HostKeyParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindHostKeyByTwoPartKey(ctx, conn, serverID, hostKeyID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Transfer Host Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Transfer Host Key (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	d.Set("date_imported", aws.TimeValue(output.DateImported).Format(time.RFC3339))
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("host_key_fingerprint", output.HostKeyFingerprint)
+	d.Set("host_key_id", output.HostKeyId)
+	d.Set("server_id", serverID)
+	d.Set(names.AttrType, output.Type)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceHostKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferConn(ctx)
+
+	if d.HasChange(names.AttrDescription) {
+		serverID, hostKeyID, err := HostKeyParseResourceID(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &transfer.UpdateHostKeyInput{
+			Description: aws.String(d.Get(names.AttrDescription).(string)),
+			HostKeyId:   aws.String(hostKeyID),
+			ServerId:    aws.String(serverID),
+		}
+
+		_, err = conn.UpdateHostKeyWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Transfer Host Key (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceHostKeyRead(ctx, d, meta)...)
+}
+
+func resourceHostKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferConn(ctx)
+
+	serverID, hostKeyID, err := HostKeyParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Transfer Host Key: %s", d.Id())
+	_, err = conn.DeleteHostKeyWithContext(ctx, &transfer.DeleteHostKeyInput{
+		HostKeyId: aws.String(hostKeyID),
+		ServerId:  aws.String(serverID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, transfer.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Transfer Host Key (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
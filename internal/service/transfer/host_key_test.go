@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftransfer "github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccHostKey_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf transfer.DescribedHostKey
+	resourceName := "aws_transfer_host_key.test"
+	hostKey := "test-fixtures/transfer-ssh-rsa-key"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHostKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostKeyConfig_basic(hostKey, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckHostKeyExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "date_imported"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+					resource.TestCheckResourceAttr(resourceName, "host_key_fingerprint", "SHA256:Z2pW9sPKDD/T34tVfCoolsRcECNTlekgaKvDn9t+9sg="),
+					resource.TestCheckResourceAttrSet(resourceName, "host_key_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "server_id", "aws_transfer_server.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "ssh-rsa"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"host_key_body"},
+			},
+			{
+				Config: testAccHostKeyConfig_basic(hostKey, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckHostKeyExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHostKey_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf transfer.DescribedHostKey
+	resourceName := "aws_transfer_host_key.test"
+	hostKey := "test-fixtures/transfer-ssh-rsa-key"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHostKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostKeyConfig_basic(hostKey, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostKeyExists(ctx, resourceName, &conf),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tftransfer.ResourceHostKey(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccHostKey_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf transfer.DescribedHostKey
+	resourceName := "aws_transfer_host_key.test"
+	hostKey := "test-fixtures/transfer-ssh-rsa-key"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHostKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostKeyConfig_tags1(hostKey, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckHostKeyExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"host_key_body"},
+			},
+			{
+				Config: testAccHostKeyConfig_tags2(hostKey, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckHostKeyExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccHostKeyConfig_tags1(hostKey, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckHostKeyExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckHostKeyExists(ctx context.Context, n string, v *transfer.DescribedHostKey) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Transfer Host Key ID is set")
+		}
+
+		serverID, hostKeyID, err := tftransfer.HostKeyParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn(ctx)
+
+		output, err := tftransfer.FindHostKeyByTwoPartKey(ctx, conn, serverID, hostKeyID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckHostKeyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_transfer_host_key" {
+				continue
+			}
+
+			serverID, hostKeyID, err := tftransfer.HostKeyParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tftransfer.FindHostKeyByTwoPartKey(ctx, conn, serverID, hostKeyID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Transfer Host Key %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testAccHostKeyConfig_base = `
+resource "aws_transfer_server" "test" {
+  identity_provider_type = "SERVICE_MANAGED"
+}
+`
+
+func testAccHostKeyConfig_basic(hostKey, description string) string {
+	return acctest.ConfigCompose(testAccHostKeyConfig_base, fmt.Sprintf(`
+resource "aws_transfer_host_key" "test" {
+  server_id     = aws_transfer_server.test.id
+  host_key_body = file(%[1]q)
+  description   = %[2]q
+}
+`, hostKey, description))
+}
+
+func testAccHostKeyConfig_tags1(hostKey, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccHostKeyConfig_base, fmt.Sprintf(`
+resource "aws_transfer_host_key" "test" {
+  server_id     = aws_transfer_server.test.id
+  host_key_body = file(%[1]q)
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, hostKey, tagKey1, tagValue1))
+}
+
+func testAccHostKeyConfig_tags2(hostKey, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccHostKeyConfig_base, fmt.Sprintf(`
+resource "aws_transfer_host_key" "test" {
+  server_id     = aws_transfer_server.test.id
+  host_key_body = file(%[1]q)
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, hostKey, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -64,3 +64,22 @@ func AgreementParseResourceID(id string) (string, string, error) {
 
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected SERVERID%[2]sAGREEMENTID", id, agreementResourceIDSeparator)
 }
+
+const hostKeyResourceIDSeparator = "/"
+
+func HostKeyCreateResourceID(serverID, hostKeyID string) string {
+	parts := []string{serverID, hostKeyID}
+	id := strings.Join(parts, hostKeyResourceIDSeparator)
+
+	return id
+}
+
+func HostKeyParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, hostKeyResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected SERVERID%[2]sHOSTKEYID", id, hostKeyResourceIDSeparator)
+}
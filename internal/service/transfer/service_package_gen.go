@@ -64,6 +64,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceHostKey,
+			TypeName: "aws_transfer_host_key",
+			Name:     "Host Key",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceProfile,
 			TypeName: "aws_transfer_profile",
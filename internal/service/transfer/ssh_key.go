@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/transfer"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/crypto/ssh"
 )
 
 // @SDKResource("aws_transfer_ssh_key")
@@ -40,6 +42,16 @@ func ResourceSSHKey() *schema.Resource {
 				},
 			},
 
+			"date_imported": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"server_id": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -104,10 +116,11 @@ func resourceSSHKeyRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return sdkdiag.AppendErrorf(diags, "reading Transfer SSH Key (%s): %s", d.Id(), err)
 	}
 
-	var body string
+	var body, dateImported string
 	for _, s := range resp.User.SshPublicKeys {
 		if sshKeyID == aws.StringValue(s.SshPublicKeyId) {
 			body = aws.StringValue(s.SshPublicKeyBody)
+			dateImported = aws.TimeValue(s.DateImported).Format(time.RFC3339)
 		}
 	}
 
@@ -119,6 +132,8 @@ func resourceSSHKeyRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("server_id", resp.ServerId)
 	d.Set(names.AttrUserName, resp.User.UserName)
 	d.Set("body", body)
+	d.Set("date_imported", dateImported)
+	d.Set("fingerprint", sshKeyFingerprint(body))
 
 	return diags
 }
@@ -155,6 +170,18 @@ func DecodeSSHKeyID(id string) (string, string, string, error) {
 	}
 	return idParts[0], idParts[1], idParts[2], nil
 }
+
+// sshKeyFingerprint returns the SHA256 fingerprint of an authorized_keys
+// formatted public key, or an empty string if the key cannot be parsed.
+func sshKeyFingerprint(body string) string {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(body))
+	if err != nil {
+		return ""
+	}
+
+	return ssh.FingerprintSHA256(key)
+}
+
 func cleanSSHKey(key string) string {
 	// Remove comments from SSH Keys
 	// Comments are anything after "ssh-rsa XXXX" where XXXX is the key.
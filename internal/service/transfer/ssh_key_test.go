@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -45,6 +46,8 @@ func testAccSSHKey_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "server_id", "aws_transfer_server.test", names.AttrID),
 					resource.TestCheckResourceAttrPair(resourceName, names.AttrUserName, "aws_transfer_user.test", names.AttrUserName),
 					resource.TestCheckResourceAttr(resourceName, "body", publicKey),
+					resource.TestCheckResourceAttrSet(resourceName, "date_imported"),
+					resource.TestMatchResourceAttr(resourceName, "fingerprint", regexache.MustCompile(`^SHA256:`)),
 				),
 			},
 			{
@@ -25,6 +25,11 @@ func TestAccTransfer_serial(t *testing.T) {
 			"disappears":   testAccAgreement_disappears,
 			names.AttrTags: testAccAgreement_tags,
 		},
+		"HostKey": {
+			"basic":        testAccHostKey_basic,
+			"disappears":   testAccHostKey_disappears,
+			names.AttrTags: testAccHostKey_tags,
+		},
 		"Server": {
 			"basic":                           testAccServer_basic,
 			"disappears":                      testAccServer_disappears,
@@ -0,0 +1,519 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package translate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/translate"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/translate/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Parallel Data")
+// @Tags(identifierAttribute="arn")
+func newParallelDataResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &parallelDataResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type parallelDataResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*parallelDataResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_translate_parallel_data"
+}
+
+func (r *parallelDataResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+				},
+			},
+			"failed_record_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"imported_data_size": schema.Int64Attribute{
+				Computed: true,
+			},
+			"imported_record_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"last_updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"latest_update_attempt_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"latest_update_attempt_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ParallelDataStatus](),
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9A-Za-z-]_?)+$`), "must contain only alphanumeric characters, hyphens and single underscores"),
+				},
+			},
+			"skipped_record_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"source_language_code": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ParallelDataStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"target_language_codes": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"encryption_key": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionKeyModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrID: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						names.AttrType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.EncryptionKeyType](),
+							Required:   true,
+						},
+					},
+				},
+			},
+			"parallel_data_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[parallelDataConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrFormat: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ParallelDataFormat](),
+							Required:   true,
+						},
+						"s3_uri": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexache.MustCompile(`^s3://`), "must be an S3 URI"),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *parallelDataResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data parallelDataResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TranslateClient(ctx)
+
+	input := &translate.CreateParallelDataInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	name := data.Name.ValueString()
+	_, err := conn.CreateParallelData(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Translate Parallel Data (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	output, err := waitParallelDataCreated(ctx, conn, name, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Translate Parallel Data (%s) create", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *parallelDataResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data parallelDataResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().TranslateClient(ctx)
+
+	output, err := findParallelDataByName(ctx, conn, data.Name.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Translate Parallel Data (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *parallelDataResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new parallelDataResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TranslateClient(ctx)
+
+	name := new.Name.ValueString()
+
+	// Each successful update imports the parallel data file again and creates a new version.
+	if !new.Description.Equal(old.Description) ||
+		!new.ParallelDataConfig.Equal(old.ParallelDataConfig) {
+		input := &translate.UpdateParallelDataInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ClientToken = aws.String(id.UniqueId())
+
+		_, err := conn.UpdateParallelData(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Translate Parallel Data (%s)", name), err.Error())
+
+			return
+		}
+
+		if _, err := waitParallelDataUpdated(ctx, conn, name, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Translate Parallel Data (%s) update", name), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findParallelDataByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Translate Parallel Data (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *parallelDataResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data parallelDataResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TranslateClient(ctx)
+
+	name := data.Name.ValueString()
+	_, err := conn.DeleteParallelData(ctx, &translate.DeleteParallelDataInput{
+		Name: aws.String(name),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Translate Parallel Data (%s)", name), err.Error())
+
+		return
+	}
+
+	if _, err := waitParallelDataDeleted(ctx, conn, name, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Translate Parallel Data (%s) delete", name), err.Error())
+
+		return
+	}
+}
+
+func (r *parallelDataResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findParallelDataByName(ctx context.Context, conn *translate.Client, name string) (*awstypes.ParallelDataProperties, error) {
+	input := &translate.GetParallelDataInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetParallelData(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ParallelDataProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ParallelDataProperties, nil
+}
+
+func statusParallelData(ctx context.Context, conn *translate.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findParallelDataByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+// statusParallelDataLatestUpdateAttempt tracks an update, during which the
+// parallel data itself stays ACTIVE on its previous version.
+func statusParallelDataLatestUpdateAttempt(ctx context.Context, conn *translate.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findParallelDataByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.LatestUpdateAttemptStatus), nil
+	}
+}
+
+func waitParallelDataCreated(ctx context.Context, conn *translate.Client, name string, timeout time.Duration) (*awstypes.ParallelDataProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ParallelDataStatusCreating),
+		Target:  enum.Slice(awstypes.ParallelDataStatusActive),
+		Refresh: statusParallelData(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ParallelDataProperties); ok {
+		if output.Status == awstypes.ParallelDataStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitParallelDataUpdated(ctx context.Context, conn *translate.Client, name string, timeout time.Duration) (*awstypes.ParallelDataProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ParallelDataStatusUpdating),
+		Target:  enum.Slice(awstypes.ParallelDataStatusActive),
+		Refresh: statusParallelDataLatestUpdateAttempt(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ParallelDataProperties); ok {
+		if output.LatestUpdateAttemptStatus == awstypes.ParallelDataStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitParallelDataDeleted(ctx context.Context, conn *translate.Client, name string, timeout time.Duration) (*awstypes.ParallelDataProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ParallelDataStatusActive, awstypes.ParallelDataStatusDeleting),
+		Target:  []string{},
+		Refresh: statusParallelData(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ParallelDataProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type parallelDataResourceModel struct {
+	ARN                       types.String                                             `tfsdk:"arn"`
+	CreatedAt                 timetypes.RFC3339                                        `tfsdk:"created_at"`
+	Description               types.String                                             `tfsdk:"description"`
+	EncryptionKey             fwtypes.ListNestedObjectValueOf[encryptionKeyModel]      `tfsdk:"encryption_key"`
+	FailedRecordCount         types.Int64                                              `tfsdk:"failed_record_count"`
+	ID                        types.String                                             `tfsdk:"id"`
+	ImportedDataSize          types.Int64                                              `tfsdk:"imported_data_size"`
+	ImportedRecordCount       types.Int64                                              `tfsdk:"imported_record_count"`
+	LastUpdatedAt             timetypes.RFC3339                                        `tfsdk:"last_updated_at"`
+	LatestUpdateAttemptAt     timetypes.RFC3339                                        `tfsdk:"latest_update_attempt_at"`
+	LatestUpdateAttemptStatus fwtypes.StringEnum[awstypes.ParallelDataStatus]          `tfsdk:"latest_update_attempt_status"`
+	Name                      types.String                                             `tfsdk:"name"`
+	ParallelDataConfig        fwtypes.ListNestedObjectValueOf[parallelDataConfigModel] `tfsdk:"parallel_data_config"`
+	SkippedRecordCount        types.Int64                                              `tfsdk:"skipped_record_count"`
+	SourceLanguageCode        types.String                                             `tfsdk:"source_language_code"`
+	Status                    fwtypes.StringEnum[awstypes.ParallelDataStatus]          `tfsdk:"status"`
+	Tags                      types.Map                                                `tfsdk:"tags"`
+	TagsAll                   types.Map                                                `tfsdk:"tags_all"`
+	TargetLanguageCodes       fwtypes.ListValueOf[types.String]                        `tfsdk:"target_language_codes"`
+	Timeouts                  timeouts.Value                                           `tfsdk:"timeouts"`
+}
+
+func (data *parallelDataResourceModel) InitFromID() error {
+	data.Name = data.ID
+
+	return nil
+}
+
+func (data *parallelDataResourceModel) setID() {
+	data.ID = data.Name
+}
+
+type encryptionKeyModel struct {
+	ID   fwtypes.ARN                                    `tfsdk:"id"`
+	Type fwtypes.StringEnum[awstypes.EncryptionKeyType] `tfsdk:"type"`
+}
+
+type parallelDataConfigModel struct {
+	Format fwtypes.StringEnum[awstypes.ParallelDataFormat] `tfsdk:"format"`
+	S3URI  types.String                                    `tfsdk:"s3_uri"`
+}
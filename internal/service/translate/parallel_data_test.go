@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package translate_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/translate"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/translate/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftranslate "github.com/hashicorp/terraform-provider-aws/internal/service/translate"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTranslateParallelData_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ParallelDataProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_translate_parallel_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranslateServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParallelDataDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "translate", regexache.MustCompile(`parallel-data/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(resourceName, "encryption_key.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "imported_record_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "parallel_data_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "parallel_data_config.0.format", string(awstypes.ParallelDataFormatTsv)),
+					resource.TestCheckResourceAttr(resourceName, "parallel_data_config.0.s3_uri", fmt.Sprintf("s3://%s/parallel-data-1.tsv", rName)),
+					resource.TestCheckResourceAttr(resourceName, "source_language_code", "en"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ParallelDataStatusActive)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_language_codes.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "target_language_codes.0", "es"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTranslateParallelData_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ParallelDataProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_translate_parallel_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranslateServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParallelDataDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tftranslate.ResourceParallelData, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTranslateParallelData_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 awstypes.ParallelDataProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_translate_parallel_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranslateServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParallelDataDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v1),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "imported_record_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parallel_data_config.0.s3_uri", fmt.Sprintf("s3://%s/parallel-data-1.tsv", rName)),
+				),
+			},
+			{
+				Config: testAccParallelDataConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v2),
+					testAccCheckParallelDataNewVersion(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "imported_record_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "latest_update_attempt_status", string(awstypes.ParallelDataStatusActive)),
+					resource.TestCheckResourceAttrSet(resourceName, "latest_update_attempt_at"),
+					resource.TestCheckResourceAttr(resourceName, "parallel_data_config.0.s3_uri", fmt.Sprintf("s3://%s/parallel-data-2.tsv", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTranslateParallelData_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ParallelDataProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_translate_parallel_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranslateServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParallelDataDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccParallelDataConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccParallelDataConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckParallelDataDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranslateClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_translate_parallel_data" {
+				continue
+			}
+
+			_, err := tftranslate.FindParallelDataByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Translate Parallel Data %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParallelDataExists(ctx context.Context, n string, v *awstypes.ParallelDataProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranslateClient(ctx)
+
+		output, err := tftranslate.FindParallelDataByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckParallelDataNewVersion(before, after *awstypes.ParallelDataProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.LastUpdatedAt == nil || after.LastUpdatedAt == nil || !after.LastUpdatedAt.After(*before.LastUpdatedAt) {
+			return fmt.Errorf("Translate Parallel Data (%s) not updated to a new version", *after.Name)
+		}
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TranslateClient(ctx)
+
+	input := &translate.ListTerminologiesInput{}
+	_, err := conn.ListTerminologies(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccParallelDataConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test1" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "parallel-data-1.tsv"
+  content = "en\tes\nHello\tHola\nGoodbye\tAdiós\n"
+}
+
+resource "aws_s3_object" "test2" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "parallel-data-2.tsv"
+  content = "en\tes\nHello\tHola\nGoodbye\tAdiós\nThank you\tGracias\n"
+}
+`, rName)
+}
+
+func testAccParallelDataConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccParallelDataConfig_base(rName), fmt.Sprintf(`
+resource "aws_translate_parallel_data" "test" {
+  name = %[1]q
+
+  parallel_data_config {
+    format = "TSV"
+    s3_uri = "s3://${aws_s3_object.test1.bucket}/${aws_s3_object.test1.key}"
+  }
+}
+`, rName))
+}
+
+func testAccParallelDataConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccParallelDataConfig_base(rName), fmt.Sprintf(`
+resource "aws_translate_parallel_data" "test" {
+  name        = %[1]q
+  description = "updated"
+
+  parallel_data_config {
+    format = "TSV"
+    s3_uri = "s3://${aws_s3_object.test2.bucket}/${aws_s3_object.test2.key}"
+  }
+}
+`, rName))
+}
+
+func testAccParallelDataConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccParallelDataConfig_base(rName), fmt.Sprintf(`
+resource "aws_translate_parallel_data" "test" {
+  name = %[1]q
+
+  parallel_data_config {
+    format = "TSV"
+    s3_uri = "s3://${aws_s3_object.test1.bucket}/${aws_s3_object.test1.key}"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccParallelDataConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccParallelDataConfig_base(rName), fmt.Sprintf(`
+resource "aws_translate_parallel_data" "test" {
+  name = %[1]q
+
+  parallel_data_config {
+    format = "TSV"
+    s3_uri = "s3://${aws_s3_object.test1.bucket}/${aws_s3_object.test1.key}"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
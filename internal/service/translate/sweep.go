@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package translate
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/translate"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_translate_parallel_data", &resource.Sweeper{
+		Name: "aws_translate_parallel_data",
+		F:    sweepParallelData,
+	})
+
+	resource.AddTestSweepers("aws_translate_terminology", &resource.Sweeper{
+		Name: "aws_translate_terminology",
+		F:    sweepTerminologies,
+	})
+}
+
+func sweepParallelData(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	input := &translate.ListParallelDataInput{}
+	conn := client.TranslateClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := translate.NewListParallelDataPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Translate Parallel Data sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Translate Parallel Data (%s): %w", region, err)
+		}
+
+		for _, v := range page.ParallelDataPropertiesList {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newParallelDataResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Name)),
+				framework.NewAttribute(names.AttrName, aws.ToString(v.Name)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Translate Parallel Data (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepTerminologies(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	input := &translate.ListTerminologiesInput{}
+	conn := client.TranslateClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := translate.NewListTerminologiesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Translate Terminology sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Translate Terminologies (%s): %w", region, err)
+		}
+
+		for _, v := range page.TerminologyPropertiesList {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newTerminologyResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Name)),
+				framework.NewAttribute(names.AttrName, aws.ToString(v.Name)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Translate Terminologies (%s): %w", region, err)
+	}
+
+	return nil
+}
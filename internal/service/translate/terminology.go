@@ -0,0 +1,435 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package translate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/translate"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/translate/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Terminology")
+// @Tags(identifierAttribute="arn")
+func newTerminologyResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &terminologyResource{}, nil
+}
+
+type terminologyResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*terminologyResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_translate_terminology"
+}
+
+func (r *terminologyResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"last_updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9A-Za-z-]_?)+$`), "must contain only alphanumeric characters, hyphens and single underscores"),
+				},
+			},
+			"size_bytes": schema.Int64Attribute{
+				Computed: true,
+			},
+			"skipped_term_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"source_language_code": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"target_language_codes": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"term_count": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"encryption_key": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionKeyModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrID: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						names.AttrType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.EncryptionKeyType](),
+							Required:   true,
+						},
+					},
+				},
+			},
+			"terminology_data": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[terminologyDataModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"directionality": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.Directionality](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"file": schema.StringAttribute{
+							Required:  true,
+							Sensitive: true,
+						},
+						names.AttrFormat: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.TerminologyDataFormat](),
+							Required:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *terminologyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data terminologyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TranslateClient(ctx)
+
+	input, diags := expandImportTerminologyInput(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	name := data.Name.ValueString()
+	output, err := conn.ImportTerminology(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Translate Terminology (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	response.Diagnostics.Append(flattenTerminologyProperties(ctx, output.TerminologyProperties, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *terminologyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data terminologyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().TranslateClient(ctx)
+
+	output, err := findTerminologyByName(ctx, conn, data.Name.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Translate Terminology (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenTerminologyProperties(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *terminologyResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new terminologyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TranslateClient(ctx)
+
+	name := new.Name.ValueString()
+
+	var properties *awstypes.TerminologyProperties
+
+	// Importing again overwrites the terminology with the latest version of its data.
+	if !new.Description.Equal(old.Description) ||
+		!new.TerminologyData.Equal(old.TerminologyData) {
+		input, diags := expandImportTerminologyInput(ctx, &new)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.ImportTerminology(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Translate Terminology (%s)", name), err.Error())
+
+			return
+		}
+
+		properties = output.TerminologyProperties
+	} else {
+		output, err := findTerminologyByName(ctx, conn, name)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Translate Terminology (%s)", name), err.Error())
+
+			return
+		}
+
+		properties = output
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(flattenTerminologyProperties(ctx, properties, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *terminologyResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data terminologyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TranslateClient(ctx)
+
+	name := data.Name.ValueString()
+	_, err := conn.DeleteTerminology(ctx, &translate.DeleteTerminologyInput{
+		Name: aws.String(name),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Translate Terminology (%s)", name), err.Error())
+
+		return
+	}
+}
+
+func (r *terminologyResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findTerminologyByName(ctx context.Context, conn *translate.Client, name string) (*awstypes.TerminologyProperties, error) {
+	input := &translate.GetTerminologyInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetTerminology(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TerminologyProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TerminologyProperties, nil
+}
+
+func expandImportTerminologyInput(ctx context.Context, data *terminologyResourceModel) (*translate.ImportTerminologyInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	input := &translate.ImportTerminologyInput{
+		Description:   fwflex.StringFromFramework(ctx, data.Description),
+		MergeStrategy: awstypes.MergeStrategyOverwrite,
+		Name:          fwflex.StringFromFramework(ctx, data.Name),
+	}
+
+	diags.Append(fwflex.Expand(ctx, data.EncryptionKey, &input.EncryptionKey)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// The terminology file is sent as raw bytes, which AutoFlex doesn't expand.
+	terminologyData, d := data.TerminologyData.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if terminologyData != nil {
+		input.TerminologyData = &awstypes.TerminologyData{
+			Directionality: terminologyData.Directionality.ValueEnum(),
+			File:           []byte(terminologyData.File.ValueString()),
+			Format:         terminologyData.Format.ValueEnum(),
+		}
+	}
+
+	return input, diags
+}
+
+func flattenTerminologyProperties(ctx context.Context, apiObject *awstypes.TerminologyProperties, data *terminologyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// GetTerminology doesn't return the terminology file, so keep the configured one.
+	terminologyData, d := data.TerminologyData.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if terminologyData == nil {
+		terminologyData = &terminologyDataModel{
+			File: types.StringNull(),
+		}
+	}
+
+	terminologyData.Directionality = fwtypes.StringEnumValue(apiObject.Directionality)
+	terminologyData.Format = fwtypes.StringEnumValue(apiObject.Format)
+
+	data.TerminologyData = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, terminologyData)
+
+	return diags
+}
+
+type terminologyResourceModel struct {
+	ARN                 types.String                                          `tfsdk:"arn"`
+	CreatedAt           timetypes.RFC3339                                     `tfsdk:"created_at"`
+	Description         types.String                                          `tfsdk:"description"`
+	EncryptionKey       fwtypes.ListNestedObjectValueOf[encryptionKeyModel]   `tfsdk:"encryption_key"`
+	ID                  types.String                                          `tfsdk:"id"`
+	LastUpdatedAt       timetypes.RFC3339                                     `tfsdk:"last_updated_at"`
+	Name                types.String                                          `tfsdk:"name"`
+	SizeBytes           types.Int64                                           `tfsdk:"size_bytes"`
+	SkippedTermCount    types.Int64                                           `tfsdk:"skipped_term_count"`
+	SourceLanguageCode  types.String                                          `tfsdk:"source_language_code"`
+	Tags                types.Map                                             `tfsdk:"tags"`
+	TagsAll             types.Map                                             `tfsdk:"tags_all"`
+	TargetLanguageCodes fwtypes.ListValueOf[types.String]                     `tfsdk:"target_language_codes"`
+	TermCount           types.Int64                                           `tfsdk:"term_count"`
+	TerminologyData     fwtypes.ListNestedObjectValueOf[terminologyDataModel] `tfsdk:"terminology_data"`
+}
+
+func (data *terminologyResourceModel) InitFromID() error {
+	data.Name = data.ID
+
+	return nil
+}
+
+func (data *terminologyResourceModel) setID() {
+	data.ID = data.Name
+}
+
+type terminologyDataModel struct {
+	Directionality fwtypes.StringEnum[awstypes.Directionality]        `tfsdk:"directionality"`
+	File           types.String                                       `tfsdk:"file"`
+	Format         fwtypes.StringEnum[awstypes.TerminologyDataFormat] `tfsdk:"format"`
+}
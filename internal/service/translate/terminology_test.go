@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package translate_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/translate/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftranslate "github.com/hashicorp/terraform-provider-aws/internal/service/translate"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTranslateTerminology_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.TerminologyProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_translate_terminology.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranslateServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTerminologyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTerminologyConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTerminologyExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "translate", regexache.MustCompile(`terminology/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(resourceName, "encryption_key.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_at"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "size_bytes"),
+					resource.TestCheckResourceAttr(resourceName, "source_language_code", "en"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_language_codes.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "target_language_codes.0", "fr"),
+					resource.TestCheckResourceAttr(resourceName, "term_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "terminology_data.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "terminology_data.0.directionality", string(awstypes.DirectionalityUni)),
+					resource.TestCheckResourceAttr(resourceName, "terminology_data.0.format", string(awstypes.TerminologyDataFormatCsv)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminology_data.0.file"},
+			},
+		},
+	})
+}
+
+func TestAccTranslateTerminology_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.TerminologyProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_translate_terminology.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranslateServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTerminologyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTerminologyConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTerminologyExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tftranslate.ResourceTerminology, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTranslateTerminology_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 awstypes.TerminologyProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_translate_terminology.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranslateServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTerminologyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTerminologyConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTerminologyExists(ctx, resourceName, &v1),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "term_count", "1"),
+				),
+			},
+			{
+				Config: testAccTerminologyConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTerminologyExists(ctx, resourceName, &v2),
+					testAccCheckTerminologyNewVersion(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "target_language_codes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "term_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTranslateTerminology_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.TerminologyProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_translate_terminology.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TranslateServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTerminologyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTerminologyConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTerminologyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminology_data.0.file"},
+			},
+			{
+				Config: testAccTerminologyConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTerminologyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccTerminologyConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTerminologyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTerminologyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranslateClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_translate_terminology" {
+				continue
+			}
+
+			_, err := tftranslate.FindTerminologyByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Translate Terminology %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTerminologyExists(ctx context.Context, n string, v *awstypes.TerminologyProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranslateClient(ctx)
+
+		output, err := tftranslate.FindTerminologyByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckTerminologyNewVersion(before, after *awstypes.TerminologyProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.LastUpdatedAt == nil || after.LastUpdatedAt == nil || !after.LastUpdatedAt.After(*before.LastUpdatedAt) {
+			return fmt.Errorf("Translate Terminology (%s) not updated to a new version", *after.Name)
+		}
+
+		return nil
+	}
+}
+
+func testAccTerminologyConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_translate_terminology" "test" {
+  name = %[1]q
+
+  terminology_data {
+    file   = "en,fr\nAmazon,Amazon\n"
+    format = "CSV"
+  }
+}
+`, rName)
+}
+
+func testAccTerminologyConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_translate_terminology" "test" {
+  name        = %[1]q
+  description = "updated"
+
+  terminology_data {
+    file   = "en,fr,de\nAmazon,Amazon,Amazon\nTerraform,Terraform,Terraform\n"
+    format = "CSV"
+  }
+}
+`, rName)
+}
+
+func testAccTerminologyConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_translate_terminology" "test" {
+  name = %[1]q
+
+  terminology_data {
+    file   = "en,fr\nAmazon,Amazon\n"
+    format = "CSV"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccTerminologyConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_translate_terminology" "test" {
+  name = %[1]q
+
+  terminology_data {
+    file   = "en,fr\nAmazon,Amazon\n"
+    format = "CSV"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
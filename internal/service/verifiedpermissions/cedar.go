@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+
+	cedar "github.com/cedar-policy/cedar-go/x/exp/parser"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// parseCedarPolicies parses the policies in a Cedar policy document.
+func parseCedarPolicies(statement string) ([]cedar.Policy, error) {
+	tokens, err := cedar.Tokenize([]byte(statement))
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := cedar.Parse(tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// cedarPolicyScopeChanged reports whether two Cedar statements differ in effect, principal or resource.
+// Verified Permissions can't update those parts of a static policy in place.
+func cedarPolicyScopeChanged(old, new string) (bool, error) {
+	policiesOld, err := parseCedarPolicies(old)
+	if err != nil {
+		return false, err
+	}
+
+	policiesNew, err := parseCedarPolicies(new)
+	if err != nil {
+		return false, err
+	}
+
+	if len(policiesOld) == 0 || len(policiesNew) == 0 {
+		return false, nil
+	}
+
+	policyOld, policyNew := policiesOld[0], policiesNew[0]
+
+	var principal bool
+	if len(policyNew.Principal.Entity.Path) > 0 && len(policyOld.Principal.Entity.Path) > 0 {
+		principal = (policyNew.Principal.Entity.String() != policyOld.Principal.Entity.String()) || (policyNew.Principal.Type != policyOld.Principal.Type)
+	}
+
+	var resource bool
+	if len(policyNew.Resource.Entity.Path) > 0 && len(policyOld.Resource.Entity.Path) > 0 {
+		resource = (policyNew.Resource.Entity.String() != policyOld.Resource.Entity.String()) || (policyNew.Resource.Type != policyOld.Resource.Type)
+	}
+
+	effect := policyNew.Effect != policyOld.Effect
+
+	return effect || principal || resource, nil
+}
+
+// validCedarStaticPolicy validates that a string is a single Cedar policy with valid syntax.
+// Schema validation against the policy store's schema still happens in Verified Permissions.
+func validCedarStaticPolicy() validator.String {
+	return cedarStaticPolicyValidator{}
+}
+
+type cedarStaticPolicyValidator struct{}
+
+func (v cedarStaticPolicyValidator) Description(_ context.Context) string {
+	return "value must be a single Cedar policy"
+}
+
+func (v cedarStaticPolicyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cedarStaticPolicyValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	policies, err := parseCedarPolicies(request.ConfigValue.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(request.Path, "Invalid Cedar policy", fmt.Sprintf("%s: %s", v.Description(ctx), err))
+
+		return
+	}
+
+	if n := len(policies); n != 1 {
+		response.Diagnostics.AddAttributeError(request.Path, "Invalid Cedar policy", fmt.Sprintf("%s, got %d policies", v.Description(ctx), n))
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCedarStaticPolicyValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid policy": {
+			val: types.StringValue(`permit (principal, action == Action::"view", resource in Album::"test_album");`),
+		},
+		"valid policy with condition": {
+			val: types.StringValue(`forbid (principal, action, resource) unless { resource.public };`),
+		},
+		"missing semicolon": {
+			val:         types.StringValue(`permit (principal, action, resource)`),
+			expectError: true,
+		},
+		"unbalanced parentheses": {
+			val:         types.StringValue(`permit (principal, action, resource;`),
+			expectError: true,
+		},
+		"unknown effect": {
+			val:         types.StringValue(`allow (principal, action, resource);`),
+			expectError: true,
+		},
+		"multiple policies": {
+			val:         types.StringValue(`permit (principal, action, resource); forbid (principal, action, resource);`),
+			expectError: true,
+		},
+		"empty": {
+			val:         types.StringValue(""),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			validCedarStaticPolicy().ValidateString(ctx, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), test.expectError; got != want {
+				t.Errorf("unexpected error = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCedarPolicyScopeChanged(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		old, new string
+		expected bool
+	}
+	tests := map[string]testCase{
+		"action changed": {
+			old:      `permit (principal, action == Action::"view", resource in Album::"a");`,
+			new:      `permit (principal, action == Action::"edit", resource in Album::"a");`,
+			expected: false,
+		},
+		"effect changed": {
+			old:      `permit (principal, action == Action::"view", resource in Album::"a");`,
+			new:      `forbid (principal, action == Action::"view", resource in Album::"a");`,
+			expected: true,
+		},
+		"principal changed": {
+			old:      `permit (principal == User::"alice", action, resource);`,
+			new:      `permit (principal == User::"bob", action, resource);`,
+			expected: true,
+		},
+		"resource changed": {
+			old:      `permit (principal, action, resource in Album::"a");`,
+			new:      `permit (principal, action, resource in Album::"b");`,
+			expected: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := cedarPolicyScopeChanged(test.old, test.new)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != test.expected {
+				t.Errorf("cedarPolicyScopeChanged = %t, want %t", got, test.expected)
+			}
+		})
+	}
+}
@@ -5,11 +5,14 @@ package verifiedpermissions
 
 // Exports for use in tests only.
 var (
+	ResourceIdentitySource = newResourceIdentitySource
 	ResourcePolicy         = newResourcePolicy
 	ResourcePolicyStore    = newResourcePolicyStore
 	ResourcePolicyTemplate = newResourcePolicyTemplate
 	ResourceSchema         = newResourceSchema
+	ResourceStaticPolicies = newResourceStaticPolicies
 
+	FindIdentitySourceByID    = findIdentitySourceByID
 	FindPolicyByID            = findPolicyByID
 	FindPolicyStoreByID       = findPolicyStoreByID
 	FindPolicyTemplateByID    = findPolicyTemplateByID
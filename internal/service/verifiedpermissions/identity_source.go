@@ -0,0 +1,468 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	interflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Identity Source")
+func newResourceIdentitySource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceIdentitySource{}
+
+	return r, nil
+}
+
+const (
+	ResNameIdentitySource = "Identity Source"
+)
+
+const (
+	ResourceIdentitySourceIDPartsCount = 2
+)
+
+type resourceIdentitySource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceIdentitySource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_identity_source"
+}
+
+func (r *resourceIdentitySource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"identity_source_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_entity_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrConfiguration: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[identitySourceConfiguration](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"cognito_user_pool_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[cognitoUserPoolConfiguration](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"client_ids": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+										Computed:    true,
+										PlanModifiers: []planmodifier.List{
+											listplanmodifier.UseStateForUnknown(),
+										},
+									},
+									"user_pool_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"group_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[cognitoGroupConfiguration](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"group_entity_type": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	response.Schema = s
+}
+
+func (r *resourceIdentitySource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var plan resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	cognito, diags := plan.cognitoUserPoolConfiguration(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	value := awstypes.CognitoUserPoolConfiguration{
+		ClientIds:   fwflex.ExpandFrameworkStringValueList(ctx, cognito.ClientIDs),
+		UserPoolArn: fwflex.StringFromFramework(ctx, cognito.UserPoolARN),
+	}
+
+	if group, diags := cognito.GroupConfiguration.ToPtr(ctx); group != nil {
+		value.GroupConfiguration = &awstypes.CognitoGroupConfiguration{
+			GroupEntityType: fwflex.StringFromFramework(ctx, group.GroupEntityType),
+		}
+	} else {
+		response.Diagnostics.Append(diags...)
+	}
+
+	input := &verifiedpermissions.CreateIdentitySourceInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Configuration: &awstypes.ConfigurationMemberCognitoUserPoolConfiguration{
+			Value: value,
+		},
+		PolicyStoreId:       fwflex.StringFromFramework(ctx, plan.PolicyStoreID),
+		PrincipalEntityType: fwflex.StringFromFramework(ctx, plan.PrincipalEntityType),
+	}
+
+	output, err := conn.CreateIdentitySource(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNameIdentitySource, plan.PolicyStoreID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	idParts := []string{
+		aws.ToString(output.IdentitySourceId),
+		aws.ToString(output.PolicyStoreId),
+	}
+
+	rID, err := interflex.FlattenResourceId(idParts, ResourceIdentitySourceIDPartsCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNameIdentitySource, plan.PolicyStoreID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = fwflex.StringValueToFramework(ctx, rID)
+	plan.IdentitySourceID = fwflex.StringToFramework(ctx, output.IdentitySourceId)
+
+	identitySource, err := findIdentitySourceByID(ctx, conn, aws.ToString(output.IdentitySourceId), aws.ToString(output.PolicyStoreId))
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNameIdentitySource, plan.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(plan.refreshFromOutput(ctx, identitySource)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceIdentitySource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	rID, err := interflex.ExpandResourceId(state.ID.ValueString(), ResourceIdentitySourceIDPartsCount, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionSetting, ResNameIdentitySource, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	output, err := findIdentitySourceByID(ctx, conn, rID[0], rID[1])
+
+	if tfresource.NotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNameIdentitySource, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(state.refreshFromOutput(ctx, output)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourceIdentitySource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state, plan resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Configuration.Equal(state.Configuration) || !plan.PrincipalEntityType.Equal(state.PrincipalEntityType) {
+		cognito, diags := plan.cognitoUserPoolConfiguration(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		value := awstypes.UpdateCognitoUserPoolConfiguration{
+			ClientIds:   fwflex.ExpandFrameworkStringValueList(ctx, cognito.ClientIDs),
+			UserPoolArn: fwflex.StringFromFramework(ctx, cognito.UserPoolARN),
+		}
+
+		if group, diags := cognito.GroupConfiguration.ToPtr(ctx); group != nil {
+			value.GroupConfiguration = &awstypes.UpdateCognitoGroupConfiguration{
+				GroupEntityType: fwflex.StringFromFramework(ctx, group.GroupEntityType),
+			}
+		} else {
+			response.Diagnostics.Append(diags...)
+		}
+
+		input := &verifiedpermissions.UpdateIdentitySourceInput{
+			IdentitySourceId:    fwflex.StringFromFramework(ctx, state.IdentitySourceID),
+			PolicyStoreId:       fwflex.StringFromFramework(ctx, state.PolicyStoreID),
+			PrincipalEntityType: fwflex.StringFromFramework(ctx, plan.PrincipalEntityType),
+			UpdateConfiguration: &awstypes.UpdateConfigurationMemberCognitoUserPoolConfiguration{
+				Value: value,
+			},
+		}
+
+		_, err := conn.UpdateIdentitySource(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNameIdentitySource, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		output, err := findIdentitySourceByID(ctx, conn, state.IdentitySourceID.ValueString(), state.PolicyStoreID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNameIdentitySource, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		response.Diagnostics.Append(plan.refreshFromOutput(ctx, output)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceIdentitySource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state resourceIdentitySourceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting Verified Permissions Identity Source", map[string]interface{}{
+		names.AttrID: state.ID.ValueString(),
+	})
+
+	_, err := conn.DeleteIdentitySource(ctx, &verifiedpermissions.DeleteIdentitySourceInput{
+		IdentitySourceId: fwflex.StringFromFramework(ctx, state.IdentitySourceID),
+		PolicyStoreId:    fwflex.StringFromFramework(ctx, state.PolicyStoreID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionDeleting, ResNameIdentitySource, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+type resourceIdentitySourceData struct {
+	Configuration       fwtypes.ListNestedObjectValueOf[identitySourceConfiguration] `tfsdk:"configuration"`
+	ID                  types.String                                                 `tfsdk:"id"`
+	IdentitySourceID    types.String                                                 `tfsdk:"identity_source_id"`
+	PolicyStoreID       types.String                                                 `tfsdk:"policy_store_id"`
+	PrincipalEntityType types.String                                                 `tfsdk:"principal_entity_type"`
+}
+
+func (data *resourceIdentitySourceData) cognitoUserPoolConfiguration(ctx context.Context) (*cognitoUserPoolConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configuration, d := data.Configuration.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	cognito, d := configuration.CognitoUserPoolConfiguration.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return cognito, diags
+}
+
+func (data *resourceIdentitySourceData) refreshFromOutput(ctx context.Context, output *verifiedpermissions.GetIdentitySourceOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.IdentitySourceID = fwflex.StringToFramework(ctx, output.IdentitySourceId)
+	data.PolicyStoreID = fwflex.StringToFramework(ctx, output.PolicyStoreId)
+	data.PrincipalEntityType = fwflex.StringToFramework(ctx, output.PrincipalEntityType)
+
+	v, ok := output.Configuration.(*awstypes.ConfigurationDetailMemberCognitoUserPoolConfiguration)
+	if !ok || v == nil {
+		return diags
+	}
+
+	cognito := cognitoUserPoolConfiguration{
+		GroupConfiguration: fwtypes.NewListNestedObjectValueOfNull[cognitoGroupConfiguration](ctx),
+		UserPoolARN:        fwtypes.ARNValue(aws.ToString(v.Value.UserPoolArn)),
+	}
+	diags.Append(fwflex.Flatten(ctx, v.Value.ClientIds, &cognito.ClientIDs)...)
+
+	if group := v.Value.GroupConfiguration; group != nil {
+		cognito.GroupConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &cognitoGroupConfiguration{
+			GroupEntityType: fwflex.StringToFramework(ctx, group.GroupEntityType),
+		})
+	}
+
+	data.Configuration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &identitySourceConfiguration{
+		CognitoUserPoolConfiguration: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &cognito),
+	})
+
+	return diags
+}
+
+type identitySourceConfiguration struct {
+	CognitoUserPoolConfiguration fwtypes.ListNestedObjectValueOf[cognitoUserPoolConfiguration] `tfsdk:"cognito_user_pool_configuration"`
+}
+
+type cognitoUserPoolConfiguration struct {
+	ClientIDs          fwtypes.ListValueOf[types.String]                          `tfsdk:"client_ids"`
+	GroupConfiguration fwtypes.ListNestedObjectValueOf[cognitoGroupConfiguration] `tfsdk:"group_configuration"`
+	UserPoolARN        fwtypes.ARN                                                `tfsdk:"user_pool_arn"`
+}
+
+type cognitoGroupConfiguration struct {
+	GroupEntityType types.String `tfsdk:"group_entity_type"`
+}
+
+func findIdentitySourceByID(ctx context.Context, conn *verifiedpermissions.Client, id, policyStoreID string) (*verifiedpermissions.GetIdentitySourceOutput, error) {
+	in := &verifiedpermissions.GetIdentitySourceInput{
+		IdentitySourceId: aws.String(id),
+		PolicyStoreId:    aws.String(policyStoreID),
+	}
+
+	out, err := conn.GetIdentitySource(ctx, in)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.IdentitySourceId == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
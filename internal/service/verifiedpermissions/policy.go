@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
-	cedar "github.com/cedar-policy/cedar-go/x/exp/parser"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -95,6 +94,9 @@ func (r *resourcePolicy) Schema(ctx context.Context, req resource.SchemaRequest,
 									},
 									"statement": schema.StringAttribute{
 										Required: true,
+										Validators: []validator.String{
+											validCedarStaticPolicy(),
+										},
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.RequiresReplaceIf(
 												statementReplaceIf, "Replace cedar statement diff", "Replace cedar statement diff",
@@ -188,46 +190,13 @@ func statementReplaceIf(_ context.Context, req planmodifier.StringRequest, resp
 		return
 	}
 
-	cedarPlan, err := cedar.Tokenize([]byte(req.PlanValue.ValueString()))
-	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
-		return
-	}
-
-	cedarState, err := cedar.Tokenize([]byte(req.StateValue.ValueString()))
-	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
-		return
-	}
-
-	policyPlan, err := cedar.Parse(cedarPlan)
+	changed, err := cedarPolicyScopeChanged(req.StateValue.ValueString(), req.PlanValue.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(err.Error(), err.Error())
 		return
 	}
 
-	policyState, err := cedar.Parse(cedarState)
-	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
-		return
-	}
-
-	var policyPrincipal bool
-	if len(policyPlan) > 0 && len(policyState) > 0 && (len(policyPlan[0].Principal.Entity.Path) > 0 && (len(policyState[0].Principal.Entity.Path)) > 0) {
-		policyPrincipal = (policyPlan[0].Principal.Entity.String() != policyState[0].Principal.Entity.String()) || (policyPlan[0].Principal.Type != policyState[0].Principal.Type)
-	}
-
-	var policyResource bool
-	if len(policyPlan) > 0 && len(policyState) > 0 && (len(policyPlan[0].Resource.Entity.Path) > 0 && (len(policyState[0].Resource.Entity.Path)) > 0) {
-		policyResource = (policyPlan[0].Resource.Entity.String() != policyState[0].Resource.Entity.String()) || (policyPlan[0].Resource.Type != policyState[0].Resource.Type)
-	}
-
-	var policyEffect bool
-	if len(policyPlan) > 0 && len(policyState) > 0 {
-		policyEffect = policyPlan[0].Effect != policyState[0].Effect
-	}
-
-	resp.RequiresReplace = policyEffect || policyResource || policyPrincipal
+	resp.RequiresReplace = changed
 }
 
 const (
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
@@ -156,6 +157,31 @@ func TestAccVerifiedPermissionsPolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccVerifiedPermissionsPolicy_invalidStatement(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyConfig_basic(rName, "permit (principal, action, resource"),
+				ExpectError: regexp.MustCompile(`Invalid Cedar policy`),
+			},
+		},
+	})
+}
+
 func TestAccVerifiedPermissionsPolicy_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -25,6 +25,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newResourceIdentitySource,
+			Name:    "Identity Source",
+		},
 		{
 			Factory: newResourcePolicy,
 			Name:    "Policy",
@@ -41,6 +45,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceSchema,
 			Name:    "Schema",
 		},
+		{
+			Factory: newResourceStaticPolicies,
+			Name:    "Static Policies",
+		},
 	}
 }
 
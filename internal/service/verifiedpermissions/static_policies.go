@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Static Policies")
+func newResourceStaticPolicies(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceStaticPolicies{}
+
+	return r, nil
+}
+
+const (
+	ResNameStaticPolicies = "Static Policies"
+)
+
+type resourceStaticPolicies struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceStaticPolicies) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_static_policies"
+}
+
+func (r *resourceStaticPolicies) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"policies": schema.MapAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					// Each key is stored as the policy's description.
+					mapvalidator.KeysAre(stringvalidator.LengthBetween(1, 150)),
+					mapvalidator.ValueStringsAre(validCedarStaticPolicy()),
+				},
+			},
+			"policy_ids": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+
+	response.Schema = s
+}
+
+func (r *resourceStaticPolicies) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var plan resourceStaticPoliciesData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID := plan.PolicyStoreID.ValueString()
+	statements := flex.ExpandFrameworkStringValueMap(ctx, plan.Policies)
+	policies := make(map[string]string)
+	policyIDs := make(map[string]string)
+
+	for _, k := range sortedKeys(statements) {
+		policyID, err := createStaticPolicy(ctx, conn, policyStoreID, k, statements[k])
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNameStaticPolicies, k, err),
+				err.Error(),
+			)
+			break
+		}
+
+		policies[k] = statements[k]
+		policyIDs[k] = policyID
+	}
+
+	// Record the policies created so far, even on error, so that they are not orphaned.
+	if len(policyIDs) == 0 {
+		return
+	}
+
+	state := plan
+	state.ID = flex.StringValueToFramework(ctx, policyStoreID)
+	state.Policies = flex.FlattenFrameworkStringValueMap(ctx, policies)
+	state.PolicyIDs = flex.FlattenFrameworkStringValueMap(ctx, policyIDs)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourceStaticPolicies) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state resourceStaticPoliciesData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID := state.PolicyStoreID.ValueString()
+	policyIDs := flex.ExpandFrameworkStringValueMap(ctx, state.PolicyIDs)
+	policies := make(map[string]string)
+
+	for k, policyID := range policyIDs {
+		output, err := findPolicyByID(ctx, conn, policyID, policyStoreID)
+
+		if tfresource.NotFound(err) {
+			tflog.Warn(ctx, "Verified Permissions Policy not found, removing from state", map[string]interface{}{
+				"policy_id": policyID,
+			})
+			delete(policyIDs, k)
+			continue
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNameStaticPolicies, policyID, err),
+				err.Error(),
+			)
+			return
+		}
+
+		if v, ok := output.Definition.(*awstypes.PolicyDefinitionDetailMemberStatic); ok && v != nil {
+			policies[k] = aws.ToString(v.Value.Statement)
+		}
+	}
+
+	if len(policyIDs) == 0 {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Policies = flex.FlattenFrameworkStringValueMap(ctx, policies)
+	state.PolicyIDs = flex.FlattenFrameworkStringValueMap(ctx, policyIDs)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourceStaticPolicies) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state, plan resourceStaticPoliciesData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID := state.PolicyStoreID.ValueString()
+	old := flex.ExpandFrameworkStringValueMap(ctx, state.Policies)
+	new := flex.ExpandFrameworkStringValueMap(ctx, plan.Policies)
+	policies := flex.ExpandFrameworkStringValueMap(ctx, state.Policies)
+	policyIDs := flex.ExpandFrameworkStringValueMap(ctx, state.PolicyIDs)
+
+	defer func() {
+		plan.ID = state.ID
+		plan.Policies = flex.FlattenFrameworkStringValueMap(ctx, policies)
+		plan.PolicyIDs = flex.FlattenFrameworkStringValueMap(ctx, policyIDs)
+
+		response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+	}()
+
+	// Remove policies no longer configured first to stay within the policy store's quotas.
+	for _, k := range sortedKeys(old) {
+		if _, ok := new[k]; ok {
+			continue
+		}
+
+		if err := deleteStaticPolicy(ctx, conn, policyStoreID, policyIDs[k]); err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionDeleting, ResNameStaticPolicies, k, err),
+				err.Error(),
+			)
+			return
+		}
+
+		delete(policies, k)
+		delete(policyIDs, k)
+	}
+
+	for _, k := range sortedKeys(new) {
+		statement := new[k]
+
+		if v, ok := old[k]; ok {
+			if v == statement {
+				continue
+			}
+
+			replace, err := cedarPolicyScopeChanged(v, statement)
+
+			if err != nil {
+				response.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNameStaticPolicies, k, err),
+					err.Error(),
+				)
+				return
+			}
+
+			if !replace {
+				input := &verifiedpermissions.UpdatePolicyInput{
+					Definition: &awstypes.UpdatePolicyDefinitionMemberStatic{
+						Value: awstypes.UpdateStaticPolicyDefinition{
+							Description: aws.String(k),
+							Statement:   aws.String(statement),
+						},
+					},
+					PolicyId:      aws.String(policyIDs[k]),
+					PolicyStoreId: aws.String(policyStoreID),
+				}
+
+				if _, err := conn.UpdatePolicy(ctx, input); err != nil {
+					response.Diagnostics.AddError(
+						create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNameStaticPolicies, k, err),
+						err.Error(),
+					)
+					return
+				}
+
+				policies[k] = statement
+				continue
+			}
+
+			// The effect, principal or resource changed: replace the policy.
+			if err := deleteStaticPolicy(ctx, conn, policyStoreID, policyIDs[k]); err != nil {
+				response.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionDeleting, ResNameStaticPolicies, k, err),
+					err.Error(),
+				)
+				return
+			}
+
+			delete(policies, k)
+			delete(policyIDs, k)
+		}
+
+		policyID, err := createStaticPolicy(ctx, conn, policyStoreID, k, statement)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNameStaticPolicies, k, err),
+				err.Error(),
+			)
+			return
+		}
+
+		policies[k] = statement
+		policyIDs[k] = policyID
+	}
+}
+
+func (r *resourceStaticPolicies) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state resourceStaticPoliciesData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID := state.PolicyStoreID.ValueString()
+
+	for k, policyID := range flex.ExpandFrameworkStringValueMap(ctx, state.PolicyIDs) {
+		tflog.Debug(ctx, "deleting Verified Permissions Policy", map[string]interface{}{
+			"policy_id": policyID,
+		})
+
+		if err := deleteStaticPolicy(ctx, conn, policyStoreID, policyID); err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionDeleting, ResNameStaticPolicies, k, err),
+				err.Error(),
+			)
+			return
+		}
+	}
+}
+
+type resourceStaticPoliciesData struct {
+	ID            types.String `tfsdk:"id"`
+	Policies      types.Map    `tfsdk:"policies"`
+	PolicyIDs     types.Map    `tfsdk:"policy_ids"`
+	PolicyStoreID types.String `tfsdk:"policy_store_id"`
+}
+
+// createStaticPolicy creates a static policy whose description is the policy's key, returning the new policy's ID.
+func createStaticPolicy(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, key, statement string) (string, error) {
+	input := &verifiedpermissions.CreatePolicyInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Definition: &awstypes.PolicyDefinitionMemberStatic{
+			Value: awstypes.StaticPolicyDefinition{
+				Description: aws.String(key),
+				Statement:   aws.String(statement),
+			},
+		},
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	output, err := conn.CreatePolicy(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.PolicyId), nil
+}
+
+func deleteStaticPolicy(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, policyID string) error {
+	_, err := conn.DeletePolicy(ctx, &verifiedpermissions.DeletePolicyInput{
+		PolicyId:      aws.String(policyID),
+		PolicyStoreId: aws.String(policyStoreID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := tfmaps.Keys(m)
+	slices.Sort(keys)
+
+	return keys
+}
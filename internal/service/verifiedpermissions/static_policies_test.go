@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVerifiedPermissionsStaticPolicies_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_verifiedpermissions_static_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStaticPoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStaticPoliciesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStaticPoliciesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policies.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "policies.view", `permit (principal, action == Action::"view", resource in Album::"test_album");`),
+					resource.TestCheckResourceAttr(resourceName, "policies.edit", `permit (principal, action == Action::"edit", resource in Album::"test_album");`),
+					resource.TestCheckResourceAttr(resourceName, "policy_ids.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_ids.view"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_ids.edit"),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_store_id", "aws_verifiedpermissions_policy_store.test", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsStaticPolicies_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_verifiedpermissions_static_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStaticPoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStaticPoliciesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStaticPoliciesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policies.%", "2"),
+				),
+			},
+			{
+				Config: testAccStaticPoliciesConfig_updated(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStaticPoliciesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policies.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "policies.view", `permit (principal, action == Action::"view", resource in Album::"other_album");`),
+					resource.TestCheckNoResourceAttr(resourceName, "policies.edit"),
+					resource.TestCheckResourceAttr(resourceName, "policies.deny", `forbid (principal, action == Action::"delete", resource in Album::"test_album");`),
+					resource.TestCheckResourceAttr(resourceName, "policy_ids.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsStaticPolicies_invalidStatement(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStaticPoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStaticPoliciesConfig_invalidStatement(rName),
+				ExpectError: regexp.MustCompile(`Invalid Cedar policy`),
+			},
+		},
+	})
+}
+
+func testAccCheckStaticPoliciesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_verifiedpermissions_static_policies" {
+				continue
+			}
+
+			for k, v := range rs.Primary.Attributes {
+				if k == "policy_ids.%" || !regexp.MustCompile(`^policy_ids\.`).MatchString(k) {
+					continue
+				}
+
+				_, err := tfverifiedpermissions.FindPolicyByID(ctx, conn, v, rs.Primary.Attributes["policy_store_id"])
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return create.Error(names.VerifiedPermissions, create.ErrActionCheckingDestroyed, tfverifiedpermissions.ResNameStaticPolicies, v, err)
+				}
+
+				return create.Error(names.VerifiedPermissions, create.ErrActionCheckingDestroyed, tfverifiedpermissions.ResNameStaticPolicies, v, errors.New("not destroyed"))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckStaticPoliciesExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNameStaticPolicies, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNameStaticPolicies, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		for k, v := range rs.Primary.Attributes {
+			if k == "policy_ids.%" || !regexp.MustCompile(`^policy_ids\.`).MatchString(k) {
+				continue
+			}
+
+			if _, err := tfverifiedpermissions.FindPolicyByID(ctx, conn, v, rs.Primary.Attributes["policy_store_id"]); err != nil {
+				return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNameStaticPolicies, v, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccStaticPoliciesConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  description = %[1]q
+
+  validation_settings {
+    mode = "OFF"
+  }
+}
+`, rName)
+}
+
+func testAccStaticPoliciesConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStaticPoliciesConfig_base(rName), `
+resource "aws_verifiedpermissions_static_policies" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  policies = {
+    view = "permit (principal, action == Action::\"view\", resource in Album::\"test_album\");"
+    edit = "permit (principal, action == Action::\"edit\", resource in Album::\"test_album\");"
+  }
+}
+`)
+}
+
+func testAccStaticPoliciesConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccStaticPoliciesConfig_base(rName), `
+resource "aws_verifiedpermissions_static_policies" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  policies = {
+    view = "permit (principal, action == Action::\"view\", resource in Album::\"other_album\");"
+    deny = "forbid (principal, action == Action::\"delete\", resource in Album::\"test_album\");"
+  }
+}
+`)
+}
+
+func testAccStaticPoliciesConfig_invalidStatement(rName string) string {
+	return acctest.ConfigCompose(testAccStaticPoliciesConfig_base(rName), `
+resource "aws_verifiedpermissions_static_policies" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  policies = {
+    view = "permit (principal, action == Action::\"view\", resource"
+  }
+}
+`)
+}
@@ -0,0 +1,303 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_wellarchitected_answer", name="Answer")
+func resourceAnswer() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAnswerPut,
+		ReadWithoutTimeout:   resourceAnswerRead,
+		UpdateWithoutTimeout: resourceAnswerPut,
+		DeleteWithoutTimeout: resourceAnswerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"choice_update": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"choice_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"notes": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 250),
+						},
+						"reason": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[types.ChoiceReason](),
+						},
+						names.AttrStatus: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.ChoiceStatus](),
+						},
+					},
+				},
+			},
+			"is_applicable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"lens_alias": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"notes": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2084),
+			},
+			"pillar_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"question_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"reason": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.AnswerReason](),
+			},
+			"risk": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"selected_choices": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"workload_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const answerResourceIDSeparator = ","
+
+func answerCreateResourceID(workloadID, lensAlias, questionID string) string {
+	parts := []string{workloadID, lensAlias, questionID}
+	id := strings.Join(parts, answerResourceIDSeparator)
+
+	return id
+}
+
+func answerParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, answerResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected WORKLOAD-ID%[2]sLENS-ALIAS%[2]sQUESTION-ID", id, answerResourceIDSeparator)
+}
+
+// resourceAnswerPut handles both create and update, as answers to lens
+// questions always exist once a lens is associated with a workload.
+func resourceAnswerPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	workloadID, lensAlias, questionID := d.Get("workload_id").(string), d.Get("lens_alias").(string), d.Get("question_id").(string)
+	id := answerCreateResourceID(workloadID, lensAlias, questionID)
+	input := &wellarchitected.UpdateAnswerInput{
+		IsApplicable: aws.Bool(d.Get("is_applicable").(bool)),
+		LensAlias:    aws.String(lensAlias),
+		QuestionId:   aws.String(questionID),
+		WorkloadId:   aws.String(workloadID),
+	}
+
+	if v, ok := d.GetOk("choice_update"); ok && v.(*schema.Set).Len() > 0 {
+		input.ChoiceUpdates = expandChoiceUpdates(v.(*schema.Set).List())
+	}
+
+	if d.IsNewResource() || d.HasChange("notes") {
+		input.Notes = aws.String(d.Get("notes").(string))
+	}
+
+	if v, ok := d.GetOk("reason"); ok {
+		input.Reason = types.AnswerReason(v.(string))
+	}
+
+	if v, ok := d.GetOk("selected_choices"); ok {
+		input.SelectedChoices = flex.ExpandStringValueSet(v.(*schema.Set))
+	} else {
+		input.SelectedChoices = []string{}
+	}
+
+	_, err := conn.UpdateAnswer(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Well-Architected Answer (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return append(diags, resourceAnswerRead(ctx, d, meta)...)
+}
+
+func resourceAnswerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	workloadID, lensAlias, questionID, err := answerParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	answer, err := findAnswerByThreePartKey(ctx, conn, workloadID, lensAlias, questionID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Well-Architected Answer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Well-Architected Answer (%s): %s", d.Id(), err)
+	}
+
+	d.Set("is_applicable", answer.IsApplicable)
+	d.Set("lens_alias", lensAlias)
+	d.Set("notes", answer.Notes)
+	d.Set("pillar_id", answer.PillarId)
+	d.Set("question_id", answer.QuestionId)
+	d.Set("reason", answer.Reason)
+	d.Set("risk", answer.Risk)
+	d.Set("selected_choices", answer.SelectedChoices)
+	d.Set("workload_id", workloadID)
+
+	return diags
+}
+
+func resourceAnswerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	workloadID, lensAlias, questionID, err := answerParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Answers cannot be deleted, so reset the question to its unanswered state.
+	log.Printf("[DEBUG] Resetting Well-Architected Answer: %s", d.Id())
+	_, err = conn.UpdateAnswer(ctx, &wellarchitected.UpdateAnswerInput{
+		IsApplicable:    aws.Bool(true),
+		LensAlias:       aws.String(lensAlias),
+		Notes:           aws.String(""),
+		QuestionId:      aws.String(questionID),
+		SelectedChoices: []string{},
+		WorkloadId:      aws.String(workloadID),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "resetting Well-Architected Answer (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findAnswerByThreePartKey(ctx context.Context, conn *wellarchitected.Client, workloadID, lensAlias, questionID string) (*types.Answer, error) {
+	input := &wellarchitected.GetAnswerInput{
+		LensAlias:  aws.String(lensAlias),
+		QuestionId: aws.String(questionID),
+		WorkloadId: aws.String(workloadID),
+	}
+
+	output, err := conn.GetAnswer(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Answer == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Answer, nil
+}
+
+func expandChoiceUpdates(tfList []interface{}) map[string]types.ChoiceUpdate {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]types.ChoiceUpdate)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.ChoiceUpdate{
+			Status: types.ChoiceStatus(tfMap[names.AttrStatus].(string)),
+		}
+
+		if v, ok := tfMap["notes"].(string); ok && v != "" {
+			apiObject.Notes = aws.String(v)
+		}
+
+		if v, ok := tfMap["reason"].(string); ok && v != "" {
+			apiObject.Reason = types.ChoiceReason(v)
+		}
+
+		apiObjects[tfMap["choice_id"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWellArchitectedAnswer_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_answer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// Answers are deleted together with their workload.
+		CheckDestroy: testAccCheckWorkloadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnswerConfig_basic(rName, `"choice1"`, "first pass"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnswerExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "is_applicable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "notes", "first pass"),
+					resource.TestCheckResourceAttr(resourceName, "pillar_id", "pillar1"),
+					resource.TestCheckResourceAttr(resourceName, "question_id", "question1"),
+					resource.TestCheckResourceAttr(resourceName, "risk", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "selected_choices.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttr(resourceName, "selected_choices.*", "choice1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"choice_update"},
+			},
+			{
+				Config: testAccAnswerConfig_basic(rName, `"choice2"`, "second pass"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnswerExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notes", "second pass"),
+					resource.TestCheckResourceAttr(resourceName, "risk", "HIGH"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "selected_choices.*", "choice2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAnswerExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		_, err := tfwellarchitected.FindAnswerByThreePartKey(ctx, conn, rs.Primary.Attributes["workload_id"], rs.Primary.Attributes["lens_alias"], rs.Primary.Attributes["question_id"])
+
+		return err
+	}
+}
+
+func testAccAnswerConfig_basic(rName, selectedChoices, notes string) string {
+	return acctest.ConfigCompose(testAccLensConfig_basic(rName, "Answer lens", "v1"), fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_wellarchitected_workload" "test" {
+  workload_name = %[1]q
+  description   = "answer"
+  environment   = "PREPRODUCTION"
+  aws_regions   = [data.aws_region.current.name]
+  review_owner  = "terraform"
+  lenses        = [aws_wellarchitected_lens.test.arn]
+}
+
+resource "aws_wellarchitected_answer" "test" {
+  workload_id      = aws_wellarchitected_workload.test.id
+  lens_alias       = aws_wellarchitected_lens.test.arn
+  question_id      = "question1"
+  selected_choices = [%[2]s]
+  notes            = %[3]q
+}
+`, rName, selectedChoices, notes))
+}
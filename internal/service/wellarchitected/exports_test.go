@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+// Exports for use in tests only.
+var (
+	ResourceAnswer    = resourceAnswer
+	ResourceLens      = resourceLens
+	ResourceLensShare = resourceLensShare
+	ResourceMilestone = resourceMilestone
+	ResourceWorkload  = resourceWorkload
+
+	FindAnswerByThreePartKey  = findAnswerByThreePartKey
+	FindLensByARN             = findLensByARN
+	FindLensShareByTwoPartKey = findLensShareByTwoPartKey
+	FindMilestoneByTwoPartKey = findMilestoneByTwoPartKey
+	FindWorkloadByID          = findWorkloadByID
+	LensShareParseResourceID  = lensShareParseResourceID
+	MilestoneParseResourceID  = milestoneParseResourceID
+	ValidLensJSON             = validLensJSON
+)
@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	lensImportTimeout = 2 * time.Minute
+)
+
+// @SDKResource("aws_wellarchitected_lens", name="Lens")
+// @Tags(identifierAttribute="arn")
+func resourceLens() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLensCreate,
+		ReadWithoutTimeout:   resourceLensRead,
+		UpdateWithoutTimeout: resourceLensUpdate,
+		DeleteWithoutTimeout: resourceLensDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_major_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"json_string": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validLensJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"lens_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrOwner: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceLensCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	input := &wellarchitected.ImportLensInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		JSONString:         aws.String(d.Get("json_string").(string)),
+		Tags:               getTagsIn(ctx),
+	}
+
+	output, err := conn.ImportLens(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "importing Well-Architected Lens: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.LensArn))
+
+	// Lens import is asynchronous.
+	_, err = tfresource.RetryWhenNotFound(ctx, lensImportTimeout, func() (interface{}, error) {
+		return findLensByARN(ctx, conn, d.Id())
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Well-Architected Lens (%s) import: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("lens_version"); ok {
+		if err := createLensVersion(ctx, conn, d.Id(), v.(string), d.Get("is_major_version").(bool)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceLensRead(ctx, d, meta)...)
+}
+
+func resourceLensRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	lens, err := findLensByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Well-Architected Lens (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Well-Architected Lens (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, lens.LensArn)
+	d.Set(names.AttrDescription, lens.Description)
+	d.Set(names.AttrName, lens.Name)
+	d.Set(names.AttrOwner, lens.Owner)
+
+	// The lens document is only exported for drift detection on import, the service reformats it.
+	if _, ok := d.GetOk("json_string"); !ok {
+		output, err := conn.ExportLens(ctx, &wellarchitected.ExportLensInput{
+			LensAlias: aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "exporting Well-Architected Lens (%s): %s", d.Id(), err)
+		}
+
+		d.Set("json_string", output.LensJSON)
+	}
+
+	return diags
+}
+
+func resourceLensUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	if d.HasChange("json_string") {
+		input := &wellarchitected.ImportLensInput{
+			ClientRequestToken: aws.String(id.UniqueId()),
+			JSONString:         aws.String(d.Get("json_string").(string)),
+			LensAlias:          aws.String(d.Id()),
+		}
+
+		_, err := conn.ImportLens(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Well-Architected Lens (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("json_string", "lens_version") {
+		if v, ok := d.GetOk("lens_version"); ok {
+			if err := createLensVersion(ctx, conn, d.Id(), v.(string), d.Get("is_major_version").(bool)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return append(diags, resourceLensRead(ctx, d, meta)...)
+}
+
+func resourceLensDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Well-Architected Lens: %s", d.Id())
+	_, err := conn.DeleteLens(ctx, &wellarchitected.DeleteLensInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		LensAlias:          aws.String(d.Id()),
+		LensStatus:         types.LensStatusTypeAll,
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Well-Architected Lens (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func createLensVersion(ctx context.Context, conn *wellarchitected.Client, arn, version string, isMajorVersion bool) error {
+	input := &wellarchitected.CreateLensVersionInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		IsMajorVersion:     aws.Bool(isMajorVersion),
+		LensAlias:          aws.String(arn),
+		LensVersion:        aws.String(version),
+	}
+
+	// A version cannot be published while a lens import is still in progress.
+	_, err := tfresource.RetryWhenIsA[*types.ConflictException](ctx, lensImportTimeout, func() (interface{}, error) {
+		return conn.CreateLensVersion(ctx, input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("publishing Well-Architected Lens (%s) version (%s): %w", arn, version, err)
+	}
+
+	return nil
+}
+
+func findLensByARN(ctx context.Context, conn *wellarchitected.Client, arn string) (*types.Lens, error) {
+	input := &wellarchitected.GetLensInput{
+		LensAlias: aws.String(arn),
+	}
+
+	output, err := conn.GetLens(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Lens == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Lens, nil
+}
+
+// lensDocument is the subset of the custom lens JSON format that is validated at plan time.
+// See https://docs.aws.amazon.com/wellarchitected/latest/userguide/lenses-format-specification.html.
+type lensDocument struct {
+	SchemaVersion *string `json:"schemaVersion"`
+	Name          *string `json:"name"`
+	Description   *string `json:"description"`
+	Pillars       []struct {
+		ID        *string `json:"id"`
+		Name      *string `json:"name"`
+		Questions []struct {
+			ID      *string `json:"id"`
+			Title   *string `json:"title"`
+			Choices []struct {
+				ID    *string `json:"id"`
+				Title *string `json:"title"`
+			} `json:"choices"`
+			RiskRules []struct {
+				Condition *string `json:"condition"`
+				Risk      *string `json:"risk"`
+			} `json:"riskRules"`
+		} `json:"questions"`
+	} `json:"pillars"`
+}
+
+// validLensJSON checks that a custom lens document is valid JSON with the required sections,
+// so that mistakes are reported during plan instead of by the asynchronous import.
+func validLensJSON(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	var doc lensDocument
+	if err := json.Unmarshal([]byte(value), &doc); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+		return
+	}
+
+	if doc.SchemaVersion == nil {
+		errors = append(errors, fmt.Errorf("%q must contain a schemaVersion", k))
+	}
+	if aws.ToString(doc.Name) == "" {
+		errors = append(errors, fmt.Errorf("%q must contain a name", k))
+	}
+	if aws.ToString(doc.Description) == "" {
+		errors = append(errors, fmt.Errorf("%q must contain a description", k))
+	}
+	if len(doc.Pillars) == 0 {
+		errors = append(errors, fmt.Errorf("%q must contain at least one pillar", k))
+	}
+
+	pillarIDs := make(map[string]bool)
+	for i, pillar := range doc.Pillars {
+		pillarID := aws.ToString(pillar.ID)
+		if pillarID == "" || aws.ToString(pillar.Name) == "" {
+			errors = append(errors, fmt.Errorf("%q: pillars[%d] must contain an id and a name", k, i))
+		} else if pillarIDs[pillarID] {
+			errors = append(errors, fmt.Errorf("%q: duplicate pillar id %q", k, pillarID))
+		}
+		pillarIDs[pillarID] = true
+
+		if len(pillar.Questions) == 0 {
+			errors = append(errors, fmt.Errorf("%q: pillar %q must contain at least one question", k, pillarID))
+		}
+
+		for j, question := range pillar.Questions {
+			questionID := aws.ToString(question.ID)
+			if questionID == "" || aws.ToString(question.Title) == "" {
+				errors = append(errors, fmt.Errorf("%q: pillars[%d].questions[%d] must contain an id and a title", k, i, j))
+			}
+
+			if len(question.Choices) == 0 {
+				errors = append(errors, fmt.Errorf("%q: question %q must contain at least one choice", k, questionID))
+			}
+
+			choiceIDs := make(map[string]bool)
+			for _, choice := range question.Choices {
+				choiceID := aws.ToString(choice.ID)
+				if choiceID == "" || aws.ToString(choice.Title) == "" {
+					errors = append(errors, fmt.Errorf("%q: choices of question %q must contain an id and a title", k, questionID))
+				} else if choiceIDs[choiceID] {
+					errors = append(errors, fmt.Errorf("%q: duplicate choice id %q in question %q", k, choiceID, questionID))
+				}
+				choiceIDs[choiceID] = true
+			}
+
+			if len(question.RiskRules) == 0 {
+				errors = append(errors, fmt.Errorf("%q: question %q must contain riskRules", k, questionID))
+			}
+		}
+	}
+
+	return
+}
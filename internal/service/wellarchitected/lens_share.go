@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_wellarchitected_lens_share", name="Lens Share")
+func resourceLensShare() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLensShareCreate,
+		ReadWithoutTimeout:   resourceLensShareRead,
+		DeleteWithoutTimeout: resourceLensShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"lens_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"share_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"shared_with": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(12, 2048),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatusMessage: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const lensShareResourceIDSeparator = ","
+
+func lensShareCreateResourceID(lensARN, shareID string) string {
+	parts := []string{lensARN, shareID}
+	id := strings.Join(parts, lensShareResourceIDSeparator)
+
+	return id
+}
+
+func lensShareParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, lensShareResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected LENS-ARN%[2]sSHARE-ID", id, lensShareResourceIDSeparator)
+}
+
+func resourceLensShareCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	lensARN := d.Get("lens_arn").(string)
+	input := &wellarchitected.CreateLensShareInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		LensAlias:          aws.String(lensARN),
+		SharedWith:         aws.String(d.Get("shared_with").(string)),
+	}
+
+	output, err := conn.CreateLensShare(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Well-Architected Lens (%s) Share: %s", lensARN, err)
+	}
+
+	d.SetId(lensShareCreateResourceID(lensARN, aws.ToString(output.ShareId)))
+
+	return append(diags, resourceLensShareRead(ctx, d, meta)...)
+}
+
+func resourceLensShareRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	lensARN, shareID, err := lensShareParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	share, err := findLensShareByTwoPartKey(ctx, conn, lensARN, shareID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Well-Architected Lens Share (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Well-Architected Lens Share (%s): %s", d.Id(), err)
+	}
+
+	d.Set("lens_arn", lensARN)
+	d.Set("share_id", share.ShareId)
+	d.Set("shared_with", share.SharedWith)
+	d.Set(names.AttrStatus, share.Status)
+	d.Set(names.AttrStatusMessage, share.StatusMessage)
+
+	return diags
+}
+
+func resourceLensShareDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	lensARN, shareID, err := lensShareParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Well-Architected Lens Share: %s", d.Id())
+	_, err = conn.DeleteLensShare(ctx, &wellarchitected.DeleteLensShareInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		LensAlias:          aws.String(lensARN),
+		ShareId:            aws.String(shareID),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Well-Architected Lens Share (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findLensShareByTwoPartKey(ctx context.Context, conn *wellarchitected.Client, lensARN, shareID string) (*types.LensShareSummary, error) {
+	input := &wellarchitected.ListLensSharesInput{
+		LensAlias: aws.String(lensARN),
+	}
+
+	pages := wellarchitected.NewListLensSharesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.LensShareSummaries {
+			if aws.ToString(v.ShareId) != shareID {
+				continue
+			}
+
+			// Revoked and expired shares are retained in the list.
+			if status := v.Status; status == types.ShareStatusRevoked || status == types.ShareStatusExpired {
+				return nil, &retry.NotFoundError{
+					Message:     string(status),
+					LastRequest: input,
+				}
+			}
+
+			return &v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidLensJSON(t *testing.T) {
+	t.Parallel()
+
+	validDocument := testAccLensDocument("test", "Test lens")
+
+	testCases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    validDocument,
+			ErrCount: 0,
+		},
+		{
+			Value:    `{"schemaVersion":`,
+			ErrCount: 1,
+		},
+		{
+			Value:    `{"schemaVersion": "2021-11-01", "name": "test", "description": "test"}`,
+			ErrCount: 1,
+		},
+		{
+			Value: `{
+  "schemaVersion": "2021-11-01",
+  "name": "test",
+  "description": "test",
+  "pillars": [
+    {
+      "id": "p1",
+      "name": "Pillar",
+      "questions": [
+        {
+          "id": "q1",
+          "title": "Question",
+          "choices": [
+            {"id": "c1", "title": "Choice"},
+            {"id": "c1", "title": "Duplicate"}
+          ]
+        }
+      ]
+    }
+  ]
+}`,
+			ErrCount: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, errors := tfwellarchitected.ValidLensJSON(tc.Value, "json_string")
+
+		if len(errors) != tc.ErrCount {
+			t.Errorf("Expected %d errors for %q, got %d: %v", tc.ErrCount, tc.Value, len(errors), errors)
+		}
+	}
+}
+
+func TestAccWellArchitectedLens_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_lens.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLensDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLensConfig_basic(rName, "Initial lens", "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLensExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "wellarchitected", regexache.MustCompile(`lens/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Initial lens"),
+					resource.TestCheckResourceAttr(resourceName, "lens_version", "v1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrOwner),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"is_major_version", "json_string", "lens_version"},
+			},
+			{
+				Config: testAccLensConfig_basic(rName, "Updated lens", "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLensExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Updated lens"),
+					resource.TestCheckResourceAttr(resourceName, "lens_version", "v2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedLens_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_lens.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLensDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLensConfig_basic(rName, "Initial lens", "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLensExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfwellarchitected.ResourceLens(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLensDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wellarchitected_lens" {
+				continue
+			}
+
+			_, err := tfwellarchitected.FindLensByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Well-Architected Lens %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLensExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		_, err := tfwellarchitected.FindLensByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccLensDocument(name, description string) string {
+	return fmt.Sprintf(`{
+  "schemaVersion": "2021-11-01",
+  "name": %[1]q,
+  "description": %[2]q,
+  "pillars": [
+    {
+      "id": "pillar1",
+      "name": "Pillar One",
+      "questions": [
+        {
+          "id": "question1",
+          "title": "Is the workload tested?",
+          "choices": [
+            {"id": "choice1", "title": "Yes"},
+            {"id": "choice2", "title": "No"}
+          ],
+          "riskRules": [
+            {"condition": "choice1", "risk": "NO_RISK"},
+            {"condition": "default", "risk": "HIGH_RISK"}
+          ]
+        }
+      ]
+    }
+  ]
+}`, name, description)
+}
+
+func testAccLensConfig_basic(rName, description, version string) string {
+	return fmt.Sprintf(`
+resource "aws_wellarchitected_lens" "test" {
+  json_string  = <<EOT
+%[1]s
+EOT
+  lens_version = %[2]q
+}
+`, testAccLensDocument(rName, description), version)
+}
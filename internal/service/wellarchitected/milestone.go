@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_wellarchitected_milestone", name="Milestone")
+func resourceMilestone() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMilestoneCreate,
+		ReadWithoutTimeout:   resourceMilestoneRead,
+		DeleteWithoutTimeout: resourceMilestoneDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"milestone_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 100),
+			},
+			"milestone_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"recorded_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workload_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workload_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const milestoneResourceIDSeparator = ","
+
+func milestoneCreateResourceID(workloadID string, milestoneNumber int32) string {
+	parts := []string{workloadID, strconv.Itoa(int(milestoneNumber))}
+	id := strings.Join(parts, milestoneResourceIDSeparator)
+
+	return id
+}
+
+func milestoneParseResourceID(id string) (string, int32, error) {
+	parts := strings.Split(id, milestoneResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		v, err := strconv.ParseInt(parts[1], 10, 32)
+		if err != nil {
+			return "", 0, fmt.Errorf("parsing milestone number (%s): %w", parts[1], err)
+		}
+
+		return parts[0], int32(v), nil
+	}
+
+	return "", 0, fmt.Errorf("unexpected format for ID (%[1]s), expected WORKLOAD-ID%[2]sMILESTONE-NUMBER", id, milestoneResourceIDSeparator)
+}
+
+func resourceMilestoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	workloadID := d.Get("workload_id").(string)
+	name := d.Get("milestone_name").(string)
+	input := &wellarchitected.CreateMilestoneInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		MilestoneName:      aws.String(name),
+		WorkloadId:         aws.String(workloadID),
+	}
+
+	output, err := conn.CreateMilestone(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Well-Architected Milestone (%s): %s", name, err)
+	}
+
+	d.SetId(milestoneCreateResourceID(workloadID, aws.ToInt32(output.MilestoneNumber)))
+
+	return append(diags, resourceMilestoneRead(ctx, d, meta)...)
+}
+
+func resourceMilestoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	workloadID, milestoneNumber, err := milestoneParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	milestone, err := findMilestoneByTwoPartKey(ctx, conn, workloadID, milestoneNumber)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Well-Architected Milestone (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Well-Architected Milestone (%s): %s", d.Id(), err)
+	}
+
+	d.Set("milestone_name", milestone.MilestoneName)
+	d.Set("milestone_number", milestone.MilestoneNumber)
+	if milestone.RecordedAt != nil {
+		d.Set("recorded_at", aws.ToTime(milestone.RecordedAt).Format(time.RFC3339))
+	}
+	if workload := milestone.Workload; workload != nil {
+		d.Set("workload_arn", workload.WorkloadArn)
+	}
+	d.Set("workload_id", workloadID)
+
+	return diags
+}
+
+func resourceMilestoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	log.Printf("[WARN] Well-Architected Milestone (%s) cannot be deleted, removing from state", d.Id())
+
+	return diags
+}
+
+func findMilestoneByTwoPartKey(ctx context.Context, conn *wellarchitected.Client, workloadID string, milestoneNumber int32) (*types.Milestone, error) {
+	input := &wellarchitected.GetMilestoneInput{
+		MilestoneNumber: aws.Int32(milestoneNumber),
+		WorkloadId:      aws.String(workloadID),
+	}
+
+	output, err := conn.GetMilestone(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Milestone == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Milestone, nil
+}
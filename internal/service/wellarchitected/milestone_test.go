@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWellArchitectedMilestone_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_milestone.test"
+	workloadResourceName := "aws_wellarchitected_workload.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// Milestones are deleted together with their workload.
+		CheckDestroy: testAccCheckWorkloadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMilestoneConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMilestoneExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "milestone_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "milestone_number", acctest.CtOne),
+					resource.TestCheckResourceAttrSet(resourceName, "recorded_at"),
+					resource.TestCheckResourceAttrPair(resourceName, "workload_arn", workloadResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "workload_id", workloadResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMilestoneExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		workloadID, milestoneNumber, err := tfwellarchitected.MilestoneParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		_, err = tfwellarchitected.FindMilestoneByTwoPartKey(ctx, conn, workloadID, milestoneNumber)
+
+		return err
+	}
+}
+
+func testAccMilestoneConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkloadConfig_basic(rName, "milestone"), fmt.Sprintf(`
+resource "aws_wellarchitected_milestone" "test" {
+  workload_id    = aws_wellarchitected_workload.test.id
+  milestone_name = %[1]q
+}
+`, rName))
+}
@@ -27,7 +27,39 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceAnswer,
+			TypeName: "aws_wellarchitected_answer",
+			Name:     "Answer",
+		},
+		{
+			Factory:  resourceLens,
+			TypeName: "aws_wellarchitected_lens",
+			Name:     "Lens",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  resourceLensShare,
+			TypeName: "aws_wellarchitected_lens_share",
+			Name:     "Lens Share",
+		},
+		{
+			Factory:  resourceMilestone,
+			TypeName: "aws_wellarchitected_milestone",
+			Name:     "Milestone",
+		},
+		{
+			Factory:  resourceWorkload,
+			TypeName: "aws_wellarchitected_workload",
+			Name:     "Workload",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
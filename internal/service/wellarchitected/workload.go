@@ -0,0 +1,372 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_wellarchitected_workload", name="Workload")
+// @Tags(identifierAttribute="arn")
+func resourceWorkload() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWorkloadCreate,
+		ReadWithoutTimeout:   resourceWorkloadRead,
+		UpdateWithoutTimeout: resourceWorkloadUpdate,
+		DeleteWithoutTimeout: resourceWorkloadDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+			},
+			"applications": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"architectural_design": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"aws_regions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidRegionName,
+				},
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(3, 250),
+			},
+			names.AttrEnvironment: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.WorkloadEnvironment](),
+			},
+			"improvement_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"industry": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"industry_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"lenses": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"non_aws_regions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(3, 25),
+				},
+			},
+			"notes": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenAtMost(2084),
+			},
+			names.AttrOwner: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pillar_priorities": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"review_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(3, 255),
+			},
+			"risk_counts": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"workload_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 100),
+					validation.StringMatch(regexache.MustCompile(`^[^\s]`), "must not begin with whitespace"),
+				),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceWorkloadCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	name := d.Get("workload_name").(string)
+	input := &wellarchitected.CreateWorkloadInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		Description:        aws.String(d.Get(names.AttrDescription).(string)),
+		Environment:        types.WorkloadEnvironment(d.Get(names.AttrEnvironment).(string)),
+		Lenses:             flex.ExpandStringValueSet(d.Get("lenses").(*schema.Set)),
+		Tags:               getTagsIn(ctx),
+		WorkloadName:       aws.String(name),
+	}
+
+	if v, ok := d.GetOk("account_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.AccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("applications"); ok && v.(*schema.Set).Len() > 0 {
+		input.Applications = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("architectural_design"); ok {
+		input.ArchitecturalDesign = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("aws_regions"); ok && v.(*schema.Set).Len() > 0 {
+		input.AwsRegions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("industry"); ok {
+		input.Industry = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("industry_type"); ok {
+		input.IndustryType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("non_aws_regions"); ok && v.(*schema.Set).Len() > 0 {
+		input.NonAwsRegions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("notes"); ok {
+		input.Notes = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("pillar_priorities"); ok && len(v.([]interface{})) > 0 {
+		input.PillarPriorities = flex.ExpandStringValueList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("review_owner"); ok {
+		input.ReviewOwner = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateWorkload(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Well-Architected Workload (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.WorkloadId))
+
+	return append(diags, resourceWorkloadRead(ctx, d, meta)...)
+}
+
+func resourceWorkloadRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	workload, err := findWorkloadByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Well-Architected Workload (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Well-Architected Workload (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_ids", workload.AccountIds)
+	d.Set("applications", workload.Applications)
+	d.Set("architectural_design", workload.ArchitecturalDesign)
+	d.Set(names.AttrARN, workload.WorkloadArn)
+	d.Set("aws_regions", workload.AwsRegions)
+	d.Set(names.AttrDescription, workload.Description)
+	d.Set(names.AttrEnvironment, workload.Environment)
+	d.Set("improvement_status", workload.ImprovementStatus)
+	d.Set("industry", workload.Industry)
+	d.Set("industry_type", workload.IndustryType)
+	d.Set("lenses", workload.Lenses)
+	d.Set("non_aws_regions", workload.NonAwsRegions)
+	d.Set("notes", workload.Notes)
+	d.Set(names.AttrOwner, workload.Owner)
+	d.Set("pillar_priorities", workload.PillarPriorities)
+	d.Set("review_owner", workload.ReviewOwner)
+	d.Set("risk_counts", workload.RiskCounts)
+	d.Set("workload_name", workload.WorkloadName)
+
+	setTagsOut(ctx, workload.Tags)
+
+	return diags
+}
+
+func resourceWorkloadUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	if d.HasChange("lenses") {
+		o, n := d.GetChange("lenses")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// Associate new lenses first, a workload must always have at least one lens.
+		if add := ns.Difference(os); add.Len() > 0 {
+			input := &wellarchitected.AssociateLensesInput{
+				LensAliases: flex.ExpandStringValueSet(add),
+				WorkloadId:  aws.String(d.Id()),
+			}
+
+			if _, err := conn.AssociateLenses(ctx, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "associating Well-Architected Workload (%s) lenses: %s", d.Id(), err)
+			}
+		}
+
+		if del := os.Difference(ns); del.Len() > 0 {
+			input := &wellarchitected.DisassociateLensesInput{
+				LensAliases: flex.ExpandStringValueSet(del),
+				WorkloadId:  aws.String(d.Id()),
+			}
+
+			if _, err := conn.DisassociateLenses(ctx, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "disassociating Well-Architected Workload (%s) lenses: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChangesExcept("lenses", names.AttrTags, names.AttrTagsAll) {
+		input := &wellarchitected.UpdateWorkloadInput{
+			AccountIds:          flex.ExpandStringValueSet(d.Get("account_ids").(*schema.Set)),
+			Applications:        flex.ExpandStringValueSet(d.Get("applications").(*schema.Set)),
+			ArchitecturalDesign: aws.String(d.Get("architectural_design").(string)),
+			AwsRegions:          flex.ExpandStringValueSet(d.Get("aws_regions").(*schema.Set)),
+			Description:         aws.String(d.Get(names.AttrDescription).(string)),
+			Environment:         types.WorkloadEnvironment(d.Get(names.AttrEnvironment).(string)),
+			Industry:            aws.String(d.Get("industry").(string)),
+			IndustryType:        aws.String(d.Get("industry_type").(string)),
+			NonAwsRegions:       flex.ExpandStringValueSet(d.Get("non_aws_regions").(*schema.Set)),
+			Notes:               aws.String(d.Get("notes").(string)),
+			PillarPriorities:    flex.ExpandStringValueList(d.Get("pillar_priorities").([]interface{})),
+			WorkloadId:          aws.String(d.Id()),
+			WorkloadName:        aws.String(d.Get("workload_name").(string)),
+		}
+
+		if d.HasChange("review_owner") {
+			input.ReviewOwner = aws.String(d.Get("review_owner").(string))
+		}
+
+		_, err := conn.UpdateWorkload(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Well-Architected Workload (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceWorkloadRead(ctx, d, meta)...)
+}
+
+func resourceWorkloadDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Well-Architected Workload: %s", d.Id())
+	_, err := conn.DeleteWorkload(ctx, &wellarchitected.DeleteWorkloadInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		WorkloadId:         aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Well-Architected Workload (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findWorkloadByID(ctx context.Context, conn *wellarchitected.Client, id string) (*types.Workload, error) {
+	input := &wellarchitected.GetWorkloadInput{
+		WorkloadId: aws.String(id),
+	}
+
+	output, err := conn.GetWorkload(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Workload == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Workload, nil
+}
@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWellArchitectedWorkload_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_workload.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadConfig_basic(rName, "initial workload"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "wellarchitected", regexache.MustCompile(`workload/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "initial workload"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnvironment, "PREPRODUCTION"),
+					resource.TestCheckResourceAttr(resourceName, "lenses.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttr(resourceName, "lenses.*", "wellarchitected"),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrOwner),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "workload_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkloadConfig_basic(rName, "updated workload"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated workload"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedWorkload_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_workload.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadConfig_basic(rName, "initial workload"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfwellarchitected.ResourceWorkload(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedWorkload_lenses(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_workload.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadConfig_lenses(rName, `"wellarchitected", "serverless"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lenses.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "lenses.*", "wellarchitected"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "lenses.*", "serverless"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkloadConfig_lenses(rName, `"wellarchitected"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lenses.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttr(resourceName, "lenses.*", "wellarchitected"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedWorkload_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_workload.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkloadConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccWorkloadConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWorkloadDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wellarchitected_workload" {
+				continue
+			}
+
+			_, err := tfwellarchitected.FindWorkloadByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Well-Architected Workload %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWorkloadExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		_, err := tfwellarchitected.FindWorkloadByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccWorkloadConfig_basic(rName, description string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_wellarchitected_workload" "test" {
+  workload_name = %[1]q
+  description   = %[2]q
+  environment   = "PREPRODUCTION"
+  aws_regions   = [data.aws_region.current.name]
+  review_owner  = "terraform"
+  lenses        = ["wellarchitected"]
+}
+`, rName, description)
+}
+
+func testAccWorkloadConfig_lenses(rName, lenses string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_wellarchitected_workload" "test" {
+  workload_name = %[1]q
+  description   = "lenses"
+  environment   = "PREPRODUCTION"
+  aws_regions   = [data.aws_region.current.name]
+  review_owner  = "terraform"
+  lenses        = [%[2]s]
+}
+`, rName, lenses)
+}
+
+func testAccWorkloadConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_wellarchitected_workload" "test" {
+  workload_name = %[1]q
+  description   = "tags"
+  environment   = "PREPRODUCTION"
+  aws_regions   = [data.aws_region.current.name]
+  review_owner  = "terraform"
+  lenses        = ["wellarchitected"]
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccWorkloadConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_wellarchitected_workload" "test" {
+  workload_name = %[1]q
+  description   = "tags"
+  environment   = "PREPRODUCTION"
+  aws_regions   = [data.aws_region.current.name]
+  review_owner  = "terraform"
+  lenses        = ["wellarchitected"]
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
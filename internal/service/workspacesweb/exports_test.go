@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+// Exports for use in tests only.
+var (
+	ResourceIPAccessSettings                     = resourceIPAccessSettings
+	ResourceIPAccessSettingsAssociation          = resourceIPAccessSettingsAssociation
+	ResourcePortal                               = resourcePortal
+	ResourceUserAccessLoggingSettings            = resourceUserAccessLoggingSettings
+	ResourceUserAccessLoggingSettingsAssociation = resourceUserAccessLoggingSettingsAssociation
+
+	FindIPAccessSettingsAssociationByPortalARN          = findIPAccessSettingsAssociationByPortalARN
+	FindIPAccessSettingsByARN                           = findIPAccessSettingsByARN
+	FindPortalByARN                                     = findPortalByARN
+	FindUserAccessLoggingSettingsAssociationByPortalARN = findUserAccessLoggingSettingsAssociationByPortalARN
+	FindUserAccessLoggingSettingsByARN                  = findUserAccessLoggingSettingsByARN
+)
@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_workspacesweb_ip_access_settings", name="IP Access Settings")
+// @Tags(identifierAttribute="arn")
+func resourceIPAccessSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIPAccessSettingsCreate,
+		ReadWithoutTimeout:   resourceIPAccessSettingsRead,
+		UpdateWithoutTimeout: resourceIPAccessSettingsUpdate,
+		DeleteWithoutTimeout: resourceIPAccessSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"additional_encryption_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associated_portal_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"customer_managed_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			names.AttrDisplayName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"ip_rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDescription: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"ip_range": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceIPAccessSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+	input := &workspacesweb.CreateIpAccessSettingsInput{
+		IpRules: expandIPRules(d.Get("ip_rule").([]interface{})),
+		Tags:    getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("additional_encryption_context"); ok && len(v.(map[string]interface{})) > 0 {
+		input.AdditionalEncryptionContext = flex.ExpandStringValueMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("customer_managed_key"); ok {
+		input.CustomerManagedKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDisplayName); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateIpAccessSettings(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating WorkSpaces Web IP Access Settings: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.IpAccessSettingsArn))
+
+	return append(diags, resourceIPAccessSettingsRead(ctx, d, meta)...)
+}
+
+func resourceIPAccessSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+	settings, err := findIPAccessSettingsByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web IP Access Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WorkSpaces Web IP Access Settings (%s): %s", d.Id(), err)
+	}
+
+	d.Set("additional_encryption_context", settings.AdditionalEncryptionContext)
+	d.Set(names.AttrARN, settings.IpAccessSettingsArn)
+	d.Set("associated_portal_arns", settings.AssociatedPortalArns)
+	d.Set("customer_managed_key", settings.CustomerManagedKey)
+	d.Set(names.AttrDescription, settings.Description)
+	d.Set(names.AttrDisplayName, settings.DisplayName)
+	if err := d.Set("ip_rule", flattenIPRules(settings.IpRules)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ip_rule: %s", err)
+	}
+
+	return diags
+}
+
+func resourceIPAccessSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+	if d.HasChanges(names.AttrDescription, names.AttrDisplayName, "ip_rule") {
+		input := &workspacesweb.UpdateIpAccessSettingsInput{
+			IpAccessSettingsArn: aws.String(d.Id()),
+			IpRules:             expandIPRules(d.Get("ip_rule").([]interface{})),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChange(names.AttrDisplayName) {
+			input.DisplayName = aws.String(d.Get(names.AttrDisplayName).(string))
+		}
+
+		_, err := conn.UpdateIpAccessSettings(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WorkSpaces Web IP Access Settings (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceIPAccessSettingsRead(ctx, d, meta)...)
+}
+
+func resourceIPAccessSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+	log.Printf("[DEBUG] Deleting WorkSpaces Web IP Access Settings: %s", d.Id())
+	_, err := conn.DeleteIpAccessSettings(ctx, &workspacesweb.DeleteIpAccessSettingsInput{
+		IpAccessSettingsArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting WorkSpaces Web IP Access Settings (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findIPAccessSettingsByARN(ctx context.Context, conn *workspacesweb.Client, arn string) (*awstypes.IpAccessSettings, error) {
+	input := &workspacesweb.GetIpAccessSettingsInput{
+		IpAccessSettingsArn: aws.String(arn),
+	}
+
+	output, err := conn.GetIpAccessSettings(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.IpAccessSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.IpAccessSettings, nil
+}
+
+func expandIPRules(tfList []interface{}) []awstypes.IpRule {
+	var apiObjects []awstypes.IpRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.IpRule{
+			IpRange: aws.String(tfMap["ip_range"].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrDescription].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenIPRules(apiObjects []awstypes.IpRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrDescription: aws.ToString(apiObject.Description),
+			"ip_range":            aws.ToString(apiObject.IpRange),
+		})
+	}
+
+	return tfList
+}
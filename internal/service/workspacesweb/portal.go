@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_workspacesweb_portal", name="Portal")
+// @Tags(identifierAttribute="arn")
+func resourcePortal() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePortalCreate,
+		ReadWithoutTimeout:   resourcePortalRead,
+		UpdateWithoutTimeout: resourcePortalUpdate,
+		DeleteWithoutTimeout: resourcePortalDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"additional_encryption_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authentication_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.AuthenticationType](),
+			},
+			"browser_settings_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"browser_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"customer_managed_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrDisplayName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			names.AttrInstanceType: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.InstanceType](),
+			},
+			"ip_access_settings_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_concurrent_sessions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 5000),
+			},
+			"network_settings_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"portal_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"portal_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"renderer_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"trust_store_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_access_logging_settings_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_settings_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePortalCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+	input := &workspacesweb.CreatePortalInput{
+		Tags: getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("additional_encryption_context"); ok && len(v.(map[string]interface{})) > 0 {
+		input.AdditionalEncryptionContext = flex.ExpandStringValueMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("authentication_type"); ok {
+		input.AuthenticationType = awstypes.AuthenticationType(v.(string))
+	}
+
+	if v, ok := d.GetOk("customer_managed_key"); ok {
+		input.CustomerManagedKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDisplayName); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrInstanceType); ok {
+		input.InstanceType = awstypes.InstanceType(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_concurrent_sessions"); ok {
+		input.MaxConcurrentSessions = aws.Int32(int32(v.(int)))
+	}
+
+	output, err := conn.CreatePortal(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating WorkSpaces Web Portal: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.PortalArn))
+
+	return append(diags, resourcePortalRead(ctx, d, meta)...)
+}
+
+func resourcePortalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+	portal, err := findPortalByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web Portal (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WorkSpaces Web Portal (%s): %s", d.Id(), err)
+	}
+
+	d.Set("additional_encryption_context", portal.AdditionalEncryptionContext)
+	d.Set(names.AttrARN, portal.PortalArn)
+	d.Set("authentication_type", portal.AuthenticationType)
+	d.Set("browser_settings_arn", portal.BrowserSettingsArn)
+	d.Set("browser_type", portal.BrowserType)
+	if portal.CreationDate != nil {
+		d.Set(names.AttrCreationDate, aws.ToTime(portal.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrCreationDate, nil)
+	}
+	d.Set("customer_managed_key", portal.CustomerManagedKey)
+	d.Set(names.AttrDisplayName, portal.DisplayName)
+	d.Set(names.AttrInstanceType, portal.InstanceType)
+	d.Set("ip_access_settings_arn", portal.IpAccessSettingsArn)
+	d.Set("max_concurrent_sessions", portal.MaxConcurrentSessions)
+	d.Set("network_settings_arn", portal.NetworkSettingsArn)
+	d.Set("portal_endpoint", portal.PortalEndpoint)
+	d.Set("portal_status", portal.PortalStatus)
+	d.Set("renderer_type", portal.RendererType)
+	d.Set("status_reason", portal.StatusReason)
+	d.Set("trust_store_arn", portal.TrustStoreArn)
+	d.Set("user_access_logging_settings_arn", portal.UserAccessLoggingSettingsArn)
+	d.Set("user_settings_arn", portal.UserSettingsArn)
+
+	return diags
+}
+
+func resourcePortalUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &workspacesweb.UpdatePortalInput{
+			PortalArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("authentication_type") {
+			input.AuthenticationType = awstypes.AuthenticationType(d.Get("authentication_type").(string))
+		}
+
+		if d.HasChange(names.AttrDisplayName) {
+			input.DisplayName = aws.String(d.Get(names.AttrDisplayName).(string))
+		}
+
+		if d.HasChange(names.AttrInstanceType) {
+			input.InstanceType = awstypes.InstanceType(d.Get(names.AttrInstanceType).(string))
+		}
+
+		if d.HasChange("max_concurrent_sessions") {
+			input.MaxConcurrentSessions = aws.Int32(int32(d.Get("max_concurrent_sessions").(int)))
+		}
+
+		_, err := conn.UpdatePortal(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WorkSpaces Web Portal (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePortalRead(ctx, d, meta)...)
+}
+
+func resourcePortalDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+	log.Printf("[DEBUG] Deleting WorkSpaces Web Portal: %s", d.Id())
+	_, err := conn.DeletePortal(ctx, &workspacesweb.DeletePortalInput{
+		PortalArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting WorkSpaces Web Portal (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findPortalByARN(ctx context.Context, conn *workspacesweb.Client, arn string) (*awstypes.Portal, error) {
+	input := &workspacesweb.GetPortalInput{
+		PortalArn: aws.String(arn),
+	}
+
+	output, err := conn.GetPortal(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Portal == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Portal, nil
+}
@@ -27,7 +27,42 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceIPAccessSettings,
+			TypeName: "aws_workspacesweb_ip_access_settings",
+			Name:     "IP Access Settings",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  resourceIPAccessSettingsAssociation,
+			TypeName: "aws_workspacesweb_ip_access_settings_association",
+			Name:     "IP Access Settings Association",
+		},
+		{
+			Factory:  resourcePortal,
+			TypeName: "aws_workspacesweb_portal",
+			Name:     "Portal",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  resourceUserAccessLoggingSettings,
+			TypeName: "aws_workspacesweb_user_access_logging_settings",
+			Name:     "User Access Logging Settings",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  resourceUserAccessLoggingSettingsAssociation,
+			TypeName: "aws_workspacesweb_user_access_logging_settings_association",
+			Name:     "User Access Logging Settings Association",
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_workspacesweb_user_access_logging_settings", name="User Access Logging Settings")
+// @Tags(identifierAttribute="arn")
+func resourceUserAccessLoggingSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUserAccessLoggingSettingsCreate,
+		ReadWithoutTimeout:   resourceUserAccessLoggingSettingsRead,
+		UpdateWithoutTimeout: resourceUserAccessLoggingSettingsUpdate,
+		DeleteWithoutTimeout: resourceUserAccessLoggingSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associated_portal_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"kinesis_stream_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceUserAccessLoggingSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+	input := &workspacesweb.CreateUserAccessLoggingSettingsInput{
+		KinesisStreamArn: aws.String(d.Get("kinesis_stream_arn").(string)),
+		Tags:             getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateUserAccessLoggingSettings(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating WorkSpaces Web User Access Logging Settings: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.UserAccessLoggingSettingsArn))
+
+	return append(diags, resourceUserAccessLoggingSettingsRead(ctx, d, meta)...)
+}
+
+func resourceUserAccessLoggingSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+	settings, err := findUserAccessLoggingSettingsByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web User Access Logging Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WorkSpaces Web User Access Logging Settings (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, settings.UserAccessLoggingSettingsArn)
+	d.Set("associated_portal_arns", settings.AssociatedPortalArns)
+	d.Set("kinesis_stream_arn", settings.KinesisStreamArn)
+
+	return diags
+}
+
+func resourceUserAccessLoggingSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+	if d.HasChange("kinesis_stream_arn") {
+		input := &workspacesweb.UpdateUserAccessLoggingSettingsInput{
+			KinesisStreamArn:             aws.String(d.Get("kinesis_stream_arn").(string)),
+			UserAccessLoggingSettingsArn: aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateUserAccessLoggingSettings(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WorkSpaces Web User Access Logging Settings (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceUserAccessLoggingSettingsRead(ctx, d, meta)...)
+}
+
+func resourceUserAccessLoggingSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+	log.Printf("[DEBUG] Deleting WorkSpaces Web User Access Logging Settings: %s", d.Id())
+	_, err := conn.DeleteUserAccessLoggingSettings(ctx, &workspacesweb.DeleteUserAccessLoggingSettingsInput{
+		UserAccessLoggingSettingsArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting WorkSpaces Web User Access Logging Settings (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findUserAccessLoggingSettingsByARN(ctx context.Context, conn *workspacesweb.Client, arn string) (*awstypes.UserAccessLoggingSettings, error) {
+	input := &workspacesweb.GetUserAccessLoggingSettingsInput{
+		UserAccessLoggingSettingsArn: aws.String(arn),
+	}
+
+	output, err := conn.GetUserAccessLoggingSettings(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.UserAccessLoggingSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.UserAccessLoggingSettings, nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_workspacesweb_user_access_logging_settings_association", name="User Access Logging Settings Association")
+func resourceUserAccessLoggingSettingsAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUserAccessLoggingSettingsAssociationPut,
+		ReadWithoutTimeout:   resourceUserAccessLoggingSettingsAssociationRead,
+		UpdateWithoutTimeout: resourceUserAccessLoggingSettingsAssociationPut,
+		DeleteWithoutTimeout: resourceUserAccessLoggingSettingsAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_access_logging_settings_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"portal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceUserAccessLoggingSettingsAssociationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+	// Associating new settings with a portal replaces any existing association,
+	// so a change of settings is applied in place.
+	portalARN := d.Get("portal_arn").(string)
+	input := &workspacesweb.AssociateUserAccessLoggingSettingsInput{
+		UserAccessLoggingSettingsArn: aws.String(d.Get("user_access_logging_settings_arn").(string)),
+		PortalArn:                    aws.String(portalARN),
+	}
+
+	_, err := conn.AssociateUserAccessLoggingSettings(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "associating WorkSpaces Web User Access Logging Settings with Portal (%s): %s", portalARN, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(portalARN)
+	}
+
+	return append(diags, resourceUserAccessLoggingSettingsAssociationRead(ctx, d, meta)...)
+}
+
+func resourceUserAccessLoggingSettingsAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+	portal, err := findUserAccessLoggingSettingsAssociationByPortalARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web User Access Logging Settings Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WorkSpaces Web User Access Logging Settings Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("user_access_logging_settings_arn", portal.UserAccessLoggingSettingsArn)
+	d.Set("portal_arn", portal.PortalArn)
+
+	return diags
+}
+
+func resourceUserAccessLoggingSettingsAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+	log.Printf("[DEBUG] Deleting WorkSpaces Web User Access Logging Settings Association: %s", d.Id())
+	_, err := conn.DisassociateUserAccessLoggingSettings(ctx, &workspacesweb.DisassociateUserAccessLoggingSettingsInput{
+		PortalArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting WorkSpaces Web User Access Logging Settings Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findUserAccessLoggingSettingsAssociationByPortalARN(ctx context.Context, conn *workspacesweb.Client, portalARN string) (*awstypes.Portal, error) {
+	portal, err := findPortalByARN(ctx, conn, portalARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.ToString(portal.UserAccessLoggingSettingsArn) == "" {
+		return nil, &retry.NotFoundError{}
+	}
+
+	return portal, nil
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkSpacesWebUserAccessLoggingSettingsAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_workspacesweb_user_access_logging_settings_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserAccessLoggingSettingsAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserAccessLoggingSettingsAssociationConfig_basic(rName, "test1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserAccessLoggingSettingsAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "user_access_logging_settings_arn", "aws_workspacesweb_user_access_logging_settings.test1", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "portal_arn", "aws_workspacesweb_portal.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUserAccessLoggingSettingsAssociationConfig_basic(rName, "test2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserAccessLoggingSettingsAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "user_access_logging_settings_arn", "aws_workspacesweb_user_access_logging_settings.test2", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebUserAccessLoggingSettingsAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_workspacesweb_user_access_logging_settings_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserAccessLoggingSettingsAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserAccessLoggingSettingsAssociationConfig_basic(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserAccessLoggingSettingsAssociationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfworkspacesweb.ResourceUserAccessLoggingSettingsAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckUserAccessLoggingSettingsAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspacesweb_user_access_logging_settings_association" {
+				continue
+			}
+
+			_, err := tfworkspacesweb.FindUserAccessLoggingSettingsAssociationByPortalARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkSpaces Web User Access Logging Settings Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckUserAccessLoggingSettingsAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		_, err := tfworkspacesweb.FindUserAccessLoggingSettingsAssociationByPortalARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccUserAccessLoggingSettingsAssociationConfig_basic(rName, streamName string) string {
+	return acctest.ConfigCompose(testAccUserAccessLoggingSettingsConfig_base(rName), fmt.Sprintf(`
+resource "aws_workspacesweb_portal" "test" {
+  display_name = %[1]q
+}
+
+resource "aws_workspacesweb_user_access_logging_settings" "test1" {
+  kinesis_stream_arn = aws_kinesis_stream.test1.arn
+}
+
+resource "aws_workspacesweb_user_access_logging_settings" "test2" {
+  kinesis_stream_arn = aws_kinesis_stream.test2.arn
+}
+
+resource "aws_workspacesweb_user_access_logging_settings_association" "test" {
+  portal_arn                       = aws_workspacesweb_portal.test.arn
+  user_access_logging_settings_arn = aws_workspacesweb_user_access_logging_settings.%[2]s.arn
+}
+`, rName, streamName))
+}
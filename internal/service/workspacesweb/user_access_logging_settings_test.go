@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkSpacesWebUserAccessLoggingSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.UserAccessLoggingSettings
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_workspacesweb_user_access_logging_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserAccessLoggingSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserAccessLoggingSettingsConfig_basic(rName, "test1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserAccessLoggingSettingsExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "workspaces-web", regexache.MustCompile(`userAccessLoggingSettings/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "kinesis_stream_arn", "aws_kinesis_stream.test1", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUserAccessLoggingSettingsConfig_basic(rName, "test2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserAccessLoggingSettingsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "kinesis_stream_arn", "aws_kinesis_stream.test2", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebUserAccessLoggingSettings_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.UserAccessLoggingSettings
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_workspacesweb_user_access_logging_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserAccessLoggingSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserAccessLoggingSettingsConfig_basic(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserAccessLoggingSettingsExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfworkspacesweb.ResourceUserAccessLoggingSettings(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckUserAccessLoggingSettingsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspacesweb_user_access_logging_settings" {
+				continue
+			}
+
+			_, err := tfworkspacesweb.FindUserAccessLoggingSettingsByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkSpaces Web User Access Logging Settings %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckUserAccessLoggingSettingsExists(ctx context.Context, n string, v *awstypes.UserAccessLoggingSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		output, err := tfworkspacesweb.FindUserAccessLoggingSettingsByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// The Kinesis data stream name must begin with "amazon-workspaces-web-".
+func testAccUserAccessLoggingSettingsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_stream" "test1" {
+  name        = "amazon-workspaces-web-%[1]s-1"
+  shard_count = 1
+}
+
+resource "aws_kinesis_stream" "test2" {
+  name        = "amazon-workspaces-web-%[1]s-2"
+  shard_count = 1
+}
+`, rName)
+}
+
+func testAccUserAccessLoggingSettingsConfig_basic(rName, streamName string) string {
+	return acctest.ConfigCompose(testAccUserAccessLoggingSettingsConfig_base(rName), fmt.Sprintf(`
+resource "aws_workspacesweb_user_access_logging_settings" "test" {
+  kinesis_stream_arn = aws_kinesis_stream.%[1]s.arn
+}
+`, streamName))
+}
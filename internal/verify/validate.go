@@ -550,6 +550,20 @@ func MapKeysAre(keyValidators ...schema.SchemaValidateDiagFunc) schema.SchemaVal
 	}
 }
 
+func MapValuesAre(valueValidators ...schema.SchemaValidateDiagFunc) schema.SchemaValidateDiagFunc {
+	return func(v interface{}, path cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		for k, value := range v.(map[string]interface{}) {
+			for _, valueValidator := range valueValidators {
+				diags = append(diags, valueValidator(value, path.IndexString(k))...)
+			}
+		}
+
+		return diags
+	}
+}
+
 func MapSizeAtMost(max int) schema.SchemaValidateDiagFunc {
 	return func(v interface{}, path cty.Path) diag.Diagnostics {
 		var diags diag.Diagnostics
@@ -944,3 +944,41 @@ func TestMapKeysAre(t *testing.T) {
 		})
 	}
 }
+
+func TestMapValuesAre(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		value   interface{}
+		wantErr bool
+	}{
+		{
+			name: "ok",
+			value: map[string]interface{}{
+				"K1": "V1",
+				"K2": "V2",
+			},
+		},
+		{
+			name: "not ok",
+			value: map[string]interface{}{
+				"K1": "V1",
+				"K3": "V3",
+			},
+			wantErr: true,
+		},
+	}
+	f := MapValuesAre(validation.ToDiagFunc(validation.StringInSlice([]string{"V1", "V2"}, false)))
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := f(testCase.value, cty.Path{})
+			if got, want := diags.HasError(), testCase.wantErr; got != want {
+				t.Errorf("got = %v, want = %v", got, want)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ const (
 	AppStream                    = "appstream"
 	AppSync                      = "appsync"
 	ApplicationInsights          = "applicationinsights"
+	ApplicationSignals           = "applicationsignals"
 	Athena                       = "athena"
 	AuditManager                 = "auditmanager"
 	AutoScaling                  = "autoscaling"
@@ -29,6 +30,8 @@ const (
 	Batch                        = "batch"
 	Bedrock                      = "bedrock"
 	BedrockAgent                 = "bedrockagent"
+	BillingConductor             = "billingconductor"
+	Braket                       = "braket"
 	Budgets                      = "budgets"
 	CE                           = "ce"
 	CUR                          = "cur"
@@ -69,6 +72,7 @@ const (
 	DLM                          = "dlm"
 	DMS                          = "dms"
 	DS                           = "ds"
+	DataBrew                     = "databrew"
 	DataExchange                 = "dataexchange"
 	DataPipeline                 = "datapipeline"
 	DataSync                     = "datasync"
@@ -96,6 +100,7 @@ const (
 	ElasticBeanstalk             = "elasticbeanstalk"
 	ElasticTranscoder            = "elastictranscoder"
 	Elasticsearch                = "elasticsearch"
+	EntityResolution             = "entityresolution"
 	Events                       = "events"
 	Evidently                    = "evidently"
 	FIS                          = "fis"
@@ -109,6 +114,7 @@ const (
 	Glue                         = "glue"
 	Grafana                      = "grafana"
 	Greengrass                   = "greengrass"
+	GreengrassV2                 = "greengrassv2"
 	GroundStation                = "groundstation"
 	GuardDuty                    = "guardduty"
 	HealthLake                   = "healthlake"
@@ -123,6 +129,7 @@ const (
 	IoT                          = "iot"
 	IoTAnalytics                 = "iotanalytics"
 	IoTEvents                    = "iotevents"
+	IoTTwinMaker                 = "iottwinmaker"
 	KMS                          = "kms"
 	Kafka                        = "kafka"
 	KafkaConnect                 = "kafkaconnect"
@@ -146,6 +153,8 @@ const (
 	MQ                           = "mq"
 	MWAA                         = "mwaa"
 	Macie2                       = "macie2"
+	ManagedBlockchain            = "managedblockchain"
+	MarketplaceCatalog           = "marketplacecatalog"
 	MediaConnect                 = "mediaconnect"
 	MediaConvert                 = "mediaconvert"
 	MediaLive                    = "medialive"
@@ -157,6 +166,7 @@ const (
 	NeptuneGraph                 = "neptunegraph"
 	NetworkFirewall              = "networkfirewall"
 	NetworkManager               = "networkmanager"
+	NetworkMonitor               = "networkmonitor"
 	ObservabilityAccessManager   = "oam"
 	OpenSearch                   = "opensearch"
 	OpenSearchIngestion          = "osis"
@@ -181,6 +191,7 @@ const (
 	RedshiftData                 = "redshiftdata"
 	RedshiftServerless           = "redshiftserverless"
 	Rekognition                  = "rekognition"
+	ResilienceHub                = "resiliencehub"
 	ResourceExplorer2            = "resourceexplorer2"
 	ResourceGroups               = "resourcegroups"
 	ResourceGroupsTaggingAPI     = "resourcegroupstaggingapi"
@@ -223,9 +234,11 @@ const (
 	SimpleDB                     = "simpledb"
 	StorageGateway               = "storagegateway"
 	Synthetics                   = "synthetics"
+	TimestreamInfluxDB           = "timestreaminfluxdb"
 	TimestreamWrite              = "timestreamwrite"
 	Transcribe                   = "transcribe"
 	Transfer                     = "transfer"
+	Translate                    = "translate"
 	VPCLattice                   = "vpclattice"
 	VerifiedPermissions          = "verifiedpermissions"
 	WAF                          = "waf"
@@ -259,6 +272,7 @@ const (
 	AppStreamServiceID                    = "AppStream"
 	AppSyncServiceID                      = "AppSync"
 	ApplicationInsightsServiceID          = "Application Insights"
+	ApplicationSignalsServiceID           = "Application Signals"
 	AthenaServiceID                       = "Athena"
 	AuditManagerServiceID                 = "AuditManager"
 	AutoScalingServiceID                  = "Auto Scaling"
@@ -268,6 +282,8 @@ const (
 	BatchServiceID                        = "Batch"
 	BedrockServiceID                      = "Bedrock"
 	BedrockAgentServiceID                 = "Bedrock Agent"
+	BillingConductorServiceID             = "billingconductor"
+	BraketServiceID                       = "Braket"
 	BudgetsServiceID                      = "Budgets"
 	CEServiceID                           = "Cost Explorer"
 	CURServiceID                          = "Cost and Usage Report Service"
@@ -308,6 +324,7 @@ const (
 	DLMServiceID                          = "DLM"
 	DMSServiceID                          = "Database Migration Service"
 	DSServiceID                           = "Directory Service"
+	DataBrewServiceID                     = "DataBrew"
 	DataExchangeServiceID                 = "DataExchange"
 	DataPipelineServiceID                 = "Data Pipeline"
 	DataSyncServiceID                     = "DataSync"
@@ -335,6 +352,7 @@ const (
 	ElasticBeanstalkServiceID             = "Elastic Beanstalk"
 	ElasticTranscoderServiceID            = "Elastic Transcoder"
 	ElasticsearchServiceID                = "Elasticsearch Service"
+	EntityResolutionServiceID             = "EntityResolution"
 	EventsServiceID                       = "EventBridge"
 	EvidentlyServiceID                    = "Evidently"
 	FISServiceID                          = "fis"
@@ -348,6 +366,7 @@ const (
 	GlueServiceID                         = "Glue"
 	GrafanaServiceID                      = "grafana"
 	GreengrassServiceID                   = "Greengrass"
+	GreengrassV2ServiceID                 = "GreengrassV2"
 	GroundStationServiceID                = "GroundStation"
 	GuardDutyServiceID                    = "GuardDuty"
 	HealthLakeServiceID                   = "HealthLake"
@@ -362,6 +381,7 @@ const (
 	IoTServiceID                          = "IoT"
 	IoTAnalyticsServiceID                 = "IoTAnalytics"
 	IoTEventsServiceID                    = "IoT Events"
+	IoTTwinMakerServiceID                 = "IoTTwinMaker"
 	KMSServiceID                          = "KMS"
 	KafkaServiceID                        = "Kafka"
 	KafkaConnectServiceID                 = "KafkaConnect"
@@ -385,6 +405,8 @@ const (
 	MQServiceID                           = "mq"
 	MWAAServiceID                         = "MWAA"
 	Macie2ServiceID                       = "Macie2"
+	ManagedBlockchainServiceID            = "ManagedBlockchain"
+	MarketplaceCatalogServiceID           = "Marketplace Catalog"
 	MediaConnectServiceID                 = "MediaConnect"
 	MediaConvertServiceID                 = "MediaConvert"
 	MediaLiveServiceID                    = "MediaLive"
@@ -396,6 +418,7 @@ const (
 	NeptuneGraphServiceID                 = "Neptune Graph"
 	NetworkFirewallServiceID              = "Network Firewall"
 	NetworkManagerServiceID               = "NetworkManager"
+	NetworkMonitorServiceID               = "NetworkMonitor"
 	ObservabilityAccessManagerServiceID   = "OAM"
 	OpenSearchServiceID                   = "OpenSearch"
 	OpenSearchIngestionServiceID          = "OSIS"
@@ -420,6 +443,7 @@ const (
 	RedshiftDataServiceID                 = "Redshift Data"
 	RedshiftServerlessServiceID           = "Redshift Serverless"
 	RekognitionServiceID                  = "Rekognition"
+	ResilienceHubServiceID                = "resiliencehub"
 	ResourceExplorer2ServiceID            = "Resource Explorer 2"
 	ResourceGroupsServiceID               = "Resource Groups"
 	ResourceGroupsTaggingAPIServiceID     = "Resource Groups Tagging API"
@@ -462,9 +486,11 @@ const (
 	SimpleDBServiceID                     = "SimpleDB"
 	StorageGatewayServiceID               = "Storage Gateway"
 	SyntheticsServiceID                   = "synthetics"
+	TimestreamInfluxDBServiceID           = "Timestream InfluxDB"
 	TimestreamWriteServiceID              = "Timestream Write"
 	TranscribeServiceID                   = "Transcribe"
 	TransferServiceID                     = "Transfer"
+	TranslateServiceID                    = "Translate"
 	VPCLatticeServiceID                   = "VPC Lattice"
 	VerifiedPermissionsServiceID          = "VerifiedPermissions"
 	WAFServiceID                          = "WAF"